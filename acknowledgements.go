@@ -0,0 +1,312 @@
+//
+// Acknowledgements, and maintenance windows.
+//
+// A node which is known to be broken, or which is being rebuilt, would
+// otherwise stay red on our dashboard and in our `state.failed` metrics,
+// hiding any new failures.  Instead an operator may:
+//
+//  * Acknowledge a failed, or orphaned, node with a note and an expiry.
+//    The acknowledgement is removed early if the node next reports that
+//    it hasn't failed.
+//
+//  * Schedule a window of maintenance, for the nodes whose names match
+//    a pattern, or for the members of a node group.
+//
+// While either covers a node which has failed, or is orphaned, its state
+// is `acknowledged` instead.  We don't raise alerts ourselves, so changing
+// the state reported by our listings and metrics is the only way in which
+// such a node is excluded from alerting.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//
+// Acknowledgement is either the acknowledgement of a single node, or a
+// window of maintenance.
+//
+type Acknowledgement struct {
+	ID int64
+
+	// Kind is either `acknowledgement` or `maintenance`.
+	Kind string
+
+	// Fqdn is the name of the node which is acknowledged, or the
+	// pattern of the nodes within a window of maintenance.
+	Fqdn string `json:",omitempty" xml:",omitempty"`
+
+	// Group is the node group within a window of maintenance.
+	Group string `json:",omitempty" xml:",omitempty"`
+
+	Note string
+
+	// The period covered, in seconds past the epoch, and as times.
+	Start  int64
+	End    int64
+	Starts string
+	Ends   string
+
+	// Active is true if the period has begun.
+	Active bool
+}
+
+//
+// The longest note we'll store.
+//
+var maxAcknowledgementNote = 1024
+
+//
+// Validate returns an error if the acknowledgement contains anything
+// bogus.
+//
+func (a Acknowledgement) Validate() error {
+
+	switch a.Kind {
+	case "acknowledgement":
+		if !fqdnRegexp.MatchString(a.Fqdn) {
+			return fmt.Errorf("invalid node '%s'", a.Fqdn)
+		}
+		if len(a.Group) > 0 {
+			return errors.New("only a single node may be acknowledged")
+		}
+	case "maintenance":
+		if len(a.Fqdn) > 0 && len(a.Group) > 0 {
+			return errors.New("a window of maintenance covers either a pattern, or a group, not both")
+		}
+		if len(a.Fqdn) > 0 && !fqdnPatternRegexp.MatchString(a.Fqdn) {
+			return fmt.Errorf("invalid node pattern '%s'", a.Fqdn)
+		}
+		if len(a.Group) > 0 {
+			if _, ok := currentSettings().Groups.Rule(a.Group); !ok {
+				return fmt.Errorf("unknown group '%s'", a.Group)
+			}
+		}
+		if len(a.Fqdn) == 0 && len(a.Group) == 0 {
+			return errors.New("a window of maintenance requires an fqdn, or a group")
+		}
+	default:
+		return fmt.Errorf("invalid kind '%s'", a.Kind)
+	}
+
+	if len(strings.TrimSpace(a.Note)) == 0 {
+		return errors.New("missing 'note'")
+	}
+	if len(a.Note) > maxAcknowledgementNote {
+		return fmt.Errorf("the note is longer than %d bytes", maxAcknowledgementNote)
+	}
+	if a.End <= a.Start {
+		return errors.New("the end must be after the start")
+	}
+	return nil
+}
+
+//
+// Covers returns true if the acknowledgement applies to the named node,
+// whether or not it has begun.
+//
+func (a Acknowledgement) Covers(fqdn string) bool {
+	if len(a.Group) > 0 {
+		rule, ok := currentSettings().Groups.Rule(a.Group)
+		return ok && rule.Match(fqdn)
+	}
+	ok, _ := filepath.Match(a.Fqdn, fqdn)
+	return ok
+}
+
+//
+// parseWindowTime parses the start, or end, of a period, which may be
+// relative to the given time, such as `4h` from now, or any time accepted
+// by our `from` and `to` parameters.
+//
+func parseWindowTime(value string, now time.Time) (time.Time, error) {
+	m := searchAgoRegexp.FindStringSubmatch(value)
+	if m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(time.Duration(n) * searchAgoUnits[m[2]]), nil
+	}
+	return parseRangeTime(value)
+}
+
+//
+// acknowledgedCondition returns a condition upon the nodes-table which is
+// true for the nodes covered by an acknowledgement, or a window of
+// maintenance, at the given time.
+//
+// Groups are matched by their rules, which aren't stored in the database,
+// so there is a clause for each group we've configured.
+//
+func acknowledgedCondition(now int64) searchCondition {
+
+	conditions := []string{`EXISTS ( SELECT 1 FROM acknowledgements a
+                                  WHERE a.starts_at <= ? AND a.ends_at > ?
+                                    AND IFNULL(a.fqdn,'') != '' AND nodes.fqdn GLOB a.fqdn )`}
+	args := []interface{}{now, now}
+
+	groups := currentSettings().Groups
+	for _, name := range groups.Names() {
+		rule, _ := groups.Rule(name)
+
+		q := newQuery("").Where(`EXISTS ( SELECT 1 FROM acknowledgements a
+                                          WHERE a.node_group = ? AND a.starts_at <= ? AND a.ends_at > ? )`, name, now, now)
+		rule.Apply(q, "")
+
+		conditions = append(conditions, strings.Join(q.where, " AND "))
+		args = append(args, q.args...)
+	}
+	return searchCondition{sql: strings.Join(conditions, " OR "), args: args}
+}
+
+//
+// stateCondition returns a condition upon the nodes-table which is true
+// for the nodes in the given state, at the given time.
+//
+// Nodes are orphaned if they've not reported recently, and nodes which
+// have failed, or are orphaned, are acknowledged while they're covered
+// by an acknowledgement.
+//
+func stateCondition(state string, now int64) (searchCondition, error) {
+	cutoff := now - currentSettings().OrphanedThreshold
+	ack := acknowledgedCondition(now)
+
+	switch state {
+	case "changed", "unchanged":
+		return searchCondition{"state = ? AND last_seen >= ?", []interface{}{state, cutoff}}, nil
+	case "failed":
+		return searchCondition{"state = ? AND last_seen >= ? AND NOT ( " + ack.sql + " )",
+			append([]interface{}{state, cutoff}, ack.args...)}, nil
+	case "orphaned":
+		return searchCondition{"last_seen < ? AND NOT ( " + ack.sql + " )",
+			append([]interface{}{cutoff}, ack.args...)}, nil
+	case "acknowledged":
+		return searchCondition{"( state = 'failed' OR last_seen < ? ) AND ( " + ack.sql + " )",
+			append([]interface{}{cutoff}, ack.args...)}, nil
+	}
+	return searchCondition{}, fmt.Errorf("invalid state '%s'", state)
+}
+
+//
+// addAcknowledgement stores the given acknowledgement, or window of
+// maintenance, returning its ID.
+//
+// Those which have expired are removed at the same time.
+//
+func addAcknowledgement(a Acknowledgement) (int64, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return 0, errors.New("SetupDB not called")
+	}
+
+	err := a.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = db.Exec("DELETE FROM acknowledgements WHERE ends_at <= ?", time.Now().Unix())
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := db.Exec("INSERT INTO acknowledgements(kind, fqdn, node_group, note, starts_at, ends_at) VALUES(?,?,?,?,?,?)",
+		a.Kind, a.Fqdn, a.Group, a.Note, a.Start, a.End)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+//
+// removeAcknowledgement removes the acknowledgement, or window of
+// maintenance, with the given ID.
+//
+func removeAcknowledgement(id int64) error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	res, err := db.Exec("DELETE FROM acknowledgements WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("there is no acknowledgement with the ID %d", id)
+	}
+	return nil
+}
+
+//
+// getAcknowledgements returns the acknowledgements, and windows of
+// maintenance, which haven't yet ended, in the order they start.
+//
+func getAcknowledgements() ([]Acknowledgement, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	now := time.Now().Unix()
+
+	rows, err := db.Query(`SELECT id, kind, IFNULL(fqdn,''), IFNULL(node_group,''), IFNULL(note,''), starts_at, ends_at
+                                 FROM acknowledgements WHERE ends_at > ? ORDER BY starts_at, id`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var acks []Acknowledgement
+	for rows.Next() {
+		var tmp Acknowledgement
+		err = rows.Scan(&tmp.ID, &tmp.Kind, &tmp.Fqdn, &tmp.Group, &tmp.Note, &tmp.Start, &tmp.End)
+		if err != nil {
+			return nil, err
+		}
+		tmp.Starts = time.Unix(tmp.Start, 0).Format("2006-01-02 15:04:05")
+		tmp.Ends = time.Unix(tmp.End, 0).Format("2006-01-02 15:04:05")
+		tmp.Active = tmp.Start <= now
+		acks = append(acks, tmp)
+	}
+	return acks, rows.Err()
+}
+
+//
+// getNodeAcknowledgements returns the acknowledgements, and windows of
+// maintenance, which cover the given node and haven't yet ended.
+//
+func getNodeAcknowledgements(fqdn string) ([]Acknowledgement, error) {
+	acks, err := getAcknowledgements()
+	if err != nil {
+		return nil, err
+	}
+
+	var covers []Acknowledgement
+	for _, a := range acks {
+		if a.Covers(fqdn) {
+			covers = append(covers, a)
+		}
+	}
+	return covers, nil
+}
@@ -0,0 +1,274 @@
+//
+//  Testing of acknowledgements, and maintenance windows.
+//
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+//
+// Test validating acknowledgements.
+//
+func TestAcknowledgementValidate(t *testing.T) {
+
+	type TestCase struct {
+		Ack   Acknowledgement
+		Error string
+	}
+
+	tests := []TestCase{
+		{Acknowledgement{Kind: "acknowledgement", Fqdn: "web1.example.com", Note: "Rebuilding", End: 1}, ""},
+		{Acknowledgement{Kind: "maintenance", Fqdn: "web-*", Note: "Upgrades", Start: 1, End: 2}, ""},
+		{Acknowledgement{Kind: "acknowledgement", Fqdn: "web-*", Note: "Rebuilding", End: 1}, "invalid node"},
+		{Acknowledgement{Kind: "maintenance", Fqdn: "web'", Note: "Upgrades", End: 1}, "invalid node pattern"},
+		{Acknowledgement{Kind: "maintenance", Note: "Upgrades", End: 1}, "requires an fqdn, or a group"},
+		{Acknowledgement{Kind: "maintenance", Fqdn: "web-*", Group: "web", Note: "Upgrades", End: 1}, "not both"},
+		{Acknowledgement{Kind: "maintenance", Group: "missing", Note: "Upgrades", End: 1}, "unknown group"},
+		{Acknowledgement{Kind: "acknowledgement", Fqdn: "web1.example.com", Note: " ", End: 1}, "missing 'note'"},
+		{Acknowledgement{Kind: "acknowledgement", Fqdn: "web1.example.com", Note: strings.Repeat("x", 1025), End: 1}, "longer than"},
+		{Acknowledgement{Kind: "acknowledgement", Fqdn: "web1.example.com", Note: "Rebuilding", Start: 2, End: 1}, "end must be after"},
+		{Acknowledgement{Kind: "silence", Fqdn: "web1.example.com", Note: "Rebuilding", End: 1}, "invalid kind"},
+	}
+
+	for _, test := range tests {
+		err := test.Ack.Validate()
+		if test.Error == "" {
+			if err != nil {
+				t.Errorf("Unexpected error for %v: %s", test.Ack, err.Error())
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Error) {
+			t.Errorf("Expected an error containing %q for %v, got %v", test.Error, test.Ack, err)
+		}
+	}
+}
+
+//
+// Test the states, and metrics, of acknowledged nodes.
+//
+func TestAcknowledgedStates(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+
+	file, cleanup := writeConfig(t, "groups:\n  foo:\n    globs: [\"foo.*\"]\n")
+	defer cleanup()
+
+	groups, err := loadNodeGroups(file)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	bak := currentSettings()
+	settings := *bak
+	settings.Groups = groups
+	storeSettings(&settings)
+	defer storeSettings(bak)
+
+	now := time.Now().Unix()
+
+	count := func(state string) string {
+		return getMetrics(NodeFilter{}, "")["state."+state]
+	}
+
+	//
+	// Maintenance of nodes which haven't failed changes nothing, nor
+	// does maintenance which hasn't begun.
+	//
+	_, err = addAcknowledgement(Acknowledgement{Kind: "maintenance", Group: "foo", Note: "Upgrades", Start: now - 60, End: now + 3600})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	_, err = addAcknowledgement(Acknowledgement{Kind: "maintenance", Fqdn: "bar.*", Note: "Later", Start: now + 3600, End: now + 7200})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if count("changed") != "1" || count("failed") != "1" || count("acknowledged") != "0" {
+		t.Errorf("Unexpected metrics %v", getMetrics(NodeFilter{}, ""))
+	}
+
+	//
+	// Acknowledging the failed node.
+	//
+	_, err = addAcknowledgement(Acknowledgement{Kind: "acknowledgement", Fqdn: "bar.example.com", Note: "Broken disk", Start: now, End: now + 3600})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if count("failed") != "0" || count("acknowledged") != "1" {
+		t.Errorf("Unexpected metrics %v", getMetrics(NodeFilter{}, ""))
+	}
+
+	nodes, _ := getNodes(NodeFilter{State: "acknowledged"})
+	if len(nodes) != 1 || nodes[0].Fqdn != "bar.example.com" || nodes[0].State != "acknowledged" {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+	nodes, _ = getNodes(NodeFilter{State: "failed"})
+	if len(nodes) != 0 {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+
+	s, _ := parseSearch("state:acknowledged", time.Now())
+	nodes, _ = findNodes(NodeFilter{}, s)
+	if len(nodes) != 1 {
+		t.Errorf("Unexpected search results %v", nodes)
+	}
+
+	acks, _ := getNodeAcknowledgements("bar.example.com")
+	if len(acks) != 2 || acks[0].Note != "Broken disk" || !acks[0].Active || acks[1].Active {
+		t.Errorf("Unexpected acknowledgements %v", acks)
+	}
+
+	//
+	// A report executed at the given time, by a node whose clock has
+	// the given offset from UTC.
+	//
+	report := func(at time.Time, offset int) PuppetReport {
+		r := PuppetReport{Fqdn: "bar.example.com", State: "changed"}
+		zone := time.FixedZone("", offset*60*60)
+		parseTime(&yamlReport{Time: at.In(zone).Format(time.RFC3339Nano)}, &r)
+		return r
+	}
+
+	//
+	// A report which was delayed doesn't count as recovery, even if
+	// the node's clock is ahead of ours.
+	//
+	addDB(report(time.Now().Add(-time.Hour), 5), "")
+
+	acks, _ = getAcknowledgements()
+	if len(acks) != 3 {
+		t.Errorf("Unexpected acknowledgements %v", acks)
+	}
+
+	//
+	// Once the node recovers it's no longer acknowledged, which is
+	// true even if the node's clock is behind ours.
+	//
+	addDB(report(time.Now(), -8), "")
+
+	acks, _ = getAcknowledgements()
+	if len(acks) != 2 {
+		t.Errorf("Unexpected acknowledgements %v", acks)
+	}
+	if count("changed") != "2" || count("acknowledged") != "0" {
+		t.Errorf("Unexpected metrics %v", getMetrics(NodeFilter{}, ""))
+	}
+}
+
+//
+// Test that importing old reports, via `db repair`, doesn't remove the
+// acknowledgement of a node.
+//
+func TestRepairKeepsAcknowledgements(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	prefix := filepath.Join(path, "reports")
+	addReportFile(t, prefix, filepath.Join("www.steve.org.uk", strings.Repeat("a", 40)))
+
+	now := time.Now().Unix()
+	_, err := addAcknowledgement(Acknowledgement{Kind: "acknowledgement", Fqdn: "www.steve.org.uk", Note: "Rebuilding", Start: now, End: now + 3600})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	check, err := checkDB(prefix)
+	if err != nil {
+		t.Fatalf("Unexpected error checking: %s", err.Error())
+	}
+	imported, _, err := repairDB(prefix, check, false)
+	if err != nil || imported != 1 {
+		t.Fatalf("Unexpected result of repairing %d %v", imported, err)
+	}
+
+	acks, _ := getAcknowledgements()
+	if len(acks) != 1 {
+		t.Errorf("Unexpected acknowledgements %v", acks)
+	}
+}
+
+//
+// Test acknowledging nodes, and scheduling maintenance, via HTTP.
+//
+func TestAcknowledgeHandlers(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/node/{fqdn}", NodeHandler).Methods("GET")
+	router.HandleFunc("/node/{fqdn}/acknowledge", AcknowledgeHandler).Methods("POST")
+	router.HandleFunc("/maintenance", MaintenanceHandler).Methods("GET")
+	router.HandleFunc("/maintenance", AcknowledgeHandler).Methods("POST")
+	router.HandleFunc("/maintenance/{id}", UnacknowledgeHandler).Methods("DELETE")
+	router.HandleFunc("/maintenance/{id}/delete", UnacknowledgeHandler).Methods("POST")
+
+	type TestCase struct {
+		Method   string
+		URL      string
+		Form     url.Values
+		Accept   string
+		Status   int
+		Response string
+	}
+
+	tests := []TestCase{
+		{"POST", "/node/bar.example.com/acknowledge", url.Values{"note": {"Broken disk"}, "end": {"1d"}}, "", http.StatusSeeOther, "/node/bar.example.com"},
+		{"POST", "/node/missing.example.com/acknowledge", url.Values{"note": {"Broken disk"}, "end": {"1d"}}, "", http.StatusNotFound, "unknown"},
+		{"POST", "/node/bar.example.com/acknowledge", url.Values{"end": {"1d"}}, "", http.StatusBadRequest, "missing 'note'"},
+		{"POST", "/node/bar.example.com/acknowledge", url.Values{"note": {"Broken disk"}}, "", http.StatusBadRequest, "missing 'end'"},
+		{"POST", "/maintenance", url.Values{"fqdn": {"foo.*"}, "note": {"Upgrades"}, "start": {"1h"}, "end": {"2h"}}, "application/json", http.StatusCreated, "{\"ID\":2}"},
+		{"POST", "/maintenance", url.Values{"note": {"Upgrades"}, "end": {"2h"}}, "", http.StatusBadRequest, "requires an fqdn"},
+		{"POST", "/maintenance", url.Values{"fqdn": {"foo.*"}, "note": {"Upgrades"}, "end": {"soon"}}, "", http.StatusBadRequest, "invalid time"},
+		{"GET", "/maintenance", nil, "application/json", http.StatusOK, "\"Note\":\"Broken disk\""},
+		{"GET", "/maintenance", nil, "text/html", http.StatusOK, "Upgrades"},
+		{"GET", "/node/bar.example.com", nil, "text/html", http.StatusOK, "Broken disk"},
+		{"GET", "/node/foo.example.com", nil, "text/html", http.StatusOK, "Maintenance</b> of <code>foo.*</code>"},
+		{"DELETE", "/maintenance/2", nil, "", http.StatusNoContent, ""},
+		{"DELETE", "/maintenance/2", nil, "", http.StatusNotFound, "no acknowledgement"},
+		{"DELETE", "/maintenance/two", nil, "", http.StatusBadRequest, "must be numeric"},
+		{"POST", "/maintenance/1/delete", url.Values{"fqdn": {"bar.example.com"}}, "", http.StatusSeeOther, "/node/bar.example.com"},
+		{"GET", "/maintenance", nil, "application/json", http.StatusOK, "[]"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.Method, test.URL, strings.NewReader(test.Form.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.Form != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		req.Header.Set("Accept", test.Accept)
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != test.Status {
+			t.Errorf("Unexpected status-code for %s %s: %v %s", test.Method, test.URL, rr.Code, rr.Body.String())
+		}
+
+		body := rr.Body.String()
+		if test.Status == http.StatusSeeOther {
+			body = rr.Header().Get("Location")
+		}
+		if !strings.Contains(body, test.Response) {
+			t.Errorf("Unexpected response for %s %s: '%s'", test.Method, test.URL, body)
+		}
+	}
+}
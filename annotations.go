@@ -0,0 +1,208 @@
+//
+// Annotations of nodes.
+//
+// Reports tell us what a node is doing, but not who is responsible for
+// it.  Each node may be annotated with the team which owns it, free-text
+// notes, and links to tickets or runbooks.  These are kept until they're
+// edited, even if the reports of the node are pruned.
+//
+// The owner of a node may be used to filter the nodes we show, and the
+// metrics count the states of the nodes owned by each team, so that alerts
+// may be routed to them.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+//
+// NodeAnnotation holds the metadata attached to a single node.
+//
+type NodeAnnotation struct {
+
+	// Owner is the name of the team which owns the node.
+	Owner string `json:",omitempty" xml:",omitempty"`
+
+	// Notes are free-text.
+	Notes string `json:",omitempty" xml:",omitempty"`
+
+	// Links are the URLs of tickets, or documentation.
+	Links []string `json:",omitempty" xml:"Links>Link,omitempty"`
+
+	// Updated is the time at which the annotation was last edited.
+	Updated string `json:",omitempty" xml:",omitempty"`
+}
+
+//
+// PuppetNode is a node, along with its annotation and its recent runs.
+//
+type PuppetNode struct {
+	Fqdn  string
+	State string
+	NodeAnnotation
+	Reports []PuppetReportSummary `xml:"Reports>PuppetReportSummary"`
+}
+
+//
+// The names of owners we accept.
+//
+var ownerRegexp = regexp.MustCompile("^([A-Za-z0-9_.-]+)$")
+
+//
+// The limits upon the size of an annotation.
+//
+var (
+	maxAnnotationNotes = 4096
+	maxAnnotationLinks = 20
+)
+
+//
+// Validate returns an error if the annotation contains anything bogus.
+//
+func (a NodeAnnotation) Validate() error {
+
+	if len(a.Owner) > 0 && !ownerRegexp.MatchString(a.Owner) {
+		return fmt.Errorf("invalid owner '%s'", a.Owner)
+	}
+	if len(a.Notes) > maxAnnotationNotes {
+		return fmt.Errorf("the notes are longer than %d bytes", maxAnnotationNotes)
+	}
+	if len(a.Links) > maxAnnotationLinks {
+		return fmt.Errorf("there are more than %d links", maxAnnotationLinks)
+	}
+
+	//
+	// Links are shown on our pages, so only those which are
+	// obviously links are accepted.
+	//
+	for _, link := range a.Links {
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid link '%s'", link)
+		}
+	}
+	return nil
+}
+
+//
+// IsEmpty returns true if the annotation holds nothing.
+//
+func (a NodeAnnotation) IsEmpty() bool {
+	return a.Owner == "" && a.Notes == "" && len(a.Links) == 0
+}
+
+//
+// parseLinks splits the links given in a form, one per line, ignoring
+// any which are blank.
+//
+func parseLinks(text string) []string {
+	var links []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			links = append(links, line)
+		}
+	}
+	return links
+}
+
+//
+// setAnnotation replaces the annotation of the given node, or removes it
+// if the annotation is empty.
+//
+func setAnnotation(fqdn string, a NodeAnnotation) error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	if !fqdnRegexp.MatchString(fqdn) {
+		return fmt.Errorf("invalid node '%s'", fqdn)
+	}
+	err := a.Validate()
+	if err != nil {
+		return err
+	}
+
+	if a.IsEmpty() {
+		_, err = db.Exec("DELETE FROM node_annotations WHERE fqdn = ?", fqdn)
+		return err
+	}
+
+	_, err = db.Exec(`INSERT INTO node_annotations(fqdn, owner, notes, links, updated_at) VALUES(?,?,?,?,?)
+                          ON CONFLICT(fqdn) DO UPDATE SET
+                            owner      = excluded.owner,
+                            notes      = excluded.notes,
+                            links      = excluded.links,
+                            updated_at = excluded.updated_at`,
+		fqdn, a.Owner, a.Notes, strings.Join(a.Links, "\n"), time.Now().Unix())
+	return err
+}
+
+//
+// getAnnotation returns the annotation of the given node, which is empty
+// if the node has none.
+//
+func getAnnotation(fqdn string) (NodeAnnotation, error) {
+	var a NodeAnnotation
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return a, errors.New("SetupDB not called")
+	}
+
+	rows, err := db.Query("SELECT IFNULL(owner,''), IFNULL(notes,''), IFNULL(links,''), updated_at FROM node_annotations WHERE fqdn = ?", fqdn)
+	if err != nil {
+		return a, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var links string
+		var updated int64
+		err = rows.Scan(&a.Owner, &a.Notes, &links, &updated)
+		if err != nil {
+			return a, err
+		}
+		a.Links = parseLinks(links)
+		a.Updated = time.Unix(updated, 0).Format("2006-01-02 15:04:05")
+	}
+	return a, rows.Err()
+}
+
+//
+// getOwners returns the names of the teams which own nodes, sorted.
+//
+func getOwners() ([]string, error) {
+
+	rows, err := newQuery("SELECT DISTINCT owner FROM node_annotations").
+		Where("IFNULL(owner,'') != ''").
+		Then("ORDER BY owner").Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var owners []string
+	for rows.Next() {
+		var owner string
+		err = rows.Scan(&owner)
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, owner)
+	}
+	return owners, rows.Err()
+}
@@ -0,0 +1,194 @@
+//
+//  Testing of the annotations of nodes.
+//
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+//
+// Test validating annotations.
+//
+func TestAnnotationValidate(t *testing.T) {
+
+	type TestCase struct {
+		Annotation NodeAnnotation
+		Error      string
+	}
+
+	tests := []TestCase{
+		{NodeAnnotation{}, ""},
+		{NodeAnnotation{Owner: "db-team", Notes: "Primary", Links: []string{"https://tickets.example.com/123"}}, ""},
+		{NodeAnnotation{Owner: "db team"}, "invalid owner"},
+		{NodeAnnotation{Notes: strings.Repeat("x", 4097)}, "longer than"},
+		{NodeAnnotation{Links: []string{"javascript:alert(1)"}}, "invalid link"},
+		{NodeAnnotation{Links: []string{"/relative"}}, "invalid link"},
+		{NodeAnnotation{Links: make([]string, 21)}, "more than 20 links"},
+	}
+
+	for _, test := range tests {
+		err := test.Annotation.Validate()
+		if test.Error == "" {
+			if err != nil {
+				t.Errorf("Unexpected error for %v: %s", test.Annotation, err.Error())
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Error) {
+			t.Errorf("Expected an error containing %q for %v, got %v", test.Error, test.Annotation, err)
+		}
+	}
+
+	links := parseLinks(" https://a.example.com \n\n\thttps://b.example.com\n")
+	if len(links) != 2 || links[0] != "https://a.example.com" || links[1] != "https://b.example.com" {
+		t.Errorf("Unexpected links %v", links)
+	}
+}
+
+//
+// Test storing annotations, and filtering nodes by their owner.
+//
+func TestAnnotationOwners(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+
+	err := setAnnotation("bar.example.com", NodeAnnotation{Owner: "database", Notes: "Replica", Links: []string{"https://a.example.com"}})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	err = setAnnotation("foo.example.com", NodeAnnotation{Owner: "web"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	a, err := getAnnotation("bar.example.com")
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if a.Owner != "database" || a.Notes != "Replica" || len(a.Links) != 1 || a.Updated == "" {
+		t.Errorf("Unexpected annotation %v", a)
+	}
+
+	owners, _ := getOwners()
+	if len(owners) != 2 || owners[0] != "database" || owners[1] != "web" {
+		t.Errorf("Unexpected owners %v", owners)
+	}
+
+	nodes, _ := getNodes(NodeFilter{Owner: "database"})
+	if len(nodes) != 1 || nodes[0].Fqdn != "bar.example.com" || nodes[0].Owner != "database" {
+		t.Errorf("Unexpected nodes %v", nodes)
+	}
+
+	s, _ := parseSearch("owner:web", time.Now())
+	nodes, _ = findNodes(NodeFilter{}, s)
+	if len(nodes) != 1 || nodes[0].Fqdn != "foo.example.com" {
+		t.Errorf("Unexpected search results %v", nodes)
+	}
+
+	metrics := getMetrics(NodeFilter{}, "")
+	if metrics["owners.database.failed"] != "1" || metrics["owners.web.changed"] != "1" || metrics["owners.web.failed"] != "0" {
+		t.Errorf("Unexpected metrics %v", metrics)
+	}
+
+	//
+	// An empty annotation removes the owner.
+	//
+	err = setAnnotation("foo.example.com", NodeAnnotation{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	owners, _ = getOwners()
+	if len(owners) != 1 {
+		t.Errorf("Unexpected owners %v", owners)
+	}
+
+	err = NodeFilter{Owner: "data'base"}.Validate()
+	if err == nil {
+		t.Errorf("Expected an error filtering by a bogus owner")
+	}
+}
+
+//
+// Test editing annotations via HTTP.
+//
+func TestAnnotationHandler(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/", IndexHandler).Methods("GET")
+	router.HandleFunc("/node/{fqdn}", NodeHandler).Methods("GET")
+	router.HandleFunc("/node/{fqdn}/annotations", AnnotationHandler).Methods("POST")
+	router.HandleFunc("/api/v1/annotations/{fqdn}", AnnotationHandler).Methods("GET", "PUT", "DELETE")
+
+	type TestCase struct {
+		Method   string
+		URL      string
+		Body     string
+		Type     string
+		Accept   string
+		Status   int
+		Response string
+	}
+
+	form := url.Values{"owner": {"database"}, "notes": {"Replica"}, "links": {"https://a.example.com\nhttps://b.example.com"}}
+
+	tests := []TestCase{
+		{"POST", "/node/bar.example.com/annotations", form.Encode(), "application/x-www-form-urlencoded", "", http.StatusSeeOther, "/node/bar.example.com"},
+		{"POST", "/node/missing.example.com/annotations", form.Encode(), "application/x-www-form-urlencoded", "", http.StatusNotFound, "unknown"},
+		{"POST", "/node/bar.example.com/annotations", "owner=data+base", "application/x-www-form-urlencoded", "", http.StatusBadRequest, "invalid owner"},
+		{"GET", "/api/v1/annotations/bar.example.com", "", "", "", http.StatusOK, `"Links":["https://a.example.com","https://b.example.com"]`},
+		{"GET", "/node/bar.example.com", "", "", "application/json", http.StatusOK, `"Owner":"database"`},
+		{"GET", "/node/bar.example.com", "", "", "application/xml", http.StatusOK, "<Link>https://b.example.com</Link>"},
+		{"GET", "/node/bar.example.com", "", "", "text/html", http.StatusOK, "Replica"},
+		{"GET", "/?owner=database", "", "", "text/html", http.StatusOK, "owned by: database"},
+		{"PUT", "/api/v1/annotations/foo.example.com", `{"Owner":"web","Notes":"Frontend"}`, "application/json", "", http.StatusNoContent, ""},
+		{"PUT", "/api/v1/annotations/foo.example.com", `{"Links":["ftp://a.example.com"]}`, "application/json", "", http.StatusBadRequest, "invalid link"},
+		{"PUT", "/api/v1/annotations/foo.example.com", `{"Owner":`, "application/json", "", http.StatusBadRequest, "invalid annotation"},
+		{"GET", "/api/v1/annotations/foo.example.com", "", "", "", http.StatusOK, `"Notes":"Frontend"`},
+		{"DELETE", "/api/v1/annotations/foo.example.com", "", "", "", http.StatusNoContent, ""},
+		{"GET", "/api/v1/annotations/foo.example.com", "", "", "", http.StatusOK, "{}"},
+		{"GET", "/api/v1/annotations/foo'", "", "", "", http.StatusBadRequest, "invalid node"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.Method, test.URL, strings.NewReader(test.Body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.Type != "" {
+			req.Header.Set("Content-Type", test.Type)
+		}
+		req.Header.Set("Accept", test.Accept)
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != test.Status {
+			t.Errorf("Unexpected status-code for %s %s: %v %s", test.Method, test.URL, rr.Code, rr.Body.String())
+		}
+
+		body := rr.Body.String()
+		if test.Status == http.StatusSeeOther {
+			body = rr.Header().Get("Location")
+		}
+		if !strings.Contains(body, test.Response) {
+			t.Errorf("Unexpected response for %s %s: '%s'", test.Method, test.URL, body)
+		}
+	}
+}
@@ -0,0 +1,564 @@
+//
+// Backing up our database, and the reports stored alongside it, into a
+// single archive, and restoring them again.
+//
+// Each archive is a gzipped tarball holding a copy of the database, the
+// report files which that copy refers to, and a manifest listing each of
+// them along with its checksum.  An incremental archive omits the reports
+// which were present, unchanged, in the archive it is based upon, and its
+// manifest names the archive which holds each of them instead.
+//
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+)
+
+//
+// The names of the database, the directory of reports, and the manifest
+// within each archive.
+//
+const (
+	backupDatabase = "database"
+	backupReports  = "reports"
+	backupManifest = "manifest.json"
+)
+
+//
+// backupFile describes a single file within a backup.
+//
+type backupFile struct {
+	// The path of the file within the archive.
+	Path string
+
+	// The size, and modification time, of the file.
+	Size    int64
+	ModTime int64
+
+	// The SHA256-hash of the file's content.
+	SHA256 string
+
+	// The name of the archive which holds the file.
+	Archive string
+}
+
+//
+// backupIndex is the manifest stored within each archive.
+//
+type backupIndex struct {
+	Version int
+	Created time.Time
+
+	// The name of the archive this one is based upon, if incremental.
+	Base string `json:",omitempty"`
+
+	Database backupFile
+	Reports  []backupFile
+}
+
+//
+// Copy our database to the given path, using SQLite's online backup,
+// such that we get a consistent copy while reports are being submitted.
+//
+func snapshotDB(dest string) error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	snapshot, err := sql.Open("sqlite3", "file:"+dest)
+	if err != nil {
+		return err
+	}
+	defer snapshot.Close()
+
+	ctx := context.Background()
+	destConn, err := snapshot.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer destConn.Close()
+
+	srcConn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	return destConn.Raw(func(d interface{}) error {
+		return srcConn.Raw(func(s interface{}) error {
+			backup, err := d.(*sqlite3.SQLiteConn).Backup("main", s.(*sqlite3.SQLiteConn), "main")
+			if err != nil {
+				return err
+			}
+			_, err = backup.Step(-1)
+			if err != nil {
+				backup.Finish()
+				return err
+			}
+			return backup.Finish()
+		})
+	})
+}
+
+//
+// Get the report files the database at the given path refers to.
+//
+func snapshotReports(dbPath string) ([]string, error) {
+
+	snapshot, err := sql.Open("sqlite3", "file:"+dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Close()
+
+	rows, err := snapshot.Query("SELECT yaml_file FROM reports WHERE yaml_file IS NOT NULL AND yaml_file NOT IN ('', 'pruned') ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []string
+	for rows.Next() {
+		var file string
+		err = rows.Scan(&file)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, rows.Err()
+}
+
+//
+// Is the given path, taken from our database or a manifest, beneath the
+// directory it is relative to?
+//
+func isRelative(rel string) bool {
+	clean := filepath.ToSlash(filepath.Clean(filepath.FromSlash(rel)))
+	return clean != "." && clean != ".." && !strings.HasPrefix(clean, "/") && !strings.HasPrefix(clean, "../")
+}
+
+//
+// Add the file at the given path to our archive, returning its details.
+//
+func addBackupFile(tw *tar.Writer, name string, src string, info os.FileInfo) (backupFile, error) {
+
+	entry := backupFile{Path: name, Size: info.Size(), ModTime: info.ModTime().Unix()}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return entry, err
+	}
+	defer in.Close()
+
+	err = tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	hash := sha256.New()
+	_, err = io.CopyN(tw, io.TeeReader(in, hash), info.Size())
+	if err != nil {
+		return entry, err
+	}
+	entry.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return entry, nil
+}
+
+//
+// Write a backup of our database, and the reports beneath the given
+// prefix, to `w`.  The archive is named `name`.
+//
+// If `base` is given the reports which it recorded, and which haven't
+// changed since, are not included.
+//
+func writeBackup(w io.Writer, name string, prefix string, base *backupIndex, verbose bool) (*backupIndex, error) {
+
+	index := &backupIndex{Version: 1, Created: time.Now()}
+
+	//
+	// Take a copy of the database, and use that to find the reports
+	// to include, such that the two agree.
+	//
+	tmp, err := ioutil.TempDir("", "puppet-summary-backup")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	dbPath := filepath.Join(tmp, "ps.db")
+	err = snapshotDB(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := snapshotReports(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// The reports we can omit.
+	//
+	previous := make(map[string]backupFile)
+	if base != nil {
+		index.Base = base.Database.Archive
+		for _, f := range base.Reports {
+			previous[f.Path] = f
+		}
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	index.Database, err = addBackupFile(tw, backupDatabase, dbPath, info)
+	if err != nil {
+		return nil, err
+	}
+	index.Database.Archive = name
+
+	for _, file := range files {
+		if !isRelative(file) {
+			fmt.Printf("Warning - skipping report outside %s: %q\n", prefix, file)
+			continue
+		}
+
+		//
+		// The report might have been removed since we copied
+		// the database, in which case it'll be marked as missing
+		// when it is restored.
+		//
+		src := filepath.Join(prefix, file)
+		info, err := os.Stat(src)
+		if os.IsNotExist(err) {
+			if verbose {
+				fmt.Printf("Skipping missing file %q\n", src)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entryName := backupReports + "/" + filepath.ToSlash(filepath.Clean(file))
+
+		if prev, ok := previous[entryName]; ok && prev.Size == info.Size() && prev.ModTime == info.ModTime().Unix() {
+			index.Reports = append(index.Reports, prev)
+			continue
+		}
+
+		entry, err := addBackupFile(tw, entryName, src, info)
+		if err != nil {
+			return nil, err
+		}
+		entry.Archive = name
+		index.Reports = append(index.Reports, entry)
+		if verbose {
+			fmt.Printf("Added %q\n", src)
+		}
+	}
+
+	//
+	// The manifest comes last, once we know the checksums.
+	//
+	manifest, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	err = tw.WriteHeader(&tar.Header{
+		Name:    backupManifest,
+		Mode:    0644,
+		Size:    int64(len(manifest)),
+		ModTime: index.Created,
+	})
+	if err == nil {
+		_, err = tw.Write(manifest)
+	}
+	if err == nil {
+		err = tw.Close()
+	}
+	if err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+//
+// Iterate over the entries of the archive at the given path.
+//
+func walkArchive(archive string, fn func(hdr *tar.Header, r io.Reader) error) error {
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = fn(hdr, tr)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+//
+// Read the manifest of the archive at the given path.
+//
+func readBackupIndex(archive string) (*backupIndex, error) {
+
+	var index *backupIndex
+	err := walkArchive(archive, func(hdr *tar.Header, r io.Reader) error {
+		if hdr.Name != backupManifest {
+			return nil
+		}
+		index = &backupIndex{}
+		return json.NewDecoder(r).Decode(index)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return nil, fmt.Errorf("%s has no manifest, is it a backup?", archive)
+	}
+	return index, nil
+}
+
+//
+// Extract the files of the backup at the given path to `dest`, verifying
+// each of them against its manifest.
+//
+// The archives which an incremental backup is based upon are expected to
+// be found alongside it.
+//
+func extractBackup(archive string, dest string) (*backupIndex, error) {
+
+	index, err := readBackupIndex(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// The files we expect to find within each archive.
+	//
+	wanted := make(map[string]map[string]backupFile)
+	for _, f := range append([]backupFile{index.Database}, index.Reports...) {
+		clean := filepath.ToSlash(filepath.Clean(filepath.FromSlash(f.Path)))
+		if f.Path != backupDatabase && !(isRelative(f.Path) && clean == f.Path && strings.HasPrefix(f.Path, backupReports+"/")) {
+			return nil, fmt.Errorf("the manifest contains an invalid path %q", f.Path)
+		}
+		if wanted[f.Archive] == nil {
+			wanted[f.Archive] = make(map[string]backupFile)
+		}
+		wanted[f.Archive][f.Path] = f
+	}
+
+	name := filepath.Base(archive)
+	dir := filepath.Dir(archive)
+
+	for source, files := range wanted {
+		src := archive
+		if source != name {
+			src = filepath.Join(dir, source)
+		}
+
+		err = walkArchive(src, func(hdr *tar.Header, r io.Reader) error {
+			f, ok := files[hdr.Name]
+			if !ok {
+				return nil
+			}
+
+			target := filepath.Join(dest, filepath.FromSlash(hdr.Name))
+			err := os.MkdirAll(filepath.Dir(target), 0755)
+			if err != nil {
+				return err
+			}
+			out, err := os.Create(target)
+			if err != nil {
+				return err
+			}
+
+			hash := sha256.New()
+			n, err := io.Copy(io.MultiWriter(out, hash), r)
+			if err == nil {
+				err = out.Close()
+			} else {
+				out.Close()
+			}
+			if err != nil {
+				return err
+			}
+
+			if n != f.Size || hex.EncodeToString(hash.Sum(nil)) != f.SHA256 {
+				return fmt.Errorf("the checksum of %s within %s is incorrect", hdr.Name, source)
+			}
+			mtime := time.Unix(f.ModTime, 0)
+			os.Chtimes(target, mtime, mtime)
+
+			delete(files, hdr.Name)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for missing := range files {
+			return nil, fmt.Errorf("%s is missing from %s", missing, source)
+		}
+	}
+
+	//
+	// Finally ensure the database we extracted is intact.
+	//
+	snapshot, err := sql.Open("sqlite3", "file:"+filepath.Join(dest, backupDatabase))
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Close()
+
+	var result string
+	err = snapshot.QueryRow("PRAGMA integrity_check").Scan(&result)
+	if err != nil {
+		return nil, err
+	}
+	if result != "ok" {
+		return nil, fmt.Errorf("the database within %s is corrupt: %s", archive, result)
+	}
+	return index, nil
+}
+
+//
+// Restore the backup at the given path to our database, and prefix.
+//
+// Neither may exist, unless `force` is set, in which case they are
+// replaced.  When `noop` is set the backup is verified, but nothing
+// is restored.
+//
+func restoreBackup(archive string, dbFile string, prefix string, force bool, noop bool) (*backupIndex, error) {
+
+	if !noop && !force {
+		if Exists(dbFile) {
+			return nil, fmt.Errorf("the database %s already exists", dbFile)
+		}
+		if entries, _ := ioutil.ReadDir(prefix); len(entries) > 0 {
+			return nil, fmt.Errorf("the directory %s is not empty", prefix)
+		}
+	}
+
+	//
+	// Extract the reports alongside our prefix, such that they
+	// may be moved into place.
+	//
+	parent := filepath.Dir(filepath.Clean(prefix))
+	err := os.MkdirAll(parent, 0755)
+	if err != nil {
+		return nil, err
+	}
+	staging, err := ioutil.TempDir(parent, ".restore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	index, err := extractBackup(archive, staging)
+	if err != nil || noop {
+		return index, err
+	}
+
+	//
+	// Move the database into place, beside the original so that
+	// the rename can't fail half-way, and discard the write-ahead
+	// log of the original.
+	//
+	tmp := dbFile + ".restore"
+	err = copyFile(filepath.Join(staging, backupDatabase), tmp)
+	if err != nil {
+		return nil, err
+	}
+	for _, suffix := range []string{"-wal", "-shm"} {
+		os.Remove(dbFile + suffix)
+	}
+	err = os.Rename(tmp, dbFile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.RemoveAll(prefix)
+	if err != nil {
+		return nil, err
+	}
+	reports := filepath.Join(staging, backupReports)
+	if !Exists(reports) {
+		return index, os.MkdirAll(prefix, 0755)
+	}
+	return index, os.Rename(reports, prefix)
+}
+
+//
+// Copy the file at `src` to `dest`.
+//
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		os.Remove(dest)
+		return err
+	}
+	return out.Close()
+}
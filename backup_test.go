@@ -0,0 +1,130 @@
+//
+//  Testing of our backups, and their restoration.
+//
+
+package main
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//
+// Test a full, and then an incremental, backup and their restoration.
+//
+func TestBackupAndRestore(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	prefix := filepath.Join(path, "reports")
+	first := filepath.Join("foo.example.com", strings.Repeat("a", 40))
+	second := filepath.Join("bar.example.com", strings.Repeat("b", 40))
+
+	addReportFile(t, prefix, first)
+	db.Exec("INSERT INTO reports(fqdn, environment, yaml_file, executed_at) VALUES('foo.example.com', 'production', ?, 100)", first)
+	db.Exec("INSERT INTO reports(fqdn, environment, yaml_file, executed_at) VALUES('foo.example.com', 'production', 'pruned', 50)")
+
+	full := filepath.Join(path, "full.tar.gz")
+	index, err := runBackup(backupCmd{output: full, prefix: prefix})
+	if err != nil {
+		t.Fatalf("Unexpected error backing up: %s", err.Error())
+	}
+	if len(index.Reports) != 1 || index.Base != "" {
+		t.Errorf("Unexpected full backup: %v", index)
+	}
+
+	//
+	// Add a second report, which is all the incremental backup
+	// should contain.
+	//
+	addReportFile(t, prefix, second)
+	db.Exec("INSERT INTO reports(fqdn, environment, yaml_file, executed_at) VALUES('bar.example.com', 'test', ?, 200)", second)
+
+	incr := filepath.Join(path, "incr.tar.gz")
+	index, err = runBackup(backupCmd{output: incr, prefix: prefix, incremental: full})
+	if err != nil {
+		t.Fatalf("Unexpected error backing up: %s", err.Error())
+	}
+	if index.Base != "full.tar.gz" || len(index.Reports) != 2 {
+		t.Fatalf("Unexpected incremental backup: %v", index)
+	}
+	for _, r := range index.Reports {
+		want := "incr.tar.gz"
+		if strings.Contains(r.Path, "foo.example.com") {
+			want = "full.tar.gz"
+		}
+		if r.Archive != want {
+			t.Errorf("%s is held by %s, not %s", r.Path, r.Archive, want)
+		}
+	}
+
+	//
+	// We refuse to replace the existing database.
+	//
+	_, err = restoreBackup(incr, filepath.Join(path, "db.sql"), prefix, false, false)
+	if err == nil {
+		t.Errorf("Expected an error restoring over our database")
+	}
+
+	//
+	// Restore elsewhere.
+	//
+	dbFile := filepath.Join(path, "restored", "ps.db")
+	restored := filepath.Join(path, "restored", "reports")
+	_, err = restoreBackup(incr, dbFile, restored, false, false)
+	if err != nil {
+		t.Fatalf("Unexpected error restoring: %s", err.Error())
+	}
+	for _, rel := range []string{first, second} {
+		if !Exists(filepath.Join(restored, rel)) {
+			t.Errorf("%s wasn't restored", rel)
+		}
+	}
+
+	copy, err := sql.Open("sqlite3", "file:"+dbFile)
+	if err != nil {
+		t.Fatalf("Failed to open the restored database: %s", err.Error())
+	}
+	defer copy.Close()
+
+	var count int
+	copy.QueryRow("SELECT COUNT(*) FROM reports").Scan(&count)
+	if count != 3 {
+		t.Errorf("Restored %d reports, not 3", count)
+	}
+
+	//
+	// The incremental backup can't be restored without the backup
+	// it is based upon.
+	//
+	os.Remove(full)
+	_, err = restoreBackup(incr, dbFile, restored, true, true)
+	if err == nil {
+		t.Errorf("Expected an error without the full backup")
+	}
+}
+
+//
+// Test that paths outside our prefix are rejected.
+//
+func TestIsRelative(t *testing.T) {
+
+	tests := map[string]bool{
+		"foo.example.com/abc": true,
+		"a/../b":              true,
+		"":                    false,
+		"/etc/passwd":         false,
+		"../etc/passwd":       false,
+		"a/../../etc":         false,
+	}
+
+	for input, expected := range tests {
+		if isRelative(input) != expected {
+			t.Errorf("isRelative(%q) != %v", input, expected)
+		}
+	}
+}
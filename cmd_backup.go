@@ -0,0 +1,138 @@
+//
+// Backup our database, and the reports stored alongside it.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/subcommands"
+)
+
+//
+// The options set by our command-line flags.
+//
+type backupCmd struct {
+	dbFile      string
+	incremental string
+	output      string
+	prefix      string
+	verbose     bool
+}
+
+//
+// Run a backup
+//
+func runBackup(x backupCmd) (*backupIndex, error) {
+
+	//
+	// The backup an incremental backup is based upon.
+	//
+	var base *backupIndex
+	if x.incremental != "" {
+		var err error
+		base, err = readBackupIndex(x.incremental)
+		if err != nil {
+			return nil, err
+		}
+		if filepath.Dir(x.incremental) != filepath.Dir(x.output) {
+			fmt.Printf("Warning - %s must be moved alongside %s before it can be restored\n", x.incremental, x.output)
+		}
+	}
+
+	//
+	// Write the archive under a temporary name, so that an interrupted
+	// backup is never mistaken for a complete one.
+	//
+	tmp := x.output + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := writeBackup(f, filepath.Base(x.output), x.prefix, base, x.verbose)
+	if err == nil {
+		err = f.Close()
+	} else {
+		f.Close()
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	return index, os.Rename(tmp, x.output)
+}
+
+//
+// Glue
+//
+func (*backupCmd) Name() string     { return "backup" }
+func (*backupCmd) Synopsis() string { return "Backup the database and reports." }
+func (*backupCmd) Usage() string {
+	return `backup [options]:
+  Write a consistent copy of the database, and the reports it refers to,
+  to a single archive.  This is safe to run while the server is running.
+`
+}
+
+//
+// Flag setup
+//
+func (p *backupCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.verbose, "verbose", false, "Be verbose in reporting output")
+	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to use.")
+	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
+	f.StringVar(&p.output, "output", "", "The archive to write, by default named after the current time.")
+	f.StringVar(&p.incremental, "incremental", "", "Omit the reports held by this earlier backup.")
+}
+
+//
+// Entry-point.
+//
+func (p *backupCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.output == "" {
+		p.output = "puppet-summary-" + time.Now().Format("20060102-150405") + ".tar.gz"
+	}
+
+	//
+	// Setup the database, by opening a handle, and creating it if
+	// missing.
+	//
+	err := SetupDB(p.dbFile)
+	if err != nil {
+		fmt.Printf("Error opening database: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	index, err := runBackup(*p)
+	if err != nil {
+		fmt.Printf("Error writing backup: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.verbose {
+		added := 0
+		for _, r := range index.Reports {
+			if r.Archive == index.Database.Archive {
+				added++
+			}
+		}
+		fmt.Printf("Wrote %s, holding the database and %d of %d reports\n", p.output, added, len(index.Reports))
+	}
+	return subcommands.ExitSuccess
+}
@@ -0,0 +1,122 @@
+//
+// Show the configuration our sub-commands will use.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/subcommands"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type configCmd struct {
+	sources bool
+}
+
+//
+// Glue
+//
+func (*configCmd) Name() string     { return "config" }
+func (*configCmd) Synopsis() string { return "Show the effective configuration." }
+func (*configCmd) Usage() string {
+	return `config [options] [section..]:
+  Show the settings each sub-command will use, after merging their defaults
+  with the configuration file, and the environment.
+
+  The configuration file may be given via the '-config' flag, before the
+  name of the sub-command, or via $PUPPET_SUMMARY_CONFIG.
+`
+}
+
+//
+// Flag setup
+//
+func (p *configCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.sources, "sources", false, "Show where each setting came from.")
+}
+
+//
+// showConfig writes the effective configuration of the given sections,
+// or of every section if none are given, as YAML.
+//
+func showConfig(w io.Writer, c *configuration, sources bool, sections ...string) error {
+
+	if c == nil {
+		return fmt.Errorf("no configuration loaded")
+	}
+
+	if len(sections) == 0 {
+		sections = strings.Split(c.sectionNames(), ", ")
+	}
+
+	if c.path != "" {
+		fmt.Fprintf(w, "# Read from %s\n", c.path)
+	}
+
+	for _, section := range sections {
+		fs, ok := c.flags[section]
+		if !ok {
+			return fmt.Errorf("unknown section '%s', valid sections are: %s", section, c.sectionNames())
+		}
+
+		fmt.Fprintf(w, "%s:\n", section)
+
+		var err error
+		fs.VisitAll(func(f *flag.Flag) {
+			if err != nil {
+				return
+			}
+
+			//
+			// Use the typed value where possible, so that strings
+			// are quoted only when they need to be.
+			//
+			var value interface{} = f.Value.String()
+			if getter, ok := f.Value.(flag.Getter); ok {
+				switch v := getter.Get().(type) {
+				case bool, int, int64, uint, uint64, float64, string:
+					value = v
+				}
+			}
+
+			var out []byte
+			out, err = yaml.Marshal(value)
+			if err != nil {
+				return
+			}
+			line := fmt.Sprintf("  %s: %s", f.Name, strings.TrimSpace(string(out)))
+
+			if sources {
+				source := c.Source(section, f.Name)
+				if source == "" {
+					source = "default"
+				}
+				line += "  # " + source
+			}
+			fmt.Fprintln(w, line)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// Entry-point.
+//
+func (p *configCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	err := showConfig(out, config, p.sources, f.Args()...)
+	if err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+	return subcommands.ExitSuccess
+}
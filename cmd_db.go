@@ -0,0 +1,180 @@
+//
+// Check, repair, and compact our database.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/google/subcommands"
+)
+
+//
+// The options set by our command-line flags.
+//
+type dbCmd struct {
+	dbFile  string
+	prefix  string
+	verbose bool
+}
+
+//
+// Show the problems found by checkDB.
+//
+func reportCheck(w io.Writer, check dbCheck) {
+	for _, msg := range check.Integrity {
+		fmt.Fprintf(w, "Integrity: %s\n", msg)
+	}
+	for _, file := range check.Missing {
+		fmt.Fprintf(w, "Missing file: %q\n", file)
+	}
+	for _, file := range check.Unindexed {
+		fmt.Fprintf(w, "Unindexed file: %q\n", file)
+	}
+	for _, file := range check.Unexpected {
+		fmt.Fprintf(w, "Unexpected file: %q\n", file)
+	}
+	for _, file := range check.Stale {
+		fmt.Fprintf(w, "Stale upload: %q\n", file)
+	}
+	fmt.Fprintf(w, "Found %d integrity problems, %d missing files, %d unindexed files, %d unexpected files, and %d stale uploads\n",
+		len(check.Integrity), len(check.Missing), len(check.Unindexed), len(check.Unexpected), len(check.Stale))
+}
+
+//
+// Show the sizes found by getDBStats.
+//
+func reportStats(w io.Writer, stats dbStats) {
+	for _, table := range dbTables {
+		fmt.Fprintf(w, "%-20s %d rows\n", table, stats.Tables[table])
+	}
+	fmt.Fprintf(w, "\n")
+	for _, e := range stats.Environments {
+		fmt.Fprintf(w, "%-20s %d reports, %d stored, %.1f MB\n", e.Environment, e.Reports, e.Bodies, float64(e.Bytes)/(1024*1024))
+	}
+	fmt.Fprintf(w, "\n")
+	fmt.Fprintf(w, "Database: %.1f MB, write-ahead log: %.1f MB\n",
+		float64(stats.DBSize)/(1024*1024), float64(stats.WALSize)/(1024*1024))
+}
+
+//
+// Run the given maintenance action.
+//
+func runDB(x dbCmd, action string) error {
+
+	switch action {
+	case "check":
+		check, err := checkDB(x.prefix)
+		if err != nil {
+			return err
+		}
+		reportCheck(out, check)
+		if !check.OK() {
+			return fmt.Errorf("the database, or the reports beneath %s, are inconsistent", x.prefix)
+		}
+		return nil
+
+	case "repair":
+		check, err := checkDB(x.prefix)
+		if err != nil {
+			return err
+		}
+		if len(check.Integrity) > 0 {
+			reportCheck(out, check)
+			return fmt.Errorf("the database is corrupt, and must be restored")
+		}
+		imported, missing, err := repairDB(x.prefix, check, x.verbose)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Imported %d reports, marked %d as missing, and removed %d stale uploads\n", imported, missing, len(check.Stale))
+		for _, file := range check.Unexpected {
+			fmt.Fprintf(out, "Warning - unexpected file: %q\n", file)
+		}
+		return nil
+
+	case "vacuum":
+		if x.verbose {
+			fmt.Fprintf(out, "Vacuuming %s\n", x.dbFile)
+		}
+		return vacuumDB()
+
+	case "stats":
+		stats, err := getDBStats(x.dbFile, x.prefix)
+		if err != nil {
+			return err
+		}
+		reportStats(out, stats)
+		return nil
+	}
+
+	return fmt.Errorf("unknown action %q, expected check, repair, vacuum, or stats", action)
+}
+
+//
+// Glue
+//
+func (*dbCmd) Name() string     { return "db" }
+func (*dbCmd) Synopsis() string { return "Check, repair, or compact the database." }
+func (*dbCmd) Usage() string {
+	return `db [options] check|repair|vacuum|stats:
+  check   Test the integrity of the database, and that it agrees with the
+          reports stored on-disk.
+  repair  Import the reports which are missing from the database, mark
+          those whose file is missing as expired, and remove the temporary
+          files left by interrupted uploads.
+  vacuum  Reclaim the space left unused by pruning.
+  stats   Show the number of rows in each table, and the size of the reports
+          of each environment.
+`
+}
+
+//
+// Flag setup
+//
+func (p *dbCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.verbose, "verbose", false, "Be verbose in reporting output")
+	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to use.")
+	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
+}
+
+//
+// Entry-point.
+//
+func (p *dbCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if f.NArg() != 1 {
+		fmt.Printf("Please specify one of check, repair, vacuum, or stats\n")
+		return subcommands.ExitUsageError
+	}
+
+	//
+	// Setup the database, by opening a handle, and creating it if
+	// missing.
+	//
+	err := SetupDB(p.dbFile)
+	if err != nil {
+		fmt.Printf("Error opening database: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+	defer CloseDB()
+
+	err = runDB(*p, f.Arg(0))
+	if err != nil {
+		fmt.Printf("Error: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+	return subcommands.ExitSuccess
+}
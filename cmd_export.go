@@ -0,0 +1,144 @@
+//
+// Export reports, resources, and logged messages.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/subcommands"
+)
+
+//
+// The options set by our command-line flags.
+//
+type exportCmd struct {
+	dataset     string
+	dbFile      string
+	environment string
+	format      string
+	fqdn        string
+	from        string
+	output      string
+	prefix      string
+	state       string
+	to          string
+	verbose     bool
+}
+
+//
+// Convert our flags into the options of an export.
+//
+func (p *exportCmd) options() (exportOptions, error) {
+
+	opts := exportOptions{
+		Dataset: p.dataset,
+		Format:  p.format,
+		Prefix:  p.prefix,
+	}
+	opts.Filter.Environment = p.environment
+	opts.Filter.State = p.state
+	opts.Filter.Fqdn = p.fqdn
+
+	var err error
+	if p.from != "" {
+		opts.Filter.From, err = parseRangeTime(p.from)
+		if err != nil {
+			return opts, err
+		}
+	}
+	if p.to != "" {
+		opts.Filter.To, err = parseRangeTime(p.to)
+		if err != nil {
+			return opts, err
+		}
+	}
+	return opts, opts.Validate()
+}
+
+//
+// Glue
+//
+func (*exportCmd) Name() string     { return "export" }
+func (*exportCmd) Synopsis() string { return "Export reports, resources, or logs." }
+func (*exportCmd) Usage() string {
+	return `export [options]:
+  Write the summary of each report, the slowest resources recorded for each
+  report, or the messages each report logged, as CSV, newline-delimited JSON,
+  or Parquet.
+`
+}
+
+//
+// Flag setup
+//
+func (p *exportCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.verbose, "verbose", false, "Be verbose in reporting output")
+	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to use.")
+	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
+	f.StringVar(&p.dataset, "dataset", "reports", "The data to export: reports, resources, or logs.")
+	f.StringVar(&p.format, "format", "csv", "The format to export: csv, ndjson, or parquet.")
+	f.StringVar(&p.output, "output", "", "The file to write, rather than STDOUT.")
+	f.StringVar(&p.from, "from", "", "Only export reports submitted at, or after, this time.")
+	f.StringVar(&p.to, "to", "", "Only export reports submitted before this time.")
+	f.StringVar(&p.environment, "environment", "", "Only export reports from this environment.")
+	f.StringVar(&p.state, "state", "", "Only export reports in this state.")
+	f.StringVar(&p.fqdn, "fqdn", "", "Only export reports from the nodes matching this pattern.")
+}
+
+//
+// Entry-point.
+//
+func (p *exportCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	opts, err := p.options()
+	if err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitUsageError
+	}
+
+	//
+	// Setup the database, by opening a handle, and creating it if
+	// missing.
+	//
+	err = SetupDB(p.dbFile)
+	if err != nil {
+		fmt.Printf("Error opening database: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	var w io.Writer = out
+	if p.output != "" {
+		file, err := os.Create(p.output)
+		if err != nil {
+			fmt.Printf("Error creating %s: %s\n", p.output, err.Error())
+			return subcommands.ExitFailure
+		}
+		defer file.Close()
+		w = file
+	}
+
+	count, err := runExport(w, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.verbose {
+		fmt.Fprintf(os.Stderr, "Exported %d rows\n", count)
+	}
+	return subcommands.ExitSuccess
+}
@@ -9,25 +9,48 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/google/subcommands"
 	graphite "github.com/marpaia/graphite-golang"
 )
 
+//
+// metricName replaces the characters which can't be used within the name
+// of a metric, such as the periods within the name of a fact.
+//
+func metricName(name string) string {
+	if name == "" {
+		return "unknown"
+	}
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
 //
 // Get all the metrics
 //
-func getMetrics() map[string]string {
+// The states of the nodes may be limited by the given filter, and are
+// also counted within each of our node groups, for the nodes owned by each
+// team, and for each value of the given fact.
+//
+func getMetrics(filter NodeFilter, group string) map[string]string {
 
 	// A map to store the names & values which should be sent.
 	metrics := make(map[string]string)
 
 	// Get the node-states.
-	data, err := getStates("")
+	NodeList, err := getNodes(filter)
 	if err != nil {
 		fmt.Printf("Error getting node states: %s\n", err.Error())
 		os.Exit(1)
 	}
+	data := countStates(NodeList)
 
 	// Now record the metrics we would send.
 	for i := range data {
@@ -40,6 +63,103 @@ func getMetrics() map[string]string {
 		metrics[metric] = value
 	}
 
+	//
+	// Count the states within each of our node groups, for example
+	// `groups.web.failed`.
+	//
+	for _, name := range currentSettings().Groups.Names() {
+		group := filter
+		group.Group = name
+
+		states, err := getStates(group)
+		if err != nil {
+			fmt.Printf("Error getting node states: %s\n", err.Error())
+			os.Exit(1)
+		}
+		for _, state := range states {
+			metrics[fmt.Sprintf("groups.%s.%s", name, state.State)] = fmt.Sprintf("%d", state.Count)
+		}
+	}
+
+	//
+	// Count the states of the nodes owned by each team, for example
+	// `owners.database.failed`, so that alerts may be routed to them.
+	//
+	owners, err := getOwners()
+	if err != nil {
+		fmt.Printf("Error getting owners: %s\n", err.Error())
+		os.Exit(1)
+	}
+	for _, owner := range owners {
+		owned := filter
+		owned.Owner = owner
+
+		states, err := getStates(owned)
+		if err != nil {
+			fmt.Printf("Error getting node states: %s\n", err.Error())
+			os.Exit(1)
+		}
+		for _, state := range states {
+			metrics[fmt.Sprintf("owners.%s.%s", metricName(owner), state.State)] = fmt.Sprintf("%d", state.Count)
+		}
+	}
+
+	//
+	// Count the states within each value of the fact, for example
+	// `facts.role.web.failed`.
+	//
+	if len(group) > 0 {
+		err = setNodeGroups(NodeList, group)
+		if err != nil {
+			fmt.Printf("Error getting facts: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		for _, g := range groupNodes(NodeList) {
+			prefix := fmt.Sprintf("facts.%s.%s.", metricName(group), metricName(g.Value))
+			for _, state := range g.States {
+				metrics[prefix+state.State] = fmt.Sprintf("%d", state.Count)
+			}
+		}
+	}
+
+	//
+	// Get the average time taken by each stage of the runs,
+	// per-environment, over the past hour.
+	//
+	since := time.Now().Add(-time.Hour).Unix()
+	latency, err := getLatency("", since, false)
+	if err != nil {
+		fmt.Printf("Error getting latency: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	for _, l := range latency {
+		prefix := fmt.Sprintf("latency.%s.", l.Environment)
+
+		metrics[prefix+"runtime"] = fmt.Sprintf("%f", l.Runtime)
+		metrics[prefix+"config_retrieval"] = fmt.Sprintf("%f", l.ConfigRetrieval)
+		metrics[prefix+"fact_generation"] = fmt.Sprintf("%f", l.FactGeneration)
+		metrics[prefix+"plugin_sync"] = fmt.Sprintf("%f", l.PluginSync)
+		metrics[prefix+"transaction_evaluation"] = fmt.Sprintf("%f", l.TransactionEvaluation)
+	}
+
+	//
+	// Report upon the last application of our retention policy, if any.
+	//
+	runs, err := getPruneHistory(1)
+	if err != nil {
+		fmt.Printf("Error getting prune history: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	for _, run := range runs {
+		metrics["prune.reports"] = fmt.Sprintf("%d", run.Reports)
+		metrics["prune.nodes"] = fmt.Sprintf("%d", run.Nodes)
+		metrics["prune.bytes"] = fmt.Sprintf("%d", run.Bytes)
+		metrics["prune.duration"] = fmt.Sprintf("%f", run.Duration)
+	}
+
 	// And return them
 	return metrics
 }
@@ -49,10 +169,12 @@ func getMetrics() map[string]string {
 // server - unless `nop` is in-use, in which case they are dumped to
 // STDOUT.
 //
-func SendMetrics(host string, port int, prefix string, nop bool) {
+// The filter, and fact, are those given to getMetrics.
+//
+func SendMetrics(host string, port int, prefix string, nop bool, filter NodeFilter, group string) {
 
 	// Get the metrics.
-	metrics := getMetrics()
+	metrics := getMetrics(filter, group)
 
 	// Create the helper.
 	g, err := graphite.NewGraphite(host, port)
@@ -97,6 +219,9 @@ type metricsCmd struct {
 	port   int
 	prefix string
 	nop    bool
+	facts  string
+	by     string
+	groups string
 }
 
 //
@@ -119,6 +244,9 @@ func (p *metricsCmd) SetFlags(f *flag.FlagSet) {
 	f.IntVar(&p.port, "port", 2003, "The carbon port to use, when submitting metrics.")
 	f.StringVar(&p.prefix, "prefix", "puppet", "The prefix to use when submitting metrics.")
 	f.BoolVar(&p.nop, "nop", false, "Print metrics rather than submitting them.")
+	f.StringVar(&p.facts, "fact", "", "Only count the nodes with the given facts, as name=value, separated by commas.")
+	f.StringVar(&p.by, "by", "", "Count the states of the nodes for each value of the given fact.")
+	f.StringVar(&p.groups, "group-rules", "", "A file of rules describing groups of nodes, whose states are counted.")
 }
 
 //
@@ -126,6 +254,47 @@ func (p *metricsCmd) SetFlags(f *flag.FlagSet) {
 //
 func (p *metricsCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
 
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	//
+	// Parse the facts we're filtering and grouping by.
+	//
+	var filter NodeFilter
+	var err error
+	if len(p.facts) > 0 {
+		filter.Facts, err = parseFactFilter(strings.Split(p.facts, ","))
+		if err == nil {
+			err = filter.Validate()
+		}
+	}
+	if err == nil && len(p.by) > 0 && !factNameRegexp.MatchString(p.by) {
+		err = fmt.Errorf("invalid fact '%s'", p.by)
+	}
+	if err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	//
+	// Load our node groups, if any.
+	//
+	if len(p.groups) > 0 {
+		groups, err := loadNodeGroups(p.groups)
+		if err != nil {
+			fmt.Printf("%s\n", err.Error())
+			return subcommands.ExitFailure
+		}
+		settings := *currentSettings()
+		settings.Groups = groups
+		storeSettings(&settings)
+	}
+
 	//
 	// Setup the database, by opening a handle, and creating it if
 	// missing.
@@ -135,7 +304,7 @@ func (p *metricsCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{
 	//
 	// Run metrics
 	//
-	SendMetrics(p.host, p.port, p.prefix, p.nop)
+	SendMetrics(p.host, p.port, p.prefix, p.nop, filter, p.by)
 
 	//
 	// All done.
@@ -17,10 +17,10 @@ func TestMetrics(t *testing.T) {
 	addFakeNodes()
 
 	// Get the metrics
-	metrics := getMetrics()
+	metrics := getMetrics(NodeFilter{}, "")
 
 	// Now test we can find things.
-	if len(metrics) != 4 {
+	if len(metrics) != 5 {
 		t.Errorf("Unexpected metrics-size: %v", len(metrics))
 	}
 
@@ -37,6 +37,24 @@ func TestMetrics(t *testing.T) {
 	if metrics["state.orphaned"] != "0" {
 		t.Errorf("Unexpected metrics value")
 	}
+	if metrics["state.acknowledged"] != "0" {
+		t.Errorf("Unexpected metrics value")
+	}
+
+	//
+	// Group, and filter, by facts.
+	//
+	addFacts(PuppetFacts{Fqdn: "foo.example.com", Values: map[string]interface{}{"os": map[string]interface{}{"family": "Debian"}}})
+
+	metrics = getMetrics(NodeFilter{}, "os.family")
+	if metrics["facts.os_family.Debian.changed"] != "1" || metrics["facts.os_family.unknown.failed"] != "1" {
+		t.Errorf("Unexpected grouped metrics: %v", metrics)
+	}
+
+	metrics = getMetrics(NodeFilter{Facts: map[string]string{"os.family": "Debian"}}, "")
+	if metrics["state.changed"] != "1" || metrics["state.failed"] != "0" {
+		t.Errorf("Unexpected filtered metrics: %v", metrics)
+	}
 
 	//
 	// Cleanup here because otherwise later tests will
@@ -102,3 +120,49 @@ func TestMetricNop(t *testing.T) {
 	db = nil
 	os.RemoveAll(path)
 }
+
+//
+// The time taken by each stage of recent runs is exported.
+//
+func TestMetricsLatency(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add a report with timings.
+	addTimedReport(t, "one.example.com")
+
+	// Get the metrics
+	metrics := getMetrics(NodeFilter{}, "")
+
+	expected := map[string]string{
+		"latency.production.runtime":                "3.793005",
+		"latency.production.config_retrieval":       "2.922780",
+		"latency.production.fact_generation":        "0.000000",
+		"latency.production.plugin_sync":            "0.000000",
+		"latency.production.transaction_evaluation": "0.000000",
+		"state.unchanged":                           "1",
+	}
+	for name, value := range expected {
+		if metrics[name] != value {
+			t.Errorf("Unexpected value of %s: '%s'", name, metrics[name])
+		}
+	}
+
+	//
+	// There's no latency for environments without reports.
+	//
+	for name := range metrics {
+		if strings.HasPrefix(name, "latency.") && expected[name] == "" {
+			t.Errorf("Unexpected metric %s", name)
+		}
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
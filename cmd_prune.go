@@ -22,6 +22,7 @@ type pruneCmd struct {
 	unchanged   bool
 	orphaned    bool
 	prefix      string
+	rules       string
 	dangling    bool
 	noop        bool
 	verbose     bool
@@ -42,6 +43,22 @@ func runPrune(x pruneCmd) error {
 		return (pruneDangling(x.prefix, x.noop, x.verbose))
 	}
 
+	//
+	// Applying our retention rules?
+	//
+	if x.rules != "" {
+		policy, err := loadRetention(x.rules)
+		if err != nil {
+			return err
+		}
+		result, err := applyRetention(policy, x.prefix, x.noop)
+		if err != nil {
+			return err
+		}
+		reportRetention(out, result, x.noop || x.verbose)
+		return nil
+	}
+
 	//
 	// Removing orphaned nodes?
 	//
@@ -96,7 +113,8 @@ func (p *pruneCmd) SetFlags(f *flag.FlagSet) {
 	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to use.")
 	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
 	f.BoolVar(&p.dangling, "dangling", false, "Remove yaml reports that are not referenced in the database.")
-	f.BoolVar(&p.noop, "noop", false, "Do not remove dangling yaml files, just pretend.")
+	f.BoolVar(&p.noop, "noop", false, "Do not remove dangling yaml files, or reports matched by -retention-rules, just pretend.")
+	f.StringVar(&p.rules, "retention-rules", "", "Remove the reports which aren't kept by the rules in this file.")
 	f.StringVar(&p.environment, "environment", "", "If specified only prune this environment.")
 }
 
@@ -105,6 +123,14 @@ func (p *pruneCmd) SetFlags(f *flag.FlagSet) {
 //
 func (p *pruneCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
 
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
 	//
 	// Setup the database, by opening a handle, and creating it if
 	// missing.
@@ -0,0 +1,76 @@
+//
+// Rebuild the table of nodes from the reports we hold.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/google/subcommands"
+)
+
+//
+// The options set by our command-line flags.
+//
+type rebuildCmd struct {
+	dbFile  string
+	verbose bool
+}
+
+//
+// Glue
+//
+func (*rebuildCmd) Name() string     { return "rebuild" }
+func (*rebuildCmd) Synopsis() string { return "Rebuild the table of known nodes." }
+func (*rebuildCmd) Usage() string {
+	return `rebuild [options]:
+  The most recent state of each node is updated as reports are submitted,
+  this command recreates it from the reports held in the database.
+`
+}
+
+//
+// Flag setup
+//
+func (p *rebuildCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.verbose, "verbose", false, "Be verbose in reporting output")
+	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to use.")
+}
+
+//
+// Entry-point.
+//
+func (p *rebuildCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	//
+	// Setup the database, by opening a handle, and creating it if
+	// missing.
+	//
+	err := SetupDB(p.dbFile)
+	if err != nil {
+		fmt.Printf("Error opening database: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	count, err := rebuildNodes()
+	if err != nil {
+		fmt.Printf("Error rebuilding nodes: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.verbose {
+		fmt.Printf("Found %d nodes\n", count)
+	}
+	return subcommands.ExitSuccess
+}
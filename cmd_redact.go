@@ -0,0 +1,239 @@
+//
+// Redact secrets from the reports already stored on disk.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/google/subcommands"
+)
+
+//
+// The options set by our command-line flags.
+//
+type redactCmd struct {
+	noop            bool
+	prefix          string
+	rules           string
+	streamThreshold int64
+	verbose         bool
+}
+
+//
+// Rewrite each stored report beneath our prefix, masking secrets with
+// the given redactor.
+//
+// Reports larger than the threshold, in bytes, are redacted one line at
+// a time, rather than being loaded into memory, so that only the
+// redactor's patterns are applied to them.
+//
+// Returns the number of reports which were examined, and the number
+// which were changed.
+//
+func redactReports(r *reportRedactor, prefix string, threshold int64, noop bool, verbose bool) (int, int, error) {
+
+	examined := 0
+	changed := 0
+
+	//
+	// Reports are named after their SHA1-hash.
+	//
+	reg, _ := regexp.Compile("^[0-9a-f]{40}$")
+
+	err := filepath.Walk(prefix, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !reg.MatchString(info.Name()) {
+			return nil
+		}
+		examined++
+
+		var updated bool
+		if info.Size() > threshold {
+			updated, err = redactStream(r, path, info, noop)
+		} else {
+			updated, err = redactFile(r, path, info, noop)
+		}
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return nil
+		}
+		changed++
+
+		if noop {
+			fmt.Printf("Would redact %s\n", path)
+		} else if verbose {
+			fmt.Printf("Redacted %s\n", path)
+		}
+		return nil
+	})
+
+	return examined, changed, err
+}
+
+//
+// Redact the given report in memory, returning true if it contained any
+// secrets.
+//
+func redactFile(r *reportRedactor, path string, info os.FileInfo, noop bool) (bool, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	redacted, updated, err := r.Redact(content)
+	if err != nil {
+		fmt.Printf("Warning - failed to redact %s: %s\n", path, err.Error())
+		return false, nil
+	}
+	if !updated || noop {
+		return updated, nil
+	}
+
+	return true, replaceReport(path, info, func(tmp *os.File) error {
+		_, err := tmp.Write(redacted)
+		return err
+	})
+}
+
+//
+// Redact the given report one line at a time, returning true if it
+// contained any secrets.
+//
+func redactStream(r *reportRedactor, path string, info os.FileInfo, noop bool) (bool, error) {
+
+	//
+	// We can only tell whether a report contains secrets by reading
+	// it, so one which does is read twice.
+	//
+	in, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer in.Close()
+
+	updated, err := r.RedactStream(in, ioutil.Discard)
+	if err != nil || !updated || noop {
+		return updated, err
+	}
+
+	_, err = in.Seek(0, io.SeekStart)
+	if err != nil {
+		return false, err
+	}
+	return true, replaceReport(path, info, func(tmp *os.File) error {
+		_, err := r.RedactStream(in, tmp)
+		return err
+	})
+}
+
+//
+// Replace the given report with the content written by the function.
+//
+// The new copy is written to a temporary file alongside the original,
+// named as our uploads are so that `db check` ignores it, and then
+// renamed over it, so that a failure never leaves a partial report.
+//
+func replaceReport(path string, info os.FileInfo, write func(*os.File) error) error {
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".upload-")
+	if err != nil {
+		return err
+	}
+
+	err = write(tmp)
+	if err == nil {
+		err = tmp.Chmod(info.Mode())
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	err = tmp.Close()
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+//
+// Glue
+//
+func (*redactCmd) Name() string     { return "redact" }
+func (*redactCmd) Synopsis() string { return "Mask secrets within stored reports." }
+func (*redactCmd) Usage() string {
+	return `redact [options]:
+  Rewrite the reports stored on-disk, masking any secrets which match
+  the given redaction rules.
+`
+}
+
+//
+// Flag setup
+//
+func (p *redactCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.noop, "noop", false, "Show the reports which would be changed, without changing them.")
+	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
+	f.StringVar(&p.rules, "rules", "", "The file of redaction rules to apply.")
+	f.Int64Var(&p.streamThreshold, "stream-threshold", 16, "Reports larger than this many megabytes are redacted without being loaded into memory.")
+	f.BoolVar(&p.verbose, "verbose", false, "Be verbose in reporting output")
+}
+
+//
+// Entry-point.
+//
+func (p *redactCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.rules == "" {
+		fmt.Printf("Please specify the rules to apply with -rules\n")
+		return subcommands.ExitFailure
+	}
+
+	r, err := loadRedactor(p.rules)
+	if err != nil {
+		fmt.Printf("Error loading redaction rules: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.streamThreshold <= 0 {
+		fmt.Printf("The -stream-threshold must be positive\n")
+		return subcommands.ExitFailure
+	}
+
+	examined, changed, err := redactReports(r, p.prefix, p.streamThreshold*1024*1024, p.noop, p.verbose)
+	if err != nil {
+		fmt.Printf("Error redacting reports: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.verbose || p.noop {
+		fmt.Printf("%d of %d reports contained secrets\n", changed, examined)
+	}
+	return subcommands.ExitSuccess
+}
@@ -0,0 +1,83 @@
+//
+// Restore our database, and the reports stored alongside it, from a backup.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/google/subcommands"
+)
+
+//
+// The options set by our command-line flags.
+//
+type restoreCmd struct {
+	dbFile  string
+	force   bool
+	noop    bool
+	prefix  string
+	verbose bool
+}
+
+//
+// Glue
+//
+func (*restoreCmd) Name() string     { return "restore" }
+func (*restoreCmd) Synopsis() string { return "Restore the database and reports from a backup." }
+func (*restoreCmd) Usage() string {
+	return `restore [options] archive:
+  Verify the given backup, and restore the database and reports it holds.
+  The backups an incremental backup is based upon must be alongside it.
+
+  The server must be stopped while this runs.
+`
+}
+
+//
+// Flag setup
+//
+func (p *restoreCmd) SetFlags(f *flag.FlagSet) {
+	f.BoolVar(&p.verbose, "verbose", false, "Be verbose in reporting output")
+	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to restore.")
+	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
+	f.BoolVar(&p.force, "force", false, "Replace the existing database and reports.")
+	f.BoolVar(&p.noop, "noop", false, "Verify the backup, without restoring it.")
+}
+
+//
+// Entry-point.
+//
+func (p *restoreCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+
+	//
+	// Settings not given on the command-line come from our configuration.
+	//
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if f.NArg() != 1 {
+		fmt.Printf("Please specify the backup to restore\n")
+		return subcommands.ExitUsageError
+	}
+
+	index, err := restoreBackup(f.Arg(0), p.dbFile, p.prefix, p.force, p.noop)
+	if err != nil {
+		fmt.Printf("Error restoring backup: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
+	if p.verbose || p.noop {
+		verb := "Restored"
+		if p.noop {
+			verb = "Verified"
+		}
+		fmt.Printf("%s the database, and %d reports, backed up at %s\n", verb, len(index.Reports), index.Created.Format("2006-01-02 15:04:05"))
+	}
+	return subcommands.ExitSuccess
+}
@@ -17,16 +17,18 @@ import (
 	"mime"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/google/subcommands"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
-	"github.com/robfig/cron"
 	_ "github.com/skx/golang-metrics"
 )
 
@@ -43,6 +45,52 @@ func Exists(name string) bool {
 	return !os.IsNotExist(err)
 }
 
+//
+// requestFilter returns the filter described by the `environment`,
+// `state`, `fqdn`, `fact`, `group`, and `owner` parameters of the given
+// request.  An environment, or group, within the path, such as
+// `/environment/production`, takes precedence.
+//
+// Facts are given as `name=value`, and the parameter may be repeated.
+//
+// An error is returned if any of the values are bogus.
+//
+func requestFilter(req *http.Request) (NodeFilter, error) {
+	filter := NodeFilter{
+		Environment: req.FormValue("environment"),
+		State:       req.FormValue("state"),
+		Fqdn:        req.FormValue("fqdn"),
+		Group:       req.FormValue("group"),
+		Owner:       req.FormValue("owner"),
+	}
+
+	if environment, ok := mux.Vars(req)["environment"]; ok {
+		filter.Environment = environment
+	}
+	if group, ok := mux.Vars(req)["group"]; ok {
+		filter.Group = group
+	}
+
+	var err error
+	filter.Facts, err = parseFactFilter(req.Form["fact"])
+	if err != nil {
+		return filter, err
+	}
+	return filter, filter.Validate()
+}
+
+//
+// requestGroupBy returns the name of the fact, given by the `by`
+// parameter of the given request, by which nodes should be grouped.
+//
+func requestGroupBy(req *http.Request) (string, error) {
+	by := req.FormValue("by")
+	if len(by) > 0 && !factNameRegexp.MatchString(by) {
+		return "", fmt.Errorf("invalid fact '%s'", by)
+	}
+	return by, nil
+}
+
 //
 // APIState is the handler for the HTTP end-point
 //
@@ -86,6 +134,7 @@ func APIState(res http.ResponseWriter, req *http.Request) {
 	case "unchanged":
 	case "failed":
 	case "orphaned":
+	case "acknowledged":
 	default:
 		err = errors.New("invalid state supplied")
 		status = http.StatusInternalServerError
@@ -93,9 +142,28 @@ func APIState(res http.ResponseWriter, req *http.Request) {
 	}
 
 	//
-	// Get the nodes.
+	// The nodes may be further limited by environment, or name.
+	//
+	filter, err := requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+	filter.State = state
+
+	//
+	// The nodes may also be grouped by a fact.
 	//
-	NodeList, err := getIndexNodes("")
+	group, err := requestGroupBy(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	//
+	// Get the nodes in the correct users' preferred state.
+	//
+	NodeList, err := getNodes(filter)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
@@ -106,13 +174,8 @@ func APIState(res http.ResponseWriter, req *http.Request) {
 	//
 	var result []string
 
-	//
-	// Add the hosts in the correct users' preferred state.
-	//
 	for _, o := range NodeList {
-		if o.State == state {
-			result = append(result, o.Fqdn)
-		}
+		result = append(result, o.Fqdn)
 	}
 
 	//
@@ -126,6 +189,19 @@ func APIState(res http.ResponseWriter, req *http.Request) {
 		accept = req.Header.Get("Accept")
 	}
 
+	if len(group) > 0 {
+		err = setNodeGroups(NodeList, group)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		err = writeStateGroups(res, accept, NodeList)
+		if err != nil {
+			status = http.StatusInternalServerError
+		}
+		return
+	}
+
 	switch accept {
 	case "text/plain":
 		res.Header().Set("Content-Type", "text/plain")
@@ -160,6 +236,302 @@ func APIState(res http.ResponseWriter, req *http.Request) {
 
 }
 
+//
+// writeStateGroups writes the names of the given nodes, grouped by the
+// value of the fact set by setNodeGroups, in the format requested by the
+// given `Accept:` header.
+//
+// Plain-text is written as a group and a node-name upon each line.
+//
+func writeStateGroups(res http.ResponseWriter, accept string, nodes []PuppetRuns) error {
+
+	type StateGroup struct {
+		Value string
+		Nodes []string
+	}
+
+	var groups []StateGroup
+	for _, group := range groupNodes(nodes) {
+		tmp := StateGroup{Value: group.Value, Nodes: []string{}}
+		for _, node := range nodes {
+			if node.Group == group.Value {
+				tmp.Nodes = append(tmp.Nodes, node.Fqdn)
+			}
+		}
+		groups = append(groups, tmp)
+	}
+
+	switch accept {
+	case "text/plain":
+		res.Header().Set("Content-Type", "text/plain")
+
+		for _, group := range groups {
+			for _, fqdn := range group.Nodes {
+				fmt.Fprintf(res, "%s\t%s\n", group.Value, fqdn)
+			}
+		}
+	case "application/xml":
+		x, err := xml.MarshalIndent(groups, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		res.Header().Set("Content-Type", "application/xml")
+		res.Write(x)
+	default:
+		if groups == nil {
+			groups = []StateGroup{}
+		}
+		js, err := json.Marshal(groups)
+		if err != nil {
+			return err
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(js)
+	}
+	return nil
+}
+
+//
+// The default time-range shown for each size of history-bucket.
+//
+var historySpan = map[string]time.Duration{
+	"hour": 48 * time.Hour,
+	"day":  30 * 24 * time.Hour,
+	"week": 26 * 7 * 24 * time.Hour,
+}
+
+//
+// parseRangeTime parses a time given as seconds past the epoch, as a local
+// date such as `2019-03-31`, or in RFC3339 format.
+//
+func parseRangeTime(value string) (time.Time, error) {
+
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time '%s'", value)
+}
+
+//
+// historyRange returns the size of the history-buckets, and the range of
+// time, which were requested via the `bucket`, `from` and `to` parameters.
+//
+// The bucket defaults to a day, the range ends with the current bucket,
+// and starts far enough back to show a useful number of buckets.
+//
+func historyRange(req *http.Request) (string, time.Time, time.Time, error) {
+
+	bucket := req.FormValue("bucket")
+	if len(bucket) < 1 {
+		bucket = "day"
+	}
+	span, ok := historySpan[bucket]
+	if !ok {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("the 'bucket' parameter must be one of 'hour', 'day', or 'week'")
+	}
+
+	var err error
+
+	//
+	// By default we finish with the bucket containing the present.
+	//
+	to, _ := historyBucket(bucket, time.Now())
+	to = nextBucket(bucket, to)
+	if len(req.FormValue("to")) > 0 {
+		to, err = parseRangeTime(req.FormValue("to"))
+		if err != nil {
+			return "", time.Time{}, time.Time{}, err
+		}
+	}
+
+	from := to.Add(-span)
+	if len(req.FormValue("from")) > 0 {
+		from, err = parseRangeTime(req.FormValue("from"))
+		if err != nil {
+			return "", time.Time{}, time.Time{}, err
+		}
+	}
+
+	if !to.After(from) {
+		return "", time.Time{}, time.Time{}, errors.New("the 'to' parameter must be after 'from'")
+	}
+	return bucket, from, to, nil
+}
+
+//
+// APIHistory is the handler for the HTTP end-point
+//
+//	 GET /api/v1/history
+//
+// This returns the number of reports in each state within each hour, day,
+// or week of the requested range.  The results may be limited to a single
+// environment via the `environment` parameter.
+//
+// JSON is returned by default, but XML is possible via the `Accept:`
+// header or `?accept=XX` parameter.
+//
+func APIHistory(res http.ResponseWriter, req *http.Request) {
+
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+		}
+	}()
+
+	bucket, from, to, err := historyRange(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	filter, err := requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+	environment := filter.Environment
+
+	history, err := getHistory(environment, bucket, from.Unix(), to.Unix())
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	//
+	// The data we return.
+	//
+	type Result struct {
+		Environment string
+		Bucket      string
+		From        string
+		To          string
+		History     []PuppetHistory
+	}
+
+	var x Result
+	x.Environment = environment
+	x.Bucket = bucket
+	x.From = from.Format(time.RFC3339)
+	x.To = to.Format(time.RFC3339)
+	x.History = history
+
+	//
+	// Accept either a "?accept=XXX" URL-parameter, or
+	// the Accept HEADER in the HTTP request
+	//
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
+	}
+
+	switch accept {
+	case "application/xml":
+		out, err := xml.MarshalIndent(x, "", "  ")
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/xml")
+		res.Write(out)
+	default:
+		out, err := json.Marshal(x)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(out)
+	}
+}
+
+//
+// APIExport is the handler for the HTTP end-point
+//
+//	 GET /api/v1/export
+//
+// This streams the reports, the slowest resources of each, or the messages
+// they logged, as selected by the `dataset` parameter.  The `format` may be
+// `csv`, `ndjson`, or `parquet`, and the reports may be limited via the
+// `from`, `to`, `environment`, `state`, and `fqdn` parameters.
+//
+func APIExport(res http.ResponseWriter, req *http.Request) {
+
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+		}
+	}()
+
+	opts := exportOptions{
+		Dataset:  req.FormValue("dataset"),
+		Format:   req.FormValue("format"),
+		Prefix:   ReportPrefix,
+		Redactor: currentSettings().Redactor,
+	}
+	if len(opts.Dataset) < 1 {
+		opts.Dataset = "reports"
+	}
+	if len(opts.Format) < 1 {
+		opts.Format = "csv"
+	}
+
+	opts.Filter.NodeFilter, err = requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+	if len(req.FormValue("from")) > 0 {
+		opts.Filter.From, err = parseRangeTime(req.FormValue("from"))
+		if err != nil {
+			status = http.StatusBadRequest
+			return
+		}
+	}
+	if len(req.FormValue("to")) > 0 {
+		opts.Filter.To, err = parseRangeTime(req.FormValue("to"))
+		if err != nil {
+			status = http.StatusBadRequest
+			return
+		}
+	}
+
+	err = opts.Validate()
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	res.Header().Set("Content-Type", exportFormats[opts.Format])
+	res.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.%s\"", opts.Dataset, opts.Format))
+
+	//
+	// Once we've started to stream the rows we can't report an error
+	// to the caller, other than by truncating the response.
+	//
+	_, exportErr := runExport(res, opts)
+	if exportErr != nil {
+		fmt.Printf("Error exporting %s: %s\n", opts.Dataset, exportErr.Error())
+	}
+}
+
 //
 // RadiatorView is the handler for the HTTP end-point
 //
@@ -168,6 +540,9 @@ func APIState(res http.ResponseWriter, req *http.Request) {
 // It will respond in either HTML, JSON, or XML depending on the
 // Accepts-header which is received.
 //
+// The nodes may be limited via the `environment`, `fqdn`, and `fact`
+// parameters, and counted for each value of a fact via the `by` parameter.
+//
 func RadiatorView(res http.ResponseWriter, req *http.Request) {
 
 	var (
@@ -182,41 +557,75 @@ func RadiatorView(res http.ResponseWriter, req *http.Request) {
 
 	// anonymous struct
 	type Pagedata struct {
-		States    []PuppetState
+		Group     string
+		Groups    []FactGroup
+		NodeGroup string
 		Urlprefix string
 	}
 
 	//
-	// Get the state of the nodes.
+	// Get the nodes we're interested in, in any state.
 	//
-	data, err := getStates("")
+	filter, err := requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+	filter.State = ""
+
+	group, err := requestGroupBy(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	NodeList, err := getNodes(filter)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
 	//
-	// Sum up our known-nodes.
+	// Count the state of the nodes, within each group.
 	//
-	total := 0
-	for i := range data {
-		total += data[i].Count
+	var groups []FactGroup
+	if len(group) > 0 {
+		err = setNodeGroups(NodeList, group)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		groups = groupNodes(NodeList)
+	} else {
+		groups = []FactGroup{{Total: len(NodeList), States: countStates(NodeList)}}
 	}
 
 	//
 	// Add in the total count of nodes.
 	//
-	var tmp PuppetState
-	tmp.State = "Total"
-	tmp.Count = total
-	tmp.Percentage = 0
-	data = append(data, tmp)
+	for i := range groups {
+		var tmp PuppetState
+		tmp.State = "Total"
+		tmp.Count = groups[i].Total
+		tmp.Percentage = 0
+		groups[i].States = append(groups[i].States, tmp)
+	}
 
 	// genereic template args
 	var x Pagedata
-	x.States = data
+	x.Group = group
+	x.Groups = groups
+	x.NodeGroup = filter.Group
 	x.Urlprefix = templateArgs.urlprefix
 
+	//
+	// Without grouping we return the states alone.
+	//
+	var data interface{} = groups
+	if len(group) < 1 {
+		data = groups[0].States
+	}
+
 	//
 	// What kind of reply should we send?
 	//
@@ -293,6 +702,9 @@ func RadiatorView(res http.ResponseWriter, req *http.Request) {
 // then the data is written beneath ./reports/$hostname/$timestamp
 // and a summary-record is inserted into our SQLite database.
 //
+// Submissions larger than the `MaxReportSize` setting are rejected,
+// and those larger than `StreamThreshold` are parsed without being
+// loaded into memory.
 //
 func ReportSubmissionHandler(res http.ResponseWriter, req *http.Request) {
 	var (
@@ -319,24 +731,59 @@ func ReportSubmissionHandler(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	//
+	// Refuse new reports once we've started to shut down, so the
+	// puppet-server will retry them elsewhere, or later.
+	//
+	if isDraining() {
+		res.Header().Set("Retry-After", "30")
+		err = errors.New("the server is shutting down")
+		status = http.StatusServiceUnavailable
+		return
+	}
+
+	//
+	// Refuse to read more than our configured maximum.
+	//
+	settings := currentSettings()
+	req.Body = http.MaxBytesReader(res, req.Body, settings.MaxReportSize)
+
 	//
 	// Read the body of the request.
 	//
-	content, err := ioutil.ReadAll(req.Body)
+	upload, err := readUpload(req.Body, settings.StreamThreshold)
 	if err != nil {
 		status = http.StatusInternalServerError
+		if isTooLarge(err) {
+			status = http.StatusRequestEntityTooLarge
+		}
 		return
 	}
+	defer upload.Close()
 
 	//
 	// Parse the YAML into something we can work with.
 	//
-	report, err := ParsePuppetReport(content)
+	report, err := upload.Parse()
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
+	//
+	// Mask any secrets before the report is written to disk.
+	//
+	// The hash, used to detect duplicates, remains that of the
+	// report as it was submitted.
+	//
+	if settings.Redactor != nil {
+		err = upload.Redact(settings.Redactor)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+	}
+
 	//
 	// Create a report directory for this host, unless it already exists.
 	//
@@ -366,7 +813,7 @@ func ReportSubmissionHandler(res http.ResponseWriter, req *http.Request) {
 	//
 	// Create the new report-file, on-disk.
 	//
-	err = ioutil.WriteFile(path, content, 0644)
+	err = upload.Save(path)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
@@ -377,7 +824,18 @@ func ReportSubmissionHandler(res http.ResponseWriter, req *http.Request) {
 	//
 	relativePath := filepath.Join(report.Fqdn, report.Hash)
 
-	addDB(report, relativePath)
+	err = addDB(report, relativePath)
+	if err != nil {
+
+		//
+		// Remove the file we just wrote, so that a retry of
+		// this submission isn't treated as a duplicate.
+		//
+		os.Remove(path)
+
+		status = http.StatusInternalServerError
+		return
+	}
 
 	//
 	// Show something to the caller.
@@ -388,14 +846,951 @@ func ReportSubmissionHandler(res http.ResponseWriter, req *http.Request) {
 }
 
 //
-// SearchHandler is the handler for the HTTP end-point:
+// FactSubmissionHandler is the handler for the HTTP end-points:
 //
+//	POST /upload/facts
+//	PUT  /upload/facts/$fqdn
+//
+// The input may be the facts sent to PuppetDB, as a `replace facts`
+// command or its payload, or those sent to Puppet's REST facts terminus,
+// as either JSON or YAML.  The name of the node may be given within the
+// path, or by the facts themselves.
+//
+func FactSubmissionHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	//
+	// Refuse new facts once we've started to shut down.
+	//
+	if isDraining() {
+		res.Header().Set("Retry-After", "30")
+		err = errors.New("the server is shutting down")
+		status = http.StatusServiceUnavailable
+		return
+	}
+
+	//
+	// Facts are much smaller than reports, but we'll refuse to read
+	// more than we'd accept for a report.
+	//
+	settings := currentSettings()
+	req.Body = http.MaxBytesReader(res, req.Body, settings.MaxReportSize)
+
+	content, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		status = http.StatusInternalServerError
+		if isTooLarge(err) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		return
+	}
+
+	//
+	// The terminus names the node within the path, rather than
+	// within the facts.
+	//
+	fqdn := mux.Vars(req)["fqdn"]
+
+	facts, err := ParsePuppetFacts(content, fqdn)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	if len(fqdn) > 0 && fqdn != facts.Fqdn {
+		err = fmt.Errorf("the facts are those of '%s', not '%s'", facts.Fqdn, fqdn)
+		status = http.StatusBadRequest
+		return
+	}
+
+	err = addFacts(facts)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	//
+	// Show something to the caller.
+	//
+	out := fmt.Sprintf("{\"host\":\"%s\"}", facts.Fqdn)
+	fmt.Fprint(res, string(out))
+}
+
+//
+// APIFacts is the handler for the HTTP end-point
+//
+//	 GET /api/v1/facts/$fqdn
+//
+// This returns the facts submitted by the given node, most recent first,
+// as JSON.
+//
+func APIFacts(res http.ResponseWriter, req *http.Request) {
+
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+		}
+	}()
+
+	fqdn := mux.Vars(req)["fqdn"]
+	if !fqdnRegexp.MatchString(fqdn) {
+		err = fmt.Errorf("invalid node '%s'", fqdn)
+		status = http.StatusBadRequest
+		return
+	}
+
+	history, err := getFactHistory(fqdn)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+	if len(history) < 1 {
+		err = fmt.Errorf("no facts have been submitted by '%s'", fqdn)
+		status = http.StatusNotFound
+		return
+	}
+
+	js, err := json.Marshal(history)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+	res.Header().Set("Content-Type", "application/json")
+	res.Write(js)
+}
+
+//
+// MaintenanceHandler is the handler for the HTTP end-point:
+//
+//	GET /maintenance
+//
+// It lists the acknowledgements, and windows of maintenance, which haven't
+// yet ended, in either HTML, JSON, or XML.
+//
+func MaintenanceHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	acks, err := getAcknowledgements()
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	type Pagedata struct {
+		Acknowledgements []Acknowledgement
+		NodeGroups       []string
+		Urlprefix        string
+	}
+
+	var x Pagedata
+	x.Acknowledgements = acks
+	x.NodeGroups = currentSettings().Groups.Names()
+	x.Urlprefix = templateArgs.urlprefix
+
+	//
+	// Accept either a "?accept=XXX" URL-parameter, or
+	// the Accept HEADER in the HTTP request
+	//
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
+	}
+
+	switch accept {
+	case "application/json":
+		if acks == nil {
+			acks = []Acknowledgement{}
+		}
+		js, err := json.Marshal(acks)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(js)
+
+	case "application/xml":
+		x, err := xml.MarshalIndent(acks, "", "  ")
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/xml")
+		res.Write(x)
+
+	default:
+
+		//
+		// Load our template source.
+		//
+		tmpl, err := getResource("data/maintenance.template")
+		if err != nil {
+			fmt.Fprint(res, err.Error())
+			return
+		}
+
+		//
+		//  Load our template, from the resource.
+		//
+		src := string(tmpl)
+		t := template.Must(template.New("tmpl").Parse(src))
+
+		//
+		// Execute the template into our buffer.
+		//
+		buf := &bytes.Buffer{}
+		err = t.Execute(buf, x)
+
+		//
+		// If there were errors, then show them.
+		if err != nil {
+			fmt.Fprint(res, err.Error())
+			return
+		}
+
+		//
+		// Otherwise write the result.
+		//
+		buf.WriteTo(res)
+	}
+}
+
+//
+// AcknowledgeHandler is the handler for the HTTP end-points:
+//
+//	POST /node/$FQDN/acknowledge
+//	POST /maintenance
+//
+// The former acknowledges a single node, and the latter schedules a window
+// of maintenance for the nodes matching `fqdn`, or the members of `group`.
+// Both require a `note`, and an `end`, such as `4h` or `2019-03-31`, and
+// windows of maintenance may have a `start` too.
+//
+// Forms are redirected back to the page they came from, and requests for
+// JSON receive the ID of the new acknowledgement.
+//
+func AcknowledgeHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	req.ParseForm()
+	now := time.Now()
+
+	var a Acknowledgement
+	a.Note = req.FormValue("note")
+
+	fqdn := mux.Vars(req)["fqdn"]
+	if len(fqdn) > 0 {
+		a.Kind = "acknowledgement"
+		a.Fqdn = fqdn
+	} else {
+		a.Kind = "maintenance"
+		a.Fqdn = req.FormValue("fqdn")
+		a.Group = req.FormValue("group")
+	}
+
+	//
+	// The period defaults to starting now.
+	//
+	start := now
+	if len(req.FormValue("start")) > 0 && a.Kind == "maintenance" {
+		start, err = parseWindowTime(req.FormValue("start"), now)
+		if err != nil {
+			status = http.StatusBadRequest
+			return
+		}
+	}
+	if len(req.FormValue("end")) < 1 {
+		err = errors.New("missing 'end' parameter")
+		status = http.StatusBadRequest
+		return
+	}
+	end, err := parseWindowTime(req.FormValue("end"), now)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+	a.Start = start.Unix()
+	a.End = end.Unix()
+
+	err = a.Validate()
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	//
+	// Only nodes which we know about may be acknowledged.
+	//
+	if a.Kind == "acknowledgement" {
+		var nodes []PuppetRuns
+		nodes, err = getNodes(NodeFilter{Fqdn: a.Fqdn})
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		if len(nodes) < 1 {
+			err = fmt.Errorf("the node '%s' is unknown", a.Fqdn)
+			status = http.StatusNotFound
+			return
+		}
+	}
+
+	a.ID, err = addAcknowledgement(a)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
+	}
+
+	if accept == "application/json" {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(res, "{\"ID\":%d}", a.ID)
+		return
+	}
+
+	if a.Kind == "acknowledgement" {
+		http.Redirect(res, req, templateArgs.urlprefix+"/node/"+a.Fqdn, http.StatusSeeOther)
+		return
+	}
+	http.Redirect(res, req, templateArgs.urlprefix+"/maintenance", http.StatusSeeOther)
+}
+
+//
+// UnacknowledgeHandler is the handler for the HTTP end-points:
+//
+//	DELETE /maintenance/NN
+//	POST /maintenance/NN/delete
+//
+// It removes an acknowledgement, or window of maintenance.  Forms are
+// redirected to the node given by `fqdn`, if any, or to the list.
+//
+func UnacknowledgeHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	id, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 64)
+	if err != nil {
+		err = errors.New("the acknowledgement ID must be numeric")
+		status = http.StatusBadRequest
+		return
+	}
+
+	err = removeAcknowledgement(id)
+	if err != nil {
+		status = http.StatusNotFound
+		return
+	}
+
+	if req.Method == "DELETE" {
+		res.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	fqdn := req.FormValue("fqdn")
+	if fqdnRegexp.MatchString(fqdn) {
+		http.Redirect(res, req, templateArgs.urlprefix+"/node/"+fqdn, http.StatusSeeOther)
+		return
+	}
+	http.Redirect(res, req, templateArgs.urlprefix+"/maintenance", http.StatusSeeOther)
+}
+
+//
+// AnnotationHandler is the handler for the HTTP end-points:
+//
+//	POST /node/$FQDN/annotations
+//	GET /api/v1/annotations/$FQDN
+//	PUT /api/v1/annotations/$FQDN
+//	DELETE /api/v1/annotations/$FQDN
+//
+// The form sets the `owner`, `notes`, and `links` of a node, with one link
+// per line, and is redirected back to the node.  The API reads, replaces,
+// or removes the annotation as JSON.
+//
+func AnnotationHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	fqdn := mux.Vars(req)["fqdn"]
+	if !fqdnRegexp.MatchString(fqdn) {
+		err = fmt.Errorf("invalid node '%s'", fqdn)
+		status = http.StatusBadRequest
+		return
+	}
+
+	var a NodeAnnotation
+
+	switch req.Method {
+	case "GET":
+		a, err = getAnnotation(fqdn)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		var js []byte
+		js, err = json.Marshal(a)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(js)
+		return
+
+	case "DELETE":
+		err = setAnnotation(fqdn, NodeAnnotation{})
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.WriteHeader(http.StatusNoContent)
+		return
+
+	case "PUT":
+		req.Body = http.MaxBytesReader(res, req.Body, int64(maxAnnotationNotes*4))
+		err = json.NewDecoder(req.Body).Decode(&a)
+		if err != nil {
+			err = fmt.Errorf("invalid annotation: %s", err.Error())
+			status = http.StatusBadRequest
+			return
+		}
+
+	default:
+		a.Owner = strings.TrimSpace(req.FormValue("owner"))
+		a.Notes = strings.TrimSpace(req.FormValue("notes"))
+		a.Links = parseLinks(req.FormValue("links"))
+	}
+
+	err = a.Validate()
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	//
+	// Only nodes which we know about may be annotated.
+	//
+	nodes, err := getNodes(NodeFilter{Fqdn: fqdn})
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+	if len(nodes) < 1 {
+		err = fmt.Errorf("the node '%s' is unknown", fqdn)
+		status = http.StatusNotFound
+		return
+	}
+
+	err = setAnnotation(fqdn, a)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	if req.Method == "PUT" {
+		res.WriteHeader(http.StatusNoContent)
+		return
+	}
+	http.Redirect(res, req, templateArgs.urlprefix+"/node/"+fqdn, http.StatusSeeOther)
+}
+
+//
+// SearchHandler is the handler for the HTTP end-points:
+//
+//	GET /search?q=XXX
 //	POST /search
 //
-// We perform a search for nodes matching a given pattern.  The comparison
-// is a regular substring-match, rather than a regular expression.
+// We perform a search for nodes matching the given query, which is written
+// in the language described in search.go, such as `state:failed env:prod`.
+// Words which don't name a field match any part of the name of a node.
+//
+// Searches made via GET may be bookmarked, or shared, and like our other
+// views respond in either HTML, JSON, or XML.  The older form POSTs the
+// query as `term`.
+//
+func SearchHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	//
+	// Get the query from the URL, or the form.
+	//
+	req.ParseForm()
+	term := req.FormValue("q")
+	if len(term) < 1 {
+		term = req.FormValue("term")
+	}
+
+	//
+	// Ensure we have a term.
+	//
+	if len(strings.TrimSpace(term)) < 1 {
+		err = errors.New("missing search term")
+		status = http.StatusInternalServerError
+		return
+	}
+
+	search, err := parseSearch(term, time.Now())
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	//
+	// Annoying struct to allow us to populate our template
+	// with both the matching nodes, and the term used for the search
+	//
+	type Pagedata struct {
+		Nodes     []PuppetRuns
+		Term      string
+		Urlprefix string
+	}
+
+	//
+	// Find the matching nodes.
+	//
+	NodeList, err := findNodes(NodeFilter{}, search)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	//
+	// Populate this structure with the search-term
+	//
+	var x Pagedata
+	x.Nodes = NodeList
+	x.Term = term
+	x.Urlprefix = templateArgs.urlprefix
+
+	//
+	// Accept either a "?accept=XXX" URL-parameter, or
+	// the Accept HEADER in the HTTP request
+	//
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
+	}
+
+	switch accept {
+	case "application/json":
+		js, err := json.Marshal(NodeList)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(js)
+		return
+
+	case "application/xml":
+		x, err := xml.MarshalIndent(NodeList, "", "  ")
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/xml")
+		res.Write(x)
+		return
+	}
+
+	//
+	// Load our template source.
+	//
+	tmpl, err := getResource("data/results.template")
+	if err != nil {
+		fmt.Fprint(res, err.Error())
+		return
+	}
+
+	//
+	//  Load our template, from the resource.
+	//
+	src := string(tmpl)
+	t := template.Must(template.New("tmpl").Parse(src))
+
+	//
+	// Execute the template into our buffer.
+	//
+	buf := &bytes.Buffer{}
+	err = t.Execute(buf, x)
+
+	//
+	// If there were errors, then show them.
+	if err != nil {
+		fmt.Fprint(res, err.Error())
+		return
+	}
+
+	//
+	// Otherwise write the result.
+	//
+	buf.WriteTo(res)
+}
+
+//
+// The levels at which puppet logs messages, most severe first.
+//
+var logLevels = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+//
+// ReportHandler is the handler for the HTTP end-point
+//
+//	 GET /report/NN
+//
+// It will respond in either HTML, JSON, or XML depending on the
+// Accepts-header which is received.
+//
+func ReportHandler(res http.ResponseWriter, req *http.Request) {
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+
+			// Don't spam stdout when running test-cases.
+			if flag.Lookup("test.v") == nil {
+				fmt.Printf("Error: %s\n", err.Error())
+			}
+		}
+	}()
+
+	//
+	// Get the node name we're going to show.
+	//
+	vars := mux.Vars(req)
+	id := vars["id"]
+
+	//
+	// Ensure we received a parameter.
+	//
+	if len(id) < 1 {
+		status = http.StatusNotFound
+		err = errors.New("missing 'id' parameter")
+		return
+	}
+
+	//
+	// If the ID is non-numeric we're in trouble.
+	//
+	reg, _ := regexp.Compile("^([0-9]+)$")
+	if !reg.MatchString(id) {
+		status = http.StatusInternalServerError
+		err = errors.New("the report ID must be numeric")
+		return
+	}
+
+	//
+	// Get the content.
+	//
+	content, err := getYAML(ReportPrefix, id)
+	if err == ErrReportExpired {
+		err = reportExpired(res, req, id)
+		status = http.StatusInternalServerError
+		return
+	}
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	//
+	// Mask any secrets, which might be present in reports stored
+	// before redaction was configured.
+	//
+	if redactor := currentSettings().Redactor; redactor != nil {
+		content, _, err = redactor.Redact(content)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+	}
+
+	// need generic struct
+	type Pagedata struct {
+		Report    PuppetReport
+		Slowest   []Resource
+		Levels    []string
+		Urlprefix string
+	}
+
+	//
+	// Parse it
+	//
+	report, err := ParsePuppetReport(content)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	var x Pagedata
+	x.Report = report
+	x.Slowest = report.Slowest(10)
+
+	sources := currentSettings().Sources
+
+	//
+	// The distinct levels of the logged messages, most severe first,
+	// which may be used to filter them.
+	//
+	seen := make(map[string]bool)
+	for _, l := range report.Logs {
+		seen[l.Level] = true
+	}
+	for _, level := range logLevels {
+		if seen[level] {
+			x.Levels = append(x.Levels, level)
+			delete(seen, level)
+		}
+	}
+	var other []string
+	for level := range seen {
+		other = append(other, level)
+	}
+	sort.Strings(other)
+	x.Levels = append(x.Levels, other...)
+	x.Urlprefix = templateArgs.urlprefix
+
+	//
+	// Accept either a "?accept=XXX" URL-parameter, or
+	// the Accept HEADER in the HTTP request
+	//
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
+	}
+
+	switch accept {
+	case "application/json":
+		js, err := json.Marshal(report)
+
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(js)
+
+	case "application/xml":
+		x, err := xml.MarshalIndent(report, "", "  ")
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/xml")
+		res.Write(x)
+	default:
+
+		//
+		// Load our template resource.
+		//
+		tmpl, err := getResource("data/report.template")
+		if err != nil {
+			fmt.Fprint(res, err.Error())
+			return
+		}
+
+		//
+		// Helper to allow a float to be truncated
+		// to two/three digits.
+		//
+		funcMap := template.FuncMap{
+
+			"truncate": func(f float64) string {
+				return fmt.Sprintf("%.2f", f)
+			},
+
+			//
+			// The URL of a line of a manifest, if any.
+			//
+			"source": func(file string, line string) string {
+				return sources.Link(report, file, line)
+			},
+
+			//
+			// The class used to colour a log-level.
+			//
+			"level": func(level string) string {
+				switch level {
+				case "emerg", "alert", "crit", "err":
+					return "label-danger"
+				case "warning":
+					return "label-warning"
+				case "notice":
+					return "label-info"
+				}
+				return "label-default"
+			},
+		}
+
+		//
+		//  Load our template, from the resource.
+		//
+		src := string(tmpl)
+		t := template.Must(template.New("tmpl").Funcs(funcMap).Parse(src))
+
+		//
+		// Execute the template into our buffer.
+		//
+		buf := &bytes.Buffer{}
+		err = t.Execute(buf, x)
+
+		//
+		// If there were errors, then show them.
+		if err != nil {
+			fmt.Fprint(res, err.Error())
+			return
+		}
+
+		//
+		// Otherwise write the result.
+		//
+		buf.WriteTo(res)
+	}
+}
+
+//
+// reportExpired responds to a request for a report whose body has been
+// removed by our retention policy, with a `410` status and its summary.
 //
-func SearchHandler(res http.ResponseWriter, req *http.Request) {
+func reportExpired(res http.ResponseWriter, req *http.Request, id string) error {
+
+	summary, err := getReportSummary(id)
+	if err != nil {
+		return err
+	}
+
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
+	}
+
+	switch accept {
+	case "application/json", "application/xml":
+		http.Error(res, ErrReportExpired.Error(), http.StatusGone)
+		return nil
+	}
+
+	type Pagedata struct {
+		Report    PuppetReportSummary
+		Urlprefix string
+	}
+	x := Pagedata{Report: summary, Urlprefix: templateArgs.urlprefix}
+
+	tmpl, err := getResource("data/expired.template")
+	if err != nil {
+		return err
+	}
+	t := template.Must(template.New("tmpl").Parse(string(tmpl)))
+
+	buf := &bytes.Buffer{}
+	err = t.Execute(buf, x)
+	if err != nil {
+		return err
+	}
+
+	res.Header().Set("Content-Type", "text/html; charset=utf-8")
+	res.WriteHeader(http.StatusGone)
+	buf.WriteTo(res)
+	return nil
+}
+
+//
+// NodeHandler is the handler for the HTTP end-point
+//
+//	 GET /node/$FQDN
+//
+// It will respond in either HTML, JSON, or XML depending on the
+// Accepts-header which is received.  JSON and XML describe the node, its
+// annotation, and its recent reports.
+//
+func NodeHandler(res http.ResponseWriter, req *http.Request) {
 	var (
 		status int
 		err    error
@@ -412,107 +1807,200 @@ func SearchHandler(res http.ResponseWriter, req *http.Request) {
 	}()
 
 	//
-	// Ensure this was a POST-request
+	// Get the node name we're going to show.
 	//
-	if req.Method != "POST" {
-		err = errors.New("must be called via HTTP-POST")
-		status = http.StatusInternalServerError
+	vars := mux.Vars(req)
+	fqdn := vars["fqdn"]
+
+	//
+	// Ensure we received a parameter.
+	//
+	if len(fqdn) < 1 {
+		status = http.StatusNotFound
+		err = errors.New("missing 'fqdn' parameter")
 		return
 	}
 
 	//
-	// Get the term from the form.
+	// Get the reports
+	//
+	reports, err := getReports(fqdn)
+
+	//
+	// Ensure that something was present.
 	//
-	req.ParseForm()
-	term := req.FormValue("term")
+	if (reports == nil) || (len(reports) < 1) {
+		status = http.StatusNotFound
+		return
+	}
 
 	//
-	// Ensure we have a term.
+	// Handle error(s)
 	//
-	if len(term) < 1 {
-		err = errors.New("missing search term")
+	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
 	//
 	// Annoying struct to allow us to populate our template
-	// with both the matching nodes, and the term used for the search
+	// with both the reports and the fqdn of the host.
 	//
 	type Pagedata struct {
-		Nodes     []PuppetRuns
-		Term      string
-		Urlprefix string
+		Fqdn             string
+		State            string
+		Annotation       NodeAnnotation
+		Acknowledgements []Acknowledgement
+		Nodes            []PuppetReportSummary
+		Timings          []PuppetTimingSeries
+		Urlprefix        string
 	}
 
 	//
-	// Get all known nodes.
+	// Get the time taken by each category, for the graph.
 	//
-	NodeList, err := getIndexNodes("")
+	timings, err := getNodeTimings(fqdn, reports)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
 	//
-	// Populate this structure with the search-term
+	// The current state of the node, and whatever covers it.
+	//
+	state := ""
+	nodes, err := getNodes(NodeFilter{Fqdn: fqdn})
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+	for _, node := range nodes {
+		if node.Fqdn == fqdn {
+			state = node.State
+		}
+	}
+
+	acks, err := getNodeAcknowledgements(fqdn)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	annotation, err := getAnnotation(fqdn)
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	//
+	// Populate this structure.
 	//
 	var x Pagedata
-	x.Term = term
+	x.Nodes = reports
+	x.Fqdn = fqdn
+	x.State = state
+	x.Annotation = annotation
+	x.Acknowledgements = acks
+	x.Timings = timings
 	x.Urlprefix = templateArgs.urlprefix
 
 	//
-	// Add in any nodes which match our term.
+	// Accept either a "?accept=XXX" URL-parameter, or
+	// the Accept HEADER in the HTTP request
 	//
-	for _, o := range NodeList {
-		if strings.Contains(o.Fqdn, term) {
-			x.Nodes = append(x.Nodes, o)
-		}
+	accept := req.FormValue("accept")
+	if len(accept) < 1 {
+		accept = req.Header.Get("Accept")
 	}
 
 	//
-	// Load our template source.
+	// The node, as exported.
 	//
-	tmpl, err := getResource("data/results.template")
-	if err != nil {
-		fmt.Fprint(res, err.Error())
-		return
-	}
+	node := PuppetNode{Fqdn: fqdn, State: state, NodeAnnotation: annotation, Reports: reports}
+
+	switch accept {
+	case "application/json":
+		js, err := json.Marshal(node)
+
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.Write(js)
+
+	case "application/xml":
+		x, err := xml.MarshalIndent(node, "", "  ")
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/xml")
+		res.Write(x)
+	default:
+
+		//
+		// Load our template resource.
+		//
+		tmpl, err := getResource("data/node.template")
+		if err != nil {
+			fmt.Fprint(res, err.Error())
+			return
+		}
+
+		funcMap := template.FuncMap{
+
+			"incr": func(d int) string {
+
+				//
+				// Return the incremented string.
+				//
+				s := fmt.Sprintf("%d", (d + 1))
+				return s
+			},
+		}
 
-	//
-	//  Load our template, from the resource.
-	//
-	src := string(tmpl)
-	t := template.Must(template.New("tmpl").Parse(src))
+		//
+		//  Load our template, from the resource.
+		//
+		src := string(tmpl)
+		t := template.Must(template.New("tmpl").Funcs(funcMap).Parse(src))
 
-	//
-	// Execute the template into our buffer.
-	//
-	buf := &bytes.Buffer{}
-	err = t.Execute(buf, x)
+		//
+		// Execute the template into our buffer.
+		//
+		buf := &bytes.Buffer{}
+		err = t.Execute(buf, x)
 
-	//
-	// If there were errors, then show them.
-	if err != nil {
-		fmt.Fprint(res, err.Error())
-		return
-	}
+		//
+		// If there were errors, then show them.
+		if err != nil {
+			fmt.Fprint(res, err.Error())
+			return
+		}
 
-	//
-	// Otherwise write the result.
-	//
-	buf.WriteTo(res)
+		//
+		// Otherwise write the result.
+		//
+		buf.WriteTo(res)
+	}
 }
 
 //
-// ReportHandler is the handler for the HTTP end-point
+// AnalyticsHandler is the handler for the HTTP end-point
 //
-//	 GET /report/NN
+//	 GET /analytics
+//
+// It shows the average time taken by each stage of the puppet runs, for
+// each environment, by hour.  The period shown may be changed via the
+// `hours` parameter, and limited to a single environment via the
+// `environment` parameter.
 //
 // It will respond in either HTML, JSON, or XML depending on the
 // Accepts-header which is received.
 //
-func ReportHandler(res http.ResponseWriter, req *http.Request) {
+func AnalyticsHandler(res http.ResponseWriter, req *http.Request) {
 	var (
 		status int
 		err    error
@@ -528,57 +2016,48 @@ func ReportHandler(res http.ResponseWriter, req *http.Request) {
 		}
 	}()
 
-	//
-	// Get the node name we're going to show.
-	//
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	//
-	// Ensure we received a parameter.
-	//
-	if len(id) < 1 {
-		status = http.StatusNotFound
-		err = errors.New("missing 'id' parameter")
+	filter, err := requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
 		return
 	}
+	environment := filter.Environment
 
 	//
-	// If the ID is non-numeric we're in trouble.
+	// The number of hours to show, defaulting to two days.
 	//
-	reg, _ := regexp.Compile("^([0-9]+)$")
-	if !reg.MatchString(id) {
-		status = http.StatusInternalServerError
-		err = errors.New("the report ID must be numeric")
-		return
+	hours := 48
+	if len(req.FormValue("hours")) > 0 {
+		hours, err = strconv.Atoi(req.FormValue("hours"))
+		if err != nil || hours < 1 || hours > 24*31 {
+			status = http.StatusBadRequest
+			err = errors.New("the 'hours' parameter must be between 1 and 744")
+			return
+		}
 	}
 
-	//
-	// Get the content.
-	//
-	content, err := getYAML(ReportPrefix, id)
+	since := time.Now().Add(-time.Duration(hours) * time.Hour).Unix()
+
+	latency, err := getLatency(environment, since, true)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
-	// need generic struct
-	type Pagedata struct {
-		Report    PuppetReport
-		Urlprefix string
-	}
-
 	//
-	// Parse it
+	// The data we serve, or pass to our template.
 	//
-	report, err := ParsePuppetReport(content)
-	if err != nil {
-		status = http.StatusInternalServerError
-		return
+	type Pagedata struct {
+		Environment string
+		Hours       int
+		Latency     []PuppetLatency
+		Urlprefix   string `json:"-" xml:"-"`
 	}
 
 	var x Pagedata
-	x.Report = report
+	x.Environment = environment
+	x.Hours = hours
+	x.Latency = latency
 	x.Urlprefix = templateArgs.urlprefix
 
 	//
@@ -592,7 +2071,7 @@ func ReportHandler(res http.ResponseWriter, req *http.Request) {
 
 	switch accept {
 	case "application/json":
-		js, err := json.Marshal(report)
+		js, err := json.Marshal(x)
 
 		if err != nil {
 			status = http.StatusInternalServerError
@@ -602,43 +2081,29 @@ func ReportHandler(res http.ResponseWriter, req *http.Request) {
 		res.Write(js)
 
 	case "application/xml":
-		x, err := xml.MarshalIndent(report, "", "  ")
+		out, err := xml.MarshalIndent(x, "", "  ")
 		if err != nil {
 			status = http.StatusInternalServerError
 			return
 		}
 
 		res.Header().Set("Content-Type", "application/xml")
-		res.Write(x)
+		res.Write(out)
 	default:
 
 		//
 		// Load our template resource.
 		//
-		tmpl, err := getResource("data/report.template")
+		tmpl, err := getResource("data/analytics.template")
 		if err != nil {
 			fmt.Fprint(res, err.Error())
 			return
 		}
 
-		//
-		// Helper to allow a float to be truncated
-		// to two/three digits.
-		//
 		funcMap := template.FuncMap{
 
-			"truncate": func(s string) string {
-
-				//
-				// Parse as a float.
-				//
-				f, _ := strconv.ParseFloat(s, 64)
-
-				//
-				// Output to a truncated string
-				//
-				s = fmt.Sprintf("%.2f", f)
-				return s
+			"truncate": func(f float64) string {
+				return fmt.Sprintf("%.2f", f)
 			},
 		}
 
@@ -669,14 +2134,18 @@ func ReportHandler(res http.ResponseWriter, req *http.Request) {
 }
 
 //
-// NodeHandler is the handler for the HTTP end-point
+// SlowestHandler is the handler for the HTTP end-point
 //
-//	 GET /node/$FQDN
+//	 GET /slowest
+//
+// It shows the resource-types, and resources, which take the longest
+// to apply across all our nodes.  The results may be limited to a single
+// environment via the `environment` parameter.
 //
 // It will respond in either HTML, JSON, or XML depending on the
 // Accepts-header which is received.
 //
-func NodeHandler(res http.ResponseWriter, req *http.Request) {
+func SlowestHandler(res http.ResponseWriter, req *http.Request) {
 	var (
 		status int
 		err    error
@@ -692,58 +2161,41 @@ func NodeHandler(res http.ResponseWriter, req *http.Request) {
 		}
 	}()
 
-	//
-	// Get the node name we're going to show.
-	//
-	vars := mux.Vars(req)
-	fqdn := vars["fqdn"]
-
-	//
-	// Ensure we received a parameter.
-	//
-	if len(fqdn) < 1 {
-		status = http.StatusNotFound
-		err = errors.New("missing 'fqdn' parameter")
+	filter, err := requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
 		return
 	}
+	environment := filter.Environment
 
 	//
-	// Get the reports
-	//
-	reports, err := getReports(fqdn)
-
-	//
-	// Ensure that something was present.
+	// Get the data.
 	//
-	if (reports == nil) || (len(reports) < 1) {
-		status = http.StatusNotFound
+	types, err := getSlowestTypes(environment)
+	if err != nil {
+		status = http.StatusInternalServerError
 		return
 	}
-
-	//
-	// Handle error(s)
-	//
+	resources, err := getSlowestResources(environment, 50)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
 	//
-	// Annoying struct to allow us to populate our template
-	// with both the reports and the fqdn of the host.
+	// The data we serve, or pass to our template.
 	//
 	type Pagedata struct {
-		Fqdn      string
-		Nodes     []PuppetReportSummary
-		Urlprefix string
+		Environment string
+		Types       []PuppetSlowType
+		Resources   []PuppetSlowResource
+		Urlprefix   string `json:"-" xml:"-"`
 	}
 
-	//
-	// Populate this structure.
-	//
 	var x Pagedata
-	x.Nodes = reports
-	x.Fqdn = fqdn
+	x.Environment = environment
+	x.Types = types
+	x.Resources = resources
 	x.Urlprefix = templateArgs.urlprefix
 
 	//
@@ -757,7 +2209,7 @@ func NodeHandler(res http.ResponseWriter, req *http.Request) {
 
 	switch accept {
 	case "application/json":
-		js, err := json.Marshal(reports)
+		js, err := json.Marshal(x)
 
 		if err != nil {
 			status = http.StatusInternalServerError
@@ -767,20 +2219,20 @@ func NodeHandler(res http.ResponseWriter, req *http.Request) {
 		res.Write(js)
 
 	case "application/xml":
-		x, err := xml.MarshalIndent(reports, "", "  ")
+		out, err := xml.MarshalIndent(x, "", "  ")
 		if err != nil {
 			status = http.StatusInternalServerError
 			return
 		}
 
 		res.Header().Set("Content-Type", "application/xml")
-		res.Write(x)
+		res.Write(out)
 	default:
 
 		//
 		// Load our template resource.
 		//
-		tmpl, err := getResource("data/node.template")
+		tmpl, err := getResource("data/slowest.template")
 		if err != nil {
 			fmt.Fprint(res, err.Error())
 			return
@@ -788,13 +2240,8 @@ func NodeHandler(res http.ResponseWriter, req *http.Request) {
 
 		funcMap := template.FuncMap{
 
-			"incr": func(d int) string {
-
-				//
-				// Return the incremented string.
-				//
-				s := fmt.Sprintf("%d", (d + 1))
-				return s
+			"truncate": func(f float64) string {
+				return fmt.Sprintf("%.2f", f)
 			},
 		}
 
@@ -885,10 +2332,13 @@ func IndexHandler(res http.ResponseWriter, req *http.Request) {
 	}()
 
 	//
-	// Check if we are filtering by environment
+	// Check if we are filtering by environment, state, name, or facts.
 	//
-	vars := mux.Vars(req)
-	environment := vars["environment"]
+	filter, err := requestFilter(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
 
 	//
 	// Annoying struct to allow us to populate our template
@@ -896,27 +2346,62 @@ func IndexHandler(res http.ResponseWriter, req *http.Request) {
 	//
 	type Pagedata struct {
 		Graph        []PuppetHistory
+		Bucket       string
 		Nodes        []PuppetRuns
+		Group        string
+		Groups       []FactGroup
 		Environment  string
 		Environments []string
+		NodeGroup    string
+		NodeGroups   []string
+		Owner        string
+		Owners       bool
 		Urlprefix    string
 	}
 
+	//
+	// The size of the buckets in our graph, and the range it covers.
+	//
+	bucket, from, to, err := historyRange(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
 	//
 	// Get the nodes to show on our front-page
 	//
-	NodeList, err := getIndexNodes(environment)
+	NodeList, err := getNodes(filter)
 	if err != nil {
 		status = http.StatusInternalServerError
 		return
 	}
 
+	//
+	// Group them by a fact, if requested.
+	//
+	group, err := requestGroupBy(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	var groups []FactGroup
+	if len(group) > 0 {
+		err = setNodeGroups(NodeList, group)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return
+		}
+		groups = groupNodes(NodeList)
+	}
+
 	//
 	// Get the graph-data
 	//
-	graphs, err := getHistory(environment, 30)
+	graphs, err := getFilteredHistory(filter, bucket, from.Unix(), to.Unix())
 	if err != nil {
-		status = http.StatusInternalServerError
+		status = http.StatusBadRequest
 		return
 	}
 
@@ -929,11 +2414,26 @@ func IndexHandler(res http.ResponseWriter, req *http.Request) {
 	//
 	var x Pagedata
 	x.Graph = graphs
+	x.Bucket = bucket
 	x.Nodes = NodeList
-	x.Environment = environment
+	x.Group = group
+	x.Groups = groups
+	x.Environment = filter.Environment
 	x.Environments = environments
+	x.NodeGroup = filter.Group
+	x.NodeGroups = currentSettings().Groups.Names()
+	x.Owner = filter.Owner
 	x.Urlprefix = templateArgs.urlprefix
 
+	//
+	// Owners are only shown if some node has one.
+	//
+	for _, node := range NodeList {
+		if len(node.Owner) > 0 {
+			x.Owners = true
+		}
+	}
+
 	//
 	// Accept either a "?accept=XXX" URL-parameter, or
 	// the Accept HEADER in the HTTP request
@@ -1003,7 +2503,7 @@ func IndexHandler(res http.ResponseWriter, req *http.Request) {
 //
 //  Entry-point.
 //
-func serve(settings serveCmd) {
+func serve(settings serveCmd, r *reloader) {
 	templateArgs.urlprefix = settings.urlprefix
 
 	//
@@ -1022,11 +2522,29 @@ func serve(settings serveCmd) {
 	//
 	router.NotFoundHandler = http.HandlerFunc(StaticHandler)
 
+	//
+	// Liveness and readiness checks.
+	//
+	router.HandleFunc("/healthz", HealthHandler).Methods("GET")
+	router.HandleFunc("/readyz", ReadyHandler).Methods("GET")
+
 	//
 	// API end-points
 	//
 	router.HandleFunc("/api/state/{state}/", APIState).Methods("GET")
 	router.HandleFunc("/api/state/{state}", APIState).Methods("GET")
+	router.HandleFunc("/api/v1/history/", APIHistory).Methods("GET")
+	router.HandleFunc("/api/v1/history", APIHistory).Methods("GET")
+	router.HandleFunc("/api/v1/export/", APIExport).Methods("GET")
+	router.HandleFunc("/api/v1/export", APIExport).Methods("GET")
+	router.HandleFunc("/api/v1/facts/{fqdn}", APIFacts).Methods("GET")
+
+	//
+	// A subset of the PuppetDB query API.
+	//
+	router.HandleFunc("/pdb/query/v4/{entity:nodes}/{certname}", PDBQuery).Methods("GET")
+	router.HandleFunc("/pdb/query/v4/reports/{hash}/events", PDBQuery).Methods("GET")
+	router.HandleFunc("/pdb/query/v4/{entity}", PDBQuery).Methods("GET")
 
 	//
 	//
@@ -1040,11 +2558,18 @@ func serve(settings serveCmd) {
 	router.HandleFunc("/upload/", ReportSubmissionHandler).Methods("POST")
 	router.HandleFunc("/upload", ReportSubmissionHandler).Methods("POST")
 
+	//
+	// Upload the facts of a node.
+	//
+	router.HandleFunc("/upload/facts/", FactSubmissionHandler).Methods("POST")
+	router.HandleFunc("/upload/facts", FactSubmissionHandler).Methods("POST")
+	router.HandleFunc("/upload/facts/{fqdn}", FactSubmissionHandler).Methods("POST", "PUT")
+
 	//
 	// Search nodes.
 	//
-	router.HandleFunc("/search/", SearchHandler).Methods("POST")
-	router.HandleFunc("/search", SearchHandler).Methods("POST")
+	router.HandleFunc("/search/", SearchHandler).Methods("GET", "POST")
+	router.HandleFunc("/search", SearchHandler).Methods("GET", "POST")
 
 	//
 	// Show the recent state of a node.
@@ -1052,6 +2577,30 @@ func serve(settings serveCmd) {
 	router.HandleFunc("/node/{fqdn}/", NodeHandler).Methods("GET")
 	router.HandleFunc("/node/{fqdn}", NodeHandler).Methods("GET")
 
+	//
+	// Acknowledge nodes, and schedule windows of maintenance.
+	//
+	router.HandleFunc("/node/{fqdn}/acknowledge", AcknowledgeHandler).Methods("POST")
+	router.HandleFunc("/node/{fqdn}/annotations", AnnotationHandler).Methods("POST")
+	router.HandleFunc("/api/v1/annotations/{fqdn}", AnnotationHandler).Methods("GET", "PUT", "DELETE")
+	router.HandleFunc("/maintenance/", MaintenanceHandler).Methods("GET")
+	router.HandleFunc("/maintenance", MaintenanceHandler).Methods("GET")
+	router.HandleFunc("/maintenance", AcknowledgeHandler).Methods("POST")
+	router.HandleFunc("/maintenance/{id}", UnacknowledgeHandler).Methods("DELETE")
+	router.HandleFunc("/maintenance/{id}/delete", UnacknowledgeHandler).Methods("POST")
+
+	//
+	// Show the time taken by each stage of the runs, by hour.
+	//
+	router.HandleFunc("/analytics/", AnalyticsHandler).Methods("GET")
+	router.HandleFunc("/analytics", AnalyticsHandler).Methods("GET")
+
+	//
+	// Show the slowest resources, across all nodes.
+	//
+	router.HandleFunc("/slowest/", SlowestHandler).Methods("GET")
+	router.HandleFunc("/slowest", SlowestHandler).Methods("GET")
+
 	//
 	// Show "everything" about a given run.
 	//
@@ -1065,6 +2614,8 @@ func serve(settings serveCmd) {
 	// also do it for environments
 	router.HandleFunc("/environment/{environment}/", IndexHandler).Methods("GET")
 	router.HandleFunc("/environment/{environment}", IndexHandler).Methods("GET")
+	router.HandleFunc("/group/{group}/", IndexHandler).Methods("GET")
+	router.HandleFunc("/group/{group}", IndexHandler).Methods("GET")
 
 	//
 	// Bind the router.
@@ -1089,16 +2640,97 @@ func serve(settings serveCmd) {
 	srv := &http.Server{
 		Addr:         bind,
 		Handler:      loggedRouter,
-		ReadTimeout:  300 * time.Second,
+		ReadTimeout:  UploadTimeout,
 		WriteTimeout: 300 * time.Second,
 	}
 
+	//
+	// Reload our configuration when we receive SIGHUP, and shutdown
+	// gracefully when we receive any other signal.
+	//
+	done := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		for sig := range signals {
+			if sig == syscall.SIGHUP {
+				fmt.Printf("Received %s, reloading configuration\n", sig)
+				reload(r)
+				continue
+			}
+
+			fmt.Printf("Received %s, shutting down\n", sig)
+			shutdown(srv, r, settings.shutdownTimeout)
+			close(done)
+			return
+		}
+	}()
+
 	//
 	// Launch the server.
 	//
 	err := srv.ListenAndServe()
-	if err != nil {
+	if err != nil && err != http.ErrServerClosed {
 		fmt.Printf("\nError: %s\n", err.Error())
+		return
+	}
+
+	<-done
+}
+
+//
+// reload re-reads our configuration, and applies it.
+//
+// If the configuration is invalid we keep the settings we have.
+//
+func reload(r *reloader) {
+
+	c, err := config.Reload()
+	if err == nil {
+		var changes []string
+		changes, err = r.Reload(c)
+		for _, change := range changes {
+			fmt.Printf("Configuration reload: %s\n", change)
+		}
+	}
+	if err != nil {
+		fmt.Printf("Configuration reload failed, keeping the current settings: %s\n", err.Error())
+		return
+	}
+
+	config = c
+	fmt.Printf("Configuration reloaded\n")
+}
+
+//
+// shutdown stops the server gracefully.
+//
+// We stop accepting uploads, wait for the requests which are in-flight,
+// and any background jobs, to complete, then write any pending reports
+// and close the database.
+//
+func shutdown(srv *http.Server, r *reloader, timeout time.Duration) {
+
+	startDraining()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := srv.Shutdown(ctx)
+	if err != nil {
+		fmt.Printf("Error waiting for requests to complete: %s\n", err.Error())
+	}
+
+	r.Stop()
+
+	if writer != nil {
+		writer.Close()
+	}
+
+	err = CloseDB()
+	if err != nil {
+		fmt.Printf("Error closing database: %s\n", err.Error())
 	}
 }
 
@@ -1106,12 +2738,24 @@ func serve(settings serveCmd) {
 // The options set by our command-line flags.
 //
 type serveCmd struct {
-	autoPrune bool
-	bindHost  string
-	bindPort  int
-	dbFile    string
-	prefix    string
-	urlprefix string
+	autoPrune       bool
+	batchSize       int
+	batchLatency    time.Duration
+	bindHost        string
+	bindPort        int
+	dbFile          string
+	groupRules      string
+	maxReportSize   int64
+	minFreeSpace    int64
+	orphanedAfter   time.Duration
+	prefix          string
+	pruneSchedule   string
+	redactRules     string
+	retentionRules  string
+	shutdownTimeout time.Duration
+	sourceRules     string
+	streamThreshold int64
+	urlprefix       string
 }
 
 type templateOptions struct {
@@ -1136,10 +2780,22 @@ func (*serveCmd) Usage() string {
 //
 func (p *serveCmd) SetFlags(f *flag.FlagSet) {
 	f.IntVar(&p.bindPort, "port", 3001, "The port to bind upon.")
-	f.BoolVar(&p.autoPrune, "auto-prune", false, "Prune reports automatically, once per week.")
+	f.BoolVar(&p.autoPrune, "auto-prune", false, "Prune reports automatically, by default once per week.")
+	f.IntVar(&p.batchSize, "batch-size", 100, "The maximum number of reports to insert in a single transaction.")
+	f.DurationVar(&p.batchLatency, "batch-latency", 100*time.Millisecond, "The maximum time a report will wait for others to share its transaction.")
 	f.StringVar(&p.bindHost, "host", "127.0.0.1", "The IP to listen upon.")
 	f.StringVar(&p.dbFile, "db-file", "ps.db", "The SQLite database to use.")
+	f.StringVar(&p.groupRules, "group-rules", "", "A file of rules describing groups of nodes, by their names.")
+	f.Int64Var(&p.maxReportSize, "max-report-size", 128, "The size of the largest report we'll accept, in megabytes.")
+	f.Int64Var(&p.minFreeSpace, "min-free-space", 100, "The space, in megabytes, which must be free beneath the prefix for us to be ready.")
+	f.DurationVar(&p.orphanedAfter, "orphaned-after", 84*time.Hour, "Nodes which haven't reported for this long are orphaned.")
 	f.StringVar(&p.prefix, "prefix", "./reports/", "The prefix to the local YAML hierarchy.")
+	f.StringVar(&p.pruneSchedule, "prune-schedule", "@weekly", "When to prune reports, if -auto-prune or -retention-rules are set.")
+	f.StringVar(&p.redactRules, "redact-rules", "", "A file of rules used to mask secrets within reports.")
+	f.StringVar(&p.retentionRules, "retention-rules", "", "A file of rules describing the reports to keep, applied upon the prune-schedule.")
+	f.DurationVar(&p.shutdownTimeout, "shutdown-timeout", 30*time.Second, "The time to wait for requests to complete when shutting down.")
+	f.StringVar(&p.sourceRules, "source-rules", "", "A file of rules linking the manifests named by reports to a repository browser.")
+	f.Int64Var(&p.streamThreshold, "stream-threshold", 16, "Reports larger than this many megabytes are parsed without being loaded into memory.")
 	f.StringVar(&p.urlprefix, "urlprefix", "", "The URL prefix for serving behind a proxy.")
 }
 
@@ -1148,6 +2804,16 @@ func (p *serveCmd) SetFlags(f *flag.FlagSet) {
 //
 func (p *serveCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
 
+	//
+	// Settings not given on the command-line come from our configuration,
+	// which may be reloaded later.
+	//
+	given := commandLine(f)
+	if err := config.Apply(p.Name(), f); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+
 	//
 	// Setup the database, by opening a handle, and creating it if
 	// missing.
@@ -1160,33 +2826,35 @@ func (p *serveCmd) Execute(_ context.Context, f *flag.FlagSet, _ ...interface{})
 	populateEnvironment(p.prefix)
 
 	//
-	// If autoprune
+	// Setup our submission limits, and load our redaction rules.
 	//
-	if p.autoPrune {
-
-		//
-		// Create a cron scheduler
-		//
-		c := cron.New()
+	settings, err := newSettings(*p)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err.Error())
+		return subcommands.ExitFailure
+	}
+	storeSettings(settings)
 
-		//
-		//  Every seven days prune the reports.
-		//
-		c.AddFunc("@weekly", func() {
-			fmt.Printf("Automatically pruning old reports")
-			pruneReports("", p.prefix, 7, false)
-		})
+	//
+	// Submitted reports are written to the database in batches.
+	//
+	writer = newReportWriter(p.batchSize, p.batchLatency)
 
-		//
-		// Launch the cron-scheduler.
-		//
-		c.Start()
+	//
+	// Create, and launch, the scheduler for our background jobs, which
+	// will prune reports if that was requested.
+	//
+	jobs, err := newJobs(*p)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err.Error())
+		return subcommands.ExitFailure
 	}
+	jobs.Start()
 
 	//
 	// Start the server
 	//
-	serve(*p)
+	serve(*p, newReloader(given, f, jobs))
 
 	//
 	// All done.
@@ -11,7 +11,9 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 	"unicode"
 
 	"github.com/gorilla/mux"
@@ -122,6 +124,9 @@ func TestReportView(t *testing.T) {
 	//
 	tests := []TestCase{
 		{"text/html", "Report of execution against www.steve.org.uk in production, at 2017-07-29 23:17:01"},
+		{"text/html", "Time by Category"},
+		{"text/html", "<span class=\"label label-info\" title=\"2017-07-29T23:17:09.679987918&#43;00:00\">notice</span>"},
+		{"application/json", "\"Logs\":[{\"Level\":\"notice\",\"Message\":\"Tidying 0 files\","},
 		{"application/json", "\"State\":\"unchanged\","},
 		{"application/xml", "<State>unchanged</State>"}}
 
@@ -182,6 +187,78 @@ func TestReportView(t *testing.T) {
 	os.RemoveAll(path)
 }
 
+//
+// Reports whose bodies have expired are shown as such.
+//
+func TestReportExpired(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeReports()
+
+	id, _ := validReportID()
+	_, err := db.Exec("UPDATE reports SET yaml_file='pruned', state='failed' WHERE id=?", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type TestCase struct {
+		Type     string
+		Response string
+	}
+
+	tests := []TestCase{
+		{"text/html", "has been removed by our retention policy"},
+		{"text/html", "<tr><td>State </td><td>failed</td></tr>"},
+		{"application/json", "report body expired"},
+		{"application/xml", "report body expired"}}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/report/{id}", ReportHandler).Methods("GET")
+
+	for _, test := range tests {
+		req, err := http.NewRequest("GET", fmt.Sprintf("/report/%d", id), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Add("Accept", test.Type)
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusGone {
+			t.Errorf("Unexpected status-code: %v", status)
+		}
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Errorf("Unexpected body: '%s'", rr.Body.String())
+		}
+	}
+
+	//
+	// A missing file is treated the same way.
+	//
+	_, err = db.Exec("UPDATE reports SET yaml_file='missing.yaml' WHERE id=?", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/report/%d", id), nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusGone {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
 // API state must be known.
 func TestKnownAPIState(t *testing.T) {
 
@@ -337,10 +414,16 @@ func TestAPITypes(t *testing.T) {
 
 }
 
-// Searching must be done via a POST.
+// Searches may be made via a GET, so that they can be shared.
 func TestSearchMethod(t *testing.T) {
 
-	req, err := http.NewRequest("GET", "/search", nil)
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeNodes()
+
+	req, err := http.NewRequest("GET", "/search?q="+url.QueryEscape("state:failed example"), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -351,17 +434,23 @@ func TestSearchMethod(t *testing.T) {
 	handler.ServeHTTP(rr, req)
 
 	// Check the status code is what we expect.
-	if status := rr.Code; status != http.StatusInternalServerError {
+	if status := rr.Code; status != http.StatusOK {
 		t.Errorf("Unexpected status-code: %v", status)
 	}
 
 	// Check the response body is what we expect.
-	expected := "must be called via HTTP-POST\n"
-	if rr.Body.String() != expected {
-		t.Errorf("handler returned unexpected body: got '%v' want '%v'",
-			rr.Body.String(), expected)
+	if !strings.Contains(rr.Body.String(), "/node/bar.example.com") ||
+		strings.Contains(rr.Body.String(), "/node/foo.example.com") {
+		t.Errorf("Unexpected body: '%s'", rr.Body.String())
 	}
 
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
 }
 
 // The search handler must have a term-parameter.
@@ -593,6 +682,115 @@ func TestUploadBogusReport(t *testing.T) {
 	os.RemoveAll(path)
 }
 
+// Submitting a report larger than our limit should fail.
+func TestUploadTooLarge(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Ensure we point our report-upload directory at
+	// our temporary location.
+	ReportPrefix = path
+
+	//
+	// Lower our limit to something smaller than our sample.
+	//
+	bak := currentSettings()
+	settings := *bak
+	settings.MaxReportSize = 1024
+	storeSettings(&settings)
+	defer storeSettings(bak)
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest("POST", "/upload", bytes.NewReader(tmpl))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(ReportSubmissionHandler)
+	handler.ServeHTTP(rr, req)
+
+	// Check the status code is what we expect.
+	if status := rr.Code; status != http.StatusRequestEntityTooLarge {
+		t.Errorf("Unexpected status-code: %v", status)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+// Submitting a report larger than our streaming threshold should
+// succeed, and the report should be stored intact.
+func TestUploadStreamed(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Ensure we point our report-upload directory at
+	// our temporary location.
+	ReportPrefix = path
+
+	//
+	// Lower our threshold to something smaller than our sample.
+	//
+	bak := currentSettings()
+	settings := *bak
+	settings.StreamThreshold = 1024
+	storeSettings(&settings)
+	defer storeSettings(bak)
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest("POST", "/upload", bytes.NewReader(tmpl))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(ReportSubmissionHandler)
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Errorf("Unexpected status-code: %v %s", status, rr.Body.String())
+	}
+	if rr.Body.String() != "{\"host\":\"www.steve.org.uk\"}" {
+		t.Errorf("Unexpected body: %s", rr.Body.String())
+	}
+
+	//
+	// The stored copy should be identical to what we sent.
+	//
+	id, _ := validReportID()
+	content, err := getYAML(ReportPrefix, strconv.Itoa(id))
+	if err != nil {
+		t.Fatalf("Failed to load stored report: %s", err.Error())
+	}
+	if !bytes.Equal(content, tmpl) {
+		t.Errorf("Stored report differs from the submission")
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
 // Unknown-nodes are handled.
 func TestUnknownNode(t *testing.T) {
 
@@ -731,19 +929,14 @@ func TestKnownNode(t *testing.T) {
 
 }
 
-// Test that our index-view returns content that seems reasonable,
-// in all three cases:
-//
-//   - text/html
-//   - application/json
-//   - application/xml
-func TestIndexView(t *testing.T) {
+// The slowest resources are shown in all three formats.
+func TestSlowestView(t *testing.T) {
 
 	// Create a fake database
 	FakeDB()
 
 	// Add some data.
-	addFakeNodes()
+	addTimedReport(t, "slow.example.com")
 
 	//
 	// We'll make one test for each supported content-type
@@ -757,30 +950,35 @@ func TestIndexView(t *testing.T) {
 	// The tests
 	//
 	tests := []TestCase{
-		{"text/html", "foo.example.com"},
-		{"application/json", "\"State\":\"failed\","},
-		{"application/xml", "<PuppetRuns>"}}
+		{"text/html", "Config retrieval"},
+		{"application/json", "\"Name\":\"config_retrieval\","},
+		{"application/xml", "<Name>config_retrieval</Name>"}}
 
 	//
 	// Run each one.
 	//
 	for _, test := range tests {
 
+		//
+		// Create a router.
+		//
+		router := mux.NewRouter()
+		router.HandleFunc("/slowest", SlowestHandler).Methods("GET")
+
 		//
 		// Make the request, with the appropriate Accept: header
 		//
-		req, err := http.NewRequest("GET", "/", nil)
+		req, err := http.NewRequest("GET", "/slowest", nil)
 		if err != nil {
 			t.Fatal(err)
 		}
 		req.Header.Add("Accept", test.Type)
 
 		//
-		// Fake it out
+		// Fake out the request
 		//
 		rr := httptest.NewRecorder()
-		handler := http.HandlerFunc(IndexHandler)
-		handler.ServeHTTP(rr, req)
+		router.ServeHTTP(rr, req)
 
 		//
 		// Test the status-code is OK
@@ -804,74 +1002,327 @@ func TestIndexView(t *testing.T) {
 	db.Close()
 	db = nil
 	os.RemoveAll(path)
-
 }
 
-// Test that static-resources work:
-//
-//  1. They produce content
-//  2. They have sensible MIME-types
-func TestStaticResources(t *testing.T) {
+// The analytics view is shown in all three formats.
+func TestAnalyticsView(t *testing.T) {
 
-	// Test-cases
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addTimedReport(t, "one.example.com")
+
+	//
+	// We'll make one test for each supported content-type
+	//
 	type TestCase struct {
-		path string
-		mime string
+		Type     string
+		Response string
 	}
 
+	//
+	// The tests
+	//
 	tests := []TestCase{
-		TestCase{path: "/favicon.ico", mime: "image/vnd.microsoft.icon"},
-		TestCase{path: "/robots.txt", mime: "text/plain"},
-		TestCase{path: "/js/jquery.tablesorter.min.js", mime: "text/javascript"},
-		TestCase{path: "/css/bootstrap.min.css", mime: "text/css"},
-		TestCase{path: "/fonts/glyphicons-halflings-regular.woff2", mime: "font/woff2"},
-	}
-
-	// Wire up the router.
-	r := mux.NewRouter()
-	r.NotFoundHandler = http.HandlerFunc(StaticHandler)
+		{"text/html", "Catalog Compilation (s)"},
+		{"application/json", "\"Environment\":\"production\","},
+		{"application/xml", "<Environment>production</Environment>"}}
 
+	//
+	// Run each one.
+	//
 	for _, test := range tests {
 
-		// Get the test-server
-		ts := httptest.NewServer(r)
-		defer ts.Close()
-
-		// Make a request
-		url := ts.URL + test.path
+		//
+		// Create a router.
+		//
+		router := mux.NewRouter()
+		router.HandleFunc("/analytics", AnalyticsHandler).Methods("GET")
 
-		resp, err := http.Get(url)
+		//
+		// Make the request, with the appropriate Accept: header
+		//
+		req, err := http.NewRequest("GET", "/analytics", nil)
 		if err != nil {
 			t.Fatal(err)
 		}
-
-		defer resp.Body.Close()
-		body, err := ioutil.ReadAll(resp.Body)
-
-		if err != nil {
-			t.Errorf("Failed to read response-body %v\n", err)
-		}
-
-		if len(body) < 10 {
-			t.Errorf("too-short body reading %s: %d\n", test.path, len(body))
-		}
+		req.Header.Add("Accept", test.Type)
 
 		//
-		// Test that the content-type was what we expect.
+		// Fake out the request
 		//
-		headers := resp.Header
-		ctype := headers["Content-Type"][0]
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
 
-		// Content-type might have a character-set, so we can
-		// expect either of these:
 		//
-		//    Content-Type: text/plain
-		//    Content-Type: text/css; charset=utf-8
+		// Test the status-code is OK
 		//
-		// Strip anything after the ";" to avoid caring about this
-		if strings.Contains(ctype, ";") {
-			pieces := strings.Split(ctype, ";")
-			ctype = pieces[0]
+		if status := rr.Code; status != http.StatusOK {
+			t.Errorf("Unexpected status-code: %v", status)
+		}
+
+		//
+		// Test that the body contained our expected content.
+		//
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Fatalf("Unexpected body: '%s'", rr.Body.String())
+		}
+	}
+
+	//
+	// A bogus period is rejected.
+	//
+	req, err := http.NewRequest("GET", "/analytics?hours=steve", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	router := mux.NewRouter()
+	router.HandleFunc("/analytics", AnalyticsHandler).Methods("GET")
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Errorf("Unexpected status-code: %v", status)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+// Secrets are masked before reports are stored, and when they are shown.
+func TestUploadRedacted(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Ensure we point our report-upload directory at
+	// our temporary location.
+	ReportPrefix = path
+
+	//
+	// Upload a report before redaction is configured.
+	//
+	upload := func(content string) {
+		req, err := http.NewRequest("POST", "/upload", strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(ReportSubmissionHandler)
+		handler.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("Unexpected status-code: %v", status)
+		}
+	}
+	upload(secretReport)
+
+	//
+	// Now configure redaction, and upload another.
+	//
+	bak := currentSettings()
+	settings := *bak
+	settings.Redactor = testRedactor(t)
+	storeSettings(&settings)
+	defer storeSettings(bak)
+
+	upload(strings.Replace(secretReport, "host: db.example.com", "host: db2.example.com", 1))
+
+	//
+	// The first report is stored verbatim, the second is not.
+	//
+	for i, expected := range []bool{true, false} {
+		content, err := getYAML(ReportPrefix, strconv.Itoa(i+1))
+		if err != nil {
+			t.Fatalf("Failed to read report: %s", err.Error())
+		}
+		if strings.Contains(string(content), "hunter2") != expected {
+			t.Errorf("Unexpected content for report %d", i+1)
+		}
+	}
+
+	//
+	// But neither shows the secret.
+	//
+	router := mux.NewRouter()
+	router.HandleFunc("/report/{id}", ReportHandler).Methods("GET")
+
+	for _, id := range []string{"1", "2"} {
+		for _, accept := range []string{"text/html", "application/json"} {
+			req, err := http.NewRequest("GET", "/report/"+id, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Accept", accept)
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != http.StatusOK {
+				t.Errorf("Unexpected status-code: %v", status)
+			}
+			if strings.Contains(rr.Body.String(), "hunter2") || strings.Contains(rr.Body.String(), "new-secret") {
+				t.Errorf("Secret shown in report %s: %s", id, rr.Body.String())
+			}
+		}
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+// Test that our index-view returns content that seems reasonable,
+// in all three cases:
+//
+//   - text/html
+//   - application/json
+//   - application/xml
+func TestIndexView(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeNodes()
+
+	//
+	// We'll make one test for each supported content-type
+	//
+	type TestCase struct {
+		Type     string
+		Response string
+	}
+
+	//
+	// The tests
+	//
+	tests := []TestCase{
+		{"text/html", "foo.example.com"},
+		{"application/json", "\"State\":\"failed\","},
+		{"application/xml", "<PuppetRuns>"}}
+
+	//
+	// Run each one.
+	//
+	for _, test := range tests {
+
+		//
+		// Make the request, with the appropriate Accept: header
+		//
+		req, err := http.NewRequest("GET", "/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Add("Accept", test.Type)
+
+		//
+		// Fake it out
+		//
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(IndexHandler)
+		handler.ServeHTTP(rr, req)
+
+		//
+		// Test the status-code is OK
+		//
+		if status := rr.Code; status != http.StatusOK {
+			t.Errorf("Unexpected status-code: %v", status)
+		}
+
+		//
+		// Test that the body contained our expected content.
+		//
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Fatalf("Unexpected body: '%s'", rr.Body.String())
+		}
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+
+}
+
+// Test that static-resources work:
+//
+//  1. They produce content
+//  2. They have sensible MIME-types
+func TestStaticResources(t *testing.T) {
+
+	// Test-cases
+	type TestCase struct {
+		path string
+		mime string
+	}
+
+	tests := []TestCase{
+		TestCase{path: "/favicon.ico", mime: "image/vnd.microsoft.icon"},
+		TestCase{path: "/robots.txt", mime: "text/plain"},
+		TestCase{path: "/js/jquery.tablesorter.min.js", mime: "text/javascript"},
+		TestCase{path: "/css/bootstrap.min.css", mime: "text/css"},
+		TestCase{path: "/fonts/glyphicons-halflings-regular.woff2", mime: "font/woff2"},
+	}
+
+	// Wire up the router.
+	r := mux.NewRouter()
+	r.NotFoundHandler = http.HandlerFunc(StaticHandler)
+
+	for _, test := range tests {
+
+		// Get the test-server
+		ts := httptest.NewServer(r)
+		defer ts.Close()
+
+		// Make a request
+		url := ts.URL + test.path
+
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+
+		if err != nil {
+			t.Errorf("Failed to read response-body %v\n", err)
+		}
+
+		if len(body) < 10 {
+			t.Errorf("too-short body reading %s: %d\n", test.path, len(body))
+		}
+
+		//
+		// Test that the content-type was what we expect.
+		//
+		headers := resp.Header
+		ctype := headers["Content-Type"][0]
+
+		// Content-type might have a character-set, so we can
+		// expect either of these:
+		//
+		//    Content-Type: text/plain
+		//    Content-Type: text/css; charset=utf-8
+		//
+		// Strip anything after the ";" to avoid caring about this
+		if strings.Contains(ctype, ";") {
+			pieces := strings.Split(ctype, ";")
+			ctype = pieces[0]
 		}
 
 		if ctype != test.mime {
@@ -955,3 +1406,472 @@ func TestRadiatorView(t *testing.T) {
 	os.RemoveAll(path)
 
 }
+
+//
+// Test the history API.
+//
+func TestHistoryAPI(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeNodes()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/history", APIHistory).Methods("GET")
+
+	//
+	// The requests we make, and the responses we expect.
+	//
+	type TestCase struct {
+		URL      string
+		Status   int
+		Response string
+	}
+
+	today := time.Now().Format("2006-01-02")
+
+	tests := []TestCase{
+		{"/api/v1/history", http.StatusOK, "{\"Date\":\"" + today + "\",\"Failed\":1,\"Changed\":1,\"Unchanged\":0}"},
+		{"/api/v1/history?bucket=week", http.StatusOK, "\"Bucket\":\"week\""},
+		{"/api/v1/history?bucket=hour&accept=application/xml", http.StatusOK, "<Failed>1</Failed>"},
+		{"/api/v1/history?from=1970-01-01&to=1970-01-03", http.StatusOK, "\"Unchanged\":1"},
+		{"/api/v1/history?from=1970-01-01&to=1970-01-03&environment=production", http.StatusOK, "\"Unchanged\":0"},
+		{"/api/v1/history?bucket=month", http.StatusBadRequest, "bucket"},
+		{"/api/v1/history?from=yesterday", http.StatusBadRequest, "invalid time"},
+		{"/api/v1/history?from=2019-03-31&to=2019-03-01", http.StatusBadRequest, "must be after"},
+		{"/api/v1/history?bucket=hour&from=0", http.StatusBadRequest, "buckets"},
+	}
+
+	for _, test := range tests {
+
+		req, err := http.NewRequest("GET", test.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != test.Status {
+			t.Errorf("Unexpected status-code for %s: %v", test.URL, status)
+		}
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Errorf("Unexpected body for %s: '%s'", test.URL, rr.Body.String())
+		}
+	}
+
+	//
+	// The index-page has a bucket selector too.
+	//
+	router.HandleFunc("/", IndexHandler).Methods("GET")
+
+	req, err := http.NewRequest("GET", "/?bucket=week", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if !strings.Contains(rr.Body.String(), "active\" href=\"?bucket=week\"") {
+		t.Errorf("Unexpected body: '%s'", rr.Body.String())
+	}
+
+	req, err = http.NewRequest("GET", "/?bucket=year", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Errorf("Unexpected status-code: %v", status)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Test the export API.
+//
+func TestExportAPI(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeNodes()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/export", APIExport).Methods("GET")
+
+	//
+	// The requests we make, and the responses we expect.
+	//
+	type TestCase struct {
+		URL         string
+		Status      int
+		ContentType string
+		Response    string
+	}
+
+	tests := []TestCase{
+		{"/api/v1/export", http.StatusOK, "text/csv", ",bar.example.com,,"},
+		{"/api/v1/export?format=ndjson&state=unchanged", http.StatusOK, "application/x-ndjson", "\"fqdn\":\"foo.example.com\",\"environment\":\"\",\"executed_at\":300,"},
+		{"/api/v1/export?format=parquet", http.StatusOK, "application/vnd.apache.parquet", "PAR1"},
+		{"/api/v1/export?from=1970-01-01&to=1970-01-02&format=ndjson", http.StatusOK, "application/x-ndjson", "\"executed_at\":300,"},
+		{"/api/v1/export?dataset=nodes", http.StatusBadRequest, "", "invalid dataset"},
+		{"/api/v1/export?format=xlsx", http.StatusBadRequest, "", "invalid format"},
+		{"/api/v1/export?environment=prod'uction", http.StatusBadRequest, "", "invalid environment"},
+		{"/api/v1/export?from=yesterday", http.StatusBadRequest, "", "invalid time"},
+	}
+
+	for _, test := range tests {
+
+		req, err := http.NewRequest("GET", test.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != test.Status {
+			t.Errorf("Unexpected status-code for %s: %v", test.URL, status)
+		}
+		if test.ContentType != "" && rr.Header().Get("Content-Type") != test.ContentType {
+			t.Errorf("Unexpected content-type for %s: %s", test.URL, rr.Header().Get("Content-Type"))
+		}
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Errorf("Unexpected body for %s: '%s'", test.URL, rr.Body.String())
+		}
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Test submitting facts, and grouping and filtering nodes by them.
+//
+func TestFacts(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeNodes()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/upload/facts", FactSubmissionHandler).Methods("POST")
+	router.HandleFunc("/upload/facts/{fqdn}", FactSubmissionHandler).Methods("POST", "PUT")
+	router.HandleFunc("/api/v1/facts/{fqdn}", APIFacts).Methods("GET")
+	router.HandleFunc("/api/state/{state}", APIState).Methods("GET")
+	router.HandleFunc("/radiator", RadiatorView).Methods("GET")
+	router.HandleFunc("/", IndexHandler).Methods("GET")
+
+	//
+	// The requests we make, and the responses we expect.
+	//
+	type TestCase struct {
+		Method   string
+		URL      string
+		Body     string
+		Accept   string
+		Status   int
+		Response string
+	}
+
+	tests := []TestCase{
+		{"POST", "/upload/facts", `{"certname": "foo.example.com", "values": {"os": {"family": "Debian"}, "role": "web"}}`, "", http.StatusOK, "{\"host\":\"foo.example.com\"}"},
+		{"PUT", "/upload/facts/bar.example.com", "name: bar.example.com\nvalues:\n  os:\n    family: RedHat\n  role: web\n", "", http.StatusOK, "{\"host\":\"bar.example.com\"}"},
+		{"PUT", "/upload/facts/baz.example.com", `{"name": "bar.example.com", "values": {"role": "db"}}`, "", http.StatusBadRequest, "not 'baz.example.com'"},
+		{"POST", "/upload/facts", `{"values": {"role": "db"}}`, "", http.StatusBadRequest, "certname"},
+
+		{"GET", "/api/v1/facts/foo.example.com", "", "", http.StatusOK, "\"family\":\"Debian\""},
+		{"GET", "/api/v1/facts/missing.example.com", "", "", http.StatusNotFound, "no facts"},
+
+		{"GET", "/api/state/failed?fact=os.family=RedHat", "", "application/json", http.StatusOK, "[\"bar.example.com\"]"},
+		{"GET", "/api/state/failed?fact=os.family=Debian", "", "application/json", http.StatusOK, "[]"},
+		{"GET", "/api/state/changed?by=os.family", "", "application/json", http.StatusOK, "[{\"Value\":\"Debian\",\"Nodes\":[\"foo.example.com\"]}]"},
+		{"GET", "/api/state/changed?by=os.family", "", "text/plain", http.StatusOK, "Debian\tfoo.example.com\n"},
+		{"GET", "/api/state/changed?fact=os.family", "", "", http.StatusBadRequest, "invalid fact"},
+		{"GET", "/api/state/changed?by=os'family", "", "", http.StatusBadRequest, "invalid fact"},
+
+		{"GET", "/radiator?by=role", "", "application/json", http.StatusOK, "{\"Value\":\"web\",\"Total\":2,"},
+		{"GET", "/radiator?by=os.family", "", "text/html", http.StatusOK, "os.family: RedHat"},
+		{"GET", "/radiator?fact=os.family=RedHat", "", "application/json", http.StatusOK, "{\"State\":\"Total\",\"Count\":1,"},
+
+		{"GET", "/?by=os.family", "", "application/json", http.StatusOK, "\"Group\":\"RedHat\""},
+		{"GET", "/?by=os.family", "", "text/html", http.StatusOK, "<th>os.family</th>"},
+		{"GET", "/?fact=role=db", "", "application/json", http.StatusOK, "null"},
+	}
+
+	for _, test := range tests {
+
+		req, err := http.NewRequest(test.Method, test.URL, strings.NewReader(test.Body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.Accept != "" {
+			req.Header.Set("Accept", test.Accept)
+		}
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if status := rr.Code; status != test.Status {
+			t.Errorf("Unexpected status-code for %s %s: %v", test.Method, test.URL, status)
+		}
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Errorf("Unexpected body for %s %s: '%s'", test.Method, test.URL, rr.Body.String())
+		}
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Requests containing SQL-injection payloads are rejected, and cannot
+// harm our database.
+//
+func TestInjection(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Add some data.
+	addFakeNodes()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/state/{state}", APIState).Methods("GET")
+	router.HandleFunc("/api/v1/history", APIHistory).Methods("GET")
+	router.HandleFunc("/analytics", AnalyticsHandler).Methods("GET")
+	router.HandleFunc("/slowest", SlowestHandler).Methods("GET")
+	router.HandleFunc("/", IndexHandler).Methods("GET")
+	router.HandleFunc("/environment/{environment}", IndexHandler).Methods("GET")
+
+	payloads := []string{
+		"production' OR '1'='1",
+		"x'; DROP TABLE reports; --",
+		"' UNION SELECT fqdn, yaml_file, state, runtime, executed_at FROM reports --",
+	}
+
+	for _, payload := range payloads {
+
+		escaped := url.QueryEscape(payload)
+
+		urls := []string{
+			"/environment/" + url.PathEscape(payload),
+			"/?environment=" + escaped,
+			"/?state=" + escaped,
+			"/?fqdn=" + escaped,
+			"/api/state/failed?environment=" + escaped,
+			"/api/state/failed?fqdn=" + escaped,
+			"/api/v1/history?environment=" + escaped,
+			"/analytics?environment=" + escaped,
+			"/slowest?environment=" + escaped,
+		}
+
+		for _, u := range urls {
+			req, err := http.NewRequest("GET", u, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != http.StatusBadRequest {
+				t.Errorf("Unexpected status-code for %s: %v", u, status)
+			}
+			if strings.Contains(rr.Body.String(), "foo.example.com") {
+				t.Errorf("Unexpected node in the response for %s", u)
+			}
+		}
+	}
+
+	//
+	// Our reports remain.
+	//
+	count, err := countReports()
+	if err != nil || count != 3 {
+		t.Errorf("Unexpected reports: %d %v", count, err)
+	}
+
+	//
+	// Valid filters may be combined.
+	//
+	req, err := http.NewRequest("GET", "/api/state/failed?fqdn=*.example.com&accept=text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Body.String() != "bar.example.com\n" {
+		t.Errorf("Unexpected body: '%s'", rr.Body.String())
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Test the liveness and readiness checks.
+//
+func TestHealthChecks(t *testing.T) {
+
+	router := mux.NewRouter()
+	router.HandleFunc("/healthz", HealthHandler).Methods("GET")
+	router.HandleFunc("/readyz", ReadyHandler).Methods("GET")
+	router.HandleFunc("/upload", ReportSubmissionHandler).Methods("POST")
+
+	get := func(url string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	//
+	// We're alive, but without a database we're not ready.
+	//
+	if rr := get("/healthz"); rr.Code != http.StatusOK {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+	if rr := get("/readyz"); rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+
+	// Create a fake database
+	FakeDB()
+
+	if rr := get("/readyz"); rr.Code != http.StatusOK {
+		t.Errorf("Unexpected status-code: %v %s", rr.Code, rr.Body.String())
+	}
+
+	//
+	// Too little free space.
+	//
+	bak := currentSettings()
+	settings := *bak
+	settings.MinFreeSpace = 1 << 62
+	storeSettings(&settings)
+	prefix := ReportPrefix
+	ReportPrefix = path
+	rr := get("/readyz")
+	if rr.Code != http.StatusServiceUnavailable || !strings.Contains(rr.Body.String(), "MB free") {
+		t.Errorf("Unexpected response: %v %s", rr.Code, rr.Body.String())
+	}
+	storeSettings(bak)
+	ReportPrefix = prefix
+
+	//
+	// Once we start shutting down we're not ready, and uploads are
+	// refused.
+	//
+	startDraining()
+	defer atomic.StoreInt32(&draining, 0)
+
+	if rr := get("/readyz"); rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+	if rr := get("/healthz"); rr.Code != http.StatusOK {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+
+	req, err := http.NewRequest("POST", "/upload", strings.NewReader("---"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusServiceUnavailable || rr.Header().Get("Retry-After") == "" {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Reports which are queued when we shut down are written to the database
+// before it is closed.
+//
+func TestShutdown(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+	defer os.RemoveAll(path)
+	defer atomic.StoreInt32(&draining, 0)
+
+	writer = newReportWriter(100, time.Hour)
+	defer func() { writer = nil }()
+
+	result := make(chan error)
+	go func() {
+		var n PuppetReport
+		n.Fqdn = "late.example.com"
+		n.State = "changed"
+		result <- addDB(n, "")
+	}()
+
+	//
+	// Give the report time to be queued.
+	//
+	time.Sleep(50 * time.Millisecond)
+
+	jobs := newScheduler()
+	jobs.Start()
+	shutdown(&http.Server{}, newReloader(nil, nil, jobs), time.Second)
+
+	if err := <-result; err != nil {
+		t.Errorf("Failed to add report: %s", err.Error())
+	}
+	if db != nil {
+		t.Errorf("The database was not closed")
+	}
+
+	//
+	// The report is present, and the write-ahead log is empty.
+	//
+	SetupDB(path + "/db.sql")
+	count, err := countReports()
+	if err != nil || count != 1 {
+		t.Errorf("Unexpected count: %d %v", count, err)
+	}
+	db.Close()
+	db = nil
+}
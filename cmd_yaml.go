@@ -51,15 +51,15 @@ func YamlDump(file string) {
 	fmt.Printf("Hostname: %s\n", node.Fqdn)
 	fmt.Printf("Reported: %s\n", node.At)
 	fmt.Printf("State   : %s\n", node.State)
-	fmt.Printf("Runtime : %s\n", node.Runtime)
+	fmt.Printf("Runtime : %.2f\n", node.Runtime)
 
 	fmt.Printf("\nResources\n")
-	fmt.Printf("\tFailed : %s\n", node.Failed)
-	fmt.Printf("\tChanged: %s\n", node.Changed)
-	fmt.Printf("\tSkipped: %s\n", node.Skipped)
-	fmt.Printf("\tTotal  : %s\n", node.Total)
+	fmt.Printf("\tFailed : %d\n", node.Failed)
+	fmt.Printf("\tChanged: %d\n", node.Changed)
+	fmt.Printf("\tSkipped: %d\n", node.Skipped)
+	fmt.Printf("\tTotal  : %d\n", node.Total)
 
-	if node.Failed != "0" {
+	if node.Failed > 0 {
 		fmt.Printf("\nFailed:\n")
 		for i := range node.ResourcesFailed {
 			fmt.Printf("\t%s\n", node.ResourcesFailed[i].Name)
@@ -67,7 +67,7 @@ func YamlDump(file string) {
 		}
 	}
 
-	if node.Changed != "0" {
+	if node.Changed > 0 {
 		fmt.Printf("\nChanged:\n")
 		for i := range node.ResourcesChanged {
 			fmt.Printf("\t%s\n", node.ResourcesChanged[i].Name)
@@ -75,7 +75,7 @@ func YamlDump(file string) {
 		}
 	}
 
-	if node.Skipped != "0" {
+	if node.Skipped > 0 {
 		fmt.Printf("\nSkipped:\n")
 		for i := range node.ResourcesSkipped {
 			fmt.Printf("\t%s\n", node.ResourcesSkipped[i].Name)
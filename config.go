@@ -0,0 +1,352 @@
+//
+// Configuration of our sub-commands.
+//
+// Every setting of a sub-command may be specified in a configuration file,
+// or via the environment, rather than on the command-line.  For example:
+//
+//    db-file: /var/lib/puppet-summary/ps.db
+//    prefix: /var/lib/puppet-summary/reports
+//
+//    serve:
+//      host: 0.0.0.0
+//      auto-prune: true
+//
+//    metrics:
+//      host: carbon.example.com
+//
+// The top-level settings apply to every sub-command which uses them, and
+// each section contains the flags of a single sub-command.
+//
+// The environment-variable `PUPPET_SUMMARY_DB_FILE` overrides the top-level
+// `db-file` setting, and `PUPPET_SUMMARY_SERVE_HOST` the `host` setting of
+// the `serve` section.  Flags given on the command-line override both.
+//
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/subcommands"
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// EnvironmentPrefix is the prefix of the environment-variables we read.
+//
+const EnvironmentPrefix = "PUPPET_SUMMARY_"
+
+//
+// The settings which may be given at the top-level of the configuration
+// file, and the sub-commands to which they apply.
+//
+// The `metrics` sub-command uses `prefix` for the carbon-prefix, rather
+// than the location of our reports, so it must be set in its section.
+//
+var sharedSettings = map[string][]string{
+	"db-file": {"backup", "db", "export", "metrics", "prune", "rebuild", "restore", "serve"},
+	"prefix":  {"backup", "db", "export", "prune", "redact", "restore", "serve"},
+
+	"group-rules":     {"metrics", "serve"},
+	"retention-rules": {"prune", "serve"},
+}
+
+//
+// setting is a single value, along with where it came from.
+//
+type setting struct {
+	value  string
+	source string
+}
+
+//
+// configuration holds the settings read from our configuration file,
+// and environment.
+//
+type configuration struct {
+	// The file we read, if any.
+	path string
+
+	// The sub-commands we configure.
+	commands []subcommands.Command
+
+	// The flags of each sub-command we configure.  As each setting is
+	// validated by applying it to these they hold the effective values.
+	flags map[string]*flag.FlagSet
+
+	// The settings for each sub-command, by flag-name, in the order
+	// in which they should be applied.
+	settings map[string]map[string][]setting
+}
+
+//
+// The global configuration.
+//
+// When this is nil our sub-commands use only their flags.
+//
+var config *configuration
+
+//
+// loadConfig reads the configuration file at the given path, which may
+// be empty, and the given environment.
+//
+// The settings are validated against the flags of the given commands.
+//
+func loadConfig(path string, environ []string, commands ...subcommands.Command) (*configuration, error) {
+
+	c := &configuration{
+		path:     path,
+		commands: commands,
+		flags:    make(map[string]*flag.FlagSet),
+		settings: make(map[string]map[string][]setting),
+	}
+
+	for _, cmd := range commands {
+		fs := flag.NewFlagSet(cmd.Name(), flag.ContinueOnError)
+		cmd.SetFlags(fs)
+		c.flags[cmd.Name()] = fs
+		c.settings[cmd.Name()] = make(map[string][]setting)
+	}
+
+	//
+	// The environment overrides the file, and top-level settings are
+	// overridden by those within a section.
+	//
+	var shared, sections, sharedEnv, sectionsEnv []func() error
+
+	if path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var file map[string]interface{}
+		err = yaml.UnmarshalStrict(content, &file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %s", path, err.Error())
+		}
+
+		for _, key := range sortedKeys(file) {
+			key, value := key, file[key]
+
+			//
+			// An empty section is ignored.
+			//
+			if _, ok := c.flags[key]; ok && value == nil {
+				continue
+			}
+
+			section, ok := value.(map[interface{}]interface{})
+			if !ok {
+				shared = append(shared, func() error {
+					return c.addShared(key, value, path+": "+key)
+				})
+				continue
+			}
+
+			if _, ok := c.flags[key]; !ok {
+				return nil, fmt.Errorf("%s: unknown section '%s', valid sections are: %s", path, key, c.sectionNames())
+			}
+
+			for name, v := range section {
+				name, v := fmt.Sprint(name), v
+				sections = append(sections, func() error {
+					return c.add(key, name, v, path+": "+key+"."+name)
+				})
+			}
+		}
+	}
+
+	for _, env := range environ {
+		if !strings.HasPrefix(env, EnvironmentPrefix) {
+			continue
+		}
+		kv := strings.SplitN(env, "=", 2)
+		if len(kv) != 2 || kv[0] == EnvironmentPrefix+"CONFIG" {
+			continue
+		}
+		name, value := kv[0], kv[1]
+
+		key := envName(strings.TrimPrefix(name, EnvironmentPrefix))
+		if _, ok := sharedSettings[key]; ok {
+			sharedEnv = append(sharedEnv, func() error {
+				return c.addShared(key, value, name)
+			})
+			continue
+		}
+
+		found := false
+		for section := range c.flags {
+			if strings.HasPrefix(key, section+"-") {
+				key := strings.TrimPrefix(key, section+"-")
+				section := section
+				sectionsEnv = append(sectionsEnv, func() error {
+					return c.add(section, key, value, name)
+				})
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown environment variable %s", name)
+		}
+	}
+
+	for _, group := range [][]func() error{shared, sections, sharedEnv, sectionsEnv} {
+		for _, add := range group {
+			err := add()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return c, nil
+}
+
+//
+// addShared records a top-level setting for each sub-command to which
+// it applies.
+//
+func (c *configuration) addShared(name string, value interface{}, source string) error {
+
+	commands, ok := sharedSettings[name]
+	if !ok {
+		var valid []string
+		for key := range sharedSettings {
+			valid = append(valid, key)
+		}
+		sort.Strings(valid)
+		return fmt.Errorf("%s: unknown setting '%s', valid top-level settings are: %s, or a section named for a sub-command", source, name, strings.Join(valid, ", "))
+	}
+
+	for _, cmd := range commands {
+		if _, ok := c.flags[cmd]; !ok {
+			continue
+		}
+		err := c.add(cmd, name, value, source)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// add records the value of a single flag, after testing it is valid.
+//
+func (c *configuration) add(section string, name string, value interface{}, source string) error {
+
+	fs := c.flags[section]
+	if fs.Lookup(name) == nil {
+		var valid []string
+		fs.VisitAll(func(f *flag.Flag) {
+			valid = append(valid, f.Name)
+		})
+		return fmt.Errorf("%s: unknown setting '%s' for %s, valid settings are: %s", source, name, section, strings.Join(valid, ", "))
+	}
+
+	switch value.(type) {
+	case map[interface{}]interface{}, []interface{}:
+		return fmt.Errorf("%s: expected a single value", source)
+	}
+	str := fmt.Sprint(value)
+
+	//
+	// Test the value by setting it upon our copy of the flags.
+	//
+	err := fs.Set(name, str)
+	if err != nil {
+		return fmt.Errorf("%s: invalid value '%s': %s", source, str, err.Error())
+	}
+
+	c.settings[section][name] = append(c.settings[section][name], setting{value: str, source: source})
+	return nil
+}
+
+//
+// Apply sets the flags of the named sub-command, which were not given on
+// the command-line, from our configuration.
+//
+func (c *configuration) Apply(name string, f *flag.FlagSet) error {
+	if c == nil {
+		return nil
+	}
+
+	given := make(map[string]bool)
+	f.Visit(func(fl *flag.Flag) {
+		given[fl.Name] = true
+	})
+
+	for key, values := range c.settings[name] {
+		if given[key] || len(values) == 0 {
+			continue
+		}
+		last := values[len(values)-1]
+		err := f.Set(key, last.value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid value '%s': %s", last.source, last.value, err.Error())
+		}
+	}
+	return nil
+}
+
+//
+// Reload reads our configuration file, and the environment, again.
+//
+func (c *configuration) Reload() (*configuration, error) {
+	if c == nil {
+		return nil, fmt.Errorf("no configuration loaded")
+	}
+	return loadConfig(c.path, os.Environ(), c.commands...)
+}
+
+//
+// Source returns where the effective value of the given flag came from,
+// or an empty string if it has its default value.
+//
+func (c *configuration) Source(name string, flag string) string {
+	if c == nil {
+		return ""
+	}
+	values := c.settings[name][flag]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1].source
+}
+
+//
+// sectionNames returns the names of the sections we accept.
+//
+func (c *configuration) sectionNames() string {
+	var names []string
+	for name := range c.flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+//
+// envName converts the name of an environment-variable, without our
+// prefix, into the form of a flag.  `DB_FILE` becomes `db-file`.
+//
+func envName(name string) string {
+	return strings.Replace(strings.ToLower(name), "_", "-", -1)
+}
+
+//
+// sortedKeys returns the keys of the given map, sorted.
+//
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,229 @@
+//
+//  Testing of the configuration file of our sub-commands.
+//
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//
+// writeConfig writes the given configuration to a temporary file.
+//
+func writeConfig(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir(os.TempDir(), "config")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %s", err.Error())
+	}
+	path := filepath.Join(dir, "puppet-summary.yaml")
+	err = ioutil.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write configuration: %s", err.Error())
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+//
+// Test the order in which settings are applied.
+//
+func TestConfigPrecedence(t *testing.T) {
+
+	path, cleanup := writeConfig(t, `
+db-file: /file/shared.db
+prefix: /file/reports
+serve:
+  port: 4000
+  db-file: /file/serve.db
+prune:
+rebuild:
+  verbose: true
+`)
+	defer cleanup()
+
+	environ := []string{
+		"HOME=/root",
+		"PUPPET_SUMMARY_CONFIG=" + path,
+		"PUPPET_SUMMARY_PREFIX=/env/reports",
+		"PUPPET_SUMMARY_SERVE_HOST=0.0.0.0",
+		"PUPPET_SUMMARY_SERVE_BATCH_SIZE=7",
+	}
+
+	c, err := loadConfig(path, environ, &pruneCmd{}, &rebuildCmd{}, &serveCmd{})
+	if err != nil {
+		t.Fatalf("Unexpected error loading configuration: %s", err.Error())
+	}
+
+	//
+	// The port is given on the command-line, so it isn't changed.
+	//
+	s := serveCmd{}
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	s.SetFlags(fs)
+	fs.Parse([]string{"-port", "5000"})
+
+	err = c.Apply("serve", fs)
+	if err != nil {
+		t.Fatalf("Unexpected error applying configuration: %s", err.Error())
+	}
+
+	if s.bindPort != 5000 {
+		t.Errorf("The command-line should win, got port %d", s.bindPort)
+	}
+	if s.dbFile != "/file/serve.db" {
+		t.Errorf("The section should override the top-level, got %s", s.dbFile)
+	}
+	if s.prefix != "/env/reports" {
+		t.Errorf("The environment should override the file, got %s", s.prefix)
+	}
+	if s.bindHost != "0.0.0.0" {
+		t.Errorf("Unexpected host %s", s.bindHost)
+	}
+	if s.batchSize != 7 {
+		t.Errorf("Unexpected batch-size %d", s.batchSize)
+	}
+
+	p := pruneCmd{}
+	fs = flag.NewFlagSet("prune", flag.ContinueOnError)
+	p.SetFlags(fs)
+	c.Apply("prune", fs)
+	if p.dbFile != "/file/shared.db" {
+		t.Errorf("Unexpected database for prune %s", p.dbFile)
+	}
+
+	r := rebuildCmd{}
+	fs = flag.NewFlagSet("rebuild", flag.ContinueOnError)
+	r.SetFlags(fs)
+	c.Apply("rebuild", fs)
+	if !r.verbose {
+		t.Errorf("Expected rebuild to be verbose")
+	}
+
+	if c.Source("serve", "host") != "PUPPET_SUMMARY_SERVE_HOST" {
+		t.Errorf("Unexpected source %s", c.Source("serve", "host"))
+	}
+	if c.Source("serve", "db-file") != path+": serve.db-file" {
+		t.Errorf("Unexpected source %s", c.Source("serve", "db-file"))
+	}
+	if c.Source("serve", "auto-prune") != "" {
+		t.Errorf("Unexpected source %s", c.Source("serve", "auto-prune"))
+	}
+}
+
+//
+// Test that bogus configuration is rejected, with a helpful message.
+//
+func TestConfigInvalid(t *testing.T) {
+
+	type TestCase struct {
+		config  string
+		environ []string
+		error   string
+	}
+
+	tests := []TestCase{
+		{"serve: [", nil, "failed to parse"},
+		{"bogus:\n  port: 3", nil, "unknown section 'bogus', valid sections are: prune, serve"},
+		{"port: 3", nil, "unknown setting 'port', valid top-level settings are: db-file, group-rules, prefix"},
+		{"serve:\n  prot: 3", nil, "unknown setting 'prot' for serve"},
+		{"serve:\n  port: three", nil, "serve.port: invalid value 'three'"},
+		{"serve:\n  port: [1, 2]", nil, "serve.port: expected a single value"},
+		{"serve:\n  port: 1\n  port: 2", nil, "already set"},
+		{"", []string{"PUPPET_SUMMARY_SERVE_PROT=3"}, "PUPPET_SUMMARY_SERVE_PROT: unknown setting 'prot'"},
+		{"", []string{"PUPPET_SUMMARY_BOGUS=3"}, "unknown environment variable PUPPET_SUMMARY_BOGUS"},
+		{"", []string{"PUPPET_SUMMARY_PRUNE_DAYS=x"}, "PUPPET_SUMMARY_PRUNE_DAYS: invalid value 'x'"},
+	}
+
+	for _, test := range tests {
+		path, cleanup := writeConfig(t, test.config)
+
+		_, err := loadConfig(path, test.environ, &pruneCmd{}, &serveCmd{})
+		if err == nil {
+			t.Errorf("Expected an error loading %s %v", test.config, test.environ)
+		} else if !strings.Contains(err.Error(), test.error) {
+			t.Errorf("Expected '%s' in error, got '%s'", test.error, err.Error())
+		}
+		cleanup()
+	}
+
+	_, err := loadConfig("/does/not/exist.yaml", nil, &serveCmd{})
+	if err == nil {
+		t.Errorf("Expected an error loading a missing file")
+	}
+}
+
+//
+// With no configuration every flag keeps its default.
+//
+func TestConfigMissing(t *testing.T) {
+
+	var c *configuration
+	s := serveCmd{}
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	s.SetFlags(fs)
+
+	err := c.Apply("serve", fs)
+	if err != nil {
+		t.Errorf("Unexpected error %s", err.Error())
+	}
+	if s.bindPort != 3001 {
+		t.Errorf("Unexpected port %d", s.bindPort)
+	}
+
+	c, err = loadConfig("", nil, &serveCmd{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	err = c.Apply("serve", fs)
+	if err != nil || s.bindPort != 3001 {
+		t.Errorf("Unexpected result %d %v", s.bindPort, err)
+	}
+}
+
+//
+// Test the output of the config sub-command.
+//
+func TestConfigShow(t *testing.T) {
+
+	path, cleanup := writeConfig(t, "prefix: /srv/reports\nprune:\n  days: 3\n")
+	defer cleanup()
+
+	c, err := loadConfig(path, []string{"PUPPET_SUMMARY_PRUNE_VERBOSE=true"}, &pruneCmd{}, &serveCmd{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	buf := new(bytes.Buffer)
+	err = showConfig(buf, c, true, "prune")
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	expected := []string{
+		"# Read from " + path + "\n",
+		"prune:\n",
+		"  days: 3  # " + path + ": prune.days\n",
+		"  prefix: /srv/reports  # " + path + ": prefix\n",
+		"  verbose: true  # PUPPET_SUMMARY_PRUNE_VERBOSE\n",
+		"  db-file: ps.db  # default\n",
+	}
+	for _, line := range expected {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("Expected '%s' in output:\n%s", line, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "serve:") {
+		t.Errorf("Only the prune section should be shown")
+	}
+
+	err = showConfig(buf, c, false, "bogus")
+	if err == nil {
+		t.Errorf("Expected an error for an unknown section")
+	}
+}
@@ -14,9 +14,10 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
-	_ "github.com/mattn/go-sqlite3"
+	sqlite3 "github.com/mattn/go-sqlite3"
 )
 
 //
@@ -24,6 +25,53 @@ import (
 //
 var db *sql.DB
 
+//
+// The name of the driver we use, which is SQLite with the addition of the
+// REGEXP operator.
+//
+const dbDriver = "sqlite3_regexp"
+
+func init() {
+	sql.Register(dbDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("regexp", matchRegexp, true)
+		},
+	})
+}
+
+//
+// The patterns used with REGEXP, which are compiled once.  The patterns
+// come from our users, so we forget them all if there are too many.
+//
+var (
+	dbPatterns     = make(map[string]*regexp.Regexp)
+	dbPatternsLock sync.Mutex
+)
+
+//
+// Implement `text REGEXP pattern`, which SQLite calls as
+// `regexp(pattern, text)`.
+//
+func matchRegexp(pattern string, text string) (bool, error) {
+	dbPatternsLock.Lock()
+	re, ok := dbPatterns[pattern]
+	if !ok {
+		var err error
+		re, err = regexp.Compile(pattern)
+		if err != nil {
+			dbPatternsLock.Unlock()
+			return false, err
+		}
+		if len(dbPatterns) >= 100 {
+			dbPatterns = make(map[string]*regexp.Regexp)
+		}
+		dbPatterns[pattern] = re
+	}
+	dbPatternsLock.Unlock()
+
+	return re.MatchString(text), nil
+}
+
 //
 // PuppetRuns is the structure which is used to list a summary of puppet
 // runs on the front-page.
@@ -36,6 +84,13 @@ type PuppetRuns struct {
 	Epoch       string
 	Ago         string
 	Runtime     string
+
+	// Group is the value of the fact by which the nodes are grouped,
+	// if any.
+	Group string `json:",omitempty" xml:",omitempty"`
+
+	// Owner is the team which owns the node, if any.
+	Owner string `json:",omitempty" xml:",omitempty"`
 }
 
 //
@@ -53,18 +108,76 @@ type PuppetReportSummary struct {
 	Failed      int
 	Changed     int
 	Total       int
+	LogErrors   int
+	LogWarnings int
 	YamlFile    string
 }
 
 //
-// PuppetHistory is a simple structure used solely for the stacked-graph
-// on the front-page of our site.
+// PuppetHistory is a simple structure used for the stacked-graph on the
+// front-page of our site, and by the history API.
+//
+// Date is the local time at which the bucket starts, in ISO 8601 format.
 //
 type PuppetHistory struct {
 	Date      string
-	Failed    string
-	Changed   string
-	Unchanged string
+	Failed    int
+	Changed   int
+	Unchanged int
+}
+
+//
+// PuppetTimingSeries holds the time taken by a single category, such as
+// `config_retrieval` or `file`, across a series of runs.
+//
+// It is used for the stacked-graph on the node-page.
+//
+type PuppetTimingSeries struct {
+	Name   string
+	Label  string
+	Values []float64
+}
+
+//
+// PuppetSlowType is the average and maximum time taken by a single
+// category across all the reports we hold.
+//
+type PuppetSlowType struct {
+	Name    string
+	Label   string
+	Reports int
+	Average float64
+	Maximum float64
+}
+
+//
+// PuppetSlowResource is the average and maximum time taken to evaluate
+// a single resource, across all the nodes which contain it.
+//
+type PuppetSlowResource struct {
+	Resource string
+	Type     string
+	File     string
+	Line     string
+	Nodes    int
+	Reports  int
+	Average  float64
+	Maximum  float64
+}
+
+//
+// PuppetLatency holds the average time taken by the stages of a puppet
+// run, for the reports from an environment within a given period.
+//
+type PuppetLatency struct {
+	Environment           string
+	Period                string
+	Reports               int
+	Runtime               float64
+	ConfigRetrieval       float64
+	FactGeneration        float64
+	PluginSync            float64
+	TransactionEvaluation float64
 }
 
 //
@@ -77,6 +190,18 @@ type PuppetState struct {
 	Percentage float64
 }
 
+//
+// PruneRun records a single application of our retention policy, and is
+// used for the submission of metrics.
+//
+type PruneRun struct {
+	Started  int64
+	Duration float64
+	Reports  int
+	Nodes    int
+	Bytes    int64
+}
+
 //
 // SetupDB opens our SQLite database, creating it if necessary.
 //
@@ -87,11 +212,22 @@ func SetupDB(path string) error {
 	//
 	// Return if the database already exists.
 	//
-	db, err = sql.Open("sqlite3", "file:"+path+"?_journal_mode=WAL")
+	db, err = sql.Open(dbDriver, "file:"+path+"?_journal_mode=WAL")
 	if err != nil {
 		return err
 	}
 
+	//
+	// Databases created by older releases won't have a nodes-table,
+	// so we'll need to populate it from their reports once created.
+	//
+	var name string
+	err = db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name='nodes'").Scan(&name)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	rebuild := (err == sql.ErrNoRows)
+
 	//
 	// Create the table.
 	//
@@ -117,8 +253,120 @@ func SetupDB(path string) error {
           total       integer,
           skipped     integer,
           failed      integer,
-          changed     integer
-        )
+          changed     integer,
+          config_retrieval       real,
+          fact_generation        real,
+          plugin_sync            real,
+          transaction_evaluation real,
+          log_errors             integer,
+          log_warnings           integer,
+          hash                   text,
+          puppet_version         text,
+          configuration_version  text,
+          report_format          integer,
+          code_id                text
+        );
+
+        CREATE TABLE IF NOT EXISTS report_timings (
+          report_id   integer,
+          name        text,
+          label       text,
+          value       real
+        );
+        CREATE INDEX IF NOT EXISTS report_timings_report ON report_timings(report_id);
+
+        CREATE TABLE IF NOT EXISTS report_resources (
+          report_id       integer,
+          resource        text,
+          type            text,
+          file            text,
+          line            text,
+          evaluation_time real
+        );
+        CREATE INDEX IF NOT EXISTS report_resources_report ON report_resources(report_id);
+
+        CREATE TABLE IF NOT EXISTS report_events (
+          report_id      integer,
+          status         text,
+          resource_type  text,
+          resource_title text,
+          file           text,
+          line           text
+        );
+        CREATE INDEX IF NOT EXISTS report_events_report ON report_events(report_id);
+
+        CREATE TABLE IF NOT EXISTS nodes (
+          fqdn           text PRIMARY KEY,
+          environment    text,
+          state          text,
+          runtime        real,
+          last_report_id integer,
+          first_seen     integer,
+          last_seen      integer,
+          run_count      integer
+        );
+        CREATE INDEX IF NOT EXISTS nodes_environment ON nodes(environment);
+
+        CREATE TABLE IF NOT EXISTS daily_rollups (
+          fqdn        text,
+          environment text,
+          day         text,
+          failed      integer,
+          changed     integer,
+          unchanged   integer,
+          runs        integer,
+          runtime     real,
+          PRIMARY KEY (fqdn, day)
+        );
+        CREATE INDEX IF NOT EXISTS daily_rollups_day ON daily_rollups(day);
+
+        CREATE TABLE IF NOT EXISTS facts (
+          id          INTEGER PRIMARY KEY AUTOINCREMENT,
+          fqdn        text,
+          environment text,
+          received_at integer,
+          content     text
+        );
+        CREATE INDEX IF NOT EXISTS facts_fqdn ON facts(fqdn, received_at);
+
+        CREATE TABLE IF NOT EXISTS node_facts (
+          fqdn        text,
+          name        text,
+          value       text,
+          PRIMARY KEY (fqdn, name)
+        );
+        CREATE INDEX IF NOT EXISTS node_facts_name ON node_facts(name, value);
+
+        CREATE TABLE IF NOT EXISTS acknowledgements (
+          id          INTEGER PRIMARY KEY AUTOINCREMENT,
+          kind        text,
+          fqdn        text,
+          node_group  text,
+          note        text,
+          starts_at   integer,
+          ends_at     integer
+        );
+        CREATE INDEX IF NOT EXISTS acknowledgements_ends_at ON acknowledgements(ends_at);
+
+        CREATE TABLE IF NOT EXISTS node_annotations (
+          fqdn        text PRIMARY KEY,
+          owner       text,
+          notes       text,
+          links       text,
+          updated_at  integer
+        );
+        CREATE INDEX IF NOT EXISTS node_annotations_owner ON node_annotations(owner);
+
+        CREATE TABLE IF NOT EXISTS prune_history (
+          id          INTEGER PRIMARY KEY AUTOINCREMENT,
+          started_at  integer,
+          duration    real,
+          reports     integer,
+          nodes       integer,
+          bytes       integer
+        );
+        CREATE INDEX IF NOT EXISTS reports_fqdn ON reports(fqdn, executed_at);
+        CREATE INDEX IF NOT EXISTS reports_executed_at ON reports(executed_at);
 	`
 
 	//
@@ -132,23 +380,105 @@ func SetupDB(path string) error {
 	}
 
 	//
-	// Check if the table has environment column
+	// Add any columns which are missing from databases created by older
+	// releases, new databases have them all.
+	//
+	columns := []struct {
+		name string
+		kind string
+	}{
+		{"environment", "text"},
+		{"config_retrieval", "real"},
+		{"fact_generation", "real"},
+		{"plugin_sync", "real"},
+		{"transaction_evaluation", "real"},
+		{"log_errors", "integer"},
+		{"log_warnings", "integer"},
+		{"hash", "text"},
+		{"puppet_version", "text"},
+		{"configuration_version", "text"},
+		{"report_format", "integer"},
+		{"code_id", "text"},
+	}
+
+	for _, column := range columns {
+		err = addColumn(column.name, column.kind)
+		if err != nil {
+			return err
+		}
+	}
+
+	if rebuild {
+		_, err = rebuildNodes()
+	}
+	return err
+}
+
+//
+// CloseDB checkpoints the write-ahead log into the database, so that it
+// is complete in itself, then closes our handle.
+//
+func CloseDB() error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	_, err := db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	if err != nil {
+		db.Close()
+		db = nil
+		return err
+	}
+
+	err = db.Close()
+	db = nil
+	return err
+}
+
+//
+// Test that the database is writable, without changing it.
+//
+func checkWritable() error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//
+	// This updates nothing, but still requires a write-lock.
 	//
+	_, err = tx.Exec("UPDATE nodes SET run_count = run_count WHERE 0")
+	return err
+}
+
+//
+// Add the given column to the reports table, if it is not present.
+//
+func addColumn(column string, kind string) error {
+
 	var name string
-	row := db.QueryRow("SELECT name FROM pragma_table_info('reports') WHERE name='environment'")
-	err = row.Scan(&name)
+	row := db.QueryRow("SELECT name FROM pragma_table_info('reports') WHERE name=?", column)
+	err := row.Scan(&name)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			fmt.Println("Did not find environment column, adding")
-			_, err = db.Exec("ALTER TABLE reports ADD environment text")
-			if err != nil {
-				return err
-			}
-		} else {
-			return err
+			fmt.Printf("Did not find %s column, adding\n", column)
+			_, err = db.Exec("ALTER TABLE reports ADD " + column + " " + kind)
 		}
 	}
-	return nil
+	return err
 }
 
 //
@@ -194,6 +524,14 @@ func populateEnvironment(prefix string) error {
 			}
 		}
 	}
+
+	//
+	// Update the environment of the nodes whose most recent report
+	// we've changed.
+	//
+	if len(ids) > 0 {
+		_, _ = db.Exec("UPDATE nodes SET environment = ( SELECT environment FROM reports WHERE id = nodes.last_report_id ) WHERE environment IS NULL")
+	}
 	return err
 }
 
@@ -204,6 +542,8 @@ func populateEnvironment(prefix string) error {
 //
 // But note that it doesn't contain changed resources, etc.
 //
+// If the batching writer is running the entry is handed to it, and we
+// wait for the batch to be committed, otherwise it is inserted directly.
 //
 func addDB(data PuppetReport, path string) error {
 
@@ -214,29 +554,22 @@ func addDB(data PuppetReport, path string) error {
 		return errors.New("SetupDB not called")
 	}
 
+	if writer != nil {
+		return writer.Submit(data, path)
+	}
+
 	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
-	stmt, err := tx.Prepare("INSERT INTO reports(fqdn,environment,state,yaml_file,executed_at,runtime, failed, changed, total, skipped) values(?,?,?,?,?,?,?,?,?,?)")
+
+	err = insertSubmission(tx, data, path)
 	if err != nil {
+		tx.Rollback()
 		return err
 	}
-	defer stmt.Close()
 
-	stmt.Exec(data.Fqdn,
-		data.Environment,
-		data.State,
-		path,
-		time.Now().Unix(),
-		data.Runtime,
-		data.Failed,
-		data.Changed,
-		data.Total,
-		data.Skipped)
-	tx.Commit()
-
-	return nil
+	return tx.Commit()
 }
 
 //
@@ -304,6 +637,12 @@ func getEnvironments() ([]string, error) {
 	return environments, nil
 }
 
+//
+// ErrReportExpired is returned by getYAML when we hold the summary of a
+// report, but its body has been removed by our retention policy.
+//
+var ErrReportExpired = errors.New("report body expired")
+
 //
 // Return the contents of the YAML file which was associated
 // with the given report-ID.
@@ -336,14 +675,49 @@ func getYAML(prefix string, id string) ([]byte, error) {
 	// such as "$host/$time", rather than absolute paths
 	// such as "reports/$host/$time".)
 	//
+	if path == "pruned" {
+		return nil, ErrReportExpired
+	}
 	if len(path) > 0 {
 		path = filepath.Join(prefix, path)
 		content, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			return nil, ErrReportExpired
+		}
 		return content, err
 	}
 	return nil, errors.New("failed to find report with specified ID")
 }
 
+//
+// Return the summary of the report with the given ID.
+//
+func getReportSummary(id string) (PuppetReportSummary, error) {
+
+	var tmp PuppetReportSummary
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return tmp, errors.New("SetupDB not called")
+	}
+
+	var at int64
+	row := db.QueryRow("SELECT id, fqdn, IFNULL(environment,''), IFNULL(state,''), executed_at, IFNULL(runtime,0), IFNULL(failed,0), IFNULL(changed,0), IFNULL(total,0), IFNULL(log_errors,0), IFNULL(log_warnings,0), yaml_file FROM reports WHERE id=?", id)
+	err := row.Scan(&tmp.ID, &tmp.Fqdn, &tmp.Environment, &tmp.State, &at, &tmp.Runtime, &tmp.Failed, &tmp.Changed, &tmp.Total, &tmp.LogErrors, &tmp.LogWarnings, &tmp.YamlFile)
+	if err == sql.ErrNoRows {
+		return tmp, errors.New("report not found")
+	}
+	if err != nil {
+		return tmp, err
+	}
+
+	tmp.Ago = timeRelative(strconv.FormatInt(at, 10))
+	tmp.At = time.Unix(at, 0).Format("2006-01-02 15:04:05")
+	return tmp, nil
+}
+
 //
 // Get the data which is shown on our index page
 //
@@ -352,6 +726,23 @@ func getYAML(prefix string, id string) ([]byte, error) {
 //  * The last-seen time.
 //
 func getIndexNodes(environment string) ([]PuppetRuns, error) {
+	return getNodes(NodeFilter{Environment: environment})
+}
+
+//
+// Get the nodes which match the given filter.
+//
+// This is read from the nodes-table, which holds the most recent run of
+// each node, rather than by scanning all our reports.
+//
+func getNodes(filter NodeFilter) ([]PuppetRuns, error) {
+	return findNodes(filter, nil)
+}
+
+//
+// Get the nodes which match the given filter, and search, if any.
+//
+func findNodes(filter NodeFilter, search *nodeSearch) ([]PuppetRuns, error) {
 
 	//
 	// Our return-result.
@@ -359,14 +750,10 @@ func getIndexNodes(environment string) ([]PuppetRuns, error) {
 	var NodeList []PuppetRuns
 
 	//
-	// The threshold which marks the difference between
-	// "current" and "orphaned"
+	// The nodes we've not heard from recently, which are listed
+	// after the others.
 	//
-	// Here we set it to 4.5 days, which should be long
-	// enough to cover any hosts that were powered-off over
-	// a weekend.  (Friday + Saturday + Sunday + slack).
-	//
-	threshold := 3.5 * (24 * 60 * 60)
+	var orphaned []PuppetRuns
 
 	//
 	// Ensure we have a DB-handle
@@ -376,146 +763,120 @@ func getIndexNodes(environment string) ([]PuppetRuns, error) {
 	}
 
 	//
-	// Shared query piece
+	// Nodes which haven't reported since this time are orphaned.
 	//
-	queryStart := "SELECT fqdn, state, runtime, max(executed_at) FROM reports WHERE "
+	now := time.Now().Unix()
+	cutoff := now - currentSettings().OrphanedThreshold
 
 	//
-	// If environment is specified add a filter
+	// Whether each node is covered by an acknowledgement.
 	//
-	if len(environment) > 0 {
-		queryStart += " environment = '" + environment + "' AND "
-	}
+	ack := acknowledgedCondition(now)
 
-	//
-	// Select the status - for nodes seen in the past 24 hours.
-	//
-	rows, err := db.Query(queryStart+" ( ( strftime('%s','now') - executed_at ) < ? ) GROUP by fqdn;", threshold)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+	q := newQuery(`SELECT fqdn, IFNULL(environment,''), IFNULL(state,''), IFNULL(runtime,0), last_seen, ( `+ack.sql+` ),
+                              IFNULL(( SELECT owner FROM node_annotations a WHERE a.fqdn = nodes.fqdn ),'')
+                         FROM nodes`, ack.args...)
 
 	//
-	// We'll keep track of which nodes we've seen recently.
+	// The state of orphaned, and acknowledged, nodes isn't stored,
+	// instead it depends upon when they were last seen.
 	//
-	seen := make(map[string]int)
+	state := filter.State
+	filter.State = ""
+	filter.Apply(q, "")
+	if search != nil {
+		search.Apply(q)
+	}
 
-	//
-	// For each row in the result-set
-	//
-	// Parse into a structure and add to the list.
-	//
-	for rows.Next() {
-		var tmp PuppetRuns
-		var at string
-		err = rows.Scan(&tmp.Fqdn, &tmp.State, &tmp.Runtime, &at)
+	if len(state) > 0 {
+		cond, err := stateCondition(state, now)
 		if err != nil {
 			return nil, err
 		}
-
-		//
-		// At this point `at` is a string containing seconds past
-		// the epoch.
-		//
-		// We want to parse that into a string `At` which will
-		// contain the literal time, and also the relative
-		// time "Ago"
-		//
-		tmp.Epoch = at
-		tmp.Ago = timeRelative(at)
-
-		//
-		i, _ := strconv.ParseInt(at, 10, 64)
-		tmp.At = time.Unix(i, 0).Format("2006-01-02 15:04:05")
-
-		//
-		// Mark this node as non-orphaned.
-		//
-		seen[tmp.Fqdn] = 1
-
-		//
-		// Add the new record.
-		//
-		NodeList = append(NodeList, tmp)
-
-	}
-	err = rows.Err()
-	if err != nil {
-		return nil, err
+		q.Where(cond.sql, cond.args...)
 	}
 
-	//
-	// Now look for orphaned nodes.
-	//
-	rows2, err2 := db.Query(queryStart+" ( ( strftime('%s','now') - executed_at ) > ? ) GROUP by fqdn;", threshold)
-	if err2 != nil {
+	rows, err := q.Then("ORDER BY fqdn").Query()
+	if err != nil {
 		return nil, err
 	}
-	defer rows2.Close()
+	defer rows.Close()
 
 	//
 	// For each row in the result-set
 	//
 	// Parse into a structure and add to the list.
 	//
-	for rows2.Next() {
+	for rows.Next() {
 		var tmp PuppetRuns
-		var at string
-		err = rows2.Scan(&tmp.Fqdn, &tmp.State, &tmp.Runtime, &at)
+		var at int64
+		var acknowledged bool
+		err = rows.Scan(&tmp.Fqdn, &tmp.Environment, &tmp.State, &tmp.Runtime, &at, &acknowledged, &tmp.Owner)
 		if err != nil {
 			return nil, err
 		}
 
 		//
-		// At this point `at` is a string containing
-		// seconds-past-the-epoch.
-		//
-		// We want that to contain a human-readable
-		// string so we first convert to an integer, then
-		// parse as a Unix-timestamp
+		// At this point `at` contains seconds past the epoch.
 		//
-		tmp.Epoch = at
-		tmp.Ago = timeRelative(at)
-
+		// We want to parse that into a string `At` which will
+		// contain the literal time, and also the relative
+		// time "Ago"
 		//
-		i, _ := strconv.ParseInt(at, 10, 64)
-		tmp.At = time.Unix(i, 0).Format("2006-01-02 15:04:05")
+		tmp.Epoch = strconv.FormatInt(at, 10)
+		tmp.Ago = timeRelative(tmp.Epoch)
+		tmp.At = time.Unix(at, 0).Format("2006-01-02 15:04:05")
 
 		//
-		// Force the state to be `orphaned`.
+		// Nodes which have failed, or which we've not seen recently,
+		// are `acknowledged` while they're covered.
 		//
-		tmp.State = "orphaned"
+		if acknowledged && (tmp.State == "failed" || at < cutoff) {
+			tmp.State = "acknowledged"
+			NodeList = append(NodeList, tmp)
+			continue
+		}
 
 		//
-		// If we've NOT already seen this node then
-		// we can add it to our result set.
+		// Nodes we've not seen recently are `orphaned`.
 		//
-		if seen[tmp.Fqdn] != 1 {
-			NodeList = append(NodeList, tmp)
+		if at < cutoff {
+			tmp.State = "orphaned"
+			orphaned = append(orphaned, tmp)
+			continue
 		}
+
+		NodeList = append(NodeList, tmp)
 	}
-	err = rows2.Err()
+	err = rows.Err()
 	if err != nil {
 		return nil, err
 	}
 
-	return NodeList, nil
+	return append(NodeList, orphaned...), nil
 }
 
 //
-// Return the state of our nodes.
+// Return the state of the nodes which match the given filter.
 //
-func getStates(environment string) ([]PuppetState, error) {
+func getStates(filter NodeFilter) ([]PuppetState, error) {
 
 	//
 	// Get the nodes.
 	//
-	NodeList, err := getIndexNodes(environment)
+	NodeList, err := getNodes(filter)
 	if err != nil {
 		return nil, err
 	}
 
+	return countStates(NodeList), nil
+}
+
+//
+// Count the number of the given nodes in each state.
+//
+func countStates(NodeList []PuppetRuns) []PuppetState {
+
 	//
 	// Create a map to hold state.
 	//
@@ -528,6 +889,7 @@ func getStates(environment string) ([]PuppetState, error) {
 	states["unchanged"] = 0
 	states["failed"] = 0
 	states["orphaned"] = 0
+	states["acknowledged"] = 0
 
 	//
 	// Count the nodes we encounter, such that we can
@@ -575,7 +937,7 @@ func getStates(environment string) ([]PuppetState, error) {
 		data = append(data, tmp)
 	}
 
-	return data, nil
+	return data
 }
 
 //
@@ -593,7 +955,7 @@ func getReports(fqdn string) ([]PuppetReportSummary, error) {
 	//
 	// Select the status.
 	//
-	stmt, err := db.Prepare("SELECT id, fqdn, environment, state, executed_at, runtime, failed, changed, total, yaml_file FROM reports WHERE fqdn=? ORDER by executed_at DESC")
+	stmt, err := db.Prepare("SELECT id, fqdn, environment, state, executed_at, runtime, failed, changed, total, IFNULL(log_errors,0), IFNULL(log_warnings,0), yaml_file FROM reports WHERE fqdn=? ORDER by executed_at DESC")
 	if err != nil {
 		return nil, err
 	}
@@ -617,7 +979,7 @@ func getReports(fqdn string) ([]PuppetReportSummary, error) {
 	for rows.Next() {
 		var tmp PuppetReportSummary
 		var at string
-		err = rows.Scan(&tmp.ID, &tmp.Fqdn, &tmp.Environment, &tmp.State, &at, &tmp.Runtime, &tmp.Failed, &tmp.Changed, &tmp.Total, &tmp.YamlFile)
+		err = rows.Scan(&tmp.ID, &tmp.Fqdn, &tmp.Environment, &tmp.State, &at, &tmp.Runtime, &tmp.Failed, &tmp.Changed, &tmp.Total, &tmp.LogErrors, &tmp.LogWarnings, &tmp.YamlFile)
 		if err != nil {
 			return nil, err
 		}
@@ -651,9 +1013,13 @@ func getReports(fqdn string) ([]PuppetReportSummary, error) {
 }
 
 //
-// Get data for our stacked bar-graph
+// Get the time taken by each category, for each of the given reports
+// of a single node.
+//
+// The values of each series are in the same order as the reports, with
+// zero used for any report we hold no timings for.
 //
-func getHistory(environment string, limit int) ([]PuppetHistory, error) {
+func getNodeTimings(fqdn string, reports []PuppetReportSummary) ([]PuppetTimingSeries, error) {
 
 	//
 	// Ensure we have a DB-handle
@@ -663,128 +1029,422 @@ func getHistory(environment string, limit int) ([]PuppetHistory, error) {
 	}
 
 	//
-	// Our result.
-	//
-	var res []PuppetHistory
-
-	if limit < 2  {
-		limit = 60
-	}
-	//
-	// An array to hold the unique dates we've seen.
-	//
-	var dates []string
-
-	sel := "SELECT DISTINCT(strftime('%d/%m/%Y', DATE(executed_at, 'unixepoch', 'localtime'))) FROM reports"
-	if len(environment) > 0 {
-		sel = sel + " WHERE environment = '" + environment + "'"
-	}
-	sel = sel + " ORDER BY executed_at DESC"
-	//
-	// Get all the distinct dates we have data for.
+	// Map each report ID to its position.
 	//
-	stmt, err := db.Prepare(sel)
-	if err != nil {
-		return nil, err
+	position := make(map[string]int)
+	for i, r := range reports {
+		position[r.ID] = i
 	}
 
-	rows, err := stmt.Query()
+	rows, err := db.Query("SELECT t.report_id, t.name, t.label, t.value FROM report_timings t JOIN reports r ON r.id = t.report_id WHERE r.fqdn=? AND t.name != 'total' ORDER BY t.name", fqdn)
 	if err != nil {
 		return nil, err
 	}
-	defer stmt.Close()
 	defer rows.Close()
 
-	//
-	// For each row in the result-set
-	//
+	var res []PuppetTimingSeries
+	index := make(map[string]int)
+
 	for rows.Next() {
-		var d string
-		err = rows.Scan(&d)
+		var id, name, label string
+		var value float64
+
+		err = rows.Scan(&id, &name, &label, &value)
 		if err != nil {
-			return nil, errors.New("failed to scan SQL")
+			return nil, err
+		}
+
+		pos, ok := position[id]
+		if !ok {
+			continue
 		}
 
-		dates = append(dates, d)
+		//
+		// Create the series the first time we see a category.
+		//
+		i, ok := index[name]
+		if !ok {
+			i = len(res)
+			index[name] = i
+			res = append(res, PuppetTimingSeries{Name: name,
+				Label:  label,
+				Values: make([]float64, len(reports))})
+		}
+		res[i].Values[pos] = value
 	}
 	err = rows.Err()
 	if err != nil {
 		return nil, err
 	}
-	if ( len(dates) < limit ){
-		limit = len(dates)
-	}
+	return res, nil
+}
+
+//
+// Get the categories which take the longest, on average, across all
+// the reports we hold.
+//
+func getSlowestTypes(environment string) ([]PuppetSlowType, error) {
 
 	//
-	// Now we have all the unique dates in `dates`.
+	// Ensure we have a DB-handle
 	//
-	loc, _ := time.LoadLocation("Local")
-	for _, known := range dates[:limit] { // but we only get the first limit days PuppetHistory.
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
 
-		//
-		// The result for this date.
-		//
-		var x PuppetHistory
-		x.Changed = "0"
-		x.Unchanged = "0"
-		x.Failed = "0"
-		x.Date = known
-		formatTime, _ := time.ParseInLocation("02/01/2006 15:04:05", known+" 00:00:00", loc)
-		ts1 := formatTime.Unix()
-		ts2 := ts1 + 3600*24 - 1
-
-		query := "SELECT distinct state, COUNT(state) AS CountOf FROM reports WHERE executed_at between ? and ?"
-		if len(environment) > 0 {
-			query += " AND environment = '" + environment + "' "
+	q := newQuery("SELECT t.name, t.label, COUNT(*), AVG(t.value), MAX(t.value) FROM report_timings t JOIN reports r ON r.id = t.report_id")
+	q.Where("t.name != 'total'")
+	NodeFilter{Environment: environment}.Apply(q, "r.")
+	q.Then("GROUP BY t.name ORDER BY AVG(t.value) DESC")
+
+	rows, err := q.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []PuppetSlowType
+	for rows.Next() {
+		var tmp PuppetSlowType
+		err = rows.Scan(&tmp.Name, &tmp.Label, &tmp.Reports, &tmp.Average, &tmp.Maximum)
+		if err != nil {
+			return nil, err
 		}
-		query += " GROUP by state"
-		stmt, err = db.Prepare(query)
+		res = append(res, tmp)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+//
+// Get the resources which take the longest to evaluate, on average,
+// across all the reports we hold.
+//
+// Only the slowest resources of each report are recorded, so this is
+// intended to find outliers rather than to be a complete list.
+//
+func getSlowestResources(environment string, limit int) ([]PuppetSlowResource, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	q := newQuery("SELECT s.resource, s.type, s.file, s.line, COUNT(DISTINCT r.fqdn), COUNT(*), AVG(s.evaluation_time), MAX(s.evaluation_time) FROM report_resources s JOIN reports r ON r.id = s.report_id")
+	NodeFilter{Environment: environment}.Apply(q, "r.")
+	q.Then("GROUP BY s.type, s.resource ORDER BY AVG(s.evaluation_time) DESC LIMIT ?", limit)
+
+	rows, err := q.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []PuppetSlowResource
+	for rows.Next() {
+		var tmp PuppetSlowResource
+		err = rows.Scan(&tmp.Resource, &tmp.Type, &tmp.File, &tmp.Line, &tmp.Nodes, &tmp.Reports, &tmp.Average, &tmp.Maximum)
 		if err != nil {
 			return nil, err
 		}
+		res = append(res, tmp)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+//
+// Get the average time taken by each stage of the puppet runs reported
+// since the given time, grouped by environment.
+//
+// If `hourly` is set the results are further grouped by the hour in which
+// the reports were received, most recent first.
+//
+// Reports which predate the recording of these timings are ignored.
+//
+func getLatency(environment string, since int64, hourly bool) ([]PuppetLatency, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	period := "''"
+	if hourly {
+		period = "strftime('%Y-%m-%d %H:00', executed_at, 'unixepoch', 'localtime')"
+	}
+
+	q := newQuery("SELECT environment, " + period + ", COUNT(*), IFNULL(AVG(runtime),0), IFNULL(AVG(config_retrieval),0), IFNULL(AVG(fact_generation),0), IFNULL(AVG(plugin_sync),0), IFNULL(AVG(transaction_evaluation),0) FROM reports")
+	q.Where("executed_at >= ? AND config_retrieval IS NOT NULL", since)
+	NodeFilter{Environment: environment}.Apply(q, "")
+	q.Then("GROUP BY 1, 2 ORDER BY 2 DESC, 1")
+
+	rows, err := q.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []PuppetLatency
+	for rows.Next() {
+		var tmp PuppetLatency
+		err = rows.Scan(&tmp.Environment, &tmp.Period, &tmp.Reports, &tmp.Runtime, &tmp.ConfigRetrieval, &tmp.FactGeneration, &tmp.PluginSync, &tmp.TransactionEvaluation)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, tmp)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+//
+// The expressions which place a report into a bucket of each size, named
+// by the local time at which the bucket starts.  Weeks start on Monday.
+//
+var historyBuckets = map[string]string{
+	"hour": "strftime('%Y-%m-%dT%H:00', executed_at, 'unixepoch', 'localtime')",
+	"day":  "date(executed_at, 'unixepoch', 'localtime')",
+	"week": "date(executed_at, 'unixepoch', 'localtime', 'weekday 0', '-6 days')",
+}
+
+//
+// The same buckets, for our daily rollups.  The rollups don't record the
+// time of each run, so they can't be divided into hours.
+//
+var rollupBuckets = map[string]string{
+	"day":  "day",
+	"week": "date(day, 'weekday 0', '-6 days')",
+}
+
+//
+// MaxHistoryBuckets is the largest number of buckets we'll return from
+// a single call to getHistory.
+//
+var MaxHistoryBuckets = 1000
+
+//
+// Return the start of the bucket which contains the given time, and the
+// name of that bucket.
+//
+func historyBucket(bucket string, t time.Time) (time.Time, string) {
+	y, m, d := t.Date()
+
+	switch bucket {
+	case "hour":
+		t = time.Date(y, m, d, t.Hour(), 0, 0, 0, t.Location())
+		return t, t.Format("2006-01-02T15:00")
+	case "week":
+		d -= (int(t.Weekday()) + 6) % 7
+	}
+
+	t = time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	return t, t.Format("2006-01-02")
+}
+
+//
+// Return the start of the day which contains the given time.
+//
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+//
+// Return the start of the bucket which follows the one starting at the
+// given time.
+//
+func nextBucket(bucket string, start time.Time) time.Time {
+	switch bucket {
+	case "hour":
+		return start.Add(time.Hour)
+	case "week":
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+//
+// Get data for our stacked bar-graph
+//
+// We count the reports in each state, from the given environment, within
+// each bucket of the given size between `from` and `to`.  The buckets are
+// returned oldest first, and those which contain no reports are included.
+//
+func getHistory(environment string, bucket string, from int64, to int64) ([]PuppetHistory, error) {
+	return getFilteredHistory(NodeFilter{Environment: environment}, bucket, from, to)
+}
+
+//
+// Get the data for our stacked bar-graph, counting the reports of the
+// nodes which match the given filter.
+//
+// The state of the filter is ignored, as it describes the current state
+// of a node rather than its runs.  Our daily rollups don't record facts,
+// or owners, so a filter upon those is rejected if the range includes
+// runs which have been rolled up.
+//
+// Buckets of days, and weeks, always contain whole days, so that the runs
+// we count are the same whether or not they've been rolled up.
+//
+func getFilteredHistory(filter NodeFilter, bucket string, from int64, to int64) ([]PuppetHistory, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	expr, ok := historyBuckets[bucket]
+	if !ok {
+		return nil, fmt.Errorf("unknown bucket '%s'", bucket)
+	}
+	if to <= from {
+		return nil, errors.New("the end of the range must be after the start")
+	}
+
+	//
+	// Our result, with an entry for each bucket in the range.
+	//
+	var res []PuppetHistory
+	index := make(map[string]int)
+
+	start, name := historyBucket(bucket, time.Unix(from, 0))
+	for start.Unix() < to {
+		if _, ok := index[name]; !ok {
+			if len(res) >= MaxHistoryBuckets {
+				return nil, fmt.Errorf("the range contains more than %d buckets", MaxHistoryBuckets)
+			}
+			index[name] = len(res)
+			res = append(res, PuppetHistory{Date: name})
+		}
+
+		start, name = historyBucket(bucket, nextBucket(bucket, start))
+	}
+
+	//
+	// Rollups are by day, so extend the range to the start of the day
+	// in which it begins, and the end of the day in which it ends.
+	//
+	rollupExpr, rollups := rollupBuckets[bucket]
+	if rollups {
+		from = startOfDay(time.Unix(from, 0)).Unix()
+		end := startOfDay(time.Unix(to, 0))
+		if end.Unix() < to {
+			end = end.AddDate(0, 0, 1)
+		}
+		to = end.Unix()
+	}
+
+	q := newQuery("SELECT " + expr + " AS bucket, COUNT(CASE WHEN state = 'changed' THEN 1 END), COUNT(CASE WHEN state = 'unchanged' THEN 1 END), COUNT(CASE WHEN state = 'failed' THEN 1 END) FROM reports")
+	q.Where("executed_at >= ? AND executed_at < ?", from, to)
+
+	//
+	// Limit the reports to those of the nodes we're interested in.
+	//
+	filter.State = ""
+	filter.Apply(q, "")
+	queries := []*sqlQuery{q.Then("GROUP BY bucket")}
+
+	//
+	// Add the runs of the reports which have been pruned, but which
+	// are still counted in our daily rollups.
+	//
+	if rollups {
+		rollupRange := func(base string) *sqlQuery {
+			return newQuery(base).Where("day >= date(?, 'unixepoch', 'localtime') AND day < date(?, 'unixepoch', 'localtime')", from, to)
+		}
 
-		rows, err = stmt.Query(ts1, ts2)
+		if len(filter.Facts) > 0 || len(filter.Owner) > 0 {
+			var count int
+			plain := NodeFilter{Environment: filter.Environment, Fqdn: filter.Fqdn, Group: filter.Group}
+			stmt, args := plain.Apply(rollupRange("SELECT COUNT(*) FROM daily_rollups"), "").SQL()
+			err := db.QueryRow(stmt, args...).Scan(&count)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, errors.New("the runs in this range have been rolled up, so can't be filtered by facts, or owner")
+			}
+		}
+
+		rq := rollupRange("SELECT " + rollupExpr + " AS bucket, SUM(changed), SUM(unchanged), SUM(failed) FROM daily_rollups")
+		filter.Apply(rq, "")
+		queries = append(queries, rq.Then("GROUP BY bucket"))
+	}
+
+	for _, q := range queries {
+		rows, err := q.Query()
 		if err != nil {
 			return nil, err
 		}
-		defer stmt.Close()
-		defer rows.Close()
 
 		//
 		// For each row in the result-set
 		//
 		for rows.Next() {
 			var name string
-			var count string
+			var x PuppetHistory
 
-			err = rows.Scan(&name, &count)
+			err = rows.Scan(&name, &x.Changed, &x.Unchanged, &x.Failed)
 			if err != nil {
-				return nil, errors.New("failed to scan SQL")
-			}
-			if name == "changed" {
-				x.Changed = count
-			}
-			if name == "unchanged" {
-				x.Unchanged = count
+				rows.Close()
+				return nil, err
 			}
-			if name == "failed" {
-				x.Failed = count
+
+			i, ok := index[name]
+			if !ok {
+				continue
 			}
+			res[i].Changed += x.Changed
+			res[i].Unchanged += x.Unchanged
+			res[i].Failed += x.Failed
 		}
 		err = rows.Err()
+		rows.Close()
 		if err != nil {
 			return nil, err
 		}
-
-		//
-		// Add this days result.
-		//
-		res = append(res, x)
-
 	}
 
-	return res, err
+	return res, nil
+}
 
+//
+// rollupReports returns a statement which adds the runs of the reports
+// it matches to our daily rollups, so that they're still included in our
+// history once they've been removed.  The caller must add the conditions
+// which select the reports.
+//
+func rollupReports() *sqlQuery {
+	q := newQuery(`INSERT INTO daily_rollups(fqdn, environment, day, failed, changed, unchanged, runs, runtime)
+                       SELECT fqdn, IFNULL(environment,''), date(executed_at, 'unixepoch', 'localtime') AS day,
+                              COUNT(CASE WHEN state = 'failed' THEN 1 END),
+                              COUNT(CASE WHEN state = 'changed' THEN 1 END),
+                              COUNT(CASE WHEN state = 'unchanged' THEN 1 END),
+                              COUNT(*), SUM(IFNULL(runtime,0))
+                         FROM reports`)
+	return q.Then(`GROUP BY fqdn, day
+                ON CONFLICT(fqdn, day) DO UPDATE SET
+                   failed    = failed + excluded.failed,
+                   changed   = changed + excluded.changed,
+                   unchanged = unchanged + excluded.unchanged,
+                   runs      = runs + excluded.runs,
+                   runtime   = runtime + excluded.runtime`)
 }
 
 //
@@ -887,91 +1547,112 @@ func pruneReports(environment string, prefix string, days int, verbose bool) err
 		return errors.New("SetupDB not called")
 	}
 
-	//
-	// Select appropriate environment, if specified
-	//
-	envCondition := ""
-	if len(environment) > 0 {
-		envCondition = " AND environment = '" + environment + "'"
-	}
-
 	//
 	// Convert our query into something useful.
 	//
-	time := days * (24 * 60 * 60)
+	// The same moment is used by each statement, so that a report
+	// can't become old between rolling it up and removing it.
+	//
+	cutoff := time.Now().Unix() - int64(days*(24*60*60))
 
 	//
-	// Find things that are old.
+	// Find things that are old, within the appropriate environment
+	// if specified.
 	//
-	find, err := db.Prepare("SELECT id,yaml_file FROM reports WHERE ( ( strftime('%s','now') - executed_at ) > ? )" + envCondition)
-	if err != nil {
-		return err
-	}
+	find := newQuery("SELECT id,yaml_file FROM reports")
+	find.Where("executed_at < ?", cutoff)
+	NodeFilter{Environment: environment}.Apply(find, "")
 
 	//
-	// Remove old reports, en mass.
+	// Remove old reports, en mass, after adding them to our rollups.
 	//
-	clean, err := db.Prepare("DELETE FROM reports WHERE ( ( strftime('%s','now') - executed_at ) > ? )" + envCondition)
-	if err != nil {
-		return err
-	}
+	rollup := rollupReports()
+	rollup.Where("executed_at < ?", cutoff)
+	NodeFilter{Environment: environment}.Apply(rollup, "")
+
+	clean := newQuery("DELETE FROM reports")
+	clean.Where("executed_at < ?", cutoff)
+	NodeFilter{Environment: environment}.Apply(clean, "")
 
 	//
 	// Find the old reports.
 	//
-	rows, err := find.Query(time)
+	rows, err := find.Query()
 	if err != nil {
 		return err
 	}
-	defer find.Close()
-	defer clean.Close()
 	defer rows.Close()
 
 	//
 	// For each row in the result-set
 	//
-	// Parse into "id" + "path", then remove the path from disk.
+	// Parse into "id" + "path", which we remove once the records
+	// have gone.
 	//
+	var ids, paths []string
 	for rows.Next() {
 		var id string
 		var path string
 
 		err = rows.Scan(&id, &path)
 		if err == nil {
-
-			//
-			// Convert the path to a qualified one,
-			// rather than one relative to our report-dir.
-			//
-			path = filepath.Join(prefix, path)
-			if verbose {
-				fmt.Printf("Removing ID:%s - %s\n", id, path)
-			}
-
-			//
-			//  Remove the file from-disk
-			//
-			//  We won't care if this fails, it might have
-			// been removed behind our back or failed to
-			// be uploaded in the first place.
-			//
-			os.Remove(path)
+			ids = append(ids, id)
+			paths = append(paths, path)
 		}
 	}
 	err = rows.Err()
 	if err != nil {
 		return err
 	}
+	rows.Close()
 
 	//
-	//  Now cleanup the old records
+	//  Now cleanup the old records, in a single transaction so that
+	// a report is never removed without being rolled up.
 	//
-	_, err = clean.Exec(time)
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, args := rollup.SQL()
+	_, err = tx.Exec(stmt, args...)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	stmt, args = clean.SQL()
+	_, err = tx.Exec(stmt, args...)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	for i, path := range paths {
+
+		//
+		// Convert the path to a qualified one,
+		// rather than one relative to our report-dir.
+		//
+		path = filepath.Join(prefix, path)
+		if verbose {
+			fmt.Printf("Removing ID:%s - %s\n", ids[i], path)
+		}
+
+		//
+		//  Remove the file from-disk
+		//
+		//  We won't care if this fails, it might have
+		// been removed behind our back or failed to
+		// be uploaded in the first place.
+		//
+		os.Remove(path)
+	}
+
+	return pruneDetails()
 }
 
 //
@@ -991,28 +1672,20 @@ func pruneUnchanged(environment string, prefix string, verbose bool) error {
 	}
 
 	//
-	// Select appropriate environment, if specified
+	// Select the unchanged reports, from the appropriate environment
+	// if specified.
 	//
-	envCondition := ""
-	if len(environment) > 0 {
-		envCondition = " AND environment = '" + environment + "'"
-	}
+	filter := NodeFilter{Environment: environment, State: "unchanged"}
 
 	//
 	// Find unchanged reports.
 	//
-	find, err := db.Prepare("SELECT id,yaml_file FROM reports WHERE state='unchanged'" + envCondition)
-	if err != nil {
-		return err
-	}
+	find := filter.Apply(newQuery("SELECT id,yaml_file FROM reports"), "")
 
 	//
 	// Prepare to update them all.
 	//
-	clean, err := db.Prepare("UPDATE reports SET yaml_file='pruned' WHERE state='unchanged'" + envCondition)
-	if err != nil {
-		return err
-	}
+	clean := filter.Apply(newQuery("UPDATE reports SET yaml_file='pruned'"), "")
 
 	//
 	// Find the reports.
@@ -1021,8 +1694,6 @@ func pruneUnchanged(environment string, prefix string, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	defer find.Close()
-	defer clean.Close()
 	defer rows.Close()
 
 	//
@@ -1086,57 +1757,272 @@ func pruneOrphaned(environment string, prefix string, verbose bool) error {
 				fmt.Printf("Orphaned host: %s\n", entry.Fqdn)
 			}
 
-			//
-			// Find all reports that refer to this host.
-			//
-			rows, err := db.Query("SELECT yaml_file FROM reports WHERE fqdn=?", entry.Fqdn)
+			err = pruneNode(entry.Fqdn, prefix, verbose)
 			if err != nil {
 				return err
 			}
-			defer rows.Close()
+		}
 
-			for rows.Next() {
-				var tmp string
-				err = rows.Scan(&tmp)
-				if err != nil {
-					return err
-				}
+	}
 
-				//
-				// Convert the path to a qualified one,
-				// rather than one relative to our report-dir.
-				//
-				path := filepath.Join(prefix, tmp)
-				if verbose {
-					fmt.Printf("\tRemoving: %s\n", path)
-				}
+	return pruneDetails()
+}
 
-				//
-				//  Remove the file from-disk
-				//
-				//  We won't care if this fails, it might have
-				// been removed behind our back or failed to
-				// be uploaded in the first place.
-				//
-				os.Remove(path)
-			}
+//
+// pruneNode removes all the reports of the given node, after adding them
+// to our rollups, along with everything else we know about it.
+//
+func pruneNode(fqdn string, prefix string, verbose bool) error {
 
-			//
-			// Now remove the report-entries
-			//
-			clean, err := db.Prepare("DELETE FROM reports WHERE fqdn=?")
-			if err != nil {
-				return err
-			}
-			defer clean.Close()
-			_, err = clean.Exec(entry.Fqdn)
-			if err != nil {
-				return err
-			}
+	//
+	// Find all reports that refer to this host.
+	//
+	rows, err := db.Query("SELECT yaml_file FROM reports WHERE fqdn=?", fqdn)
+	if err != nil {
+		return err
+	}
 
+	var paths []string
+	for rows.Next() {
+		var tmp string
+		err = rows.Scan(&tmp)
+		if err != nil {
+			rows.Close()
+			return err
 		}
+		paths = append(paths, tmp)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return err
+	}
 
+	//
+	// Now remove the report-entries, after adding them to our
+	// rollups, in a single transaction.
+	//
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	rollup, args := rollupReports().Where("fqdn = ?", fqdn).SQL()
+	_, err = tx.Exec(rollup, args...)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM reports WHERE fqdn=?", fqdn)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = removeNode(tx, fqdn)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
 	}
 
+	for _, tmp := range paths {
+
+		//
+		// Convert the path to a qualified one,
+		// rather than one relative to our report-dir.
+		//
+		path := filepath.Join(prefix, tmp)
+		if verbose {
+			fmt.Printf("\tRemoving: %s\n", path)
+		}
+
+		//
+		//  Remove the file from-disk
+		//
+		//  We won't care if this fails, it might have
+		// been removed behind our back or failed to
+		// be uploaded in the first place.
+		//
+		os.Remove(path)
+	}
 	return nil
 }
+
+//
+// removeNode removes the facts, acknowledgement, and annotation, of a
+// node which was orphaned, using the given transaction.  Otherwise they
+// would be inherited by a node which later reused its name.
+//
+// Windows of maintenance are kept, as they may cover other nodes too.
+//
+func removeNode(tx *sql.Tx, fqdn string) error {
+	for _, stmt := range []string{
+		"DELETE FROM facts WHERE fqdn=?",
+		"DELETE FROM node_facts WHERE fqdn=?",
+		"DELETE FROM acknowledgements WHERE kind = 'acknowledgement' AND fqdn=?",
+		"DELETE FROM node_annotations WHERE fqdn=?",
+	} {
+		_, err := tx.Exec(stmt, fqdn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// Remove the timings, resources, and events, which belong to reports
+// that no longer exist.
+//
+func pruneDetails() error {
+
+	_, err := db.Exec("DELETE FROM report_timings WHERE report_id NOT IN ( SELECT id FROM reports )")
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("DELETE FROM report_resources WHERE report_id NOT IN ( SELECT id FROM reports )")
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("DELETE FROM report_events WHERE report_id NOT IN ( SELECT id FROM reports )")
+	if err != nil {
+		return err
+	}
+
+	return refreshNodes()
+}
+
+//
+// Record the outcome of applying our retention policy.
+//
+func addPruneHistory(result retentionResult) error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	_, err := db.Exec("INSERT INTO prune_history(started_at, duration, reports, nodes, bytes) VALUES(?,?,?,?,?)",
+		result.Started.Unix(), result.Duration.Seconds(), len(result.Reports), result.Nodes, result.Bytes)
+	return err
+}
+
+//
+// Get the most recent applications of our retention policy, newest first.
+//
+func getPruneHistory(limit int) ([]PruneRun, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	rows, err := newQuery("SELECT started_at, duration, reports, nodes, bytes FROM prune_history").
+		Then("ORDER BY id DESC LIMIT ?", limit).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []PruneRun
+	for rows.Next() {
+		var run PruneRun
+		err = rows.Scan(&run.Started, &run.Duration, &run.Reports, &run.Nodes, &run.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+//
+// Update the nodes-table after reports have been removed.
+//
+// Nodes with no remaining reports are removed, and the first-seen time
+// and run-count of the others recalculated.  If the most recent run of a
+// node was removed its state is taken from the latest run which remains,
+// as by rebuildNodes.
+//
+func refreshNodes() error {
+
+	_, err := db.Exec("DELETE FROM nodes WHERE fqdn NOT IN ( SELECT fqdn FROM reports )")
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`UPDATE nodes SET ( environment, state, runtime, last_report_id, last_seen ) = (
+                            SELECT environment, state, runtime, id, executed_at FROM reports
+                             WHERE reports.fqdn = nodes.fqdn
+                          ORDER BY executed_at DESC, id DESC LIMIT 1 )
+                           WHERE last_report_id NOT IN ( SELECT id FROM reports )`)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`UPDATE nodes SET
+                            first_seen = ( SELECT MIN(executed_at) FROM reports WHERE reports.fqdn = nodes.fqdn ),
+                            run_count  = ( SELECT COUNT(*) FROM reports WHERE reports.fqdn = nodes.fqdn )`)
+	return err
+}
+
+//
+// Rebuild the nodes-table from the reports we hold, returning the number
+// of nodes found.
+//
+// The table is updated as each report is inserted, so this is only
+// required when upgrading an older database, or if the reports-table
+// has been modified by hand.
+//
+func rebuildNodes() (int, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return 0, errors.New("SetupDB not called")
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec("DELETE FROM nodes")
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	//
+	// Find the most recent report of each node.
+	//
+	res, err := tx.Exec(`INSERT INTO nodes(fqdn, environment, state, runtime, last_report_id, first_seen, last_seen, run_count)
+                               SELECT fqdn, environment, state, runtime, id, first_seen, executed_at, run_count FROM (
+                                 SELECT fqdn, environment, state, runtime, id, executed_at,
+                                        MIN(executed_at) OVER (PARTITION BY fqdn) AS first_seen,
+                                        COUNT(*) OVER (PARTITION BY fqdn) AS run_count,
+                                        ROW_NUMBER() OVER (PARTITION BY fqdn ORDER BY executed_at DESC, id DESC) AS n
+                                 FROM reports WHERE fqdn IS NOT NULL
+                               ) WHERE n = 1`)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	return int(count), tx.Commit()
+}
@@ -0,0 +1,405 @@
+//
+// Checking, repairing, and compacting our database, and the reports
+// stored alongside it.
+//
+
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+//
+// dbCheck holds the problems found by checkDB.
+//
+type dbCheck struct {
+	// The problems reported by SQLite's integrity-check.
+	Integrity []string
+
+	// The reports whose file is missing, as paths relative to our prefix.
+	Missing []string
+
+	// The report files which aren't in our database.
+	Unindexed []string
+
+	// The files which aren't named as reports are.
+	Unexpected []string
+
+	// The temporary files left by uploads which were interrupted.
+	Stale []string
+}
+
+//
+// OK returns true if no problems were found.
+//
+func (c dbCheck) OK() bool {
+	return len(c.Integrity) == 0 && len(c.Missing) == 0 &&
+		len(c.Unindexed) == 0 && len(c.Unexpected) == 0 && len(c.Stale) == 0
+}
+
+//
+// dbEnvironmentStats holds the number of reports, and the size of their
+// files, of a single environment.
+//
+type dbEnvironmentStats struct {
+	Environment string
+	Reports     int
+	Bodies      int
+	Bytes       int64
+}
+
+//
+// dbStats holds the sizes reported by getDBStats.
+//
+type dbStats struct {
+	// The number of rows in each of our tables.
+	Tables map[string]int
+
+	// The reports of each environment.
+	Environments []dbEnvironmentStats
+
+	// The size of the database, and its write-ahead log.
+	DBSize  int64
+	WALSize int64
+}
+
+//
+// The tables whose rows are counted by getDBStats.
+//
+var dbTables = []string{"reports", "report_timings", "report_resources", "report_events", "nodes", "facts", "node_facts", "acknowledgements", "node_annotations", "daily_rollups", "prune_history"}
+
+//
+// Reports are stored beneath a directory named after their node, in a
+// file named after their SHA1-hash.
+//
+var reportName = regexp.MustCompile("^[0-9a-f]{40}$")
+
+//
+// Walk the reports beneath our prefix, returning the relative paths of
+// those which are named correctly, of those which are not, and of the
+// stale temporary files left by uploads.
+//
+// The temporary files which uploads are spooled into, named `.upload-*`,
+// are skipped while they're younger than `UploadTimeout`, as they're
+// renamed into place once they're complete.
+//
+func walkReports(prefix string) ([]string, []string, []string, error) {
+
+	var reports []string
+	var unexpected []string
+	var stale []string
+
+	cutoff := time.Now().Add(-UploadTimeout)
+
+	err := filepath.Walk(prefix, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == prefix {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(prefix, path)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), ".upload-") {
+			if info.ModTime().Before(cutoff) {
+				stale = append(stale, rel)
+			}
+			return nil
+		}
+		if reportName.MatchString(info.Name()) {
+			reports = append(reports, rel)
+		} else {
+			unexpected = append(unexpected, rel)
+		}
+		return nil
+	})
+	return reports, unexpected, stale, err
+}
+
+//
+// Check our database, and the reports beneath the given prefix, for
+// consistency.
+//
+func checkDB(prefix string) (dbCheck, error) {
+
+	var result dbCheck
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return result, errors.New("SetupDB not called")
+	}
+
+	rows, err := db.Query("PRAGMA integrity_check")
+	if err != nil {
+		return result, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var msg string
+		err = rows.Scan(&msg)
+		if err != nil {
+			return result, err
+		}
+		if msg != "ok" {
+			result.Integrity = append(result.Integrity, msg)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return result, err
+	}
+
+	//
+	// Find the files which our reports refer to.  The bodies of
+	// some reports will have been removed by our retention rules,
+	// and those of others were never stored.
+	//
+	files, err := db.Query("SELECT yaml_file FROM reports WHERE yaml_file IS NOT NULL AND yaml_file NOT IN ('', 'pruned')")
+	if err != nil {
+		return result, err
+	}
+	defer files.Close()
+
+	known := make(map[string]bool)
+	for files.Next() {
+		var file string
+		err = files.Scan(&file)
+		if err != nil {
+			return result, err
+		}
+		known[filepath.Clean(file)] = true
+	}
+	if err = files.Err(); err != nil {
+		return result, err
+	}
+
+	reports, unexpected, stale, err := walkReports(prefix)
+	if err != nil {
+		return result, err
+	}
+	result.Unexpected = unexpected
+	result.Stale = stale
+
+	found := make(map[string]bool)
+	for _, rel := range reports {
+		found[rel] = true
+		if !known[rel] {
+			result.Unindexed = append(result.Unindexed, rel)
+		}
+	}
+
+	for file := range known {
+		if !found[file] && !Exists(filepath.Join(prefix, file)) {
+			result.Missing = append(result.Missing, file)
+		}
+	}
+	sort.Strings(result.Missing)
+
+	return result, nil
+}
+
+//
+// Repair the problems with our reports found by checkDB, returning the
+// number of reports imported and the number marked as missing.
+//
+// Report files which aren't in our database are imported, as if they
+// had been submitted when the file was last modified.  Reports whose
+// file is missing are marked as expired, as if their body had been
+// removed by our retention rules, so that their summary remains.  The
+// stale temporary files of uploads are removed.
+//
+// Problems found by the integrity-check can't be repaired, other than
+// by restoring a backup.
+//
+func repairDB(prefix string, check dbCheck, verbose bool) (int, int, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return 0, 0, errors.New("SetupDB not called")
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	imported := 0
+	for _, rel := range check.Unindexed {
+		path := filepath.Join(prefix, rel)
+
+		info, err := os.Stat(path)
+		if err != nil {
+			tx.Rollback()
+			return 0, 0, err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			tx.Rollback()
+			return 0, 0, err
+		}
+
+		report, err := ParsePuppetReport(content)
+		if err != nil {
+			fmt.Printf("Warning - failed to parse %s: %s\n", path, err.Error())
+			continue
+		}
+
+		err = insertReport(tx, report, rel)
+		if err == nil {
+			_, err = tx.Exec("UPDATE reports SET executed_at = ? WHERE yaml_file = ?", info.ModTime().Unix(), rel)
+		}
+		if err != nil {
+			tx.Rollback()
+			return 0, 0, err
+		}
+		if verbose {
+			fmt.Printf("Imported %s\n", path)
+		}
+		imported++
+	}
+
+	for _, rel := range check.Missing {
+		_, err = tx.Exec("UPDATE reports SET yaml_file = 'pruned' WHERE yaml_file = ?", rel)
+		if err != nil {
+			tx.Rollback()
+			return 0, 0, err
+		}
+		if verbose {
+			fmt.Printf("Marked %s as missing\n", rel)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	//
+	// Remove the temporary files of interrupted uploads.
+	//
+	for _, rel := range check.Stale {
+		err = os.Remove(filepath.Join(prefix, rel))
+		if err != nil && !os.IsNotExist(err) {
+			return 0, 0, err
+		}
+		if verbose {
+			fmt.Printf("Removed %s\n", rel)
+		}
+	}
+
+	//
+	// The imported reports were recorded as the most recent run of
+	// their node, which they might not be.
+	//
+	if imported > 0 {
+		_, err = rebuildNodes()
+	}
+	return imported, len(check.Missing), err
+}
+
+//
+// Reclaim the space left unused in our database, by rebuilding it, and
+// truncate the write-ahead log.
+//
+func vacuumDB() error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	_, err := db.Exec("VACUUM")
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	return err
+}
+
+//
+// Get the number of rows in each of our tables, the reports of each
+// environment, and the size of the database at the given path.
+//
+func getDBStats(path string, prefix string) (dbStats, error) {
+
+	stats := dbStats{Tables: make(map[string]int)}
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return stats, errors.New("SetupDB not called")
+	}
+
+	for _, table := range dbTables {
+		var count int
+		err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+		if err != nil {
+			return stats, err
+		}
+		stats.Tables[table] = count
+	}
+
+	rows, err := db.Query("SELECT IFNULL(environment,''), yaml_file FROM reports ORDER BY environment")
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var env string
+		var file sql.NullString
+		err = rows.Scan(&env, &file)
+		if err != nil {
+			return stats, err
+		}
+
+		n := len(stats.Environments)
+		if n == 0 || stats.Environments[n-1].Environment != env {
+			stats.Environments = append(stats.Environments, dbEnvironmentStats{Environment: env})
+			n++
+		}
+		e := &stats.Environments[n-1]
+		e.Reports++
+
+		if !file.Valid || file.String == "" || file.String == "pruned" {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(prefix, file.String))
+		if err == nil {
+			e.Bodies++
+			e.Bytes += info.Size()
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return stats, err
+	}
+
+	if info, err := os.Stat(path); err == nil {
+		stats.DBSize = info.Size()
+	}
+	if info, err := os.Stat(path + "-wal"); err == nil {
+		stats.WALSize = info.Size()
+	}
+	return stats, nil
+}
@@ -0,0 +1,193 @@
+//
+//  Testing of the checking, repair, and compaction of our database.
+//
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+//
+// Store a copy of our valid report beneath the given prefix, with the
+// given name.
+//
+func addReportFile(t *testing.T, prefix string, rel string) {
+	content, err := ioutil.ReadFile("data/valid.yaml")
+	if err != nil {
+		t.Fatalf("Failed to read report: %s", err.Error())
+	}
+	path := filepath.Join(prefix, rel)
+	os.MkdirAll(filepath.Dir(path), 0755)
+	err = ioutil.WriteFile(path, content, 0644)
+	if err != nil {
+		t.Fatalf("Failed to write report: %s", err.Error())
+	}
+}
+
+//
+// Test that check finds the problems which repair fixes.
+//
+func TestCheckAndRepair(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	prefix := filepath.Join(path, "reports")
+	indexed := filepath.Join("foo.example.com", strings.Repeat("a", 40))
+	unindexed := filepath.Join("bar.example.com", strings.Repeat("b", 40))
+	missing := filepath.Join("baz.example.com", strings.Repeat("c", 40))
+
+	addReportFile(t, prefix, indexed)
+	addReportFile(t, prefix, unindexed)
+	addReportFile(t, prefix, "bar.example.com/notes.txt")
+	addReportFile(t, prefix, ".upload-123")
+	addReportFile(t, prefix, ".upload-456")
+
+	db.Exec("INSERT INTO reports(fqdn, environment, state, yaml_file, executed_at) VALUES('foo.example.com', 'production', 'changed', ?, 100)", indexed)
+	db.Exec("INSERT INTO reports(fqdn, environment, state, yaml_file, executed_at) VALUES('baz.example.com', 'production', 'failed', ?, 200)", missing)
+	db.Exec("INSERT INTO reports(fqdn, environment, state, yaml_file, executed_at) VALUES('qux.example.com', 'production', 'failed', 'pruned', 300)")
+
+	//
+	// The report which was never indexed was submitted yesterday.
+	//
+	yesterday := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	os.Chtimes(filepath.Join(prefix, unindexed), yesterday, yesterday)
+
+	//
+	// One upload is in progress, but the other was interrupted.
+	//
+	os.Chtimes(filepath.Join(prefix, ".upload-456"), yesterday, yesterday)
+
+	check, err := checkDB(prefix)
+	if err != nil {
+		t.Fatalf("Unexpected error checking: %s", err.Error())
+	}
+	if check.OK() {
+		t.Fatalf("Expected problems to be found")
+	}
+	if len(check.Integrity) != 0 {
+		t.Errorf("Unexpected integrity problems: %v", check.Integrity)
+	}
+	if len(check.Missing) != 1 || check.Missing[0] != missing {
+		t.Errorf("Unexpected missing files: %v", check.Missing)
+	}
+	if len(check.Unindexed) != 1 || check.Unindexed[0] != unindexed {
+		t.Errorf("Unexpected unindexed files: %v", check.Unindexed)
+	}
+	if len(check.Unexpected) != 1 || check.Unexpected[0] != "bar.example.com/notes.txt" {
+		t.Errorf("Unexpected unexpected files: %v", check.Unexpected)
+	}
+	if len(check.Stale) != 1 || check.Stale[0] != ".upload-456" {
+		t.Errorf("Unexpected stale uploads: %v", check.Stale)
+	}
+
+	imported, marked, err := repairDB(prefix, check, false)
+	if err != nil {
+		t.Fatalf("Unexpected error repairing: %s", err.Error())
+	}
+	if imported != 1 || marked != 1 {
+		t.Errorf("Imported %d and marked %d, not 1 and 1", imported, marked)
+	}
+
+	//
+	// The imported report was recorded at the time it was written.
+	//
+	var at int64
+	err = db.QueryRow("SELECT executed_at FROM reports WHERE yaml_file=?", unindexed).Scan(&at)
+	if err != nil {
+		t.Fatalf("Failed to find the imported report: %s", err.Error())
+	}
+	if at != yesterday.Unix() {
+		t.Errorf("Imported report recorded at %d, not %d", at, yesterday.Unix())
+	}
+
+	//
+	// The report whose file was missing is now shown as expired.
+	//
+	var id string
+	db.QueryRow("SELECT id FROM reports WHERE fqdn='baz.example.com'").Scan(&id)
+	_, err = getYAML(prefix, id)
+	if err != ErrReportExpired {
+		t.Errorf("Expected the missing report to have expired, got %v", err)
+	}
+
+	//
+	// Only the stray file, and the upload in progress, remain.
+	//
+	check, err = checkDB(prefix)
+	if err != nil {
+		t.Fatalf("Unexpected error checking: %s", err.Error())
+	}
+	if len(check.Missing) != 0 || len(check.Unindexed) != 0 || len(check.Unexpected) != 1 || len(check.Stale) != 0 {
+		t.Errorf("Unexpected problems after repair: %v", check)
+	}
+	if _, err = os.Stat(filepath.Join(prefix, ".upload-123")); err != nil {
+		t.Errorf("The upload in progress was removed: %v", err)
+	}
+}
+
+//
+// Test the sizes we report.
+//
+func TestDBStats(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	prefix := filepath.Join(path, "reports")
+	stored := filepath.Join("foo.example.com", strings.Repeat("a", 40))
+	addReportFile(t, prefix, stored)
+
+	db.Exec("INSERT INTO reports(fqdn, environment, yaml_file, executed_at) VALUES('foo.example.com', 'production', ?, 100)", stored)
+	db.Exec("INSERT INTO reports(fqdn, environment, yaml_file, executed_at) VALUES('foo.example.com', 'production', 'pruned', 50)")
+	db.Exec("INSERT INTO reports(fqdn, environment, yaml_file, executed_at) VALUES('bar.example.com', 'test', '', 100)")
+
+	err := vacuumDB()
+	if err != nil {
+		t.Fatalf("Unexpected error vacuuming: %s", err.Error())
+	}
+
+	stats, err := getDBStats(filepath.Join(path, "db.sql"), prefix)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if stats.Tables["reports"] != 3 {
+		t.Errorf("Found %d reports, not 3", stats.Tables["reports"])
+	}
+	if stats.DBSize == 0 {
+		t.Errorf("Expected the size of the database")
+	}
+	if len(stats.Environments) != 2 {
+		t.Fatalf("Unexpected environments: %v", stats.Environments)
+	}
+
+	info, _ := os.Stat(filepath.Join(prefix, stored))
+	prod := stats.Environments[0]
+	if prod.Environment != "production" || prod.Reports != 2 || prod.Bodies != 1 || prod.Bytes != info.Size() {
+		t.Errorf("Unexpected stats for production: %v", prod)
+	}
+
+	bak := out
+	buf := new(bytes.Buffer)
+	out = buf
+	err = runDB(dbCmd{dbFile: filepath.Join(path, "db.sql"), prefix: prefix}, "stats")
+	out = bak
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	if !strings.Contains(buf.String(), "production") {
+		t.Errorf("Environment missing from output: %s", buf.String())
+	}
+
+	err = runDB(dbCmd{}, "explode")
+	if err == nil {
+		t.Errorf("Expected an error with an unknown action")
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 )
@@ -35,6 +37,15 @@ func FakeDB() {
 
 }
 
+//
+// Close, and remove, the temporary database created by FakeDB.
+//
+func removeFakeDB() {
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
 //
 // Add some fake reports
 //
@@ -68,6 +79,11 @@ func addFakeReports() {
 		count++
 	}
 	tx.Commit()
+
+	//
+	// We've bypassed addDB, so update the nodes by hand.
+	//
+	rebuildNodes()
 }
 
 //
@@ -78,29 +94,29 @@ func addFakeNodes() {
 	var n PuppetReport
 	n.Fqdn = "foo.example.com"
 	n.State = "changed"
-	n.Runtime = "3.134"
-	n.Failed = "0"
-	n.Total = "1"
-	n.Changed = "2"
-	n.Skipped = "3"
+	n.Runtime = 3.134
+	n.Failed = 0
+	n.Total = 1
+	n.Changed = 2
+	n.Skipped = 3
 	addDB(n, "")
 
 	n.Fqdn = "bar.example.com"
 	n.State = "failed"
-	n.Runtime = "2.718"
-	n.Failed = "0"
-	n.Total = "1"
-	n.Changed = "2"
-	n.Skipped = "3"
+	n.Runtime = 2.718
+	n.Failed = 0
+	n.Total = 1
+	n.Changed = 2
+	n.Skipped = 3
 	addDB(n, "")
 
 	n.Fqdn = "foo.example.com"
 	n.State = "unchanged"
-	n.Runtime = "2.718"
-	n.Failed = "0"
-	n.Total = "1"
-	n.Changed = "2"
-	n.Skipped = "3"
+	n.Runtime = 2.718
+	n.Failed = 0
+	n.Total = 1
+	n.Changed = 2
+	n.Skipped = 3
 	addDB(n, "")
 
 	//
@@ -135,6 +151,13 @@ func addFakeNodes() {
 		panic("Failed to change report ")
 	}
 
+	//
+	// Which means the nodes-table must be rebuilt.
+	//
+	_, err = rebuildNodes()
+	if err != nil {
+		panic("Failed to rebuild nodes")
+	}
 }
 
 //
@@ -183,7 +206,7 @@ func TestMissingInit(t *testing.T) {
 		t.Errorf("Got wrong error: %v", err)
 	}
 
-	_, err = getHistory("", 60)
+	_, err = getHistory("", "day", 0, 1)
 	if !reg.MatchString(err.Error()) {
 		t.Errorf("Got wrong error: %v", err)
 	}
@@ -193,6 +216,51 @@ func TestMissingInit(t *testing.T) {
 		t.Errorf("Got wrong error: %v", err)
 	}
 
+	_, err = getNodeTimings("example.com", nil)
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = getSlowestTypes("")
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = getSlowestResources("", 10)
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = getLatency("", 0, true)
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = rebuildNodes()
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = getReportSummary("1")
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = applyRetention(&retentionPolicy{}, "", true)
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	err = addPruneHistory(retentionResult{})
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
+	_, err = getPruneHistory(1)
+	if !reg.MatchString(err.Error()) {
+		t.Errorf("Got wrong error: %v", err)
+	}
+
 }
 
 //
@@ -349,6 +417,62 @@ func TestPruneUnchanged(t *testing.T) {
 	os.RemoveAll(path)
 }
 
+//
+// Test removing orphaned nodes.
+//
+func TestPruneOrphaned(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// With some reports.
+	addFakeNodes()
+
+	//
+	// The failed node hasn't been seen for a long time.
+	//
+	prefix := filepath.Join(path, "reports")
+	file := filepath.Join("bar.example.com", strings.Repeat("b", 40))
+	addReportFile(t, prefix, file)
+	db.Exec("UPDATE reports SET yaml_file = ?, executed_at = 100 WHERE fqdn = 'bar.example.com'", file)
+	db.Exec("UPDATE nodes SET last_seen = 100 WHERE fqdn = 'bar.example.com'")
+	db.Exec("INSERT INTO node_facts(fqdn, name, value) VALUES('bar.example.com', 'os.family', 'Debian')")
+	db.Exec("INSERT INTO node_annotations(fqdn, owner) VALUES('bar.example.com', 'database')")
+
+	err := pruneOrphaned("", prefix, false)
+	if err != nil {
+		t.Fatalf("Unexpected error pruning: %s", err.Error())
+	}
+
+	//
+	// Its reports, file, facts, and annotation, are gone, but it is
+	// counted in our rollups.
+	//
+	count, _ := countReports()
+	if count != 2 {
+		t.Errorf("We have %d reports, not 2", count)
+	}
+	if _, err = os.Stat(filepath.Join(prefix, file)); !os.IsNotExist(err) {
+		t.Errorf("The report of the orphaned node was not removed")
+	}
+
+	var facts, annotations, runs int
+	db.QueryRow("SELECT COUNT(*) FROM node_facts WHERE fqdn = 'bar.example.com'").Scan(&facts)
+	db.QueryRow("SELECT COUNT(*) FROM node_annotations WHERE fqdn = 'bar.example.com'").Scan(&annotations)
+	db.QueryRow("SELECT IFNULL(SUM(runs),0) FROM daily_rollups WHERE fqdn = 'bar.example.com'").Scan(&runs)
+	if facts != 0 || annotations != 0 || runs != 1 {
+		t.Errorf("Unexpected facts %d, annotations %d, or rollups %d", facts, annotations, runs)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
 //
 //  Test the index nodes are valid
 //
@@ -458,7 +582,7 @@ func TestReports(t *testing.T) {
 }
 
 //
-//  Test the report-run are valid
+//  Test the history is bucketed correctly.
 //
 func TestHistory(t *testing.T) {
 
@@ -468,19 +592,100 @@ func TestHistory(t *testing.T) {
 	FakeDB()
 	addFakeNodes()
 
+	now := time.Now()
+
+	//
+	// We have three fake reports now, one of which is from 1970.
+	//
+	for bucket, buckets := range map[string]int{"hour": 3, "day": 2, "week": 2} {
+
+		from := now.AddDate(0, 0, -1)
+		if bucket == "hour" {
+			from = now.Add(-2 * time.Hour)
+		}
+		if bucket == "week" {
+			from = now.AddDate(0, 0, -7)
+		}
+
+		runs, err := getHistory("", bucket, from.Unix(), now.Unix()+1)
+		if err != nil {
+			t.Fatalf("getHistory failed: %v", err)
+		}
+		if len(runs) != buckets {
+			t.Errorf("getHistory returned the wrong number of %s buckets: %d", bucket, len(runs))
+		}
+
+		//
+		// The recent reports are in the most recent bucket.
+		//
+		last := runs[len(runs)-1]
+		if last.Changed != 1 || last.Failed != 1 || last.Unchanged != 0 {
+			t.Errorf("Unexpected counts for %s: %v", bucket, runs)
+		}
+	}
+
+	//
+	// The old report is found when we look for it, but it isn't
+	// in the production environment.
+	//
+	for environment, expected := range map[string]int{"": 1, "production": 0} {
+		runs, err := getHistory(environment, "day", 0, 24*60*60)
+		if err != nil {
+			t.Fatalf("getHistory failed: %v", err)
+		}
+
+		unchanged := 0
+		for _, run := range runs {
+			unchanged += run.Unchanged
+		}
+		if unchanged != expected {
+			t.Errorf("Unexpected history for '%s': %v", environment, runs)
+		}
+	}
+
 	//
-	// We have three fake nodes now, two of which have the same hostname.
+	// A day which began before our range is counted whole, whether
+	// its runs have been rolled up or not.
 	//
-	runs, err := getHistory("", 60)
+	day := startOfDay(now.AddDate(0, 0, -10))
+	db.Exec("INSERT INTO reports(fqdn,environment,state,executed_at) VALUES('old.example.com','production','changed',?)", day.Add(8*time.Hour).Unix())
+	db.Exec("INSERT INTO daily_rollups(fqdn,environment,day,failed,changed,unchanged,runs,runtime) VALUES('old.example.com','production',?,1,0,0,1,1)", day.Format("2006-01-02"))
+
+	runs, err := getHistory("production", "day", day.Add(12*time.Hour).Unix(), day.Add(36*time.Hour).Unix())
 	if err != nil {
-		t.Errorf("getHistory failed: %v", err)
+		t.Fatalf("getHistory failed: %v", err)
+	}
+	if len(runs) != 2 || runs[0].Changed != 1 || runs[0].Failed != 1 {
+		t.Errorf("Unexpected history of a partial day: %v", runs)
 	}
 
 	//
-	// Should have 2 runs, becase we have only one unique date..
+	// Our rollups can't be filtered by facts, or owner, but recent
+	// runs can.
 	//
-	if len(runs) != 2 {
-		t.Errorf("getReports returned wrong number of results: %d", len(runs))
+	_, err = getFilteredHistory(NodeFilter{Owner: "web"}, "day", day.Unix(), day.Add(24*time.Hour).Unix())
+	if err == nil {
+		t.Errorf("Expected an error filtering rollups by owner")
+	}
+	_, err = getFilteredHistory(NodeFilter{Facts: map[string]string{"os.family": "Debian"}}, "day", now.AddDate(0, 0, -1).Unix(), now.Unix())
+	if err != nil {
+		t.Errorf("Unexpected error filtering recent runs by facts: %s", err.Error())
+	}
+
+	//
+	// Bogus requests are rejected.
+	//
+	_, err = getHistory("", "month", 0, 1)
+	if err == nil {
+		t.Errorf("Expected an error for an unknown bucket")
+	}
+	_, err = getHistory("", "day", 10, 1)
+	if err == nil {
+		t.Errorf("Expected an error for an empty range")
+	}
+	_, err = getHistory("", "hour", 0, now.Unix())
+	if err == nil {
+		t.Errorf("Expected an error for too many buckets")
 	}
 
 	//
@@ -491,3 +696,421 @@ func TestHistory(t *testing.T) {
 	db = nil
 	os.RemoveAll(path)
 }
+
+//
+// Add a copy of our sample report, which contains timings, for the
+// given node.
+//
+func addTimedReport(t *testing.T, fqdn string) {
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal("Failed to load YAML asset data/valid.yaml")
+	}
+
+	report, err := ParsePuppetReport(tmpl)
+	if err != nil {
+		t.Fatalf("Failed to parse YAML: %s", err.Error())
+	}
+	report.Fqdn = fqdn
+
+	err = addDB(report, "")
+	if err != nil {
+		t.Fatalf("Failed to add report: %s", err.Error())
+	}
+}
+
+//
+// Timings are recorded when reports are added, and removed when they
+// are pruned.
+//
+func TestTimings(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	addTimedReport(t, "one.example.com")
+	addTimedReport(t, "one.example.com")
+	addTimedReport(t, "two.example.com")
+
+	//
+	// The node has a series for each category, bar the total.
+	//
+	reports, err := getReports("one.example.com")
+	if err != nil {
+		t.Fatalf("Failed to get reports: %s", err.Error())
+	}
+	timings, err := getNodeTimings("one.example.com", reports)
+	if err != nil {
+		t.Fatalf("Failed to get timings: %s", err.Error())
+	}
+	if len(timings) != 11 {
+		t.Errorf("Expected 11 categories, got %d", len(timings))
+	}
+	for _, series := range timings {
+		if series.Name == "total" {
+			t.Errorf("The total shouldn't be included")
+		}
+		if len(series.Values) != 2 {
+			t.Errorf("Expected a value for each report, got %v", series.Values)
+		}
+		if series.Name == "config_retrieval" && series.Values[0] < 2.9 {
+			t.Errorf("Unexpected config_retrieval time: %v", series.Values)
+		}
+	}
+
+	//
+	// Config-retrieval is the slowest category.
+	//
+	types, err := getSlowestTypes("")
+	if err != nil {
+		t.Fatalf("Failed to get slowest types: %s", err.Error())
+	}
+	if len(types) != 11 || types[0].Name != "config_retrieval" || types[0].Reports != 3 {
+		t.Errorf("Unexpected slowest types: %v", types)
+	}
+
+	types, err = getSlowestTypes("staging")
+	if err != nil || len(types) != 0 {
+		t.Errorf("Expected no timings for staging, got %v %v", types, err)
+	}
+
+	//
+	// Each report records the slowest resources.
+	//
+	resources, err := getSlowestResources("", 5)
+	if err != nil {
+		t.Fatalf("Failed to get slowest resources: %s", err.Error())
+	}
+	if len(resources) != 5 {
+		t.Fatalf("Expected 5 resources, got %d", len(resources))
+	}
+	if resources[0].Nodes != 2 || resources[0].Reports != 3 {
+		t.Errorf("Unexpected counts for %s: %v", resources[0].Resource, resources[0])
+	}
+	for i := 1; i < len(resources); i++ {
+		if resources[i].Average > resources[i-1].Average {
+			t.Errorf("Resources are not sorted by time")
+		}
+	}
+
+	//
+	// Pruning removes the details along with the reports.
+	//
+	err = pruneReports("", "", -1, false)
+	if err != nil {
+		t.Fatalf("Failed to prune: %s", err.Error())
+	}
+	types, err = getSlowestTypes("")
+	if err != nil || len(types) != 0 {
+		t.Errorf("Expected no timings after pruning, got %v %v", types, err)
+	}
+
+	var count int
+	err = db.QueryRow("SELECT COUNT(*) FROM report_resources").Scan(&count)
+	if err != nil || count != 0 {
+		t.Errorf("Expected no resources after pruning, got %d %v", count, err)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// The time taken by each stage of the runs is averaged per-environment.
+//
+func TestLatency(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Reports without timings are ignored.
+	addFakeNodes()
+
+	addTimedReport(t, "one.example.com")
+	addTimedReport(t, "two.example.com")
+
+	latency, err := getLatency("", 0, false)
+	if err != nil {
+		t.Fatalf("Failed to get latency: %s", err.Error())
+	}
+	if len(latency) != 1 {
+		t.Fatalf("Expected a single environment, got %v", latency)
+	}
+	if latency[0].Environment != "production" || latency[0].Reports != 2 {
+		t.Errorf("Unexpected result: %v", latency[0])
+	}
+	if latency[0].ConfigRetrieval < 2.92 || latency[0].ConfigRetrieval > 2.93 {
+		t.Errorf("Unexpected config_retrieval: %f", latency[0].ConfigRetrieval)
+	}
+	if latency[0].PluginSync != 0 {
+		t.Errorf("Unexpected plugin_sync: %f", latency[0].PluginSync)
+	}
+
+	//
+	// Hourly results are labeled with the hour.
+	//
+	latency, err = getLatency("production", 0, true)
+	if err != nil {
+		t.Fatalf("Failed to get latency: %s", err.Error())
+	}
+	if len(latency) < 1 || !strings.HasSuffix(latency[0].Period, ":00") {
+		t.Errorf("Unexpected hourly result: %v", latency)
+	}
+
+	//
+	// Nothing is returned for other environments, or the future.
+	//
+	latency, err = getLatency("staging", 0, true)
+	if err != nil || len(latency) != 0 {
+		t.Errorf("Expected no results, got %v %v", latency, err)
+	}
+	latency, err = getLatency("", time.Now().Unix()+60, true)
+	if err != nil || len(latency) != 0 {
+		t.Errorf("Expected no results, got %v %v", latency, err)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Databases created by older releases gain the columns they lack.
+//
+func TestMigration(t *testing.T) {
+
+	p, err := ioutil.TempDir(os.TempDir(), "prefix")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %s", err.Error())
+	}
+	defer os.RemoveAll(p)
+
+	old, err := sql.Open("sqlite3", p+"/db.sql")
+	if err != nil {
+		t.Fatalf("Failed to open database: %s", err.Error())
+	}
+	_, err = old.Exec("CREATE TABLE reports (id INTEGER PRIMARY KEY AUTOINCREMENT, fqdn text, state text, yaml_file text, runtime integer, executed_at integer(4), total integer, skipped integer, failed integer, changed integer)")
+	if err != nil {
+		t.Fatalf("Failed to create table: %s", err.Error())
+	}
+	_, err = old.Exec("INSERT INTO reports(fqdn,state,runtime,executed_at) values('old.example.com','failed',1.5,strftime('%s','now'))")
+	if err != nil {
+		t.Fatalf("Failed to add report: %s", err.Error())
+	}
+	old.Close()
+
+	err = SetupDB(p + "/db.sql")
+	if err != nil {
+		t.Fatalf("Failed to upgrade database: %s", err.Error())
+	}
+
+	for _, column := range []string{"environment", "config_retrieval", "fact_generation", "plugin_sync", "transaction_evaluation", "log_errors", "log_warnings"} {
+		var name string
+		err = db.QueryRow("SELECT name FROM pragma_table_info('reports') WHERE name=?", column).Scan(&name)
+		if err != nil {
+			t.Errorf("Column %s is missing: %v", column, err)
+		}
+	}
+
+	//
+	// The nodes-table is populated from the existing reports.
+	//
+	runs, err := getIndexNodes("")
+	if err != nil || len(runs) != 1 || runs[0].State != "failed" {
+		t.Errorf("Unexpected nodes: %v %v", runs, err)
+	}
+
+	db.Close()
+	db = nil
+}
+
+//
+// The count of logged errors and warnings is stored with each run.
+//
+func TestLogCounts(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	var n PuppetReport
+	n.Fqdn = "noisy.example.com"
+	n.Environment = "production"
+	n.State = "failed"
+	n.LogErrors = 3
+	n.LogWarnings = 2
+	err := addDB(n, "")
+	if err != nil {
+		t.Fatalf("Failed to add report: %s", err.Error())
+	}
+
+	reports, err := getReports("noisy.example.com")
+	if err != nil {
+		t.Fatalf("Failed to get reports: %s", err.Error())
+	}
+	if reports[0].LogErrors != 3 || reports[0].LogWarnings != 2 {
+		t.Errorf("Unexpected counts: %v", reports[0])
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// The nodes-table is updated as reports are added, and removed.
+//
+func TestNodes(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	var n PuppetReport
+	n.Fqdn = "one.example.com"
+	n.Environment = "production"
+	n.State = "failed"
+	n.Runtime = 2.5
+	addDB(n, "")
+
+	n.State = "changed"
+	n.Environment = "test"
+	addDB(n, "")
+
+	n.Fqdn = "two.example.com"
+	n.State = "unchanged"
+	addDB(n, "")
+
+	runs, err := getIndexNodes("test")
+	if err != nil {
+		t.Fatalf("getIndexNodes failed: %v", err)
+	}
+	if len(runs) != 2 || runs[0].Fqdn != "one.example.com" || runs[0].State != "changed" || runs[0].Environment != "test" || runs[0].Runtime != "2.5" {
+		t.Errorf("Unexpected nodes: %v", runs)
+	}
+
+	//
+	// The node no longer belongs to the environment of its
+	// first report.
+	//
+	runs, err = getIndexNodes("production")
+	if err != nil || len(runs) != 0 {
+		t.Errorf("Unexpected nodes: %v %v", runs, err)
+	}
+
+	var count, last int
+	err = db.QueryRow("SELECT run_count, last_report_id FROM nodes WHERE fqdn='one.example.com'").Scan(&count, &last)
+	if err != nil || count != 2 || last != 2 {
+		t.Errorf("Unexpected node: %d %d %v", count, last, err)
+	}
+
+	//
+	// Age the reports of one node, which will then be orphaned,
+	// and then pruned.
+	//
+	db.Exec("UPDATE reports SET executed_at = executed_at - ? WHERE fqdn='one.example.com'", 10*24*60*60)
+	found, err := rebuildNodes()
+	if err != nil || found != 2 {
+		t.Errorf("Unexpected rebuild: %d %v", found, err)
+	}
+
+	runs, _ = getIndexNodes("")
+	if len(runs) != 2 || runs[0].Fqdn != "two.example.com" || runs[1].State != "orphaned" {
+		t.Errorf("Unexpected nodes: %v", runs)
+	}
+
+	err = pruneReports("", "", 7, false)
+	if err != nil {
+		t.Errorf("Failed to prune: %s", err.Error())
+	}
+
+	runs, _ = getIndexNodes("")
+	if len(runs) != 1 || runs[0].Fqdn != "two.example.com" {
+		t.Errorf("Unexpected nodes: %v", runs)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Compare the time taken to find the state of each node from the nodes
+// table, and by scanning the reports, with 10k nodes and 1M reports.
+//
+//   go test -run XXX -bench IndexNodes
+//
+func BenchmarkIndexNodes(b *testing.B) {
+
+	nodes := 10000
+	reports := 1000000
+
+	// Create a fake database
+	FakeDB()
+	defer removeFakeDB()
+
+	tx, err := db.Begin()
+	if err != nil {
+		b.Fatal(err)
+	}
+	stmt, err := tx.Prepare("INSERT INTO reports(fqdn,environment,state,yaml_file,runtime,executed_at) values(?,?,?,?,?,?)")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	//
+	// The reports are spread over the past fortnight.
+	//
+	now := time.Now().Unix()
+	for i := 0; i < reports; i++ {
+		at := now - int64((reports-i)*14*24*60*60/reports)
+		_, err = stmt.Exec(fmt.Sprintf("node%d.example.com", i%nodes), "production", "unchanged", "", 1.5, at)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	stmt.Close()
+	tx.Commit()
+
+	found, err := rebuildNodes()
+	if err != nil || found != nodes {
+		b.Fatalf("Unexpected rebuild: %d %v", found, err)
+	}
+
+	b.Run("nodes", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			runs, err := getIndexNodes("")
+			if err != nil || len(runs) != nodes {
+				b.Fatalf("Unexpected nodes: %d %v", len(runs), err)
+			}
+		}
+	})
+
+	b.Run("reports", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rows, err := db.Query("SELECT fqdn, state, runtime, max(executed_at) FROM reports WHERE ( ( strftime('%s','now') - executed_at ) < ? ) GROUP by fqdn", 3.5*24*60*60)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for rows.Next() {
+			}
+			rows.Close()
+		}
+	})
+}
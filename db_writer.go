@@ -0,0 +1,394 @@
+//
+// This file contains a writer which batches report-insertions.
+//
+// Every report which is submitted to us results in a database insertion,
+// and with SQLite each committed transaction is relatively expensive.
+// Rather than opening a transaction per-report we queue submissions to a
+// single goroutine which coalesces them into shared transactions.
+//
+
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+)
+
+//
+// pendingReport is a single report which is waiting to be written, along
+// with the channel upon which the result of the write will be returned.
+//
+type pendingReport struct {
+	data   PuppetReport
+	path   string
+	result chan error
+}
+
+//
+// reportWriter accepts reports for insertion, and commits them to the
+// database in batches.
+//
+// A batch is committed when it contains `maxBatch` reports, or when the
+// oldest report in it has been waiting for `maxLatency`, whichever comes
+// first.
+//
+type reportWriter struct {
+	queue      chan *pendingReport
+	maxBatch   int
+	maxLatency time.Duration
+
+	// lock protects `closed`, and ensures that nothing is sent to
+	// the queue after it has been closed.
+	lock   sync.RWMutex
+	closed bool
+
+	// done is closed when the writer-goroutine has terminated.
+	done chan struct{}
+}
+
+//
+// The global writer, if batching has been enabled.
+//
+// When this is nil `addDB` will insert reports directly.
+//
+var writer *reportWriter
+
+//
+// newReportWriter creates a writer, and launches the goroutine which
+// will perform the database-writes.
+//
+func newReportWriter(maxBatch int, maxLatency time.Duration) *reportWriter {
+	if maxBatch < 1 {
+		maxBatch = 1
+	}
+	if maxLatency <= 0 {
+		maxLatency = 100 * time.Millisecond
+	}
+
+	w := &reportWriter{
+		queue:      make(chan *pendingReport, maxBatch*2),
+		maxBatch:   maxBatch,
+		maxLatency: maxLatency,
+		done:       make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+//
+// Submit queues the given report for insertion, and blocks until the
+// batch containing it has been committed.
+//
+// The error returned is the result of inserting this specific report.
+//
+func (w *reportWriter) Submit(data PuppetReport, path string) error {
+
+	p := &pendingReport{data: data, path: path, result: make(chan error, 1)}
+
+	w.lock.RLock()
+	if w.closed {
+		w.lock.RUnlock()
+		return errors.New("the report writer has been closed")
+	}
+	w.queue <- p
+	w.lock.RUnlock()
+
+	return <-p.result
+}
+
+//
+// Close stops accepting new reports, and waits for any which have been
+// queued already to be committed.
+//
+func (w *reportWriter) Close() {
+	w.lock.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+	w.lock.Unlock()
+
+	<-w.done
+}
+
+//
+// run is the body of the writer-goroutine.
+//
+// We wait for a report to arrive, then keep collecting more until either
+// the batch is full or the deadline for the first report has passed.
+//
+func (w *reportWriter) run() {
+	defer close(w.done)
+
+	for {
+		first, ok := <-w.queue
+		if !ok {
+			return
+		}
+
+		batch := []*pendingReport{first}
+		timer := time.NewTimer(w.maxLatency)
+		open := true
+
+	collect:
+		for len(batch) < w.maxBatch {
+			select {
+			case p, more := <-w.queue:
+				if !more {
+					open = false
+					break collect
+				}
+				batch = append(batch, p)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+
+		w.flush(batch)
+
+		if !open {
+			return
+		}
+	}
+}
+
+//
+// flush writes the given batch of reports inside a single transaction,
+// and notifies each submitter of the outcome.
+//
+func (w *reportWriter) flush(batch []*pendingReport) {
+
+	//
+	// Record the result for every report in the batch.
+	//
+	fail := func(err error) {
+		for _, p := range batch {
+			p.result <- err
+		}
+	}
+
+	if db == nil {
+		fail(errors.New("SetupDB not called"))
+		return
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	//
+	// Insert each report within its own savepoint, remembering the
+	// individual results, so that a report which fails part-way
+	// through leaves nothing behind.
+	//
+	errs := make([]error, len(batch))
+	for i, p := range batch {
+		_, err = tx.Exec("SAVEPOINT r")
+		if err != nil {
+			tx.Rollback()
+			fail(err)
+			return
+		}
+
+		errs[i] = insertSubmission(tx, p.data, p.path)
+		if errs[i] != nil {
+			_, err = tx.Exec("ROLLBACK TO r")
+			if err != nil {
+				tx.Rollback()
+				fail(err)
+				return
+			}
+		}
+
+		_, err = tx.Exec("RELEASE r")
+		if err != nil {
+			tx.Rollback()
+			fail(err)
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		fail(err)
+		return
+	}
+
+	for i, p := range batch {
+		p.result <- errs[i]
+	}
+}
+
+//
+// insertSubmission adds a report which has just been submitted to the
+// database, using the given transaction.
+//
+// A node which has recovered is no longer acknowledged, so that we notice
+// if it fails again.  Only a report which was executed after we last heard
+// from the node counts, so one which was delayed doesn't, and reports
+// imported by `db repair` never do.
+//
+func insertSubmission(tx *sql.Tx, data PuppetReport, path string) error {
+
+	var seen int64
+	err := tx.QueryRow("SELECT IFNULL(MAX(last_seen),0) FROM nodes WHERE fqdn = ?", data.Fqdn).Scan(&seen)
+	if err != nil {
+		return err
+	}
+
+	//
+	// A report whose time we couldn't parse is assumed to be current.
+	//
+	newer := data.Executed.IsZero() || data.Executed.Unix() >= seen
+
+	err = insertReport(tx, data, path)
+	if err != nil {
+		return err
+	}
+
+	if data.State != "failed" && newer {
+		_, err = tx.Exec("DELETE FROM acknowledgements WHERE kind = 'acknowledgement' AND fqdn = ?", data.Fqdn)
+	}
+	return err
+}
+
+//
+// insertReport adds a single report to the database, using the given
+// transaction.
+//
+func insertReport(tx *sql.Tx, data PuppetReport, path string) error {
+
+	//
+	// The time taken by a stage of the run, or NULL if the report
+	// didn't include it.
+	//
+	stage := func(name string) interface{} {
+		v, ok := data.Metrics.Time.Get(name)
+		if !ok {
+			return nil
+		}
+		return v
+	}
+
+	now := time.Now().Unix()
+
+	//
+	// A value which is NULL when absent.
+	//
+	optional := func(value string) interface{} {
+		if value == "" {
+			return nil
+		}
+		return value
+	}
+
+	//
+	// Older reports don't record their format.
+	//
+	var format interface{}
+	if data.ReportFormat != 0 {
+		format = data.ReportFormat
+	}
+
+	res, err := tx.Exec("INSERT INTO reports(fqdn,environment,state,yaml_file,executed_at,runtime, failed, changed, total, skipped, config_retrieval, fact_generation, plugin_sync, transaction_evaluation, log_errors, log_warnings, hash, puppet_version, configuration_version, report_format, code_id) values(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)",
+		data.Fqdn,
+		data.Environment,
+		data.State,
+		path,
+		now,
+		data.Runtime,
+		data.Failed,
+		data.Changed,
+		data.Total,
+		data.Skipped,
+		stage("config_retrieval"),
+		stage("fact_generation"),
+		stage("plugin_sync"),
+		stage("transaction_evaluation"),
+		data.LogErrors,
+		data.LogWarnings,
+		optional(data.Hash),
+		optional(data.PuppetVersion),
+		optional(data.ConfigurationVersion),
+		format,
+		optional(data.CodeID))
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	//
+	// Record the time taken by each category.
+	//
+	for _, m := range data.Metrics.Time {
+		_, err = tx.Exec("INSERT INTO report_timings(report_id,name,label,value) values(?,?,?,?)",
+			id, m.Name, m.Label, m.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	//
+	// Record the slowest resources.
+	//
+	for _, r := range data.Slowest(SlowResources) {
+		_, err = tx.Exec("INSERT INTO report_resources(report_id,resource,type,file,line,evaluation_time) values(?,?,?,?,?,?)",
+			id, r.Name, r.Type, r.File, r.Line, r.EvaluationTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	//
+	// Record the resources which failed, changed, or were skipped,
+	// once each, with the most significant outcome.
+	//
+	seen := make(map[string]bool)
+	events := []struct {
+		status    string
+		resources []Resource
+	}{
+		{"failure", data.ResourcesFailed},
+		{"success", data.ResourcesChanged},
+		{"skipped", data.ResourcesSkipped},
+	}
+	for _, e := range events {
+		for _, r := range e.resources {
+			key := r.Type + "[" + r.Name + "]"
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			_, err = tx.Exec("INSERT INTO report_events(report_id,status,resource_type,resource_title,file,line) values(?,?,?,?,?,?)",
+				id, e.status, r.Type, r.Name, r.File, r.Line)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	//
+	// Finally update the node's most recent state.
+	//
+	_, err = tx.Exec(`INSERT INTO nodes(fqdn, environment, state, runtime, last_report_id, first_seen, last_seen, run_count) values(?,?,?,?,?,?,?,1)
+                          ON CONFLICT(fqdn) DO UPDATE SET
+                            environment    = excluded.environment,
+                            state          = excluded.state,
+                            runtime        = excluded.runtime,
+                            last_report_id = excluded.last_report_id,
+                            last_seen      = excluded.last_seen,
+                            run_count      = run_count + 1`,
+		data.Fqdn, data.Environment, data.State, data.Runtime, id, now, now)
+	return err
+}
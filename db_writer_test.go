@@ -0,0 +1,263 @@
+//
+//  Testing of our batching report-writer.
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+//
+// Create a report suitable for insertion.
+//
+func fakeReport(i int) PuppetReport {
+	var n PuppetReport
+	n.Fqdn = fmt.Sprintf("node%d.example.com", i)
+	n.Environment = "production"
+	n.State = "changed"
+	n.Runtime = 1.234
+	n.Failed = 0
+	n.Total = 10
+	n.Changed = 1
+	n.Skipped = 0
+	return n
+}
+
+//
+// Reports submitted concurrently are all written.
+//
+func TestWriterBatches(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	writer = newReportWriter(10, 50*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := addDB(fakeReport(i), "")
+			if err != nil {
+				t.Errorf("unexpected error adding report: %s", err.Error())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	writer.Close()
+	writer = nil
+
+	count, err := countReports()
+	if err != nil {
+		t.Errorf("Error counting reports")
+	}
+	if count != 25 {
+		t.Errorf("We have %d reports, not 25", count)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// A failure to write is returned to the submitter.
+//
+func TestWriterErrors(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// Remove the table so that insertions fail.
+	_, err := db.Exec("DROP TABLE reports")
+	if err != nil {
+		t.Fatalf("failed to drop table: %s", err.Error())
+	}
+
+	writer = newReportWriter(10, 10*time.Millisecond)
+
+	err = addDB(fakeReport(1), "")
+	if err == nil {
+		t.Errorf("expected an error inserting into a missing table")
+	} else if !strings.Contains(err.Error(), "no such table") {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+
+	writer.Close()
+
+	//
+	// Once closed submissions are refused.
+	//
+	err = addDB(fakeReport(2), "")
+	if err == nil || !strings.Contains(err.Error(), "closed") {
+		t.Errorf("expected an error submitting to a closed writer, got %v", err)
+	}
+	writer = nil
+
+	//
+	// The direct path reports errors too.
+	//
+	err = addDB(fakeReport(3), "")
+	if err == nil {
+		t.Errorf("expected an error inserting into a missing table")
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// A report which fails part-way through is removed from its batch, and
+// the others are still committed.
+//
+func TestWriterPartialFailure(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	//
+	// Fail the last statement of a single node's insertion, after
+	// its report has been inserted.
+	//
+	_, err := db.Exec(`CREATE TRIGGER broken BEFORE INSERT ON nodes WHEN NEW.fqdn = 'node2.example.com'
+                           BEGIN SELECT RAISE(ABORT, 'broken node'); END`)
+	if err != nil {
+		t.Fatalf("failed to create trigger: %s", err.Error())
+	}
+
+	writer = newReportWriter(10, 200*time.Millisecond)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = addDB(fakeReport(i), "")
+		}(i)
+	}
+	wg.Wait()
+
+	writer.Close()
+	writer = nil
+
+	for i, err := range errs {
+		if (i == 2) != (err != nil) {
+			t.Errorf("unexpected result for report %d: %v", i, err)
+		}
+	}
+
+	count, err := countReports()
+	if err != nil {
+		t.Errorf("Error counting reports")
+	}
+	if count != 3 {
+		t.Errorf("We have %d reports, not 3", count)
+	}
+
+	var orphans int
+	err = db.QueryRow("SELECT COUNT(*) FROM report_timings WHERE report_id NOT IN ( SELECT id FROM reports )").Scan(&orphans)
+	if err != nil || orphans != 0 {
+		t.Errorf("Unexpected timings of the failed report: %d %v", orphans, err)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Submit reports at the given rate, and record the throughput we
+// actually achieved along with the average latency of a submission.
+//
+func benchmarkSubmissions(b *testing.B, rate int, batched bool) {
+
+	// Create a fake database
+	FakeDB()
+
+	if batched {
+		writer = newReportWriter(100, 100*time.Millisecond)
+	}
+
+	interval := time.Second / time.Duration(rate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var latency time.Duration
+
+	b.ResetTimer()
+	start := time.Now()
+
+	for i := 0; i < b.N; i++ {
+		<-ticker.C
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			t := time.Now()
+			err := addDB(fakeReport(i), "")
+			if err != nil {
+				b.Errorf("failed to add report: %s", err.Error())
+			}
+
+			lock.Lock()
+			latency += time.Since(t)
+			lock.Unlock()
+		}(i)
+	}
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	b.StopTimer()
+
+	b.ReportMetric(float64(b.N)/elapsed.Seconds(), "reports/s")
+	b.ReportMetric(float64(latency.Milliseconds())/float64(b.N), "ms/report")
+
+	if batched {
+		writer.Close()
+		writer = nil
+	}
+
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Compare direct and batched insertion, at 100 and 1000 reports
+// per second.
+//
+func BenchmarkSubmissions(b *testing.B) {
+	for _, rate := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("direct-%d", rate), func(b *testing.B) {
+			benchmarkSubmissions(b, rate, false)
+		})
+		b.Run(fmt.Sprintf("batched-%d", rate), func(b *testing.B) {
+			benchmarkSubmissions(b, rate, true)
+		})
+	}
+}
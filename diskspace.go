@@ -0,0 +1,23 @@
+//go:build !windows
+// +build !windows
+
+//
+// Find the free space beneath a directory.
+//
+
+package main
+
+import "syscall"
+
+//
+// freeSpace returns the number of bytes available to us on the
+// filesystem containing the given path.
+//
+func freeSpace(path string) (int64, error) {
+	var st syscall.Statfs_t
+	err := syscall.Statfs(path, &st)
+	if err != nil {
+		return 0, err
+	}
+	return int64(st.Bavail) * int64(st.Bsize), nil
+}
@@ -0,0 +1,13 @@
+//
+// Find the free space beneath a directory.
+//
+
+package main
+
+//
+// freeSpace is not implemented on Windows, so we report that the space
+// available is unknown.
+//
+func freeSpace(path string) (int64, error) {
+	return -1, nil
+}
@@ -0,0 +1,514 @@
+//
+// Exporting our reports, the resources they recorded, and the messages
+// they logged, as CSV, newline-delimited JSON, or Parquet.
+//
+// Rows are written as they are read from the database, so the size of
+// an export is limited only by the space available to the reader.
+//
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+//
+// exportColumn is a single column of an export.
+//
+type exportColumn struct {
+	Name string
+
+	// One of `string`, `int`, or `float`.
+	Kind string
+}
+
+//
+// ExportFilter describes the reports which should be exported.
+//
+type ExportFilter struct {
+	NodeFilter
+
+	// The range of time within which the reports were submitted.  Each
+	// is ignored when zero.
+	From time.Time
+	To   time.Time
+}
+
+//
+// Apply adds the conditions of the filter to the given query.
+//
+func (f ExportFilter) Apply(q *sqlQuery, prefix string) *sqlQuery {
+	f.NodeFilter.Apply(q, prefix)
+	if !f.From.IsZero() {
+		q.Where(prefix+"executed_at >= ?", f.From.Unix())
+	}
+	if !f.To.IsZero() {
+		q.Where(prefix+"executed_at < ?", f.To.Unix())
+	}
+	return q
+}
+
+//
+// exportOptions holds the settings of a single export.
+//
+type exportOptions struct {
+	// One of `reports`, `resources`, or `logs`.
+	Dataset string
+
+	// One of `csv`, `ndjson`, or `parquet`.
+	Format string
+
+	Filter ExportFilter
+
+	// The prefix beneath which the bodies of our reports are stored,
+	// from which logged messages are read.
+	Prefix string
+
+	// If set, the logged messages are masked with this.
+	Redactor *reportRedactor
+}
+
+//
+// An exportDataset produces the rows of an export, passing each to the
+// given function.
+//
+type exportDataset struct {
+	Columns []exportColumn
+	Rows    func(opts exportOptions, emit func([]interface{}) error) error
+}
+
+//
+// The columns of each dataset which are common to every row of a report.
+//
+var exportReportColumns = []exportColumn{
+	{"report_id", "int"},
+	{"fqdn", "string"},
+	{"environment", "string"},
+	{"executed_at", "int"},
+}
+
+//
+// The columns of each dataset.
+//
+var (
+	exportSummaryColumns = append(append([]exportColumn{}, exportReportColumns...),
+		exportColumn{"state", "string"},
+		exportColumn{"runtime", "float"},
+		exportColumn{"total", "int"},
+		exportColumn{"changed", "int"},
+		exportColumn{"failed", "int"},
+		exportColumn{"skipped", "int"},
+		exportColumn{"config_retrieval", "float"},
+		exportColumn{"fact_generation", "float"},
+		exportColumn{"plugin_sync", "float"},
+		exportColumn{"transaction_evaluation", "float"},
+		exportColumn{"log_errors", "int"},
+		exportColumn{"log_warnings", "int"},
+	)
+
+	exportResourceColumns = append(append([]exportColumn{}, exportReportColumns...),
+		exportColumn{"resource", "string"},
+		exportColumn{"type", "string"},
+		exportColumn{"file", "string"},
+		exportColumn{"line", "string"},
+		exportColumn{"evaluation_time", "float"},
+	)
+
+	exportLogColumns = append(append([]exportColumn{}, exportReportColumns...),
+		exportColumn{"time", "string"},
+		exportColumn{"level", "string"},
+		exportColumn{"source", "string"},
+		exportColumn{"message", "string"},
+		exportColumn{"file", "string"},
+		exportColumn{"line", "string"},
+		exportColumn{"tags", "string"},
+	)
+)
+
+//
+// The datasets we can export.
+//
+var exportDatasets = map[string]exportDataset{
+	"reports":   {Columns: exportSummaryColumns, Rows: exportReports},
+	"resources": {Columns: exportResourceColumns, Rows: exportResources},
+	"logs":      {Columns: exportLogColumns, Rows: exportLogs},
+}
+
+//
+// The content-type of each format we can export.
+//
+var exportFormats = map[string]string{
+	"csv":     "text/csv",
+	"ndjson":  "application/x-ndjson",
+	"parquet": "application/vnd.apache.parquet",
+}
+
+//
+// Validate returns an error if the options contain anything bogus.
+//
+func (o exportOptions) Validate() error {
+	if _, ok := exportDatasets[o.Dataset]; !ok {
+		return fmt.Errorf("invalid dataset '%s', expected reports, resources, or logs", o.Dataset)
+	}
+	if _, ok := exportFormats[o.Format]; !ok {
+		return fmt.Errorf("invalid format '%s', expected csv, ndjson, or parquet", o.Format)
+	}
+	if !o.Filter.From.IsZero() && !o.Filter.To.IsZero() && !o.Filter.To.After(o.Filter.From) {
+		return fmt.Errorf("the end of the range must be after its start")
+	}
+	return o.Filter.Validate()
+}
+
+//
+// Pass each row of the given query to `emit`, scanning its values as the
+// kinds of the given columns.
+//
+func exportQuery(q *sqlQuery, columns []exportColumn, emit func([]interface{}) error) error {
+
+	rows, err := q.Query()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i, c := range columns {
+			switch c.Kind {
+			case "int":
+				ptrs[i] = new(int64)
+			case "float":
+				ptrs[i] = new(float64)
+			default:
+				ptrs[i] = new(string)
+			}
+		}
+
+		err = rows.Scan(ptrs...)
+		if err != nil {
+			return err
+		}
+		for i, p := range ptrs {
+			switch v := p.(type) {
+			case *int64:
+				row[i] = *v
+			case *float64:
+				row[i] = *v
+			case *string:
+				row[i] = *v
+			}
+		}
+
+		err = emit(row)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+//
+// Export a summary of each report.
+//
+func exportReports(opts exportOptions, emit func([]interface{}) error) error {
+	q := newQuery(`SELECT id, IFNULL(fqdn,''), IFNULL(environment,''), executed_at, IFNULL(state,''), IFNULL(runtime,0),
+                              IFNULL(total,0), IFNULL(changed,0), IFNULL(failed,0), IFNULL(skipped,0),
+                              IFNULL(config_retrieval,0), IFNULL(fact_generation,0), IFNULL(plugin_sync,0), IFNULL(transaction_evaluation,0),
+                              IFNULL(log_errors,0), IFNULL(log_warnings,0)
+                       FROM reports`)
+	opts.Filter.Apply(q, "")
+	q.Then("ORDER BY executed_at, id")
+	return exportQuery(q, exportSummaryColumns, emit)
+}
+
+//
+// Export the slowest resources we recorded of each report.
+//
+func exportResources(opts exportOptions, emit func([]interface{}) error) error {
+	q := newQuery(`SELECT r.id, IFNULL(r.fqdn,''), IFNULL(r.environment,''), r.executed_at,
+                              IFNULL(rr.resource,''), IFNULL(rr.type,''), IFNULL(rr.file,''), IFNULL(rr.line,''), IFNULL(rr.evaluation_time,0)
+                       FROM reports r JOIN report_resources rr ON rr.report_id = r.id`)
+	opts.Filter.Apply(q, "r.")
+	q.Then("ORDER BY r.executed_at, r.id, rr.evaluation_time DESC")
+	return exportQuery(q, exportResourceColumns, emit)
+}
+
+//
+// Export the messages logged by each report, which are read from its
+// body.  Reports whose body has expired are skipped.
+//
+func exportLogs(opts exportOptions, emit func([]interface{}) error) error {
+
+	columns := append(append([]exportColumn{}, exportReportColumns...), exportColumn{"yaml_file", "string"})
+
+	q := newQuery("SELECT id, IFNULL(fqdn,''), IFNULL(environment,''), executed_at, IFNULL(yaml_file,'') FROM reports")
+	opts.Filter.Apply(q, "")
+	q.Where("yaml_file IS NOT NULL AND yaml_file NOT IN ('', 'pruned')")
+	q.Then("ORDER BY executed_at, id")
+
+	return exportQuery(q, columns, func(report []interface{}) error {
+		path := filepath.Join(opts.Prefix, report[4].(string))
+		content, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if opts.Redactor != nil {
+			content, _, err = opts.Redactor.Redact(content)
+			if err != nil {
+				return err
+			}
+		}
+
+		parsed, err := ParsePuppetReport(content)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning - failed to parse %s: %s\n", path, err.Error())
+			return nil
+		}
+
+		for _, l := range parsed.Logs {
+			err = emit([]interface{}{report[0], report[1], report[2], report[3],
+				l.Time, l.Level, l.Source, l.Message, l.File, l.Line, strings.Join(l.Tags, ",")})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//
+// exportWriter writes the rows of an export in a single format.
+//
+type exportWriter interface {
+	Write(row []interface{}) error
+	Close() error
+}
+
+//
+// Create a writer of the given format.
+//
+func newExportWriter(format string, w io.Writer, columns []exportColumn) (exportWriter, error) {
+	switch format {
+	case "csv":
+		return newCSVExport(w, columns)
+	case "ndjson":
+		return &jsonExport{w: w, columns: columns}, nil
+	case "parquet":
+		return newParquetExport(w, columns), nil
+	}
+	return nil, fmt.Errorf("invalid format '%s'", format)
+}
+
+//
+// Format a single value as text.
+//
+func exportText(v interface{}) string {
+	switch x := v.(type) {
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case string:
+		return x
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+//
+// csvExport writes rows as CSV, with a header naming the columns.
+//
+type csvExport struct {
+	w    *csv.Writer
+	rows int
+}
+
+func newCSVExport(w io.Writer, columns []exportColumn) (*csvExport, error) {
+	c := &csvExport{w: csv.NewWriter(w)}
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Name
+	}
+	return c, c.w.Write(header)
+}
+
+func (c *csvExport) Write(row []interface{}) error {
+	record := make([]string, len(row))
+	for i, v := range row {
+		record[i] = exportText(v)
+	}
+	err := c.w.Write(record)
+	if err != nil {
+		return err
+	}
+
+	c.rows++
+	if c.rows%1000 == 0 {
+		c.w.Flush()
+		return c.w.Error()
+	}
+	return nil
+}
+
+func (c *csvExport) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+//
+// jsonExport writes each row as a JSON object, upon a line of its own.
+//
+type jsonExport struct {
+	w       io.Writer
+	columns []exportColumn
+}
+
+func (j *jsonExport) Write(row []interface{}) error {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, v := range row {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		key, _ := json.Marshal(j.columns[i].Name)
+		val, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		sb.Write(key)
+		sb.WriteString(":")
+		sb.Write(val)
+	}
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(j.w, sb.String())
+	return err
+}
+
+func (j *jsonExport) Close() error {
+	return nil
+}
+
+//
+// The number of rows within each row-group of a Parquet export, which
+// limits the number of rows held in memory.
+//
+const parquetRowGroup = 10000
+
+//
+// parquetExport writes rows as a Parquet file.
+//
+type parquetExport struct {
+	w *parquet.Writer
+
+	// The index of each of our columns within the schema, which
+	// orders them by name.
+	index []int
+
+	rows int
+}
+
+func newParquetExport(w io.Writer, columns []exportColumn) *parquetExport {
+	group := parquet.Group{}
+	for _, c := range columns {
+		switch c.Kind {
+		case "int":
+			group[c.Name] = parquet.Int(64)
+		case "float":
+			group[c.Name] = parquet.Leaf(parquet.DoubleType)
+		default:
+			group[c.Name] = parquet.String()
+		}
+	}
+	schema := parquet.NewSchema("export", group)
+
+	position := make(map[string]int)
+	for i, f := range schema.Fields() {
+		position[f.Name()] = i
+	}
+	index := make([]int, len(columns))
+	for i, c := range columns {
+		index[i] = position[c.Name]
+	}
+
+	return &parquetExport{
+		w:     parquet.NewWriter(w, schema, parquet.Compression(&parquet.Snappy)),
+		index: index,
+	}
+}
+
+func (p *parquetExport) Write(row []interface{}) error {
+	values := make(parquet.Row, len(row))
+	for i, v := range row {
+		var value parquet.Value
+		switch x := v.(type) {
+		case int64:
+			value = parquet.Int64Value(x)
+		case float64:
+			value = parquet.DoubleValue(x)
+		default:
+			value = parquet.ByteArrayValue([]byte(exportText(v)))
+		}
+		values[p.index[i]] = value.Level(0, 0, p.index[i])
+	}
+
+	_, err := p.w.WriteRows([]parquet.Row{values})
+	if err != nil {
+		return err
+	}
+
+	p.rows++
+	if p.rows%parquetRowGroup == 0 {
+		return p.w.Flush()
+	}
+	return nil
+}
+
+func (p *parquetExport) Close() error {
+	return p.w.Close()
+}
+
+//
+// Write the rows described by the given options to `w`, returning the
+// number written.
+//
+func runExport(w io.Writer, opts exportOptions) (int, error) {
+
+	err := opts.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	dataset := exportDatasets[opts.Dataset]
+	out, err := newExportWriter(opts.Format, w, dataset.Columns)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = dataset.Rows(opts, func(row []interface{}) error {
+		count++
+		return out.Write(row)
+	})
+	if err != nil {
+		out.Close()
+		return count, err
+	}
+	return count, out.Close()
+}
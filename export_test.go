@@ -0,0 +1,137 @@
+//
+//  Testing of our exports, as CSV, JSON, and Parquet.
+//
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+//
+// Add the reports, and resources, which we export.
+//
+func addExportReports(t *testing.T, prefix string) {
+	stored := filepath.Join("www.steve.org.uk", strings.Repeat("a", 40))
+	addReportFile(t, prefix, stored)
+
+	db.Exec("INSERT INTO reports(id, fqdn, environment, state, yaml_file, executed_at, runtime, total) VALUES(1, 'www.steve.org.uk', 'production', 'unchanged', ?, 1000, 1.5, 10)", stored)
+	db.Exec("INSERT INTO reports(id, fqdn, environment, state, yaml_file, executed_at, runtime, total) VALUES(2, 'foo.example.com', 'test', 'failed', 'pruned', 2000, 2.5, 20)")
+	db.Exec("INSERT INTO reports(id, fqdn, environment, state, yaml_file, executed_at, runtime, total) VALUES(3, 'foo.example.com', 'test', 'changed', '', 3000, 3.5, 30)")
+	db.Exec("INSERT INTO report_resources(report_id, resource, type, file, line, evaluation_time) VALUES(2, 'Service[nginx]', 'Service', '/etc/init.pp', '12', 4.25)")
+}
+
+//
+// Test exporting each dataset as CSV, and newline-delimited JSON.
+//
+func TestExportText(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	prefix := filepath.Join(path, "reports")
+	addExportReports(t, prefix)
+
+	type TestCase struct {
+		Options  exportOptions
+		Rows     int
+		Expected []string
+	}
+
+	tests := []TestCase{
+		{exportOptions{Dataset: "reports", Format: "csv"}, 3,
+			[]string{"report_id,fqdn,environment,executed_at,state,runtime", "\n2,foo.example.com,test,2000,failed,2.5,20,"}},
+		{exportOptions{Dataset: "reports", Format: "ndjson", Filter: ExportFilter{NodeFilter: NodeFilter{State: "changed"}}}, 1,
+			[]string{"{\"report_id\":3,\"fqdn\":\"foo.example.com\",\"environment\":\"test\",\"executed_at\":3000,\"state\":\"changed\",\"runtime\":3.5,"}},
+		{exportOptions{Dataset: "reports", Format: "csv", Filter: ExportFilter{From: time.Unix(1500, 0), To: time.Unix(3000, 0)}}, 1,
+			[]string{"\n2,"}},
+		{exportOptions{Dataset: "resources", Format: "csv"}, 1,
+			[]string{"\n2,foo.example.com,test,2000,Service[nginx],Service,/etc/init.pp,12,4.25\n"}},
+		{exportOptions{Dataset: "resources", Format: "csv", Filter: ExportFilter{NodeFilter: NodeFilter{Environment: "production"}}}, 0,
+			[]string{"report_id,"}},
+		{exportOptions{Dataset: "logs", Format: "ndjson", Prefix: prefix}, 2,
+			[]string{"\"report_id\":1,\"fqdn\":\"www.steve.org.uk\"", "\"level\":"}},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		count, err := runExport(&buf, test.Options)
+		if err != nil {
+			t.Errorf("Unexpected error exporting %v: %s", test.Options, err.Error())
+			continue
+		}
+		if count != test.Rows {
+			t.Errorf("Exported %d rows of %v, not %d", count, test.Options, test.Rows)
+		}
+		for _, expected := range test.Expected {
+			if !strings.Contains(buf.String(), expected) {
+				t.Errorf("Export of %v didn't contain %q:\n%s", test.Options, expected, buf.String())
+			}
+		}
+	}
+
+	//
+	// Bogus options are rejected.
+	//
+	bogus := []exportOptions{
+		{Dataset: "nodes", Format: "csv"},
+		{Dataset: "reports", Format: "xlsx"},
+		{Dataset: "reports", Format: "csv", Filter: ExportFilter{NodeFilter: NodeFilter{State: "broken"}}},
+		{Dataset: "reports", Format: "csv", Filter: ExportFilter{From: time.Unix(2000, 0), To: time.Unix(1000, 0)}},
+	}
+	for _, opts := range bogus {
+		_, err := runExport(new(bytes.Buffer), opts)
+		if err == nil {
+			t.Errorf("Expected an error exporting %v", opts)
+		}
+	}
+}
+
+//
+// Test exporting to Parquet, and reading the result.
+//
+func TestExportParquet(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addExportReports(t, filepath.Join(path, "reports"))
+
+	var buf bytes.Buffer
+	count, err := runExport(&buf, exportOptions{Dataset: "reports", Format: "parquet"})
+	if err != nil {
+		t.Fatalf("Unexpected error exporting: %s", err.Error())
+	}
+	if count != 3 {
+		t.Errorf("Exported %d rows, not 3", count)
+	}
+
+	file, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Failed to read the export: %s", err.Error())
+	}
+	if file.NumRows() != 3 {
+		t.Errorf("Read %d rows, not 3", file.NumRows())
+	}
+
+	type Row struct {
+		ReportID int64   `parquet:"report_id"`
+		Fqdn     string  `parquet:"fqdn"`
+		Runtime  float64 `parquet:"runtime"`
+	}
+	rows := make([]Row, 3)
+	_, err = parquet.NewGenericReader[Row](file).Read(rows)
+	if err != nil && err != io.EOF {
+		t.Fatalf("Failed to read rows: %s", err.Error())
+	}
+	if rows[1].ReportID != 2 || rows[1].Fqdn != "foo.example.com" || rows[1].Runtime != 2.5 {
+		t.Errorf("Unexpected row: %v", rows[1])
+	}
+}
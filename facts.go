@@ -0,0 +1,424 @@
+//
+// The facts submitted by our nodes.
+//
+// Reports don't contain facts, so nodes may also submit them to us, in
+// the same form that Puppet sends them to PuppetDB or its facts terminus.
+// Each submission is recorded, so we hold the history of every node, and
+// the most recent facts are flattened such that nodes may be grouped, and
+// filtered, by any one of them, for example `os.release.major`.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// PuppetFacts holds the facts submitted by a single node.
+//
+type PuppetFacts struct {
+	Fqdn        string
+	Environment string
+	Values      map[string]interface{}
+}
+
+//
+// FactSet is a single submission of facts, as recorded in our history.
+//
+type FactSet struct {
+	ID          int
+	Fqdn        string
+	Environment string
+	At          string
+	Epoch       int64
+	Values      map[string]interface{}
+}
+
+//
+// FactGroup holds the number of nodes in each state, for the nodes which
+// share a single value of a fact.
+//
+// Nodes which don't have the fact are grouped beneath an empty value.
+//
+type FactGroup struct {
+	Value  string
+	Total  int
+	States []PuppetState
+}
+
+//
+// FactHistory is the number of submissions, from each node, which we
+// keep.  Submissions whose facts haven't changed aren't recorded twice.
+//
+var FactHistory = 10
+
+//
+// yamlFacts mirrors the facts which are submitted to us, which might
+// be a `replace facts` command for PuppetDB, its payload, or the facts
+// sent to Puppet's REST terminus.
+//
+// JSON is decoded via the same structure.
+//
+type yamlFacts struct {
+	Name        string                 `yaml:"name" json:"name"`
+	Certname    string                 `yaml:"certname" json:"certname"`
+	Environment string                 `yaml:"environment" json:"environment"`
+	Values      map[string]interface{} `yaml:"values" json:"values"`
+	Payload     *yamlFacts             `yaml:"payload" json:"payload"`
+}
+
+//
+// ParsePuppetFacts parses the facts submitted by a node, as JSON or as
+// YAML.
+//
+// The node may be named by the submission, by the `clientcert` fact, or
+// failing those by the given fqdn.  The environment is taken from the
+// `environment` fact if it isn't given.
+//
+func ParsePuppetFacts(content []byte, fqdn string) (PuppetFacts, error) {
+
+	var x PuppetFacts
+	var y yamlFacts
+
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		err = json.Unmarshal(content, &y)
+	} else {
+		err = yaml.Unmarshal(content, &y)
+	}
+	if err != nil {
+		return x, errors.New("failed to parse facts")
+	}
+
+	//
+	// PuppetDB commands wrap the facts in a payload.
+	//
+	if y.Payload != nil {
+		y = *y.Payload
+	}
+
+	if len(y.Values) == 0 {
+		return x, missing("values")
+	}
+	x.Values = y.Values
+
+	x.Fqdn = y.Certname
+	if x.Fqdn == "" {
+		x.Fqdn = y.Name
+	}
+	if x.Fqdn == "" {
+		x.Fqdn, _ = y.Values["clientcert"].(string)
+	}
+	if x.Fqdn == "" {
+		x.Fqdn = fqdn
+	}
+	if x.Fqdn == "" {
+		return x, missing("certname")
+	}
+	if !fqdnRegexp.MatchString(x.Fqdn) {
+		return x, errors.New("the submitted 'certname' field failed our security check")
+	}
+
+	x.Environment = y.Environment
+	if x.Environment == "" {
+		x.Environment, _ = y.Values["environment"].(string)
+	}
+	if x.Environment != "" && !environmentRegexp.MatchString(x.Environment) {
+		return x, errors.New("the submitted 'environment' field failed our security check")
+	}
+
+	//
+	// Facts decoded from YAML have maps keyed by arbitrary values,
+	// which can't be stored as JSON.
+	//
+	x.Values = normalizeFacts(x.Values).(map[string]interface{})
+
+	return x, nil
+}
+
+//
+// normalizeFacts converts the maps within the given value, as decoded
+// from YAML, to maps keyed by strings.
+//
+func normalizeFacts(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprintf("%v", key)] = normalizeFacts(val)
+		}
+		return m
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = normalizeFacts(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = normalizeFacts(val)
+		}
+		return v
+	}
+	return value
+}
+
+//
+// flattenFacts returns the facts as a map of names to values, with the
+// names of nested facts joined by a period.
+//
+// Arrays are stored as JSON, and facts whose value is null are omitted.
+//
+func flattenFacts(values map[string]interface{}) map[string]string {
+	flat := make(map[string]string)
+
+	var walk func(prefix string, value interface{})
+	walk = func(prefix string, value interface{}) {
+		switch v := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			for key, val := range v {
+				walk(prefix+"."+key, val)
+			}
+		case string:
+			flat[prefix] = v
+		case bool:
+			flat[prefix] = strconv.FormatBool(v)
+		case float64:
+			flat[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+		case []interface{}:
+			js, err := json.Marshal(v)
+			if err == nil {
+				flat[prefix] = string(js)
+			}
+		default:
+			flat[prefix] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	for key, val := range values {
+		walk(key, val)
+	}
+	return flat
+}
+
+//
+// addFacts records the facts submitted by a node, and replaces those we
+// hold as its latest.
+//
+func addFacts(facts PuppetFacts) error {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return errors.New("SetupDB not called")
+	}
+
+	content, err := json.Marshal(facts.Values)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	//
+	// If the facts haven't changed since the last submission then
+	// we only need to record that we've seen them again.
+	//
+	var id int64
+	var previous string
+	err = tx.QueryRow("SELECT id, content FROM facts WHERE fqdn = ? ORDER BY received_at DESC, id DESC LIMIT 1", facts.Fqdn).Scan(&id, &previous)
+	if err == nil && previous == string(content) {
+		_, err = tx.Exec("UPDATE facts SET received_at = ?, environment = ? WHERE id = ?", now, facts.Environment, id)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	}
+
+	_, err = tx.Exec("INSERT INTO facts(fqdn, environment, received_at, content) VALUES(?,?,?,?)",
+		facts.Fqdn, facts.Environment, now, string(content))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	//
+	// Discard the oldest submissions of this node.
+	//
+	_, err = tx.Exec(`DELETE FROM facts WHERE fqdn = ? AND id NOT IN (
+                            SELECT id FROM facts WHERE fqdn = ? ORDER BY received_at DESC, id DESC LIMIT ? )`,
+		facts.Fqdn, facts.Fqdn, FactHistory)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM node_facts WHERE fqdn = ?", facts.Fqdn)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO node_facts(fqdn, name, value) VALUES(?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	for name, value := range flattenFacts(facts.Values) {
+		_, err = stmt.Exec(facts.Fqdn, name, value)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+//
+// getFactHistory returns the facts submitted by the given node, most
+// recent first.
+//
+func getFactHistory(fqdn string) ([]FactSet, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	rows, err := db.Query("SELECT id, fqdn, IFNULL(environment,''), received_at, content FROM facts WHERE fqdn = ? ORDER BY received_at DESC, id DESC", fqdn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sets []FactSet
+	for rows.Next() {
+		var tmp FactSet
+		var content string
+		err = rows.Scan(&tmp.ID, &tmp.Fqdn, &tmp.Environment, &tmp.Epoch, &content)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal([]byte(content), &tmp.Values)
+		if err != nil {
+			return nil, err
+		}
+		tmp.At = time.Unix(tmp.Epoch, 0).Format("2006-01-02 15:04:05")
+		sets = append(sets, tmp)
+	}
+	return sets, rows.Err()
+}
+
+//
+// getFactValues returns the value of the given fact for each node which
+// has it.
+//
+func getFactValues(name string) (map[string]string, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	rows, err := db.Query("SELECT fqdn, value FROM node_facts WHERE name = ?", name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make(map[string]string)
+	for rows.Next() {
+		var fqdn, value string
+		err = rows.Scan(&fqdn, &value)
+		if err != nil {
+			return nil, err
+		}
+		values[fqdn] = value
+	}
+	return values, rows.Err()
+}
+
+//
+// setNodeGroups sets the group of each of the given nodes to its value
+// of the named fact.
+//
+func setNodeGroups(nodes []PuppetRuns, fact string) error {
+	values, err := getFactValues(fact)
+	if err != nil {
+		return err
+	}
+	for i := range nodes {
+		nodes[i].Group = values[nodes[i].Fqdn]
+	}
+	return nil
+}
+
+//
+// groupNodes counts the states of the given nodes, within each of their
+// groups, ordered by the value of the group.
+//
+func groupNodes(nodes []PuppetRuns) []FactGroup {
+	members := make(map[string][]PuppetRuns)
+	for _, node := range nodes {
+		members[node.Group] = append(members[node.Group], node)
+	}
+
+	var values []string
+	for value := range members {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	var groups []FactGroup
+	for _, value := range values {
+		groups = append(groups, FactGroup{
+			Value:  value,
+			Total:  len(members[value]),
+			States: countStates(members[value]),
+		})
+	}
+	return groups
+}
+
+//
+// parseFactFilter parses matches of the form `name=value`, such as
+// `os.family=RedHat`, returning a map of the names to the values.
+//
+func parseFactFilter(matches []string) (map[string]string, error) {
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	facts := make(map[string]string)
+	for _, match := range matches {
+		i := strings.Index(match, "=")
+		if i < 1 {
+			return nil, fmt.Errorf("invalid fact '%s', expected name=value", match)
+		}
+		facts[match[:i]] = match[i+1:]
+	}
+	return facts, nil
+}
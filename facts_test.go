@@ -0,0 +1,180 @@
+//
+//  Testing of the facts submitted by our nodes.
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+//
+// Test parsing the forms in which facts are submitted.
+//
+func TestParseFacts(t *testing.T) {
+
+	type TestCase struct {
+		Input       string
+		Fqdn        string
+		Environment string
+		Error       string
+	}
+
+	tests := []TestCase{
+		// A `replace facts` command for PuppetDB.
+		{`{"command": "replace facts", "version": 5, "payload": {"certname": "web1.example.com", "environment": "production", "values": {"os": {"family": "RedHat"}}}}`,
+			"web1.example.com", "production", ""},
+		// The payload alone.
+		{`{"certname": "web1.example.com", "values": {"environment": "test", "role": "web"}}`,
+			"web1.example.com", "test", ""},
+		// The facts terminus.
+		{`{"name": "web1.example.com", "values": {"role": "web"}, "timestamp": "2019-03-31T00:00:00Z"}`,
+			"web1.example.com", "", ""},
+		// The YAML cached by an agent.
+		{"--- !ruby/object:Puppet::Node::Facts\nname: db1.example.com\nvalues:\n  os:\n    release:\n      major: \"8\"\n  processorcount: 4\n",
+			"db1.example.com", "", ""},
+		// Named by a fact, or the given name.
+		{`{"values": {"clientcert": "web2.example.com"}}`, "web2.example.com", "", ""},
+		{`{"values": {"role": "web"}}`, "path.example.com", "", ""},
+
+		{`{"certname": "web1.example.com"}`, "", "", "values"},
+		{`{"certname": "WEB1;example", "values": {"role": "web"}}`, "", "", "security check"},
+		{`{"certname": "web1.example.com", "environment": "prod'", "values": {"role": "web"}}`, "", "", "security check"},
+		{`{"certname": `, "", "", "failed to parse"},
+	}
+
+	for _, test := range tests {
+		fqdn := ""
+		if strings.Contains(test.Fqdn, "path") {
+			fqdn = test.Fqdn
+		}
+
+		facts, err := ParsePuppetFacts([]byte(test.Input), fqdn)
+		if test.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Error) {
+				t.Errorf("Expected an error containing %q for %s, got %v", test.Error, test.Input, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error parsing %s: %s", test.Input, err.Error())
+			continue
+		}
+		if facts.Fqdn != test.Fqdn || facts.Environment != test.Environment {
+			t.Errorf("Unexpected facts from %s: %v", test.Input, facts)
+		}
+	}
+
+	//
+	// Nested facts are flattened, whether they were JSON or YAML.
+	//
+	facts, err := ParsePuppetFacts([]byte("name: db1.example.com\nvalues:\n  os:\n    release:\n      major: \"8\"\n  processorcount: 4\n  is_virtual: true\n  ips: [\"10.0.0.1\"]\n  empty: ~\n"), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	flat := flattenFacts(facts.Values)
+
+	expected := map[string]string{
+		"os.release.major": "8",
+		"processorcount":   "4",
+		"is_virtual":       "true",
+		"ips":              "[\"10.0.0.1\"]",
+	}
+	if len(flat) != len(expected) {
+		t.Errorf("Unexpected facts: %v", flat)
+	}
+	for name, value := range expected {
+		if flat[name] != value {
+			t.Errorf("Unexpected value of %s: %q", name, flat[name])
+		}
+	}
+}
+
+//
+// Test storing facts, and filtering and grouping nodes by them.
+//
+func TestAddFacts(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+
+	add := func(fqdn string, values map[string]interface{}) {
+		err := addFacts(PuppetFacts{Fqdn: fqdn, Environment: "production", Values: values})
+		if err != nil {
+			t.Fatalf("Failed to add facts: %s", err.Error())
+		}
+	}
+
+	add("foo.example.com", map[string]interface{}{"role": "web"})
+	add("foo.example.com", map[string]interface{}{"role": "web"})
+	add("foo.example.com", map[string]interface{}{"role": "db", "os": map[string]interface{}{"family": "Debian"}})
+	add("bar.example.com", map[string]interface{}{"role": "web"})
+
+	//
+	// Unchanged facts aren't recorded twice.
+	//
+	history, err := getFactHistory("foo.example.com")
+	if err != nil {
+		t.Fatalf("Failed to get history: %s", err.Error())
+	}
+	if len(history) != 2 || history[0].Values["role"] != "db" || history[1].Values["role"] != "web" {
+		t.Errorf("Unexpected history: %v", history)
+	}
+
+	//
+	// Only the most recent submissions are kept.
+	//
+	old := FactHistory
+	FactHistory = 1
+	add("foo.example.com", map[string]interface{}{"role": "db"})
+	FactHistory = old
+
+	history, _ = getFactHistory("foo.example.com")
+	if len(history) != 1 {
+		t.Errorf("Unexpected history: %v", history)
+	}
+
+	//
+	// Filter by the latest facts.
+	//
+	nodes, err := getNodes(NodeFilter{Facts: map[string]string{"role": "web"}})
+	if err != nil {
+		t.Fatalf("Failed to get nodes: %s", err.Error())
+	}
+	if len(nodes) != 1 || nodes[0].Fqdn != "bar.example.com" {
+		t.Errorf("Unexpected nodes: %v", nodes)
+	}
+
+	err = NodeFilter{Facts: map[string]string{"role'": "web"}}.Validate()
+	if err == nil {
+		t.Errorf("Expected an error validating a bogus fact")
+	}
+
+	//
+	// Group by a fact.
+	//
+	nodes, _ = getNodes(NodeFilter{})
+	err = setNodeGroups(nodes, "role")
+	if err != nil {
+		t.Fatalf("Failed to group nodes: %s", err.Error())
+	}
+
+	groups := groupNodes(nodes)
+	if len(groups) != 2 || groups[0].Value != "db" || groups[1].Value != "web" {
+		t.Fatalf("Unexpected groups: %v", groups)
+	}
+	if groups[0].Total != 1 || groups[0].States[1].State != "changed" || groups[0].States[1].Count != 1 {
+		t.Errorf("Unexpected states: %v", groups[0])
+	}
+
+	//
+	// The facts which were replaced are no longer matched.
+	//
+	nodes, _ = getNodes(NodeFilter{Facts: map[string]string{"os.family": "Debian"}})
+	if len(nodes) != 0 {
+		t.Errorf("Unexpected nodes: %v", nodes)
+	}
+}
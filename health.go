@@ -0,0 +1,99 @@
+//
+// This file contains the handlers used by load-balancers, and other
+// supervisors, to test whether we're alive and able to accept reports.
+//
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+)
+
+//
+// draining is set, to a non-zero value, once we've begun shutting down.
+//
+// After that point uploads are refused, and we report we're not ready.
+//
+var draining int32
+
+//
+// isDraining returns true if we're shutting down.
+//
+func isDraining() bool {
+	return atomic.LoadInt32(&draining) != 0
+}
+
+//
+// startDraining marks us as shutting down.
+//
+func startDraining() {
+	atomic.StoreInt32(&draining, 1)
+}
+
+//
+// checkReady returns an error if we're unable to accept reports.
+//
+func checkReady() error {
+
+	if isDraining() {
+		return fmt.Errorf("shutting down")
+	}
+
+	err := checkWritable()
+	if err != nil {
+		return fmt.Errorf("database is not writable: %s", err.Error())
+	}
+
+	//
+	// Reports are written beneath our prefix, so test that there's
+	// space for them.
+	//
+	_, err = os.Stat(ReportPrefix)
+	if err == nil {
+		var free int64
+		free, err = freeSpace(ReportPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to find free space beneath %s: %s", ReportPrefix, err.Error())
+		}
+		if free >= 0 && free < currentSettings().MinFreeSpace {
+			return fmt.Errorf("only %d MB free beneath %s", free/(1024*1024), ReportPrefix)
+		}
+	}
+	return nil
+}
+
+//
+// HealthHandler is the handler for the HTTP end-point
+//
+//	 GET /healthz
+//
+// It succeeds so long as we're running, and is suitable for use as a
+// liveness-check.
+//
+func HealthHandler(res http.ResponseWriter, req *http.Request) {
+	res.Header().Set("Content-Type", "text/plain")
+	fmt.Fprintf(res, "OK\n")
+}
+
+//
+// ReadyHandler is the handler for the HTTP end-point
+//
+//	 GET /readyz
+//
+// It succeeds if we're able to accept reports, which requires that our
+// database is writable and that there's space beneath our report-prefix.
+// Otherwise it returns a 503 response describing the problem.
+//
+func ReadyHandler(res http.ResponseWriter, req *http.Request) {
+	err := checkReady()
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	res.Header().Set("Content-Type", "text/plain")
+	fmt.Fprintf(res, "OK\n")
+}
@@ -27,13 +27,33 @@ func main() {
 	subcommands.Register(subcommands.HelpCommand(), "")
 	subcommands.Register(subcommands.FlagsCommand(), "")
 	subcommands.Register(subcommands.CommandsCommand(), "")
+	subcommands.Register(&backupCmd{}, "")
+	subcommands.Register(&configCmd{}, "")
+	subcommands.Register(&dbCmd{}, "")
+	subcommands.Register(&exportCmd{}, "")
 	subcommands.Register(&metricsCmd{}, "")
 	subcommands.Register(&pruneCmd{}, "")
+	subcommands.Register(&rebuildCmd{}, "")
+	subcommands.Register(&redactCmd{}, "")
+	subcommands.Register(&restoreCmd{}, "")
 	subcommands.Register(&serveCmd{}, "")
 	subcommands.Register(&versionCmd{}, "")
 	subcommands.Register(&yamlCmd{}, "")
 
+	path := flag.String("config", os.Getenv(EnvironmentPrefix+"CONFIG"), "The configuration file to read.")
 	flag.Parse()
+
+	//
+	// Load our configuration, which the sub-commands will apply to
+	// their flags.
+	//
+	var err error
+	config, err = loadConfig(*path, os.Environ(), &backupCmd{}, &dbCmd{}, &exportCmd{}, &metricsCmd{}, &pruneCmd{}, &rebuildCmd{}, &redactCmd{}, &restoreCmd{}, &serveCmd{})
+	if err != nil {
+		fmt.Printf("Error loading configuration: %s\n", err.Error())
+		os.Exit(1)
+	}
+
 	ctx := context.Background()
 	os.Exit(int(subcommands.Execute(ctx)))
 }
@@ -0,0 +1,195 @@
+//
+// Groups of nodes, defined by the names of their members.
+//
+// Many sites encode the role, or location, of a node in its name, such as
+// `web-03.lon.example.com`.  We allow groups to be configured which match
+// such names, each of which has its own dashboard, state-counts, and
+// metrics.  For example:
+//
+//    groups:
+//      web:
+//        globs:
+//          - "web-*"
+//      london:
+//        patterns:
+//          - "^[a-z]+-[0-9]+\\.lon\\."
+//
+// A node is a member of every group it matches.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// nodeGroupRule describes the members of a single group.
+//
+// A node is a member if its name matches any of the globs, or patterns.
+//
+type nodeGroupRule struct {
+
+	//
+	// Patterns which may contain `*` and `?` wildcards, as accepted
+	// by our `fqdn` parameters.
+	//
+	Globs []string `yaml:"globs"`
+
+	//
+	// Regular expressions, which match any part of the name unless
+	// they're anchored.
+	//
+	Patterns []string `yaml:"patterns"`
+}
+
+//
+// nodeGroupRules is the structure of the file which configures our
+// groups.
+//
+type nodeGroupRules struct {
+	Groups map[string]nodeGroupRule `yaml:"groups"`
+}
+
+//
+// nodeGroups holds the validated groups.
+//
+type nodeGroups struct {
+	rules map[string]nodeGroupRule
+}
+
+//
+// The names of groups we accept.
+//
+var groupNameRegexp = regexp.MustCompile("^([A-Za-z0-9_-]+)$")
+
+//
+// loadNodeGroups reads, and validates, the groups in the given file.
+//
+func loadNodeGroups(path string) (*nodeGroups, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules nodeGroupRules
+	err = yaml.UnmarshalStrict(content, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", path, err.Error())
+	}
+
+	err = rules.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return &nodeGroups{rules: rules.Groups}, nil
+}
+
+//
+// Validate returns an error if the rules contain anything bogus.
+//
+func (r nodeGroupRules) Validate() error {
+
+	if len(r.Groups) == 0 {
+		return errors.New("no groups are configured")
+	}
+
+	for name, rule := range r.Groups {
+		if !groupNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid group '%s'", name)
+		}
+		if len(rule.Globs) == 0 && len(rule.Patterns) == 0 {
+			return fmt.Errorf("the group '%s' has no globs or patterns", name)
+		}
+		for _, glob := range rule.Globs {
+			if !fqdnPatternRegexp.MatchString(glob) {
+				return fmt.Errorf("invalid glob '%s' in the group '%s'", glob, name)
+			}
+		}
+		for _, pattern := range rule.Patterns {
+			_, err := regexp.Compile(pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern '%s' in the group '%s': %s", pattern, name, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+//
+// Names returns the names of our groups, sorted.
+//
+func (g *nodeGroups) Names() []string {
+	if g == nil {
+		return nil
+	}
+
+	var names []string
+	for name := range g.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//
+// Rule returns the rule describing the named group, if it exists.
+//
+func (g *nodeGroups) Rule(name string) (nodeGroupRule, bool) {
+	if g == nil {
+		return nodeGroupRule{}, false
+	}
+	rule, ok := g.rules[name]
+	return rule, ok
+}
+
+//
+// Apply adds a condition matching the members of the group to the given
+// query, with the prefix added to the name of the column as for the
+// NodeFilter.
+//
+// The patterns are tested by the `REGEXP` function we register with
+// SQLite, which uses the same syntax as Go.
+//
+func (r nodeGroupRule) Apply(q *sqlQuery, prefix string) *sqlQuery {
+	var conditions []string
+	var args []interface{}
+
+	for _, glob := range r.Globs {
+		conditions = append(conditions, prefix+"fqdn GLOB ?")
+		args = append(args, glob)
+	}
+	for _, pattern := range r.Patterns {
+		conditions = append(conditions, prefix+"fqdn REGEXP ?")
+		args = append(args, pattern)
+	}
+	return q.Where(strings.Join(conditions, " OR "), args...)
+}
+
+//
+// Match returns true if the named node is a member of the group.
+//
+// This is the equivalent of Apply, for a single node we already know.
+//
+func (r nodeGroupRule) Match(fqdn string) bool {
+	for _, glob := range r.Globs {
+		if ok, _ := filepath.Match(glob, fqdn); ok {
+			return true
+		}
+	}
+	for _, pattern := range r.Patterns {
+		if ok, _ := regexp.MatchString(pattern, fqdn); ok {
+			return true
+		}
+	}
+	return false
+}
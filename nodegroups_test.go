@@ -0,0 +1,170 @@
+//
+//  Testing of our node groups.
+//
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+//
+// Test loading, and validating, node groups.
+//
+func TestNodeGroupRules(t *testing.T) {
+
+	type TestCase struct {
+		rules string
+		error string
+	}
+
+	tests := []TestCase{
+		{"groups: [", "failed to parse"},
+		{"", "no groups are configured"},
+		{"groups:\n  \"web servers\":\n    globs: [\"web-*\"]", "invalid group 'web servers'"},
+		{"groups:\n  web: {}", "has no globs or patterns"},
+		{"groups:\n  web:\n    globs: [\"web-*;\"]", "invalid glob"},
+		{"groups:\n  web:\n    patterns: [\"web-(\"]", "invalid pattern"},
+		{"groups:\n  web:\n    hosts: [\"web\"]", "field hosts not found"},
+	}
+
+	for _, test := range tests {
+		file, cleanup := writeConfig(t, test.rules)
+
+		_, err := loadNodeGroups(file)
+		if err == nil {
+			t.Errorf("Expected an error loading '%s'", test.rules)
+		} else if !strings.Contains(err.Error(), test.error) {
+			t.Errorf("Expected '%s' in error, got '%s'", test.error, err.Error())
+		}
+		cleanup()
+	}
+
+	var missing *nodeGroups
+	if missing.Names() != nil {
+		t.Errorf("Expected no groups")
+	}
+	if _, ok := missing.Rule("web"); ok {
+		t.Errorf("Expected no rule")
+	}
+}
+
+//
+// Test the dashboards, state-counts, and metrics, of our node groups.
+//
+func TestNodeGroups(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+
+	file, cleanup := writeConfig(t, "groups:\n  foo:\n    globs: [\"foo.*\"]\n  example:\n    globs: [\"nothing\"]\n    patterns: [\"\\\\.example\\\\.com$\"]\n")
+	defer cleanup()
+
+	groups, err := loadNodeGroups(file)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if strings.Join(groups.Names(), ",") != "example,foo" {
+		t.Errorf("Unexpected groups %v", groups.Names())
+	}
+
+	bak := currentSettings()
+	settings := *bak
+	settings.Groups = groups
+	storeSettings(&settings)
+	defer storeSettings(bak)
+
+	//
+	// The state of the members of each group.
+	//
+	states, err := getStates(NodeFilter{Group: "foo"})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	for _, state := range states {
+		expected := 0
+		if state.State == "changed" {
+			expected = 1
+		}
+		if state.Count != expected {
+			t.Errorf("Unexpected count of %s nodes: %d", state.State, state.Count)
+		}
+	}
+
+	nodes, _ := getNodes(NodeFilter{Group: "example"})
+	if len(nodes) != 2 {
+		t.Errorf("Unexpected members %v", nodes)
+	}
+
+	if (NodeFilter{Group: "missing"}).Validate() == nil {
+		t.Errorf("Expected an error for an unknown group")
+	}
+
+	metrics := getMetrics(NodeFilter{}, "")
+	if metrics["groups.foo.changed"] != "1" || metrics["groups.foo.failed"] != "0" || metrics["groups.example.failed"] != "1" {
+		t.Errorf("Unexpected metrics %v", metrics)
+	}
+
+	//
+	// The pages, and APIs, of each group.
+	//
+	router := mux.NewRouter()
+	router.HandleFunc("/api/state/{state}", APIState).Methods("GET")
+	router.HandleFunc("/radiator", RadiatorView).Methods("GET")
+	router.HandleFunc("/group/{group}", IndexHandler).Methods("GET")
+	router.HandleFunc("/", IndexHandler).Methods("GET")
+
+	type TestCase struct {
+		URL      string
+		Accept   string
+		Status   int
+		Response string
+	}
+
+	tests := []TestCase{
+		{"/api/state/failed?group=example", "application/json", http.StatusOK, "[\"bar.example.com\"]"},
+		{"/api/state/failed?group=foo", "application/json", http.StatusOK, "[]"},
+		{"/api/state/failed?group=missing", "application/json", http.StatusBadRequest, "unknown group"},
+		{"/radiator?group=foo", "application/json", http.StatusOK, "{\"State\":\"Total\",\"Count\":1,"},
+		{"/radiator?group=foo", "text/html", http.StatusOK, "Puppet Summary: foo"},
+		{"/group/foo", "application/json", http.StatusOK, "\"Fqdn\":\"foo.example.com\""},
+		{"/group/foo", "text/html", http.StatusOK, "for group: foo"},
+		{"/", "text/html", http.StatusOK, "/group/example"},
+		{"/group/missing", "text/html", http.StatusBadRequest, "unknown group"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("GET", test.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Accept", test.Accept)
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != test.Status {
+			t.Errorf("Unexpected status-code for %s: %v", test.URL, rr.Code)
+		}
+		if !strings.Contains(rr.Body.String(), test.Response) {
+			t.Errorf("Unexpected body for %s: '%s'", test.URL, rr.Body.String())
+		}
+	}
+
+	//
+	// Members of the `foo` group aren't in the other.
+	//
+	req, _ := http.NewRequest("GET", "/group/foo?accept=application/json", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if strings.Contains(rr.Body.String(), "bar.example.com") {
+		t.Errorf("Unexpected member of group: %s", rr.Body.String())
+	}
+}
@@ -0,0 +1,571 @@
+//
+// A read-only subset of the PuppetDB query API, version 4, such that tools
+// which speak it may be pointed at us.
+//
+// We serve the `nodes`, `reports`, `events`, and `environments` entities,
+// from our own tables, and accept queries in the AST syntax, such as:
+//
+//    ["and", ["=", "environment", "production"],
+//            ["~", "certname", "^web"],
+//            ["=", "latest_report?", true]]
+//
+// The operators `=`, `<`, `<=`, `>`, `>=`, `~`, `null?`, `and`, `or`, and
+// `not` are supported, along with the `limit`, `offset`, and `order_by`
+// parameters.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+//
+// pdbField is a single field of an entity, which may be queried.
+//
+type pdbField struct {
+	Name string
+
+	// The SQL expression which produces the field.
+	Expr string
+
+	// One of `string`, `number`, `timestamp`, or `boolean`.
+	Kind string
+}
+
+//
+// pdbEntity is one of the entities which may be queried.
+//
+type pdbEntity struct {
+	// The tables the fields are selected from.
+	From string
+
+	// The fields, in the order they are selected.
+	Fields []pdbField
+
+	// The default order of the results.
+	Order string
+
+	// Add any fields which aren't selected, to a single result.
+	Extra func(row map[string]interface{})
+}
+
+//
+// The hash of a report, or its ID if it was stored by an older release
+// which didn't record the hash.
+//
+var pdbReportHash = "COALESCE(r.hash, CAST(r.id AS TEXT))"
+
+//
+// The entities we serve.
+//
+var pdbEntities = map[string]pdbEntity{
+	"nodes": {
+		From: "nodes n LEFT JOIN reports r ON r.id = n.last_report_id",
+		Fields: []pdbField{
+			{"certname", "n.fqdn", "string"},
+			{"deactivated", "NULL", "timestamp"},
+			{"expired", "NULL", "timestamp"},
+			{"report_timestamp", "n.last_seen", "timestamp"},
+			{"catalog_timestamp", "NULL", "timestamp"},
+			{"facts_timestamp", "( SELECT MAX(f.received_at) FROM facts f WHERE f.fqdn = n.fqdn )", "timestamp"},
+			{"report_environment", "n.environment", "string"},
+			{"catalog_environment", "n.environment", "string"},
+			{"facts_environment", "n.environment", "string"},
+			{"latest_report_status", "n.state", "string"},
+			{"latest_report_hash", pdbReportHash, "string"},
+			{"latest_report_noop", "0", "boolean"},
+			{"latest_report_noop_pending", "0", "boolean"},
+			{"latest_report_corrective_change", "NULL", "boolean"},
+			{"latest_report_job_id", "NULL", "string"},
+			{"cached_catalog_status", "NULL", "string"},
+		},
+		Order: "n.fqdn",
+	},
+	//
+	// Reports don't record when the run started, so it is derived from
+	// the time we received the report, and its runtime.
+	//
+	"reports": {
+		From: "reports r LEFT JOIN nodes n ON n.last_report_id = r.id",
+		Fields: []pdbField{
+			{"hash", pdbReportHash, "string"},
+			{"certname", "r.fqdn", "string"},
+			{"environment", "r.environment", "string"},
+			{"status", "r.state", "string"},
+			{"noop", "0", "boolean"},
+			{"noop_pending", "0", "boolean"},
+			{"corrective_change", "NULL", "boolean"},
+			{"puppet_version", "r.puppet_version", "string"},
+			{"report_format", "r.report_format", "number"},
+			{"configuration_version", "r.configuration_version", "string"},
+			{"transaction_uuid", "NULL", "string"},
+			{"catalog_uuid", "NULL", "string"},
+			{"code_id", "r.code_id", "string"},
+			{"job_id", "NULL", "string"},
+			{"cached_catalog_status", "NULL", "string"},
+			{"start_time", "r.executed_at - IFNULL(r.runtime, 0)", "timestamp"},
+			{"end_time", "r.executed_at", "timestamp"},
+			{"producer_timestamp", "r.executed_at", "timestamp"},
+			{"receive_time", "r.executed_at", "timestamp"},
+			{"producer", "NULL", "string"},
+			{"type", "'agent'", "string"},
+			{"latest_report?", "n.fqdn IS NOT NULL", "boolean"},
+		},
+		Order: "r.executed_at DESC, r.id DESC",
+		Extra: func(row map[string]interface{}) {
+			row["resource_events"] = map[string]string{
+				"href": "/pdb/query/v4/reports/" + row["hash"].(string) + "/events",
+			}
+		},
+	},
+	"events": {
+		From: "report_events e JOIN reports r ON r.id = e.report_id LEFT JOIN nodes n ON n.last_report_id = r.id",
+		Fields: []pdbField{
+			{"certname", "r.fqdn", "string"},
+			{"report", pdbReportHash, "string"},
+			{"status", "e.status", "string"},
+			{"timestamp", "r.executed_at", "timestamp"},
+			{"run_start_time", "r.executed_at - IFNULL(r.runtime, 0)", "timestamp"},
+			{"run_end_time", "r.executed_at", "timestamp"},
+			{"report_receive_time", "r.executed_at", "timestamp"},
+			{"resource_type", "e.resource_type", "string"},
+			{"resource_title", "e.resource_title", "string"},
+			{"property", "NULL", "string"},
+			{"name", "NULL", "string"},
+			{"new_value", "NULL", "string"},
+			{"old_value", "NULL", "string"},
+			{"message", "NULL", "string"},
+			{"file", "NULLIF(e.file, '')", "string"},
+			{"line", "CAST(NULLIF(e.line, '') AS INTEGER)", "number"},
+			{"containment_path", "NULL", "string"},
+			{"containing_class", "NULL", "string"},
+			{"corrective_change", "NULL", "boolean"},
+			{"environment", "r.environment", "string"},
+			{"configuration_version", "r.configuration_version", "string"},
+			{"latest_report?", "n.fqdn IS NOT NULL", "boolean"},
+		},
+		Order: "r.executed_at DESC, r.id DESC, e.rowid",
+	},
+	"environments": {
+		From: "( SELECT DISTINCT environment AS name FROM reports WHERE environment IS NOT NULL ) env",
+		Fields: []pdbField{
+			{"name", "env.name", "string"},
+		},
+		Order: "env.name",
+	},
+}
+
+//
+// Find the named field.
+//
+func (e pdbEntity) field(name string) (pdbField, error) {
+	for _, f := range e.Fields {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return pdbField{}, fmt.Errorf("'%s' is not a queryable field", name)
+}
+
+//
+// Convert a value, given in a query, into the value we compare against
+// the SQL expression of the given field.
+//
+func (f pdbField) value(v interface{}) (interface{}, error) {
+	switch f.Kind {
+	case "string":
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case "number":
+		switch n := v.(type) {
+		case float64:
+			return n, nil
+		case string:
+			return strconv.ParseFloat(n, 64)
+		}
+	case "boolean":
+		if b, ok := v.(bool); ok {
+			if b {
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case "timestamp":
+		switch t := v.(type) {
+		case float64:
+			return t, nil
+		case string:
+			parsed, err := time.Parse(time.RFC3339, t)
+			if err != nil {
+				return nil, fmt.Errorf("'%s' is not a valid timestamp", t)
+			}
+			return float64(parsed.UnixNano()) / 1e9, nil
+		}
+	}
+	return nil, fmt.Errorf("%v is not a valid value for the %s field '%s'", v, f.Kind, f.Name)
+}
+
+//
+// Compile a query, in the AST syntax, into an SQL condition and the values
+// of its parameters.
+//
+func (e pdbEntity) compile(ast interface{}) (string, []interface{}, error) {
+
+	terms, ok := ast.([]interface{})
+	if !ok || len(terms) < 1 {
+		return "", nil, errors.New("queries must be arrays, such as [\"=\", \"certname\", \"foo\"]")
+	}
+	op, ok := terms[0].(string)
+	if !ok {
+		return "", nil, errors.New("the first element of a query must be its operator")
+	}
+	args := terms[1:]
+
+	switch op {
+	case "and", "or":
+		if len(args) < 1 {
+			return "", nil, fmt.Errorf("'%s' requires at least one argument", op)
+		}
+		var clauses []string
+		var values []interface{}
+		for _, arg := range args {
+			clause, v, err := e.compile(arg)
+			if err != nil {
+				return "", nil, err
+			}
+			clauses = append(clauses, "( "+clause+" )")
+			values = append(values, v...)
+		}
+		return strings.Join(clauses, " "+strings.ToUpper(op)+" "), values, nil
+
+	case "not":
+		if len(args) != 1 {
+			return "", nil, errors.New("'not' requires one argument")
+		}
+		clause, values, err := e.compile(args[0])
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT ( " + clause + " )", values, nil
+	}
+
+	//
+	// The remaining operators compare a field against a value.
+	//
+	if len(args) != 2 {
+		return "", nil, fmt.Errorf("'%s' requires a field and a value", op)
+	}
+	name, ok := args[0].(string)
+	if !ok {
+		return "", nil, fmt.Errorf("the field compared by '%s' must be a string", op)
+	}
+	f, err := e.field(name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch op {
+	case "=":
+		v, err := f.value(args[1])
+		if err != nil {
+			return "", nil, err
+		}
+		return "( " + f.Expr + " ) = ?", []interface{}{v}, nil
+
+	case "<", "<=", ">", ">=":
+		if f.Kind != "number" && f.Kind != "timestamp" {
+			return "", nil, fmt.Errorf("'%s' can't be applied to the %s field '%s'", op, f.Kind, f.Name)
+		}
+		v, err := f.value(args[1])
+		if err != nil {
+			return "", nil, err
+		}
+		return "( " + f.Expr + " ) " + op + " ?", []interface{}{v}, nil
+
+	case "~":
+		pattern, ok := args[1].(string)
+		if !ok || f.Kind != "string" {
+			return "", nil, fmt.Errorf("'~' requires a string field, and a pattern")
+		}
+		_, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid pattern '%s': %s", pattern, err.Error())
+		}
+		return "IFNULL(" + f.Expr + ", '') REGEXP ?", []interface{}{pattern}, nil
+
+	case "null?":
+		null, ok := args[1].(bool)
+		if !ok {
+			return "", nil, errors.New("'null?' requires a boolean value")
+		}
+		if null {
+			return "( " + f.Expr + " ) IS NULL", nil, nil
+		}
+		return "( " + f.Expr + " ) IS NOT NULL", nil, nil
+	}
+
+	return "", nil, fmt.Errorf("the operator '%s' is not supported", op)
+}
+
+//
+// pdbRequest holds the query, and paging, parameters of a request.
+//
+type pdbRequest struct {
+	Query  interface{}
+	Limit  int
+	Offset int
+
+	// The fields to order by, each followed by `DESC` if the order is
+	// descending.
+	OrderBy []string
+}
+
+//
+// Parse the `query`, `limit`, `offset`, and `order_by` parameters of the
+// given request.
+//
+func parsePDBRequest(req *http.Request) (pdbRequest, error) {
+
+	var p pdbRequest
+
+	if q := req.FormValue("query"); len(q) > 0 {
+		err := json.Unmarshal([]byte(q), &p.Query)
+		if err != nil {
+			return p, errors.New("the query must be in the AST syntax, PQL is not supported")
+		}
+	}
+
+	var err error
+	if l := req.FormValue("limit"); len(l) > 0 {
+		p.Limit, err = strconv.Atoi(l)
+		if err != nil || p.Limit < 0 {
+			return p, fmt.Errorf("invalid limit '%s'", l)
+		}
+	}
+	if o := req.FormValue("offset"); len(o) > 0 {
+		p.Offset, err = strconv.Atoi(o)
+		if err != nil || p.Offset < 0 {
+			return p, fmt.Errorf("invalid offset '%s'", o)
+		}
+	}
+
+	if o := req.FormValue("order_by"); len(o) > 0 {
+		var order []struct {
+			Field string `json:"field"`
+			Order string `json:"order"`
+		}
+		err = json.Unmarshal([]byte(o), &order)
+		if err != nil {
+			return p, errors.New("order_by must be an array, such as [{\"field\": \"certname\", \"order\": \"desc\"}]")
+		}
+		for _, o := range order {
+			switch strings.ToLower(o.Order) {
+			case "", "asc":
+				p.OrderBy = append(p.OrderBy, o.Field)
+			case "desc":
+				p.OrderBy = append(p.OrderBy, o.Field+" DESC")
+			default:
+				return p, fmt.Errorf("invalid order '%s'", o.Order)
+			}
+		}
+	}
+	return p, nil
+}
+
+//
+// Format a single value of the given kind, as PuppetDB would.
+//
+func pdbValue(kind string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if b, ok := v.([]byte); ok {
+		v = string(b)
+	}
+
+	switch kind {
+	case "boolean":
+		n, ok := v.(int64)
+		return ok && n != 0
+	case "timestamp":
+		var secs float64
+		switch t := v.(type) {
+		case int64:
+			secs = float64(t)
+		case float64:
+			secs = t
+		default:
+			return v
+		}
+		return time.Unix(0, int64(secs*1e9)).UTC().Format("2006-01-02T15:04:05.000Z")
+	}
+	return v
+}
+
+//
+// Run the given request against an entity, with any additional condition,
+// returning the results.
+//
+func queryPDB(entity string, p pdbRequest, condition string, args ...interface{}) ([]map[string]interface{}, error) {
+
+	e, ok := pdbEntities[entity]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity '%s'", entity)
+	}
+
+	var exprs []string
+	for _, f := range e.Fields {
+		exprs = append(exprs, f.Expr)
+	}
+	q := newQuery("SELECT " + strings.Join(exprs, ", ") + " FROM " + e.From)
+
+	if p.Query != nil {
+		clause, values, err := e.compile(p.Query)
+		if err != nil {
+			return nil, err
+		}
+		q.Where(clause, values...)
+	}
+	if condition != "" {
+		q.Where(condition, args...)
+	}
+
+	//
+	// Order by the requested fields, and then by our default order
+	// so that paging is stable.
+	//
+	var order []string
+	for _, o := range p.OrderBy {
+		name := strings.TrimSuffix(o, " DESC")
+		f, err := e.field(name)
+		if err != nil {
+			return nil, err
+		}
+		order = append(order, "( "+f.Expr+" )"+strings.TrimPrefix(o, name))
+	}
+	order = append(order, e.Order)
+	q.Then("ORDER BY " + strings.Join(order, ", "))
+
+	if p.Limit > 0 {
+		q.Then("LIMIT ? OFFSET ?", p.Limit, p.Offset)
+	} else if p.Offset > 0 {
+		q.Then("LIMIT -1 OFFSET ?", p.Offset)
+	}
+
+	rows, err := q.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []map[string]interface{}{}
+	for rows.Next() {
+		values := make([]interface{}, len(e.Fields))
+		ptrs := make([]interface{}, len(e.Fields))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		err = rows.Scan(ptrs...)
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]interface{})
+		for i, f := range e.Fields {
+			row[f.Name] = pdbValue(f.Kind, values[i])
+		}
+		if e.Extra != nil {
+			e.Extra(row)
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+//
+// PDBQuery is the handler for the HTTP end-points
+//
+//	 GET /pdb/query/v4/{entity}
+//	 GET /pdb/query/v4/nodes/{certname}
+//	 GET /pdb/query/v4/reports/{hash}/events
+//
+// These return the nodes, reports, events, or environments which match
+// the `query` parameter, as JSON.
+//
+func PDBQuery(res http.ResponseWriter, req *http.Request) {
+
+	var (
+		status int
+		err    error
+	)
+	defer func() {
+		if nil != err {
+			http.Error(res, err.Error(), status)
+		}
+	}()
+
+	vars := mux.Vars(req)
+	entity := vars["entity"]
+	if _, ok := vars["hash"]; ok {
+		entity = "events"
+	}
+	if _, ok := pdbEntities[entity]; !ok {
+		status = http.StatusNotFound
+		err = fmt.Errorf("unknown entity '%s'", entity)
+		return
+	}
+
+	p, err := parsePDBRequest(req)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	//
+	// A single node, or the events of a single report.
+	//
+	var condition string
+	var args []interface{}
+	if certname, ok := vars["certname"]; ok {
+		condition, args = "n.fqdn = ?", []interface{}{certname}
+	}
+	if hash, ok := vars["hash"]; ok {
+		condition, args = pdbReportHash+" = ?", []interface{}{hash}
+	}
+
+	results, err := queryPDB(entity, p, condition, args...)
+	if err != nil {
+		status = http.StatusBadRequest
+		return
+	}
+
+	var out []byte
+	if _, ok := vars["certname"]; ok {
+		if len(results) < 1 {
+			status = http.StatusNotFound
+			err = fmt.Errorf("no information is known about node %s", vars["certname"])
+			return
+		}
+		out, err = json.Marshal(results[0])
+	} else {
+		out, err = json.Marshal(results)
+	}
+	if err != nil {
+		status = http.StatusInternalServerError
+		return
+	}
+
+	res.Header().Set("Content-Type", "application/json")
+	res.Write(out)
+}
@@ -0,0 +1,182 @@
+//
+//  Testing of our subset of the PuppetDB query API.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+//
+// Test the compilation of queries.
+//
+func TestPDBCompile(t *testing.T) {
+
+	nodes := pdbEntities["nodes"]
+
+	type TestCase struct {
+		Query  string
+		SQL    string
+		Values int
+		Error  string
+	}
+
+	tests := []TestCase{
+		{`["=", "certname", "foo"]`, "( n.fqdn ) = ?", 1, ""},
+		{`["~", "certname", "^web"]`, "IFNULL(n.fqdn, '') REGEXP ?", 1, ""},
+		{`["and", ["=", "report_environment", "production"], ["not", ["=", "latest_report_status", "failed"]]]`,
+			"( ( n.environment ) = ? ) AND ( NOT ( ( n.state ) = ? ) )", 2, ""},
+		{`["or", ["<", "report_timestamp", "2019-03-31T00:00:00Z"], ["null?", "deactivated", true]]`,
+			"( ( n.last_seen ) < ? ) OR ( ( NULL ) IS NULL )", 1, ""},
+		{`["=", "password", "secret"]`, "", 0, "not a queryable field"},
+		{`["<", "certname", "foo"]`, "", 0, "can't be applied"},
+		{`["~", "certname", "("]`, "", 0, "invalid pattern"},
+		{`["<", "report_timestamp", "yesterday"]`, "", 0, "not a valid timestamp"},
+		{`["=", "latest_report_noop", "yes"]`, "", 0, "not a valid value"},
+		{`["extract", "certname"]`, "", 0, "requires a field and a value"},
+		{`["in", "certname", "foo"]`, "", 0, "not supported"},
+		{`["and"]`, "", 0, "at least one"},
+		{`"certname"`, "", 0, "must be arrays"},
+	}
+
+	for _, test := range tests {
+		var ast interface{}
+		err := json.Unmarshal([]byte(test.Query), &ast)
+		if err != nil {
+			t.Fatalf("Invalid test-case %s", test.Query)
+		}
+
+		sql, values, err := nodes.compile(ast)
+		if test.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Error) {
+				t.Errorf("Expected an error containing %q for %s, got %v", test.Error, test.Query, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %s", test.Query, err.Error())
+			continue
+		}
+		if sql != test.SQL || len(values) != test.Values {
+			t.Errorf("Unexpected compilation of %s: %s %v", test.Query, sql, values)
+		}
+	}
+}
+
+//
+// Test the end-points, against some real reports.
+//
+func TestPDBQuery(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addDB(PuppetReport{Fqdn: "web1.example.com", Environment: "production", State: "failed", Hash: "aaaa",
+		PuppetVersion: "7.1.0", ReportFormat: 12, CodeID: "abc123", ResourcesFailed: []Resource{{Name: "nginx", Type: "Service", File: "/etc/init.pp", Line: "12"}},
+		ResourcesChanged: []Resource{{Name: "nginx", Type: "Service"}, {Name: "/etc/motd", Type: "File"}}}, "")
+	addDB(PuppetReport{Fqdn: "web1.example.com", Environment: "production", State: "changed", Hash: "bbbb",
+		ResourcesChanged: []Resource{{Name: "/etc/motd", Type: "File"}}}, "")
+	addDB(PuppetReport{Fqdn: "db1.example.com", Environment: "test", State: "unchanged", Hash: "cccc"}, "")
+	addFacts(PuppetFacts{Fqdn: "web1.example.com", Environment: "production", Values: map[string]interface{}{"kernel": "Linux"}})
+
+	router := mux.NewRouter()
+	router.HandleFunc("/pdb/query/v4/{entity:nodes}/{certname}", PDBQuery).Methods("GET")
+	router.HandleFunc("/pdb/query/v4/reports/{hash}/events", PDBQuery).Methods("GET")
+	router.HandleFunc("/pdb/query/v4/{entity}", PDBQuery).Methods("GET")
+
+	type TestCase struct {
+		URL    string
+		Query  string
+		Status int
+		Count  int
+		Field  string
+		Values []interface{}
+	}
+
+	tests := []TestCase{
+		{"/pdb/query/v4/nodes", "", http.StatusOK, 2, "certname", []interface{}{"db1.example.com", "web1.example.com"}},
+		{"/pdb/query/v4/nodes", `["~", "certname", "^web"]`, http.StatusOK, 1, "latest_report_hash", []interface{}{"bbbb"}},
+		{"/pdb/query/v4/reports", `["=", "certname", "web1.example.com"]`, http.StatusOK, 2, "hash", []interface{}{"bbbb", "aaaa"}},
+		{"/pdb/query/v4/reports", `["and", ["=", "certname", "web1.example.com"], ["=", "latest_report?", false]]`, http.StatusOK, 1, "puppet_version", []interface{}{"7.1.0"}},
+		{"/pdb/query/v4/reports", `["=", "hash", "aaaa"]`, http.StatusOK, 1, "report_format", []interface{}{float64(12)}},
+		{"/pdb/query/v4/reports", `["null?", "code_id", false]`, http.StatusOK, 1, "code_id", []interface{}{"abc123"}},
+		{"/pdb/query/v4/nodes", `["null?", "facts_timestamp", false]`, http.StatusOK, 1, "certname", []interface{}{"web1.example.com"}},
+		{"/pdb/query/v4/reports", `["not", ["=", "environment", "production"]]`, http.StatusOK, 1, "status", []interface{}{"unchanged"}},
+		{"/pdb/query/v4/events", `["=", "report", "aaaa"]`, http.StatusOK, 2, "status", []interface{}{"failure", "success"}},
+		{"/pdb/query/v4/events", `["and", ["=", "status", "success"], ["=", "latest_report?", true]]`, http.StatusOK, 1, "resource_title", []interface{}{"/etc/motd"}},
+		{"/pdb/query/v4/reports/aaaa/events", "", http.StatusOK, 2, "resource_type", []interface{}{"Service", "File"}},
+		{"/pdb/query/v4/environments", "", http.StatusOK, 2, "name", []interface{}{"production", "test"}},
+		{"/pdb/query/v4/catalogs", "", http.StatusNotFound, 0, "", nil},
+		{"/pdb/query/v4/nodes", `nodes { certname = "foo" }`, http.StatusBadRequest, 0, "", nil},
+		{"/pdb/query/v4/nodes", `["=", "password", "secret"]`, http.StatusBadRequest, 0, "", nil},
+	}
+
+	for _, test := range tests {
+		u := test.URL
+		if test.Query != "" {
+			u += "?query=" + url.QueryEscape(test.Query)
+		}
+		req, err := http.NewRequest("GET", u, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		if rr.Code != test.Status {
+			t.Errorf("Unexpected status-code for %s %s: %v %s", test.URL, test.Query, rr.Code, rr.Body.String())
+			continue
+		}
+		if test.Status != http.StatusOK {
+			continue
+		}
+
+		var results []map[string]interface{}
+		err = json.Unmarshal(rr.Body.Bytes(), &results)
+		if err != nil {
+			t.Errorf("Invalid response for %s: %s", test.URL, rr.Body.String())
+			continue
+		}
+		if len(results) != test.Count {
+			t.Errorf("Found %d results for %s %s, not %d: %s", len(results), test.URL, test.Query, test.Count, rr.Body.String())
+			continue
+		}
+		for i, v := range test.Values {
+			if results[i][test.Field] != v {
+				t.Errorf("Unexpected %s for %s %s: %v", test.Field, test.URL, test.Query, results[i][test.Field])
+			}
+		}
+	}
+
+	//
+	// A single node, with paging of the reports.
+	//
+	req, _ := http.NewRequest("GET", "/pdb/query/v4/nodes/web1.example.com", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), "\"latest_report_status\":\"changed\"") {
+		t.Errorf("Unexpected response for a single node: %v %s", rr.Code, rr.Body.String())
+	}
+
+	req, _ = http.NewRequest("GET", "/pdb/query/v4/nodes/missing.example.com", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Unexpected status-code for a missing node: %v", rr.Code)
+	}
+
+	req, _ = http.NewRequest("GET", "/pdb/query/v4/reports?limit=1&offset=1&order_by="+url.QueryEscape(`[{"field": "certname", "order": "desc"}]`), nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), "\"hash\":\"aaaa\"") || strings.Count(rr.Body.String(), "\"hash\"") != 1 {
+		t.Errorf("Unexpected response for paging: %v %s", rr.Code, rr.Body.String())
+	}
+}
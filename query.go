@@ -0,0 +1,252 @@
+//
+// A simple builder for the SQL queries we run.
+//
+// Every value which might have come from a user, such as an environment
+// taken from a URL, is passed to SQLite as a parameter rather than being
+// interpolated into the statement.  The conditions, and clauses, added to
+// a query must be constant strings, using `?` for each value.
+//
+
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+//
+// sqlQuery holds a statement which is being built.
+//
+type sqlQuery struct {
+	// The statement, up to any WHERE clause.
+	base string
+
+	// The conditions which must all be true.
+	where []string
+
+	// Any GROUP BY, ORDER BY, or LIMIT clauses.
+	tail []string
+
+	// The values of the parameters, in order.
+	args []interface{}
+
+	// The values of the parameters within the tail.
+	tailArgs []interface{}
+}
+
+//
+// newQuery starts a new statement, such as `SELECT id FROM reports`.
+//
+func newQuery(base string, args ...interface{}) *sqlQuery {
+	return &sqlQuery{base: base, args: args}
+}
+
+//
+// Where adds a condition to the query, for example:
+//
+//    q.Where("environment = ?", environment)
+//
+func (q *sqlQuery) Where(condition string, args ...interface{}) *sqlQuery {
+	q.where = append(q.where, "( "+condition+" )")
+	q.args = append(q.args, args...)
+	return q
+}
+
+//
+// Then adds a clause which follows the conditions, for example:
+//
+//    q.Then("ORDER BY executed_at DESC LIMIT ?", limit)
+//
+func (q *sqlQuery) Then(clause string, args ...interface{}) *sqlQuery {
+	q.tail = append(q.tail, clause)
+	q.tailArgs = append(q.tailArgs, args...)
+	return q
+}
+
+//
+// SQL returns the statement, and the values of its parameters.
+//
+func (q *sqlQuery) SQL() (string, []interface{}) {
+	stmt := q.base
+	if len(q.where) > 0 {
+		stmt += " WHERE " + strings.Join(q.where, " AND ")
+	}
+	if len(q.tail) > 0 {
+		stmt += " " + strings.Join(q.tail, " ")
+	}
+
+	args := make([]interface{}, 0, len(q.args)+len(q.tailArgs))
+	args = append(args, q.args...)
+	args = append(args, q.tailArgs...)
+	return stmt, args
+}
+
+//
+// Query runs the statement against our database, returning the rows.
+//
+func (q *sqlQuery) Query() (*sql.Rows, error) {
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+	stmt, args := q.SQL()
+	return db.Query(stmt, args...)
+}
+
+//
+// Exec runs the statement against our database.
+//
+func (q *sqlQuery) Exec() (sql.Result, error) {
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+	stmt, args := q.SQL()
+	return db.Exec(stmt, args...)
+}
+
+//
+// NodeFilter describes the nodes, or their reports, which should be
+// returned.  Each field is ignored when empty.
+//
+type NodeFilter struct {
+
+	// Environment contains the name of a single environment.
+	Environment string
+
+	// State is one of `changed`, `unchanged`, `failed`, `orphaned`, or
+	// `acknowledged`.
+	State string
+
+	// Fqdn is a pattern which the name of a node must match, and
+	// may contain `*` and `?` wildcards.
+	Fqdn string
+
+	// Facts maps the names of facts to the values that the latest
+	// facts of a node must have, for example `os.family` to `RedHat`.
+	Facts map[string]string
+
+	// Group is the name of one of the node groups we've configured.
+	Group string
+
+	// Owner is the team which owns the nodes.
+	Owner string
+}
+
+//
+// The names of environments, nodes, and facts, and the patterns matching
+// node-names, that we accept.
+//
+// Nested facts are named by joining their keys with a period, for example
+// `os.release.major`.
+//
+var (
+	environmentRegexp = regexp.MustCompile("^([A-Za-z0-9_]+)$")
+	fqdnRegexp        = regexp.MustCompile("^([a-z0-9._-]+)$")
+	fqdnPatternRegexp = regexp.MustCompile("^([a-z0-9._*?-]+)$")
+	factNameRegexp    = regexp.MustCompile("^[A-Za-z0-9_:-]+(\\.[A-Za-z0-9_:-]+)*$")
+)
+
+//
+// Validate returns an error if the filter contains anything bogus.
+//
+// The values are always passed to SQLite as parameters, so this isn't
+// required for safety, but it allows us to reject bad requests.
+//
+func (f NodeFilter) Validate() error {
+
+	if len(f.Environment) > 0 && !environmentRegexp.MatchString(f.Environment) {
+		return fmt.Errorf("invalid environment '%s'", f.Environment)
+	}
+
+	switch f.State {
+	case "", "changed", "unchanged", "failed", "orphaned", "acknowledged":
+	default:
+		return fmt.Errorf("invalid state '%s'", f.State)
+	}
+
+	if len(f.Fqdn) > 0 && !fqdnPatternRegexp.MatchString(f.Fqdn) {
+		return fmt.Errorf("invalid node pattern '%s'", f.Fqdn)
+	}
+
+	for name := range f.Facts {
+		if !factNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid fact '%s'", name)
+		}
+	}
+
+	if len(f.Group) > 0 {
+		if _, ok := currentSettings().Groups.Rule(f.Group); !ok {
+			return fmt.Errorf("unknown group '%s'", f.Group)
+		}
+	}
+
+	if len(f.Owner) > 0 && !ownerRegexp.MatchString(f.Owner) {
+		return fmt.Errorf("invalid owner '%s'", f.Owner)
+	}
+	return nil
+}
+
+//
+// Apply adds the conditions of the filter to the given query.
+//
+// The prefix is added to the name of each column, to allow the filter
+// to be applied to a table within a join, for example `r.`.
+//
+// The states `changed`, `unchanged`, and `failed`, are compared as-is,
+// so they match the state of each report.  Nodes are only orphaned, or
+// acknowledged, in relation to the time they were last seen, so those
+// states match the rows of the nodes which are currently in them.
+//
+func (f NodeFilter) Apply(q *sqlQuery, prefix string) *sqlQuery {
+	if len(f.Environment) > 0 {
+		q.Where(prefix+"environment = ?", f.Environment)
+	}
+	switch f.State {
+	case "":
+	case "orphaned", "acknowledged":
+		cond, err := stateCondition(f.State, time.Now().Unix())
+		if err != nil {
+			return q.Where("0")
+		}
+		q.Where(prefix+"fqdn IN ( SELECT fqdn FROM nodes WHERE "+cond.sql+" )", cond.args...)
+	default:
+		q.Where(prefix+"state = ?", f.State)
+	}
+	if len(f.Fqdn) > 0 {
+		q.Where(prefix+"fqdn GLOB ?", f.Fqdn)
+	}
+
+	//
+	// Apply the facts in a stable order, so that the statement
+	// doesn't vary.
+	//
+	var names []string
+	for name := range f.Facts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		q.Where(prefix+"fqdn IN ( SELECT fqdn FROM node_facts WHERE name = ? AND value = ? )", name, f.Facts[name])
+	}
+
+	if len(f.Owner) > 0 {
+		q.Where(prefix+"fqdn IN ( SELECT fqdn FROM node_annotations WHERE owner = ? )", f.Owner)
+	}
+
+	//
+	// A group which doesn't exist has no members.
+	//
+	if len(f.Group) > 0 {
+		rule, ok := currentSettings().Groups.Rule(f.Group)
+		if !ok {
+			return q.Where("0")
+		}
+		rule.Apply(q, prefix)
+	}
+	return q
+}
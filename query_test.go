@@ -0,0 +1,136 @@
+//
+//  Testing of our query builder, and node filters.
+//
+
+package main
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+//
+// Conditions and clauses are combined, along with their parameters.
+//
+func TestQueryBuilder(t *testing.T) {
+
+	q := newQuery("SELECT id FROM reports")
+	stmt, args := q.SQL()
+	if stmt != "SELECT id FROM reports" || len(args) != 0 {
+		t.Errorf("Unexpected query: %s %v", stmt, args)
+	}
+
+	q.Then("LIMIT ?", 10)
+	q.Where("environment = ?", "production")
+	NodeFilter{State: "failed", Fqdn: "*.example.com"}.Apply(q, "r.")
+
+	stmt, args = q.SQL()
+	if stmt != "SELECT id FROM reports WHERE ( environment = ? ) AND ( r.state = ? ) AND ( r.fqdn GLOB ? ) LIMIT ?" {
+		t.Errorf("Unexpected query: %s", stmt)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"production", "failed", "*.example.com", 10}) {
+		t.Errorf("Unexpected arguments: %v", args)
+	}
+}
+
+//
+// Bogus filters are rejected.
+//
+func TestNodeFilterValidate(t *testing.T) {
+
+	valid := []NodeFilter{
+		{},
+		{Environment: "production", State: "orphaned", Fqdn: "web-?.example.com"},
+		{Fqdn: "*"},
+	}
+	for _, f := range valid {
+		if err := f.Validate(); err != nil {
+			t.Errorf("Unexpected error for %v: %s", f, err.Error())
+		}
+	}
+
+	invalid := []NodeFilter{
+		{Environment: "production' OR '1'='1"},
+		{Environment: "test;"},
+		{State: "broken"},
+		{Fqdn: "x' OR 1=1 --"},
+		{Fqdn: "[a-z]*"},
+	}
+	for _, f := range invalid {
+		if err := f.Validate(); err == nil {
+			t.Errorf("Expected an error for %v", f)
+		}
+	}
+}
+
+//
+// Filters may be combined, and their values are never treated as SQL.
+//
+func TestNodeFilters(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	// With some reports.
+	addFakeReports()
+
+	//
+	// The filters we'll try, and the number of nodes we expect.
+	//
+	tests := []struct {
+		Filter NodeFilter
+		Count  int
+	}{
+		{NodeFilter{}, 30},
+		{NodeFilter{Environment: "production"}, 3},
+		{NodeFilter{Environment: "test", State: "orphaned"}, 26},
+		{NodeFilter{Environment: "production", State: "orphaned"}, 0},
+		{NodeFilter{Fqdn: "node1*"}, 11},
+		{NodeFilter{Fqdn: "node?.example.com", State: "orphaned"}, 6},
+		{NodeFilter{Environment: "production' OR '1'='1"}, 0},
+		{NodeFilter{Fqdn: "x' OR 1=1 --"}, 0},
+	}
+
+	for _, test := range tests {
+		nodes, err := getNodes(test.Filter)
+		if err != nil {
+			t.Errorf("Failed to get nodes for %v: %s", test.Filter, err.Error())
+		}
+		if len(nodes) != test.Count {
+			t.Errorf("Expected %d nodes for %v, got %d", test.Count, test.Filter, len(nodes))
+		}
+
+		//
+		// Each node has a single report, which the same filter
+		// finds, even though the states of orphaned nodes aren't
+		// stored.
+		//
+		var reports int
+		stmt, args := test.Filter.Apply(newQuery("SELECT COUNT(*) FROM reports"), "").SQL()
+		err = db.QueryRow(stmt, args...).Scan(&reports)
+		if err != nil || reports != test.Count {
+			t.Errorf("Expected %d reports for %v, got %d %v", test.Count, test.Filter, reports, err)
+		}
+	}
+
+	//
+	// Pruning with a bogus environment removes nothing.
+	//
+	err := pruneReports("test' OR '1'='1", "", 0, false)
+	if err != nil {
+		t.Errorf("Failed to prune: %s", err.Error())
+	}
+	count, _ := countReports()
+	if count != 30 {
+		t.Errorf("Expected no reports to be pruned, found %d", count)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
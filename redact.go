@@ -0,0 +1,350 @@
+//
+// Redaction of secrets from reports.
+//
+// Log messages, and the values recorded in resource-events, may contain
+// secrets - for example the diff of a templated configuration file.  We
+// allow a set of rules to be configured which mask such values before a
+// report is written to disk, and again before it is displayed.
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+//
+// Redacted is the text which replaces any masked value.
+//
+const Redacted = "[REDACTED]"
+
+//
+// redactRules is the structure of the file which configures redaction.
+//
+// For example:
+//
+//    patterns:
+//      - "(?i)password\\s*[=:]\\s*\\S+"
+//    resources:
+//      - "File[/etc/mysql/debian.cnf]"
+//      - "File[/etc/ssl/private/*]"
+//    properties:
+//      - content
+//
+type redactRules struct {
+
+	//
+	// Regular expressions, any matches of which are masked wherever
+	// they appear in a report.
+	//
+	Patterns []string `yaml:"patterns"`
+
+	//
+	// Resource titles, which may contain `*` as a wildcard, whose
+	// event-values and logged messages are masked.
+	//
+	Resources []string `yaml:"resources"`
+
+	//
+	// Properties, such as `content`, whose event-values and logged
+	// messages are masked for every resource.
+	//
+	Properties []string `yaml:"properties"`
+}
+
+//
+// reportRedactor applies a set of compiled rules to reports.
+//
+type reportRedactor struct {
+	patterns []*regexp.Regexp
+
+	// Matches the title of a masked resource.
+	titles []*regexp.Regexp
+
+	// Matches the source of a message logged by a masked resource.
+	sources []*regexp.Regexp
+
+	properties map[string]bool
+}
+
+//
+// The values within a resource-event which are masked.
+//
+var eventValues = []string{"previous_value", "desired_value", "historical_value", "message"}
+
+//
+// newRedactor compiles the given rules.
+//
+func newRedactor(rules redactRules) (*reportRedactor, error) {
+
+	r := &reportRedactor{properties: make(map[string]bool)}
+
+	for _, p := range rules.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s': %s", p, err.Error())
+		}
+		r.patterns = append(r.patterns, re)
+	}
+
+	for _, title := range rules.Resources {
+		if !strings.HasSuffix(title, "]") || !strings.Contains(title, "[") {
+			return nil, fmt.Errorf("invalid resource '%s', expected Type[title]", title)
+		}
+
+		//
+		// Convert the wildcard into a regular expression.
+		//
+		glob := strings.Replace(regexp.QuoteMeta(title), "\\*", ".*", -1)
+
+		r.titles = append(r.titles, regexp.MustCompile("^"+glob+"$"))
+		r.sources = append(r.sources, regexp.MustCompile("/"+glob+"(/|$)"))
+	}
+
+	for _, p := range rules.Properties {
+		r.properties[p] = true
+	}
+
+	return r, nil
+}
+
+//
+// loadRedactor reads the rules from the given file, and compiles them.
+//
+func loadRedactor(path string) (*reportRedactor, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules redactRules
+	err = yaml.UnmarshalStrict(content, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", path, err.Error())
+	}
+
+	return newRedactor(rules)
+}
+
+//
+// maskedTitle returns true if the given resource is masked.
+//
+func (r *reportRedactor) maskedTitle(title string) bool {
+	for _, re := range r.titles {
+		if re.MatchString(title) {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// maskedSource returns true if messages logged by the given source are
+// masked.  Sources look like `/Stage[main]/Web/File[/etc/motd]/content`.
+//
+func (r *reportRedactor) maskedSource(source string) bool {
+	for _, re := range r.sources {
+		if re.MatchString(source) {
+			return true
+		}
+	}
+
+	i := strings.LastIndex(source, "/")
+	if i >= 0 && strings.HasSuffix(source[:i], "]") {
+		return r.properties[source[i+1:]]
+	}
+	return false
+}
+
+//
+// maskString applies our patterns to the given string.
+//
+func (r *reportRedactor) maskString(s string) string {
+	for _, re := range r.patterns {
+		s = re.ReplaceAllString(s, Redacted)
+	}
+	return s
+}
+
+//
+// Redact masks the secrets within the given report, returning the updated
+// report and whether anything was changed.
+//
+// The ruby-specific tags which puppet adds are preserved, so the result is
+// still a valid report.
+//
+func (r *reportRedactor) Redact(content []byte) ([]byte, bool, error) {
+
+	var doc yamlv3.Node
+	err := yamlv3.Unmarshal(content, &doc)
+	if err != nil {
+		return nil, false, errors.New("failed to parse YAML")
+	}
+	if doc.Kind != yamlv3.DocumentNode || len(doc.Content) != 1 {
+		return nil, false, errors.New("failed to parse YAML")
+	}
+	root := doc.Content[0]
+
+	changed := false
+
+	//
+	// Mask the values of the events of masked resources, and of
+	// masked properties.
+	//
+	statuses := mapValue(root, "resource_statuses")
+	if statuses != nil && statuses.Kind == yamlv3.MappingNode {
+		for i := 0; i+1 < len(statuses.Content); i += 2 {
+			title := statuses.Content[i].Value
+			masked := r.maskedTitle(title)
+
+			events := mapValue(statuses.Content[i+1], "events")
+			if events == nil || events.Kind != yamlv3.SequenceNode {
+				continue
+			}
+			for _, event := range events.Content {
+				property := mapValue(event, "property")
+				if !masked && (property == nil || !r.properties[property.Value]) {
+					continue
+				}
+				for _, key := range eventValues {
+					if mask(mapValue(event, key)) {
+						changed = true
+					}
+				}
+			}
+		}
+	}
+
+	//
+	// Mask the messages logged by masked resources, or properties.
+	//
+	logs := mapValue(root, "logs")
+	if logs != nil && logs.Kind == yamlv3.SequenceNode {
+		for _, log := range logs.Content {
+			source := mapValue(log, "source")
+			if source != nil && r.maskedSource(source.Value) {
+				if mask(mapValue(log, "message")) {
+					changed = true
+				}
+			}
+		}
+	}
+
+	//
+	// Finally apply our patterns to every value.
+	//
+	if len(r.patterns) > 0 && r.maskNode(root) {
+		changed = true
+	}
+
+	if !changed {
+		return content, false, nil
+	}
+
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	err = enc.Encode(&doc)
+	if err != nil {
+		return nil, false, err
+	}
+	enc.Close()
+
+	return buf.Bytes(), true, nil
+}
+
+//
+// RedactStream applies our patterns to the report read from `in`, one
+// line at a time, writing the result to `out`, and returning true if
+// anything was masked.
+//
+// This is used for reports too large to be redacted in memory, and so
+// only the patterns are applied.
+//
+func (r *reportRedactor) RedactStream(in io.Reader, out io.Writer) (bool, error) {
+
+	changed := false
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			masked := r.maskString(line)
+			if masked != line {
+				changed = true
+			}
+			_, werr := io.WriteString(out, masked)
+			if werr != nil {
+				return changed, werr
+			}
+		}
+		if err == io.EOF {
+			return changed, nil
+		}
+		if err != nil {
+			return changed, err
+		}
+	}
+}
+
+//
+// maskNode applies our patterns to every scalar beneath the given node,
+// returning true if any were changed.
+//
+func (r *reportRedactor) maskNode(n *yamlv3.Node) bool {
+	changed := false
+
+	if n.Kind == yamlv3.ScalarNode {
+		s := r.maskString(n.Value)
+		if s != n.Value {
+			n.Value = s
+			n.Tag = "!!str"
+			changed = true
+		}
+	}
+	for _, c := range n.Content {
+		if r.maskNode(c) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+//
+// mapValue returns the value of the given key in a mapping-node, or nil.
+//
+func mapValue(n *yamlv3.Node, key string) *yamlv3.Node {
+	if n == nil || n.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+//
+// mask replaces the given value, returning true if it was non-empty.
+//
+func mask(n *yamlv3.Node) bool {
+	if n == nil {
+		return false
+	}
+	if n.Kind == yamlv3.ScalarNode && (n.Value == "" || n.Value == Redacted || n.Tag == "!!null") {
+		return false
+	}
+	*n = yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: Redacted}
+	return true
+}
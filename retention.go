@@ -0,0 +1,513 @@
+//
+// Retention policies, which describe the reports we keep.
+//
+// Rather than removing every report older than a fixed number of days we
+// allow the age at which reports are removed to depend upon their state,
+// and their environment.  For example:
+//
+//    environments:
+//      production:
+//        bodies: 7
+//        failed: 90
+//        changed: 30
+//        unchanged: 3
+//        keep: 10
+//        orphaned: 14
+//        rollups: 730
+//      "*":
+//        failed: 30
+//        changed: 7
+//        unchanged: 1
+//
+// Each rule applies to the named environment, and the rule named `*` to
+// every environment which has no rule of its own.
+//
+// Retention is tiered.  The body of a report, the YAML file, is the
+// largest part and the first to be removed.  Its summary, with the
+// resources and timings we recorded, is kept for longer.  Once that is
+// removed the run is still counted in a daily rollup of each node, which
+// is used for our history graphs.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// retentionRule describes the reports we keep within an environment.
+//
+// Each value is ignored when it is zero.
+//
+type retentionRule struct {
+
+	//
+	// The number of days for which we keep the body of each report.
+	//
+	Bodies int `yaml:"bodies"`
+
+	//
+	// The number of days for which we keep reports of failed,
+	// changed, and unchanged runs.
+	//
+	Failed    int `yaml:"failed"`
+	Changed   int `yaml:"changed"`
+	Unchanged int `yaml:"unchanged"`
+
+	//
+	// The number of the most recent runs of each node which are kept,
+	// regardless of their age.  The latest run of a node is always
+	// kept, unless the node is orphaned.
+	//
+	Keep int `yaml:"keep"`
+
+	//
+	// The number of days after which every report of a node which has
+	// stopped reporting is removed.
+	//
+	Orphaned int `yaml:"orphaned"`
+
+	//
+	// The number of days for which we keep the daily rollups of the
+	// runs whose reports have been removed.
+	//
+	Rollups int `yaml:"rollups"`
+}
+
+//
+// retentionPolicy is the structure of the file which configures our
+// retention.
+//
+type retentionPolicy struct {
+	Environments map[string]retentionRule `yaml:"environments"`
+}
+
+//
+// retentionCandidate is a report which our policy removes.
+//
+type retentionCandidate struct {
+	ID          int64
+	Fqdn        string
+	Environment string
+	State       string
+	Path        string
+	ExecutedAt  int64
+	Size        int64
+
+	// Orphaned is set if the report was removed because its node
+	// has stopped reporting, rather than because of its age.
+	Orphaned bool
+	Reason   string
+}
+
+//
+// retentionResult describes the outcome of applying our policy.
+//
+type retentionResult struct {
+	Started  time.Time
+	Duration time.Duration
+	DryRun   bool
+
+	// The reports which were removed, or would have been.
+	Reports []retentionCandidate
+
+	// The reports whose bodies were removed, leaving their summary.
+	Expired []retentionCandidate
+
+	// The number of daily rollups which were removed.
+	Rollups int
+
+	// The number of nodes which were removed, as they were orphaned.
+	Nodes int
+
+	// The size of the report files which were removed.
+	Bytes int64
+}
+
+//
+// loadRetention reads, and validates, the policy in the given file.
+//
+func loadRetention(path string) (*retentionPolicy, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy retentionPolicy
+	err = yaml.UnmarshalStrict(content, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", path, err.Error())
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return &policy, nil
+}
+
+//
+// Validate returns an error if the policy contains anything bogus.
+//
+func (p *retentionPolicy) Validate() error {
+
+	if len(p.Environments) == 0 {
+		return errors.New("no environments are configured")
+	}
+
+	for name, rule := range p.Environments {
+		if name != "*" && !environmentRegexp.MatchString(name) {
+			return fmt.Errorf("invalid environment '%s'", name)
+		}
+		if rule.Bodies < 0 || rule.Failed < 0 || rule.Changed < 0 || rule.Unchanged < 0 || rule.Keep < 0 || rule.Orphaned < 0 || rule.Rollups < 0 {
+			return fmt.Errorf("negative value in the rule for '%s'", name)
+		}
+	}
+	return nil
+}
+
+//
+// Rule returns the rule which applies to the given environment, if any.
+//
+func (p *retentionPolicy) Rule(environment string) (retentionRule, bool) {
+	rule, ok := p.Environments[environment]
+	if !ok {
+		rule, ok = p.Environments["*"]
+	}
+	return rule, ok
+}
+
+//
+// findRetention returns the reports, within the given environment, which
+// the rule removes.
+//
+func findRetention(environment string, rule retentionRule, now time.Time) ([]retentionCandidate, error) {
+
+	days := func(n int) int64 {
+		return now.Add(-time.Duration(n) * 24 * time.Hour).Unix()
+	}
+	ages := map[string]int{"failed": rule.Failed, "changed": rule.Changed, "unchanged": rule.Unchanged}
+
+	//
+	// We number the runs of each node, most recent first, so that
+	// we can keep the last few regardless of their age.
+	//
+	q := newQuery(`SELECT r.id, r.fqdn, IFNULL(r.state,''), IFNULL(r.yaml_file,''), r.executed_at, IFNULL(n.last_seen,0)
+                         FROM ( SELECT *, ROW_NUMBER() OVER ( PARTITION BY fqdn ORDER BY executed_at DESC, id DESC ) AS position FROM reports ) r
+                    LEFT JOIN nodes n ON n.fqdn = r.fqdn`)
+	q.Where("IFNULL(r.environment,'') = ?", environment)
+
+	//
+	// A report is removed if its node is orphaned, or if it is old
+	// enough, and not among the most recent runs.  The latest run is
+	// always among them, so that the nodes-table never refers to a
+	// report which has been removed.
+	//
+	keep := rule.Keep
+	if keep < 1 {
+		keep = 1
+	}
+
+	var aged []interface{}
+	condition := ""
+	for _, state := range []string{"failed", "changed", "unchanged"} {
+		if ages[state] > 0 {
+			if condition != "" {
+				condition += " OR "
+			}
+			condition += "( r.state = ? AND r.executed_at < ? )"
+			aged = append(aged, state, days(ages[state]))
+		}
+	}
+
+	var orphaned int64
+	switch {
+	case condition != "" && rule.Orphaned > 0:
+		orphaned = days(rule.Orphaned)
+		q.Where("IFNULL(n.last_seen,0) < ? OR ( r.position > ? AND ( "+condition+" ) )", append([]interface{}{orphaned, keep}, aged...)...)
+	case condition != "":
+		q.Where("r.position > ? AND ( "+condition+" )", append([]interface{}{keep}, aged...)...)
+	case rule.Orphaned > 0:
+		orphaned = days(rule.Orphaned)
+		q.Where("IFNULL(n.last_seen,0) < ?", orphaned)
+	default:
+		return nil, nil
+	}
+
+	rows, err := q.Then("ORDER BY r.fqdn, r.executed_at").Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []retentionCandidate
+	for rows.Next() {
+		var c retentionCandidate
+		var seen int64
+		err = rows.Scan(&c.ID, &c.Fqdn, &c.State, &c.Path, &c.ExecutedAt, &seen)
+		if err != nil {
+			return nil, err
+		}
+		c.Environment = environment
+
+		if rule.Orphaned > 0 && seen < orphaned {
+			c.Orphaned = true
+			c.Reason = fmt.Sprintf("orphaned for over %d days", rule.Orphaned)
+		} else {
+			c.Reason = fmt.Sprintf("%s over %d days ago", c.State, ages[c.State])
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+//
+// findExpired returns the reports, within the given environment, whose
+// bodies the rule removes.  Those which are removed entirely are found
+// by findRetention instead.
+//
+func findExpired(environment string, rule retentionRule, now time.Time) ([]retentionCandidate, error) {
+
+	if rule.Bodies == 0 {
+		return nil, nil
+	}
+
+	q := newQuery(`SELECT r.id, r.fqdn, IFNULL(r.state,''), r.yaml_file, r.executed_at
+                         FROM ( SELECT *, ROW_NUMBER() OVER ( PARTITION BY fqdn ORDER BY executed_at DESC, id DESC ) AS position FROM reports ) r`)
+	q.Where("IFNULL(r.environment,'') = ?", environment)
+	q.Where("r.yaml_file IS NOT NULL AND r.yaml_file != 'pruned'")
+	q.Where("r.position > ? AND r.executed_at < ?", rule.Keep, now.Add(-time.Duration(rule.Bodies)*24*time.Hour).Unix())
+
+	rows, err := q.Then("ORDER BY r.fqdn, r.executed_at").Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []retentionCandidate
+	for rows.Next() {
+		c := retentionCandidate{Environment: environment}
+		err = rows.Scan(&c.ID, &c.Fqdn, &c.State, &c.Path, &c.ExecutedAt)
+		if err != nil {
+			return nil, err
+		}
+		c.Reason = fmt.Sprintf("body over %d days old", rule.Bodies)
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+//
+// reportRetention describes the outcome of applying our policy, and the
+// reports which were removed if `verbose` is set.
+//
+func reportRetention(w io.Writer, result *retentionResult, verbose bool) {
+
+	verb, expire := "Removed", "Expired"
+	if result.DryRun {
+		verb, expire = "Would remove", "Would expire"
+	}
+
+	if verbose {
+		for _, c := range result.Reports {
+			fmt.Fprintf(w, "%s ID:%d %s [%s] %s - %s\n", verb, c.ID, c.Fqdn, c.Environment,
+				time.Unix(c.ExecutedAt, 0).Format("2006-01-02 15:04:05"), c.Reason)
+		}
+		for _, c := range result.Expired {
+			fmt.Fprintf(w, "%s ID:%d %s [%s] %s - %s\n", expire, c.ID, c.Fqdn, c.Environment,
+				time.Unix(c.ExecutedAt, 0).Format("2006-01-02 15:04:05"), c.Reason)
+		}
+	}
+
+	fmt.Fprintf(w, "%s %d reports, including those of %d orphaned nodes, the bodies of %d more, and %d daily rollups, reclaiming %.1f MB\n",
+		verb, len(result.Reports), result.Nodes, len(result.Expired), result.Rollups, float64(result.Bytes)/(1024*1024))
+}
+
+//
+// applyRetention removes the reports our policy doesn't keep, from disk
+// and from our database, along with any daily rollups which have expired.
+//
+// When `dryRun` is set we only report what would be removed.  Otherwise
+// the outcome is recorded, so that it may be included in our metrics.
+//
+func applyRetention(policy *retentionPolicy, prefix string, dryRun bool) (*retentionResult, error) {
+
+	//
+	// Ensure we have a DB-handle
+	//
+	if db == nil {
+		return nil, errors.New("SetupDB not called")
+	}
+
+	result := &retentionResult{Started: time.Now(), DryRun: dryRun}
+
+	//
+	// Find the environments we hold reports for.
+	//
+	rows, err := db.Query("SELECT IFNULL(environment,'') FROM reports UNION SELECT environment FROM daily_rollups")
+	if err != nil {
+		return nil, err
+	}
+	var environments []string
+	for rows.Next() {
+		var env string
+		err = rows.Scan(&env)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		environments = append(environments, env)
+	}
+	rows.Close()
+	sort.Strings(environments)
+
+	//
+	// Find the reports, and rollups, each rule removes.
+	//
+	rollups := make(map[string]string)
+	for _, env := range environments {
+		rule, ok := policy.Rule(env)
+		if !ok {
+			continue
+		}
+
+		candidates, err := findRetention(env, rule, result.Started)
+		if err != nil {
+			return nil, err
+		}
+		result.Reports = append(result.Reports, candidates...)
+
+		removed := make(map[int64]bool)
+		for _, c := range candidates {
+			removed[c.ID] = true
+		}
+
+		expired, err := findExpired(env, rule, result.Started)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range expired {
+			if !removed[c.ID] {
+				result.Expired = append(result.Expired, c)
+			}
+		}
+
+		if rule.Rollups > 0 {
+			day := result.Started.AddDate(0, 0, -rule.Rollups).Format("2006-01-02")
+			var count int
+			err = db.QueryRow("SELECT COUNT(*) FROM daily_rollups WHERE environment = ? AND day < ?", env, day).Scan(&count)
+			if err != nil {
+				return nil, err
+			}
+			rollups[env] = day
+			result.Rollups += count
+		}
+	}
+
+	nodes := make(map[string]bool)
+	for _, list := range [][]retentionCandidate{result.Reports, result.Expired} {
+		for i, c := range list {
+			if c.Path != "" && c.Path != "pruned" {
+				info, err := os.Stat(filepath.Join(prefix, c.Path))
+				if err == nil {
+					list[i].Size = info.Size()
+					result.Bytes += info.Size()
+				}
+			}
+			if c.Orphaned {
+				nodes[c.Fqdn] = true
+			}
+		}
+	}
+	result.Nodes = len(nodes)
+
+	if dryRun {
+		result.Duration = time.Since(result.Started)
+		return result, nil
+	}
+
+	//
+	// Update the database, then remove the files from disk.
+	//
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, _ := rollupReports().Where("id = ?").SQL()
+	steps := []struct {
+		stmt    string
+		reports []retentionCandidate
+	}{
+		{rollup, result.Reports},
+		{"DELETE FROM reports WHERE id = ?", result.Reports},
+		{"UPDATE reports SET yaml_file = 'pruned' WHERE id = ?", result.Expired},
+	}
+	for _, step := range steps {
+		stmt, err := tx.Prepare(step.stmt)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		for _, c := range step.reports {
+			_, err = stmt.Exec(c.ID)
+			if err != nil {
+				stmt.Close()
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		stmt.Close()
+	}
+
+	for env, day := range rollups {
+		_, err = tx.Exec("DELETE FROM daily_rollups WHERE environment = ? AND day < ?", env, day)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	for fqdn := range nodes {
+		err = removeNode(tx, fqdn)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range append(result.Reports, result.Expired...) {
+		//
+		//  We won't care if this fails, it might have
+		// been removed behind our back or failed to
+		// be uploaded in the first place.
+		//
+		if c.Path != "" && c.Path != "pruned" {
+			os.Remove(filepath.Join(prefix, c.Path))
+		}
+	}
+
+	err = pruneDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	result.Duration = time.Since(result.Started)
+	return result, addPruneHistory(*result)
+}
@@ -0,0 +1,457 @@
+//
+//  Testing of our retention policies.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+//
+// Test that bogus policies are rejected.
+//
+func TestRetentionPolicy(t *testing.T) {
+
+	type TestCase struct {
+		policy string
+		error  string
+	}
+
+	tests := []TestCase{
+		{"environments: [", "failed to parse"},
+		{"", "no environments are configured"},
+		{"environments:\n  \"prod uction\":\n    failed: 3", "invalid environment 'prod uction'"},
+		{"environments:\n  production:\n    failed: -3", "negative value in the rule for 'production'"},
+		{"environments:\n  production:\n    bodies: -3", "negative value in the rule for 'production'"},
+		{"environments:\n  production:\n    broken: 3", "field broken not found"},
+	}
+
+	for _, test := range tests {
+		file, cleanup := writeConfig(t, test.policy)
+
+		_, err := loadRetention(file)
+		if err == nil {
+			t.Errorf("Expected an error loading '%s'", test.policy)
+		} else if !strings.Contains(err.Error(), test.error) {
+			t.Errorf("Expected '%s' in error, got '%s'", test.error, err.Error())
+		}
+		cleanup()
+	}
+
+	file, cleanup := writeConfig(t, "environments:\n  production:\n    failed: 3\n  \"*\":\n    keep: 3\n")
+	defer cleanup()
+
+	policy, err := loadRetention(file)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	rule, ok := policy.Rule("production")
+	if !ok || rule.Failed != 3 {
+		t.Errorf("Unexpected rule %v", rule)
+	}
+	rule, ok = policy.Rule("test")
+	if !ok || rule.Keep != 3 || rule.Failed != 0 {
+		t.Errorf("Unexpected default rule %v", rule)
+	}
+
+	delete(policy.Environments, "*")
+	_, ok = policy.Rule("test")
+	if ok {
+		t.Errorf("Expected no rule without a default")
+	}
+}
+
+//
+// Test applying a policy.
+//
+func TestRetention(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	//
+	// The reports of each node, with their age in days.
+	//
+	type fakeReport struct {
+		fqdn        string
+		environment interface{}
+		state       string
+		age         int
+	}
+
+	reports := []fakeReport{
+		// Old runs are removed, unless they're one of the last two.
+		{"a.example.com", "production", "unchanged", 0},
+		{"a.example.com", "production", "unchanged", 2},
+		{"a.example.com", "production", "unchanged", 5},
+		{"a.example.com", "production", "unchanged", 10},
+		{"a.example.com", "production", "changed", 40},
+		{"a.example.com", "production", "failed", 60},
+		{"b.example.com", "production", "unchanged", 5},
+		{"b.example.com", "production", "unchanged", 6},
+
+		// Orphaned nodes lose every report.
+		{"c.example.com", "production", "changed", 20},
+		{"c.example.com", "production", "unchanged", 25},
+
+		// The default rule applies to other environments, but
+		// the latest run of a node is always kept.
+		{"d.example.com", "test", "unchanged", 2},
+		{"d.example.com", "test", "failed", 2},
+		{"e.example.com", nil, "unchanged", 3},
+	}
+
+	now := time.Now()
+	for i, r := range reports {
+		file := fmt.Sprintf("%d.yaml", i)
+		err := ioutil.WriteFile(filepath.Join(path, file), bytes.Repeat([]byte("x"), 1024), 0644)
+		if err != nil {
+			t.Fatalf("Failed to write report: %s", err.Error())
+		}
+
+		at := now.Add(-time.Duration(r.age)*24*time.Hour - time.Minute).Unix()
+		_, err = db.Exec("INSERT INTO reports(fqdn,environment,state,yaml_file,executed_at) VALUES(?,?,?,?,?)",
+			r.fqdn, r.environment, r.state, file, at)
+		if err != nil {
+			t.Fatalf("Failed to insert report: %s", err.Error())
+		}
+	}
+	rebuildNodes()
+
+	policy := &retentionPolicy{
+		Environments: map[string]retentionRule{
+			"production": {Failed: 90, Changed: 30, Unchanged: 3, Keep: 2, Orphaned: 14},
+			"*":          {Unchanged: 1},
+		},
+	}
+
+	//
+	// Ordered by environment, node, and then the time of the run.
+	//
+	expected := []string{
+		"4.yaml changed over 30 days ago",
+		"3.yaml unchanged over 3 days ago",
+		"2.yaml unchanged over 3 days ago",
+		"9.yaml orphaned for over 14 days",
+		"8.yaml orphaned for over 14 days",
+		"10.yaml unchanged over 1 days ago",
+	}
+
+	//
+	// A dry-run finds the reports, but removes nothing.
+	//
+	result, err := applyRetention(policy, path, true)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	var found []string
+	for _, c := range result.Reports {
+		found = append(found, c.Path+" "+c.Reason)
+	}
+	if strings.Join(found, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected reports:\n%s", strings.Join(found, "\n"))
+	}
+	if result.Nodes != 1 || result.Bytes != 6*1024 {
+		t.Errorf("Unexpected result %d nodes, %d bytes", result.Nodes, result.Bytes)
+	}
+
+	count, _ := countReports()
+	if count != len(reports) {
+		t.Errorf("A dry-run removed reports, %d remain", count)
+	}
+	runs, _ := getPruneHistory(10)
+	if len(runs) != 0 {
+		t.Errorf("A dry-run was recorded")
+	}
+
+	buf := new(bytes.Buffer)
+	reportRetention(buf, result, true)
+	if !strings.Contains(buf.String(), "Would remove ID:9 c.example.com [production]") ||
+		!strings.Contains(buf.String(), "Would remove 6 reports, including those of 1 orphaned nodes, the bodies of 0 more, and 0 daily rollups, reclaiming 0.0 MB") {
+		t.Errorf("Unexpected report:\n%s", buf.String())
+	}
+
+	//
+	// The orphaned node's acknowledgement and annotation go with it,
+	// but those of other nodes are kept.
+	//
+	for _, fqdn := range []string{"a.example.com", "c.example.com"} {
+		db.Exec("INSERT INTO acknowledgements(kind, fqdn, note, starts_at, ends_at) VALUES('acknowledgement', ?, 'Broken', 0, ?)", fqdn, now.Unix()+3600)
+		db.Exec("INSERT INTO node_annotations(fqdn, owner) VALUES(?, 'web')", fqdn)
+	}
+
+	//
+	// Now remove them, via the prune sub-command.
+	//
+	file, cleanup := writeConfig(t, `
+environments:
+  production:
+    failed: 90
+    changed: 30
+    unchanged: 3
+    keep: 2
+    orphaned: 14
+  "*":
+    unchanged: 1
+`)
+	defer cleanup()
+
+	bak := out
+	out = new(bytes.Buffer)
+	err = runPrune(pruneCmd{rules: file, prefix: path})
+	out = bak
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	count, _ = countReports()
+	if count != len(reports)-len(expected) {
+		t.Errorf("Unexpected count of remaining reports %d", count)
+	}
+	for i := range reports {
+		_, err = os.Stat(filepath.Join(path, fmt.Sprintf("%d.yaml", i)))
+		removed := os.IsNotExist(err)
+		if removed != (i == 2 || i == 3 || i == 4 || i == 8 || i == 9 || i == 10) {
+			t.Errorf("Unexpected state of report %d, removed: %v", i, removed)
+		}
+	}
+
+	//
+	// The nodes whose reports were all removed are gone, and the
+	// others still refer to their latest run.
+	//
+	nodes, err := getNodes(NodeFilter{})
+	if err != nil || len(nodes) != 4 {
+		t.Errorf("Unexpected nodes %v %v", nodes, err)
+	}
+
+	var acks, annotations int
+	db.QueryRow("SELECT COUNT(*) FROM acknowledgements WHERE fqdn = 'c.example.com'").Scan(&acks)
+	db.QueryRow("SELECT COUNT(*) FROM node_annotations WHERE fqdn = 'c.example.com'").Scan(&annotations)
+	if acks != 0 || annotations != 0 {
+		t.Errorf("Unexpected acknowledgements %d, or annotations %d, of an orphaned node", acks, annotations)
+	}
+	db.QueryRow("SELECT COUNT(*) FROM acknowledgements WHERE fqdn = 'a.example.com'").Scan(&acks)
+	db.QueryRow("SELECT COUNT(*) FROM node_annotations WHERE fqdn = 'a.example.com'").Scan(&annotations)
+	if acks != 1 || annotations != 1 {
+		t.Errorf("Unexpected acknowledgements %d, or annotations %d", acks, annotations)
+	}
+
+	var dangling int
+	err = db.QueryRow("SELECT COUNT(*) FROM nodes WHERE last_report_id NOT IN ( SELECT id FROM reports )").Scan(&dangling)
+	if err != nil || dangling != 0 {
+		t.Errorf("Unexpected nodes without their latest report %d %v", dangling, err)
+	}
+
+	runs, err = getPruneHistory(10)
+	if err != nil || len(runs) != 1 {
+		t.Fatalf("Unexpected history %v %v", runs, err)
+	}
+	if runs[0].Reports != 6 || runs[0].Nodes != 1 || runs[0].Bytes != 6*1024 {
+		t.Errorf("Unexpected history %v", runs[0])
+	}
+
+	metrics := getMetrics(NodeFilter{}, "")
+	if metrics["prune.reports"] != "6" || metrics["prune.bytes"] != "6144" {
+		t.Errorf("Unexpected metrics %v", metrics)
+	}
+
+	//
+	// Applying the policy again removes nothing more.
+	//
+	result, err = applyRetention(policy, path, false)
+	if err != nil || len(result.Reports) != 0 {
+		t.Errorf("Unexpected result %v %v", result, err)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Test that nodes whose latest run was removed refer to the latest which
+// remains.
+//
+func TestRefreshNodes(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	now := time.Now().Unix()
+	for i, state := range []string{"failed", "unchanged"} {
+		_, err := db.Exec("INSERT INTO reports(fqdn,environment,state,yaml_file,executed_at,runtime) VALUES(?,?,?,?,?,?)",
+			"g.example.com", "production", state, fmt.Sprintf("%d.yaml", i), now-int64(60*(2-i)), i+1)
+		if err != nil {
+			t.Fatalf("Failed to insert report: %s", err.Error())
+		}
+	}
+	rebuildNodes()
+
+	_, err := db.Exec("DELETE FROM reports WHERE state = 'unchanged'")
+	if err != nil {
+		t.Fatalf("Failed to remove report: %s", err.Error())
+	}
+	err = refreshNodes()
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	nodes, err := getNodes(NodeFilter{})
+	if err != nil || len(nodes) != 1 || nodes[0].State != "failed" || nodes[0].Runtime != "1" {
+		t.Errorf("Unexpected nodes %v %v", nodes, err)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
+
+//
+// Test that retention is tiered, removing the bodies of reports before
+// their summaries, and their summaries before their daily rollups.
+//
+func TestRetentionTiers(t *testing.T) {
+
+	// Create a fake database
+	FakeDB()
+
+	type fakeReport struct {
+		state string
+		age   int
+	}
+
+	reports := []fakeReport{
+		{"unchanged", 1},
+		{"unchanged", 5},
+		{"failed", 20},
+		{"unchanged", 20},
+		{"failed", 40},
+	}
+
+	now := time.Now()
+	for i, r := range reports {
+		file := fmt.Sprintf("%d.yaml", i)
+		err := ioutil.WriteFile(filepath.Join(path, file), []byte("x"), 0644)
+		if err != nil {
+			t.Fatalf("Failed to write report: %s", err.Error())
+		}
+
+		at := now.Add(-time.Duration(r.age)*24*time.Hour - time.Minute).Unix()
+		_, err = db.Exec("INSERT INTO reports(fqdn,environment,state,yaml_file,executed_at,runtime) VALUES(?,?,?,?,?,?)",
+			"f.example.com", "staging", r.state, file, at, 10)
+		if err != nil {
+			t.Fatalf("Failed to insert report: %s", err.Error())
+		}
+	}
+	rebuildNodes()
+
+	//
+	// A rollup which has expired.
+	//
+	_, err := db.Exec("INSERT INTO daily_rollups(fqdn,environment,day,failed,changed,unchanged,runs,runtime) VALUES(?,?,?,1,0,0,1,10)",
+		"f.example.com", "staging", now.AddDate(0, 0, -400).Format("2006-01-02"))
+	if err != nil {
+		t.Fatalf("Failed to insert rollup: %s", err.Error())
+	}
+
+	policy := &retentionPolicy{
+		Environments: map[string]retentionRule{
+			"staging": {Bodies: 3, Failed: 30, Unchanged: 10, Rollups: 365},
+		},
+	}
+
+	result, err := applyRetention(policy, path, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	if len(result.Reports) != 2 || len(result.Expired) != 2 || result.Rollups != 1 {
+		t.Errorf("Unexpected result %d reports, %d expired, %d rollups", len(result.Reports), len(result.Expired), result.Rollups)
+	}
+
+	//
+	// The bodies of the reports from 5 and 20 days ago are gone, but
+	// their summaries remain.
+	//
+	for _, c := range result.Expired {
+		_, err = getYAML(path, fmt.Sprintf("%d", c.ID))
+		if err != ErrReportExpired {
+			t.Errorf("Expected the body of %s to have expired, got %v", c.Path, err)
+		}
+		_, err = os.Stat(filepath.Join(path, c.Path))
+		if !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be removed", c.Path)
+		}
+		summary, err := getReportSummary(fmt.Sprintf("%d", c.ID))
+		if err != nil || summary.YamlFile != "pruned" || summary.Fqdn != "f.example.com" {
+			t.Errorf("Unexpected summary %v %v", summary, err)
+		}
+	}
+	_, err = getYAML(path, "1")
+	if err != nil {
+		t.Errorf("Unexpected error reading the most recent report %s", err.Error())
+	}
+
+	//
+	// The runs are all still counted in our history.
+	//
+	count := func() (int, int) {
+		history, err := getHistory("staging", "day", now.AddDate(0, 0, -50).Unix(), now.Add(time.Hour).Unix())
+		if err != nil {
+			t.Fatalf("Unexpected error %s", err.Error())
+		}
+		var failed, unchanged int
+		for _, h := range history {
+			failed += h.Failed
+			unchanged += h.Unchanged
+		}
+		return failed, unchanged
+	}
+
+	failed, unchanged := count()
+	if failed != 2 || unchanged != 3 {
+		t.Errorf("Unexpected history, %d failed, %d unchanged", failed, unchanged)
+	}
+
+	//
+	// Including when they're removed by age alone.
+	//
+	err = pruneReports("", path, 0, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+	remain, _ := countReports()
+	if remain != 0 {
+		t.Errorf("Expected every report to be removed, %d remain", remain)
+	}
+	failed, unchanged = count()
+	if failed != 2 || unchanged != 3 {
+		t.Errorf("Unexpected history, %d failed, %d unchanged", failed, unchanged)
+	}
+
+	//
+	// Cleanup here because otherwise later tests will
+	// see an active/valid DB-handle.
+	//
+	db.Close()
+	db = nil
+	os.RemoveAll(path)
+}
@@ -0,0 +1,101 @@
+//
+// This file contains the scheduler for our background jobs.
+//
+// We wrap the cron-scheduler so that when we're shutting down we can
+// stop launching jobs, and wait for any which are running to complete.
+//
+
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/robfig/cron"
+)
+
+//
+// scheduler runs jobs, such as pruning, at the times requested.
+//
+type scheduler struct {
+	cron *cron.Cron
+
+	// lock protects `stopped`, and ensures no job starts after it
+	// has been set.
+	lock    sync.Mutex
+	stopped bool
+
+	// running counts the jobs which are currently executing.
+	running sync.WaitGroup
+}
+
+//
+// newScheduler creates a scheduler, which will not run anything until
+// it is started.
+//
+func newScheduler() *scheduler {
+	return &scheduler{cron: cron.New()}
+}
+
+//
+// Add schedules the given job, with a spec such as `@weekly`.
+//
+func (s *scheduler) Add(spec string, name string, job func()) error {
+	err := s.cron.AddFunc(spec, func() { s.run(name, job) })
+	if err != nil {
+		return fmt.Errorf("invalid schedule '%s' for %s: %s", spec, name, err.Error())
+	}
+	return nil
+}
+
+//
+// run executes a single job, unless we've been stopped.
+//
+func (s *scheduler) run(name string, job func()) {
+	s.lock.Lock()
+	if s.stopped {
+		s.lock.Unlock()
+		return
+	}
+	s.running.Add(1)
+	s.lock.Unlock()
+
+	defer s.running.Done()
+
+	fmt.Printf("Running background job: %s\n", name)
+	job()
+}
+
+//
+// Start launches the scheduler.
+//
+func (s *scheduler) Start() {
+	s.cron.Start()
+}
+
+//
+// Halt prevents any further jobs from starting.
+//
+func (s *scheduler) Halt() {
+	s.cron.Stop()
+
+	s.lock.Lock()
+	s.stopped = true
+	s.lock.Unlock()
+}
+
+//
+// Wait waits for the jobs which are running to complete.
+//
+func (s *scheduler) Wait() {
+	s.running.Wait()
+}
+
+//
+// Stop prevents any further jobs from starting, and waits for those
+// which are running to complete.
+//
+func (s *scheduler) Stop() {
+	s.Halt()
+	s.Wait()
+}
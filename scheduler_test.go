@@ -0,0 +1,55 @@
+//
+//  Testing of our scheduler for background jobs.
+//
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+//
+// Stopping the scheduler waits for running jobs, and prevents more.
+//
+func TestSchedulerStop(t *testing.T) {
+
+	s := newScheduler()
+
+	err := s.Add("@weekly", "prune", func() {})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	err = s.Add("every tuesday", "prune", func() {})
+	if err == nil {
+		t.Errorf("Expected an error for a bogus schedule")
+	}
+
+	s.Start()
+
+	//
+	// Launch a slow job.
+	//
+	started := make(chan bool)
+	finished := false
+	go s.run("slow", func() {
+		started <- true
+		time.Sleep(100 * time.Millisecond)
+		finished = true
+	})
+	<-started
+
+	s.Stop()
+	if !finished {
+		t.Errorf("Stop returned before the job completed")
+	}
+
+	//
+	// Jobs don't run once we've stopped.
+	//
+	ran := false
+	s.run("late", func() { ran = true })
+	if ran {
+		t.Errorf("A job ran after we'd stopped")
+	}
+}
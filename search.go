@@ -0,0 +1,286 @@
+//
+// Searching for nodes.
+//
+// Searches are written in a small query language, as a list of terms
+// which must all match, for example:
+//
+//    state:failed env:production fqdn:web-* runtime>300 since:2d
+//
+// Values which contain spaces may be quoted, as in `resource:"File[/etc/a b]"`,
+// and a term may be negated by prefixing it with `-`.  Words which aren't
+// qualified by a field match any part of the name of a node, as our search
+// always has.
+//
+// Each term becomes a condition upon the nodes-table, with its value passed
+// to SQLite as a parameter.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+//
+// searchCondition is a single condition upon the nodes-table, as built
+// from one term of a search.
+//
+type searchCondition struct {
+	sql  string
+	args []interface{}
+}
+
+//
+// nodeSearch holds a parsed search.
+//
+type nodeSearch struct {
+	conditions []searchCondition
+}
+
+//
+// A term which names a field, such as `state:failed` or `runtime>=30`.
+//
+var searchFieldRegexp = regexp.MustCompile("^([a-z]+)(>=|<=|:|>|<|=)(.*)$")
+
+//
+// A relative time, such as `2d`.
+//
+var searchAgoRegexp = regexp.MustCompile("^([0-9]+)([smhdw])$")
+
+//
+// The units of relative times.
+//
+var searchAgoUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+//
+// The comparisons which may be made against the runtime of a node.
+//
+// These are the only operators which are written into our statement.
+//
+var searchComparisons = map[string]string{
+	":":  "IFNULL(runtime,0) = ?",
+	"=":  "IFNULL(runtime,0) = ?",
+	">":  "IFNULL(runtime,0) > ?",
+	"<":  "IFNULL(runtime,0) < ?",
+	">=": "IFNULL(runtime,0) >= ?",
+	"<=": "IFNULL(runtime,0) <= ?",
+}
+
+//
+// A resource, such as `Service[nginx]`, or a type alone.
+//
+var searchResourceRegexp = regexp.MustCompile(`^([^\[\]]+)(\[(.+)\])?$`)
+
+//
+// splitSearch splits a search into its terms, which are separated by
+// spaces unless they're quoted.
+//
+// The quotes are kept, so that a quoted word isn't mistaken for a field.
+//
+func splitSearch(query string) ([]string, error) {
+	var terms []string
+	var term strings.Builder
+	quoted := false
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			term.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if term.Len() > 0 {
+				terms = append(terms, term.String())
+				term.Reset()
+			}
+		default:
+			term.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, errors.New("unterminated quote in search")
+	}
+	if term.Len() > 0 {
+		terms = append(terms, term.String())
+	}
+	return terms, nil
+}
+
+//
+// parseSearchTime parses the value of a `since` or `before` term, which
+// may be relative to the given time, such as `2d`, or any time accepted
+// by our `from` and `to` parameters.
+//
+func parseSearchTime(value string, now time.Time) (time.Time, error) {
+	m := searchAgoRegexp.FindStringSubmatch(value)
+	if m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(-time.Duration(n) * searchAgoUnits[m[2]]), nil
+	}
+	return parseRangeTime(value)
+}
+
+//
+// filterCondition returns the conditions that the given filter would add
+// to a query, as a single condition.
+//
+func filterCondition(filter NodeFilter) (searchCondition, error) {
+	err := filter.Validate()
+	if err != nil {
+		return searchCondition{}, err
+	}
+
+	q := filter.Apply(newQuery(""), "")
+	return searchCondition{sql: strings.Join(q.where, " AND "), args: q.args}, nil
+}
+
+//
+// parseSearchTerm parses a single term of a search, relative to the
+// given time.
+//
+func parseSearchTerm(term string, now time.Time) (searchCondition, error) {
+
+	m := searchFieldRegexp.FindStringSubmatch(term)
+	if m == nil {
+		word := strings.Replace(term, "\"", "", -1)
+		if word == "" {
+			return searchCondition{}, errors.New("empty search term")
+		}
+		return searchCondition{"instr(fqdn, ?) > 0", []interface{}{word}}, nil
+	}
+
+	field, op := m[1], m[2]
+	value := strings.Replace(m[3], "\"", "", -1)
+
+	if op != ":" && field != "runtime" {
+		return searchCondition{}, fmt.Errorf("the field '%s' must be given as %s:value", field, field)
+	}
+	if value == "" {
+		return searchCondition{}, fmt.Errorf("missing value for the field '%s'", field)
+	}
+
+	switch field {
+	case "state":
+		return stateCondition(value, now.Unix())
+
+	case "env", "environment":
+		return filterCondition(NodeFilter{Environment: value})
+
+	case "fqdn", "node":
+		return filterCondition(NodeFilter{Fqdn: value})
+
+	case "fact":
+		facts, err := parseFactFilter([]string{value})
+		if err != nil {
+			return searchCondition{}, err
+		}
+		return filterCondition(NodeFilter{Facts: facts})
+
+	case "group":
+		return filterCondition(NodeFilter{Group: value})
+
+	case "owner":
+		return filterCondition(NodeFilter{Owner: value})
+
+	case "runtime":
+		secs, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return searchCondition{}, fmt.Errorf("invalid runtime '%s'", value)
+		}
+		return searchCondition{searchComparisons[op], []interface{}{secs}}, nil
+
+	case "since", "before":
+		t, err := parseSearchTime(value, now)
+		if err != nil {
+			return searchCondition{}, err
+		}
+		if field == "since" {
+			return searchCondition{"last_seen >= ?", []interface{}{t.Unix()}}, nil
+		}
+		return searchCondition{"last_seen < ?", []interface{}{t.Unix()}}, nil
+
+	case "resource":
+		r := searchResourceRegexp.FindStringSubmatch(value)
+		if r == nil {
+			return searchCondition{}, fmt.Errorf("invalid resource '%s', expected Type[title]", value)
+		}
+		title := r[3]
+		if title == "" {
+			title = "*"
+		}
+
+		//
+		// The resources which were applied by the latest run of
+		// a node, or which were amongst the slowest.
+		//
+		return searchCondition{`last_report_id IN (
+                                          SELECT report_id FROM report_events WHERE resource_type GLOB ? AND resource_title GLOB ?
+                                          UNION
+                                          SELECT report_id FROM report_resources WHERE type GLOB ? AND resource GLOB ? )`,
+			[]interface{}{r[1], title, r[1], title}}, nil
+	}
+
+	return searchCondition{}, fmt.Errorf("unknown search field '%s'", field)
+}
+
+//
+// parseSearch parses the given search, relative to the given time.
+//
+func parseSearch(query string, now time.Time) (*nodeSearch, error) {
+
+	terms, err := splitSearch(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, errors.New("missing search term")
+	}
+
+	var s nodeSearch
+	for _, term := range terms {
+		negated := false
+		if strings.HasPrefix(term, "-") && len(term) > 1 {
+			negated = true
+			term = term[1:]
+		}
+
+		cond, err := parseSearchTerm(term, now)
+		if err != nil {
+			return nil, err
+		}
+
+		//
+		// A condition upon a column which is NULL is neither true
+		// nor false, so it's treated as false before it's negated.
+		//
+		if negated {
+			cond.sql = "NOT IFNULL(( " + cond.sql + " ), 0)"
+		}
+		s.conditions = append(s.conditions, cond)
+	}
+	return &s, nil
+}
+
+//
+// Apply adds the conditions of the search to the given query.
+//
+func (s *nodeSearch) Apply(q *sqlQuery) *sqlQuery {
+	for _, cond := range s.conditions {
+		q.Where(cond.sql, cond.args...)
+	}
+	return q
+}
@@ -0,0 +1,173 @@
+//
+//  Testing of our search for nodes.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+//
+// Test parsing searches.
+//
+func TestParseSearch(t *testing.T) {
+
+	now := time.Unix(1000000, 0)
+
+	type TestCase struct {
+		Query string
+		SQL   string
+		Args  []interface{}
+		Error string
+	}
+
+	tests := []TestCase{
+		{"web", "instr(fqdn, ?) > 0", []interface{}{"web"}, ""},
+		{"env:production", "( environment = ? )", []interface{}{"production"}, ""},
+		{"fqdn:web-*", "( fqdn GLOB ? )", []interface{}{"web-*"}, ""},
+		{"runtime>300", "IFNULL(runtime,0) > ?", []interface{}{300.0}, ""},
+		{"runtime<=2.5", "IFNULL(runtime,0) <= ?", []interface{}{2.5}, ""},
+		{"since:2d", "last_seen >= ?", []interface{}{int64(1000000 - 2*86400)}, ""},
+		{"-before:1h", "NOT IFNULL(( last_seen < ? ), 0)", []interface{}{int64(1000000 - 3600)}, ""},
+		{`resource:"File[/etc/a b]"`, "", []interface{}{"File", "/etc/a b", "File", "/etc/a b"}, ""},
+		{"resource:Service", "", []interface{}{"Service", "*", "Service", "*"}, ""},
+		{"fact:os.family=RedHat", "", []interface{}{"os.family", "RedHat"}, ""},
+		{`"state:failed"`, "instr(fqdn, ?) > 0", []interface{}{"state:failed"}, ""},
+
+		{"", "", nil, "missing search term"},
+		{"colour:red", "", nil, "unknown search field"},
+		{"state:broken", "", nil, "invalid state"},
+		{"state>failed", "", nil, "must be given as state:value"},
+		{"env:", "", nil, "missing value"},
+		{"env:prod'", "", nil, "invalid environment"},
+		{"runtime>slow", "", nil, "invalid runtime"},
+		{"since:yesterday", "", nil, "invalid time"},
+		{"resource:[nginx]", "", nil, "invalid resource"},
+		{"fact:role", "", nil, "invalid fact"},
+		{"group:missing", "", nil, "unknown group"},
+		{`resource:"Service[nginx]`, "", nil, "unterminated quote"},
+	}
+
+	for _, test := range tests {
+		s, err := parseSearch(test.Query, now)
+		if test.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Error) {
+				t.Errorf("Expected an error containing %q for %s, got %v", test.Error, test.Query, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %s", test.Query, err.Error())
+			continue
+		}
+		if len(s.conditions) != 1 {
+			t.Errorf("Unexpected conditions for %s: %v", test.Query, s.conditions)
+			continue
+		}
+
+		cond := s.conditions[0]
+		if test.SQL != "" && cond.sql != test.SQL {
+			t.Errorf("Unexpected SQL for %s: %s", test.Query, cond.sql)
+		}
+		if len(cond.args) != len(test.Args) {
+			t.Errorf("Unexpected values for %s: %v", test.Query, cond.args)
+			continue
+		}
+		for i, arg := range test.Args {
+			if cond.args[i] != arg {
+				t.Errorf("Unexpected values for %s: %v", test.Query, cond.args)
+			}
+		}
+	}
+
+	//
+	// Terms are separated by spaces, unless they're quoted.
+	//
+	s, err := parseSearch(`  state:failed   env:production resource:"File[/etc/a b]" `, now)
+	if err != nil || len(s.conditions) != 3 {
+		t.Errorf("Unexpected result of parsing three terms: %v %v", s, err)
+	}
+}
+
+//
+// Test searching for nodes, via the HTTP end-point.
+//
+func TestSearchNodes(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeNodes()
+	addDB(PuppetReport{Fqdn: "web1.example.com", Environment: "production", State: "failed", Runtime: 400,
+		ResourcesFailed: []Resource{{Name: "nginx", Type: "Service"}}}, "")
+
+	type TestCase struct {
+		Query    string
+		Accept   string
+		Status   int
+		Response string
+	}
+
+	tests := []TestCase{
+		{"state:failed", "application/json", http.StatusOK, `["bar.example.com","web1.example.com"]`},
+		{"state:failed -env:production", "application/json", http.StatusOK, `["bar.example.com"]`},
+		{"runtime>300", "application/json", http.StatusOK, `["web1.example.com"]`},
+		{"runtime<3 since:1d", "application/json", http.StatusOK, `["bar.example.com"]`},
+		{"before:1d", "application/json", http.StatusOK, `[]`},
+		{`resource:"Service[nginx]"`, "application/json", http.StatusOK, `["web1.example.com"]`},
+		{"resource:Service[apache*]", "application/json", http.StatusOK, `[]`},
+		{"fqdn:*.example.com foo", "application/json", http.StatusOK, `["foo.example.com"]`},
+		{"state:changed", "application/xml", http.StatusOK, "<Fqdn>foo.example.com</Fqdn>"},
+		{"state:failed env:production", "text/html", http.StatusOK, "/node/web1.example.com"},
+		{"state:failed env:production", "text/html", http.StatusOK, "/search?q=state%3afailed%20env%3aproduction"},
+		{"env:production'", "application/json", http.StatusBadRequest, "invalid environment"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("GET", "/search?q="+url.QueryEscape(test.Query), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Accept", test.Accept)
+
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(SearchHandler).ServeHTTP(rr, req)
+
+		if rr.Code != test.Status {
+			t.Errorf("Unexpected status-code for %s: %v", test.Query, rr.Code)
+		}
+
+		body := rr.Body.String()
+		if test.Accept == "application/json" && test.Status == http.StatusOK {
+			body = fqdnsOf(t, body)
+		}
+		if !strings.Contains(body, test.Response) {
+			t.Errorf("Unexpected body for %s: '%s'", test.Query, body)
+		}
+	}
+}
+
+//
+// fqdnsOf returns the names of the nodes in the given JSON, as JSON.
+//
+func fqdnsOf(t *testing.T, body string) string {
+	var nodes []PuppetRuns
+	err := json.Unmarshal([]byte(body), &nodes)
+	if err != nil {
+		t.Fatalf("Invalid response: %s", body)
+	}
+
+	fqdns := []string{}
+	for _, node := range nodes {
+		fqdns = append(fqdns, node.Fqdn)
+	}
+	js, _ := json.Marshal(fqdns)
+	return string(js)
+}
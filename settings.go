@@ -0,0 +1,370 @@
+//
+// The settings of our server which may be changed while it is running.
+//
+// When we receive SIGHUP we re-read our configuration, and if it is valid
+// we replace the limits, thresholds, redaction rules, and schedules we're
+// using without closing our listener.  If it is invalid we log the error
+// and continue with the settings we already have.
+//
+// The settings are replaced as a whole, so a request sees either the old
+// values or the new ones, and never a mixture of the two.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//
+// runtimeSettings holds the settings which may be reloaded.
+//
+type runtimeSettings struct {
+
+	// MaxReportSize is the largest report, in bytes, which we'll accept.
+	MaxReportSize int64
+
+	// StreamThreshold is the size, in bytes, above which a report is
+	// spooled to disk and parsed by the streaming parser.
+	StreamThreshold int64
+
+	// MinFreeSpace is the space, in bytes, which must be available
+	// beneath our report-prefix for us to be considered ready.
+	MinFreeSpace int64
+
+	// OrphanedThreshold is the number of seconds after which a node
+	// which hasn't reported is considered to be orphaned.
+	OrphanedThreshold int64
+
+	// Redactor masks secrets within reports, when this is nil reports
+	// are stored, and displayed, as submitted.
+	Redactor *reportRedactor
+
+	// Groups holds the node groups we've configured, if any.
+	Groups *nodeGroups
+
+	// Sources links the manifests named by reports to a repository
+	// browser, when this is nil they're shown as plain text.
+	Sources *sourceLinks
+}
+
+//
+// The settings we use until others are stored.
+//
+// The orphaned threshold of 3.5 days should be long enough to cover any
+// hosts that were powered-off over a weekend.
+//
+var defaultSettings = runtimeSettings{
+	MaxReportSize:     128 * 1024 * 1024,
+	StreamThreshold:   16 * 1024 * 1024,
+	MinFreeSpace:      100 * 1024 * 1024,
+	OrphanedThreshold: int64(3.5 * (24 * 60 * 60)),
+}
+
+//
+// The settings in use, which always hold a *runtimeSettings.
+//
+var liveSettings atomic.Value
+
+//
+// currentSettings returns the settings in use.
+//
+// The result must not be modified, callers should take a copy and
+// store that instead.
+//
+func currentSettings() *runtimeSettings {
+	s, ok := liveSettings.Load().(*runtimeSettings)
+	if !ok {
+		return &defaultSettings
+	}
+	return s
+}
+
+//
+// storeSettings replaces the settings in use.
+//
+func storeSettings(s *runtimeSettings) {
+	liveSettings.Store(s)
+}
+
+//
+// The flags of the serve sub-command which may be changed by reloading
+// our configuration.  Changes to the others require a restart.
+//
+var reloadableFlags = map[string]bool{
+	"auto-prune":       true,
+	"group-rules":      true,
+	"max-report-size":  true,
+	"min-free-space":   true,
+	"orphaned-after":   true,
+	"prune-schedule":   true,
+	"redact-rules":     true,
+	"retention-rules":  true,
+	"source-rules":     true,
+	"stream-threshold": true,
+}
+
+//
+// newSettings creates the runtime settings described by our flags,
+// loading our redaction rules, node groups, and source links, if any.
+//
+func newSettings(p serveCmd) (*runtimeSettings, error) {
+
+	if p.maxReportSize <= 0 {
+		return nil, errors.New("max-report-size must be positive")
+	}
+	if p.streamThreshold <= 0 {
+		return nil, errors.New("stream-threshold must be positive")
+	}
+	if p.minFreeSpace < 0 {
+		return nil, errors.New("min-free-space must not be negative")
+	}
+	if p.orphanedAfter <= 0 {
+		return nil, errors.New("orphaned-after must be positive")
+	}
+
+	s := &runtimeSettings{
+		MaxReportSize:     p.maxReportSize * 1024 * 1024,
+		StreamThreshold:   p.streamThreshold * 1024 * 1024,
+		MinFreeSpace:      p.minFreeSpace * 1024 * 1024,
+		OrphanedThreshold: int64(p.orphanedAfter / time.Second),
+	}
+
+	if p.redactRules != "" {
+		var err error
+		s.Redactor, err = loadRedactor(p.redactRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load redaction rules: %s", err.Error())
+		}
+	}
+
+	if p.groupRules != "" {
+		var err error
+		s.Groups, err = loadNodeGroups(p.groupRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load node groups: %s", err.Error())
+		}
+	}
+
+	if p.sourceRules != "" {
+		var err error
+		s.Sources, err = loadSourceLinks(p.sourceRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load source links: %s", err.Error())
+		}
+	}
+	return s, nil
+}
+
+//
+// newJobs creates a scheduler containing the background jobs described
+// by our flags.  It must be started by the caller.
+//
+// If we have retention rules they're applied upon our prune-schedule,
+// otherwise reports older than a week are removed if auto-prune is set.
+//
+func newJobs(p serveCmd) (*scheduler, error) {
+
+	jobs := newScheduler()
+
+	//
+	// A retention policy replaces the default pruning.
+	//
+	if p.retentionRules != "" {
+		policy, err := loadRetention(p.retentionRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load retention rules: %s", err.Error())
+		}
+
+		prefix := p.prefix
+		err = jobs.Add(p.pruneSchedule, "retention", func() {
+			result, err := applyRetention(policy, prefix, false)
+			if err != nil {
+				fmt.Printf("Error applying retention rules: %s\n", err.Error())
+				return
+			}
+			reportRetention(out, result, false)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return jobs, nil
+	}
+
+	if p.autoPrune {
+		prefix := p.prefix
+		err := jobs.Add(p.pruneSchedule, "prune", func() {
+			fmt.Printf("Automatically pruning old reports\n")
+			pruneReports("", prefix, 7, false)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+//
+// reloader replaces our settings, and background jobs, when our
+// configuration is reloaded.
+//
+type reloader struct {
+
+	// lock protects the fields which follow.
+	lock sync.Mutex
+
+	// The flags given on the command-line, which always take
+	// precedence over our configuration.
+	given map[string]string
+
+	// The flags in use.
+	flags *flag.FlagSet
+
+	// The background jobs which are running.
+	jobs *scheduler
+
+	// The jobs we've replaced, which may still be running.
+	retired sync.WaitGroup
+}
+
+//
+// newReloader records the settings with which we were launched.
+//
+// The flags given on the command-line must be recorded before our
+// configuration is applied to them, as that marks the flags it sets
+// as given too.
+//
+func newReloader(given map[string]string, f *flag.FlagSet, jobs *scheduler) *reloader {
+	return &reloader{given: given, flags: f, jobs: jobs}
+}
+
+//
+// commandLine returns the flags which have been set, and their values.
+//
+func commandLine(f *flag.FlagSet) map[string]string {
+	given := make(map[string]string)
+	f.Visit(func(fl *flag.Flag) {
+		given[fl.Name] = fl.Value.String()
+	})
+	return given
+}
+
+//
+// Jobs returns the background jobs which are running.
+//
+func (r *reloader) Jobs() *scheduler {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.jobs
+}
+
+//
+// Reload applies the given configuration.
+//
+// If the configuration is invalid an error is returned, and nothing is
+// changed.  Otherwise the new settings, and jobs, replace the old ones,
+// and we return a description of each setting which changed.
+//
+// Changes to settings which can't be reloaded are ignored, and reported.
+//
+func (r *reloader) Reload(c *configuration) ([]string, error) {
+
+	if c == nil {
+		return nil, errors.New("no configuration loaded")
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	p := &serveCmd{}
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	p.SetFlags(fs)
+
+	for name, value := range r.given {
+		err := fs.Set(name, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := c.Apply("serve", fs)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Find what has changed, and put back anything which can't be,
+	// stopping at the first we fail to put back.
+	//
+	var changes []string
+	var failed error
+	r.flags.VisitAll(func(old *flag.Flag) {
+		if failed != nil {
+			return
+		}
+		current := old.Value.String()
+		updated := fs.Lookup(old.Name).Value.String()
+		if current == updated {
+			return
+		}
+		if !reloadableFlags[old.Name] {
+			changes = append(changes, fmt.Sprintf("ignored change to %s, from %s to %s, which requires a restart", old.Name, current, updated))
+			failed = fs.Set(old.Name, current)
+			return
+		}
+		changes = append(changes, fmt.Sprintf("changed %s from %s to %s", old.Name, current, updated))
+	})
+	if failed != nil {
+		return nil, failed
+	}
+	sort.Strings(changes)
+
+	settings, err := newSettings(*p)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := newJobs(*p)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Everything is valid, so swap it in.
+	//
+	storeSettings(settings)
+
+	old := r.jobs
+	r.jobs = jobs
+	r.flags = fs
+
+	//
+	// The jobs we replace may still be running, and we wait for them
+	// in the background so that we don't delay handling the signals
+	// which follow.
+	//
+	if old != nil {
+		old.Halt()
+		r.retired.Add(1)
+		go func() {
+			defer r.retired.Done()
+			old.Wait()
+		}()
+	}
+	jobs.Start()
+	return changes, nil
+}
+
+//
+// Stop prevents any further jobs from starting, and waits for those
+// which are running to complete, including those we've replaced.
+//
+func (r *reloader) Stop() {
+	r.Jobs().Stop()
+	r.retired.Wait()
+}
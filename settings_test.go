@@ -0,0 +1,232 @@
+//
+//  Testing of the settings which may be reloaded while we run.
+//
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+//
+// Test reloading our configuration.
+//
+func TestReload(t *testing.T) {
+
+	bak := currentSettings()
+	defer storeSettings(bak)
+
+	path, cleanup := writeConfig(t, `
+serve:
+  host: 127.0.0.1
+  max-report-size: 10
+  orphaned-after: 24h
+`)
+	defer cleanup()
+
+	c, err := loadConfig(path, nil, &serveCmd{})
+	if err != nil {
+		t.Fatalf("Unexpected error loading configuration: %s", err.Error())
+	}
+
+	//
+	// Launch as `serve` would, with a port on the command-line.
+	//
+	p := &serveCmd{}
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	p.SetFlags(fs)
+	fs.Parse([]string{"-port", "5000"})
+
+	given := commandLine(fs)
+	err = c.Apply("serve", fs)
+	if err != nil {
+		t.Fatalf("Unexpected error applying configuration: %s", err.Error())
+	}
+
+	settings, err := newSettings(*p)
+	if err != nil {
+		t.Fatalf("Unexpected error creating settings: %s", err.Error())
+	}
+	storeSettings(settings)
+
+	jobs, err := newJobs(*p)
+	if err != nil {
+		t.Fatalf("Unexpected error creating jobs: %s", err.Error())
+	}
+	jobs.Start()
+
+	r := newReloader(given, fs, jobs)
+	defer func() { r.Jobs().Stop() }()
+
+	if currentSettings().MaxReportSize != 10*1024*1024 {
+		t.Errorf("Unexpected limit %d", currentSettings().MaxReportSize)
+	}
+	if currentSettings().OrphanedThreshold != 24*60*60 {
+		t.Errorf("Unexpected threshold %d", currentSettings().OrphanedThreshold)
+	}
+
+	//
+	// Change the file, and reload it.
+	//
+	err = ioutil.WriteFile(path, []byte(`
+serve:
+  host: 0.0.0.0
+  port: 6000
+  max-report-size: 20
+  auto-prune: true
+  prune-schedule: "@daily"
+`), 0644)
+	if err != nil {
+		t.Fatalf("Failed to update configuration: %s", err.Error())
+	}
+
+	c, err = c.Reload()
+	if err != nil {
+		t.Fatalf("Unexpected error reloading configuration: %s", err.Error())
+	}
+	changes, err := r.Reload(c)
+	if err != nil {
+		t.Fatalf("Unexpected error applying configuration: %s", err.Error())
+	}
+
+	expected := []string{
+		"changed auto-prune from false to true",
+		"changed max-report-size from 10 to 20",
+		"changed orphaned-after from 24h0m0s to 84h0m0s",
+		"changed prune-schedule from @weekly to @daily",
+		"ignored change to host, from 127.0.0.1 to 0.0.0.0, which requires a restart",
+	}
+	if strings.Join(changes, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected changes:\n%s", strings.Join(changes, "\n"))
+	}
+
+	if currentSettings().MaxReportSize != 20*1024*1024 {
+		t.Errorf("Unexpected limit %d", currentSettings().MaxReportSize)
+	}
+	if r.Jobs() == jobs {
+		t.Errorf("Expected our jobs to be replaced")
+	}
+	if r.flags.Lookup("host").Value.String() != "127.0.0.1" {
+		t.Errorf("The host should be unchanged")
+	}
+
+	//
+	// Reloading again changes nothing, but still reports the setting
+	// which differs from the one in use.
+	//
+	changes, err = r.Reload(c)
+	if err != nil || len(changes) != 1 || !strings.HasPrefix(changes[0], "ignored change to host") {
+		t.Errorf("Unexpected result %v %v", changes, err)
+	}
+
+	//
+	// Invalid configurations are rejected, and the current settings
+	// are kept.
+	//
+	invalid := []string{
+		"serve:\n  max-report-size: -1",
+		"serve:\n  auto-prune: true\n  prune-schedule: bogus",
+		"serve:\n  redact-rules: /does/not/exist",
+	}
+	for _, content := range invalid {
+		current := currentSettings()
+		jobs := r.Jobs()
+
+		path, cleanup := writeConfig(t, content)
+		c, err = loadConfig(path, nil, &serveCmd{})
+		if err != nil {
+			t.Fatalf("Unexpected error loading %s: %s", content, err.Error())
+		}
+
+		_, err = r.Reload(c)
+		if err == nil {
+			t.Errorf("Expected an error reloading %s", content)
+		}
+		if currentSettings() != current || r.Jobs() != jobs {
+			t.Errorf("Settings changed by invalid configuration %s", content)
+		}
+		cleanup()
+	}
+
+	_, err = r.Reload(nil)
+	if err == nil {
+		t.Errorf("Expected an error with no configuration")
+	}
+}
+
+//
+// Reloading doesn't wait for the jobs it replaced, but stopping does.
+//
+func TestReloadWaitsForJobs(t *testing.T) {
+
+	bak := currentSettings()
+	defer storeSettings(bak)
+
+	path, cleanup := writeConfig(t, "serve:\n  port: 5000\n")
+	defer cleanup()
+
+	c, err := loadConfig(path, nil, &serveCmd{})
+	if err != nil {
+		t.Fatalf("Unexpected error loading configuration: %s", err.Error())
+	}
+
+	p := &serveCmd{}
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	p.SetFlags(fs)
+
+	jobs := newScheduler()
+	jobs.Start()
+	r := newReloader(commandLine(fs), fs, jobs)
+
+	//
+	// Launch a slow job, then reload while it is running.
+	//
+	started := make(chan bool)
+	release := make(chan bool)
+	finished := false
+	go jobs.run("slow", func() {
+		started <- true
+		<-release
+		finished = true
+	})
+	<-started
+
+	_, err = r.Reload(c)
+	if err != nil {
+		t.Fatalf("Unexpected error reloading: %s", err.Error())
+	}
+	if r.Jobs() == jobs {
+		t.Fatalf("Expected our jobs to be replaced")
+	}
+
+	//
+	// No more of the old jobs will start.
+	//
+	ran := false
+	jobs.run("late", func() { ran = true })
+	if ran {
+		t.Errorf("A replaced job ran after reloading")
+	}
+
+	stopped := make(chan bool)
+	go func() {
+		r.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		t.Errorf("Stop returned before the old job completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-stopped
+	if !finished {
+		t.Errorf("Stop returned before the old job completed")
+	}
+}
@@ -0,0 +1,209 @@
+//
+// Links to the source of our manifests.
+//
+// Resources, and logged messages, name the manifest and line which defined
+// them, such as:
+//
+//    /etc/puppetlabs/code/environments/production/modules/ssh/manifests/init.pp:12
+//
+// We allow rules to be configured which turn such paths into links to a
+// repository browser, for example:
+//
+//    sources:
+//      - path: "/etc/puppetlabs/code/environments/{environment}/modules/ssh/"
+//        url: "https://gitlab.example.com/puppet/ssh/-/blob/main/{path}#L{line}"
+//      - path: "/etc/puppetlabs/code/environments/{environment}/"
+//        url: "https://github.com/example/control/blob/{commit}/{path}#L{line}"
+//        commit: code_id
+//
+// The first rule whose path matches a file is used.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// sourceRule describes the location of the manifests beneath a single
+// path.
+//
+type sourceRule struct {
+
+	//
+	// The prefix of the files within the repository, which may contain
+	// `{environment}` to match the directory of any environment.
+	//
+	Path string `yaml:"path"`
+
+	//
+	// The URL of a line, in which `{path}` is replaced by the file
+	// relative to the prefix, `{line}` by its line, `{environment}` by
+	// the environment, and `{commit}` by the commit.
+	//
+	URL string `yaml:"url"`
+
+	//
+	// The field of the report which holds the commit that was applied,
+	// either `code_id` or `configuration_version`.  Without it, or when
+	// the report lacks it, the commit is the name of the environment,
+	// as r10k names the branch of each environment.
+	//
+	Commit string `yaml:"commit"`
+}
+
+//
+// sourceRules is the structure of the file which configures our links.
+//
+type sourceRules struct {
+	Sources []sourceRule `yaml:"sources"`
+}
+
+//
+// sourceLinks holds the validated rules, along with the expressions which
+// match their paths.
+//
+type sourceLinks struct {
+	rules []sourceRule
+	paths []*regexp.Regexp
+}
+
+//
+// The commits we'll place in a URL, which are usually hashes, or tags.
+//
+var sourceCommitRegexp = regexp.MustCompile("^([A-Za-z0-9._-]+)$")
+
+//
+// The lines we'll place in a URL.
+//
+var sourceLineRegexp = regexp.MustCompile("^([0-9]+)$")
+
+//
+// loadSourceLinks reads, and validates, the rules in the given file.
+//
+func loadSourceLinks(path string) (*sourceLinks, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules sourceRules
+	err = yaml.UnmarshalStrict(content, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", path, err.Error())
+	}
+
+	links, err := newSourceLinks(rules)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return links, nil
+}
+
+//
+// newSourceLinks validates the given rules, and compiles their paths.
+//
+func newSourceLinks(rules sourceRules) (*sourceLinks, error) {
+
+	if len(rules.Sources) == 0 {
+		return nil, errors.New("no sources are configured")
+	}
+
+	links := &sourceLinks{}
+	for _, rule := range rules.Sources {
+		if !strings.HasPrefix(rule.Path, "/") {
+			return nil, fmt.Errorf("the path '%s' must be absolute", rule.Path)
+		}
+		if strings.Count(rule.Path, "{environment}") > 1 {
+			return nil, fmt.Errorf("the path '%s' contains more than one {environment}", rule.Path)
+		}
+		if !strings.HasPrefix(rule.URL, "http://") && !strings.HasPrefix(rule.URL, "https://") {
+			return nil, fmt.Errorf("the URL of '%s' must be http, or https", rule.Path)
+		}
+		if !strings.Contains(rule.URL, "{path}") {
+			return nil, fmt.Errorf("the URL of '%s' doesn't contain {path}", rule.Path)
+		}
+		if rule.Commit != "" && rule.Commit != "code_id" && rule.Commit != "configuration_version" {
+			return nil, fmt.Errorf("invalid commit '%s', expected code_id or configuration_version", rule.Commit)
+		}
+
+		//
+		// The path is a prefix, so a directory is matched whether or
+		// not it ends with a slash.
+		//
+		prefix := strings.TrimSuffix(rule.Path, "/") + "/"
+		pattern := strings.Replace(regexp.QuoteMeta(prefix), regexp.QuoteMeta("{environment}"), "([^/]+)", 1)
+		if !strings.Contains(rule.Path, "{environment}") {
+			pattern += "()"
+		}
+
+		links.rules = append(links.rules, rule)
+		links.paths = append(links.paths, regexp.MustCompile("^"+pattern+"(.+)$"))
+	}
+	return links, nil
+}
+
+//
+// Link returns the URL of the given line of a manifest, applied by the
+// given report, or an empty string if no rule matches.
+//
+func (s *sourceLinks) Link(report PuppetReport, file string, line string) string {
+	if s == nil {
+		return ""
+	}
+
+	for i, rule := range s.rules {
+		m := s.paths[i].FindStringSubmatch(file)
+		if m == nil {
+			continue
+		}
+
+		environment := m[1]
+		if environment == "" {
+			environment = report.Environment
+		}
+
+		commit := ""
+		switch rule.Commit {
+		case "code_id":
+			commit = report.CodeID
+		case "configuration_version":
+			commit = report.ConfigurationVersion
+		}
+		if !sourceCommitRegexp.MatchString(commit) {
+			commit = environment
+		}
+
+		//
+		// Each part of the path is escaped, but its slashes are kept.
+		//
+		parts := strings.Split(m[2], "/")
+		for j, part := range parts {
+			parts[j] = url.PathEscape(part)
+		}
+
+		//
+		// Lines are numbers, though a report might claim otherwise.
+		//
+		if !sourceLineRegexp.MatchString(line) {
+			line = ""
+		}
+
+		r := strings.NewReplacer(
+			"{path}", strings.Join(parts, "/"),
+			"{line}", line,
+			"{environment}", url.PathEscape(environment),
+			"{commit}", url.PathEscape(commit))
+		return r.Replace(rule.URL)
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+//
+//  Testing of our links to the source of manifests.
+//
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+//
+// Test validating the rules which describe our sources.
+//
+func TestSourceRules(t *testing.T) {
+
+	type TestCase struct {
+		Content string
+		Error   string
+	}
+
+	tests := []TestCase{
+		{"sources:\n  - path: /etc/puppetlabs/code/environments/{environment}/\n    url: \"https://git.example.com/{commit}/{path}#L{line}\"\n    commit: code_id\n", ""},
+		{"sources: []\n", "no sources are configured"},
+		{"sources:\n  - path: code/\n    url: \"https://git.example.com/{path}\"\n", "must be absolute"},
+		{"sources:\n  - path: /{environment}/{environment}/\n    url: \"https://git.example.com/{path}\"\n", "more than one {environment}"},
+		{"sources:\n  - path: /code/\n    url: \"javascript:alert(1)/{path}\"\n", "must be http, or https"},
+		{"sources:\n  - path: /code/\n    url: \"https://git.example.com/\"\n", "doesn't contain {path}"},
+		{"sources:\n  - path: /code/\n    url: \"https://git.example.com/{path}\"\n    commit: sha\n", "invalid commit"},
+		{"sources:\n  - path: /code/\n    link: \"https://git.example.com/{path}\"\n", "failed to parse"},
+	}
+
+	for _, test := range tests {
+		file, cleanup := writeConfig(t, test.Content)
+
+		_, err := loadSourceLinks(file)
+		cleanup()
+
+		if test.Error == "" {
+			if err != nil {
+				t.Errorf("Unexpected error for %s: %s", test.Content, err.Error())
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Error) {
+			t.Errorf("Expected an error containing %q for %s, got %v", test.Error, test.Content, err)
+		}
+	}
+}
+
+//
+// Test linking manifests to their sources.
+//
+func TestSourceLinks(t *testing.T) {
+
+	links, err := newSourceLinks(sourceRules{Sources: []sourceRule{
+		{Path: "/etc/puppetlabs/code/environments/{environment}/modules/ssh", URL: "https://gitlab.example.com/puppet/ssh/-/blob/main/{path}#L{line}"},
+		{Path: "/etc/puppetlabs/code/environments/{environment}/", URL: "https://github.com/example/control/blob/{commit}/{path}#L{line}", Commit: "code_id"},
+		{Path: "/opt/site/", URL: "https://gitea.example.com/site/src/branch/{environment}/{path}#L{line}"},
+	}})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	report := PuppetReport{Environment: "production", CodeID: "e996a033f36d"}
+
+	type TestCase struct {
+		Report PuppetReport
+		File   string
+		Line   string
+		URL    string
+	}
+
+	tests := []TestCase{
+		{report, "/etc/puppetlabs/code/environments/production/modules/ssh/manifests/init.pp", "12",
+			"https://gitlab.example.com/puppet/ssh/-/blob/main/manifests/init.pp#L12"},
+		{report, "/etc/puppetlabs/code/environments/staging/manifests/site.pp", "3",
+			"https://github.com/example/control/blob/e996a033f36d/manifests/site.pp#L3"},
+		{PuppetReport{Environment: "production"}, "/etc/puppetlabs/code/environments/testing/manifests/site.pp", "3",
+			"https://github.com/example/control/blob/testing/manifests/site.pp#L3"},
+		{PuppetReport{Environment: "production", CodeID: "a b"}, "/etc/puppetlabs/code/environments/testing/manifests/site.pp", "3",
+			"https://github.com/example/control/blob/testing/manifests/site.pp#L3"},
+		{report, "/opt/site/manifests/my file.pp", "x",
+			"https://gitea.example.com/site/src/branch/production/manifests/my%20file.pp#L"},
+		{report, "/opt/site", "1", ""},
+		{report, "/etc/puppet/manifests/site.pp", "1", ""},
+		{report, "", "", ""},
+	}
+
+	for _, test := range tests {
+		url := links.Link(test.Report, test.File, test.Line)
+		if url != test.URL {
+			t.Errorf("Unexpected link for %s:%s: '%s'", test.File, test.Line, url)
+		}
+	}
+
+	//
+	// Without rules there are no links.
+	//
+	var none *sourceLinks
+	if none.Link(report, "/opt/site/manifests/site.pp", "1") != "" {
+		t.Errorf("Unexpected link without rules")
+	}
+}
+
+//
+// Test the links shown by our report-view.
+//
+func TestReportSourceLinks(t *testing.T) {
+
+	FakeDB()
+	defer removeFakeDB()
+
+	addFakeReports()
+
+	//
+	// Our fake reports are relative to the default prefix.
+	//
+	prefix := ReportPrefix
+	ReportPrefix = "reports"
+	defer func() { ReportPrefix = prefix }()
+
+	links, err := newSourceLinks(sourceRules{Sources: []sourceRule{
+		{Path: "/etc/puppet/code/environments/{environment}/", URL: "https://git.example.com/puppet/src/commit/{commit}/{path}#L{line}"},
+	}})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err.Error())
+	}
+
+	bak := currentSettings()
+	settings := *bak
+	settings.Sources = links
+	storeSettings(&settings)
+	defer storeSettings(bak)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/report/{id}", ReportHandler).Methods("GET")
+
+	id, _ := validReportID()
+	req, err := http.NewRequest("GET", fmt.Sprintf("/report/%d", id), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("Unexpected status-code: %v", rr.Code)
+	}
+
+	expected := `<a href="https://git.example.com/puppet/src/commit/production/modules/heartbeat/manifests/init.pp#L4" rel="noopener noreferrer"><code>/etc/puppet/code/environments/production/modules/heartbeat/manifests/init.pp:4</code></a>`
+	if !strings.Contains(rr.Body.String(), expected) {
+		t.Errorf("Unexpected body: '%s'", rr.Body.String())
+	}
+}
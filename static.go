@@ -35,87 +35,110 @@ type EmbeddedResource struct {
 //
 var RESOURCES = map[string]EmbeddedResource{
 
+	"data/analytics.template": {
+		Filename: "data/analytics.template",
+		Contents: "H4sIAAAAAAAC/8xZ73LcthH/rqfY0E18mtyRkmzF0YlkJlFdp9O08VhuZzoaTQYkl0fYOIABQEo3N3ygvkafrAOAvCN5PCvJpJ36gw/cPz8sdhfYBRR+9scfb97/8+1rKPSaxSeh+QFG+CrykHvxCUBYIMnMACDUVDOMv+WEbTRNVRg4gmOuURNICyIV6sirdL742mtZjPKPUEjMI2+79f8uWSkxp4/QNEFOapoK7tNUeCCRRZ4qhNRppcHQPQj68JysMfJqig+lkNqDVHCNXEfeA810EWVY0xQX9mMOlFNNCVuolDCMzv2zX2BOqlSQCKGVlqT015T7qVKdYXrDUBWIugNSqaSlBiXTQ6QPKvjwc4Vyszj3zy/8lxbsg/LiMHBqvwxjaMyv178piNR+UvGM4RMQelNi5Gl81MEHUhNH9eITKwQPlGfiwRecCZJBBHnFU00Fh9kpbFsZgCDYj+B9gUBqlGSFag5roTRITJFryKlU2j9UqokE5FpSVBDBXUcG2G4l4SsE/weikacbaJoeE5DXSzDLf81rKgVfI9dN482hRElF5nhv7diSU8FzulrCduvf2OE7NJPWhDXNHHKSamWZfyKpfoMcJTFLbRpo5n2bkGd7O+59iTVKhbPT62l/fFdRlgEBhWaB1jjAvcFzyIUEJGkBIgddIChtXNdDeChoWsADwkqSsjjiQLdm68D76z7DzQsRbJsd3Uw5M0wKEZxdA4Wwi4DPkK90cQ30yy/BBHm3chsmiDrBO7qfB4DmMOts8CnP8PHHfIa+o5xCCGdDMNgJl5Uq9pI9yGaE/lm7lDv0kdf3Y7whM4LtPt696DbQTE8xUPed6l1nlsHDlnh9TMdOMVKxtJ1Gc9IPTCqYqKSNGHjPXrx4RZJX3hy8Z5dp8vVlaof5Gcleoh1mV5cvXuZOIEnPMvTm/eX3/3nPrpLLq+QrK/zi5curSwdxTpL0ygHjV6/w4sIOry7JJfnKg/vrgXk22XpbfkazOdijf+5S9CA5MqKJQj3KwV62Ia+B8tZto/B1AGPl6WTtkudosnaQNUS7MPH6/s5afn/X6t/R++FcYG1wOWl0owgqnmFOOWbwDfCKMVhCDacDreZkBGC80IKMbGIkQbY0npgfzru0/48YOWVsCTlhCkccVRL+hpRqCVpWY2YiZIbyRjAhl12q3e2Nc46DzztWR7gfwDQw3JDDeLv9YLZaX8cUlCU8Z5Tj8/nJwQqn/KGWXUAnnGLMXe5GA+sG0qI0WaoOZpCoSsEVrXHSTzajlyMdgIyqkpHNckIDwFTLpdMcspoxuBBM0/LQKIC1yIyb7FH5/GAGyrUpKqmejvx4HtvsTM2y+fbR0O8OGPsVwuQSW8wfXLpOqD8N0Ab3VkvKV0t4foup4Jl6fijZjEnN/clxgWby/LYJqR8hgkyklams/gr1a4Zm+N3mz9mMZqeGdGO6x0c98y4yr5/dHB/A9k2zVD92zcLpxNlti9GwWkI8rm/29Jx5DsUcszdEEyZWcCPWJWXENVGO/ZPs+pBTI9nqnF4foNlqYkRMhwL7FgVmhvPTakewOE66v4LeT78ZDIOu2w8TkW3a/pCTGlJGlIo8TuqESHA/iwxzUrGuHQYIM7qTNK05oRzlImcVzXYyQ6kWyMyKsidjDKi0FrztSt2HN1LTYrViaA4uRkqFmWcPh5YceR29IxO5MleTZ07bAyIpWeBjSXiGWeTZ7dVSjfVSsN1UA9MAQnPgdsYouRCcbbz4vTOHk5qurPPDwMh9QtVccRYW/n8lGgbOlXtaGGS0HkWHZruF7+PpnNnFfufcAXovtGXF2IJhrse+q1gvjB0cJ/VIzl7UOslEIslSWa2TBdW49uKQHLnBeXGYxG+rskS9uK3WayI3YZDEYUDiMGB0ZEtQsaF3Br6YWJCkq+JgRbmQ61FqGpIHxPZLQyObJlBIZFp4sEZdiCzy3rx+74EUJmdb1oErenZQXlZ6sZKiKg/kAELL7l3mdhE0JnWJ7UHJSIqFYBnKyLttDXL365+nYKcNWCSaT0jvt28XP80h0Xx3YrT2qSpZU+3F4S7QK7YpC5PAsBstOp+EAY0P8/do7I4Qw8A44hNRH3z2PsKAk244ddDtj8HifP9Ist3SHIb3UtvHji+r7W0yDIrzHU4Z967QoOkaQZOPyCHZuHui7WFB5EBAVnwOokZpb44lUdpM8b3p6JoGCvPrA9wy8QBpW4DSXgEqBeVaAdFO3e4fhbJGOYeHAiUSBcoom1oC+/oCQkLJqhXloDY8HeGQlam+YVB2S3LeaC/xu7tzmBJeE2WPnbbmgX1tibwCzYZbwsXZWfl4DfZpZwlXZ59fw5rIFeX2iFle7r/tDl1efm7yxeHGE9O4kvhfmaWMv+CJKq97yw41SRh2GeM+7P8L15xj1n4qLWm5+zJdEnK1+y5E3UszAysHeayL2AQ8DHQxpvdybYr9Ds2DmppkVdxm3kydTrEnu5lp0YNeZVrsrUunW5NOR0TeS8KVO1zhdU1YdQQwDPoeGj8iNc1xT2Zx78UoDHQ2ZvcK0B8Ghzvpdn7wTe9xJxrv9y/Iury22zIyCO1G9eKxnCtch/Nvt34btGn7tlstK54SjeC3IXxa8OAx7CmF8QPZU/Iutia0T8v2gryP8VhtHOH+k1wY2G2zP3uQKdxzy/hvwhyqlK8UFKRGSBA5SEzNnsyActAFVe2FdHiK7afpF4hC7p6rcyE0SnfU2OGRBviwehxKsMU6W7z8ZBs11Tsd75EkySjRQgZe/K4dwj8oPkw3SU+AmaqASgdefOtG8A6VqGSK6jfh7feP1/9Tw69EappgTczdmROeYuDFf91//Q694O8QlELrUi2DYEV1USV+KtaB+vgYuNK7UK519eI3VH9fJfBWig+Y6v8v05XGGv2PuC79nAZe/O9/wcXZ+avFxdn5FSzg1rDhL7guf5PZoz7MbSN3S3WX0zBwf7X6zwD92rK8xhoAAA==",
+		Length:   6854,
+	},
+
 	"data/css/bootstrap.min.css": {
 		Filename: "data/css/bootstrap.min.css",
-		Contents: "H4sIAAAAAAAC/+z9ba/jOLIniL+fT6HOQqEyKy2nJD8d26jz7/vvGcxt4PZ9sdMLDFCdu6Al2lalLKkl+TyUx/PZF+KTyGBQkn1OVfdi+yZulw/5YzAYDDLIEBn88uMf/ov3o/f/L4qmbipSek+z6Wy69D4em6bcfPlyoM1O5k3j4vSpRf+pKF+r9HBsvCgIQz8KwoX31+e0aWg18f6cx9MW9B9pTPOaJt45T2jl/eXPf+VE65Zq2hzPu5bel+Z5V39RVXzZZcXuy4nUDa2+/Mef//Tf/vN//Le2yi9ffvyDlxfViWTpr3Qa13XLaDCdef+LURaVef/L00jnNC4yUn8xy/345dicssu+yBt/T05p9rqpSV77Na3S/dZ/prtvaeM39KXx6/RX6pPkl3PdbMIg+H7rn2o857orktfLiVSHNN8EV1I1aZzRCanThE4S2pA0qyf79BCTskmLvP15ruhkXxStzI6UJO1/DlVxLicnkuaTE83Pk5w8TWoasxL1+XQi1eslSesyI6+bXVbE367knKTFJCb5E6knZVUcKlrXk6c0oYVCpnmW5tRnBbZPtGWNZD7J0kO+2ZGatrmc0CYvmo8/x0XeVEVWf/2kSORFTrdH2nb5Jrj+fEyThOZfJw09lRlpqIG7ksuOxN/atuSJHxdZUW2aiuR1SSqaN1eyIXGTPtEJ2RyLJ1pdinPTstCKbberfm7SJqNfL7uiSmjl74qmKU6bsHzxkqJpaHLdTeqmKvID78FnztQqCK7JPudpdfOa0U3akCyNr8dQdst0uaInL9hyTPor3UT0dD2R6tuFc/ldEATbjvfNd/t9cK1PJBPawso8BN9f6/NuUp/LS1nUads5m4pmpG2TRnu1+H7L5C7F5hR9S6kpyo0/XdBTS/siGu1PozYlPR2ENDbBtX46sF7aVEXRfLq0AtxnxfOGd8mV65VscUhP3jwoX67H6qLYkBq+K15aTtP8sGl7nOYsaeufil9deXjytaxoxwg5N8U1LhI6+bZLJmVFJzU5lcZwOxV5UZckphP1SxNcSE/X3blpinyS5uW5mRRlwwdGTTMaN5N2AJKKEjXcWOFNmh9plTZb3pfiL0GpY+8prdNdRmUNnOSFjWmmpPuiOnE1Foh2svAYIz83ryX9iSd/nWhJFa1pY6TU590pbb5epKxJWVJSkTymG15+G5+ruqg2ZZHmDa1EZT8naU12GU2+6tWqxIsolNA9OWeybZsN67J9EZ9rP81zWnFO7PRLSZKk7bxgq/SJQS+6ovLZ8qq1Jj7S+NuueDEbTZK06FqoqYYaubYyaVl4quJQrz8/n3a0+rrZyMpYa/y6THNf1xQHujg3JlqOBaaqeq9RUsVHtE1vGyFbRA9aldunNEsQDjreeYIft0UypLGuAgmNi4q0cxOmg0y/WeU1bZRWTGcLevKmy4j9Z7Wgp60cYV5UvkidaafiusjSxKvT7IlW14weaJ5gyqVGqjk7yAFtzeBNq+dy5m/nBZ1ea0oyUtZ0I39cm2TSHLuKr+0i4X8U5yqmGw9ZahwXu5IZ/4W/K9KMVsx4GUuOuoq/xHX9pbXBYrXwxxNNUuKVVZo3lx8nG7JvTfZmR/dFRTXL8Yf0VBZVQ/Jmy5cIR5IUz0zWWpZmXgJPL2MoHV7UlXMlE8ImtoYmfCrrun/DVl/cxP98rOj+K2/ARajn5oP38YNHmqb62OZ+8j58+qDbYSeaZQs4I/x//fThF/JE6rhKy2bzQZScqMzvPljEPlzZouTv56Khram4WCr23Xq93pbkQP1dRck3P83bFdWGPBVpcm3adZNaezDl8flSymf61VrOSdNOe3j51rCeyIv/nCbNkS3jNJkeo8lxNikvRVUeSV5vZtvnNCme682MZ+lUWbME0WlOnnakMpdE012TP05jUtFmMk2qojyXj1qaVPmmKH1Moa7TjOxohognCILr1Bg21ijRyTCk1yQT+etor9W+2+/3VhmfU6dJV1hLOiKcJUmiUbn+USwAYmosBX7479lreUzjIq+9fyfZPkvzQ/3Dtq7izbnKPk6nX1p0/eWgYP5RwvyKHs4Zqaa0aD7dXuT/911K9+nLJ681+aT5+AM97WiS0MQvSpq3s+sPnybjKT4X+33UEWN/3kzALH9T8abRSjfVmd7cgvrp8F0H+L8VQOR31Ounww+frlOFRdbB7Xo2LF+26B5khAJo63i+HtnqtmIeBMbaOlSzJy93Korm2JoEkjcpyVJS04Sb7aJ+gZhDRV7rmGRUa5HPrEFaf+umeTFl/S0IIvJBh5bZuUZhOwNGz5VATczUwi4cBSQ2Cp/SHKskisLIwMVZcU4Q3DIITWbyJ5oVJUWgq2BtNo/mcZqhwL0BPGSkRnikAaj7dK7TGMWZbeErGRQ4M4BHSqoGxS1Mgg2pUNjSgvn0VDavKHhlgM81xWk+GLB9mp1QmCnr5uhnpDpQDBoGAIqCQoteWqOyAYpTfENBpqAreiqecObmBvDXojj5aY4iFzayOOMsmv1S7PcoyuyQOj3kJEOBZpfExQFFgR6pSI1KOjK741icUMFEIdQDHGb2RpM6qIH+KEiCwszeSIrnPCtI4pMMlXO0QOEo1OySc+kEmr2S5rviBcU9gLmUvPpxWsUOMa2BPpaUoE2aBQC4ryjejzOzg9rh4pLTzOyk1pShMLOT9hlBFW02h5NYUh6LnKJT6MzsoqciO5+oa0TMlhi47VYUvcLQ5xLFmr319youErSjZmZH7YgTOQfTGi6seQhRqJjmZg/tCnxam88s2IlUONTsJbYJRHFmB8XkRCuCAs3OYZ4rDLYCLGboMJubHcJdnigQTGvtJlEsnhD0IrDRfJOEgc2+Yc5NP6N7nHKEgGOaN7gZXcwQeOVke46gfznXTbpHbfliYY19FLYEc1lC88bdwhWGdvMMFgokpu3s7zMXPloALM/SuDlX6NBamr14IqXfqjku6SXoGP5pAwPOgKnCFXhp9gVNUhwGlmhH4miL2QfMI4niTOm71ivLB7Dko6XfboSfSYWOs+Ua9FLd9OJXAZj/eqChZQFRmNk/JTnXaMtWM9CyAp3JV3MwDVVO/hZ20/vgS1uyfXCzv+gvNEb1ZPUA+/+pKtzTzGqNwp2j8CGwtnRsJYliQ3tr5gZHyArajZ6BRbkbafbf38+0bjfgbvwCzEr7wo0FXRhXlOb1scAlt8Ia6F7CPTzAJvZg4Soi7wGvzS4kVVU8O/VjHSJgp3asIwSNr5DWMwTqWnqt5/bk51p8rhdAzuwL9P6coXud9RJDs0+ZKByMwpc4IyfSp1Ah2NQfUlTQIdjTZ5TsURhYM6eoFQgDYFReKfPVodCFBY2zosbJmtJ6JlWe5gd301dwxs5xsmDOIhnNE9QFEQI/QEXypMAcBiHwAsTF6URRAxwCV8CJHHKKAyN0rkT1OwQeAQl2aHgI/AIVbZ6pgwu4ECjKsu2EGPfthCFcR2fM+e3qYuAlEHCX8gBXgRg+8vs9WmKNlTgWVfprkTd4GehCSBIUZfbj7pxlx6JC2QZehB3N8HpBH7bN2qcxaVDJAWdCczyfdrVDO4AnQWBdygGcCUeSJ845OAQOBQZ2zO4hcCowrIPhtY10sQt8CtwSDZiOELgXjEIu9oGfwSiDNwO4HIwSzubMgfe22KH9D1wPzxXNUa9sCNwODam/1ShuBV1i+OYvBN6GXZXSfUzw8Q0cDq1d5OsWDAx8Dgmpj7sCX6CGwPNQkpJWcZai3QDcD8wv7fQkh8ALkaX5NxQGPBDHArc2wANRnutjmeLNB068Gm+4Kf3DDm+yKfe6wGdr4FBoYf7u1SdZeSQ73CAAtwIs4lgnhcDBIIvxz5MYfubGO+uY46w1TZXuzg3qwguBs8Eu5KwNdFfONr8U7bQFXMiVJMeB0BnOvxU7ZwvgdVB4fD4CnoesOOBfA8JlCH2lqJc2XEYWQfyjQQjcEzl99p/TPCmeUTBcnsQFPgtANwVB3Qoh8FK4lhfASdFSw2sF3j32NR0FrmG3O4DAL1FTXDtWsFuKsnz1E/R7KA2Ba0Kgna1azTC489tSCF0VHXkUvcDQrp4A3oq4oknatGtOnPMV/DaU13t8WoH+inOT0Qo1A8BVwc+vYMAHa+lfVrSucSEDJwUlldNwABcFw7nmIuCgaIpnB69z+Em1QSdF4JaoE6ffMwReiWMfFIyv844dVsI5AJ5AdhCmbmjlIL1G4EmR7dC+XQcIeuGHKDZEsEsHNkKwKwcWrA3l0X3f8ckjXMNJ8ZDWDT9M5i6zsI8h9H1IDIHHgRdwfk4M1w9g5FHqx0WeOkbfem3DExqnybnAjlHQKABjK0FBoTVtuz7oRsDv0c4/bixYCNInmuGGNQIOkLYzURhYC5K6wWtegg8mFDUbEXBP0L+f2XUKTPYR8FB8Ywd8EVgIHZjoDA0PuJTkGYeBT3ppfUQd3xHwSHzLHTu3CDgkdmT36u+L6nTOCAoHux/UKxMt9+bZoV1G4m+uvUcE3BA7iqPMziFlianZ/mFvHtehFb6VioDD4VicK8fRnmgWmmecMnJChQ48Dsm5zFz+hgj4G8r0cHj1dwTdHEXA4VDHaV0XFTrEgbdhlzZxgS5KI+Bq2DXxCNTLrhmBesWUPAiI2YxfsFFtoarzDuvoKNglEDcCxU7AYS0Abo80pn5WZBk67wBvh8L6TTsDodoLnB00Ocf83DKGBZ9H2FWqfidbBNwcokyPKy8CDo8Tzc/+kZx25+qAz3jA8XEqEpK5Nx0R8H8UKc4F2H5XBFdW4PiozzkbrOhiJ5oj3/XZnRsMG9pYfkAYA0c2WDvpjpUAfbn7hcaN+GSPf7OMgCfEKCJuTGGlFu5S/aoD/CRGSYfvLgLnNowyfUoHvC1GOZdzMYKHOqqU5IeMugvAcx2ygKs1wAej8G5pA/eLKuHo0gVcnOZ1gU9D0OdyLmklrhpg6AXcAfRgl/Z4dwpkZWPd0n6wwQ7/SgT8KwyLLwGXwYfrj+987eoKLrW8M/Xuvi2/4BeU3Y2ohpT+MT0cM7Yn4RNMddiRj8GE/fvEb9bqR8Y//DvNnmg7lLz/pGf6YaL+nvxblZJsol3n1Wqdly/mofHpPHpYrML5TFwZ/G42m23R6xDmfUR4DVHnTV5C7OqVKXrV8nIiuaiaV2S32sK7O/w67YZd4VPXZUWRaLaIVvG257oPL6eu1zbHNBd3aLcybVG+eOTcFF53fj4+137Fvry19UikX+z3NW02flS+gEumAbtHAy63ntIkyeh1GpOqONc041f2HqdpQ0+P5DE9HSZ4HstJTwe/onVZ5HX6RCdT9iEpJ2nmiaIqof3TvBK9NW/0bPW7fpxw27k0kfdlKpKk53qzLF94tiKN3592U1d3F3sUDdWuLXp5ZmvyN9fHTEVyceGCZJk3jWqPkpr6ae4X52brF0OI/mwuB/4RCUhpEXx/PVai59mkHrWDWfwtzAJLkncAt93VJr2BlNLrtK78Is9eu+sjZFcX2bmhWyHh8kUKuP3ZXTAUmue3qeAG9JZ9nKlo3KgZpLuPKGvkak7a9bO4i47k8NGjeKsb0qSx4Iz1t9736ioyvGjM+WHa93NVZOr+8AVcAJ4ew8n0GE2mx9lkepxPpsfFZHpcTo7hhN9EO84nx8XkuHRPN+JyzAJejpmG4E709Bh6U3YYZNL+lL+iLjFSibMucaYS513iXCUuusSFSlx2iUuR2FWu6u6qVjV3Fat6u2pVrV2lqs6uSlnjpf/qkBiIq9XK6AQp+AFlb43ZWwV6r0Q0m7pcfH811EZqi8Z96OT+bf35pm6RsRKY7I+hljhjU3LbB5GeyjmetT2jBXKY83ZMjnN9ofHAUheT4+JiLgSuTEZLPbU1bKWyaV7gcdlklCQXZH7TSi7ln0LFZtYAnF/FJeKPpzQX5mO1fChfPl14BVpLwvLlehWismJPtHI6kerbhEWtUBe3I3rCTEu8f6Cz65QtEdoVLb8bzA10+7fIYgtYPY8liEx+NlvP5SkiW5yu1vNFkgDkxXNFysvzMW0ou9JNNzxJ8lU80yomNYUxGFSGAJ7LEgeqDMkxKdk5+F8tZJcjoKdzQ5OLPgGw5LJKWagVY3F2JUamXJSZieYK7WEZrANBsz7HMa1rRTNeLWeJpCkyTZoy0aS5W8yjWNBM832hCIar4GEvCbY5JjWWYpKaL6LlWpASZ95k3gNZJrOdpCYyTYIy0aC5XC5CxV5C8kOXRdbz+TySJHmeSVGkGQQf5rPFbH6d7g6wV9jCydJ51VddAVWJlsTrsIvLLtsdVIfZoGS/D5IHXgfsOS3JVUcc0mg3Y3WwDkQqWNNkLxph9KT820Wa7JN1u7DaHVSHOqcFoqH0Csx+RYqvaLxbsDpEByOYKKEJ5VWAnu5SXBXQ+W69W1+n7H49/xwqZzo5A6+VIdvMg/LFCzxtzamHDNJWm0U2OWe6OQwwW1hkXpFNisw7t3CPFfK6cgIaXKfsotg5Z1eUVewL7jdoZ/+6u72cU4Hm+wiIFZTZX/6CbR3chR+zFN+WSKLcGbHoVsuc8KJ8uSa9rW8FeE2SSWIGg+n2LteksSMuKdPIG9Nj5pJM87Z5La2sIA0zQ3K1vwzQ5TwlFYdBC8UTVAGaZWlZp/UWszWgepPv8KFtPI96kZCG+EWVHtKcZD6PgTHR41KJdfuRZuXWFaPK48YkzVN2/bw+aTZ8HXy/dVqwLhyGMu6tWnrawpOtTeASZDVddPove1zX/o6wV2SbjNSNHx/TLJloGaUj/awXsEaCBhSrFi1FxDfTUviSxtyxG8G1Bnw0rWCtKuXFeqtmJAM9BP/D36IgnHt/C4J/C364Tju4X9EnWtU6hWl5zjKxaDKHXWiNu8BWWrmhlgNV6yWjAwOMDdBeDGE2HEM4RKa1yylWHeOSsI7Bhf1DX9O4J7KnZW6ATqC3XX0Qo5a+VpmxbH5guuMxPfrhSpKkahcPzo2DHtnCMd/2x1D7C82zYvKXIidxMfkT85uTevLhT8W5Smnl/Sd9/tBFV2O01IwSlS/e3Jg/2jlJrk5W0WJOsd3Eeh/t57ZX6vptl4wj7VqxzQDRmebq0mIepXlNGy/w/JBZfM1JPI0Wn7ajkS3Dns60HhGQOfWAmXOFZoIBmVgcPHNmk1Ws2fwMNpd6tbNRzunnokp4yKGNCDyUZTyxtXIirf0b679F+w/xNcZxjPRqWVHP0JoA8WkbbiXD7pYVZTxt+0JBgmqD67QtVsdVkWUspNGJvEiBzOb66sB/3XDYddoOQJJq0e2ck3HY9YHAaK47DmF+OvcipqtLpC/YwsEusF5HaIH1ylEgjIIALRGGvEiX4e+zc5q8W2unVfF8MXC+XpSvS9uUloXMzw5+OFG/gu6nlhqpn92vmfo1V78W6tdS/VqpXw/q15r/OiWy6vZX0P3UUiP1s/s1U7/m6tdC/VqqXyv160H9ElXXJ1l1+yvofmqpkfrZ/ZqpX3P1a6F+LdWvlfr1oH6Jql9qWXX7K+h+aqmR+tn9mqlfc/VroX4t1a+V+vWgfq2RiE6trtrO+F71u/4DG9BtLzouokv35aZLDeXYDKdL/n8rLTcQuQ+z6Uz8X5e7VvNAl/Yg0pZLhNxKZC4eEGpLmalxtxBpc4y5ucicYbzNRGak8aYEgPEm5YCxxhY/YXQRva3Lj2eFIgsVIocEAoJKkkHWAqGLk2U8iAxUpgyxEoiFk/ulREDeFyJj7mR9LhAzJ+czgYgg50pkTs6l5JyMS7nx2Vrl1Me2Q/hYM/ujzQl5jqM7WkTAEY7eqI/+mgPMzqiP/gNPd/RFffRXHODoivroLwUAcr3g6XMn03MOmDl5nnFABHmWgnLyLOTlZFlIy+gD/km87QXDmaB3hoSEBgTtFQkNDCjaPQK6NpB6PwnAgwFAO0wgVwYS7TmBXJpIu60LAzDvaercQM56WjozkJHdUtAFPS01e6KnocFo19Y/bIFgGTlWi2XkGBtOI8f4dRo5Vg0wci0TTiPX8uo0cm2ToJFrG+w0cq1cnEauFR80cq1wnUaubarLyNUnp5FTWW4jpyBuIychlpGTGW4jJxFuIycRlpGTGW4jJxFuIycRlpGTGW4jp+TiMnISYBs5loMaOZXjNHIK4TRyEgGNnEx3GjkJcBo5CYBGTqY7jZwEOI2cBEAjJ9OdRk6Jw2HkZL5l5OrToJHTIENGToMOGbkO6jByHWDIyHXIISPXIR1GrgMMGbkOOWTkOqTDyHWAISOnybffyHVAaOR6XRn/oB24ZeVYLZaVY2w4rRzj12nlWDXAyrVMOK1cy6vTyrVNglaubbDTyrVycVq5VnzQyrXCdVq5tqkuK3dKnFZOZbmtnIK4rZyEWFZOZritnES4rZxEWFZOZritnES4rZxEWFZOZritnJKLy8pJgG3lWA5q5VSO08ophNPKSQS0cjLdaeUkwGnlJABaOZnutHIS4LRyEgCtnEx3WjklDoeVk/mWlTslg1ZOgwxZOQ06ZOU6qMPKdYAhK9chh6xch3RYuQ4wZOU65JCV65AOK9cBhqycJt9+K9cBR1g5zf/+D/JxW2aO1WKZOcaG08wxfp1mjlUDzFzLhNPMtbw6zVzbJGjm2gY7zVwrF6eZa8UHzVwrXKeZa5vqMnPZwWnmVJbbzCmI28xJiGXmZIbbzEmE28xJhGXmZIbbzEmE28xJhGXmZIbbzCm5uMycBNhmjuWgZk7lOM2cQjjNnERAMyfTnWZOApxmTgKgmZPpTjMnAU4zJwHQzMl0p5lT4nCYOZlvmbnsMGjmNMiQmdOgQ2augzrMXAcYMnMdcsjMdUiHmesAQ2auQw6ZuQ7pMHMdYMjMafLtN3Md0DJz4l2gvscYxXuU6mtyU5SbB+1bnji50iZ1B7C28Bx5c0SOlrPKtatS4OYUcvyQl3lsdkXy+thUj+qpIS3pqJL2RdEAlErqUEdKEoBSSd3TYQ/u4xfgYltTlI4rTUmSXJEq4KuPrL3g5GCEUhF981lS2+zTSh7D09rTD1OSiIuMPYo1SK4fZ0rWzHORHIYetRewNoGhCJ/Z/+r5qLS8qUPb2f1N8WJVXOQJe5MW0TE084hkWnqHZmIlLV1EMzutXKgxod7awh/agiiseUje0c6zG4fkIeXspiF5x7Hc30RNDCIxt0SdzOqmSkutwZu8OfrF3m9eS/qxSJJPF/SU23q/lhTYEfWuvPNIPDtaxadbLy6yn+OM1PWPP7XT81frBqH5Xl1cZOdTvuWLf3aKTL7RZlCZyPfabqJNs0ynDCbTKb/7iEyzKudoT8DJVF6ZtKZmmCMUBqkH5mCzuoMaUo9QCaQemIPZBQe1rh53j6NqIkptRKpS4V7UcdKjco8miwDTNYGjXaijgcIuXjy0/ywtEfdZMDVRWaieiFxMUWCW1AekLisL1RUHQawuqRNIXVYWqi8Oglpd7ktDuC4YV4bcKgNgAzpjsokojUHOrTVD95mSgK7jpaU2ab4vMJ3h6ajCtFmYthjpUh8gfTMdVRKMjkVf6gCkb6ajioHRkfTd17zwvu7uebn1QccMKIPGGqIJHSG3GvRePIvndLafWTog7pJhaqCyUE0QuZgywCzZ70hdVhaqFQ6CWF1SB5C6rCxUQxwEtbouPfd4MR0wruu5VQXABrTFZBNRGIOcW2cG7xKSfRTHltrwC4KY1sgcVGl4JqYzIEfqhV0PzEEVBqeG1CN1wq4H5qDKglPr6nFfvMR1QL936dYUEzWgKAaLiJ7oxNxqMnQhdBfHSku0uDAX7UjyNAi/724HvBgH+XkgeI/kifexc0Kslivm8LeoOn0U7JCzdgNB3FD0T7W6hCgu9rRJLQfHlDlR+FWFHam2vfufjodHsZm1bp06gNh+rw907AHZO8A+UB8lexfXBzriMQLwctZ+OBiG6kLSvQ+TW0oeby3ZifPukjfX2Qn+7pJGnRdwL/EmSWt3Sm8T9G0FNTnfWfDWGjUp31lQr/Fi3Au9Rcgakb6hNlDweIOsbq8RKwj8N5vget2nNEtq2ly6D7OBHfYp6AI6ZfRA8wRcutMmcFjWEcIlCoH/17zgpt1R7AJaIVEGFu2/K3+qf0zwMJOnBQgjswqCKws493PzWtKf+HPYX987Np9WA3tXYle8fJ1oiRVJ0uKrjIszZxcqlTSFB/xva0N4/FqrTnmfZvSr2UtXo478APO1XrzygHs/n85Zk5YZ/Soi8P3c9t3Xix7gDdYpwk5gjbSzeFN/s6h5xbkpzw1+QZRJcmVeCR2OV7hYLK7TfVGd/LjIm6rI3ANB3aLU4qgtyxcvjO6o1BXGrktNT+RA5SXZURdO+278tmXb/9cv8gYr/M6vE4sEzxNMsCboAfC8abioJzZDFgaE2uun10fnPYiYqiDUWKe2+W65JHu6Vnoc3CP0SeAF3oPMCINoEq4Wk2g2m0yXN/VILyHQmA2b18qMxPTInm6TUYDW6/W2KEmcNq+bEBRqV+FseDsKWnUIYdxU5lSLV3YuI246t3ZdL/9zkrJYg8nXiZleUZIUefb6dSJNYgf1zCGP7JcodclEq3CQsAh0kheNT7KseKbJVYY8NYGOCRgaLFKWlFQkj0VcG2R3JqGt+U/oUxpTv0xfaOaz4Kab4NNFo5+Qhn41ONEn8yY99eS2Zdkzy1kRk8yNOxV5czSzjfA4MxZLjisM+2rq1ycP8jjpATA2JwMUNE77oJzZiSUiXqI+2eLBcqBoMIwQi8wyRRJAkWSHAZGYAEQkNgWnSExov0iyg0skZg4uEhNjiCQ7GCKZL9kNfqZFjMuL7Vi4TuXKZDJlCxHkCjaMczsc2VHS9NiiVFDmf+jeE7YcNq5vI5Eugy2MowlDmKra0EWlyhaxrBwozqK1LBMZSFmxQrWDyGoCmrNFqhHSIDIE9BmK/7PoBY2Ib3ST4MTkq6fT0HBd42WNxld29oBg53M/t59N3rHoYOKAGAv6bNkLtAP7caIzsZJTWQDN1SyXRQ4tybO6Ypg9g+LpCBmS6ZIxowlFjGEMOfcxoqoyRixM7WOjB6KPfowJ3cz5/ESBPkvomwe1YQEHxVa2KqN0uzm0J7c+gSgnAYiude2AmvXZaudnrEA7kbXBWdjhh8SOE6duGTu4Pe1MplovQUL8VGZnEFpLji6q3qk9oCrRPGeFtj3HymstNtZPADy8aOwThNRDnT1dJyO4oR2Wz7Wz0pqB3hoB97qgviqSMCAjTmFu7TDyhvKASqxlgUN5sgNUno6QJbPsgCvP+zYL1NijQ3hL0fJjdCg73KhDUB5Ahxh7ug496GIKbxHTdXoktb+nNGm3Ybb1N/NBL5lz2zyaLpSUJOM2ZbW64WZazou/+mme0JdNtMVcQGzm1mdxuIfZWvGdt2JN4dMnmje1OEDWI+TPOOdwdT4AcwLkrZJl15JhNYOTySge69MAzAmQl1sCTdr4dNpqhThX42krTzRZLd3MXFG3WCYYeUealXyhCTLYAgBLw+qwNg9YnlyPIxBttYFkGAVBVGyTQdPbYfizOP63dh328IN62XiM7rt9a60V+2652oXLh+0bygKudQ0nSVLkpsy3rhNrW0ziPRLpBgNSQpwHgSpvJRvq2OXaKq/yoMqrDE3lzTSsDlTlYR6i8hJiqbyRgaq8iLRuMtij8hz/+6g8yo/DsbwI36rycUDC5W77hrKAa6fKCxm6TlptMYn3SMRSeb0EraqiggoPEg1VlHm2soscqOoiWVN0PcWmjSq5mYOoOAdYCq4lo+otov7rbPUoN0f/PsqNcIOqNn+B4I2qTR/mD7PtG8oaPDsVW8jPdS5si0nbKQ1LrXW8WtKy3v7fjoLsts5CLnjMMvLFpb6ywVXT9ovT/blAvZ/qwtqs/dcTv4vVL5RXd9I6PuGbfjrHu2c2TfiJ1qCqvSp1K0G5ucHogmKa3kA4O7Axqm6NiIft2VCcpbFjwbsmv3TCcbPyaApZvzVvFDGm1cs9ndnN5EYy9xb3qQz26sJtVcqJ165YTLrQO4ZTwf3uCNEeJ3sXFta4cmpSce994TAXBbUnGqCcsSxl2yyEMIZ4OnyIQ7oy+/rpNgbFpwPtA8tK7Ux1nP1FqD8C88AcZnBnKLqjqUoLrcefEGbH9GYXDfgWXoFXwc16GFp+meudFdWnvoqWlhPxep22c1Hf15zuQA36Mac7YNP7Hl134Mb2ydgnZB3fhfxT7TfFOT76JGbj9UTytDxn7I3QrTvH/J6kFj3nmlY+d9jxQz3sOAaSWtuJVsLIY0LuOPHsm/uuycV9tik/yKWlbLSU7ufGgm8s+G92+Avyov003ruazWb4K7Ba83TeL7g0R53wmZUv3gKsPUPHUwouLOer+2y2a/KBMybtKLI/Pm33acae3cjKI/koTq/8tNTObQ08vqBOvEyXiysxuULYYIgL6uPcNbmf0D05Z81l6GFgsKJm1xS08lqPyyShYb2E6bL9B7ehcfvPIG+rzkhaJGn/maxquqXoy7SipPnjNKmKMimeWwt5OGR0vKBu5AGRmj2wYY64YmJzj/WBk9rGpDbYbkl8ELgZDRzRp8m8/TesH2/sU3RqMSuQAwrrMpm36cuzu60bpAjRLnPTm8nJOqYf0HkDqM04lPOK2+Ak4U13JDnQoXcNZ7zQDa8ggnojukzI3KCiS9h4LbGfPH8lEZAPo2g3Dwzypi7fQCsK5skKsKrrsqQ/rMujxHUjD4jUkPkJ5GiKbnKP9YGT2uj5CXTwIHAzGjimT5nohvXjjX3aMz/JCrD5CeZt+vLsbsPnJytz05vZPz+ZnTeA2oxDueansVMFmKVkMTzaTlsOPHiLa8oi3j0sYlD7PCZ0HhtUdFEbT672k5/P18kcKmK0WCyjhUF+jFKjtGbrh/lsbbKqK7WkP6zUo8R1Iw+I1JCJCuRoGm9yj/WBk9roiQp08CBwMxo4ok+56Ib144192jNRyQqwiQrmbfry7G7DJyorc9Ob2T9RmZ03gNqMQ7kmqrFTBZioZDH3RKW/ou2YpXZxYH09mS93DwnpSOhC7h5tHtC/cBckC2god8vkYdERHqXIGKFouSa7WONQ12JGeViFh4VzS9VQRshspCdrOq2xawkaJzJ6BtI7rx+1GYcas0Ji8hno+Lv7q2e+YXSxycbI2DgzQJfgc4yZs3Hn9M8uWsf0QTYjIM5JZczIhjOKKOOeUcBj+rgK7AOSzGHVlJJotjSo6II1nmvvJ0/j9SqEW8/1w2IfJAb5MdqK0koWD4swMlnVFVbSH9bZUeK6kQdEashkA3I05Ta5x/rASW30xAM6eBC4GQ0c0adcdMP68cY+7ZmKZAXYbATzNn15drfh05KVuenN7J+czM4bQG3GoZw+pJFTBfQkiWLuiUrEEepXlPViNrcG3ny2nxGdiOGs4/F8RsxS8XoWRNAOrpZhHK514mMUGiVF4mgt1/KCT8MnyqmPcImOENRtDNjywnzcRobuK9UZR0TvIjXev2106hBuMxY3oh+5xAZV4k392OfZ5tRRx7aZtenJsrrK4dUGeZu+vAGftt5h/aDNKJAz3OO4+QDMRbKUey7K0vzbxbphijmpuufCZbmJ+mWoRZuwgQnDnyc5K73X+ce+CI9waDGkdzr7W0heF7Aesn2ooNxizBbRKrY+Jp/zhFZZmtPel9mviLhgdaNVU+O1F2Kwr8LMYx/C1WGRx/YvwdLh8n5Xnbo66pNWR3ch8S138TriL7VG/KXuGsC/tt9JGzstqR+IU5jPGtw8TqnHcdidm6bIv3ZY45YtrWnjyKvPu1OqZ5rH8khCL/KjfYCFZxGZLLiJ17adVCDgCoboz+b1TtP8okXIiIssI2VNlcy4osnkFm3GLmoqNFPEwSqeryzEVj+Gq4CqpV0s26frRGcHKuygCjpoictv0lOaH/z9OecHeyipqSkvHDJIwq4qOYsROZ3BIDggz13IplpWRUmr5nXDWz15Sut0l2Zp8wqq6AGOQl2nMalo03cUN+hEbzzkUb44Tlxp7xPMyxcvIfWRJjCVnWz6m3I4i4t/fUee+JMkGOKqFjgT9utcYtcPwSIInG8KNMCJ5mfHNUMWQYqf8FQXDcMgCLb6eNl271dttXe1lvACswrjFolYYuBwHHhIZJuldSPCasLjY9o6UhlkLTdLy013Pf1l25vXE6VKSzWOP7GTUiPCWPGD/O0MbpYH1xZ6YKCbpuyVJaY9F3kBVHvsx8B60yR9ShNayduvoTqfuFmz7oBTC+J74YHtTMKPWfpI8GhmrSnyWFSLOKOk2uyK5jj20KN28AWLB2qzIJclSI65Hlq2/9A1xdYd4N+kKt8RIBNXBs5Nl21tgEbyI1bAroH7qLYeCG9dloM7DQDXYNe3E0NbiJwA7I+fhR5zFIcGy6o4pMnmv/7PP7dZf22L7YvqNP1LGldFXeyb6aEdoTRvPtKcMffTnmQ1/XSFW0U2CZq2nkOIc84cOQ7ZTK69i7aVJ+YV6khJO0wHRlTvkhCOonYR3TuKWrG2f3QT/z59oQm4XK7OMQMbsF4HV20ugnJ0iORcetz+TqY5edqRymd1itPSniIiUJe4yBuaN5sPH3RzCuNw2kZXyxB2t6vfYHSQD7NZbe2sB9VdI/s1q97z76I2Jh5vWGY9cJdSaXuMibbdkCuXsVGKHHdTbILaLkbcxuGna2Et+hOXDirGBh7J3wzla7tULFvzzHSs2pU66sKr0ChfVCAGrYVsj/sZyKhLtDrK636ipbQs8/aIuCoiN4JNUWQ7Upm5C5DrdTXoKTpTKl2/QAb7UoAeLXKPDnKPBjnjjT5jl8ylmxfNRz0m9See0gUU5glwwfvpgjqN9M7U4lyDy0xu5I2VN0XJx69iw5ykQKZVc1eRLQdDD+Fy30LrHLXNdDFk5EF+HBoAASP6jE8UA10kqPWJH4ppcg8JILx36jxRdV8X2or4xl7yLEWwZjK2rLFw+irHbMZnC2qGr3mAEQQf7EHMorv0kwkjSCeMDEIOvn+Pay59DHR+41tcw3KJg8w77CIcyxRdLl5aFgxCTRBei26F00Mj8ASVMabczHCvKR6h9TfXsd0rdFvnk6DXMWT15+wc8M+9Tfg8uh2fR4gCMcIsVGQI7m8GTm5HzJI9EyRc/PRMcK5JZV6+bF1TnZaHTnYj5yPAZd9cODj13TYd2w0ATR9QunewYSjZO43ZHbQcVu39uvM3MXB2L+v1/3Kum3Sf0sR0q+tTC/ezZ+S1ODdiU9t9UWNe+U1NS1KRhqKUrXnQzAFRFND3NiU7318HyPBLkfhEiOPNTWO3Wfw5IQ0RPS0+3NRfWUn81v84vAja6gZrE/St9TiKOoP6Ms9tReNGmOfgEx6pTt9buLe7XG3ciqFRMR9e1Xp5XIhSJGAGwlcXxk/zpjtfDOkjLjz9kuAMhuR+BLE7YC4SsaMPsmtyPg/+9hE1HS1wYOx2jACirRkburOXvx60i9ORRSTPMG6ogx0nymZjFBSKjPuuzLiKfTrX5g7oHITAKn+L2L8O1h2YUco2qhljgwz38teDvlHZXDzjumGx40SNVLYhkVnK5vVoGqJZ+n6lM+V9s+vwshCp9OZS924PRjRXrk1GxxRBqF6GXhLq/eanPyhkhzrBnzUZ+ZgQwqsVUtw5QyCnalzUxpw5Qk4XWeQcIZl6ccbDXL6IltazHNAfArQ105kNFu5iWTyE0FZ1A2CwPbHR2pNxcFsG90yDhX9rt22vXK0X/tydpe/9bJquXHdHOHZkw8Uxd/OAjEf24Ahsjz/2Bqdon/zgw5ZwkkS+YKnBHaBfVlErhZzLwXDMl4R8ysGwyqGLZooPVGie9ZnqvUY7DGGGsH6nrnTcbm3p5OQJxL2zwm+BI0SszGOWDr2wI3GPZPAtHtMKLARfxvkU9XfPqQjU6ElS2skK44CGlWvW2He6Y/seZzMYB544JjHR/9AYUUnOR54pvODCj7wI6jl58t/vIJPsi8f0dLh0TmilHH5DdvXF+dAne7NZwlpF0o++GbqnVFRCH4k5THrOcNwWEc0TT3OC2rDD5K2sPf4/sCXaAScsVetQO89QrnZRJ/RIhLnpDaAFpAtmdFzlGAfsR+cO1Fwl8HFZR5Gu+8zet1Bdz2nvtVrrVieB4eMknRev9+iIow2IJ7JzP45vVHAdhe5OBlkrb1f7bc1yIaCWOXG6bsPxeYcMH0nvkB8z5n7nRpsDhN2l4byUaZaBmcnM6NoK+04iPmfpBRx9NgGgcVay3iI7c0xQg84A+HVD4m/4aO2yNJZZ5Gn7G5xrtrgOTwr3zgW/xRRw28gfPeA10Thnz/snhP5xMWZM/AaTwHtPAL9DI9FB35CdLw5KstfX/ZLk8D6LgRHhQ+0FL2MCaij8tH3P5zt+jhELId3FSV7YTyGyU699a6HBk5bITDd8OlOcxtXmT3USU10XAkdXFtbRlTZFrkr9lw27gZJ1N2pUVh1XRZa1GwgWmlc/54ov/wZeIwj4AZZosZjI/5+GzjcScLTVXHZpSnL8OmKaMmSlRbg3TvGOPi4DubEubTEV/kN6KouqIXmz1XzDWip4r1DbNYje6bBDEhAFrvjxYVAWHDJuitIN4RH9UczQg4jvxgzbFqkXwgL9QDN5kY8zize5HoLy5RN/wbmoUpo3fDOZkTypY1LSTh3ek6soCFhY7nZKI2lOK3+fndPk0SbrQvAxruX3lQWl3hqw/Z+B6wAsj662Al6M+17gGBmbPobnAI0a/FKBqqutB/B6gnFKXr+TNguG2RmoaoDFFmLcPzCFgVVyUXMNdEwZJEJtLdGS2FUkT3TXgm4jlcNpIRxOfZ/W+VPHOllt0dGluR1TZmHmNTEXDkNCf+w00jNoTWwAV3cTdrGHluJJniN13DZguqLktZYffbQVzUP3p2bJzb5iX3Juv6B0a1R7o0n2ZU0j25umcZH77QIHu+sdRd0rhPZnrdCqrSP3uSNsPqE9PLZEXxjrTlVPTp4Ewc1q2uqtrzylIls4W/UXHwL7jeEwwPRbN0+St5XOm/JCeq5Lr3yisk9wsVWL8TpIvyrwTr99bePi0IOXxCZDJQxBbuQcwc79RkDmPeWdc0hvMTC39GMtd7AWj2VY3VqNsnyv2kARfn7gBHEr2wJRNmOu2RfV6WI5+3snE98xm1gz2vCq37U5ffPOYPJ+24ZeUiOMc1Gd3u0RLpvmGx/hchLsf4TLKHbvI1wuIvCoixuHnAoZB4aPcLlK9TzCZRS56xEuk4J6e8lIfudHuNAq5SNcdsWOR7hwKviJD4ToHY9wGVRueIRr0IRao9Pygl5dUP2ru+WBHDUt6C4EfdYO7D193y7ndvMMjYbbNxa8wTGG7417q4T7iN/vZodcm3fnFYDt6wydDpZBlLQyYYA+3miVeqmNUnO7lLGApi+NgUeeiFyMWtC2hOzPu/Y+QTn8Rmgzu7XdEUU8TjymgLaB0TxY9urCLPa/TSLmWOiUGXnlRXqUH9p/MNzkqv0HS4NdGjiW4ASCJSKOMb8ns0/3w+cQMHKsD0ewptaEN2AHWgIOffS96jOmJS0587PCIGoEgz2f8JEjFP16wOjpsUjG4cZw6TrHwk6A3qUXxrEyGahQnIhwFxjgVYCc4RMH6Wv7erv4w8ODs7jlR4UAZkVvGtZM8NrJngHMmG40zgGNUbXBVQlSTc+mdtzgHr29va3su00DeB2j5oaBove2751nEUcl46aWwcJ3N/LuScjZVnZpfIRS2iEy2ZtgEK3uofeRhBFEnfnj67PjhI5AuuOGDnB8UxHYUaoRaf5Eq5oi02wUwdDowUP7DxbF1z/rpP3XjwVSwjHDh2Sw6QLS0tc/A6zBJdBI+EBj8FXQ3e3BV0G9qBEM3nQ0aUAbXKugAdwYLl0T0Hw+v1M7sFWQPtTxAgO8DqyChun3roJY0GhHcWsVBAHIKigM2n/93QlWQT2YMd2IrYJ6VW1wFYRU47JhIOLcTRNdzzcKcTz7ntEyvF4bnolGL9luK/tuc9bYJdvtRe9t3ztPeeOXbPcUvruRd8+Yzrbq66sBvbQXIOjcBVdtLqqOhZudP75K58KtDzm4cHNxfFMR2F3X6a6iJImr82mnvsI9gI9w+rHBEbGDWZxX9IF5VZV+yNb8sGNgPmfpZkf3RaUOafFIS1tti6BiWX75WxCQ4INBQp7J1FfuJTmkOTuIgX/WQG8+scZ76GHcjp7dKpDbjlczoS5Jf1THrXUhGN7XGgz7y8Lcjg3S67o/AlpiXFiDrTIyWQuxzwV9vnVXYCGzGu1mm8WClsc4uNNpb9faTZswWcTBtDsYL8BywNXBrfnSxJg7XbBvNOuHpSK8AJuF5rXMOjN6aCKNVJrZ6trghSb0MV15jUGvULeJeDrGJrRkjly7+UZWL2VNBNo1wTE3ApGXzkFv+9nBmlZEGp9Z7goXY9fgHu5IPhxv+ihe9o7xpTnaBG3XMLeze0f6sn+kw7rrky1ZnmZIdmRQFJt0j0jt/D6RznpFOsOa5Rapld0r0lm/SGXdtLr0GlU7VIV1vZhR8XD7yjJEO/hvxnSfcefdNsdOIA5foZTn/RaqeR4wCzLl9nvJvPA0py9N1yL+J2uU9v1SgcuKPqXFudYKqCStED+sJQBgtjSTzJbgc6SRwWq5Y3K7Tvn5DrOrVCdNI3rypsv2f2b0pI2w1eJ7IxDKyhUIRT0HYGjXcHyWHakpf9HJ6PJptKCnK+FcCynJv8Y9QyAkI+KrifZv6KlsXsHVIhbLVBx/sdaH8taQINDz5ZkteQ3Qz8eK7tXOBMtyPu3KvhhLcuIV+4v7lqGBw6o1s1zVRg/LYB1IcsgL4Ma71ACHVWtmuarlL8NLcvAVYOPlWh2EVailO9137M1nSQh569N4gRLgsDrNLGeMAPYGrNIQ6y0/4605E4bqkZ7jqpO/6ngVr9nhR8u61270o/mz8sVb2bb2N50K5GNE2MiCFoGdsmHNcg9p3uq+IW09ZiYKyTfNJA3jpJZ84OxKBANyduJ/3Tk7tWaYs5I29CQX9ZKd7hqy2kI8mm8UytW7Y/I3ycuyho3DMZ8F1DiJs4DXqTt29P0nW5X8cj7tiqbqAm6xM00z5Gj8zD5NxZJ4Q9L8SKu0cZlzVY03PYYT7c9jeDEI6FB4BhDcRYlCoPJREGjFH4+Vvl5TbxO3/676nRFVYmJfFLHF03shEwnIpbVcUb+A4NTCAV/HFaU5v+xmH/DCe2r+YPfUnB2Ne2ML9ce1eAuXAWiP1ZNd3yxn7Gh7czyfdjlJM8fTM3OXbw2PWnL3ElV/PUt7xI+DvGlUe5TU1E9zvzg34CFAB2gQobXeY9FgJl2CCA6jjVn9Hkh3VZ90ReQ7IlqSmtq6FCwki1yFdOxMY1Iyv592dWmrfz4jGa2ai34R7Nab05iDkFH1jnPzWCsY/hzE/2M/3LpqhzjLeywn4sfZOuetIJ9L+PikIJyk9Smt2ap9YialO+vhgBle0JvGWVFj5UWOy7i1plocsWRzGCYBtbyT3RKvljNs95Ds90ECT1QmS7qOl4CUh06I8ZpGuxmE6vKXq8/dYt6uVngOWwaqJdsqeMDf96bJHvqsdjF92Ic6HZwxsqQhNepDuZovouVaosBz/w9kmcx22LwR7x/oDDC2J3QXx4AUztt+RcPdAkIR9pbLRdgJzXzqm6zn83mEcRclNLHeZN/FcRKalHDm6Hy3jgOARHh7mM8Ws/n1j3Ji/EZf9xU50dorq+JQ0br2+RXbKi1pfdlXxUlfxCrlnjPnxbUp0NzAC67XP/rFb0r+N6Q9lRQv2m01bDYcDhHm+iA0dLHKerbReW/KRnb8s/MOVuhv9RgpC2zV47uLurWeYwvh9Fn3NM/XLnQZb1+ORSJWnTXMmy65NQbWHGQ6c0y5CU1KJl2qSPFM+Vq3DSV//IlgXz0WOF8k9DBBLrItPnnR4vuJZkqtvxfB946S7pwVoAH+/mRfMvaL/xcy/U/PMfKYLBtvbCaaB6Yj1swxVVK9KycTRQJQSFkfydMT3+BiM6QXyUesvTTfp3nasHFze6GbS8BxNujU6h+AGIF/DcR/DUSLY6B3A17NAaWDpf+lcf/SuCGNG/ZsDygdQuBfevcvvRvSu8FPGwNqZ5f/l9b9S+sQrWM+bXhRWiRjL+OKZyZY/oT/x98VyesFbqx/LYrTJrzqEOVLZ3HbRE6x+4XGDQxDqedN09PB73zUMFo3hzJPnWDoUXuS3DzKEWoVtwlmAXYrG3xB0Aq0bZjYhUU9SBBW8GmuKUpJin+luziiTsjqWOgw+KmfpUrMkZKW2UtPbIuF1uK0buDRFuvoSvcFqz+oPg8HBL533R4mHwmXfts5T8DBmDcGB6Ii2DTdkSve9vIkgTVN+Mt4lli7q5R2GQ8mSK1wEHPi9Vt+xJarPD4C0vkZG7wqUciRad0SHXvolznorg5R6S9Ads4vfpoIfiCWJ4Imzhx1XsCVf99JSfa11UXT3aEDbN5TkPE/pBLqo8d4llvZ387vQCkHs1Z4B/wgwMSR7upjkes489wXsdxx/hev4IZeGyjwOK1PJMtuL9db7GbdGlVsgNeh0v2F+5X6zmJDHA+UZoWHxpOro/Bx0S+q3jIjRlK8ShJqH3u58ZOnbUkkAYdZwOm7ydxq/gbLgT5yVuyyiCq/zzICIgMg8xK+U+AB+5zsZKg7m9APGGqYoUMDrMs6R6FGCcOclvsnYyEpczLm+mSRv+FTOSLitrSLcZuyg8DNqtxbaFCP29LOvmaZvT2sFe9DmLrrEmw8p7P9zMGkW3G13N6WjFJZvaphyHDTb9FUIRdTU7muWLRvOz6ByFQQcPGO0neTuVlrh8oNKq4g4Oxxmd/b2yaRAZDRhW6Bk30Ux26G3XpsAoYaNkqbQZ2jUKOEcYNaS0kZai30ySJ/07EbRMi8vIt5jLqTyM0qPVBsUKN5eWe/i+ze/jZI9GOMnnMKmu7iGNVmTsWtzEb+QJtGqbJZ4RjQGDHcoMdSRoYeCx1y9vmQTw5de9sPVxoXHq/TkuRWxNwoGO806z38iZ0B4keWQnBkKTCP/zhBgmHu/9VPqsoMKS3bMTgm1vXwHUPsEiCoG32WSwTFAfs1Xq5Jm4xeeuMJa0e0lvbZUY2M2lXaafyyW5cK/kTKiWI41/uiaLqrjbqgB47AmeHIkdcZBy5ZIvc7c5o9agNgIpM4pzKAjw6xDw9bVKzpdwRZq8zFfpFja72ublWte529Ppf0CJZG07oYzyi9aSgY9Xfebq/HEz6mJeMowafm3ke7jCH++RYVGPMJoTecM6j6jqrEqU/dSHw2hzEGdnRIw8/Pi1zkL7+idVnkNbssxVKcow2l7YmLCiZVPNWuywPXHHrv7lxdxMwnqVkuOu6snMsbB86NjDw2rSk0Uyo3p7cQbhVuNOE38HRTrf9s4vWa5H2kPVjP8Xfp1Xdrz2A9x3dSpl6O30/G7yfFt8ipR/1/UxVH7PRvoeHvUc2IrvldqnG35t2k/27yfTcJvkFGl7umby1Wi+DAyZprhXjvivAmXkT36AnVKD3sJ9qu3kYSvZeZ8fX984l1tDV7Ux3H96ijV7Lv1I6BOvqs3/uI/J2k+k6Cu1s2l3+MJr99uhgU+W88I71PKwa76u1TYK+NexeJvo/Q7pXLZThumbY/bxv5Gez0rRyt/0weP2tYZ5froIvTUWgOrTFrp8mtJY6GGwyMZZ5FkyEfhAJe5NtgDkKcocemeuyd4RH4cRS8VYkbqCv4OOrt+u4G6go+zmDeKatRBO7lYJQ8RxG4l4NRMh9FADVp8sW/YW11zuOouEegNdnegh5FW5PaLehRBvE+KY1U07vKj5HkSCW9q/wYaY9UUdtkyXfnBpTOnOQHdRRsXIdUYyx1FH68XRX667uZwPH2zrifA1wG8CvNYH/qC5vh7tTRx6FJZCRtDH1PX/bVdmv54+3D+u760fYP9GNHz3HVpfvAhX2X5beCtHwPPbHgiEwPS302C6voUDZSnSPAW4di+z4KTm4qd8NS2KRofcxzcMxxsGIPqdg6LqHXreKuYkG6NQD4etr7XB04LDCW5kghjqbnmZEcJVP4u0MoIVzGj04ZI6ypGLOO8O8axiHiO26V9JG9UcpjSHq3hMxECd0raJM7FUIEDalmYFzqfFewtj7Ktyr1CJJA3JI1x1l3B627VdtgkMfPQKPEdQCXrO8KPucke6OgB+lBKQumHOe0MUL3ithkTUWLQEPeGRiHoO8LptdH+UZZjyEJJ2vBmuP8sIPWvRI3GZSBEtAofjrEIe/7wgP2EL5R3CMoQmkLxhynXHFS9wpbskdPO5roi8uhi+LizGsXMDqAoftsop6VIo4BWkCWgKSnLD4hksEjESAZT2lCi641ZFcX2bnhIUTbVa48x8uv0GuXk/WAft3K2mpAuNy9ri8gdPBiOY0W3yPo+e51BsGrFvlMs+xySnMjTqA6Crp2RI7tX+vpi0w6a//dHjRx4FzxAJS3y2Ma8/dz0SBPeZsDEMQl5OX9rDt+HM3x+NAMV5+MULwmjHnheUBZLSJ4XyBsZ+z3IBCPjBinqwOPrQ73adbQakOy8kg+FiWJ0+b1pyj4tBW/N9NI8CHvNfM/jGPuqob+kOp4ZQu9MnUPnzddBbIrS0oqksfi3bVuFIMaOh3bBJ6+xzwVCcn8oqT5xRr4LK8bdPv0hSZixAlvkzXy5P3tMFgEWz3OvRURVDZBpvt1XBVZ1nLfFOf4uC3OTdttisnpniTUEwwnKcmKwwUJeGkk7Yvq5E1nIhS1Hc5a/mXjNJCLEqyIAzPS0I/BxI8W33/a+qe6P7/oze7Jk0JJ8z6RWGWDPp6CHoYCFzfBJ12JBDNKl/wX2d8q5ZVH8za5tu0Un8O1SOAySAwrJt73Q8rhFzSQSJNxlpabbhI352Erz5qK1+u1napPfNEne4brlBq/AzIrX7w1mIDhFRAcIwXTNiKpivLeYTsPsCdJgwDSZ6Pxgk5c2rxlF0vzy5jZThQTD+casdedHhj2Dg0oKoOPa64tP+rUSL9iYl0DkqH2VRvY5RpL4czrNhwKL4G0fGthUrjNwn1YZis4IfZU5+f2fywHnx4l32LA614NsUmopyKxMszWf+5+mm81yhJ8yt6Ryj9RUp8r6lih+ev1ujXlfEwv2kWPkPLCCBbN6XVPMFvPPhhzBs9aBl0IavYGh6dPL3KeQEfbQlzN6R1uDpASgQyFM2PRthDe1+tI4z2TfK85ftoURdakJSK5blCuArBsZ+ubPTml2evmw7/T7Ik2aUy8/6Rn+mGi/p78W5WSbFKTvPZrWqV79IkcGZWqOpHMWDjNA9eIgCF/9L/rhlQNvurRF1tdQmdWWFpGm4ZW7PGddugIvp6LKvF3FSXfjBQM9VyRUiUYD/nwFg5NWbzNvC6uSqKLnHPXWp+71h2+KUrjRUA1WtmYYCtZCTWCqInnbK3naSRYhCxzkjbAerQ1hLDBhp/m5oMsUQAfWnq4PfB5uwpGvkPIOklVFc+I+oOQ7IG5xUCuJ3JFZtOo0QceqMo0fovge1Mgmo0R8xV/Q8kzLiPpllGri9GAFaqHiLZw2pbh2N5WJ6M/0Eo7BNz9daL1sa9GwfdbM4od03RnTUZt/LAVVh8mU1mdXNEM1hfIGrfakQ60Oi4grMLRKmNVZrprkOp8VyPlok3rQbyRd9Tp7Ehba+6vsyxKtid2OW+sJegS7By16Wi11P0p0oK9zRDO/z9qCH+vjVIcx3dslNwLtgCsxSJswYaALLMuFJOZaV3L+T5T5nLLrA93M18YYz20q5HP7K+55NbzxRbEeGS/ewjMvOLueJl21f7r2Rbt2n9AxGr29boR2m2qO+8bY0YhHqdsipiAvzdk36AD3Fy0vs2gm1WC++OhzaRgSrRp8+GDOXOZndQUZUdYREhlj+ij0z3Lsm1k5xHQUg0lXHwC82R3p9hiRPDf9efWUqKtapz3AeFHeyfcVR1Tbtlyw4LzRhrDQmu1Yau1duvpjpYzs9vLiNly3lI+8PAmG8xojcZr4mT1Nt/S1VKHUXOnCcLIAJJw8CKaLdwB43u7lyV+PECbimBni6fXRvQ2qEdfPmkN15Kdzdb4EI0Wd9G7FbKzxYO8sBbHpCrONfZIcpcnNjsuzyP7YmS5xM3Cj9OBaMzMkCNOavmwkngd0WNrB9M1jUIG8nH+HsWDi2heejpcjLWN9GCQLOOPb6pliT9LPk0+Wv7lNvmCC2ace37Z9+Ck6aFfOp+d7KGnrWj2JKb+U1qnuzRrd+va62SOLFm6pFVdUh7KKAz4tthKwuUvIiBNRex0FJLTl+YiVsI9HvxZ8rHVy4nLH6/n9zPDI6ujiLKiT+N48YeY8cdx0yOUIUbF+mwUu0EfqzzzavPpYjFngV9hKhMeiLKPU7xIX+oNhF17KO35XJTcRSxcsfyuu303AUc3WD3gaixfBvfVog8SlN3WGFRFdushgIX+kN/wu332B2H4jODyk70I1/KDT6M+51rt4hIa/ZII6w/TJe0FRkIQBOEnrxXiuGc+3kpRMNrRY/QnzK/RFOWEOxvaX/uqOH00a/o0aYqPVl2fRrz10RQen1hHsy66p6yKQ5ps/uv//HNL969yUpj+JY2roi72zVTVwbbyf2p7um6qn3747iHg//fDxKN5omUEXcZ/F4X/+lrSn0KjIRUtKWk2/D/+C6ILfBRI35Z6Anl7v3pwAQRQSm9QjxspvkE9uC5ADVncrx69rL9dPQKXejy8j3qoEycwfdx7/u5vGOrjtP41A9biTQ/Za3lM4yL34yN9qorcB9ahBwkXQQrKUMD0mZk9FrDdyUgH4kKtvtOc+3yY98H28LylYR1Djn3j6BqGBSK/H3R1yE2bo5KRopSfeLqvsey37lLlzlJzf9BHc7Oj+6Kiyuvyw9+iYLb+oZdJtAz5wVghJGlMmqKqke6XDpJA38MrZ/JiK78Sf7/F39kRX8HMd0zEYsB6tQdhycvSC6pwnZ+pO4TYOfZCeSKgZTRv2Cfydm3iOraludT/th5agNhOWM0tIjyAIdyvqAbJ9aloQKQ1INI9k47LCF1Xy4BzVp/J0HPfb/VzkCL6nNZ76lgqG7b6YUrjAOWbF3Q20+wUxAX66eXeuI4rSnO+PbYPKfxzTZjydELXi7MA+nrUsGeL5Nk7zo6/3aTYPxUK1avEtCacmpE2CwgFmrmGwUW/anftDtL48qWwxy6Ne7EmAyA+z3FUUxTZjlR6QZkkYXFGSbVPXyRG/a0ARd6QNKeVv8/OaaJwINmCW0AFSTL/WFTpr21G5iWKpJUuCzD/j5bFEwyZ9EEkGf38kSxnpJlAfrTLBIo0CczJk8xvf2rJO1Kpg/UaxEgGcLM+M9GEmhiVWZJDV57/0WXJk/1dvkqRIOUo5T+FwTSewtN9pTdoK9BDS+cGVMypICM6H+tnrEu1bnR0F9oxZk8YsrcFrQlXfDZqBbHZFc3xOuWGRJyDMz9pGZOQdlbVV1u2q/5IonZi/Q/pqSyqhuTNVXsVkQPan3r+MU263m7tkJ5ZH4tnkys9N82ZSzOjF8u1eZ0y88aIt5P/JvgSeGRrf42zvlZbht/+drcJGNsJzZ2Mk/0+fQHHVK9/9E+1/5TS5xYmbFdCn9KYciN7nYr2+Nlhon6fku53fep+v9TO2jsyvGMnegpfxyFJEHtKkBRYWiVBbH1CUmBplQSxLzWSAkurJKC+QBzq1KI6arFarthiBhElVDI2/2BAlqEDKyfKr4pnHZloyElzdJeLaZZpBUe1BBvKN9PgYgVL/zupAIb0RJQiWHKKhaiqZ70OjXrq08i+04A9fQdR7r6rT3rfWeWcfXdz+0b36O2Ux/fzvbTv7n1+shjWE4btTlKr6JSM7H4N2NP9EOXu/lOid79Vbrj7Rzfw9v4fT/oOBbiV+N0aELIDuhrJ7DCyrzVgT19DlLuvs4Pe11a54b5GmnJ7r2JE7ug/N5lbe8qa+/miqGdlcvN8Iihqs+swxQEFFSS1ETtMUslMFNZUABZWYi2rNG8G1iQc4yjSr+MmtkfNEaBb0xlYV3asNNR3EzxqMYY1fGhEADRQ/RvqGRw0KP7+dt0zugQhoW+9unT9L19+/M6ri3MV07+Qskzzw//5f/zHT7uiaOqmIuX0lObTuK6nJ1J6P375fwIAAP//bus49azZAQA=",
+		Contents: "H4sIAAAAAAAC/+z9bZPjuLEgCn/fX0H3RMd0uUU1SYlSSYqpx+fxbuxxxPH5cNc3YiPGfW9AJCTRzTeTVHXVaLW//QZeCOIlAVKqmrFvXLvC0xSQSCQyE5lgEkh8+f3v/ov3e+//X1Vd2zWo9p4X88V85X06dV29/fLliLt9XzdPquKBQP+xql+b7HjqvCgIQz8Kwtj7y/es63Az8/5UJnMC9B9ZgssWp965THHj/flPf2FIW4I1607nPcH3pfu+b7+ILr7s82r/pUBth5sv//GnP/63//wf/410+eXL73/nlVVToDz7Bc+TtiWEBvOF978oZt6Z9788CXWJkypH7Re13e+/nLoivxyqsvMPqMjy122LytZvcZMddv53vP+WdX6HXzq/zX7BPkr/dm67bRgEH3d+0cI1132Vvl4K1ByzchtcUdNlSY5nqM1SPEtxh7K8nR2yY4LqLqtK8nhu8OxQVYRnJ4xS8s+xqc71rEBZOStweZ6V6HnW4oS2aM9FgZrXS5q1dY5et/u8Sr5d0TnNqlmCymfUzuqmOja4bWfPWYorAZmVeVZinzbYPWNCGsp9lGfHcrtHLSa1DNG2rLpPPydV2TVV3n59ECjKqsS7EyYi3wbXn09ZmuLy66zDRZ2jDitwV3TZo+QbGUuZ+kmVV822a1DZ1qjBZXdFW5R02TOeoe2pesbNpTp3hATCtv2++bnLuhx/veyrJsWNv6+6riq2Yf3ipVXX4fS6n7VdU5VHJsHvjKh1EFzTQ8nK2u41x9usQ3mWXE9hL5b5ao0LL9gxmOwXvI1wcS1Q8+3CqPwhCILdQPv2h8MhuLYFyvPL0OYx+Hhtz/tZe64vddVmRDjbBueIjEnCvY4/7ijfe7ZZWU8wdVW99ecxLgjuCx+0P49ISVYcOTe2wbV9PlIpbZuq6h4uhIGHvPq+ZSK5Mr3qRxziwlsG9cv11FwEGb2G76sXQmlWHrdE4rikRTu/qH6x1cHF17rBAyHo3FXXpErx7Ns+ndUNnrWoqJXpVlRl1dYowTPxJDEuxMV1f+66qpxlZX3uZlXdsYnR4hwn3YxMQNRgJKYbbbzNyhNusm7HZMl/cUwDec9Zm+1z3PfAUF7onKZKeqiagqkxhyDGwqOE/Ny91vgnVvx1JhU1uMWdUtKe90XWfb30vEZ1jVGDygRvWftdcm7aqtnWVVZ2uOGd/ZxmLdrnOP0qdysKL7xRig/onPdj226pyA5Vcm79rCxxwygxyy81SlMivGAn9ImCXmRFZdbyKo0mOeHk2756UQeN0qwaRiiphpi5pjJJVXCpoFDuvzwXe9x83W77zuho/LbOSl/WFAt0de5U6H4uUFWVpYZRk5zAMb1thuwAPSAqd8hwngIUDLSzAj8hTXJgsLYGKU6qBhHbBOkg1W/aeYs7oRXzRYwLb76K6D/rGBe7foZ5Uf3S6wwxxW2VZ6nXZvkzbq45PuIyhZRLzFTVOvQT2rDgHdHz3vITuyDjI64kR3WLt/3DtUtn3Wno+EoWCf+jOjcJ3nrAUuMU72vq/GN/X2U5bqjzUpYcbZN8Sdr2C/HBfLXwhwKnGfLqJiu7y+9nW3QgLnu7x4eqwZLn+F1W1FXTobLbsSXCCaXVd8prqUpyL4Ent1GUDm5qq7miGaKGrcMpM2WD+Ld09cVc/M+nBh++sgFcuHpuP3ifPnio65pPpPbB+/DwQfbDVmhazcEp4v/rpw9/Q8+oTZqs7rYfeMuZqPzhg4Hsw5UuSv5+rjpMXMXFULEfNpvNrkZH7O8bjL75WUlWVFv0XGXptSPrJrH2oMrjs6WUT/WLeM5Z11xs7YljLdCL/z1LuxNdxkk8PUWz02JWX6qmPqGy3S5237O0+t5uF6xKxkqHxZHOS/S8R426JJrvu/JpnqAGd7N52lT1uX6SynqV76rahxTqOs/RHucAe4IguM6VaWPMEhkNhfS6dNY/ncy12g+Hw8Fo4zPsOB0aS0UngLI0TSUs1z/wBUCClaXAj/89f61PWVKVrffvKD/kWXlsf9y1TbI9N/mn+fwLgW6/HAWYf+rB/AYfzzlq5rjqHm5v8v/7IcOH7OXBIy4fdZ9+xMUepylO/arGJbGuPz7MpmP8Xh0O0YCM/rwZgdr+puZdJ7XumjO+eQTt8/GHAeD/FgC8fsDePh9/fLjOBSywDibr2bB+2YHvIBMUQFrHs/XITvYVyyBQ1tahsJ6sXVFV3Ym4BFR2Gcoz1OKUue2qfdFhjg16bROUY2lEPvUGWfttMPPcZP01CCL0QQat83MLgu0VMHxuONRMLa3MxlGAEqVxkZVQJ1EURgpcklfnFIBbBaFKTPmM86rGAOg62KjDw2WS5SDgQQE85qgFaMSB1ndxbrMEhFPHwlYyIOBCATxh1HQgXKwi7FADgq0MMB8XdfcKAq8V4HOLYZyPCtghywsQTOV1d/Jz1BwxBBoGGigIFBr4shbkjaY41TcQSGV0g4vqGSZuqQD+UlWFn5UgZGxCVmeYRFUu1eEAQqkCabNjiXIQUBVJUh1BKE0iDWpBTkeqOE5VATImCnU9gMFUaXSZBZsmjwqlIJgqjbT6XuYVSn2Ug3yOYhAcBFVFcq6tgKpUsnJfvYBwj5otRa9+kjWJhU0bTR9rjMAhLQIN8NBgWI4LVUBkutj4tFCFRFwZCKYK6ZAjUNEWS92IpfWpKjFoQheqiJ6r/Fxg24xYrCBgIlYQeg1Bn2sQVpXW3xsS5wEBVUHtkRVyqZk1mFnLUIcC2bRUJbSvYLO2XBhgJAoIgqpSoi+BIJwqoAQVuEEgoCocGrmCwNYaiTk4zZaqQFjIEwTUzBp5SeSLJwA6Dkxo9pIEAauyocFNP8cHGHMEACe47GA3Gi8A8MZK9hKAJiH67AD68jg25j4IttJsWYrLzj7CNQRtp1lbKKAEE+vv0xA+2EBbnmVJd27AqbVSpVig2idqDnN6pQmGfdqAABeaq4IVeKXKAqcZDKYt0U7IMhZVBjQiCcKp3LetV1Yq19sO1z55Ef6OGnCerTaalNrOCb8ONPvnAA0NDwiCqfKp0bkFR7ZeaCOrQEu+XmpmqLHSF5tDd4GvTM66wFV54b/hBNST9aMu/+emspuZ9QYEt87Cx8B4paMrSRA2NF/N7MARsIK2Qy+0RbkdUpXf38+4JS/gdvhYs0qHyg6riTBpMC7bUwVzbg0N0L6Ee3zUh+iA1VcRpQN4o4oQNU313aofmxAAtmrHJgKg4RXSZgGA2pZem6Vp/GyLz02s8Zl+gT6cc/BdZ7OCoOmnTBBcm4UvSY4K5FKoUHupP2Ygo0PtnT7H6ACCaWvmDPQCYaA5lVdMY3UgaGyAJnnVwmhVbn1HTZmVR/vQ17rFLmG0ms1COS5TMAQRanGABpVpBQUMQi0KkFRFgUEHHGqhgAIdSwwDRqCtBPU7DBcgsEXDQy0u0ODuO7ZQoS8EqromQkjg2E4Y6uvonAa/bSIO1xC4TXnCR2j69N/vwRYbqMWparJfqrKD2+ghhDQFoVQ57s95fqoakGwtirDHOdyvJkMyrEOWoA7knBZM6E7nYt9atCOKIVibcmjBhBMqU6sNDqO1CWyx7mH0aMJaCN6YkDZytZgC80QjriNchPZGNvIXkb0NPIzFwt7COpylFr2t9qD8tdDD9waXYFQ21MIOHWq/tSDcWg+JwS9/oRZt2DcZPiQInt9awIH4RbZugYC1mEOK2tO+gheooRZ5qFGNmyTPQDEsIzMubY0kh1oUIs/KbyCYFoE4VbC30SIQ9bk91Rk8fC2I18IDV7l/3MNDVvneVrC1jgMDzN+/+iivT2gPO4Q4dDaxrJPCOAKbsc+TEPzCDm/tYwmT1nVNtj93YAgvjOORRtbeNHGV9OUXg0KL9YVcjUoYUA+Gs2/FVmsRb2B42B5pkYe8OsJfA8JVqMdKwShtuIoMhPBHg1ALT5T4u/89K8meCQhYX54kFWwF9DAFAsMKoRalsC0vVmsDG9yrFt2jX9NBwI0udgugFpdoMawda10sVV2/+in4PRSH6wiCto5qvYDArd+WwvUSgreJYB1D0DZJaNGKpCFxKrLmhClf69+GyvYAmxU9XnHuctyAbmC9Mb4DwCgfjaV/3eC2hZmsBSkwaqyOQwtRUDibLdICFF313ULrUv+k2oFGUQtLtKk17hlqUYmTC1SbX+c93awEU6BFAulGmLbDjQX1BgBPq3wPynYTANCxH4KwIQC7ssBGAOzaAqutDfut+77lk0e40Y3iMWs7tpnM3iY2tyG4PiSGmzXQwPo5Mdw8ajMPYz+pyswy+zYbEzzFSZaeK2gbBY4CbW6lIFBomG3bB91Ii3sQ+2OH1RaC+BnnsGONgqUhTBBMWwuitoN7XmkfTDDoNiItPIH/fqbHKSDeR1qE4hvd4AuAhXoAE7TQ+gaXGn2HwbRPehkJIICAKge/lZY3t0gLSOzR/tUnG6DOOQLBtbcfMCoTrQ7q3qF9jsjOatjfRFoYYo9hKFU4qK4hNTs8HtTtOriBX6UiLeBwqs6NZWtPtAjVPU45KkCmaxGH9FzntnhDpMUb6ux4fCWh328gsOY/kqxtqwac4lq0YZ91SQUuSiMt1LDvkglQL/tuAtQrpORBgNRh/K1+nQDVnPeQoKNgn+pwE6DoDjhoBFrYI0uwn1d5DtqdRQjD+h2xQKD2asEOnJ4Ttm8ZgtU+j9CjVO4gW7RYQm0cobxIC3iQg1j+CRX7c3OELZ4W+CiqFOX2l45Ii39UGUyF9vrdIFhZtcBHey7pZAUXO9ES+K5Pz9xAsKEJyzYIQ8CRCSztdIdaaLLcky9//JM9/M0yWi7tTfiJKahVbG/lVp3lyt7SEruLlmt7G5fSLR/t7WzBxUjf1NFkqDzm2N4gDuAGttHEIQxv53YcwS0sIo31xWnZVrAZ0mMu5xo3/KgBBK35hfPeAbsy57uVIWsT1s7tRxPYEl+J4o0JCy8BSWzl9+987OqqHWp5Z+zDeVt2wC+ohxNRHar9U3Y85fSdhBmY5rhHn4IZ/XtgJ2vlLeMf/h3nz5hMJe8/8Rl/mInfs39rMpTPpOO8Uq/L+kXdND5fRo/xOlwu+JHBHxaLxQ48DqGeR9SPIcq09YcQh377ErlrXnZFF9HzGu3XO/3sDjtOu6VH+MRxWd4kWsTROtk5jvuwduJ4bXfKSn6GdteXxfWLh85d5Q3758lpwYZ+eSP99JB+dTi0uNv6Uf2iHTIN6Dka7XBrkaVpjq/keEt1bnHOjuw9zbMOF0/oiRzMgetoTVYc/Qa3dVW25KDwnH5IKlGWe7ypKCA/1SPRO/VEz04+68cQE+HitD8vQ84xntvtqn5h1QI1fH7ajl2cXXQoGqhdO/DwzE6lbynPGRJ4YgcuUJ5786j1MGqxn5V+de52fjUG4a5mfGAfkTQuxcFHcpaYSZ4a9YhMZv6buwVaxMckDu/xAyH9ADHG13nb+FWZvw7HR9C+rfJzh3ecw/VLz2DyOBww5Jrnk1LtBPSOfpxpcNIJCyJoET0yNUdk/czPogM19Gmgre1QlyWcMipvWfbiKLJ+0JjRQ7Xv56bKxfnhi3YAeH4KZ/NTNJufFrP5aTmbn+LZ/LSancIZO4l2Ws5O8ey0spsbfjgm1g/HzEPtTPT8FHpzuhlkRh77p2gojEThYihciMLlULgUhfFQGIvC1VC44oVD56LvoWvR89Cx6HfoVvQ6dCr6HLrse7y4jw7xibherxUh9IwfUXbizN7K0Hs5IvnUVfzxqqhNry0S9aGV+rfJ801i6XMlUN6fQqlwQU0ykUEklzKKF0QyQ2m0ZOOYnZZSafhIS+PZKb6oC4Er5dFKLiWOrRY+zQs8xpucHDEF7JvUctX/5Cq2MCbg8soPEX8qspK7j/XqsX55uLAOpJGE9cv1ylll5J4gfCIx2Bn5z3BwO8IF5FqSwyNeXOd0iZDjA09zwBw0+c2r6AJWrqMFvJLtzZZrWQmv5rur5XpexAHK6nuD6sv3U9ZheqQbb1lRTxf5hEC+6us5GEQFBzzXNQwoKnqKUU33wf9iQA41HLQ4kxPTsgGgxXWT0VQryuLsipTKflGmFqortMdVsAk4zvacJLhtBc5kvVqkV6RUqjj7QhXnPl5GCceZlYdKIAzXwePhioYaFRstUVEt42i14aj4nre+7hGt0sX+ipRKFWFfqOBcreJQkJei8jhUoc1yuYyuSK5TMfIyBeHjchEvltf5/qhLhS6cDJ0XshoaiE6kItaH2bwX2f4oBGYCpYdDkD5ekQQl96HKzWyehDjaL2gfVIBABxucHvggFEn2v22o0SHdkIXV/igEajULSIKSO1DlCjRf42Qf0z64gAGYKMUpvqIBSO5BkbPZGC/3m/3mOqfn69nn0N7S9RZ4IxzZlqS38QJPWnPKKYOk1WaVz8657A4DyBdWuVflsyr3zgTco428oR0HDa5zelDsXNIjyiL3BYsbEOvfDqeXyfF/WsDeI3RYjpn+8uP6xdn4Kc/g15IeKQtGxMNqmSGO65dr6hw9YeA1TWdpd4HfXa5pZ2ZcEq6RDcbh5tJcirZ5BFdeoY66oX61vwrA5TxGDQPTPRQrEA1wnmd1m7U7yNdo3at0h49k8CzrRYo65FdNdsxKlPssB8ZMzkvF1+0nnNc7W44qjzmTrMzo8fO2kHz4Jvi4s3qwIR2GcO5ELT1p4UnXJvoSZD2PB/3vJS5r/4DYq/JtjtrOT05Zns6kitpSfpYbGDNBAuSrFqmE5zeTSiiI9sauJNcaidEQxhpd9gfrjZ6BCnAT/I9/jYJw6f01CP4t+PE6H8D9Bj/jppUxzOtznvNFkzrtQmPeBabS9i/U/USVpKQIMIDI0MYLQagDhyAsLJPG5c0nwNg4LMPAzP7RNTQWiXSMzA4gI3COywWi9OIalZrL5keqOx7Vox+vKE0bsniwvjjImS0s9tadQ+3PuMyr2Z+rEiXV7I80bo7a2Yc/Vucmw433n/j7hyG7GsUlLEpUv3hLxX4Qm9SvTtZRvMTQ28TmEB2WZlTq+m2fTkNtW7EtNKQLKdQl5TzKyhZ3XuCRqI8XeFKQeB7FD7vJkIRgTyZazghIg3qam7OlZtITMtE8eKpl67vYUPusvVzK3S4mBae/V03KUg5t6X99lOeskHg5XkZ+Q/KLyR8Qa0ySBJBq3WBP0ZoAiGkrYSXF79YNpjTtXKkgtW6D65w0a5OmynOa0ogEWzlDFkt5deC/bhnYdU4mIMqk7HZWYxwOMuAwUuiOgZACxyJm6IuXx3ThYDbYbCKwwWZtaRBGQQC2CEPWZKjwD/k5S99ttPOm+n5R4Hy5KQWkJYSE3M+PfjgTT8HwKJVG4nF4WoinpXiKxdNKPK3F06N42rCnIvXD4SkYHqXSSDwOTwvxtBRPsXhaiae1eHoUT7zrtvDD4SkYHqXSSDwOTwvxtBRPsXhaiae1eHoUT7zrl9YPh6dgeJRKI/E4PC3E01I8xeJpJZ7W4ulRPG2AjE5EV81gvFP9rv/AAQyvFwMV0WX4cjOUhv3cDOcr9r+1VBvw2sfFfMH/N9RuhB0Yyh552WoFoFvzyvgRwLbqKyXqYl62hIhb8soFRNuCV0YSbYIBEG09HyDS6OInjC5c2kGgV4W8CmQiAwk4CMhJCrLhEOtYq3jkFSBPKcSaQ8RW6lc9hE57zCuWVtKXHGJhpXzBISKdcsEyK+U956yE93xj1lrUtCciEDbXVHmQmpDVWMRBIAIGYZFGe/I3DEAVRnvyH1m5RRbtyV8zAIso2pO/4gA61TErX1qJXjKAhZXmBQOIdJp7Rllp5vyyksy5pciAfRInUlCCCbIwepBQAQGl0oMGCigoHg66USDXsQHwqACAAuOQawUSlByHXKmQ5lhjBWDpGOpSgVw4RrpQICNzpJoIHCNVJeEYaDA5tPUPWyAYTo72Yjg5SobVyVF6rU6OdqM5OUKE1ckRWq1OjgxJd3JkwFYnR/hidXKEfbqTI8y1OjkyVJuTawurkxNVdicnQOxOri0sTq4txpxcW4w5ubawOLm2GHNybTHm5NrC4uT6CruTE3yxObkewHRybcEts+HkRI3VyQkIq5NrC9jJtcWIk2uLESfXFrCTa4sRJ9cWI06uLWAn15dbnZxgh8XJ9fWGk2uLUScngYw5OQl0zMm1xYiTa4upTq4tpjq5thhxcm0x1cm1xVQn1xYjTm4AGHNyEn/dTm4A1J2cM5TxD3oDN7wc7cXwcpQMq5ej9Fq9HO1G83KECKuXI7RavRwZku7lyICtXo7wxerlCPt0L0eYa/VyZKg2L1ekVi8nquxeToDYvVyRWrxckY55uSId83JFavFyRTrm5Yp0zMsVqcXL9RV2Lyf4YvNyPYDp5YqUm2bDy4kaq5cTEFYvV6SwlyvSES9XpCNerkhhL1ekI16uSEe8XJHCXq4vt3o5wQ6Ll+vrDS9XpKNeTgIZ83IS6JiXK9IRL1ekU71ckU71ckU64uWKdKqXK9KpXq5IR7zcADDm5ST+ur3cADjBy0nx939QjNtwc7QXw81RMqxujtJrdXO0G83NESKsbo7QanVzZEi6myMDtro5whermyPs090cYa7VzZGh2txcfrS6OVFld3MCxO7m8qPFzeXHMTeXH8fcXH60uLn8OObm8uOYm8uPFjfXV9jdnOCLzc31AKaby4/cNhtuTtRY3ZyAsLq5/Ai7ufw44uby44iby4+wm8uPI24uP464ufwIu7m+3OrmBDssbq6vN9xcfhx1cxLImJuTQMfcXH4ccXP5caqby49T3Vx+HHFz+XGqm8uPU91cfhxxcwPAmJuT+Ot2cwOg4eb4vUCuyxj5fZTia3JX1dtH6Vse37lCioYNWDt9H3l3AraW086lo1LaySlg+yFr89SRE39PXfMkrhqSik6i6FBVnQYligYosoFUgxJFw9Vhj/btF9rBtq6qLUea0jS9Al1o7dl4tZ2DEYiFy+Zzj217yJp+G540HjeY4ERS5UQL6lF0bjiVs2qdDeU46Em6AWsbKIrwmf5Xrge55c0t2k7Pb7JKP6nKlN5JC+gYWHkCKg29AyuhloYugpWDVsZiToi7tuCLtnQoaHhA3cmsMwcH1AHtzKEBdaep1N+EjU8ibluigWdt12S1NOBt2Z386uB3rzX+VKXpwwXc5bY5bHoMdIv60N66JZ5urWLm1kuq/OckR237+5+Ief5qnCBU76tLqvxclDu2+Ke7yPo72hQsM156ugk3znMZs2ZM5+zsI2BmRc3JNMDpvD8yqdec9BquMEA/eg1k1S3YgH64SgD96DWQX7BgG/qxSxxUE95qy0uFCjuhTjOHyj2pJGowwxAYtA3qpEABg8KP5M/QEn6eBVITUQXqCa+FFEWv6vUB6MuoAnXFghDqq9cJoC+jCtQXC0KpL/uhIVgXlCNDdpXRwEZ0RiUTUBoFnV1rxs4zpQHeJCtDbbLyUEE6w8pBhSFVkLYo5b0+6PjVclBJIDwG/l4HdPxqOagYEJ4ev/2YFyzr4ZyXXR9kmBFlkEgDNGFAZFcD58GzZIkXh4WhA/wsGaQGogrUBF4LKYNe1csd6MuoArXCghDqq9cBoC+jCtQQC0Kpr4vjHC+kA8pxPbuqaGAj2qKSCSiMgs6uM6NnCdEhShJDbdgBQUhr+hpQaVglpDNaTa8XZj96DagwMDagn14nzH70GlBZYGxDP/aDl7AOyOcu7ZqiQo0oikIioCcyMruajB0I3SeJ0BIpL8xF2pI8D8KPw+mAF2UjP0sE76Ey9T4NQYj1ak0D/gZWa4yCbnKWTiDwE4p+0YpDiPxgDykiFJwyGkRhRxX2qNk5338GGp5YiXnq1AIIve+5gE4OIPMN0AXkwmS+xbmATnCOALid8T4cjIPKTJKjD7NbWp5ubTmw8+6WN/c5MP7ulkqfF+1c4k2cls6U3sboHN3FrfTehrf2KHH5zoZyjxflXOgtTJaQuKbaSMPTDby6vUeooRa/IQHrQ4bztMXdZfgwG5hpn4IhoVOOj7hMtUN3kgHX21pSuEShFv9VD7hJZxRF51CWgZj8XdlV/VOSh6k0xVoaGXLAniac+5kErH5i12F/fe/cfFIP9F6JffXydSYVkrN51dc+L86SHqgU3OQR8L9uFOaxY60y5kNGTs4rUroqfZRH/NUqxStLuPdzcc67rCbn8XkBkd3Xi5zgTe+Tp52ABmlWsaH+alnzqnNXnzv4gCjl5Fo9EjqerzCO4+v8UDWFn1Rl11S5fSKIU5RSHrVV/eKF0R2d2tLYDaVZgY64PyQ76cCp68QvaUv+Lx/kDdbwmV8rLJA8jxNBhyAnwPPmYdzOTIIMGC3VnhufC897IFFVgauxjI2k50EHvBF6HNzD9Bk5vvzYV4RBNAvX8SxaLGbz1cPuvRBpg9lSu1bnKMEnenVbnwVos9nsqholWfe6DbVGZBVOp7elodEHZ8ZNbYqW37JzmXDSeRuo7X9OM5prMP06U8sbjNKqzF+/znqXOIB66pQH3pcwtvFE6nAUMU90Uladj/K8+o7Ta5/yVAW0GGDdYaG6xqhBZcLz2gBvZz0ocf8pfs4S7NfZC859mtx0GzxcJPwp6vBXhRLZmHdZ4aglbQmEn1cJyu1wRVV2J7VaSY+zoLnkaAOffjX128LTaZw5ACiZsxEMEqUuUEbszGARa9EWJnugGp01EAxnS1+lsiTQWZIfR1iiAgAsMTFYWaKCulmSH20syY96G5MlKozCkvyosGS5oif4qRZRKi9mYOE671cmszldiABHsPU8t+OZHXucHl2Ucszshxw9iYJhWcB3rJgYg52eR1NPYSp6AxeVoprnsrJAMRKNZRmvANqyeiCJrMSgJV2kKikNIoVBn3X2f2b/yCmzfEVMnBKVLofQwHRd03kN5le2SoCT89lN7WeVdig7GN8gRpM+G/4CFKAbjgsTajnvG4C1kucy0IEtWdXQDPJnOnsGRApnhmLIaeoshmAUPrsIEV0pM1YvdZHhAJFnP0SE7OZ8tqNAthLyy4N4YdE2iq1NVQbxDjbUUdsWWpaTQMuudR0AJe+zk/bPGIl2IuMFJzbTD/E3Thi74ez019PBZYr1ko6I7cocHALx5OCi6p3Go3XFh2ft0PTnUHtpxMr6SQMeXzS6GNHroUyerJOR/kI7zp/r4KUlB71TEu6FKxWNuUGQ78LcmWnkFeXROjGWBRblyY+68uRHK8/yI6w87zssrUeHDsEjBdtP0aH8eKMO5UenDi1Xmg49ymwKb2HTdX5CrX/AOCWvYab3V+s1Kam2bRnNY8GlnnATs1jdMDfd28Vf/KxM8cs22kEhIGq5ZSuuv8PsjPzOO76m8PEzLruWbyBzMPkzTLm+Oh8BswL0p0pWw0jG1Uw3JpNobIsRsLZw00htlNucEq3g+2o8aeUJFoulm1rL++bLBKXuhPOaLTS1CroAgMqgPoyXB6iuX48DINJqA6hQGmpZsVUC1WiHEs9i8L926NBBDxhlYzm6746tES/2w2q9D1ePuze01aiWNRylaVWqPN/ZdqztII47ODJMBqAF3w+iq7xRrKjjUGuqvKjTVV5USCqvlkF9gCqv1wEq34MYKq9UgCrPM62rBDpUnsH/NioP0mMJLJO8729T+SRA4Wq/e0NbjWqrynMe2nZa7SCOOzhiqLzcAjdN1egKrxUqqtjXmcrOa3RV58WSosslJm5QydUaQMUZgKHgUjGo3jzrv0yWQ7kZ9G+j3AA1oGqzGwjeqNr4cfm42L2hrUKzVbE5/2z7wnYQt63cMNRahu/rmLT/t6UhPa0T9wsetU1/45KrbXCVtP1iDX/GYPRTHFhbkD9H/i7aP1deOUhr+YSvxuks956ZOPVPtApW6VapWxH2LzcQXq2ZpDc6ON2wMalvCYkHvbOBcIbGTgXed+VlYI6dlCeVyfKpeaWJYlYv9whzsORKMYsWu1QGunXhti57w2t2zGouenQMxgLH3QGkjiD7kBZWOXKqYrG/++rTnDeUrmjQ+QxVCd9mQHBnCJfrF3H0oUyXnG4jkH86kD6wrMWbqQxnfhFyZ2AesWEKdYqiW4YqtNC4/Akgdoo0h2zAt9CqRRXspIehEZe53tlRW7g6WhlBxOt1TmyR62vOsKEG/JgzbLBx3kc3bLgxYzLmDlnLdyG/aP2uOicnHyV0vhaozOpzTu8I3dlr1O9JYtFzbnHjs4Ad29RDt2MApa1ZaBRM3CZkzxNPv7nvu5KfZ5uzjVxSyVYqGR63BvjWAP/VNn/ptEiPyn1XiwWXu34LrDQ8mfYLzM1JO3wW9YsXa2vP0HKVgg2W0TV8Ntt35cgeEzKLzI9Pu0OW02s38vqEPvHdKz+tpH1bI5cviB0v81V8RSpVABkU4gLGOPdd6af4gM55dxm7GFhbUdNjClJ7SeJ9EdcwJ2K8In/6a2hC/hT0pupMxIVS8qeSKumWwN+XVTUun+ZpU9Xk/mq/q47HHE9n1I00AFwzJ7Zew4+YmNRDMrBi26rYRsfdIx8F3E4GnCDTdEn+xvXjjTIFTYvaQT+hIJH1dVtXnSm2YZICSIfKrbOSobWYH014I1DbaVDWI26jRsKb71F6xGP3Gi5YoxtuQdT6jfAqRUsFi8xh5bZEN3p2S6KGPoyi/TJQ0Ku6fAOuKFima41UWZd7/OO6PIldN9IAcA2wT1qNpOgq9ZAMrNgm2ydNwKOA28mAU2RKWTeuH2+UqcM+9R1A9kmv27rqTLHB9smo3Dor3fZJFd4I1HYalM0+TTUVmpXqm8HZdkg77cJbWFPiZP8YJ1rvywThZaJgkVmtXLnqRr9cbtKlrohRHK+iWEE/RalBXIvN43KxUUmVlbrHP67Uk9h1Iw0A1wBDpdVIGq9SD8nAim2yodIEPAq4nQw4QaaMdeP68UaZOgxV3wFkqPS6ravOFBtsqIzKrbPSbahU4Y1AbadB2QzVVFOhGaq+md1QybdoW6zUPgmMryfL1f4xRQMKmcnDpc0j+hfugzTWHeV+lT7GA+JJigwhilYbtE8kCmUtppjHVXicObd0rfMIsEZysaTTErkGo2Ekky2QLDw31HYa1JQVEuXPiODvlpfD3lC8kLFRKrbWCk0ksI1Ra7b2Grd1kQTjAtlOALEalSkzW7covI3domiX6cMqcAhQutS7xhhFi5WCRWascl27Gz1ONutQf/XcPMaHIFXQT9FWEFcaP8ZhpJIqK2yPf1xnJ7HrRhoArgHGRquRlFulHpKBFdtkw6MJeBRwOxlwgkwZ68b1440ydZiivgPIGul1W1edKTbYLBmVW2el2zipwhuB2k6DssaQJpoKPZLEm9kNFc8j5FaUTbxYGhNvuTgskIxECdbRkilWKtksgkj3g+tVmIQbGfkUhQZRoSTaRLGMSo2JMuwTQqITGHUbASa/oBi3UiHHSmXCAdbbUE2PbytCHYPbToWbIEfGsVGVeJMcXZFthh0MbKtVW0eVISpLVFur27rqRmLassDcQNtJQNZ0j9PsgWaL+lZ2W5Rn5beLccIUClIN14X37WbiSVELUrDVC8Y/TzJSnMf5p94ID1BoECQLnf7mnJcZLPV9HWvYv2Is4midGB+Tz2WKmzwrsfNm9ivALr27yaop0eoEUcgXaeahD+Fis8gT+cVJOl7e76jT0EdbSH0MBxLfchZvQP7SSshf2mEA7Gv7nbih3ZLyhjgB81kCV7dTynkc9ueuq8qvA6xyyha3uLPUted9kcmV6rY8lOJL/9E+gNKz8Eqa3MQjY0eNlnAFgnBXs37nWXmRMmQkVZ6jusWCZ0zR+mICrXDz2jVgJc+DVX2/0hRbbhimAqIXslg2d9dxYQci7aBIOmiwy++yIiuP/uFcso09GLVY5RcMMorC7Co98xk5X+hJcLQ6eyMTa91UNW661y0b9ew5a7N9lmfdq9aFA3AS1HWeoAZ3rq24wcB65SKP+sWy40q6n4AkyUpRe8KpXkp3Nv1VBJz5wT/XlifaKwhxFQucGX0619DxQ20RpO1vCiSAApdnyzFDmkGKUjIcNAyDINjJ82U33F+1k+7VWukHmHuGbiOeS0zbHKddJLLLs7bjaTX17WPSOlI4ZKk2z+rtcDz9Zeesc2SpkkqV7U/xAwcYSWPFNvITC662144tOMA0Mc3pLUtUey79AVDpsh8F1pun2XNGkhj1jkPsT9xuqDh002IymCe2UxE/5dkTgrOZEVfk0awWSY5Rs91X3Wnqpkdp4wuUD9QkoV+WADXqemhF/sA1xc6e4F/F2t8jgGa2Cpiaodp4AZpID18B2ybuk3j1AGgbqizUSQD6Guz6dmTgCIEdgO78WeA2R75psG6qY5Zu/+v//BOp+gtpRnb6zv+cJU3VVodufiQzFJfdJ1xS4n46oLzFD1f9VZEaQdXXMxBktZkT5yG15NK9aLt+x7yAOmFEpunIjHIuCfVZRBbRzllE2Ep+DIb/kL3gVDtcLvYxaz5gswmuki3S+WhhCTniQf3vbF6i5z1qfNon3y3tCSQc6pJUZYfLbvvhg+xO9TycptOVKrjfHfpXCB2lQx0W6Z1KUJw1Mm+zcu5/571R9njjPHOA25RKeseYDY9+v3KZmqXIcjbFRCi9xfDTOGx3rd6LfMWlBYvyAg/Ub8fqpbdUqFqKzAykmp1a+oK7kDBfRCIGaYT0HfezxqOh0BCUNzyCraQq9fQIPyrSvwh2VZXvUaPWxlqtN/Qgl8hEiXL5AJkuSw70ZKB7sqB7UtDJNMbKWzLjbll1n+Sc1A+sJEdqgb7gfbiAQSNZmANO7f48B+SNnXdVzeavIEM1Ulql0fPQkckHRQ/15b4BLVNEhmkjSKnT6bFogA4wQWbMUIyIiGNzsV9n0+weFBrz3kl4vGuXCE1FfKOUPEMRDEtGlzUGnLzKUYfx2QBV09c86hkEH81JTLO7uNGEkY4njBREFrp/i2MuLgKGuPEtoeF+iQPYnfzYV3KR85uWOYG6JrDaYFjhOHAEHscyxZWrFfY1xZPu/dV17HAL3c56Jeh1Clr5OjsL+GfnED5PHsfnCawAnDBNFRlq5zcDK7UTrKTDQGq4XAbOZlRI1MJm6qQ60NhNtEcalS5bOGr6bjPH5gC0oY8o3Tv4MBDtnc7sDlwWr/Z+4vxVHJwpZbn/v53bLjtkOFXD6rJpoQV+jl6rc8dfaocvajQqv21xjRrUYRCzYQfVGi2LAnjfZk/Ox+sIGnYoEjaEMLz60ji8LP6cog5xSfMPN+1X2hI+9T8NnidttQNLBvrWfixNrUl9aeS2wUnH3XPwAGeqk98t7K+7TG3siiFhUS9elaQ8LUUpkDADoGtI4ydF0603hriQ80h/j3Chp+R+0nJ36LVAxg4XyL4rmR389TNqWkZggTHHMQEQHM3U1J1O+hzQNkonNulp1vOGWsixQplkTALVWcZiV2peRZfOkdoRnWsLd5e/Ru5fC+kWmEnKNmkYU5MMO+lzQN+obDaaYd0wyLFCTVS2trhR2TyHpgGaJb+vDK7cZV3Hl4VApze3uvf1YMJw+7XJ5JwiANbL2E1Czm9+8oVCZqoT+FqTiZcJAbQaKcWtFgLYVWPDNmXPEbC7yEBnScnkhFMu5vJ5tjTHckC+CNDUTGu1tnDny+IxCGlVNwKsvZ6Y0NKVcfprmf7ONNr41w7bOvlq3PBnF5b87mfitNXaBWF5IxtvDoWbR3g8UYITYB3x2BuCoi7+6Rdb6kYS+IIlJncAflkFvRSwLweCo7Ek4FMOBCsCumAl/0AF1hmfqd5rtuspzADS79SVgdqdyZ0SPWt574z0W9oWItrmKc/Gbtjp4Z7QGKT2phPXL0PbXhjit2NXBOj0elTSzgplg4ZRq/bo2t2xe4+9GZQCj2+TmMk/JEJEkfWSZ6wfcGFbXjj2Ej3777eRqZfFU1YcL0MQWiiH36F9e7Fe9EnvbO7BiCLJW98U3RMq2oM+IXWaOPZw3JYRzeNXc2q9QZvJCa899h99JNIGJ6hUEqhZpygXWdRxPeJpbpwJtDTuahYdVjlKAX0YwoFSqES/XNbSZBCfKn0DapCcdF+rsW61IhjfTjJE8ZxbRyxjACKRQ/hx+qDI3pEJ0MPOIGPlbRu/qVk2CF3LrHCybuvz8w4ePiHnlJ8y537jQasThJ6lYbTUWZ5rlkmtGMaqy66H+JxnF23rswqgDc4olkdkVk5JajA4AL/tUPINnq1DlUQyzTxtfoOzWYvruFG41xb8Gibgtpk/ecJLrLFaz/sNgnteTJkTv4IReG8D8BsMEpz0Hdr7fKMkvX3dr1Gpn2dRYBjqi7ngpUToGqp/2r7n8x3bxwilkB7yJMfmVYh016trLTS60xKwdKNt+t24kv3sRyC+E+lbV2Jj6wop6Vel/suWnkDJhxM1oqpNmirPyQsETc0rcdGy/Bu5jSBgG1iiOJ71/5+HD7uboI3h0kNTPcWvE8yUwispw72yi3fydhmdGuPQFlXh32VFXTUdKrudFBuWSrX7CqW3Bi6dAXaMA7zBFd4+rLXVNhl3VW0HYRn9QZixCxHfjRj6WiRuCAvkDc3opb+cmd/J9RjULw/sBueqyXDZsZfJHJVpm6AaP1zU/t6HqiggVF1pFm+UlbjxD/k5S59MtDYINseleldbrdVbE7b/M1AdaMujq6mAF+W8l7aNjJqPcRsgYdO/VIDqauqBfjxB2SUvn0lbBOPkjHQ1QiIBUc4fqMyAOrkIW6MHphQUobSWICj2DSpTObQg+0gRcIp5wMn1aZ1ddSyjlRYdQ5k9MKU2plETdeEwxvSnQSM9BdfMBGDqroJdzKklaOr3kVpOG1BdEfza9B99pBXN4/BT8uSqrAjMHQeUbs1qrwzJPKypVHvzLKlKnyxwoLPeUTTcQmh+1grrFyu6zwNi9Qrt8bnFZaGsO0U/JFLLEG7X5K5LzxeRUl7Ng63yjQ+BecdwGED6Lbunnra1TJuIQnq2Q6/MUJk7uOiqRbkdxK0KTOi3r21sFHr6IbHZWAuFkdveRtB9v5HGc0d7qw1xNtNsixvWCAdL+VjG1Y1olBF73QaaSulBELuyxYCyKbaGfKW7GMF+pzHxLdbEsGjjq37by+mb3wxm7/fa4EQ1wTlXTfFul3CZON94CZcVofsSLqXZvZdw2ZDoW13scMCukGnA+iVctlaOS7iUJnddwqViEHcvKcXvfAkX2GV/CZfZseUSLhgLvOMDQHrHJVwKlhsu4Rp1ocbsNKKgVxuo/NXdiEBOMgtyCEG22oH5Tu96y7ndPetOwx4bC94QGIPfjZ1d6u8Rv93Jjn5t3pXy8B8hRycD90mUpDZhAF7eaLR6aZVWS7OVsoDGL50CD1wRGU9a0BJE5udd8z1BBPwmaDOBlZACESeK/yK9wAwwwOpCbfa/VSTqXBiUGbjlpY8oP5I/Pd3kmvzprbW3NG1bghVQWyLCMOr3ZPrpfnwfAoSOynACaWJNeAPsyEi0TR+uW32mjISgUz8rjEJNINDxCd9UjhE9oPjkXCTT4KZQadvHQneA3qUXyrYyjq3fEWFvMEIrB7KmTxzFL73Xm80fHx+tzY04qg5AvehN05oyXtrZMwIzRYzKPqApqja6KgG6cbzUTpvck19vb2v7bmYA7mOSbRhpeu/43tmKWDqZZlpGG989yLuNkHWs9ND4BKU0U2TSO8F0aHEO3YVSzyBqrZ/en5kndAKkPW/oCMU3NdEFJQaRlc+4aTFgZqNIT40ePJI/vSm8/tmk5M8Nq3EJhhnfJAOZCx2XvP4ZIU1fAk0EHxkMvAq6ezzwKsgJNYHAm7YmjWiDbRU0AjeFSpsBWi6XJp2TtANaBclTHW4wQuvIKmgcv3MVRJNGW5obqyAdAFgFhQH5c4tTWwU5YKaIEVoFOVVtdBUEdGPzYVrGuZsMneMbBd+efc9sGV+vjVuiyUu229q+m82aumS7vem943tnkzd9yXZP47sHebfFtI5VXl+N6KW5AAFtl75qs2G1LNzM+uldWhduLsjRhZuN4pua6OK6zvcNRmnSnIu9+Ar3qH2Ek7cNTsgdTPO8ghfMi67kTbbqhx0F5nOebff4UDVikxbLtLSTXhFELssvfw0CFHxQUPR7MuWVe42OWUk3YsCfNcCTT3TwHrgZd8BnjkqrJfNVLWhr5M7quDMOBOvntUbT/tI0t1OT9NrOj2gjUQ6soZmjko4Q+lzgiq3bEgup3eTITkKOVAruDNqbvQ5mUy9mMxgQMNyA1mhHB3fqTRNTznTpspG8H1QK0KL5LLCOEGutcOAEBrnYSbo2eqAJvEy3P8Ygdyj7RLgcIlP3ZJZac/hKlROzxALpmOCUE4HATeeatEnOEN2s8DJmWe5KF2P2YJ/uQL0+3+RZvHLO8ZU62zhu2zQ3q50zfeWe6XrfbWFylpUpnJ2YFMVE7WCpWe9i6cLJ0gU0LDtLjWonSxdulvZ94+bidKpmqgrjeDHF4sH+lVbwcbBnSrTLuTOxLesX56RzH1ZhnwmH/iUj0Jfcfi6ZNZ6X+KUbRsR+0kFJ3y8FcN3g56w6t1IDUSQ1Ypu1OIBmLdUidSSwjVQqaC93GLfrnO3vUEUlhDSPcOHNV+Q/C1xIM2wdf1QSoaxtiVDEdQCKdo3nZ9mjFlNSVJHPoxgXV8So5lzqf027hoBzhudX4+Pf4qLuXrWjRTSXKWOPuT7sTw1xBI4vz3TJqwD9fGrwQbyZQFXWq13pF+MeHb/F/mI/ZajAQd2qVbZuo8dVQNLiszbADeDKvdQaHNStWmXrlt0M36PTbwFWbq6VgaAOpXJr+I7e+dwjAu76VG6g1OCgPtUqW7fsDlihIcZdfspdcyoYqEdyja1Pdqvjld9mB28tG267kbfmk1zCa9PX/qqmoL+MCJpZukegu2zosOxTmo3aNaWNy8x4o/5Osx6HslOrv+DsijgBvXViv+60TsQNM1KyDhf9or4nZziG3NegJ/WOwn71bjH+Kvq+reLjYJjPHFTZiRPrx6kHcuT3T7oq+du52FddMyTconuaFsDW+IW5m4oWsYFk5Qk3WWdz56Ibb34KZ9LPU3hREMig+h5A7SxKFGoqHwWB1Pzp1MjrNXE3Mfm7ymdGRIuZeVDEZI/zQCaQkEsaucB+0ZJT8wB8mzQYl+ywm7nBC5bU8tGU1JJujXvjCFeBPsJVUL+4JTnIZrWgW9u707nYlyjLLVfPLG2xNThryd1LVPn2LOkSPwbkzaPWw6jFflb61bnTLgK0AI1CSKP3aDaY2VDAk8NIc1Y+BzIc1UdDk/4eEalImLahBErJ0q9CBnLmCapp3E86urSTP5+hHDfdRT4IduvJaShASLF6p6W6rVWb/gyI/WNe3LomU5zWPdUz/nA29nkLkM+1fvkkR5xmbZG1dNU+U4uyvXFxwAJu6M2TvGqh9rzG5tyIq2aYfWrDIA6I5V0vlmS9WkBvD+nhEKT6jsp0hTfJSkPlgQYx2eBov9BBZf73q899vCSrFVZDl4FiybYOHuH7vXF60GNW+wQ/HkIZD0wYWuEQK/2BVC3jaLXpobTr/h/RKl3sIbuRHB7xQiPsgPA+STRUMG2HNQ73sQ4KkLdaxeHANPWqb7RZLpcRRF2U4tS4k32fJGmoYoKJw8v9Jgk0SIC2x+UiXiyvf+gN4zf8emhQgVuvbqpjg9vWZ0dsm6zG7eXQkOOmA7FCuZc0eHHtKrCWHDu9/sGvflX0vyLueY/xIp1Wg6zheIow2wehsYNVxrWND7vJkNe5zJGLkfpbXEZKE1s5YnfRsNazvEJYY9aO4fnSgS7l7supkIBXpwPz5ivmjTVvrlVaa65zQJPS2VDKSzyVv8Zpw54+dkWwLy4LXMYpPs6Ag2zxgxfFH2eSKzV+x8FHS0t7zVrDof1+MA8Z+9X/C4n+p6cYuEyWzjdqiZaBGohVa1SVFPfK9YW8QFPIvj9UZgV7wYUspBf1l1h7WXnIyqyj8+b2Rje30OfZaFDLPQEhBP+aiP+aiAbFmt6NRDVHlE5v/S+N+5fGjWnceGR7ROkABP/Su3/p3ZjejX7aGFE7s/2/tO5fWgdoHY1p6weleTF0My6/ZoLWz9g//r5KXy/6i/UvFfkWcJVBRCyd5m3jNdX+bzjp9DSUct08K47+EKPWs3UzUBqp4wQ9SVeSq1s5QqljUqA2ICX6FwSpARnDzGzM+wGSsGqf5rqq7lGxr3QXS9aJvjuaOkyDYaU9zAkjQuzFkdsilkactZ2+tcXYujJ8wXIn1WfpgLTvXbenyQfSpd+2z1OjYModgyNZEUyc9swVb7t5Euk9zdjNeAZbh6OUZhtPL+i1woLMCi+f8kMmX/vtI1o522MDd8UbWSqNU6JTN/3SAN3Vwir5Bsgh+MV2E2mwYkfQzFoj9gvY6u/bKUm/ttpw2gU6QuY9DSn9YyohPnpMJ5nw/nZ6R1pZiDXSO8AbAWaWcpuMea1lz7MrY7ll/y/cwQ1SG2nwNG8LlOe3t3M2u1m3JjUboXWstbuxW6nvbDZG8Uhr2nhsPtkEBc8LN6ucbSbMpGSdptjc9nLjJ88rsiGwuAUYvx3Nre5vtJ0mI2vHNo8o6l2eUUMyAqQewrcyPKCfk60EDXsT3ABjA1N0aIT0vs9JUJOYoZpltzHmnFKNMdMnA/0Nn8qvCGxtI9zEbEFwsyo7G43qMWltlTWtdEpYau6CUHXXxthkiReHhYVIu+JKtc6RTFJZuatxkPGh36KpnC+qpjJdMXDftn3iimwIbLSD+O1obtbasXajissRWCXe1zulrSIZAVJEaGc4OkRJYifYrscqwNjAJmmz1uckqEnMuEGte04pas31yUB/07abK7K0txEPYbciuVmlR5qNajRrb5U7r3bKW0HhhlEkZ2U03icJqM0Mi12ZlfqRMU1SZbXDKUBT2HCDHvc8UvSY65BV5mMxOXDtbV5cqRx4JAeiSiNjbhRMD5o5N39Ce4DYlqVQ27IUqNt/rECcYBb/lXeq9hU9t8zA4JRc1+NnDKFDgFrf4LVcPCmO9r7G2nVZl+OLM5+wtEVrZe4dldCIt0qz7AmppdpPoB1vBlN9qKoONxCjR7bAqenIgdsZRw5ZAuc7S5w/SRNg1hfRf0QCHxnE3DxsYDHM7wS0RpuLeSPHzrhd3ehajjp7rpD0BJIm47oo1yi9aSoo/efI3n2ObhnJNEz6VXPvo13KFP98iwpM+YTgTOesdX1HV3zXp+wkPqvTGAK2CKRj++d5LfDLb3BbV2VLD0vREutsA3F7/KCCihUuNfvytGMOzrM7Vxsy9UpqWgvOO6Pm8saJcyMhTx1xhWpJY6f0FsRE4SYjfgNNN/X6z8Zer0vfh9uj/Zx+E6m+23hG+zm9kzI5KX4/Hr8fF9/CJ4f6/6oqDvjpX0PD36ObCaL5Tbqxj+bduP9u/H03Dr6BR5e7zPfQvqfASppthXjvivAmWrh45IJmkh66kZLV20Sk9xIzvb9/PrZO9mZv6uP0Hn04OftO4xjpw+X93ofl78TVd2Lc3by5/GM0+e3mYpTlv7JFep9RjIrq7SbQ6ePehaPvw7R7+XIZz1smvZ+TQX7W3vSNGkl+Ko2fJViryGWgizVQqE6tKWun2a0tTnLvgTaXWRVOx2IQApAjsyJiBD11zZPTwgPgp0ngRCVuwC7Ap2En67sbsAvwaQ7zTl5NQnAvBZP4OQnBvRRM4vkkBKBL62/8G9dWqx0H2T0BWuLtLdCTcEtcuwV6kkO8j0sT1fSu9lM4OVFJ72o/hdsTVdR0Wf29cyNKpxr5dHYT+GlMNaZiB8FPt6uCu7+bEZxuF8b9FMA80L/SjMozR/bu3dCnMSMyETcEfY8sXb3d2v50+7S+u39w/CNyHPBZjrqIFuB3WXYqSKr3wB0Llsz0eqvPamORHcqEFPsI4NGBsK6PgrOb2t2wFFYxGh/ztoEDTu/YAzo2tkvIfYu8q1CSbglA+3rqvK5O2ywwFedEJk7G56mZHHui4HuHQEQwj5+sPAZIEzlmLenfJRgLi+84VeJCeyOXp6D0bkmZCSK6l9EqdSKFCJhSTYGxqfNdydpcmG9V6gkoNXb3pFn2ultw3a3aCoEsfwaYJW4AsPH6ruRzVrQ3MnoUn85lTpRlnzaE6F4Wq6SJbBFgyjsFxsLo+5LpuTDfyOspKHVjzUmz7B+24LqX4yqBfaIEMIufDGLh933pAR2Ib2T3BIw6tzlhll2uMKp7md2Th4s9TuXF5dhBcb7ndUgYHeip+0yknlHCtwEagLQAKM9ofkKggmUiACqesxRXw2jQvq3yc8dSiAY7xgyyj5cdoZcOJ8sJ/YaVtTGAcLV/3Vy01MHxah7FHwHo5f51oQOvCeR3nOeXIiuVPIFiK+jGkjnWvdaTF5l4Qf5uT5o4sq94BJSNy6Ma8/dz1QFXeasTUMtLyNr7+bD9OFrC+aEpXFsoqXhVMBqFZwllpYzgrkTY1tzvQcAvGVF2VwceXR0esrzDzRbl9Ql9qmqUZN3rT1HwsOPP23nE6ejPNbMfyjZ30YM7pTrcWSx3Js7hs6H34kZ1jVGDyoTfuzbMYq2HQcdIIk9pJhRVinK/qnF5MSY+rRsm3SF7wSmfcTzaZMy8/vx2GMTBTs5zb2QE7YfQl/tt0lR5TqjvqnNy2lXnjohNEDk/oBR77NlPM5RXxwuQ8FIpOlQNuSaEp6I201n7lQ1OArJh0jtigDnq8Kdg5kfxx4edX7Tu+spZ7ajrmZKVLpYYbQMXTYGDoMBGTfAgKxEnRuiS/9LLW5S8smzeKtWmn2I2XMoE3ieJoc34/X5AO/iABpBpMsmzejsY8Zeds84wxZvNxiyVDV/0YFq4QanhMyDkKouNZoD1IyAwTM8YMoi0qep7p+0yADgYBIGOn87GC2i4JLtlNsvKyxRrx5vxi3OV3OvWCAy9h0Zr2icfl0JbJON4DyQfMdkGllT7Ygz0cI2hcOpxGwaqHwIhdEtpUpjPgmNY6igYInpV52fyHyPAJ2fJNwjwhltDTBTiqkioDfX1n4dHpZGQKjPZe9T4BUbtucGWFZq/2WyIK2dzOiaLHs7lWEkWzfANVzAb1z4oNoNVrYIhBTW9g8OTzUtvJ8DZFvOjOc7pZgESLOhT4SwIHVeA9s0mkmjPe7o3DH7eVVXeZTXAuWFSrgNt2U7XNwdUZPnr9sO/4/wZd1mCvP/EZ/xhJn7P/q3JUD5rUdn6LW6yA3hFTp+VqilQriycloFtRugpf+TfbYeaDl71yIutoWBwK7Qsx12HG3r5Dpk6nK7vVZP6+wajb0oJBPW9QbUoUC7yYSMcM1lszKwvpkpcRFbbtZGazzcDfFfVyo2AYrbSOUFXsj2okkSNX2drXE/TA/OUZVbUCrCcbQ1ArJDhZ6V6IUsU6BctPd6e+JysgoHvEH2fqGmq74D6aynZA/UVAzieyBSZmlFFBp7Wler84uCjyhDJx3B7xe5Q8pTDSLJnlPqiOPQOxUVEO91s899v7JPiHxmlmQLu/j7B/uhXo+DjTs1iR0rtPSm9UaxgfxBP++76Fc1of0Hf407a0gF2xxgEdThZZYzO1HAN0J1vG2S/aJMkCA/yjj6tgjS15v4+66qm78S24I2xBF1pb46SOVqv5HhK/fIejnD5/1FH+Fu9KJHr2G9/UbIv2IL6xWw+Achw61wxqZuWtZy9Z/a1zDPL012t585YQqDWU/+rLrnlev4KolyyP1wEph5xt9xMuyZ/jteiPfnTWCysrzfM0OGleoi+UWIExNOcmoiZ9nuLDh04wdVF69scutqldn48NInkRPExbT98UC2XKqSuqgfEPEMqMS+wuadVpo8cIgJSqaKE8YNmJ4czxQYhnP5BnjtDiXZicN4HgB7pnnBbd1S5+5ErHtwPpS7ZtJBGrfhqadxyuWXkBLubEHXkbKRs4sFDVoiRBg33xNDKY75F1L0Og+5OYoRSoXHCQgsfNg8HTJe2kyS2PUAyRbqw+dVrE6St9SMvn6SBS8XWYUt08EHzs+jDCtk64lFa6IgT1FTnFrokeajjLzu2yCP9YmSExNXGT/ORbMzUkQNB6vlKuR3Ro2sHvxoFGamH6XviFy6CdeTuRWVt00cwUJ6zyzfFssRfpA+zT0Z8mRRfYMZMC8+vXBdO+pUDFA7S6/ikFc0BJdh/ztpsn+XkbV26ncxS1beucdPWmKUyCgP2WmwUwfznGZDmPHc6CFKSrER8JeyI4C/ST0QvZ7Z4vFzvJoZlVgch6gY/T6PFHyPGn0aNgyljhPL12SRyAxeprPJq0mkjsaSJX/VSyjwtyz6M8dLHUm9AbHuHkq7PBdFd+MIVqh/E7dsRWMRgSMA2WLYMdvUiTxKQXOIMmiq/dRNALF/kN35vn/lBWL9GcPVgLsKl+uBh0udcY1yMQ5NvEqHyUEPSXqAUBEEQPniEidOu+XgrRk7ogI/in9G4RlfVMxZsIE+Hpio+qT09zLrqk9HXw4S7PrrKY4Z1MulcPHVTHbN0+1//558I3r/0RmH+5yxpqrY6dHPRB32V/yORdNs1P/34w2PA/vfjzMNlKlUEQ8V/543/8lrjn0JlIA2uMeq27B//BdAFNgv62Ja4Anl3v3owBgQ6l96gHjdifIN6MF3QNSS+Xz2cpL9dPQKbejy+j3qIHSd6+bT7/O3fMMTHaflrht6LNz/mr/UpS6rST074ualKX/MODkh9ESRAKZTm+tRKhwckbzJ9ADEWq++sZDEfGn0wIzxvGdhAkOW9cXIP4wzpvx8MffQvbZZOJrKy/8QzfI2lz3JIlQVL1fcDF87tHh+qBouoy49/jYLF5kcnkWAb9KOyQkizBHVV0wLi7wMkgfwOL4LJ8a7/SvxxB9+zw7+CBR+BxYBxaw9AkpdnF1DhhjjTsAlxCOyF/Y4AQmjZ0U/k9Yt925YUUv/rZmwBYgZhpbAIjwCG+vuKGFC/PuUDiKQBRHJk0nIYYRB1n3DOkFmfeu7jTt4HybPPSdIT21LptJU3UyobKN+8oDOJprsgLnqcvn83bpMG45K9HpubFP65DGa/O2GQ4iLQYz1i2tNF8uIdreOvZxTdppCrXsPNGg9qRsFHXYEWtmlwkY/aXYeNNH5/U9jTUMaiWLMRIGbnGFRXVfkeNXLDvqgHS3KMmkP20sOI3wKgKjuUlbjxD/k5SwWcVmyAG4ACJM39U9Vkv5CK3EsFSqO8b0DjP1IVK1B44gLp0cj7j/p2SpkKyLZ2qYC8rAcs0XNfTx6l4j1qxMZ6CUQp1sDV/tRCFVSFEZU1Og7t2Y+hqt/ZP9SLkh5IBErZI3eYylV4cqz0Bm3V9NDQuREVsyrIBOFDcoZEKonRIi5QMKokFN6bjJaYyz8bEUZs91V3us6ZI+H74NRPWooRkvaq+uKV7SpfkijtWP9dVtRV06Gyu0q3IjIA8ijXn7J0kDbxQ3Jle6q+q1TJtVlJQ5rkEkQ9tHmdU/dGkRPjvw2+BB7amV/jjK/VhuM3v92RSBDrxko4OhyyF22b6vUPftH6zxn+TsC470rxc5Zg5mSvcz4ePz/OxHORDs9tMTy/tNbeBzRMsDO5hK3jgCIdtkiBEr21KNJh2wIo0VuLIh32pQVK9NaiSFNfjR1i16LYarFereliBmClrmTU/kCAtEIGbKxQflN9lyFTCXLWneztEpznUsNJI4Gm8s04GFu1pf+dWDSC5EIQo7bk5AtR0c9mEyr9tMVE2UmADtm1xVTZtYUsu7aYKrubxzdZordjni7ne3HfLX22s1jvJww3G6WjIp0ofgnQIf4inSr+IpXFX6Q3i3/yAG+X/3TUdyjArcjv1oCQbtCVUObHibKWAB2yzo9TZZ0fZVnnx5tlDQzldqlCSO6Qnx3NrZIybD9bFDlWJjfbE46xLW7AOKKgHGWR3oBS8Iw3zo/WxoKtdZOV3ciahMFYmrh1XIV1qDkAaNd0CiwrO9Ra13cVeNJiDBr42IzQoDXVv6Gf0UkDwt8/rntmF0fE9c2pS9f/8uX3P3htdW4S/GdU11l5/D//j//4aV9VXds1qJ4XWTlP2nZeoNr7/Zf/ZwBu6zj1rNkBAA==",
 		Length:   121260,
 	},
 
+	"data/expired.template": {
+		Filename: "data/expired.template",
+		Contents: "H4sIAAAAAAAC/8xX3W7bNhS+71OcccCuIrMuCmzrKAFFl3bDMKxIsgG7pMRjiylFsuSRE8PwA+019mQDrZ/4R86CoBh2k/D88PPH80dKfPXjb+9u/vx4CTU1pngh0j8w0i5zhpYVLwBEjVKlBYAgTQaLj633SHCF3gWCzQZm3XL2/rOysN0K3vl1exokCVUtQ0TKWUuL7DvWm4y2n6AOuMjZZjP7PRgfcKHvt1u+kCtdOTvTlWMQ0OQs1i5Q1RIkPQO+j25lgzlbabxLNBhUzhJaytmdVlTnCle6wmwnXIC2mrQ0WaykwXw+e/kvbGC75VWMvHSOIgXpZ422syrGgRitDcYakQagWAXtCWKoTpFuI7/93GJYZ/PZ/NXs9Q7sNrJC8G7b0zAOyRzvF3zImSidWveQVq6gMjLGnFm5KmWA7l+mcCFbM9AHEEqPnimUUlsM2cK0Wo0+h149UPpVDHs+iUBL5CzQ2mPOOoEdbSO3XBqEyhkjfUTFQEmSvTpng35Qy7BMlfR1t5uBDFpmeO+lVahytpAmYq9N7IMz408dUAMQ0Us7kIkhc9asWXHT0bFypZeStLOCJ79HtqaSzHbw/5Wr4F0oH3SCK706yo5W48Ef8tkFc8j9GNwD9L3U+taYzOCCjmPXmr00DnBWro78do01eJYBpapC25SZJmxYIeR0/7NClP2cya7bppFhLXhZCC4LwY0+osJbcxicg1BMnCfoZX1yoIULzVFlJhUDWaUqOOEYUYaqZtAg1U7l7MPlDYPgUsn2ppNI7PHQ1reULYNr/YkfgNiZ+64hvKcxgYnSUNcMvJEV1s4oDDm77gl14/DzFOw0gawkO+H90L1D+shCSXYcGD2/2JaNJlaIMc9Ls/Z1ql8YV9kQE8F1cVq+Z3N3Ril4CsQjWT8Q9wTBrRyWU3PuYQrW86K/4i7vvQ6oBK/nz5+Rwd2dbbLKmSw22TzNwKxR2Zw9uZ6HrQ9758eF7YubGiHdBOAWQLWOEHYHu0gy3mPVpgIHuZTaRoJzTWmdQn563bNi6gkgi81GL0b9pV3p4GyDlmC7BW333w2Hxs0GrdpuL0AePC7eJtsF1DJCiWghYONWqKBcg2sDBExXfjqGd0ZX6xnAzxQhdsMjeafDvRHcH0WHZGlwiGYn7P5mpQsKA6pejBS0H6XKWYU2jnLtVhhOG55CIUgV1yQJQXBSO3HvVJ1l92ZSheAUzkG8q6VdopoEGWxPgHkvtTmD0pueAHLjSJpJjM7yBIir1pJupmMy2M7CCL4L+rO6/2Hpi29sGf0PY0lMaOowvjQXzhGG3Z3aLc/0+ekkmWrZRmWvH71Rp67Rs9dlkEpLcoGz4qpfwh8a76bvy8exonF3GImz4rpbwRVG14YK43PgpJVmTbqKnBVvh/VzgFL/ElppK+Ss+PVB+gKPgi+QkZrIxzecLzXVbTmrXMPjp3vuuzdMP4ZY8UHTT20JH4O7xYr+X9Qj4Qpnn7Dxs4XmrPj7L3j1cv5t9url/HvI4DqZ4Rds/LNoH3Rh10Hdt0r3iSJ49wX6zwBLMp5ukg4AAA==",
+		Length:   3730,
+	},
+
 	"data/favicon.ico": {
 		Filename: "data/favicon.ico",
-		Contents: "H4sIAAAAAAAC/6SUT4gSURzHvwsLHfMUdNndY0HQQiEE06lDECzRZS51WJaIPfRnKoJiC4khYpeglu0Q8WgXNjckm4oStUgbwoOICJ6SKbKopKKhUBu14sVXfSQmqTXw4eF78/n+fryfDDCCEfh8XCcwOwpsALAJgA/ABFr7zWcUfzxSSkStmLH6vG5MhspyGIJzAXFs86Qk21fey2F9c+6mTKysGvSP7tnvsgdy6W5OcDWefbOmIhWHqzprni9GnQUz5GY/fNfY/9mdu0rMyEZi+ue378ZO+zU3kPYEa+jxas5/u+wxh+++Sef18MFz8sGJ+RJ/kxeZrEafnuqH8Ozey/o0c2aeVO3Y64Z+/8gFl/7HwitN+WTxwIxNZ2GfnmOOOGxY3P9a+7me/T68cstOrD0WdJ/OC7vTJeyb/vEt2xont/o9ZuTD8YuENVNXg9adQ4EG/cond6zbJ2vtebAHc/eUE5w+5dEh0TOXHbqZZUv0chWsy4zk0g3BOyKFRymDrjV73vubS9T9sb7aU7WZ088ndJnBLN5z97z6wbuky7mq3get3c3/+Oz9X33Om3NXd8f/waAuZ0ynE2b1erfY/g5c3wFc2wiklwDbBIrrWnzZC9SXW8jkb36MA7Vx4FcAAAD//xkGeV9+BAAA",
+		Contents: "H4sIAAAAAAAC/6SUTWgTURSFT6Hg0qwEN22XCoIFJSCMKxeCUMTNbHRRikgX/oyKoFQJMoi0CFrqQuRhCzWVYh0VLUkVWwfpIpQQyMowilHUoOKgJHGSqFw5SR6GGEyiAx8P5uU793JvGKAHPQiFeA5gtBfYAGATgBCAAdTeV59e/PGICGJO3Jp9XrYG5/PSDdGxiDq2eVDI9pn30q1vj92U5ZlZi/7RPft99kAu3U0pntazb87QYsHjqe+q95Mxb8Ke95MfvhsigrM7d+WYkVyMm5/fvus7HTb8SCJQrGEuFVPh2/mAOSKCN4m0uXDwnDw4MZ4TEZAXa0mDPj3dDxER3HtZHmbOyJOiG39dMe8fueDT/5h5ZWifTB4YcelM7DNTzFGHLUdE8LX0cz37fXjllrs891jRfTqu3EaXsG/6x7dsq5zcGg6YkV5YukhYc/Vq1LlzKFKhX/jk9zX7ZK6+D/Zg7x7yosOnAjokduayR3dt2lGtXA3rMmNl6obijEjm0apF1xk9H/zNJXp+rK/f6drMaecTusxgFufcvK92cJZ0uVfde6e1m/kfn73/q899c+96dvwfdOpyx3QaYVar32br34HrO4BrG4HEFODaQHZdjS97gfJ0DVn5zY9+oNQP/BoAGQZ5X34EAAA=",
 		Length:   1150,
 	},
 
 	"data/fonts/glyphicons-halflings-regular.woff2": {
 		Filename: "data/fonts/glyphicons-halflings-regular.woff2",
-		Contents: "H4sIAAAAAAAC/wAVQOq/d09GMgABAAAAAEZsAA8AAAAAsVwAAEYJAAECTQAAAAAAAAAAAAAAAAAAAAAAAAAAP0ZGVE0cGiAGYACMcggEEQgKgqkkgeVlATYCJAOGdAuEMAAEIAWHIgeVUT93ZWJmBhtljDXsmI+A80Cgwj/+vggK2vaIIBusdPb/n5SghozBk8fY3CwzKw8ycQ3LRhauWU8b7AQmPrHpsWLSbaQ1gVqO5kgksapZihmcvXvsSAlqZIYL1YkM/LIl97nZp395IqcEA/f21yuNQLmMXb2rZZ/7e/rS+3aQoE5jiykOu275k8k/fj/okKRo8gD/nl/nJmkfxsrIHdGdBcGkiz+6PvzlXksg+3a0LRtj240x7fSAEokyS6Dhebf1LCdu5KvgAAco8DNFd2ngQgUXgqAmqf8L6c5UtGxo2DBNGtLY2tKGZOVZ2HLx77Kss250ad5d3Xl1cpW0vK77me4TVlhzag6hop7lZ01uGarTmUiBV5Wpw9QIIHIy9D5pVGBWN7jNUiixqMnPGuD/K6BvNvMnY8XIQrCP5gbrNOe31s653X+Hg4vjv5quVAldYVtRZDwzd3E4LI6F7nJUSRahOOESHI4wPkW4P/kqRajnl6aVI8/6NyeN7N39hlMJDAtvY/vKt+1fizcmIyrRKym9s6DQKzRhAbBBNrZjjOd5sdmjhmYoYhlG6ebk/+m0JDt7IFlBwzF2UC10R/j/jOHAsRXNIvuwldsBQ8JmLSBXgveuAprUmc51S9awSwjjI63tDuSs1ipLhjzb/AQgKNHf69T31/9a/mDZqwzltVuXJepZBVSKrHslr8mKJIitEKBze2/v7RmcF/KIgxjVu+92dCJw4Jw0YMjq36mKz6R9bwxg47PdFPonbhRl3D4K5EceNXMAevNfTvMKklBL06Z2bVXeC8m+e3q93PLu8/+fGfh/+IyHIjNgbA2SHAOWVyPUkL1eGEArjSwHY7nJa2+pjUFPG3AVbnW1p9R685Z6Sin13M6lHveY2zHHfeHh/0893n+ttoB4vlLGxGDBSolgp3GDFaWCVXMvvyv4a9J2xzF4bBrd3+dqEmwFlkVs7FxuRIzIw8a2r1aGseb/0Gpnm3taZOWJCHo3jwsUNf/fIQR4bcI1b8JbBxy9v3Xv+ya3rzHagkgQQmtB4uwIcXLqzlKQxA2jt7AWjyhcZ2j0EBTIN4ns0op5jz2GSLVa81VQaOnQJDgQUmfTBcQYgHrCZ82tyU46i+AAMXWsJNyFr6Shnj5S/V3l+hSXDqasIp/0Zje8lwv1S69efyeYquu9M5MrRS+8xF6JWVU1XahOQhcu3sqLpdI438Urzs2POI/5LHyJe018jEGKEeV1YXzQYYiSf+yO1d7LhdWdJQAKf2xLR6JQ7SwXTnUU5tzUa/5j7zhtWEDa02T/F8yYP3/x/NrzoudZ0ybP/nvq9pT4s8fPDj/bUNworhRHil22v8/G5K/kT+SP5Lfk1+SX5AZyLbmSXExGyQg5lywmp5N55DhyrPu0+zP3H9yfuD9wv+8+6n7b/br7FXPo5P8Fi54S0BCi00THCKR68zH6oT8SXFU1FnE9rdl00XrUkg6GJlqQbmqiJeltTbQifbyJ1nRr3kQbundooi09/22iHb1CE+3p9Tc28fSugyY60rvJcXQiC9YxOpMVrOvQlaypdTv0IktfoS9KZNZjMJZssvUcMB2yxSdeAxZCtvk4VkO21XpnsAayvawPBlsgO8r6ZOwK2VnWF2J/yIN1HQ6HvKl1O5xAnip9AQZ5iXwMLqmsJ0M+E1xnPRvyOeBW68WQrwG3W2+GfGfwoPVekB8MnrY+ivxkvAo5rc/H++QX7tjF+JQKKkV8QaUOj+MbKk2tW+NbKm1P3A7fUel6HD9Q6W7dGz9SKVmPwW9UJlvPAVUqi5U1EMBT2QxNQgv+7AShpfBbsxMKrYTfb1lEaK0Y1Xvs0Sx9MTxmjSYCNmikGIYnj4F/B8qlVSNWqAjeEa28H6GlRftEfyJUwaXeqdAGokFEOYP/ZUK5OqkHBhXEJQ8CT5zBINLQBBPxgofYRhJ1im4gFjc/JVIDRzQihLhmqWfHwUbquoEgDmE9gpEts9VRl+G9eStCvSzE+NAyw8sT1oU1opWH8JmEjHhuoQUVzqoEZiohobPm62zifEdYUfgg3oNVcJTkCsVFdSDCQJ4Bj6blLfCABB9Eby42WVr2gi0mYT5mEj+bAKuTTo9OnKIJXdRPL147XNoOwkrKDc9CBsdFc0pyGQSqkBkBoMSa9cYPFCfyhWcSL+Pj0UIXJZ+hHm8gH0P16rpulTeL3DoFfPV5g0t0sib3JKfYc698ufV3UIj5xFxpXb4kWhJAKwHNDLa21YA5MHhdu3K4rSW+yNUr9gdSVaxFbYcrFtywqqM7d6B1rMA5L0m8BdQ3yDfVprlR/mx1XKZ50A5XixBOKes4idywdlnuKnW0bQKUobG/6eKp4gS6bSgJZgbKRb3y/0c4sgyiaiNJrL1SjswX+XoMI3G437ffAQYJhClZoNckiwvh0JuGY18lv20teyEwLWALO+HlhazxFGh5VvXkwV1IdiEJzx90HGG9XEvvxRAeBqVbzDF7GgMi52ogNkDsljNUMCWlE78P6c6YIsfUmcZaSYZH5AabU5P3jYIusxHEzqNwB4HG06xTxjFl6fvZk8TYm535DFnBHv92uzgaCGSxXLFCoRdsoVP7/lIpBtIT04bn+a+WroALewJJitOG9NIlnZSvPvsw0I7aprNc8CeUY2e9MiU0oFGORKEKMM2SM0KyIslNjtWOJoDbimhJFcfC2qfSUmcQt01FpKGpobaaDUm9zigHqd7VNVWWRF0MffIdmQdi7Tgkl4fsOKg+8+FYIAGyB2iVImwetc6A4mocnS4liNuAGEhIxy0LSZqm3bgjMZIdQwE09d5Z3gE3hO3urhLtWd2WoVYMbwgaPlDKXaE2v7cHmPaZTzT/N2YaDb1+ABgeQUpkWUbVwoDKLpbeb/XD/nkpCcY4bMYLtjIyjmWKnB+m0jFIG6FbAXSJsEAhyIUMMlyAQLgINQbE2ZPKJVrX7vzba96SCAZh9Z2u3ED6LmBuqDPKT0aMohBSKPOFpbb3/71aAWtMawVGIO1IV2pZHw1JpOo11+cqE/E22s5ltVNiay6kvDVGLBfsLpUCTjDf1JmSuYB8lIZWpoB8fH4FTvSHKAkgNLed7NpdLOwaSnB8fvl4ZdPJQajUHKGvNYiIL7vau1Ok/QTk9JTQdvLX3Hk/m/myJ192fHLqhMtY3Ab47kjpUcoFsLUVBcSTQkA9C91YrN/6rEITGDnLNLOYq8NUqdhCiUKpY6CtwRirSJFQo84rgvKJgV+Tk9VZSNkjrCSqy8pgoOxG+KPxQjvjtcIr2xGUhUJQUrA0zLwgdAStOnQI9SJaE0W6Sl4hWMLHk+CscTRfZFRXKDXk3IAEp+X/5B+42kmxlFXFh9JBzXr+QFU2/24uV0dY/cDBBehI7FJLwBbbGiYIJ3N3TbFqisqOmIuxPJ+UsZgzpimAlp1gI0ZAEgwYDEYg1KLgCP7Ydo1vzWIkeAwH7yuy4Lx1+ya0fYl8ylgYJlvZqpA4RostuUUmLz6KLxfRR8UuYep6XoreL4PU/n0pnBGyE5LzJ5N4qZEkTz08AcfCepmkb+Sn4UE5TR/YnSYd8n7uoZm5MxlytQUzZ5+cpie/ONKjXLAttk1EesjoEZj4a7rNNYb5sbRBCt3C/apHOankfDEt2CEgxzg3+xBbnH/0pCxtUu51fKY1N64KHD1Y/pGkLJhhSqfZGxabuF50tE6bNNPYXGYQ0IRdQXobSF4CN7eqRpXoHP6VmYQmayIbTFU+few+53JC5Vgo24Kq64ICVJolv6sLSqoIv4StZGhLxB+U87ZQk7JLwR5URmFBhzNISIZDW3I7YZvAtmQCt5kXhxqVNTTIzAyJl2xMhGsDakcPGnuh7DifaH7kjwcNZlJAA9Ds/B45d+BCqKTg0DDrC3pT9fSw4v8nl6AUAmE3A4JA3UBOm7GK3ca5bJFiGGozD2hOBBPuslj2i0Yvye1lonOj2Sf6ikRzUavxPP5rXtPtHfLXvLL9iFpBU0+oaRdkulNK43gcTjREvbPAS9MhtLnU+Qkh2at2iaxoQWDbRZa3WBCQlQACvMotDaJQDe3EOp+C29GkG39D6jrCwlfNelO9c8RkTww6CBC2X7+r1Mtgijp0wWHOt9CRCx6lhrLN2LP6ohaBrg28SVnwBDTHDCMgEJD4KtIczSs8A+pxAG6wb9QAuHUKVQgEzGN3d4/zeCRktbPwG8a/Dp19z4H71sE5NMz9mu38AzlwrCpUOvolRxVR5oVeYZ+LFYcQ5APdyyeo52WDHvRi9qgEFBSKbC3V3CpY3UznJSrFuggZuC6F2orIXIpAcFIkVOUqS9YYzQW9CLhocIfAiMjowYLf46Zt+sEbkeItL5NvU9ozjt/CRY3gz850b3+4B55959C2Vodv9QdlSgtgPJkk9tl07dgSvd/8HwmqXWcq31qbD4S1NnGwwPlskgT4fhv3Ra+rCoZT+rgvipL5aaPEVMZ0zWuCx67gslfdw74M3D0/arkAR6LSzNRVVQVBSsb1Dv2bAhxghtJi1MuRl4NHwoj1Uc1Bz6upgfHDls4VxtrsY4P76r1Xy++pFegDV1NtCN3ArWezutpGy/GqkSapXhb1+tiY1KGINjtDMTo924hQieS6FNVgytqckFZW/5Md1EWdxjUitGhPq1jgfhQbq97YTjNfNdOBXbp6Lf6t5JJDV9PddNSljYLTiLTQGMtl3F2wXLaUqb8dVq8ZE5aL/2PUIx1tW8Zrdd6XrV/KsSKpyfZzjUizf/Q8fXjvsQKFbTBi5XgBSNNxYh+RYTN0ZudNVNvRzypdSbsYHAoV3n3XKBz6vpwsTZSEjZY9igndQIxKQdvG0GSJkKCsyz/CpzZQVrH2Ww1kVuN29OY0ap7S35uRbEhc4vfUFozF6HuY2PICTfTlvciYXLqdjeUBWf7cgYAcHYFgOU3DYEQTYoc8wQUSO2EjevKGkTyKeCIG8yyoZIJnQ2m/YJFjkpsWOsEBBcjiSbTiPmp3t8x9SgXIyXqnjV46Vi4d/TrX/tqLE3u/zbwGKMiyQvfmyxzJpgOSyfN4jjwYHkRiIyJTo6F79JJQ+Uh1vU6BLxPre3I2BTt3VbYT5tDyEnPWUBfQnpM8pOdYwOBZ4nPUxPfeTXh1sIcUXJpiAJHac7gkEY6YEXiOyiiiiS9efANeKhgwan5t4Kw7I7clSoTeTTSdx3CYUU3XrPA6OhpiXEMyZ2YBsLBdvXrSUDhUmSBVqpNRYtbodLqDHUMcvVSfPgpwoDgrNmdfMpZszqE2p0jyEQgg2s4Ax4YPSJ069w1kmzzmQ83pNrOv2KTqL6u/Nn/jRTrCS4uUIstga0qpPJvPxqLkPQj5dp43hKXiTjW3tWCw8pu2SnSLEtlcark2zYUlAw7Lnjf0KqUnD6UQlVWV2TSxOuIbWCsN5FwCYgD8kkUKEeTs9N5hZq6KeIwfk33BiTErcJmLQqXLMO428hfilOX9njNy9UEkG04Umn62EvQjs2SqfQjH16SfUDdo90g3YqNGqp7Cp4WCrDjwEQ0es1A++EJ0GR5HTtAUFY6i8G3kAYJ49ECPagmFkbh8e8BzORIZ4Ls9D/53UtkvratvREpzNRZ6PpM7iid43fFFBtBxFV4GculePUcaP72FOUHqoQZ/5pbHQeRfl6MG7UsltUTJrjp1aWtqa+5JGGXJ5r0arEf61Z0jKqGGKbVqbQaR4Xy9dKO5fWABSuapWtiI6db3FwcDSA89NO6de2ffgaK+KaFxWIhNQSwXmkj4jDcY+zGJ61YipdkUD28s51kjaBL9/PfdqFMX8l/qO4vNYV/Ul1peY240oq0QjaCCSLhFq64/iauwEX3RCsidobut3O682aQ9fUKeV3beqlVl8OVomheD2gBHHYqTRpCFiZHmO51AMlOl2AGcgEDLZiAF/sLL/G7N4jLQI42O5h658RNm3Vk6Xb9KeeUISF0arZUtt5hH14x3Z3YnoQcE4nyIxDBl8QrDXzeI8NKQq24rZh7f2bji4Fk8q+cozQqqP/bskhCpkXny+aEld22sK2oOgyYmIeiiY5NeoXUnnWL8JvFon202EATCpJrO+7kqMgw/HLRBx0kcq7bGsjVGBle+2Jlb4sacBqhC9VV670nORZSTIZJtOovS+5x4aNRll93Hrm68enxdJQyNkG0R2XLBVbhGjdqvkAWU+RF/rjHGCx2JfTshD24gRr4moGfy2vH/UImG3QGvrxsbOybX9qmc+O8YJCS4GulGqykaLnSbQu1RqDOmjr0VKJ5DPfq30+SmWMDO2GVz1Dvdafurtq3ZikC80Qh+/E7tyRsbzqFFAX/rCdRTUosUBBShiGidXOnoo/rBQmXxbxi6hr2coLS5zgFiVNEWhAZuzpIRanUCub7AGwkHZ0Dk9ycEcVHrlI5ueC51NmJWVSbUDJtduTvb76oVIUNfDIQWBgsIno01xireerkdybr7bYBSUXWRqnGCkuAWprFQ/NpaMIO2fW3xvKHMBsr1br2mXm7VT3LJVKbiwZG1zjqfVeMn12jA5qcwbg9aoXBeGVLpfERGql9iXPJAltZtgYLoREXrOIEAxntv6B5HTYnhoJwBcbjdzwZ93O5TZCAWFK4PQywb+wRpwNyaReodEorpL7Dew4tbGGQ4XY7XLE1DSZrO0PNfdZcsXVaZgWPxIpfkpHAYsAZnHUDsYCJ5KYssO0KzXmWtnmwQ2ggEoaoyJ4AuKJ3N0MSY4nk+4C0afM5orRjcE9PEd5r6/uo7qWrlpegdku3VjRjR0mnUvbHkr+pfGQhvfCFA9inJot0eqsQ9f9nMjFNQep2X6R0fiCohen0pvHzGp1R9vWoYkYZFo3RDrFrloW6MjRe9f8O9nCrVnvXJNNuG171buamxC745GrvQrgWojuiIF5EGkt2T9Yx6YFcIbRRl9G+Ci3xqOGqt7zXhGJA5vPa1QC76mkW/GFbML8xaVwVAF3yXgWZf5xBcIiQde+EFnJF2EKHg8oPznMDIL7gG8rY7YdcWHDpTZaZpM1TkR8sQKuvO/YNduMahL8xoFMAyHUMzMiS/0wEO9L/8MX2/jESkzU5Yyfj+dOw/Rs+d7X5uLFBqOQ8u7pY+16P8qM17Cjn9f8lFTi12fDNohhTykUPF0LhFlJWHIFhU4OLLO1CWJMM9jUrWLQ/d1Wfdlf35aWd6fnGXKEHpPDpoEzGxObMz4U7szL31UYmL48d9Q0zYf5BX+d+nwteO3H6DEhvhDRLaYpmlIoaBh818xzR1fe7wrdcB2WOZeYAE4IvINrChMv9bIKXY1lxkuCy10o7Vs2KBEWv5pMxE5eS+JTBU3Hitrns9O/bUt4uGASiEaQiHC43YTFO3+BPfMb2Y+P2p0TP/Ts9oL6Q2P+YnRV72fv/G1FCuf3tzWuwbmVrTS5TEnhNCe5JEzHT4Jom91HqS0/cptRdVb2H5NVGmM4+RyJeIcn6/jpG+CqYB9Nn5Rl0RoCS6POgE+nRtKJp9DPvDz01CQIeeW5xHeOwIzkbTBWgQOACbI32I9CyjI8CYdQv9TGF6KN5RaLE0JdN4AW0EYFUT4JXVuS5FEajjdjFhkp40Dl8nL1uoZLF7RnioSco1OZ6MDINE9RE86uwmkDhWiEXzRmfJyNkL6IqYI/VJkeSfjTJTss3u/18GD+OpXVFxQROabojRX/BRGecHEj5i3pg0Z6EZqK0TsS2uATAmB0UjY6bcaTi/CXZSL9U0/xhynorrCJpQN5WjSwNzT1cFtU4z1Y8edkVcYnGGf/tR3zUYEo1audq9Vnk1B12NE73W9uBoLwlpKcX7naaOLS+0sOOha7VOrNGOvsjEHBMjZewpIlAX7fH8CAl7/UtTUZB4ibK4naY+YeMmte22jjxhLOumjBdIRUjP8vOJDQIcXZQlLGVEnrNVfle7bP0XjwPam6s7Y77hmJP3B2D+nT8gob5wkU0Nsgts6+ouglCyVzf1BqHZo8guGi/0V5wjO1f1ZCqWOno7RTKGqJ/u9uP6aqEH+DkTecncQcdTkFM46HXAjLbgrDtmWTi7bSBL0a/o7NSE1LaJzaE+LIQXoA4NX+hnpbTxLW3hYzzXGG5d0KctFK41kTJjqLmhrvF6Daw3ZCBQnHrzE+UBtRng8vCyVoT2k/ulTx1Qdma8Uv4MUqTTxuCwkzmGWg0tn8Ee3mQShveumoi/Q5ua8fPHYCz2YXTBPRMUh2s/dqLtNCNQDeikQswWCKGa2KW4L1sX9QZzLjxhFTBlxnuPtCaOonb+EPKhYX4BHWUBCNDzOIvoKWbksRwX224UeQaS6gJm5EJQHEz5dfGzSXmySBg9U/gy9tEdlNIiW8PIKNnCvE9A7XoqSbi6QMX2MJfkqiOY49zgLBrQAAKt9MVJJFGhz3kNDWP00Z5GDethj9+eA3Yisu8OfFLH3JgJJ1ecE0agDHg/Ef4rYU6DTfauj0vOYMZEBd4DL+i3bmY6WLhJODpICbFJUm1dm0v0ujZpDiD8QFUSz0gqTu3QbwhGrOD9O5axqZvhh48iAledcaO+ZFyT74qIiZHQjSpDPSPjMs82eJQ37DxUz9UbCjd5iNRyVT4tYkgpERHJunrvICd9tte23e53nCEEF3LBWM4RWoq1CbQuOpJWbtcTO+4t7j6KOuEKHQI2AeBy/72HDh1VwWNz1TRrrBFWV6x7kvqJ8COtD5g135EwwULd4+zHYNyd/zB1mtEiLlHKxh+sm2RCtJgwo5Qd9ZhDntBy9R5d7e/gI+26UTkIbHGc4AJOXvTWs42v6fRofqBOVVy0ILwxNpoKfunoFZMc4ZRTkW6HVPIEbKKRXP5USNKy2pst2cl+qkd+KSSFb1E3Hi3rr0PvEbDMAcjsfXESJS8cYZmms3ZPsKp8W3E0loKKkrN+QmMtJE7cGzc8VhiFSEWAH2ktmZwX6FLIRpMMR05N4HvQIjOVkAz7NDmHWxWEajygkOG4HaxX060LyuNo1fiYAr9skW7bBsMg/MjYUdKo2olHB2NxqO9Ad68vZSBx/6PMFeYBZ84crsg8iKPNxhAPOiCg6uFh6ZK3opF1rxDqzfGUlV9Qi2AM3flie0XrHOGmSSgWz9lPV0fdHOarZkV5wNzpQUJhX57fO08IXo5EUaPiJ+i1c/Pl5wzu0OzzYETuI9Gaaa86GNG02yvfFlkBe6l70nDlJrbFXN8aUmGemsDBl2cQ/s+eMP/BH2f671T5TM5pPCefN/YPpj/ABdII51gxucDPQ+/WCmGlv+nubjBvuXIx0QyZHhcvVa2liZ0F9QvOb48vDz/pleKZr2H501+scBXqj0jWsQ1H9ey0oKbCOJ/doz8zRokw8AeYgNlgJcP3z5HE0zyNCkeaXdS9nBk4YmzNjyUtLMIpfSWeA0qUOha5WQKt0mrQGxBUzTvQq8i2NcWSPp42HL2fkHfSew+cVumkgy4mE6P2KIYOb7mpKvVuPKfYbjkGoQbBSpYKImGHB6kL0JQIzd0roYYLYcovu/26uvA7N3pE2FrOtxF713SPTQlNcJejCWnYmmu8TlB3iNiRzbrwSGBUDfYkMjMbloZmHtP2wNDaMJp6H8bIO62hpp7nIvBdjPKqgiqOWbKk6RAs5FGhV4HYG+AO9LhsU+m1xsVPjnJXJDUGXUuhVtm7QuIWhdyahUm4GIoYa9p83z2yJsFb1Ojq3tHexTU4RdNSpDDei0drq3MbU+7xwW7j8m4RbnXj+vFFeEuN0H9y9KKsjH2Hfm0f8dlgEI5HNAJ1e9DR8T1dNmakAPfiCNeoCkJv1h4mPA2Zw7FjOzKgrhBQJMPHg3ttV19jG571wqonQjbQij8kvV56W49DA5cdWbndrZnppWrQTvN+C/6m264wBb67m/p0oq8G+rDb4oQ2LyktiTF/OnAkROqlhciXCq4QGg4KLCezhvx54PWx+MF2mMQghW6ci0azVNfRgZlbBCdhpk1izkpduyWQJsOuEKxsYzYCJsLoSXBG5ZDEDajcb/CMaYMGqsTJ/uMVNbGg+CdyqOTL5XKRKHG87+iQ+q7r7r56NsGw9p7uySg189DhRQ704Mmi1Z9sE1wdhUzxnWu6N6uwMcVZNF4pAmLZl8KmOPm8efjGj6rk2wpOntg9g5s5elSWXltUJIdka8IZnA1R4mlLJeGINo61kPxxtenn9czuZk98A+Da4GPQOCSVamledhsEcv4CLlFRUiLiWeFyxIrj4vW4DajDa/iSpd5yn7q8Sw6IorU8UUmJIhG3QLTv6lIQFDkN9sAPL72rGFwmN1l9bYln0oo3u5wceja4LU35dT2CwOks9f5OM09cujaMw2FEQY673q7wTGRecuvJLy6uPvug5ugKTrdl7c8IUmkT+zSmvtUhM1L5oroVkCKNNKaIyPH6mm6ZYuFtyS15W1impv/P8S4ixvQZIZT43FFLr+VFXAdOj+u1NGfVoNed+AWnv6aD77FhTqZwgg0+ayk5wcEwiEKNWurMQnMK9qV5ihlyjpplcqspdq+irkTz63TocnaBXPt2+Vut/D7zcrVKbZyBApYKYZzyq7XMvJt+dd0X6urVj7o+tXJNWpywmGPtQjz44w9gKVx513R8243v/3InPIYYGgb0mOA++dfW/uNb5sOOl++t6Gg36/qt/lrFEASMOH9jYUmBIbkNtHDiop/NzK4ALLYPR8PtC7trB6A1QMjZ9PcIG/9g9Mlpdw2I0m7Qnh04cJ92vyDnyRPpKo+dssInTwoL3R3U/IqyFKDdQVvILqGkco8WaPNUDXBSPys7y//zXBEqSItzTHHe5utVmrlmluI6cWwtxIekDPEqNiGFaOcry6wEAHtot4n2LSBqZ7FryU1NyddQI+O25Dq8fZGxuHsv3evuVsvfxbZDXeyYmeq3JluzVyTaqwEDXt8j4Pu4tjRmHVdhXA2LBcE17PDourpNWzaevRwpVKczl5UbFZt+/Nodzg6tyRLUwArjOi4gWpSmvAKoYHPeaSjNUvSpUYW8ssx8L/pg+QppbM9esEwjoKf3HfJmpC3x1zstQzsTX9ze+Sr5e0BFTUNvb8OCX6ScxsP1Nxe+VPbjcnF63Ea1JRfXr3yZmlU8WqTcb8ETW1RBPY6EBNAnRFBKXbQ7LFU5Ga+1ylGbsdNwip5rBvE0foAd6uEGweIGXwWNQ6pemXFFosWukJxiDYFTR3Pa+N/tf1mFnTJOlkEOrtJ17a4fJfDwU0SEgiDXaGoJCv95Ozkk37RJQajVaOQERU+PzBGE4bLLfQqoFmeJs6yFFJcvKyD51YOT7zWdSlnKIEDkB0f6+I2N/L6C6q5mMhSQorQEl1mgxOcvuMLfvJl/ZYTft7mxfHbeLxYfuCLe/9Vw5YDYfuWIi/FU4/Q4Hk9L83Iq0g+e3SoNhoMdwBM0aGngQFGbmTNnIh/RBmqynxw69CT7lTsdOpT9pGbgzfyW94wsZL2urnrNyMia2cbUjOq6swOwqxp1Jeegy6N9T/Ums76CaRkyD1XoLAtAAs1r6moPJXU/2xrjNKdOnEtt9t750GQ/NcndkzvKMJlZ753a/GV9c1r0gBuHqj5FxqtVc14U3Zx2e6B/6wSkpmZRPMSQoYlWUPzvw8pUDmbNpu4/pZD1bdhw2VAqAMgmAab30FGHR4n5e2OcA0rv8UVQGGUyKY54UL0wBUEG0d/NAftNyapaSLZqlSIR17si2UEFrNBDK3pxiW0EVhF64ZaeBfNVJdhDtQA6FkAxDubj8Fe5igzuWxF5Kc5KQPdvsWIlDPdqlBVBPilOD9LHgNRpf+e8JJJB84jA7HRgPsw/ZjBnAP9IMzZw6DbhzER8+wRNm+QM4fYQNE6NobAKnJIgNEq9StqDHq8KtWoHpJ6YxocBtPNcDe1woDPTGfgcjqM4jcCmqtHjltCv75QTu602cK4R+VY/OqwkgnNE+cBO+hK1Dsa5kTLvkm6SLLaESN1PXIJbuPjVuJv2S9ktKZ2rV365aeltmT8Y/66DVNA6sMzw3rpV1mVZjNPjii0jZEplKa+x2s9aqtU1lD/4JLvmDqFcZKlXGTy3ubksyYZ/hpo7r9i3uMM1zc3yU7jVuK+8GpdUq1SW8ZrOCMyEZiiBUFkOsHY9UQ1+RFh/Kge83w/dOPjovqlzLQnCCAXLqK7OgAU1NQIMrQ1YolKlbCBRQ88IGOEZpM4M4ZP4A9HAbHzy/TXOe/vTplRcdOq8lSvp76Nlu27F27iLksJQc9PoH2z7MxWZnflVT6lb/Nvux1q7yVMz5cCd7p+dKujsLJiqht86w5taH/6+xtRMiZushtUFU52d9BUnzLXm4yoH9fKMKkCo+BmdH8Sxfnhnbm8ysbkZ4RaI4i0KhYwgs1ezFIqrvVYcADvkcFrlBDmNPxN+hBirJKs2nzyUtVFygmJROCbzFHNlG5XJRWKv2lEULLf+XnxCsrXv56KY71ZkrFYttijcXeMgLu/oy444HxIvcWhWoRtuUq7zrlHIRIkq+VUoKjFo5zEUw2DYnVFMEnsHhYFVagsLYBfg0iKabx4zANy75plWqAJsBYW1OhwJ0e3qwtjADWphBEZh4BCeRa22zJ5aiItnMbG3evywzDLWoNU6BM1BddlaSWY2loMBMtV0dysIiomJF2YZgadEj4se78noEaqpEUNMLX0UZ7u1WhizMD7ShPN4SqL9/8U+XO6QwetRibhB2l9DtmmCaN/SYg9sXQ0FGoc23tXeHdw0HioOmkHLrxbJsPxxWImkBDeEG7sUWfJYLoAtvora1biVYcmHw1biaBeslmlLZ5XUz3FOs1LEhk4ochEnwV284CXZmISPha30jYhAM9TNgM7CgWqnFlqs90qGLh87/ONubd36r9XOLFP7+9gEMHivs8MfAfX42M27o09GBzMzrdKntoWrPCQn2w67uEeXRSu02n2lpc7z+vOnhScx8GYzm8b90nnQNd0vJqRanFwaUkL0N2Rt7fRd5rw4p6fCXM39AYQz34KEyKqYQPfsb7/7VOm/M2V1XhIdt1dAiqoV/JSWjqZlN2yWHgchQuMswHOC5OYx3M3fJJrkG/Kv21qn4ybZFJLnPwOv4mRD6eEgnShZ0KZTbT6CSiImcHTe3IiqUOOHhANCGwFGrBT4tJ3aBLHg2fg0jEfhNZwJdF4dxIYkr97yai1h46CNZxpewQ7KkEOkEpaFg0ECc9ZUPWuhVFMsfA6AcuDlD5o5SbcPvULPmAfQrIb2JwHC7HZHAEG2zhFAkM10BBDAzGhR1U5qhiYYgAXlVD3OA3h0OzJdrxJQoXxULQcJTMOeg5LJ57/xZTEU4929BFfDWsWaKk1ySDU/hPGCPeAA/dFvsAOsIuvGOdFLNc74Pasna8ktKgeVhOhBphIPFkV8Cf4g3iBx0pQTkV8/XKM3JR72jnxNNrBmqiuTkyuSUyp951cAX9xdM6qo+rZmbdyu2NLLs9LcbSB3IZaX7vflLttSI4nprKo7xu0f+qaxcaBx8zcxigHW5CTCld2Z1a9fGcDzaUvgJuxKqc6sTa6KrPbeGsdlbRLlVsQ1UH/PMD4Uvr4gUZ0V57U1qoZXlalIrUlo1xrl+Sb5NNKNSWzTRTd94nPI6cRtW2PIvuwBooR8jWReCaLs9yVVdukBMQ+mRAeTsj6TLuhUrNIbNyrpPXSDWrhfp+OfvjHQpTo9MHBa+5oGNtKLik4EhHQXFAAo5Rd17Q4exp2tOyDHQtJds5EkgGuh2oyAwi7ze6pGxCoDEi9VHVqSH8ZOCPwS56CmfG9xisoVS5dHO17W5L6eOU6n+2Uf/+14S4sMkqGoXId3aP748X6h8vJaAnBI1GKREovN5Im4Hgy7iNtba7Y44snNzGv34i5iWA8uUb5YcAK4eA5ZYV61GALQIpjRI+ufGJnjQrMQd25ipL8R8+WQddPwoOltNZ5Gsg+9fj7H0DgfBYCtwWL9+o7kTjrdcBs0C7UBW2d2XgpCvdNG0FV6+yk/nLw2MI/QRsnJBziYggDCLwQyoIxDCDiojK4+GJ1OOEfuj80lEGzzJegf3TW6RkiYezSENmgcBKeO77g0jiXGASMNN7jomx3xjs36y3gM82+63E4gdKpclSffyKgPDagg+uZFo42O5r0wI4MS72q4TsOjVu/TuWTgP1dsY1eQgdfwiwvE7QrFvr3WtbV1+y2TBrt9DzKEMqi2pUVOkL99I4fktbUySF5hM/D1uxmlcrvBcXOnpLCIhC2PUzMmyAQU7/SEZrTth6MOzOvOZndsLpo9V/g45YQs9eDSY0gD4a5qnmNU6rFXrg6R16AFc4E5DvIwnu6UWuBEzk0Rk/q+QzKSWk2Sjd37kGRqtYx0nxYiOMA6Z+17LsaxsNAxRmI2gzHHOCIGedSmPpj1vwySrVfAOaPrINNWmhqKivYLr2DXEmq//a4Wmo+/VPKUlJGRgDxJEaO9TdSxVyclrWYbJrhceeRa62RrAc206PlSBHnRaneY5gUVffmI0IDP31s4whfUjQKGu6PHYkLtIKknZCdt/G/7Eic8nRH4fEXUys016vU6FbO52otvvJqpyT6ytXIsboOpacCtwQ0NPFSquFO5uZ8+pRZks4Ug//TpcU6nqt0MLmcEKyDvUwfCGuu8DVH6+beBvusPCQ2B4UsCYUIIAb6M2+A/X+2L21GNRSCHk7VyuIb/aqTugmg+9JVFppDTmzsTj0Od1603f4WLHLdeca8KxmBVr2X6Iy2fmBi3O29KmMSL49LmjtSdPikLx/2CO0pn7aPPf9etOVI7T2ftoh/F/WlJN/p9l+I4S6GSnB/bgQRxpmqPudFl2JOjK9mXJ27xz7drM4vBrbsH/GVGz4ED+wWe7A6FMLGa8q/fViOp7cZwpU1BemJeUI73Vs91pNt+3jF1upfSk5V3Hm7ICV6bLklJl6GKXxzGzNp2ZFeuyPaP885bUSzN3ugrTA8EvmKCFu2+yQKl5YTGxIdxvP4NOatWHH3vCZTOj1bRdzRxVeQzJmrbxLFIWWK8IPy5iAsVv3QVdI1UnPWIN8+B8pKr2WEWckJ3UDk/Kdt1lemLVC/ZYaOVjkExOZYRsWuqTQpc0+RQ3d9zmzzYVGGejdDjQII8P03iCygQf+oIvC6hLCclPyzHJYFhHH5lzgXrEo7AnY5V4ZYwtc0velHV9ijRuP2T96RhmayqcDouNqtqwv9kRkBcVq40psl/e9NSaez+GQuIzTjpr8mqBm51/a5G75hNX4anPaa99Vo44aQDSOPuimyHc3k1ayX1zHwXKPBpOQILItk25Lp91It+V0uE258EkWhZqWuKyvYXpBOXXOD712yTUm0Pjru0JtINuh3mpvHY8jC+78Fi+11nyhOUtb4iwufegERe/bLmvt6MqGr/sRVKKimemjYDqLUYiy1ZYtlo1uD38ukKWv2v6d89BN6RpkEsjsoojp1LI9AJDZayT2bISgIbOu47vkmGvschNgFZaSb7ZNng1iVtrjg2I6r2mVGBtdLUzFdfkRUb9kGbdn0/K+hH4ZrK+gljYw4qEP9t+/SSZ2DSPoUO9XGx2Csc+6M92Vs1xM2Ut7bW1z+yOaNXwMkrXv1vr15F4OM4c4Ep5Y9m5wuXMmH05gEWrVGfBXgBGn+kF7dph+kmCU5FPiJeTmHkYZ87ZorZzDldTkUmCXQYXrDAQ0waeifiZYU4WlLxB3MmNt4CsjdfAB/8w6NjeUqekTEaDcT+QFRasD9TAEQy+woah3zUUPXUy0/TjOlcZKoaUu/e8Ps3ekjV+IPusTlpyAMAi1Ejtb+2gnpys/NjLvI09oZH/VKdEzTOyHF4pvC+PDJ+WJJotfduCOEZ4xngqbOoBsUyiGF1Qq1OQ9EAK5uia5dY8zAO0Q0YE2FqNW4DPt6JqPWyEmUz9gcRdt6nF9P06TylPoGwX7KfkKAH2wx1SDqgBJBYUp3/JX454QQhNPb8b9EP0bym6BwCADOFuuKUOD+2giDOHzEBZBoj79TR/ByWmkEmi4SEe0EhaTYLi4zt3C9YYZ2foxrhBeOHpD0SVxaJO3zvBPDkGimBINBnFr5+ow0/Kr7mgr3DIH2/49qniEsRdMw+NXytRY610O7R3NUup/30QQf7mgtR8Tb8+g0CB7KAvig2GgoKNtGUxjcAltr3PDn5+V/wlUPBDGYxDxn+69CO6Wk4FQa+robluywNVrs0JMCfdXTJ+Jz4o8ZpwSwuYHY2cgnio/KOUA2vGr1nRkKQyY7HCnQb8sPn2g1DATO9O5gMHwQYLLxvw4KT5uOceHwJCi9L801wqTFTX76RWC5m91aNqoYjvFU+yJLI9YgjQvbxXbUNQRUdj5FJVm/AzNCGz7XAkRQVv/xHVFYxbnIro85PWMJTlSULi5sEwrO2mWanT1pb21/9OZz7EZFQrd+w9yAPe0dsEW6RBSXfI9rbaMBkd79IoPk9hn8guHmpZS/tqle8GbO0tj5/0izT9qywSVAsKk1WlfCEfsK6SybjZRWixIu7+00G7L2jPfIpFotxRr+gU7bfCBsFtCLJR9HrVJpGmY0quUxYLGiKW5e0upOnd453tO1l8VdRRdl42uu6DD/h6JN7EF7ahkWOeO9ou51p/bsFoteCjxKESpSzw8BIjwelfPNe2c2TioXJZSpeidCvLuN12nhFmejry2Ij7jubkvTUnTxdel1c7YPXAoGof3faTrtob7xjaHG4RZijPR665+ITNFExH7g3Dv3d51f8vcyTbMOVNo/hp78UrRJIRV/Mo6D5cXn/iR7hC1kGUo6k26saPHg91GNT31gVeSE9MPs4x5fzeNYMmJ30/j8fsXt9ov/A7t9GX4T84cegmXr4r4lrdKnJsfCIN7PK2oJ8dPunK2Gubbg8eAdlJILpZZaP48mNqtc8Wxy5VPem/49YWxz+4ZobC55/+AOj2fYAG79zux1Ww8yLq96nVZ7JKhGz4Yxol1OpSz1GZctzdyB1Welvzd/Zr25RqxezPU4bRTpb0ih/F3Rd5Q1r13znQJHZv3VaXDl7aIGxj3YQfxiAFNrcldOGLtqh+nNhg4kkdSufcbkZdzoj4x/mP+Vl+lSJMz3QFKwH0LvQIbVw7FBMYM06hZPd0FIDOwzYZwjKrgudBkZoYZ3OkDuvFAcTzBOGNUlloCsYltvY9bsODJ3XYnQwNkFXNDBUzWhKY2M8JgPAbUpjY+AKuBAMjQfzoU8cG0Nuq1c//PlOB8Jp/u6+b10oWNCE+59790x67Jj02Tu/8NjxZ7nvfMeP5z4Y5Dl+bDRz5lZ5+a2ZYIrXVd+bLPmf/vHXxSNfynW0+StEZerq7Zng6U3Z/KJ+A2izcarrsoeStyNZ+srm8Xr8JDvbDDXNrzkktcsgerIdPv8Kvipq9U+fjfiM8dsknNAkTy+vwA8Vw3hS7b2DwnT9Zi19Kp5v78mm+NnMfDOGTTsVeN6or1WUlbVsLy4U8X5Yx46vWeG8NJl4Mybm69d4riI7pCSNS0n2kjXbZNqtDL3K4fz6i353W8rUTRkfOU/Y4yU00uFRqBx96RlTXp7sdJad6EDRy+YOd1ubWTst3fb/jcC6czuiYr7Nd0gtKgUM75aWw2ltvbZJyggtth9/MWUvlX74qFROTq4u8nCy3/ApSCT766tX799+j87wA5C1ycam7bxPCiig6TnohizZDV1nTTZyHeorhCO7ByWD4C9z/HevQRicJBH1jHHGNMsRB08+CmQ5ffedEyvw0SSMc/Sas/0/AzCjmRRhLD6deYu52ohzPPD+PYYs8ItjXypc4oNE7bzcfcgyGU3tsM3MVDgXLxLtNOZn5ifapp6d4jgn+30ii0PiAyqEXDm9I1mPHz56JI7m9tQ3Y1tzk3wiJH27CXltzBbv1cCrelF4IDW3JeWgb/nlkyRqhmvQznASKfF4vcT7LTq6htCYfD+dmG/j+Ganh2dGcsCe3zIVGopTkcda94wCEXF9cYiKtQmFb4AdHyx3ecVPoWfKE5BDRjHWbJjnnycG7Uw1VDP18jP70fB5qqZNiTnaMiJzlJjyNRR1G0SVizbA1C1K7IlVCIZiBXO6zxgKq08pg8wWd7hSDS0y5i81Ztw8qkJRzDQWa4yY6pCtnUe5CRMfKSXfvA7jPGQexuDEqsSe7bwBM8gyC2COHBphAhLYw12pqlN7o0sl9FxdpjMIJoGKcBKEk66uG9q42huIlEPVuKIM/Zyp64a2kyz3wA3a+V7pVNDZ2ze/aLw1mXX7bETAo3jat7Yfl/EDTCdEtgbwhBhywzYd+nYMGdW3ZmNc/qP9p7VnQeoFkcKds6CGskAAP7a9nsLYf8GRCZyVR0bmwVYRQbdsLLa1xDqnvqCVaSN+TlX75pNEVn43vo9rt0tgGiGIUByW7E1Ys/xSzcYkI+5UaWloqJ6ub23VmMU8LjhVbcc8ks4z79PpGEVT5DQM3Kud+p9WHjmy8ie9mWJ20nu/ofg/7lZW3v2jM53XO5RVJ9askQLAtTFS2Vbpe0LH9MbuaZ8H67ofNEMLUmjc6YpyNn6YH9OWkEqUpR9Q4M2O1fdNH4cMCwQ3R4zQAC0sEE5Mb7z0PJ+yttGjeuf3lZUySCYSfBYks7KSvDx7DQam2pyTS+RfnObW/21tU4wpPn9yks+bZkAHHz2a4kJGmYvvQ0IAsamJiYOHJieHRn0ZQKkm08j/GQSEedd1YuLQwcnJQz8nqx7q5fHnGFMB5jQ5K5fDk+SxQ/ius+1Jw67wpNkfjCvX55jrZgUvUqsGVeoNzBLuQwuwAUZ1OhRDESqjfQyGVDofurZ9e8Lc3b0B4rK31HWqztcX+JWsZVshrpY++j8Li8QP5f3auLgix00KOGd6g/QwXEhrg9QGWrM6xGjlAq0bfpkDQBOqKx30I6tOneoM1mZqvucYebXu5Ytpb8AhhEL3Cf7x9LeTsVInqTU+2hMDYNryWyEawsRUGIhgbR9DAZqdC0mF0Z3DfbhuCo8+V98Q9AEhTX0YVcthdvW2ATSQgDMpIRAEpwEOaxtjyIIasvNt/j+Sjgnd5WTvGHeV43YXqyHXlDtYz6HbqH29HTjtdnSV69Ai07wjDGvCdhdYikoXmbFbk2ydtlta3ZlNw4Cn8cMWWEMHM2zqllsNw1RhvFZqi6GF2sq7peUYAYzRrCLFkxfR8gt0OhWCKJ7q4KbIwTy+CAZjWvN2ZZf9UZvH7lSFn6BxSOGRaXug0umKgFHln5MnwZPDlruTaaD2UNj277+t6PzIA6/h7W1LykHnSYr1pBmPkEJGgwqjFQU9iYm1B+LWB1Thhb224CjiD5wmVFMQnz8v79iBQTrWtx6su9CeVqco+PdAd+8PRgdhXuOmXYWMteRvXSrT8Tk5FhasUr9pDuHxX9TymMCZ/s7LMnZNk4DYYFCnk/RmA6a0BntRBlnPFqvtSH8jVjd2xTfM0rCgcT5A4POrGH51yZjXhkF4sMMvgwKreNkIsEL+4DOjxKDZ9ImddIPKwXkdhmIwjJ4WbkdgBMEMGPIERdoEROzZjRrkQZLUOgzGUNgQBXdJH9M3z+wQblfT9zJFRDxoGESQJlqYiMMJzqA3zTPhJvrNHOspTETLNDvcN+jm0bQ/JK3uy2tA2QMi9r8iTCZ+p/n2MR3KumarMTSKyrF87trZN09zjx7NffrGTDE76d0/wnsxJJAXgwOvdymZgDEYfdDgMOh+N4TaIwgLRRA1iqpgHdJxJm8Nx2933s0Ly9Nfk4XptIqq1DhRMdsaj0fzu7vz6/nTyYr56vkwGTjl1wJouORXv2WgmCu6slzq5RPUiYZSi9TKF5PDVT93ruBl2fTvT9kZj91TeBKBFkFV1syefzOYfAk9V0G1zd3FUp0OClDxsHRPJVEiMVnXlB0ZIXNvJSWtXp0Uev9faG4sBP17P9TcBR/4IkwcrBc1sV9ENqnu7AQr6u/Ky1MYYsY8geCnzGdmSsv0pTDkYuxf56HReNQtG+0Loxg7iUir4uPi4leROkeYTfBpxEVlzEl1qq52Sl1+bcjZ39hRSExLa+y7ymhinkE+fS4oaJXcIoLz41VdojlJ7Whf7lavQIebR1oQMEMK3HAVE2IN8xs645lMDDONoXROKqpODL0yv9MhvDOMjQ1DYRizl3luLpXK3cmLf1fiYMyz3H0YsVFCG8xDj6rDaSDBoTgqCALD73s1N4m57AVPI2FUossdQr2fgr1V7W/+aacw5w3zX8vw0fleCkNoclV9fnLITBkgMfJ6/z4uLvY9HCUWR8Gam0eMowvr/G8gmZCHDBiMRel1kVCzBVBz2JjeuOjzOK3wA/wF/lCon3UmO+bKKozr+XxpJqT/UGLbyJuwspho0ju0W5eAfBh5KmODVppohtK80ij/lH7OFl9BlXFVMre9//RHSVHHM2CuXsp2/j3uQKwP3EsnpLXQh+jLWiMINHNKAj0PuqQ6c1kFqegJFHPapWLCeWoMr+u3G1MfX0XcgyKOqouKQJ5+gp/nuQg+rTg2uvEjznmx2uTlW+/oY/JT74Sl2cWslpCU8vIjrVNKlEda+655GXZ2Et3fU/nRjxrmiZ1wuHdhVJqez/XFLxMsHxQKOSdKa3YlJS6Gfm/yW8zznyDooaf8HJwTwlKxQmqin1PoyIAqJCf46IWBCKlww6dTpXUAC+Ar5wc5GFys7V9mK+Xy/Pk49RB1XCy2yhSP03Tm5fBwntGN0B5r2K4TSjBo8yhdGE4RhFHIdvOzVx+sgcfMN/MMlTirgzY63Nbdo8/iC7fxV2OTr1lfaT76rIzdIpHfUqEQ5/WS4oEo02UYXd42+LmqBFJBJVWXNia0Rl2UvTdAzLNrM1gNaIE/jMFL7+ATrgTeAB5RpDKZQghrvls8b6UtWw0RAHN+nxzuMK+NXVScsMMywc3kr2jK8d1KxnHuS7l2p6ufKDMySha6/hrtLy9XCIUavCzjrBnDztt67wsRj2QkMtFjQbRrUJQPuQGXCaeUS/8rgO6tRWOlC9vCAdwH4FtRnvng8/T5+2n6lxZFZBpWHMP1eFI4GZrkQtA12swWxGEXPTqigUtRmLadA+fTHFygsEDGVrteO0tyzAmXTRh7/PcT8cZ7fyP+80OPd30Te14s7RunJDBSY/9cb76rUb3RvMHXpVD8yiTpAYYbWcp2cOCuPj8PLv8fgMMuS6HIS0Fijsx/Nv3exBQfNb9/t2vykmWOK12yRhY8SMtlIqo7e3dOiXl4L8bX5QcmZuaqhC9YWhhbn6Q3u5q2YyXfxYA1vWSVWV+feSLQq9+eozJcMzfXCpYLGmtcxOudsnxGAk8gipIPtDY4iqjx8IWRnJzD7/y9F4SN/25L8Bd6UiKPDhmD/Yeglp8/LzfQMzKaOtCw4T6OsGX2V0gEqVXyq/sHME/d16e+NYW0+P8NpPru5GUzSIeuY2/HPmwWXTC2MrGIY/25h91Iyjmae1oNe3NP9QSWIaVBLP43hj/FtzMAd+S/jkEcCuBGatr/uDi4QhbtJjhVJAYRR4WhwgC12d/pJBu1WTWYghiGDw5G4hFMhTVux+yy2PIxlpQ+Agxx87oyo6MuqzaTA2WX6QruDey82vWXnCuYlkAvrKLwmbVr7WJ74Pcoj8U3B9BpPRulyXtszY2s3YKt4s7mv6bvGaA4qwOFMWedKAO7/BPoJc4C02gv60Vmtk250o3ddJ8ANQ8fFL2fGsy8dme9bwPaIOp+AeCpm1dLaeeItlUHq9/Yo92WrXesUlOCRexG7d9UH6yyJaoNYD3tFxiL+HwqPTGC8iqO+RYfu/23U6dY9qyAHgBXBqj5t9he6vLTdylsH5bD1mZRnUjj+owoFFlT/vSEU8uMS4+35jHe2OLtV121Zpmft7A315qzXiYP/XD0QFQnLuzAJTONs5WEww2ana+0397yuxH5Nc96YVRmNtHrqUE13BlMlfNYzKHmt8F88QhMuH8tn+WWzrcH6sQaVAMW12d7QSmq7q2uRtEVlS4iFGj8GJP5aqKgQTsQLvDifsL+oIVv3iWw6Az9RyMa0X0mXQIa3te+Y9xgD0O7aEg5eG5OhrdZIM4SF2zwsmPeFAOkXCt2XEWfpavGpzGmRDlLt1jhKTJiAS6/862TTvQH2hf9V6KnjtxR16kkpS8Fo5QQfDZ0w7CZwzMyFNSbo7Q3oaEXpgCWMrjR29C4eXX8MGXX8YApkk7YdWgnZJy2w+6E9YEffnhZickLHgCQkbQ+xNojDGKIImsz0w7wwgwDvIOuzDoToDm/uqJ2Ew+I+gYk0J9D3ToUyilIucs+CdWmmnrH2xw7ZZNkXGoXbRpmn+QYT89hJfI5mpGIBGNLm3jT0A/bpcUha6klSIHeRG6TEH/8e1ncIpMXe25f1n0K3yk5gz0NXy/7Hodazijp+T4WbPq2rfdZngaf1lb3ixsGZ1Ejp9+tOlHEAZUXyGJ3B4PH99Ukvht6d7Hw2a6LI7+7439VFJg/fMXw70cI1M4FhGjBHn96/Hv5b7okd/HXz5ydtLoEHCl8VmiJ2j+7jA0UWlaPN74ln/xH+28vo9eGh/TpRc8iuUvTsoWg7BW1CGwGpXA3Ns4AHy16DSHBbIA0F24+lLwkXOHXelY/c3r7cWVq7FHn6+ldbYubBOZe5j1etQ3kp+2tpSGgsrpshfRIQjRzTAtpOX3eHTKiXtf9S9A1BMVPQvop6O1PDa3ndl5+qhLqtf3v3YB4ppNybVweS9omR17QBzWPQ+fQEEwbvH0mRrrylMDL4qhCXUuGFKFuM4aGfM1zR2p5GvAWa/5Pr7UW2mLlc9y95mFXP1I28BKht7K+SpDCx2Zo49oyCQzEymxCUxynXD2iatXVVpceyiqUH/RZppMUXqKiwZkEhMu6XhpFKQfV6CqUA1y9nwSgCgPCFIJy1HIoYaIdQPgFFgiENm7UjBSjP6V92mRMsKkXHqaBFppnog5JHXZx2E5j0MdhjZHGrmuMzfdtTIz2f2PBQSGksWhkuKOxVuvwtbnO8ndjAz2ipuaGluHmFpbVc1/SOor/0peVonNMH8vto2c+kZyXMdwqNC0l8BMmy/UwEQhVYhaDKUXcrI2GKhliuNTWBjUxl8TvhOcJ5CvoKzuYjjyFq4aSYCFxsGbNGs5N+SrGLFsvR0srEwd7FwTDEpeujSw+Q+JME4GMGwHznVIlJWOMs8LGw36RkidFR4+GQYmuR7rCPYdowhLkn5SOsDgcOklE7E4CKcVXzLvhQUYpdWN3J3FozVgfw6iyTEBhhL5+jDbMUGMyTOMipUEIhTJi7MhVCZnAJowTgIv/DK859kEj01FMTwcErDqBRRSAOWunqZKRxhpm3ktGlWI5M3S6TCRjicuscP9Mev+gvzXUZBLacAii/vD527A+JGCcLvfvqx1+WPMHPbYXAKHjP4SgGE6QFM2wHC8QisQSqUyuUKrUGq1ObzCazBarze5wutwer88PIIJiOEFSNMNyvCBKsqJqv334n9AN07Id1/ODMIqTNMuLsqqbtuuHcZqXdduP87qf9/tBCEZQDCdIimZYjhdESVZUTTdMy3Zczw/CKE7SLC/Kqm7arh/GaV7WbT/O637e7/eHERTDCZKiGZbjBVGSFVXTDdOyHdfzgzCKkzTLi7Kqm7brh3Gal3Xbj/O6n/f3/wBiJJyrdFYmZ67Plu1/wXI+vzzZefPlP4+SGZOYjftm/nud57S3naMAibURm54l7rXvx8x+31r7/mHFele+v7z33PXdMp8dtXN2kO/AzPIdkJnZZVcdqwCJtZHgAgAAAABARERERCQiIiIiYmZmZmbWfQOQWBsJDtNPAYQwxhhjRERERESstdZamzZX8jA4QtbnEEkbpQ8AAAAAAAAAAJATAAAAgy4BSKyNBFcIAAAAAAAACqLfiBPH0DmgAIl1hCqllFIqSl59gB4UxDpNlLQkSZIkSdIORoKLmZmZmXnRn5773vPAX1fNxv08Rzz+AwAAAQAA//926MNhbEYAAA==",
+		Contents: "H4sIAAAAAAAC/3ScU28vDqBsa9tud23btm3btm3btm3vGr9q17Zt3fxzc97OmZdJZpL1EZaHgjgzEDAQEBCQuC0Q4n/dpAsEJA4NBAwiB/S/REBcXFUOD4cIwhAo1hkKDBkKJriKNOjYDJgdhBQ03BU2lBEIjAg8ghgyTUnAw8zYHALXLJbtKjMh8FGwcFjgpx8KZuMlkgi31vXltyC1MDx2KGV8bYuWhQaR2Ql+WhyjXksB9wqMnL/pvMl40a6ULUg7/kSStKlaKxo7t8/nShLa2jQcdiUK7quZ7LVrvSLAi7gCDPT1ZZUmTrArVq+vxqzg0+dj8dM9sVDeJIYKocf+PWVSwF/gLLHU8gHoN9/glNyKcGxqAn8hD3yoNEagm//rWF+G6NO9lQ7XZDOO6fopECWKWaZw36vjmZbC/qhmDwiS8o5FzMNqTxgcM7iQvOoX9nxOtdXWco1RDmdxbWMx3PRYa835/ra5tsXe1WpHb9vLzTmttbf+M+sGVV3TxRqhqDj/2ELOHrt6KUsySCOtamQZisiZ+YnfStVQnaNzVpmyqXJyHmfvl6bQgf2RwuTvhHBjwgnEJetpx7+5ru2AiJCYw4HselVoPSMdJVNeFg8nTtr4sBtnVSmMIs59FLx4Rn6xToF3arHK04zyNJL5Dw6KuKvt73AVaDhYB5PPqY5rgxgOchLqBRqqvpZCAA2rEXCjEHu7SeypV9N6Sbg5pTG2+PnJ0e95KymPD5GW0AiTuyKdq8Tbb+z+YBP6LPFnY9omsMiwOR2RRvBrPUj2ctacm8y/RhmoQ5K6a4Sj2n/UMuG8m19gRJQLu5fLr6u/2j+G6zVwx206GWQXWuCq0bU+ZA2T0aSRdUiFLj4Ot9fYuZgPkSFYKz237q7Ejnu5rIYTF7tV0fOlfg5whoct22gfFPZoZlv8MEcSBGwuQN6PBvKPMMmKMkvl7nZqO7CT/T7efVsPN4+/BdhvAW+xEcQshrbwyXig6Roky4l9+liCNHG0kCZdkzYOVXFCCriO6PZubRXL3o/p3tJUz1tzZQSvmZtM4377+78KfDsBde2Bnv3KY6OGQ9JRhhVOIehlwWouDAM0bzaL7uNMnrY427un1ii24Olitle69qKxEyNj7Q3q4U0nvwBrixwfbdPjKChvjgRYNLbf3T9gnnbDbA7DOpB4fQNut5/kHQ1MG8GSSMI2QgdXUE7OF3PKiaPwJR2NGAmUuhaWT0hoExxRV4vRXgl84ZJt2o9qipbnAFJOJGWLJfBRrEDvYYvZukl57pg9ICa3WtKtsIbSonx+5W+94w+0DITyWuKCJ3OO3gzYZ5kG/QCKzOrLPpYUGjGG3lH9KC01Nr1KeWFM+p2pmLJFzt2/NHOzCZwJ77S+UT5yvrFC0cjHbka+AKPI5ICr+JWd6bCVPDIgmABbGYlixWtaTHk3tJOtZZsfk1tOO03BjSXTX8yZTIGA+6+Nx+JTrSXy+R+fi5fUt5bxeQSBTcUtyno0iWi99oH5saOGI4WjhKOOo9WjjCMIZ7quZF1Z8Ukorgxa8ooUryNO59rP1k+WV8Ktgk4Bx4Fb/gv/ze/uT3SXs6Nf8Jh8FABS8ZLoOFSp9yPTR5EAiq4aG4YTX92664L3cjJCOLl2or11Mdm5nVwrsV9v1D9Xmx1R3G4Py2I6vl+7Yvw+YdTr82cO9vun+hBy7sWeSSdXYth/TNwp6LWXgLTaKjeeJ2IZgyIGadN/Jozpts3PeIz4zX8p9EExhNvfOdVF2le8LRohmvtqESF0iHimPkyvYNa1/mEaB0yEuOEjRPRWufHkCuZT+wFDeEX5wtFX1VKI8KPqWvDhPnDtqV/+TWwA7tBxCPe1uCt81k8khMtv54/+Mu2F4aqbH/88wrxZ+/uWCkMt5itUhpBwiEstV6dzqENtp7CFsKt07o0noHhuv40roEyllTDkoEquMw+sRh2TxoY0qLIOJycM+3MFVlR2p9OCClMXuuugJWpZh7Xic7VA68fEax5HDsJuWYoVTpEQFAA5VaZGol4JtYNc10tYVCb2KRpArDpUtlMFgCgWEuUK+TUT7uKugoRAHyVDBFHIHSJaBICh3gdHrImjuEXbE2FwCJApg0qwEod2mldZjA+JX3QHESEY8QUn0bWsKGXs93nRCPfRjr4BmEemUf+FsRWnRdxlhcZ62heBo89Vg5lT/ylqObm0PfCV0FR6I9oJUXNMPYL5K+ZGNCyYD5xQfkx3FwhGKOpAz66l/RJMR27Eb44ikANUkyKfIJ9bDK23rMCgz6O7gTAsPQU/LwwxLuYi7YwNVp2IDVw4mv08hohG8RBmgcJweLggjElWUETgQEQo8nzRbZ/GEbPFDe777BUi49pM/kpasebS4Nv17KEY+T6qa6XXT6qNIkgDPAvX3r4SyMXoqdfj3FlH1j+xQvMCqaxWK2YXQYOx1VhdwuNR6FY7yMUg1Qu+zDHBsVLepfRj66Zb7gVA0IhBkqe65IzaanTXuqF2a7UDSS1qGjg/qDoA67ajhDaHmBLre/iV4GyGK7YmkartU46fwXz3hiNx6tzt2AWGgA6l0ipcJY2B3QfkhJsYkA3Y0fn8YaQzhOXZPw6rvUez9FJ/PhrSk3T/Az1P6Ipn1Kcrc/sXiQCiTGeGyQcHlPjUmohd8CqdRZWRrAx1APF8LpN4fDlrTFsqXOIIIkcl5TUumL4FeXSuxBEyaGypVmWMyez8cz1ldC0n7x1Oa4jg172HEwfKtEm3SbgI07ZI5fNHmQpiEXUp/PS9Ib0+ENYHRCp6KfxpkSwvtYH/kxEQv1HeontHkWpi0cdMxlqoFC9aBMM4m8wi3Ew8KRe/Ek8euBltKYU+PrxRsahsgdQhJ1ZaVFXUng0v1TdHCVm1s8Kmli6qB+f3gJ8FaXzNSZoRccVZyf+4r0kE3AxpmUZsS9A2F3hgjZdHTxa5GYglKTlOByuVXb7dScKUjC8CzPq8o7UDzBF6fVOPcq21nV6kDucAhcOvOKVXxD7QAZn5kqXA+sthjgPf5w+ERSAkbaolvjIcOEWfvuPwPPLjRQU9xmk7BtvOzBxvFp1LWL7IJIlbpAPsGtUo+GciDI5ZN1CwE4oNYnQ9ZYpMe/Xma9NmJxkKwug5r35L8IPe0L6SZUpBPLYYSZnyMays/fW3TxvYRtYGXJzoWlLDWosQXqr0gm31lBr1nn1jzqxNxdiGvrSXTZwW84o+DUSecXc5K7kr0Dc1XL080NfXH1z+KYISmoi1I+9qQ4/2Ckfa0df/3dNsaVKochmvqIEtMpKhZ6NHpfQb7OgpFeD+sLrlJZDz3kxh4O7rfBE6rbkF8XYjea40Bd7Yhg4+miIsyAe7rVm7+1ErjIrFNc3aklkzolq1JhwlXGVSWDeEVSOZpFgyRxP8EBVkkJKyoiW5TlJLWj09ZVh4Jf5Wci/Mc9g2TLOJnBomrKjcyDrTS+QKVsftCvVMrI0q1i2t/0dzeDxlr9aJ1cBUVYOS7WgrEKzi+PeIsHNDqilV7W/EotCs94+gGvuvPb2GhOb34BD4meSVsswgxiYOORSFi4dck3X0VHxmTBNvQWpTJks5VWB6niGJuCAKHBacONFy8R7Uz5p7nMOsMaknHOQtTfNer9sneatflO+UJha5znp1Iqd4DF2XGDkDfzQD5oLEX3qjC2/96B2GkOUfP6pc5GbU5EeKFM+qJFIFPl7g8WHvrFKHo4p9IS45wrU8cvwH/5uirC4WbOc2cBaLgtxyigHOxRLdRrp2OVHviTPkzDeb7lm28PemViGY7eHvagmuqiNfJrq1P0TjnByfSDq5AU+ltHbKN26+5Wwc9TB4fJo/SaW0mUbSFeu4GDmd+q6t8jmsS2u65kiAUD0hb1xJfRCOjmrxtDO8n7SsUHIbYlxZNX6/K/5TZ+FjTcrN4OrLYBDVbLKBGljpaqiB0DpTS5lRwtTHdsWUZpkhAlVxI6EIFknJcBEdZx6jnMF2U5COLMwInDQ21okZuKgMW9lQG1BrCUQcn6IrzgJL/6MESHhzZUFQwNUXAZfHnnBl6R6A8RLWW+X5qfHglyKjEA3EiAM0WHBbUD6nKXp7rMs2yRjLmgXRUh4M9aZZ8yVGnGHy2qzYpWSd4iNa1EWp5p73x0Z/6Rr/YbW3+TtSW0hFodIK07RbRfrQE0+eVbSvZVBm6U9r1/I79J/1GveoWkshw02x9A5NpMQ0IJDeKTr4YkX461HuguDNhVLcAJEL7uFhjVlvlT6XUVMFOG4opHaDgZrlacNobtcho7kOQBIsQVl48+xay0cxRlA9fK+U1h0Y6zgcCRFS4hv1It4sDS/ohROQfaPDMlCnG4waFNiMiYdHwqMnqWlbyx3u2ABCnt980Oe/IS7Wme/s6y9QLsdaalXuDzIJdKWTMH2jghj0CKQj0O1pispTsxCCJ+OXSjA0tGhbupUtas1t2VMy6r/dUNid9GEb0RO60YKOyqSqx9Qy/7BmwfugOi0dIwYjJ86GgncPy+0+hnCTDugYUhxUNljid4fF4vbm51wdAjoh8/1OAe3qEQ7PkGbSsIa8WaQv667Xayh9u1+E0NV6FtS72jmIoW3sTo2D77bJYG/+uK9iDTUw4SofnQzRye9WJaOqY66zNsHj9XvNGtsj/XBbfALWXUASxYszy2pq4ELSY88I3zkgeIbhi8bL00kZIRLDkc9Ks0LzNVVB9yPpc+hjG1cmIZ8XfRrTt1XoZ6AaKnZQ24N1Fi3dG+LT99VJ5FX6GM8fa5nLRZHsPCJM3HybkYpRR91oK4ZTG7mJ6uq/KfjLYnljbMStlgo1mnv+aLg1O2vyLAZsS0F63d50P3VHySIaS9uuy2VxwUuRrQCsabMtvUbd9tSqAXz1BmzU9Jhfk2USfDudMRu3nYw6g6km4qrJF5c4yZaAJ14/z9smkDA7RuNjT2DJJSdjwiQjFlfzUznVzYV5aj2pHiw8GPQdv1VKvI/+XFq51NC4dL5o6G3BWGmhzTGAaVRiYe20wHAFu6J604sOvKn6ofvTCat1/uJuTpKtpO7B6zJG7N8zn8y1BxC5p+O+iUzd7ry4Y2Ctn62gQDz8IEMuuRFDUVTjCN4hcBQeIxLvh/Ak3mhPYohH2krTYAsRqwHDJJPkHAzuIWDwiQOp1gN+a4+OGT9p8IlJ74o4fW51evxv7tWfjRhUn4HZXgjKiWbh15NpvMly0OTJR894XiwCUWMSYpWSIp+nZMV3Sbc++SAG1EsfZ3ZwHg+1dtQTwAOKyz9FTEB+Cm/pqebgntaBy/Lo646cp1tjBJputjFQ0oZLJylyfCayZ/wUZXEUg74vqD41FqO1v91eLQ9JB5l06I4ca964Y6aS3GrtHTc3jrGuCLOFOXBjo16f96Iip2oWkVp1ipLxvzPX7hB8Ebw+1QJ+GMdCThp2CwPmdNu5IvYKyQdkKKKNOaDxcETJPO5XeNMc3hOR2XP2loa10guGmgH2gEMx7mGZmFTiaUMb6SrenPmx4iM+qHf3fI7QsgN5to42w8aHnHZp1xiUdV3rLvbZMDJQhOl8jifqMgrEMqQ0tbR11ibuA1xNGvgjXRBjoK9kMRjko6unHSPz+mjPWMIUv6EoJhrHrBjhsmnGG/YHzIPU4+98FudnIVJcebRs/3aUJ5IW02o/qPHV0gJFDstXSQ7jEvHq/OGKsOBazjtkeIIWRf43YVdsAgl5ABp6fPGd3RFwsOeTYII1dFhSp6/PoAsXCvZeDx/ij4fyOkNdjYOotAsbhjd/Ck80hef2vRgEwAldH8L5XJ9PAkegL4xL6KIIIuAkfVzoyCCjBOJahqxNdLKe283KxtrmRgrLbPKkD6dW4mMlj4S6KJyqzdoOImnft8+1pMvPEFj6pEp7LfL83ysmJKgkIh/rTZ6PxW5QcT9VkZNmpJwQLWa25FssB9YnU9SlOnHZOhqiA+2pFoklyvfX63alCuaDwQVPzKyRwXKGtr6JPWtxHVJcYbBkp1hNvUBUTSOy3wLMRF5RT93WTe96KZ+fcL6G+061mtndsWU2ZsgGkAR+dIp4YlhU0glPniCzStkacG6g4LQ5EfjP8PSX/ewBM4AkLv6EoOse1Xxbi1tvQNrrGEpSD6cuja4jU2I11sPCnaIIEuzAN3KU0eweZsSAI/JuMbHGnsacYHe982BPi7fmlHIWplrg5SoZqSrJ6+G9iMzDrpbGGiGEnPzPWbFJin6RG0We8Rf5vWWBHTsS2HBp9txnFzUznABeq9C4FF5N+1gzmziERv9als7BWC5EpfCzmvet1JxYasqfZDvumMXPXE/LZbOM7fF6+15vXz0yuLhEO+R15yG1TvG4jYZE8NR35IB6pjFY/Cg/nj+I9kTi/eSFFg8b97+KUeHbwA0NuLg85KsvVblvt1ikpJ045+I1VDj0rjnC10qVLOXxfeiU+SJ8Hx1LR+Wag3NrZi7LPNtWnzXtdevRgr0LUP5f8teTuLhzRWLAAZfQyyrKMWhgaEWRlnm652clH0PCZvcOWN3hfbmFrV1zwMaqCxihEPZzycjWbiBd/YO40JAWgkevFGBOSpep8fae9G7sxupq5MtwOXpdPJu31eh/RAzgQjEgYKHy49jGqHe8u/Anuz/tApWV3JKqnYKT9zDKmxS/NrQZQ9r97O57i2Ygpp7t+8r17VcUnCdVyw+GktrmuAvUDilWLQdPKhjtEbWLHPWxlc99RcWrDYx1HwTT/9kFBZ+Jil1yBgGN+TicEUjIRe0X5gI7dW7PQ/ht3aiYEmGg1SOK0OJ+glkNbmWLXeCjRJ8zNO6MxOhgmXLqxa/SyolIZc8BHg3cMmj11LOCTO6JM45KHbEaISzwBa8Mib2oYmh5hFv0zerybZE2oMCKqpkpAukp82YBo5kHXvx7dDi+c5Z1WFuoS6Me2R8/FzxV1sdlZ/jJ1ytxWAuLVst9TUcNFwbYUA6+fwRfqCaLtwmqR/kC1mdiVRS98zLO8Qkjqf94+1H1+o5VqPr1WWMlhYuVuIrUah8X2cfGYfYFjPTlUq/kP0+yboav9ul0VTXB9nPh9ADqwSvjzyIxkyCSt1OeY70NNaDs0MyeHIJjfK05retu2faxErl6X9oE6T+yxQaw1GcYZrQ1wAUxfTOCzA1OkXSJSfF99sFzk9yRivYeQh5zBycYOiEe2nmMVjHwuFXMyq1YVI8kppGoL+e+Q/Q6x4oYZizRBpnxRViYSQeWgBGeBr6Y/AZiRUtn5TUn335crwTE5/Ou/e1pFa25EOlv0vlXS74qZ31guL4DJsXk6dx9WSzD0R6SRP4COsVS0yKINFX3DqZ5FNNJR/jipP/RIW6vWGynfb9bWXj7O2VQCp3zcluiMjVxtbDsy1/N9D0rRcUcjvuJyK4FJGq871YMr8Zv+Yeg4O7Do2wYZ5URhwdFzPqOs7r53dzVrQKvm2R5BYLtxUywNxYx/+oQla390zXtpG1bjF9pMQ5CtnkvnRE9PuonY1Td8qyr9+HjeVnuiAkHpgy1goqAjVuTVel4Q91l6st8+65aYPmVn7dkKGUXOKEQ03/x/x1bVqwP8HHRvsLN0l6SSR3NRxX2SRadcX0jj+pb9k5eeqVqw1RzMHpnUypnSUiayIh09h+IT+qHKQd+Wn8X10MuJO3mPQP7cLWjzPaD+xyZlxMWjMjXyZXwvIKaE18Ct0TiBMoh8Yt8oi0hGcx0g/2WNfKm3FGybGIlW/IEtgMzVEPdS1vpohdDrjx0ZzJKzmdFMKBg0Kk0bfIR96yUmmLjyo+FCxF9Rua9uCJP5FSPFHsUt5icWIc9i84keZZKOiqIY1Zpnr35NYBAPKzSU3ISQs22j1wwuFPCPoVE4TfeyWS1KMKurENtoqsHZiSHFCMxKd+y4hyAdldmWGH9xXLOj76EylbkSCuRAXVR0ABvk2dZSSBwF9M1dgoP+FTaZcNCidPu2uhT92KD1ItD7fv3smfJAG1F9fczrzyejqG18bDIpk2+diG+f2IUD5XE7IqKWBHz+v4LCtrnW0ZFKdEzqrkzr5zf6JC87bo9nteI9rI8FlNSTHL+4TEKHgRPL5WM1kw0v0XNX6PH9hszAXQjp4bHpH8/M+UV0vAnT4CoqN8xSQ6+Gdb28oI+WLFZg+MZomi9ZIIeh+FJI5eER+PZlDqd25tHjDm8suDm5fDDugjyjQvVa3JLaFwlUYXzzG0wtn2vlsdci7PHjkhmoeHsajl0uT1qtoifNhSzEAHe4MyirPWezm7fhHcVy14PUEGHVs+WxGxS2VVUf+8NX8thSqSY6/2Iegzchr33cNesHvqS8lmmgK/obt6r/AOnXLo0tteYwhWuDU9XMESwY4oS/lqfHTkgkpN9/zhuSPti5DrEh4oWYSPgXnzNSqIIf1YqPIMBVZS9VtbJoIP5DOFCz2uEzmg25pPQSh5f7P6D9FBk5A+MbwYMBXjkjDmjMj9qwsPctEfzgki+ADE/ElbEk313u2+R8mqWxOJTeWN+WcBPXSTWGZmgXB/uVRC89qaW6uwW/PvCmHFUTZJ5uLVHiwMAAql7+itFqlQ07wUPb/K0kC8Of2mUsJsP6k7cfIvgqzy+lQlNoZGLKl4I5ymA/ENjJB8i91KfIDNnOCYKvgPKcNZhb86t2SnFyS0J3SSlbKeXI/PUzW6FQPQlqIYyL0ktfw1wgBKv3fvmSW+qytmPSCAG0chzKuk31U25jY6MSgLEUYvwkRwyz7N7pnJc8arOr+DCcHhFLjur8dMZS1IhI092e98SUfi1r7Zv33jk/gHDdB7SnEPWjm6DbqXnTla3X0XluaG74Y/m3ofBB2EH3nP+7YtAwFcbMnFZYbWpRVbXqPVJ/oi6I6lDzGTbTWIcEt4+vBo33Mr7ZXTLXiCmV5oaI6zNMRVuzWQsScV/zhTJBzg/E+hd3+6RXHcrcUHZOuXugcjrP/1riWs4f7L0L5RX020l6mViz4bxPz9DT8HbT1VJso9QfQCzLU7S/VGVXGzeyKFbn/SvlvCnIg1zUOIgoLtsELlFbpwBnrjyc0IhY8AzyipvcVdorPbVcWJND45ObvEXNqEjld/C5eBVxwqTFAsktKLLysU8U54QT4GTkJfb8wEQs6Qlwn2yckXooIda8xYm7nfi12os1cFOHVquvGWCDNgm2W9CjBB9TawpLVZuRElAmjhV3gp6NDCYETn9lsygnwBbzOHVT/BGlsyOISFyExVe7BudJ3cUJ7k1IF3M3qemGSjGGMJtvxv7iF3i7ZdLUWLMrytoSGzjndSYi3kNbp0LCRsZ5O/yKEDpx4sqlRxQnLW5PB6fwt0Arh4NRW9IFrKtGrixFlo3aXYxtIB9qnwWHY/J2//q7uIoG+VnDw5lWCD9zOcz/g4RWnzcqr7LzlnxUhRwvCCWCwyCL0KdajJ1Dc6L3P6DMSobOL+xZ3TocmZagMsiwGRQqyuLygN/hp1zSGBAFDhPO8hqMHpfMqh1RL9IkPzXBC/xfNVaWD7MWbBdIsAiHsV4B5kZuG5INvI6nzS6xBsHI5Gtt9yXi81pbDubQEZHO0zlR5YPKp3KjdGlBVK3aA3ARVKd5QHUTLSz/U3mz2fH6ytI9Fj0QcxVPy8NoSdHLXmhDEdw6KqifrbnrViz54IoghiSTochNoqArIIFpELNbtMUhcMaTTH0fHd33/FweIuuac91IPusuibIQcs5ZpRAW61l2/TKK3pEriXNdt9JGqrCv5Ays+Cmh5sTLL0MAjYYs70JxyZ46orK80+J7/RiH6qDqeO1mkvPkWiPlIo0gtZw9xCeOm1VztvG/irEO2uQskHoyRSpr/cIH2CEbWWHSXViRWPZbPju8jWTD+ksn13sLOz8ZeMUW1IuZdyJkbCpccFVubkfsj98sEe2dzv8uBfzlIkDBDg/FdfG+kX42xHerxsixUuwgrj5ABbu+fTYzUuBXg+gVithhB3+EZDzwmeNNJnwMBfWIymSjoyH+NhpGJjg5j8PV1MIswOiBUj79L11EkRAM/C1urbrss7LrAHyj/6wP+Wb9Yzg/T05H67FDRAfjZsHoewMVHRRTAHcjqSiF2VhExkw9Ug4CDC0FC3hX157z01vQ+6Zo0Rh9rkx4i2qqwtBWTpgFEblcsZz0no9ZInkI/VItbYm7CDkw2dSjuLHiaPwlVsOd7FWIRDVo5M/h+hsTYVvFM6VR9PHzkmUTr23Z4kf9Zc3/d7sQ40v+TeTlGyPgH0xhKe9yeKVAltUXfww2fs8m26Omkamv1qs+lQgxusZw+Wc771eb2MJ1UebMPL5a3whOVzequn6OqqkEaY2w9i58EoHVjJk+5Hs8W2JX05t56fPMzfmCr6gext7JgBO0jRrK313XDDnHxB6JSVl4gOtfefR6MOD5074yhGbt+SyHeeC7l4ZhMjiNl4lqShi5A7B1tsLZSQ0LviXQUSGvhosXXIOLT+7KIvFaI6eXDxv9k46uXc3vuDBc9rnH3kWBd1u9tgRI1Gh+J6d+jtZU33ny0kG+nr+z8KTMxj5DrPreShlK9RP1vL+lT8syu/F3eiJxHGs5ZET9x/Z9lnG+3aTdF46muXlv/P39MRjT9h/Ut+2lGQajsV0IeUTLttYLVYKV/PewCsGykNum4zkyx2D4fltqLiGhhgjheO0L2aEXWBeyryisZ3js82ca6jcG4rrRR8vXc+c3YN0P93fdeq++B+d26TtcoNA0mGMch9q2mZ6c/zdtjEvLlYSuvnbnOW0cxszTa6Fed8OEQ2pdL1WXX03425/nSl4w7FwIJ4y9/jfV//9HOqcjMRn3NadWXJcftT9aP9FAovlDDAxkgr60wXf6nhQXDA7Uw9E244oMXIN22MDWcgmOLH+9AqF+2v4NGnlAR+5aA/Ix+d0LHAfIDqdRD2nTshrHqZQgIHdxl/+im5GK9wW0pmgLyp1TsCwfFSFd1QWoOGZ/n3URaaWjHGRddo5uVTL7jJPP+B2sqUbjSiFu6dm/xNmeUozXQsG5GPZEfVCR2Rt0WQzKSc3uapIcth+xN3rl9TU6cOwfXmjPr37t11E7yoz66KDXKdFg3SjBhhUf5dk77OzndUcX8NIFz4GfIjt6u6su1tOhz2/D49KtYIlIw0XPcf/awN/DqFuEmV5EOaQm55IO7W8F6TS0GXHinJW+alKKay3ecaX4cPwHcbKdl6/UZaksOIV/8G8lO5+lYdOhAfVYGvnnfrdR1BMTsTBYSTYoDR3bOSZA7Nf9eXQ2cl7S7yNDHO1wTcrW41Xu3TLYQhVR1WILz4UDEAhqiit18pDq8aF3dA2pZTTtOQYnW8Dcc/qH4h/sQ8xdABhAB4nUq2f5SRW/Lc+MdcYPkhFwmXjbfc6QCssj1k+XQihftHtup6Q7O5ORTQ0mGjV0hoa5teLh4t0t1VKqHLF8ghMTCFhBjl0v3naD6YSwyKqpTYMLYOBhuh9JSTlli1PWmuKSPAIUuLjLS7uqz/4ot6cGS2xuBUsQ6tw9JShc3i3NyvALHS3o6vJ132HAYOwk3jnd8XxOHDN/zgy5l718ImTQEHm0Zl6ETF/mxo+PAR/EJXV0mpPUCkni8WCmHABwrq5AI/7ifQzjQefO/W71Hxv9iv9NZbWtK++3nt2YiJ7fWw59qK7BbSxBseN7LRwusRP4Zm8pT/YCpsZUe2MFlYQZNbmwhqRzE1gE+eQtUI+V8buZecdYCrANrmdwjPFmKV1m7fxZebnov0UiBtRzS82VqPmoo+2nevuUxhwCVZabq7EO5pYFKWu+HU7MqWKYD5bfiNQlvhst+a4rkgNNEEOXP4KUIqQiHr3MckFlb69F1PEMmOmivdU7GMEF4JY2J0F/pSbrNaWbLdOI0Ze7SFeFwKvBYjQeDtF2YGpI3vvp+eDP6qRrYm0AXFjCDIhnBzeaXRFw93oIHtRzUkLvjo0GZPBvVqnogvxU8kjLo4HLlsFnPaSJgs9Rg5euRryzwiYM1oA/UqysDuese/PiPp+gsnlHMHtvyCxyscVNcLkJhOxSvdJb4QQNMC0WUOW5meORQC3PurCXzsWsixhv+HFl3DGDZZXLxymAxpuU1F76tgd65Hf1QW4a0mDXUTfB+U/UNoQxrqSmG+T7ZNp20MltxV0g3U631Y6c15k1umo8mo0/Luszu2yBLB+60NUAdyNM3c73Wr/zLRilw6j6UhMpc2oGpo25rWrV9hSBd5Ie04QinRNqzSweTu6umgnwwPCs3ka1jo6R9hmZx9UOlc6G3pxMlRrVNPvs+egZkLNKYMUtRAa3fmU4P1FNQOoIXt3Ebc53876q1zooIehwKcr6+cCwdnYQODq4DWLlctsiZRE5qGw9rFL5+D2U95AFwZtfR++2eZ8PpfKVXVdL3rTaM5vS8x67P9udsYkDyu6LJ0R2n6yoGflvaspVOl8bd6Ma/dMqrAcD+bdFORRn13RZlaH73KP5GgT/vSPLctG5dSGr4Cr5OU9oVPMtJ0cUkM+P8RSC1a+YecRjjb5e1rYOzA3dWHvY0Qe0MGExRK1aLSgRdf0qeMB8Rw5ah+DccUJoPqHYtWQ1rAX8Ka2KTkHGytxkj+iuZjhpk2KGTdsKKHRdvzo+wo3t92eFmfeqpvWqtO1U27pHRLR355Nx3MSojA4WaoX4dor1z/WK02IRlG/q1FSM1meMqGNGK7lKcmi+ECGBalZw8IaYu4tEmc7ELAAbfWcZKlXgtiCh7Vx40EvXF8Mrw3CWxuBiRvtIeWL1bXP5GtHxlgwNXV4fzXOMP8rXOEGmxVyW9eWMmdPDwFmXnH1oIGKjorCNMcOqhBNiCG4nTxDrq5CFpGl80Oz6GnTxmph5F8MffSULv7+/cuve5M4dKkU1Sm0YSDSbhVsubtojGirBw+OE8G+s7KDv8VKQI2QLTTdgDHZ+OWkGVUKxhGK24Om9aBJH0jXH72x0plmNBkxon5giVmbbiVjkbY87yTfojwaLk/pjEwxpNe7R7aeGZoQVvcUFynIsjwbODccZF2BoV37Kh1OT8jzdWhvt/3RsDJHq/L5uQbG6NnQMkII/Py2NttToiAOycJSt1jRHlbDS0qxidDTKZEhRtO64VdWNvf48VgRRsF0Z86SM/a96AVg22YgL9XO1cVRFn7iMLW7ftI76eWsqBjWnf0GDmXZDQ6dqM4UfL1yGPhUPR9gMXPTR8Hv0BCMrkb/Sk2PLzfjsE0ngPwjfEg76BJ8dMK0NbtLmn2E+9CwqV1xSL6OlnwqANpwYCpyvk+UR6kFKFex4w+WJiZ3dN3ZiqxWPIwIBRXGBRavRXtrzdsAa9pbf2ONldhny4V21ccfDSdt2HmwpjM6LIyzwNEfQphKFClFSQ8LEQJx+afKZ1OkqkLrixDovHeMmM0l1YHYJzyfCQmoDX+hAB6+cpcAEmqfQxZMnHUFQxqcsVRakbcOJ8cKBD9W5ZsD9XTlYdZrQFEs8lWlg4SWHckPSZ48Hbg3Y0I73O4D0xjR/ptNLecsySYQeh/Mtwf0veDQCFpLfDnGtSDDNvfGV0NhM0lHDZkWeiZUjoKIIeYL/UO0Q+S0mIqaZCCgW8JBRvAS7yvH3mheTZOSQpOsQu13qgFksKXHUl350W5ut1W/tjjdsrhlR+TxJz3j5vWYflOZJOq8tppn7Jrwo7zJqdDpkYMpGvjfEelw2nbWSoMB9uCjlcwe+ZV01VytXH107csOzriZHcqx+iibYsA8Iz9afz+RUh76aRtbdbhGWpVknZTlKs7xO/k762KclO2SGIffgcvE+aidjslE/xVwcahvnKk+dPHVK5ma+zkwE2K5KGRKM38K/Zlq7SIuB/U5n2uIdqdBxWFBPxOgjJuP0VH7MxuCfTEmWh4s1BUTDYjyGNPtGoFgLK+Oh2hceFGvJYk00KZoIzZwmP7Rp1p8lBoUhUENXzuZcEwe6gvltLDc18EpegpdJkOCR//fUX8+l3zFpxnh77WnTAxcclGlboSH5c/bvX/Rw4M2iKPksnESSszsUVQnAVxP5KaOTTvnweTsjM3rAb2xJiK9Ur+mI0inJ4KWkX4bFgiAOHOB5LIAK+tQuAXJw964dAD58TjRbemu8NyOLV+8GbHPn38cwEkIHlg3ZPz9EX8kz73tiMsK2wasY+GuDyPctyQOUPP+pBLI7WVnTACIT05CcmYGAw0zgDEXxopAcVbG1vNj5clzStwkzJIh2T6SnRFus3WLS2b6sIayW+KBVcTfdrLGk40Bk8BzdJ7l4N/Hz35Me8I9OnRvyRO5VuumUexOVCLCVxJ9nIiVxMWf9DGShKDcWnfKj5So3y51me4JaNiaaCQGrd7FwI5ew/zt7/vX1ub2SZcC0eOHyBzKXNleJiafOOBHEnHU1sYsqW/Ecvfcg13m3DPk5FJR2hgZSpegwkI+AYZ2+xRq3nodUYIwczln7mFLn82n8cZ5HEr7vLdoIgm6X2+db6J60aRRj5C0WgjuEsSF8MCYf5OqvSc6J4ec8tEvwpysLZcct/2ZaGrdFutagEHMOchtce1DP9bUCGASN2YPYRrnJIbwWi7nz3wegEuu0xjkKrkkWlLPxomOfoFtMFxFsR74rsfIThhQfUhNTUoK5JVCLumTW27SoCCrM8cl79T1OtK+NLUBnm09e1cG81qw9sg8AUf3e48EBM7v6nAOYy7HgYT3lIwbC18TVVGYUth9OXyiUPDmIb/5ii1PzbJdPHOjt3RtxDj0ZldN8je3OdPah1RZDdYNwcPzqlZ3qnR1FfCXYZi2hBH9Pp07yVdUb8PRW4DREN2qQA7jXPbCK328dwT1XyJCwwfty4AYCUMB98du7gkYXMdsKsWJEUWktDl3hv9al94EW/I/pYmVJy7l8KAmiOSt1nEEhMXgdeg7NcCMGao1mH2Q0BVkYnXwOEhnjsYcLp7EL+fxU8GOfwfzSFtcl8wHrNZxKfMoWFwXE/79tpLi+PDLOOCUKUrOJdwMAnMqt07oWjBbSymhWc+gsL+f77BhiRmq64H8MhOfDwL9BM+/QghjbMp+aNhVJ6m6HnMskxPyNtZXjH9Vn3cr3fTfYXLrzlhMSfMgsJ+A1s+hl5LKKIo2wBub2XA31aifeEl4nNNRop3dOaORRQTrNw7GuO6fBCk7Dh0bjXDq/YHnqlHH87uFTp1LUF/wYHVSO2Iht94cbZLUMu4l+uqKhEUfcEV3jVPNfY7kmA96SK5ZN8JwFvZQ5BKg2nRLO49RZVg3KkmLF2LiSkdusqmWg9FdOlLc3nXJ4V1TNcqPAxwKBvMKyB3AUiIFXED10hfRUpAJ0I6TBRnh+ZvNgV+ixA/mxavtpzO2zTJ4K628UC50fqe8lhpl1VY7ctOz11gP/5qKC+qq17OWTwb4LClbXf1gw0bOcp43TFZD2Lt914vfZsoZhFfwlfc9a3Pul4JKHt5E20a4eLHZkD3P+GJS3llxgcASr7Mfdfstx/hryIRuFoAlWWpV2URPvWCWombo7n2u2qYo2yHG97SSL8J345+U3689MPbfDhl/6llMoaa29RMPn+4Eiup/N5/078RWWv82oUtTU+Vns4NWtmHF0GkZr1v+23t9OIfR/m443+UD20kqF6KNn6KMz5MhAUDDpzcrmE9Ig+By3/D0S4X3j/9hB9ayIv80Xd/7R2ZXz8lOUv2SpRTUtrg8o2GQhI77IpTj7idAcyaxnz31AW1igkCN9Gv3+ZRsYbjIH4bw7NS0RoP3WcK3rsM2Opva0f5vVaCZq0RjcJJG/9uhQV9s75DTJYjqOMH8FDaD2ejpBBijTqkA3BMYJ6AUs8Mq4pwcWl6Mn1hf3ujIqIDHPHp9hktPXowc2hVLv3FQRBbHm+LALIxTW/ke0oWcfQekmcMAiPBrpMTESzo/iQkHfvRHUFW7UUAFSJT5EwYnwndZ8Xl5WmEp9lzXtBpH+Xbn7pPDW3LlLeSmictqAhQoRomkbaA92Nu55dGE/oH1JVziWzkPlXVuwsmz/K6fl9lfM9my7dUeah/7EHuvqqUS4i9zpJGbcJ28yAIQTU6xTYbmIyPCAjwWqpE1mz0o/05U5XMzihnLCySy+04F5tLTOW8Zf1CjQXNBYiHkJpybJHchWKKmct4vmT/XPtgf1pcvhwXEhd7yMzwQIJaw7oNUToH2YKI5QiZgUxyS2+WFL7z0bCHymNBQH6E/VuywMbHXWwyaRnkFJTj74IcRpXwoGhhRPL63YHdHOFRBRAALjH7/EngB6v6T4L4RIr+Bw42KSBlk1xl+Nt86sfg2wM3C1gp9xfeTEORnNqzSPfvXBzwIZHNgPyU7DgwM+0LqGAdQ+sYrL9f7u8FdmsgQnDkTIvbPJSD+3IoTHaK/Pvy4hxZevYeVdLDAzXXyLfetZMxqiJbe0J3dGfqg5CFeEb4Bp89CXDhxMn4cxhX3YeR0A14EmKWPJxOOEAyLttdhJDjl5DDX0xcahkHmUc6xiknFoD9Jk978VT2uOpykV41/InniOZJE+OVerw1eCB0/IUlK3WFwBhA+3zaYhIY+8CWhqs5k70xTMqegOaySRgYbkwM23NKeZV6hoK2zafDNlfuJnKRYt9v4SoToI2GLbJ8ETrZLsrFpOWjqMSBZ+M4XHkDU6VVlxnBdrdGL3dLazh+wSLv0UtsopUhLLaeedhfqP9wtQ35ohl48GtPzKQdx1VfC90CFEeMkPlCo0r4LhQveSjwpsXCpmi2eFUfdJatJaxmtk9HanVLhEe/Rem12r6Ektu65cdkNJ7B/luKDpL8RLhbveWvZk2f13QNevBqcgBIqndoyMiiZAFnm+6i/PpsSHTZpRq0fBWigP3Tb8BTLOrucNia54clJflrO49Xz1tO9DlkFCaoM8NiQ77F06DWxdOoUyyzhI+g+PUBlVWIi7ORA+N4+1fjqm0mxHUljT9ivGECrQ5EUM5gpKXx38vqNItiH1YJQji/drMHh9eRbNlHZ1UT3+sPH+Mk5nvHo+Q8uM+/pR4Cwycev+HfwZlfcIPRrf7wwy/utIcrGtWqy6S6So2VqI9jX9bOCxqjerpPXE9KMNPjcLD3hcDKuepVXB69L9bX8N0FTB+9nPxsXNj/gDbRk/QUId8ClB199ZCamZqdCLX+SGrllP9YyQ6VKhk/cbNrFg8hN69xhdne9wV6pBrOFTx5XjFtnkTIA01XvGN5m1fdUMGl9wE1bl8vHMqgpwR0pACsQbWMrbSmcfqPow8UoJIrUjabAqQvbg5vkkPBE4NjsQzWKhQ+AVgsJ6AH8aXO8UpQ1nOUOS9kGKIPywK1ZQE3Vw+ZBiFsa5c0l8jSgQaLOoIazSZkV0mClb6499yDA5a0auzKyC2mwMqrOWkqbmPCSgw7aSZuY7MF0IsHFQT4WKeDhitjXaAh8vctDDlv93PQ76FFqAkI/C3xeZb2vMp/WeQbu1u4tum59xxNO+bGOuPxtWV1O1L3e67IMo1fVdnNo3wt+7lf/khhMubW+04iaXVxcZ+2dy61/FfuDWrY4VV82RyR3kGh9TJ3ce3+R8mzCsc02cJG2TRN5N+Pz/8L0U1s/KxTEvcXeb5LmAkgVGBoGEdFHPJWv+0KGXb/N6fyo8x1uJ8vf1md8WcLleNA9dyob1FLT2mwZ6NHu/TXH4xu09ntZszxZyE8uVz3riXlKSeNkpF6S2TZNN+rg+qb2vz5i/D10ppblsAm5FNYOyVgX95Uq8fzOsVX0869c0/POBBemTxA8dHK0eOi2X37jBrtdeIqN+2c9JOmoweFu09NHrOz62qWmoOjaCQOYzBjS/N8qVeXl6+kfHJt376gkST8vazRud/0T5u5AE9smx8qvexVgKAvPuc7Cadfh9Szk2J3xL2hCSXogyUL2GFy+PBqEsHJJkZ9jncZYp5EhFfhhTLn8XvNQae4WSGNdnrJbvgVAGUuy0Ixo+fO8Yro2Il147374wmnvYkwMqHUPQkSve7f8Jpix5a4bZ2dUOTEZUK5ZTyxOKDbK8/MOOCk+/YhjRA5AqUN1ufpItBII+b1J409eljlMdFxSfIlJ/XqgvexmMG5XBmu8lTyJ2DrIjgsd3o9TSK3DbQBzjihU9559o5903N3hgExfgbxMh8M384oIC3HnwfxdZnScaJWkce3XWBBkJz+nyOg26DCHQHxCWg+vvwpFFlOoiSLiTP9sM08LKCCuZdlUWZ4fWD4X7ryqy+WiuDaYiV1SMx/Y0NxwRdNi2AeX6aSvotSgwo3BXbrnsWBqFKhCZjA8OpXh6ZhPGNjMt3irhZVmWDFsYjMvEuvyJLqgUQmpyHZ7EQ55TQnG9karR/Ove4FZJphhDePxcIxAUNZG9KqqVXxKZMiedPXKWaDIg6IdUUJT6utxNzo3cCNTRVY6i+G+c6suw9tTaF8H4Tfe9c9VAeubHAOWvWxZbp+2ooMlnhsd7YQZ96CyFKLtEHehWM4j7Pgf7nDYKx3mJro/Jd8VbRZCF+BJw3ktheHNgkAC7X35w2sBQ0nQuWkS4idD6shCHba07W2j3BX9hWlWJP7yap8nKaLq/hz9CTYdMoY4fyIV8dKv5DRbvpRnx0hJblStrCwr8+sd7FYy//LSc6rZjfMmz7HcLp1jiakcscJt1eR9FKgTcDU/UPRlGbsv+gwUvQncqKvvfJew5K3ypKpR/KtNAhlsY1JeVz/3ER5/GruxKoC87CZkFYFVttw6j3Zm988kXEpPlE4tI1Tcm41feZUjjICDBeOQiAUA0dEiycs69D7xFjS3L5R4n76mpTFLkqP4YpC2NCf38vrAQ5Rv5KbIHBnknvz7tbNTiaXiD3BOns8xF4Qk5Ms+EBbPirkVEQZqqoqKCokgp4gQ98MWrCJfmvjFBgv1WnUzPgAMTU6KCFDUEFwc359iqQCfsHLRZIykHDWJvNW3XEuN1N+VrhPG0qyeZl6agzMo10CoXcDPoNyIwDYCi7txo4kgU5f4wYWrchN2t/v5DG9t9wEfNHcsu1XPrWK+pdWa6fypT+f/EICNGUU8/t7o7CQel4PhtPAOeWLUlbQJWYbQbuEetTwGqcP1zwIVRK2mwX8iqZHPu4D7Z27df4rl1XZzHGPlMPgnVPgV+uf+qSOlSZmiio1/AxXUcONB5w/O8KgqVqShHaEIcHYerFTYQt6IX6c9TAK/xi7SE/AfOT8stWkj9+d2YNbEQBaqP0hgFcAINrgmE8E4zY92PwLJ8dDbx6a3WB5ph+6YNX9WU3k054s2K/368Dmv3V3TLgHES70kcDbD7pia0dKYWU06KbZ57Tra21lyI4EV9yMYmiKQLLYX6TrwI6pGverWMUVhG1M9ZcdYwLELtcR/UzAXHmBdudGDKfMv9sonhnj7oSBMtB/dzTK+lXLGb1TDCgqdJPeTrHwKF8+jA5WOC1IohlJG0ntSrApfFNdebgfqzr4mQBv2r+1kpoROpaKfS7ETEoXFQ2BK0MH5oqLaIA/+Qaruh/W171EeIOaSq6ogFQgw3K4FCXH/6yCo7QHkq1dQvr0KetwiikMa6R+W64XFth056FEv3XNxYWDUKg9YIezfGyw/ZA5m/cxNM7vLpQSuGSpWpDyZg5a3QvgoQWjNY9RcSwaQqHO4/+WYWWwsdOIX3HuswfJ3m8xcDRfybBxhCAGp8VyHahT+2WMpGS1cf4rKcw2ZGvLCDzdmjM3HsJcwBBuCw3oAE9sAE71aj8M5Ekpe5oYbU1xDAveQIlzimL9CstdYemUWE+W1xBJNJNfOjByBnivkmGXZJ/+YxbukkhWdZuXZ4jg7WWgVIK27mbYRXAclfhkgliX3r3h/YcKf6javYWKNnmryvdlY51BwSSCY9fsYk2XiOd8WGPZhIk3EDAFt8KDKCmTC8gPsMZ75c4RukEDBiiGxRVcb4i86kTvAjzt47MzCTi8ZpISdt0ZXL3MqMW3iJEg89vQ8Xr4vTUa/X7wzYnMer4JYdh5pDJgVZtJ0N+teHKMuR4UrxyxPYaaMqAl41O+ZrT/dKqxjJ2yreKIEYQip/ZvJD2DJ9IXm0xBqm93+q5yHAKN43+iqQKZEzKS1moqP/cfFgYysTj8PzfvXwNKeFuzbR2B5C5zwjVgWrxaTrclAlL3q5gqM5uJ2aloFy3iMN2ivYsbCXHr6qYzxyPjK4LRowXOZDvcatgSLJ0qy5uDw4EAjiVsiU+7OalTMbEbKrbreXVrP325ifXdNSVJWxuaqZ8rSOF+I34+e0jJtizj48VBNr5hL6trS4Ea9QTAiR0IbiVEEZssRHdUY/hGX+zBLFo4lrshVnrpaHq6PeWDpTy9LbBy8iBFWS4aXPX3a1PZkTIDGgeFMy5YfVpOSMO6MSEL1iBXRUBEnNRTIyK0PG0dU1xW4AomRavE0vnBfQXCf2rXDj1UF4yn8o8H03cK7PoyIpbOan7/zhCw2EdOD9y8/Pf0LHx4ZhsRQdo5EbAns5ZcDUVZiBBxWrNi5W5JiC7iiy1rmTufZI2fdHegX+I9iZYEbOc/JFHXs5buvFXnpr6Lx5kROY3Om5SJPq05GoC+WF7VJiHq2Zfhi7yLlb6r/HIaBUJqTGnNH3++ThJTSOIthvf6U+w/fjWAt4pYMRWkbIOJsWpsEitVFGoQPsbuU20ULvOoMGs1lo8x42MsaruGyA1eF0EBsK4Q4vjomWjDfP7jgtAuKv46TvfueZM6raePoWOf2zORB5Ta0bP1vbXpi6sMDSZ2KdKqE9me9F7a7O8r2rsr7QgLOSVSeY6eHkWp2/vzzXwZUWkI0GC4KaRt3MjL6cH+HB52ZxwKis6KKL7xc1GHlJmHr4gKVs4lAalKKt7OwIKgqx5EKlTI3INg9mlNILizd2msDc5rjh693zmckN13a9im0hCXXk+M7x/yFOACBzVo9qjSj5SOlHpY8cqjShPtjiwZhbdD4DMcjXBpnTQg799a/7ZL5A9iOew2TlAYtAyv+j9rYbeKkXeUipNPn5IMgyiUzLL0d9reuajBlITK1DHbyVnG91D4OwZkWGxZNeMsggdghmds91HqwHSACpVLmLGEom34dXocyOh14ZCAX/wK8G8aGOD3V3MYR5qHZowbLqftt6TGnG5ku94qaAkoWZmmM7h+cawYGDagwnF7aw1rskblN71tY5ARTUuYFE6FWG8VUxC7gDOiKVJlfmsCbOjGTMtjNYeAtyD0dpfz3vcen90+rjwwMMVMcdbyRZ09lTuzsI2EA28YMxqgRJh93cZCMUmZ7HujpEp5uYaPgmHqPPo+M8wx0hhyWz9cr6v2YTwDJz6NIgocfqo8+7TVuBSmjssmvrkN/jVLfQu/Qapni1xTpOXA4rtaUu+NePb8AIv0vYeAIvUzRhIyQcfxMAPv3zigaIdtAQIfNQ7JZPI1eszgGr+S0GXE1j4+HfJSXJ8PY6jEkuflJdSiDpjaWTkEpR092uwnZ7t9Atj7TNC2DAi9iQM1ufgmzLgvHKkw6LI6N7uhlXrOvOIgCUXQyYis7Z3Tl/V1YUm7uyO1XH2Zo3K+9zB2mtzJxAgJ2yHdEYXqBAAMHgIU5mxvQuM8f32j2oiEJVqX2UPMJyaiwvVrRzxbW+vYLX/pxc2TGIhlRb+Iwzm+LocfYztwUafLdFbEtOcWV7aMN7+Oi8AyW/qdMiPaHI1zgbwcLkMfRQLwQHsyeuPXGbydnpzDGNTmnGikEskRY0TBQ2/ruOSlumxZbZjBWOCKC+AGybBib/fhV89oDU3oqPwic02M3c0kJfQ17CqhZxjnMDfxV78ZzRi5NZrogQ23xXVabzbWxz94rZcJfDkiAFR9u2aSP7Wxc7XZw3cHcyUD5LXYhZw1oGJNFHiVozxfqGdkcyJIlw6WYebtc12LctmsBEBsiIVpfAc5Mr7tFnwPoBtGFP/BeVY6GjNVpZLsaZM3uWsl2ep1HrbJKsNiV+PWuKmFtM1k1vCHfNSZWzJsvNR9T8AN1/DsGYc93h0qF5kttIEHHmv7Fw5IHlS1h+sg/c6U8ycOPWEo0LZWfp1CV6ViZhI4Tpp21g2sNvTbzrIKORo7V7BZ9csRvxydBVQr6q0EylriWtNAR+Oy8htbdnYce5He2eW8jVXP2hcsqIbYtbNm0R82ZopOOId97KNnOALqC4/S5DsiLURxVUIxVCx8hquqbunrxBfQ0emI0yy+slHfr4kIhHiT6uwP/4Z/CMIcdssYzuG8JEpwFP3I9EJyd1X6TLUNEkR5LSS5Pe/nwDi2iORRM27tmkx000FJdGnddsYKymrEKpnJRLpkOzX0qZmNg+oHHuhT5J8gNzG+N4or4LaXVKtIyBvCSVCRfdteRxqwRFma05ZySVo6iIsxyoHTmzoVNQKeX2xej2ep9IFWy/JqbJYVpbvvITehzEKG/p1bUJCwBUGJSK//oBgmccSSxDcsSwt0wHGhvSP0MN2oh10B3sTsqYuQHBCmgQGSbG22KSrJrmh96pTzbe3wTj8csxVTXGtMOx7zgCEth3ojsgSs7KRLMRCbHcwmAuFn2949NFZlk0I6ofQpSwJeP1hZxCqaPvcE/P5hdKq4QPngDhk+CCO05yvN3fgzbj/a6V618iIJ/6q8xuBAWSiQVu3XcSqPAaZgTxh6QIeOvK6T9uN4eTXfr9TEkAz2HAWpomQK+f+9uJaCW58BDLYcIAry/fN4dukk97lfnc/Nau8HwqHzVLaM2BHpi4dG01RM4+skKviQ+HRhKVsMjns7F5om7ZJaawwqv0NugbCHKHDnY54AI6bzh/wzZBrJi2vOn9pLq7q96qwu4eH86mVlfKZ1enuvZxeSAneif8Om3wR9VXNeV/Sls7rYNe5IUZnWRhbXi8tvBZy7WX/2WAbCB/VUQ/qA6u1aArzt11/evRrlo+77dDvQsT3G20yWQ2SCX0AdAsiWInAKQZHF7/cjFux9SB6cPKoX1ZMLRiuxZwsN9Z10krL1w7jBsfhQa2jA2jI9dtvpOjDQElNnvUIo6mvulE4fHzS0PN5ih4UanbIVV8Cp0+YqtV1bUMwimqFMJn7TKU9D0i4uHssBCp7v1ccSoIFfOqFNBdfsKwAphQIfRgp2XnSmNivEF38AxoELZ7Zdj0UoEyvw2TGUbqzAJyoMwsi2KEaTw3Z3W5E0A40ZxSWP1NrGzr3aysS8BJkNCf0qbLE07S5rUL+/auuYePExA+YrLT8LT908w0ldcDBa5o38XM9KKXWQJp69LLPiTcjOYtqhZ6cjuUMmnnxmRodSMMUKoxLZq48KpsY07l/9BsDFljN6GnkIf0ZzR8GTG84bVhCcb/nFqNJ/FmZN7px6j1WGQkKFBhfTBBBtByaiPo+UXOZBFDYrFBX7MUyYjM4ltGR4b8U9KphCTSAgXiqqX6B7mi7AcRjkqSI1v5MTjlhK9kgeh+qsx07MvJE7lZuJB4WQ5q0k4UtksK2gU2u8fyz6jaMIse0hcJgQVxmx8NaEGnTVIHosaGPML18D1IkSypCSrAAlWyx0khhbIZVNRlZw0hmO+IyOeZszF4totS2oSNV3r+Cvr/Vs4wLZsirLhCFX8c/e+2chPaphL/3pbg++v+QjJ144JVHQoEFqIJZ+INtuIx4AUPYpSJVuvWL2MUyfvwJg9g1Eze+PYvUXQMI9IFGzMKaTMOuLcSyTdXGw94PdWAIBfasZffQxhjE5hnY5prs5pv4xwys5w20x47C54/RSCEleEo5CMNteMxxSVUleV45CddtedRxymlF+kZZiqtt+oJxyz0v9nJzB36b9z+xqBjDYCnVyMnX4IrpSMrrYEv9SM/3/SX3+BjElza1zVyS3q59OvA4ac+Qd4170ejwUSkrFTMuM+zX988k5bO/JKgKLakHPyyW7absdn/He1P3+M/npr9A/0vm49bzMX4Le5uCfeDs484CdmrZtp4NcARbUl7YH8p2oRFBUVFSUlJiYmNjY3Nzf/5weaqIkLjbCkABzKOIZl8t8rWtv2TzuHXeOBkVP43ymSFG4Z4v+IXhJRgYCAQuiBJWvjwDSg/v8GU7wbiToO4CoEinILpS5LVaaW1vcLJEAb5ZZLbSWVSiaVWkQQD47JysrK8looyP/ceRw00Jgd++aV4P0BBQL6fwMAdujDYWxGAAA=",
 		Length:   18028,
 	},
 
 	"data/index.template": {
 		Filename: "data/index.template",
-		Contents: "H4sIAAAAAAAC/+xa747btrL/3H2KqZJe2WgkZYMWvXVkAWmS9l7c9DZoklMcBEFBiWOLWZpUScpew/ADndc4T3ZA6o/1z5tsz6I4Hxb7Yc0/M5wh5zczpCb+8sUvz9/+/fVLyM2GJxex/QeciPXSQ+ElFwBxjoTaHwCxYYZj8v+SIrxi2sRR1VENbtAQyHKiNJqlV5pV8N9ePcSZuIJc4WrpHQ7hO8ULhSt2DcdjtCJblkkRskx6oJAvPZ1LZbLSgO33IOqyF2SDS2/LcFdIZTzIpDAozNLbMWryJcUtyzBwjUfABDOM8EBnhOPyMnz8GeJkWkeplEYbRYpww0SYad0IZvYcdY5oGkY6U6wwoFU25vRRRx//KFHtg8vw8kn4jWP2UXtJHFVkn8ejL8zt6Z/nRJkwLQXl+CdZVGqEhqQctVQG1ScYmX2BS8/gtYk+ki2peus9gx0TVO5CKbgkFJawKkVmmBQwm8OhmgIQRadf8BtCIYuSE4NgcoRKEGACZKnA4KawQ8EaBSpiWXVoFTE5KjA5EZCTLRNrMBKuEAsgoLEgynLNZCkM5KiwQ7pDyIgAjQi53MGGiD0oudNWBoVAFFoRTgJ1SImgdkkDa7ZFDaV2klarMEGZwszwfTjWNsuJWCMFgCU8nPkP6vbvbgUwyp+HHMXa5BDA5dOGakUYd0Q1VdX+BJFURU6EJauImvY5ssmjeVfQzqkE1lWg0naLmch4Seut1ROqshXMWn0TeAxzOPR0dpT+PLQu6TRzDsceh1r3LoNa/T59PW8OA/p2G7oc2r3o82jnOi4Nmy1RkBLlgPaCGALLkx0DcJIi1wt4f+oCOByU1QbCnxQpcjgeu4MWgi/srh6P3qM+FQranfuhM0yJIRqNXenQJXLrL8B/Xu2f3+OYkuxqrWQp6HPJpVqA/4B+j3T1XX+a5T3Q4BM6VFrUa44UmVAF4MOpcXwEkzq8E9lnaoHf2b+70aJd9c70+NHZ4ieVWD2hSPFulKiWvL0GH1ozPz7tGnxmrmEJVGblBoUJ12hecrQ/f9j/L515GRFbor25HXhuY/S1mXlPqDdvmdRhYLP/gShYgsAdOATNMnPd2zYbTBbgp0T5A3Nf9GDXGZSFjQJ60d99l6ssDn31KdMFJ/vFinCNg62xUi+8KoB+1GAFdQ0I4I0h2RVSr0tw7JEbKblhxVAIgI2kVh8mKF77gxWZMKg0ZmYBTqDz7BXqQgrNtrgAo8q+6C7hGa98/ewaR/7Bza+0meBkLWDQsf8sLkMmPU0uxj+P8ybCWP9LOK/CkD/vph2zxnyGQe6Gab0IesO8UnzuzH6gHE+8aOBS5USdDCmOmlw6TiXd10mTIFvIONF66QmyTYmC6l9AcUVK3iZOEFPWzrSJL2ECVbDiJaPtnP6smlEVljtzrAClMVLUqVrV8AZkRq7X3EZwzkmhkXoOdXW3FaHqb7qJWtvE/0FF7QFRjAR4XRBBkS49Z9B1r5VeSd4u1RPN5pEFEY0wWgVS8L2XvK3EEWTL1i7NiyM77wZSe4EIHPu/amocVVvZOY6Isu3gdBhtFT+dZ7WZzdm3m9vj3jnaouQ84Lgyw70reecYG3aCbAfz3DWomZkqJDRT5SYNmMGNl8TkzP3IS+I0eV0WBZrgTbnZELWPozSJI5LEEWfJxRcj5lTJgsqdAJ3L3UgKgJi0YhgBqRGBxkwKStQeGtra6LxaqgceKMk7hmt3tJn7M4ryFRNXA3NthmsTzIm2l4pi6Vl3dcZax8K+FFumpLCRTo81iciEeqcDabXZoCjrJV1OwJGm+wkNkosvvnB7mZw2qWXiTmrimNwpPeMcsCPr6IB6eUNXq+Px8xd92Fu1s150OITHo5e4f2fWHuUd9R6W/GRFjmo83G334DWBEcXW+QgkK6k2A29nuzwg7i46tnuNRGW5Bxs0uaRL7/Uvb942RliPjfDVkYSJojSBze+KKQS44c61uXULVqjGW3pQcJJhLjlFtfTe1BJVTyIG1WaK87QMQWrExOxTWBgAsolEtYi6TDfMeEncYnzN90VuHSO0v4JmW+KIJWO/ePYAz3TGkd2LG46+1+w04kiQ5udUAPWSJsWN88vqbat2bIcDW4HowwM8D45HWEnVhdcCDofurOPxcAAUNt+Oo/yylapKi523qjNkcI9KSy9Ha6YLuPz2cXH9FNwL1gK+f/zVU9gQtWbC+frFt6e2s+vFt1/ZDa6Y9fIEu8aqWSTpb06R/JdIdfE0joqT8qO4ERiS6m5icXLpFiVbdGGi52MNSVsXTTh3buiE/g6jGymrzM5L6jtLL/imhK6x8vfda34bi2+/Wp32eUlzWz23Xu9d4t9YsM00vaS9Wt6eS5OFeskvzavEOcH7rxlnJa9c68VEsmlIGtQvrV17+DIIwMaZIJjIb+zxd+kLIhBWhLpHs8Z+umiunp1q0iq37jLg9TNbkEpFUSGtm9ooVrQtmzeg0G07l1scZZfm9KJ96lMjH2Ry5w3iyORTYx24n5vyxhBzlv4NohiPxdFQEjtnJG8bua2Eenjjj40a+VnnzPAPCJ1QUKPHObMmxluOyoPWdX2SR2PHHSZMrKQHt+DR2nGHyY4owcT6Rj4OGBN5iA3WQlK0GciPf1Bhs5DR7tOkHY0jQ89M6Dn0G+Y5Tc7OqCGM18ZlFS8LmeXHY52c2ltjsCW8xP6ge6twXc9MnUc9W8upNcYGM86s4sihYRQr+1Cu3e0kmhtrmQL0ORh3L+j3SP5zSD6P2qHSQxTf4+Pz8fHnMdPkDJOgad3jbVDTe4W6h83dwKYTqM7hpgpc96j5K1Bz+qIyiZtTgnwr5AxecO+xczfY6ZzGBHruAfNXAKa94k3i5ZRC3wYu/c8Y92i5G7R0rzPnQk17vbkHzx2DZ/i21etvQSTcQRKFYHKpEXY5y3LIyRZBSAPV66ZBCgQUFlIZMNKVuBTVd48N0QYVeAozFIbvvUd2dA87xjmsFaEl4XwPK8I5yNXKkdbfmYHommclQKFKgTTsCdv3AL2XzNPPkZoTPblqi+dWUlqR3bXM/bz5o+KZz4mZ5MGGBt/0XUrv4XDoAKpXrOlvSYpQRoxUkZf8Wv+EvzHcjR/Dhu/+Ux/V7kbG3JhCL6JozUxepmEmN5G+uo7qk9fVw7CX/MTM/5QpvFbyI2bmP0FgbXCL4RVuinDFIi/55z/gyePL74Injy+/dwULuEX4P9wUtxS2Z3+V7dyi2O/hrCnvm83bgoGHMz9siszetz7wgz8PkWT5FIWlMTnT8zDTeuZnpdJS+Y/8QrqKCX8euugz627TJJsuK0Lpc7vFM796jvRPxSnjeotPclO4kVu8keE8lGLmb2SpsSz8R53KR5wPazX0jpkshxmGzjvNB0U7w+ewKIJXuDLwnLPsKhyOZkQjXC6G3W31DpeZ+6DuKgHrzTFGzfz2cAaquIIlheTqVBbYkeRnRilHyM7L8mQky5YoELj7zVUF3UqOpp60QDFrOTwC//eUE3E1QYBhoXCLwryovmrNzurWr2Hpn+W4cMXp/itq5JgZePvsB0iJTY+kgJzoHJiAd7++CrtVVKXi3Sqq5hxCI98YxcS6IxpbwaxUPNwQk+Uz/4E/79mMxVTzzQbI+/ojgQ9f2zVCXXBmHNH7yw/wNfjeh6puZebrXO46m3TsKlO9blTS73IUUHFXCJWSSMOLyfX9ytQtbxGm2q50g73XJ9hq79ZbAoZVSYlrnwScNwU2tdD9ApuqriaOqnL2fwUAAP//KYAHGt8uAAA=",
-		Length:   11999,
+		Contents: "H4sIAAAAAAAC/+x88ZLbNpL335mn6KW9K6nWIj2uTeXLWNKWY3uTry65pGJnU1cp1xZEtER4IIABQGlUKj3QvcY92RUAkgJIajyTeO/Od2NXWSTQ3Wg0Gr9ugIBnf3j1/cu3//bDayjMhi8uZvYHOBHreYIiWVwAzAok1D4AzAwzHBf/KinCt0ybWeYLfOUGDYG8IEqjmSeVWU3/X1JXcSauoVC4mieHQ/qT4qXCFbuB4zFbkS3LpUhZLhNQyOeJLqQyeWXAlieQheIF2eA82TLclVKZBHIpDAozT3aMmmJOcctynLqXJ8AEM4zwqc4Jx/ll+vQO6uRaZ0spjTaKlOmGiTTXulHM7DnqAtE0gnSuWGlAq7wv6b3O3v9aodpPL9PLZ+lfnLD3OlnMMs92NxmxMvfnf1kQZdJlJSjH3yjCdyM1ZMlRS2VQfUCQ2Zc4TwzemOw92RJfWtsMdkxQuUul4JJQmMOqErlhUsB4AgdPApBlpyf4GaGUZcWJQTAFglcEmABZKTC4KW3VdI0CFbGiAl5FTIEKTEEEFGTLxBqMhGvEEghoLImyUnNZCQMFKgxYdwg5EaARoZA72BCxByV32uqgEIhCq8JJoYCVCGqbNLBmW9RQaaepb4UJyhTmhu/Tfm/zgog1UgCYw+Px6FH9/g/XAhg1mqQcxdoUMIXL5w3XijDumGou//4BJqnKggikDVPz/gE2kl8LueNIrZqeMyw6xz04sD+VNBjTqQUaVBqMBCZyXtF6YPSAodgKxq21FvAUJnCILOY4R5PUAtqJcgLHSEJtuVBAbbyYv6abQIe/NWIoobVkLKOl7UmJbBpKiiwbS4t4nMRG5JYoWBLlJv4rYgjMT/MKgJMlcn0Fv5yKAA4HZe0D6deKlAUcj2GlhYRXdpyOx+RJzIWChrTvgmpKDNFobEuHkMm1fwWjl35ERpHEJcmv10pWgr6UXKorGD2iXyJdfRGTWdmdHnygD74XdZu9jgx0BeDd6eX4BAb78JPI79gL/ML+/Ti9aFv9aP34m/PuD3Zi9YwixY/TCd/k/XvwrnXz4/PQ4XNzA3OgMq82KEy6RvOao338av//6TjJidgSnUxsxUubM9yYcfKMJpNWSB2WNvuviII5CNyBm0Hj3NxEZrPB7QpGS6JGHXe/iqZdUClLG5X0VWx9lztdHeLuU6ZLTvZXK8I1dkxjtb5KfEB/r8Eq6l5gCm8Mya+RJiHDMWI3UnLDyq4SABtJbX+YoHgz6rTIhEGlMTdX4BQ6L16hLqXQbItXYFQVq+4SsH7LNy9usIcPjt73ZkCS9YBOwf5OUrpCop5c9B+PkyZmORzm3Ae20SRMg8aT5yeaMOjeQhZF9FvoKnFXyjhw30LYj9N94otmbrmHML2bZc1CYLaUdF9nfIJsIedE63kiyHZJFPifKcUVqXib9cGMspbSZu2ECVTTFa8YbWliqlqQzwoCGqtAZYwUdZ7pX5IOm5HrNUfIJeek1EgTN0Xr4nnSlDfFRK3tquWR506AKEameFMSQZHOE+f9danVXkneNhWpBjDTJRGNMlpNpeD7ZPHWqyPIlq1djjrLLN0trHb1M3Xi/6tIZ5k35alsllG27YwOo23HT+PpjdmMfWvcSHowtGXF+ZTjynRtV/FgGBtxgmw7dG4N11AuFRKaq2qznDKDm2QxI2cWd8litlz8UJUlmumbarMhaj/LlotZRhazjLPFxWc94VTJksqdAF3IXU8LgBlp1TAClkZMNeZSUKL20PDWTpfUWj1KQEkeOK61aEP7HYrqWyauO+7aVNcuWBBtV0TlPLHYdsZb+8q+FlumpLBhUfd7kpGB7p0GpO3NBkVVN+kSCI50uR/oweLis8+cLRcnI7VC3EgNDJMbpRecAwa69gYoSjLCXh2Pd2/0cdRq0F52OKTHY7JwP2fa7iUptQ0rfvKikOtwYCtI7c7J10pWpQar6X+bt9nErvynuprv5Ud3sljx3+dhQlL8gGudxuu3O5ZT+Z/jUn0OTw1wBsAHUFixddGD4ZVUm048tUUJELdV00dWjUTlRQIbNIWk8+Tr128bv6uregAeKMJEWZmpM9SQ07vqYFOpjTtWpyYcJ1BykmMhOUU1T97UCvkNw1+HxA4rMF0aMUB9Sjo6E7DJc2r9dLXcMJMsZu2cXvN9WdiwC+3TtLHJLGOLftQ9O3hnCmeZNcQtwx69Bi+zTJDmcSg9SxbNamtWXPpt3zpsOjgTMfhCksDxCCupQvC+gsMhpDoeDwdAQeF4bIW00ywQ4QbDMbe1Q6zf7wSqmk3uBFJY7h2Xqwg4ZllxOZSL2jF0bUH7NL3REMyN2o9dVQhJ8+Qbpo1U+zB/JWf8wymMv0L6VZVfo4GkkJVyWtsptcVWzwZG/rp0hHNHt/hGVorvI/S8e1OU7O/SkiVbvCLsN7ezQ7y+S0OObvEz4nXUVOylfsXuopV/TMDtv8+TAu2wXMHl50/Lm+fgNvuv4Munf3wOG6LWTLjM8urz07sbx6vP/2gnnBcWeYJtY9U0sojVKBd/EktdPp9lZTsZfCz3Dtvi78xvfLbR1S+wWqjyte7f6VIqigpp/WoDOAq7RAmMbk5fXfy7iua3KWwoqWfFLDNFt/ZFsNYbqq+3woaq/NbMUM339UpzqK7dlRqqfCsN4XHFLAu7NMs6HQ62kJp06awt6MKPyN8Jr9BOddI63H5uw3Ftpz+RTfl8RXITFs4PB89Yh+f62frl4YBc22e2qIQ1p7B4XYfdWWZoqEar8BtDDFqFvWLpS7ttW9P3Q3ZN5AzUFRqbKOadZc55Fhdx5cW5ddTUkKUOHeyUdPrZ6pZNUSJoyLJNIgnnLmk6pS6BoFs5/bZIUntVvBhdErpGn5GGu+7t2vT+rdU+mDT+fba96DPB72iw3aZJwhlwXynNFk7STrGziscfF36H5uFuUBLhxdm2+58jzrbvE9GLgYBrv/TUn21Df/zDdAo2K59OB/YbrPuF/CURCCtCEZiAxn+jWdWCcbt1dxco1kaxsg/M9Xsht9jb7eng1gBA1WQuf+qCY1MXJEhDJA7fHvucxkNLsXAvjnh46VDzNGHKh4zHUcwYZrSUDsTOKfsGUfTrYrQaBPXe2kp3vw3MjOo0CNDmGU4pqKHCJRrNOsxKVAm0GccHZTSTNhDCxEomcA8Z7aQNhOyIEkys7yUnmoiBLF3lOWp9qyw3uwfWnXZNZte3dt35t1+psMGtN5J00dZ2o1lAEOXuQ3R952wCsiuKArJN0dX8lJ4ni9NzHXLb4HpHt6ZxJkTPerUjdDY/298aLfHGuCXu61LmxfFYb5DYTfLp1qYHcaX7juOKXpi6Ry/WcqiN/hTp69oJ7G1aHCNlHU0HwbKZH0N4eQ4lw48XD0D5CQDleVDsWrgLkg+Q8b8NMn47jDRZ8iCOtDHyPkASfbR8QJJPEEmC1OgclPhU6QFIHoCkAZJ27TsMJadV8r3ApHMG4gFOPkE4CYZ+AFAeMOQBQxoMabe+BiHktNq+D4LEZ6MeAOQTBJBwm+VcQtJuuzzgyf9JPOl+qIvKW1xxBz6AKARTSI2wK1he2FshCEIa8J/uDVIgoNDeLwIjwRQIpT8ytiHaoIJEYY7C8H3yxNbuYcc4h7UitCKc72FFOAe5WjnW+jwvEF3L9AqUqhJI00jZQVCM9uWH98YDivuBY/886ANAfoIA2d0/PgeS7X7yA0g+gGQXJCOc8UDpgNHvKz4BqRxuNcH4CSwrA0SALFERIxUURMd3kkyBG8en8wJp5T7+kuHzeBvC7PdAInLMkkXwZkc3BXhrUZYoFCPjb4QhBaJD3QK99rZl2KBRLNe3IWx0EOr02LPRQEmh2mupKykNKr+J7h5vP/F+5qx7Lvl0Q6d/iaE6+orfBVX/eXf4oLMilNlRyTrHTuF4/Ks7nTLvnqty3pMsfqwZ4e8Md/1vyh9sWHO5Q22yZPHGP8GPqGWl8vCk5Z2lEUH43rBc2xObzfM95fTc67vYvbqi4hOUQwfgP86QFcaU+irL1swU1TLN5SbT1zdZnWpof8wuWXzNzDfVEn5Q8j3m5n+CwtrgFtNr3JTpimXJ4j/+HZ49vfxi+uzp5ZfuJhJuEf4FN+U9lY2mo59K97hV/Hjc3CMeT9qbQI/Ho7S5j/pLG/XejSYpkrwY4rA8pmB6Yq99j0d5pbRUoyejUrqrUPb2pU1wxid6gEExoShC6Utr4vHIH1UYnW6dQe8i1QelKdzILd4qcJJKMR5tZKWxKkdPgivWOOlewtI7ZvICxpi6dHgS13aIAbIMvsWVgZec5ddptzYnGuHyqlvcXsvjMneXX2DedocYo8ajdnA6XbF/7P2O69MN4kCT7xilHCE/r8uzni5bokDg7md33e9eejQX10sU41bCExj9Y8mJuB5gwLRUuEVhXvkDk+OzfYvKjvFY9m+kub7/iBo55gbevvgKlkQjBSlsFC6ACfjpx2/T8HpkpTjM++OQGvnGKCbWgWr2RnKleLohJi/Go0ejSeQzdk4158mA/FIfJhrBn20bqS45M47pl8t38GcYJe/8HbPxyF5pCIx0DDvjv0N57XcFCvDSFYLvJNL0YrD9kXd1K1ukS21busXf6xFse+/amwOm/vqXez8pOLm4aA0Pvctw/g7cLPP/b8Z/DgAhuOq3SEMAAA==",
+		Length:   17224,
 	},
 
 	"data/js/Chart.bundle.min.js": {
 		Filename: "data/js/Chart.bundle.min.js",
-		Contents: "H4sIAAAAAAAC/+y9bXfbNtIw/P36FTLvRguIkERKfqWN6KRx3rpxktZJswnN9YEl2EJNkS5JxXEs3b/9ORgAJEjJjtO99tzPh7YnMvE2GAyAwWAwGPQ7G//T6rSeTllW9P7I5fe0KK6Cfn8so/7Ie2l20ZfRv/MsF2kStAa97Z73P60OlEuvbjJxMS1aA8/fab0R48vWYXqdCC5Tf+MxZzmftObJhGetYspbR6/et2Ix5knOTV150O9fiGI6P+uN05mpt29Q6p/F6Vl/xvKCZ/3Xr54+e3P8rDeb/E+r0/+fjfN5Mi5EmqAC34pz5KRnf/Bx4VBa3Fzx9LzFv16lWZG3245E4VwkfOJsmMRZOpnHHKs/PZ2VFgjv8zjnLQnPwK8gKijttvrbY7MJVp8ojEihit5+YVmL73O6rtZrkUzS65H6E6zLcRGnZyweqT9rc+Q8Ph/Jn6CYipzwHhBLor5copImGPAo9jNezLOkZeJbBeIkIQLfljEMpSQHCm4kYRqpLw5fEkRM1xAi43/ORcbbbf2xL8vk7XaMdX0xSsmGh2V8ZuIyHSehzmnCr1vPsizNkPOUJUlatM5FMtH90vqH46au8w8H7xfTLL1uzXvjdMKpc/T28MPrZ6dv3r4/ff72w5tDh8yXEt6EStzpre7I4Ha53JdtCL2oN2ZxjCamj4k9bGTRhEJGPwqLyJCLoWSUBAVekgmpShZE0W6pc8kqTeLyPM2QBJc9hF4kpd5+eiB6MU8uiul+6rqYISGJXqKwRLd+EFbYysqtbhN62OtWcNr/9/9BIeueP+k+97p70e1wiX/qk2QlfhviBe3/O7s4Y6MTdJJ3UOh2o9HJxMUneYd8NwKNgiouPJmc9CKIx6MTCZutgV3melQD+JDo79SW0T46uXZxn6Q09IhHvIjk1CcxLXozVoyniMM4jPFtTOPQj/ZNV82ptz8/SE0nzF0Xp+E8olcsy/mrpEBxOI9c+UP8bbw0jKECnOC/ALOXSx6IBp05GXTm7gDfAVxgfPsjmLp+hPdzFfU8TpmM3IzWQWYPgHzEimkvS+fJBA16W1udGlio64GVZVhx5yJjSX7FMp5IFi1ppvmC6jPiRbKTUvo1lGkR2Uh1+vJ7qN4g+Yd4ZLC1VU6fnOaLhUdpPrpBOfGIjwOfpOEwojlJl0uL+61Mo2ker0wLNAom/AKP1o7SR3fG3jd05dysj9BE4SBssiaSrITRG1T2dSJpRzwy3PYwyUyKyT6ANN/zMEmbacMqLac3SORv2Bsk8MgPBJBIEy9kJCMpyaOlRahsDaGuz/4mU4NMaUUgyaHNcOTtNtcT3yNDXOXPq/zsIfnjO+CPeDiMAqSg4DKUVaEvqZi0vArSXEJSxZ3/47hnqAi9CMNfX/8dRFbNE7kEmRItfuAvFkU4jNpt+Xvgj8aQHjjZxRlyXAnMdUhLfvnl1yByHexUMMc1mApDSilvtxGnKrhBqaxgJH8CHxMJn91bgfzi9Xqmqh5xjlbwNtLJOWTZVxKBxf1kPf3B1lYHxoOoJ/lWEqsnDaok3UmaMonrPJIoCv2Xuc4jG1eFyO1/GRF2BybyL+KKRIuFj+t0vPjOGLjSY2Cax2u66FHVR7UWX/2FUSCZ9HeqWDMOZn+lpuuz71TkuKgsy9ttX/4ZqWEYOE6DhF+qadc6DQtrkkdVphsj7umM0KEzkSD1wb4iTgpMEmt6nlWMoegV6XGRieQC+du4V6Qfrq549pTlHFUMQy+jB4OR40lEOcjRX2mBtvB+tSO6veDFbxdnLBDkghcv85gFjKi4INVRQQ4f12dBJj+exFdTFsRkyr8qLII5yS7O9PdEfjMdGJMrno15Uujw1IRNhnMyzWP9fSG/TcIVmV4bkDNyyW+u02wSfFnCBD6lt8tSIrtsiaT1FZ+GX8PLKKKXS3K7FWwtIzJYla7VulKgTTmLCuTLhaOxzSxaIskLloxBrjfco4AdEJI7snq6ySC3O5IZ78ssvS8sFhO64RMTmvOc3mYXZ4GRYKd5bH1/qb6vqzzj2c1lUIpPhAHdfUUCvu/kQJ1qE1KM5PLQ0x2KCkz4COrPefE7oIAkZ3DkDDY5ZYfDEtLMOM3jesZrOQBxu72S8Rog4mBlf17ArCtINXd62WJhh/jkTgStbHGtUCwupkXC8/xOlK3MX2pFoR/WFPvSLHZdK3Y9FQVfX+O1jaxkNL1xu22HbliyWDSLyX4Fmi33s95VlhappBi9FfnvctwE1ibfDL9yUC3l9LorR86L4ys25pqMLLuYz3hS5HgJ4+27pYCC9VJfHlLqS6PU9QMwBNrZpWC0f7eYop1dLrs4e5Jl7OYess153ssuzoAKD8k7zWNo+8PyfoEWr+QF/Qy1shrWLNePogezeVT0ptdnvXGajFmBQh0b4QDiFU0egoTMB5RgD0ejkCRZrRqI9ENgpnm8DoxiVzZ3bS7Lxchug8qPmpMFoh25GsoU2d98sg6qKfd0ypKEx3oKeKTAS3KRcZ48vJAPhc7iOX94mQGUmd5RpN1GxSM63PZIQYsDbzTc9twi0G2qAYMpqLDOWTHPmAT1IDSgpEK95JEPLzhoVPnlgUW/mDpLLvmwgtcWndn48scKKmRh3DwcTVVIcuQHlVGMRvXEjF1ImeUHyqmm3fA4Tq9/oNigosgPlBqqsVeKY6u8gvXKVNRgioqD3lOyTG2WJCuzV8Fi9wNjPwCtLj2uA1jL8SCYlcC5lk4mFTUWhLtgsfuBsR+Bdn1fP5SpdVjX61tpJOZ1kHTaQ/vzzXx2xrN7FwNZvFwQQi86OPC3F3IjdXCwu5C7qCWJ5zORpLkoasuKEeJXoBFOw4gk1NtPDgqjEkxct5ThwwT2vfs8TCIqDmjPG+4Ndkei7w96e4MANlJX6TVCwu15W1u478s/ZNDbNPr93sAfbHe43Pj1dvytQYfLnV/P2xnIT4nzOE2KjOVF0DxT4Ardqk0IgxrLjih3Yo+TEeISCdxHCfwN9N++jl+SmH/h8V3VGDRsfdBjutPzR86TJ0+cgD+mm70tGXACx1mSCcsuv9tdhFM02NvrwMZ3a3enA/te39/sgF6o7/PhfqUHGOzqVWV1QG0AYFknwkuS8AtW8PVdHEaEU2+fHwz3ueviIuQRHWxtdRuYhbw8p1m7O9DLpkaosbBbZKtmW9kQuZ+n8rdTkDu2Dxq2bM+Pgu4+DLReZPmPAPcBb/8BePO/Br77MPBqlf8e6Ouzh2Gutk8aNKx6Pwb7vt6swb7I+E0+ZjF/yLToDdWk6G3tqUnR832YFPeOypATTnhkahzHnGU211zbGuCyd4HVki/v8k455NMr9uf8fwesa4HN0uJBI4YkFAHDLPCj4bZX9YQX0UTLtUmQfGcQzcTXwNbMGGUonHcLWhBGqz30qLcVcJLRQYd1fZLSRLUO4a4wXySnCGWdlFLa9UdZgDI3xX3ku1knxdj1cX9AYup38xpJsoszlHeSXsYnCLtxR6gvIuNgw6Bj9TfEyz2BjlafWONQYtVhbolXB/ldhvGSFOkvx2/f3LWHk4hIyTROk9XxSThJ4Cw9k5SpeoMwmpidmOGwaUskLYFFb8ryt9fJuyy94llxg1Is9x9UhKlkwLfLUn2ojs4LTJxQKW9asO+LHCA8C9OIlgpMHJSZlEBQyxWM0yRPY97j6sh/nvCvV3xc8ElrnMZp1lLCumTe5RqWLJfE0n/0crnDN2oyJ+MThzhAfIc4ktqO1po50zl3iFPtVRzilDseR6vT1mUCHBytY9MZym0LVKJ3Io7RvTlyu+AQR28AHOIokd5kdqJ6E2bsa9mCgZQ21D+NuNz/+R7801jWYyRatRiFhA6W0fUqL8wsqykyzZCozWCS0NslEdTbF5VMJVwXJ2HRG09Z9qRAAkeUh0UUisjWV3A1ptttOdJNCJOk0YNrcCmnN2E1XDIVUp1OUhUC+pGc+vviHNmaVI9ovkUpLXBOeWnGYxTdmIVFRKtDNdNAyR14aEJRWa5S8FWN93CkSLdG7pTgpahpZU9wtJ9LYizXAM1kfi8qrWxkeP+7sOMwiTRQ2dylOEdMfdLyaMAj5XGBT0pGmY90xiDHuEYtLab5yjDnLhzmaytIFWZEY4ix+bLPoOa4ZEETyYIyPNmgtGi3EQsnERWyxCRCsmQ5+ze8laEDGr51I4fK9WW/qT1KlPaoHP+owAFyEuBNlRI6kUOWAluza5ODRHE/jnFzuSpIUmmb6jjqnTe942ChGt+WsZGFsQh5FCSUUvkB+AdIftJkFQdR4nC3pVm7jdRH76nksjTDpDrayZbk1g98shlsLiMy/J6tEbALkhAhJ6o+i5SzVJ89yimqzxpJTssBAmfkmMTV6NExcxpXC24sxyenXsAojUecoqyb4v48yFRw4KK0y2RESmkMxwabLmLdDPfnmPCqtm2vw9WBPz/wZD6XQkhQlLuxXOcTCnV5gdwSbo3mfRS7OQ7mfTToxt0cSznN97xOAr8ialiKNImgCaAb/+MNT6gnm+gF837c8fmw73vkv0+MWJ0Iq+pCaE+01oQBmpioJjLVxAzsDmR8Sn2AU1bIK76QECZ5AfW7dh44tyw/IY+xpciA3in8smidfYSiOhDcq0acb0acGXyl/VxFCL+bEb+bEl/2L6cyLAkI0tdi4Umh1e+mtSgho/JalFxnO7XBsYJsbOni/hlKma6nDuHE+Q0q7APeeZ3G0Iakao6omsMpf9zzNr3NrVGls+ArOouAK8WGlAZX8yer+ROdX1Cxmn9VJxJoxQksEoz2Nv3BZoe7veHWznYncXv+rrfVESSjWmuiVSaJ1pcIktKe5+8NZYrv70HK3pYsY0YAEBNIqsdCVLM9qc89ST+SUabGIZPjM6fM2oNlfbq31fM2d0jap1IyyuWf3d7u7pBkNHvc87zd3a3tqs0Z8ftDHOz0dnZ3Opnrb/d9f5ukNF3NmtpZU5M1p/lq1tzOmpusnPr+dift+tskoVue14EpTgQdyO9Us6H6rBxbY+sTkgTBNfuWxiyByeFFfSkv5mpgSSrEamD5niclKI/SvLTOpXKexiTMSEayaD+h8UFvaxR3kC8ZZOzm3biTE04HnbgrhTV9Ct2wfByCXZ6gqev3h50umnd9TITkP8J1iXjst9ui2yUZ3e6IA3/E3e0OSrocd0QwgJgkGHbEwWDEXRWPBv1hV+DOdsAJC+egEepklZWsbTdj0UCxLla1PKtarsvKJTcb6WYEKOvQAWEdmh1QuUkcdDPJGDKXyWVDNpv1IURCNfE1K7BNpK6sPkrRtN5HMysxbybaViFxM/GmwSy2PcMrZMNE2TBj+XMep2mGOH60TTLKu7U4kgIBhdx7JlL2rUKdTC5XVlgySgzDUsbt59dCWY/ejlnOW16gJ64gMUmjfYj0TWRORBk5MJEpESTWkcMqMidCR26ayJikZeRWVZGytisJ8/VHuzyhaNBluJMRTpn87dNEdncSDLoJ4ZTDctCng2YnV3We1vr4pt5Tl7U+biRe1/q4kXjUnMHfm79kTnM3Np0ylxML5X06J3GfVnKA6vbtTopJQv1uTASVjIcTb4Miv83lzl9QvyvkYp27Qk5GuUji2wk/Z/O4CKALtgPT5TQhF5SRM5rvn2WcXZbdThm5oEkzYRBkNNcJzE4Y6gQJKrETNjWovJmwpSuXCUyr6ENgBnIL3bmA3zOrp44tegt0VKf3oZXImolPa93YSHxX68ZG4vu1krIZjLoT06oTczA2s4cop37X2r8xOQtz7OaqA62UrEoR9ZS0SgECcSBNAr/2kvKqRqD39ZY8qRGokfi8NgkaiW9rBGok/vZjBKqoMuwNNr3tDnOzTtfvbQ13Bm7a6fY293blIso63d7e9u6e6/d2d7Z2O5nb8zb9rU5KBJUyzI4q2Bt4m64UanY6KVGSlTf0h97uCCSdTrluc+L3pdjTldEB71BbuFpfJKkVSUwRJV+tLyJqRYQpYsnw1p6bY+LLQbAuLYE0sTZNQNq9A+H197c25YJbylWZkqvSSq5ilK1KQMyWgJiRgP47IpiSqzJLrpLbIiNXgYy1Ilf9XpOrXtdH68f1uw+989C7jlLkPKC7I5RQEGH7e96wJ0miUESJHM1YI4oDna2SuGVzMTRiWFrwwqiCCSEbK3k67yviH1BDEa67o4PS/pbnuayrq+hDxYFOrQincslaBBV93XUG3t5ImN6UtMv7A89rADTJVQerbBLiCnE/PXxg6UHPCpYMUEoyOdSHcgvbH/Qh5d0rksA+Nin3sRCf/5kVKOtkbipXuZARQez1+pvVv7+hj/X+ffNw/ARN5WLcGXQMNpxm6nuc5khIfHU4F4kMh4zwGio/W6h8RG/qqDyzEr81E19aib+EhQXzjxoPf1kv9qHGwxuJL2o8vJH4Z20FbCR+thInzcR/WYlznWiZE2cXZ4NpHgeCqK8vAVNf12dBCl+g1M7h0xgpxBD6evNNWRIPYnam7IgH8XgajMk0jwfZxVkwhS8wSlRf12fBFXwB0Bl8lrbCZJp/gWI38CWx+qq+rs+CU/iCYpfwaYpdk+n1GRQ7gi9Z7Fh/fQkO4QuKPYVPU+wdKOuh3Hv1KQu+Mp9fgif68/oseK4+TdG3xlgDSr8sQxLAH1boS/ChCl2fBS/KEODzZxmU5PtchiRZ/0W+3nwD8L/Bl8zxWn2Np8HvJGaK/B/hS+b7Bl8y9ROJx1Mo8Qa+ZL6f4Uvme6bMkX+htywWYw5Gc+Fg0yODzV119MGSQvw553DQEoSDLY8Mhltk4MukP+csCL3qmERGzFgmEh6E/mBHJfiDiLBv88wANpnPuLiAuC0C/wZeRM5E/idgIDMNdom/tx1peypjVX0Ws2Q85RMWz9JkorNKjDwJE/D31GUzFfwi0pgXQegPd8nmkAwGEmKWXidB6G9vkc0B2RxE5GyexTfXaSoBDgbE390k/nArImM24YWCurdF/K1d4m97EYGL0Bmf51ZDITodpzEDQvke8b0tMpTRacZihanMvKuikvM4veaZAg6ryOYeGQx3VGIu4kvdONkTkjjjTMzyNJEYemTgEUDkhiW1Lpiw7LKigj/cU1Emnz/cqyIv0njCk0w2WjV4k/i+TsrYjSTQHtH/TDTnCo4HnaHj1mW9nLJLIQHvEX93SHxvR8UbY7xQomJhmMbiC9fwd7dkfrKpi6QZSy70qPA3y4rTbDwVEvetIZHD0ttU8RmfGOg6Zw6DJQgHwyHxtzziDwY6gTNdpb85JP7uLvE3hzpJ9qOi5M6AbPsVppCiCLS5Q3b2yE49ga8kFPPsz3kqcuiVgbdNBp5OKUfn5q4ctEB+zq+uRGJ6X46LHRWbX96YrvX3fN3fYqb7ytsi+p+O5Sux6eTCDLihhLupYJyLjJ9lQk4yf2eXDDfJcDMi57EctuW8l+NLsgUvIudpxvNCE04OmuGeKjEfT3PBVG49BS+YSPKzNEv1sFX/InIxTfPCAJcj3PAbOSh1fT5MKmuUDnw5/7bIcBAR3WrJJNS/iFSjc7ALJWVYG3GG/s4QZokkpqaNXXaaJvxmwq8tJiWbOk2Lqi+AkHL2imQiWALjbOBtkb0B2Ruo2Is0CHe2YFzLfF/S7MY6SpYQ9byAWqATvIjE7AtPJjyTI1RFD7as6LN4nk8NM5B9sCXTrhMzdAeyHweyxTGfpcl4Ks7PYbibTpOdD0fsmtlIUvjbsiIdbzjUpldRRCUA3xhADZrDQHzZKYa8UI/85xuYqoMGvk/0vzJeT7hNMhjKCbdZJawtYHXA7oD4e0MdX85qmbKtZ7VKKaf1cEDkkPZ3DFblHPJhzdjWlIakal77vmST28TfGtYT+frEgvPY0HZbrlpkUCJTUUiPggE0eMYN3/ZUUGOs+osorBKeaNKq4RKVtsz2HJuxLE0TNaCB5834RMxntdXYGwBYIIRKttZKOT5UZMlTd7fJ7pbqBJVyNc+uYglpc4f4/oAM/D2TVJFb9sLOHvH9YZlWsVF/IJcB6PUy9SoTyUU5b2VL/a1Nk2qxzR2J/p7i8SpRcU7F6/f2yEAyaFmrmCTVSB9Ikkt0ZUJSjDPOZlrkUHNDxufFTZbmltQxGEh6pOMxy0ViCSO7fkQS9oX9kdpcUYoLO0NIudGrrpw+aTyJ2RgyDZWAI7sPVrmK9ZiYScbOZCftEH9zQKTgUVvztmGQqCjFdba2yPaeilQdBqwRumUzIlcs5jbbHO6SAcwET6fpKbg1IIMtn/hbAxVvEdzf2SKq3K5Ks+g9kBPAH6iF6YpdsRt2PRVXRhaTnTGMyBVn4+nV/PzccHNJwS0Zn80V3/SHQ7Itc1YTfE/2s4yK57KjZK9uyxXDj8hVej0pxaUdOcM2FU3LkQnDH4if8TM+HrMyxRsQaOcwIiX9YKZkaX5jpEG1+isJIEtvmJ7R24rxw6DI2WQSc1NguCc7Qc6DihVpBip7IWfJxAAfbG4Sf3uTSHm2mi6b27B27u5AZD7lcWzY/JaifC54kkhhadsjuwMiOX8u4i9yqZC00v8issrW5CCyJ5+3Tfb0VK8xuoFCWHLhGo+z45OKf+mlaWXigmgbEYsT7sAiqBbMAlYRH0JqySs4i81SDV1WTEVexCA5byvxxt+OSJHOWJGqyvf2yI4fEWuQbm+qUeDtRsRIUrCmwCq6G5HrKWeFnvAwT/ciUpNo9JoGcfksvbQ3JJLYTebtmahyCm0adr0k/7Tvtf7aEknrF7xyzvxL+GuEI/rrktwuI7J59yVXUDSt2hwm/Lo1r6rJlNHgLQsz1/mNXTsRXXPzpIwqb1evWNqoS9b3WNpUl/YwEWERIY6XS5SpO/mp9vIyUL5eevwrH6MMk5ymoVzHaRoOon0W5hGVP4vF7ZLIjzCWEdl3kf6P0NVeAzTS++IcrVy+TcyVUUppUl4KLqnMqLfPDhJjcMXA6I7VrKhk2LaNRBleqnNXqw/NfYAvOb1dLvfnFupZOi/4/69sqPCSzB9gHVizUwb8tTm3aacVAhs/bUY8/64VZP0OBZSGe9w1i2NVp7GuVfmSaJ9TFiaR7HFtiGUhYBxE8SUJlfW3snBWF830rSxHK2CcqHeeZs/YeIps3CzsJczVCaaNg8teRYV97XVpW3qxJbkdBsNlRLZW2YGlnPtbRfO3iuZvFc3fKpq/VTR/q2j+VtH8raL5W0Xzt4rmbxXN3yqav1U0D1XRKKXLtrXL0u6WN6wIhm9XnGGJ9W6qk1FS7eIQDtb4Fi7a7aLHZpNRgRgOeG+Wyi2gzL2EK3LEUg8485y38iIT48LZt3xCVx7pRI9dXcU3KJnHsb2frN8FOhW0aNyMMZtS2/0ZqAQWizW3R99ChLVBb145bTgeNWqPeRxv0KLdLkEqQD8Ks7riAhoY3hJJy7oIV15Bq19DWfFPtPbmx4p6pLAoYF2N/QF053cR+JAV3IIugz8Ke2JfwRQ0XHsJ0XUTLHpX83yKOCrCJCJJdU9X3OXPcwWL+r1jjQvheMVRp1GLJbJjOB7LWQSXlNUtyKRShMkkx7TOgTxlY2nVbkwgI9y9e3uu8+mQ8fz29hyTouGHU01eXddXbqLIhod782KMsO0b02S85bOr4ibY8Mk8med88j695EkehJEOv0qu5oUMpl94di55S3cA6oT8NT8v3krO7hE51FXGDZ+IBO65HqVJMQ1gauqY52k2Y5BlnvPslYpkBZ9AqTyVf8C37USOjXcsKwCPGc/ERPCZgpWdjwe7g4HMe8355YTdHIkcPO4GG/5y/fUGWZDSond6dQ60PL06pxcIYwKfjVsP4hyV+bUTO6N8kzBJQq+F1hT2GtiSNXpbzQWWmAi6obzzFr3TSe+CF+/FjCOM223eM8Q98NrtDd6DPoEvm5h2xEfVdogqqW9nUMSGmAa12220wXuGqotF9d1uJ6AQLnqniu+Cwb1ot7UDQLvXTaQ9avQMrHkNPBMXL9N5hokihJ5mIn+epd940m43IlBReqEU+1UPULEsXbSZuMaFFNVF5+gNe1NpnhXxR1N0hTgmBQ7k3yZB6IZHeMOfaclkCJMUyRGX9T45gvVK4YwXC1SsxNI1GYkqbgpAFh15riPlxD4915GxjowpBFSk6hGdogK0ilZ5im8znaH4NqM6Qteef3j/tET5w/untIxUGdLz85wb+CpAq2iV58qge3VOr0BTrvBNxyzmBmkI0CqaHJmnAx57uOLXZSzc2Bb0SMDdbMpDEZEcMQAXioiysjsL24Msx7c3SmbQvjh6pxNwLiGnI1I9z3unkxGvTbdAjg+VX48jBDU1AchsmBwLSumG326jYyGHSdGbX8kx8xaoAqUg04aPGzd91q2AZ4tFKRToj5XBsv7yTnHgKXvyMRdySVwsvMC6M1M0r/WoyeAW4HTKXCFTHn9F/lwkouAI7tMk9BT6Mald/bHO3oh1N9UssaT0U0AybQl9lpep3So1pR6s0MpNAwP/DChpt2W/gmMBES0WG0m7fQldjTcovUQyVnLF1HUN6qmb2bePOL4tevn86irjeX7IrzI+BrcYH1mWiOQi1322TkbVrj3abePj45plST2EHAtk61rBDFqOa6/9x8g6ntwoaTy1HzXRK4ns5UkF8SVLJrHkn+tilSjLMTx6Yo7bSEbl+guvcJRSrv0ah6yJUcchK5J6mR2eSGEudU6SEJ4riVpOeaCXS8mlyupFmLk0dx3ZaDs6zJVb6X1GWembojvQLykwate2nykRjOHlEeKuc5I8MakS7D0HcBnu/ZGKBIE36pPEcVH5/Aru5QUbX8Ja6pcvm+hdAPAC25lqUrtCBf31w/0hS5FDEfJosUBHyATohnfHFazahH+uc1hir4n6UdH3nWX9r6RetQ/g4D2OPEVc+T0GoRNe2wmdU8eVIeXG+XScJufiwpxHnk7YzdtzkCveZhORsPidFGZe80TIHZkk+W/84tnXK4Tuy9/L03k25tov8Gm6moRdZ+G4/ZPJrU8Gy76JrV1Ds4X6KbpdkgJXbbRE6gyEatxuZwgE6xGSiwa9XZIpfBFINgHIggO9FIRJNBJGJA8mPOYFbwkQzy1yjuGwt93eMHWWVaqqADsotW5LAZfUShZvTpFr/fhkjZeNU7lOJhOWhUVkKGmiejmb8Wdxzk11T5HAIyOBkgQHonHVzTooPo3T5EIuZ0oaDAtwkLA2pe55vbrpIvnziGtXtqvwaNLL+FXMxhz1j46OjhZHR4vDw8VkMpn0L9bJw8YHk4+XZtleAVq7n1c/Qj7V4q3M37ioV8umB2KJnPNo4hC7H15XOyZl06DKZTxmhfjCpbAQJlFFdIZHrCwSsKrVjyZ9UYP8e9MNWANq8dgbOefzYp5xJ3CuWF44Vj0JHiWI48Ci66O8L2obz4+1GnpF+jq9Ln3m/w7jVP66Tu7AF4/s7f8na/u/6nb9dwGDUP6pA159lONb/UazNvdpTCTE6SfJNjnMIInJXZPnjWrVR6HMEuo3kdSgDqsb/Ib9qVXmdp6IIkjIVSbSTBQ3wUdJhaX1gkCeZgVab6vRM6W6vPxcYntL8AzZDxyUUERjlydG6A8tkoLHm1VhsXTGE7w0suuydnOqhlcpoI7kljF0LnjhuKUAP3I+vH/qwKMNPFKCbe2qlWIzFhS5xEkw+T1gkjtuZBVUDhvyFLCWU3SkPxCGxaZ2VUs/X7LqRB/fFlSOmqoXqexd7cYrsd14qbUsFFFPdm0kuXAZqJ5uWkELW1xAWzhZMbU7YzYbdhy3kmOx3IV0zfaEZLR4XErRKBslI8eV9AqcroPd8mqh7xHrIivDuHrYAvfy+VleZMjHrqhdTauzILH6DoJot9E6wzdon4gQcNF2G/0Ms4apefazCHnoRdGacn8itl5cIjz05ZI5iCTIREFJojuq7qm93SErGMI9zWzvBF3g5R2X7sxjSf2TMDzJT46jvhzrJef790m4OIl+6l8Qx8GBlXByouIqqL803CtowJ+EGmyceiSh5ZaTHyTgtvVnEUqmFIEzK2pCAYT+BfwK768xwDNiuaNkaG0QB5ZwmUufIhGyCI/kr1mnCxzIYHn91pr1/7xz1iNOf5Vla9TG5A2g90YJpb8grmNQgSWNaj1jrZa2+u9Xrbk0YXuB5r36egy7zWKp5skWtPeb6MUsL14lE/5VztzH1Gu3v4lewZVfX1zQqq++Ccn06kWI6FJ/za7+p/IpGXCAB0Kt7cnT0m/KLfQoCbhFyKKoUXKMMkYKPAJYlQaFVJqJwJJyQUyzSAThkr0XqGqQc3LiyLFnj0Z0EuKF/BPhxUmIwn+fRCdh1MEnkYzt4ZospNWyrCL5YpEsFmKxYEsbhaSozZSyurB70j85+fdPHXfUQ3gRnkS3y0jOh5OTn9r2nBBFXbRWBwiriz541JTck8RIe9mwsJXbJjngLqUci9e5sU5ZKFf1iFrMjem6AQcbmmq56J1eU/kDxq9cdm3v9BrSbF6RFWZEaCm+3R6jVHZru52qfiVJ75SRpCaCpfWRUCp15E/vw/unMtX1iYdx74IXH94/bU6QvA6gkLIfSHIzuQHK9RMzOhQWPfiQMlItvlamJ3I1oRaLLwyrucLxyDmHSCdw5K5xwuI04U5kg7yv6lp9vQqCddhzf0uOp2lW1GFC1F1tUolf2F/G/64KV2tZ25x50dBOKe9RIKrKOW1LwuIcbdhQYVcKGsiVWBpG1mbkaF1CLnFqpCi5xR/su67AGT1H4YAPiYjwHWVCEWnfoFUzUQbMZE0D1mPUgHF38VIJPnKOjo6Ug1vE6Ixp5cJaDEmKCdsA38MMds44WCnTQGhNkb9a4Y9X9YPo/YX228ePq4Ov9O1qj6ZnX9nYnNC25kVVn3qVV0kkK6VKLfR/PC5xNTBFqR60BmfSbm/cNbbkWnDXsLOWS+ffjmuPQlZbEZ0erJCu85NDHOHcNx3uhqlmx/2AMTFPUtlUA7UyyuhaJF1ncWc9ZB0oG8WsiYnBot2WY14Neq35WUNBIyFVR1ziHOmytaJrKLW27EZiSszuz2yJSWNbKlBMspI5a2/ErUgKHItzKZVP3J/6ZgXAnF7KbVbpw5g2ZFALMymr/s8G3LEo6ym9nVnHDBNYjolcxns3nGUIE66Oa+/bvjpAMCdSSltLrJwW1k0ELUiM0Liojo/W7NQ3vMZWXYO3+MG5ZYySqmIG3Wp8IZuDXBQrmqom3xihsa5PJ/3GL/hXBy8WM4uTYFKsrqHHIOBC/tXlFKJx0IRepTm4wYGqJPqV1edGs7p2+6+hY53X/9cocwcWd9BjPSkUFU6bVLiHAPfWapkcyCFkGTfVniw1B2pG2F5W+9swIkz+ZEYnph4j8QewrU0Mt+dyLVAqshWOl0j+hQlbSTYp2Q+k2CDVkZ/SuBWyAvOVlV81bJXT6EJttwnTIQab76ppg03InOnkDJJX+6i2niDHzfRh0kK9bWovRyujfAXaXb29Ugt7UC33QRBrIFgWDvbsuJGB0XB7Oxhub1l2C7UZ9GiTUrktLx75nrdBqbdYFI82PU/GWsfoFge7qXEw+zy7qPas4BYalo68Oiuvp5b7+gPfkwiAdqA8dYYLes/ncfxJVdNuwxlJGVNgYqnpTotK+buyi1tv/fed+rne8dVQgPt8dmRRU/9e1uU+uuNy8CqLdlyJIPGIqDaSNwh3OX60o9HoMld0fet0vbAVAHBplaQkl7CSrsCPdkhMoT6ZTubUd3c6iHd97OZu+cjN/IB6I5TRAl4R+VKgDLtzHMwfwziBFFemzLsQgQMZI8OY3MruDTICx3myrYH9wvzRqoQL2JSrsFxZU9tdJ6zWGhTC3azr4/4OdkvtTnrgy02AgdD1iaCpeywFLgkMB+nj40YFIyRo2m3GkgqI64PYXyYLmmJye835ZSAItI9ZbTpu9F7Zm4TBt+sroVwreIFiXeEy3N+xzo/tyQVUUrNbVtqbpNf14A3uyS/rhLhonmOZgtYJ7115bqyz0qL5apctacn8ag3cr1u+jdSJcI9NJming4oux8SZ2AzmlQX4SEtAPtlUMH8Y2pPaQmbEyI3qlKkItCkcHqGC8p425dNSYoHJqmHqqNCby/LR/IL49in887WVWgBWq3m0s1jsVKhI+IElN769S3di4Bg9hgmHMBkqnUkVXwuWqqDva1E0uO9V3Khvrerkt9r60ABglD5r0YdE6+j0PkBHIrkLzJGwrIx+/0uKnFoHWqqcWnxjb/xxfdpMJCspatu8U1fn+BGG9gh8R8lSI2M19LtanVXUVsA8TD10fS+MB6mHJpPJ5A51TQ36Ok3P3UVXW/gQ5VKTuHfU+eP4/ih+fwEz/J/Q4z9pyoNQ+7FS/0XKaoQse4b79Gq1emuatd/v1azVypXbueuHM4Pvs5DzeRw3k3DJRFZUbxYjqTRwKzBsHdy6xLUas3K6r1GX9UZ3aOJ+CPKd2rh14NdxyPuAH8EbNPeDvovf1TV9dUJYur417ViTWiJyF3N9oC6w5FBGPbeuJ+/UBjYLr+2te0rXCq/pi++oEq+/l90Srj8V+s7ERt2kGjcs8OF5qDfszb5tnaYUeNq80N5ABVYcRDQN+lFBn5RCeP10vBRNiy4HsRQHlhD07a/hi/S7sDcIuzvdVcm7FOerjd86admgZBk9/Sg+lVGxEdefrydE7X1IiXnVhEc7Ix7w7g5eNvPgxcLa9Py8qpNb5caWVs4kljq0P9bq5cqpuKIjqwGwdXMrkNGaAvQpa87btRq6h9Zv2X/9lwmxXltaS76TJGsVuKuJ9N0qce5U4v44YpYR23+XVkciuRuhI5HcSSeTtoZKJom+X6FRvbpVCj0MHcsu76/pe/X2KIxILn9i+TOvq3536ppfI2xwbLxB2WscKGrNI5b1pVElZY1thIpNld5XYJIb634Sq68Mk3mZOi9T5yYVME1LBXBefsXl17yhHlYtyrXCNwftcKxDMYTmOjS3lMF1ltBUs87vVtSumTNrIJI7hs73s96n/Y0fiNZ9MPLvw6iP1hUI6b066A9N/dQ0nWc5wo/8wWLhDywL0DsyLhaDTcsCUw/+z6BA9chDDA3NRUVkg9VnbSKZFzyHk0Lbguhzc46dGiDAjSyzRItvOVdShEKFa/bO5a65el3VMj0sty8G8ce+P0pGztXMCZx3R06QjBwmv58cOZbdX0ON0qioFC5PHeJ0HWwrpn4tau/ilu99ePtZZZmlMjAq6wmzCPfyq1gUSMIinDJj3proDK4fYZLQZJRYGWGvts8fe/sgpAj6U4Gqqz/c3NTpOnhFDjXWvI+lKHqNGEnIhocfU971sXopiXe7y8x1l5aoY5kClgpJQQEJKSP9xsIiusP5QLsNlWoHBLjIbm4FfcV6p+zsLCNSSO+rsdR33AKTgiOBl2MwEi3wrUECarBMCrl9Vl6ZHqKE5ojjkeCowAFXucBq9hWjCcbEVGwZFPLSMFoJcLQ04X8Ld0x5Txaghb4pC5jgQ+Sopir9zdsvPMvEhDsEvCMo9wn68FrlQKqRb9iMk5a68oNbRdoaT1lywVssafGvIi9EctFSOXsGil3PWij5NJ3Hk1aaxDetM96a53zSOk+z1jjjDAAysLRTRVvHnLemRXEV9Puqgj/y3jid9S/mYsLz/v/p65t1eV9V3NW9AyBnacZbIjlPe44clEALc4OptDQwdzyvWMaTQiFu3d/+jYX1tMgM0dcrKYsFWo2E3fxqtL5xkLAZDwqicAr4Ut1w3k/W1Gswrw0y4L6v0HuUgG3DazW04c9aH4ty/PRkpaTo6R5ZwjAuMFGj1rxyoq42QRypT6qkOQYt49C3bN8aeGAEWiO7ZBrvkYBxrnGXn7WWqhJEt08hp2z166CtUK38SJVcTQhq5e0WlvucxsyFmandZohzBI5HtN2v9akmKdi/NuIw2SgVTa9gfm4wuQe+BROXn+z9MN8H61lD/1/rF74Yr1a09wL9xmzzVm5ZrUsMWLl5bLevUIFLxwCU0u4AbtMkYcyiA2+xgA+50sQsSMI5iw58GTln0eO0QEmYs4hAHjyayxwTU2zCoseDTbkaU0oh2G4jb0N+j1m0WKjPafV5LmFMJIyxgTFm0eOtvdFYRk5N5FRFTmXkuYk8Z9Hjvb290TkLuj6BRp2aVh2ak0PZsoOcLRb88ZypW0Nzhhu5P3J+mbfbXNLChzwXa/OAXwQr15XJVRKT18yDUr7mRVKSw+VsEtPfmXLgm+PF4mP5LUdErJZYgC3yFPwIwD2Db6x50UCco28s5FHoRwpCHPoRxreMqmgvIkJ/DqINSjd89ZzgsmRnDFsuXZDlGmHDV8iEQ/3gu8LhzToc3jRwGEocMoricBAtFk7Lwe4bjU+z/uye+mWuDWEutWffxXQzUjqPT8wgslld3VlXKqXOZ2cJfhKYi7LFwnGwi1L4S84rNlMrZplzr+9hEpM5vXVaL47eO4HTcj3P8xzitJ4dQrjrberwsQ5vqfDTw0bYpG+r8NFhI2zSd1T43WEjbNJ3Pc9Zkgl1Pv3r4+8f3h//9uu7t28+P/n56eGz5y9evvrn6yNnX5vYnQrr/gEK/32Co84JXoQnyUkBlwFa9g0FdJKf5C5eif/3Sb44yfUFG5LQZ7pDONbyXhL6ERwQOS7aAnbhR3pcjRzSkmg7OHAcwqhz2Do6Omo5LkrCgcn02PdGzqdPnz7JrPJXbi2dly+D2QwybkYjJ8hzMOAjErjqprHlySEcRJhMaegczxMHrPAc4ryfSxnoI5/I7+ncIc7zTDjEOWaFE4XjUnEIPpIBaX0FyyNDvEHp1Iw2mLwNTzJyIq8b5fr90iTcMs3Tz8sOAo9SGo9yakZRgGI66Ylkwr++PYcSEofaHVvc9Qckpyg+8EZOqwsjUI5qx3Fju4u6oz5xPAebq1K93k99HHqR63ieg+vPkeZ0HsrK9OzdNw+jyng1zqOlTKc5AU8kStaH67jlpXuSUqf1+bNDYLoJl7mZm6pJptiodsFDN7x7J13MUSnh/mzGVe9UNJS5NBkBrXnN/YYLYwEHKAWjUZPD1KOdcmhBoEogysb0ITmLHsit/HmWzsB7znMWx2dsfCkHf+14as4b+7tSKWvulo94YB1tT6yGVzZSvSS9ttSyvHc6z/mH909HYbJikkRM1JGyIC3D6p5KFKgyzQK13DqrZfe7ygRpGGkD4NOJWjsEnUBHF73Ta8XPwRESk2JFLRzL8BTylq5MJtZyzqgkm8wp5RAhfzFBdqbHXwrw8gLeyax4ufzfISbQDU9yqdMCMeKRWiFisKJN2hn0aZ2IRss0rDWLR/uuy7H+pmBtKGSsJE6pk6ol10qPBnD46wdeYOCBmAUBkLQ8ExrXQtNa6BxksqJ3mvCvkpEp3zMKBPWUJTSV6XoMnRbB1wLbBnGZ1S/Ft5m6+6vN3I60rqSojl1MFO4W2mtQVbnCRNU92LQvLE/vWlfLVeqamF3aixfG+w3vfaw+n+GM+iSlmySRI0bmI+WwOSrQKUdYWSLdQDcLlesj8TFh6vuZ/EZMir/s8Y4cPrEcKLBPvM1swb601krXxN7sqxcKTKVgy6iRuriokJrXMbkmc1g/cNnSyQgx+Ufi5EmctkucSm8UPa4ycTcjiPe4zMh7vJaV0WwpZKvE4+MCJYDQaI1ITDc8DTYerZWGZQaU02sJxFhplu2hyuKzNptoXhn12fbuwNhAVXF6DkP11fHb091tz7dltxaw7H0722/Pn57KJaOWDVj1vtxu0TAi4JgL/J7RDW+/dL6lRXHHcbmSxo3jGzLXvoUY/RUqsu51Vnd/F4sw0jcWmX1jMYMHEYigKNeZiwJlciMuS2AQx9ttlNK8khvycjkXGJO09Gkld2oc9yx3eUpDkGKSy/KwvtqFXVE6SJq7tAr8LMIsGiExsmnhBxZ07WbNKNzBOkjuyHHAK5dtUhC/s8w+pNQ8udG4Oyf5d5uTw7IKXOCA+gOdTbt3k8us126bDBIKqqUr3xFYdXPDbR6VxYxSUWcxSlpqKWzL+ukFr9yOlbHEyorJGASBTP5a1y94zdCkflKbjIyaoSirl7iP6kHldMVkFPm7oxES+gslWI4bfuDDXt2l/gATsVj4gw1K+WIht2aY8NqJ9NUqD5VzRy8F52Z02OJqzcWfLaw2/ZdZt9MtWOqeOpWb1RvlbKdaKtR6IpE331UsDIBzwCpkEQFuQGbqxnDm0tWRRTKX+l5ndTDqKQwJ+TjNOM2I3maKxSI7EOoSckYSyjHen6KCJIuF3ZMzXp6bg+yijsK/gcSO9yVvoxMUcsXauLqkQLjkapLPTlghx800nUMirH2E93I+TpMJxMSxUKForRObdrthhQoDrrDXxi+8brp+hjKObmRkTQis1lgOJgI+GAiQKqWcOraLQgs2aClAeXS+X/lI1F74yq/FQoA4Fqu+pjAcq6dz2m1HWa19QbeWM09viYM1t8CUQCCoBb93lfErfcULk6+I41HZaMmf0BwcVMkhygOGEjyCcR8kI9hYBGcgSc6QcUA4AbEKk9qd/DNLsJbLwX4uAdU3DkbODuaraYnxnSrTV189GsGORWGHuBpCScWX1oyDdYNAtjaTIGYALV6DBg7u23xYFy+qqxW5andMb5cl19pQTBf++ouF3K2TpBwuKENwSx5IyuBb8RTNUEDpaTLHq34sNzwZq3lAbJxG5jIypgmUoIX8c065/KNdUgryhaPYasQpv9c3re048ZLX/BVINuiDE1HjV5QhHnqR0g/CF9ngDQYpRTftFZgrrZq/Lw545RxYYLBpsrzibMiIsIhQIgdfAm4JrcerqqsbHCncChpGa1/XIl5Z7pIjR+Q/8/M043XPU0c/DubJecGzOpRjXjsCVN6QxDl6wUpJg2PQgZZOJ0Metdva2D3kpcJtw7fc8Wz42n7xBbNJJs5REb5goYiU5i6pysIceB6nrKiygB/HMgTHZRuesTayXVUf8hX/Xk3nrk+tLC/UumbdmbAY4TflnVfx/MXCI4Ly3p9zlhUcglLchnVABjJ96UB+p1QvDB6RsohcFeR3TM3SIENzatYHGZrQ2iKxWHj7NfTpMSyOxgKvzJhTd+L6fNiZu9t8sxPDd97Z9jrbXuWXL6du6u50stoNMuoyd9gRrj/oJGXWgpVPihmWLyrT8LP52Vlc82vxnt/lqvCdtby84utcj6qX3bp+p8Cdrh9YcTX/dvzOY3015gG1eTHWd21llzmuU13a8oAxdQsZ33UwSdw/0f/9v6job3uYDLDLIVw82vbIoHbS/9zmHhRxUA4b6R7vl4ps60m7eRzv6yPm0JxXd/0Idg2MIuFWEP5kcmvgdB39DgN10bbXYaEfYfcSsXBQsQzJZrORFziuXFNZ6EWjLOhaR8FvObKtnUtWYywiJSfvjeM0kV3JKJLL6WIBKyivlrDgVI4xa0nriipAhN7ug9dbCJRpLlu58QyMmAgDE4aSPW5+s0eEv9Xp2r1v+df9liYGJO77W7a/PY5sX1fGwEj7F5YT6HtGkHyNEaR2NLZ6T10d0z3nKGFwYqllHts9mFJelm6/OD7wt9XuCfEO3S558IZlrApGBohRSY+pyEujZOMvmVh55QqqsGTaslbKd4w4MweTTDnjRRul/wJ1QP8qeZelFxnP89E/VR3kBUe8m6livuynYH2B0qytmXCH4+LyhvuaEspEX71N2GDPcoBmgSGAdaGH133uGEvd1dtfeoLryitWUBhPzjjoNrmEdVmAN+0Lq2xebYn8tJLTdCJaU67edT4pHXjOz4qMjQtkmgw9oWlT2RXzmsNfAFV8m+FmE00C2fCJ0VG11g1hjY3hms854sygiPdts2AbvB4bdsuqhbfhAu+NRZ6NxsxTJ+IjKbthuxsCjzRph7sFfrQN94ot2v/cXNitAo/VC5SKv2knCV6tGj0nvlNmC68fIc90X2zkyPTp4fH746k4L/ik5iGwnrSvSH27lPzlRhtVY1LQG6MSZ/amC2YCOMtnwDfhY38VLG2S9hqyEt4rUnCDjDB+bI5SVktv+Pt3YmxZ3Vb0Xu1Lm4NZpqj3FVlb4sN9JVBthsFWw2KNVve84A0H+0RdTobV2BhKSIqPMno7y4OiLkGRSaBUlTk5gjSQjpZBrG8+3y4JH4FivgiyXk32KnCAUvpZn0UVGI8QCBmUwsO8o64f+CSjtzeBRybBJUrDOYtwJyFTCExUYAaBsQrkEJjqFBl6xZEU6lKwmsCdZAmV/usBlf7JURoOIpJgcqQCQwhcq8AmBCYqsAWBqQpsQ2CmAjsQyFVgVwaWSl0lhRJJnmDFL2fWbiPnPEtnjkha2WLhFCl8YVjr/sXRKUdZT2bABD6LFGMCpM56s5yyGplJ1juSUcpdGRGw8X3HUYYJdGu7PUYFcbS8Cs+biFWFBSaWM7k/a6Kd0b7oTUdlKUkc4vScUr2C1F4nwSMvSHDHEnA/1+Dd2tgHHtGu1bxqp60bYzYQcEqiv6Q8jri53W6upEtuYZiVXPUNBOIcObinN3SgOet2y7SkPlpd3nXvgWK77P/XWjNFe3byultJkECBp5k9KmhsqCZMgOATsjSw6tbDpKRN2Q5MEhzcSVPLbLYuMJQbBVtIrGtoqVgsVJ+68JjGIeLEUcaFCPccl7sOuuKZSCekpe6P45bIW8atO5/0Wu9iznKwXWw1CqoCpKUA4N4PGDCyyaQrki88K/ike8UyNltjxZiBdkYQQTPY86yqn9wkSAijLziQgBj5jxnhxPYZqqXp0ocETRpcMqWvOEoUo8Qk1yHdQ/u8xrmZPsNkiwUjGajxq70Dr+8dso7AJG23/5C0P2QFd8jL8hO7qUzO2+1xgbhK0F6T3FymSEm4Lolyki4Wua3l+7U+2XsTcX4uAcmWOCA0mUFx0N0eOcY/uhMkB11/5MQsh7tyMuyp4CG7kSFfZdahwcjRGlYZ2lEhVbCCadkj23s2yheLU7U5e8uRUIJCLy9YVrw9RxJTdYWiZ7y4G1eq0J94sahqkAtfu42eoiTMIjySv9atVoEDiK+JAMpXAEq1lFSzkDcVIqU8kOxa1IwZisbr+WdNOZ7XXlr/KlfVAsSbcj/U3KYtFhuJtWnTPr/BPtqxxqQTcCk7WxGgcQZY5fh6bOloA+v7oCb/GUrXtr+Wmev/0yYc2E2ooc2TSQNpO69tM5qs6EyFZH4Owg5BDpLVitCLRroVajkpJOM1DdNcHZ6ul8s7VmX8soxh+3YhvSzBc/eVkWpS9yL7QHqKVXryxaJGvO/TUuJsURMldpB8d0wcyLmVHNDv9ILd2nUP+Yv8mM0UV9JzriK5ra/PH1i27Bu7cJyscYixXwdVruBC8h2zQRF2CqPbfLODRH2H1thNYQLdgYkjxRVHnQY5sDiYgFacal8GKZ0nhiORelrap8OggtNuo7RP/YF2OjQVeVeQlDp2H2d9nw8DRylXTdQ23wycaTrPTMRwm28FwEsVDlmX4f7u9qaMvZZ82ore9jZ3+VYgV9ZRGpyiFKuHnupO8edJY+dB1wtvLloj6GmGboljjCiS5U51oNfNDjwp4Ddydv0qLxEU8W6G+yjrJhgGdSO3uzZ30s0wJl3EQPixlOiT5p0pA0ytDMjhiYPNquFMJhOw0zw8bIFdJthiBnneCl8cvY8+f7avfI31M0b36OX2La2uqRceG6zEUEXVA2+xMN+P9/b29kb/lNuAT/Bf9+ioe3gYvo8MNr3j4+Pwc+Tg4Kny92W/kfPq+K1+KVGxiyJVJl12EsJBCf9u6JYp071N1VKmOXlH/U5Lew44Fa7T6vSxowmhczqEU8fZ18BeK33qYoEKWu6Mrfk4MvDnxdgJTAA2OZ/ThAOwzw5WZjHUCR23cP+BnOgfRFBPszdFWb1z1ySnQGegAZjfwgdh1FlLEYdklLv/CB0c/WOdwJG4YAxqGwSBN3xl2KCb+kH2/QhuE7F5XCjB50MxDhpRpjH/NE449++89XeV5oVBwTbmWMttK6n2K+w1C3tRUvqsqnNHLzi6LdJAPT2bpbOgWJbTxkIEYdybzmcsEd842uB6WX+Ip/2rpKmDlNUoAzNbKTL7X2qMagQ4REn/1xvzZaUxRbralJukunmz8grtyD6rUjdtAgSHVqUVCrdcEKunBLVW2DIEaHI8ndU6OgcktMG2eiVE2WqrlSqw/LN6GKyny1UtgJBaDAPtGaHgyNfZYOlReUSefqxCcqUyXwXXRdUdVVMFLG9B7b6qSdKLYaAfjYJNXJlopE9dstrmoVK5Wy6JRm+tbdqRh0mJqJWso2QOv76kmxyKOsOO/c6g5dW2P1xRgp8mqy/GVF3fEP7K0RAgRTBVNSqo3j8pSmiprsDGOsZuy0j3RoErNb1cO3PHFukuV0aLtaHtgrjUOIuyCl9bhZuUKIFIecY+4W/ssZRhSq2EfZJfPxvVcyYsvRuWEggpHSMXMK5UGowb+FSE1bEloWs24Idr6wKfkHlQ1mjeFigrltUGZe0wpoOivH+th3JQWJewjeanKMeyzGephIr6OLYtp542+8t6B0kxHXuVr99wfGeVnTW3tu+tRHjI7Uqf4llH31YWnVpeG7MOuatctwJMpbQsQNQ6pYPnRLGlwOZRBDTkgaUtJ8p0RkepgEWO54k5UfdIWBBjwBMRr7Z/eGtPvo9JzTVWyRDMpskwB0zu8WVzT9qNfTb8vYr1jEWYNDmPsuy2Dokt6h/XHWrXM/7esCdYRXJ/PZhCNazRhI/JilPWphcf4xRT5gBgcntzXDpq5Y/hDiPNMPmUNN2SQRb7YHK1OlrzC0tSelqgTFkveiSzrLFrYhI0Kl31aGu7H0/r1yF0GkzltHEhonGkmaw+oW09QltbDFxfrgdBbd1ARdfHrp3rkc0k3ySWx9HKmgCt3dSrJaG7Pg1WdKz3hpbr2Qc5YPpZzy4enrOIXsLZDXK8nuPWTA+fJWjdUXLpfd46ibsr59NUvZNV8EnrQyK+8Cxnceu9mPE6gD9sup+q0yRbvvpgVXDK1ztBrvYOtvz2orY+W8crycpLZHABgp5LMoN5SCWfszCJUFaT+D6XqgtxjmKQVOWGgFT2hQUs/46R8MwGsqxYi1tqUyDAtbn1cLB+x4KFIqJQRNhFDF7WcYjVHucsTWPOEtsRLIrN48ec8Mq+liqToQBwl2kF3fDJvXn3a+QqRqzinoFXmakkVoM5QYmb4Uc7RBA1rAFICp59lJcceOl3B572zcMU2swJShulNEhrxv5S30TobilVCdbxwX0ZwdGMnfvXpHH/raSvYxzL2Nl/+n72lSoK8d0yRyKxS3BRXwHAGK5+Ul47t6peXLFisW1zV+ZQRyY127vGey0NEUul9xqQe7VEK15LSaYQBIxMp2PhsxT9TNaybpDXVCR8Nll3IpoT+oXS9FoW4ja2LhUd1jhA0ofsOgnO28vTdpNfnUYW6ywNhaibA4lqPbRNftnd2bp2vkysM0k0r58H9tvoli63HCOVg3q6OgpISquOJ3ntGQASrxleGbiUT+E3f0y9xSI7gBj4zQ9kDMpcCgtSJxMoFijHbgoPopOcgsF1bfxkj3w+JAU9RaAbBa9Yxmjh0bZHOD3VFpBxOXy4TID323WCGj/Jo8EmSV16ipL+YBMTRk9RLlCKMcldykjapQojhjERMrHvDzDJH1F/QOKemgqyGjXychLr4SYagyy3u2Rz1/M6Rd/f3Pb2LA+CsZ1HJXaKvsxsaWXF/S4QSy+M6mHHle7br94BrVTYcrkxOunSjwe3+tkVSl4oH0TWIzsXoBevAI2SIOn7g32tS6jBsOSWWNQ5BCaFVjioLapBob/jCq2u3q/UBibVYGWrC3TaYLMjk4fbJtVoDErqbnqQY5tv1rUGOsPu9qancvh8aEBYR1urm1w1fjl2RXmRvJhm6XWrfJAdOR+SyyS9TlrzRBQtKTRZO5eJuH8jV+dBqjaLujY9H/mDzmBrb8C33aG/Ndzm253LGsHlIK5r+8f22LvDuxjL65d2pmJVg7EG87CAt5Wbj+6eP6DGJgkkXwmLCABZuk2Lcqel584c4f6OrVwUax6TZD37tWnEFwufbGwkpCDC1jeK+qMIL0CZyM5yONS+zJCQtHFyeO2lCoPlY1qFpzKcV+GJDMdV+EiG51X4RoYnlB3Q66yX5+126OQOYdFiwQ5kDETomOyA+jI8cyBwnfVmEJw5JIsWi1QnTx0IXGe9KQSnDkmjxSLXyRMHAtdZbwLBiUPyaLGIdfKRA4HrrHcEwSOHxNFiMdfJNzI5dG5uHDIvH2+dhIOIcjIJhxF1i8cemYSbEU3IlbCF8YmtOLUHhqUMvcwCx2Sqv8d5mdECTDcrtWp9pVRQNii9zpRPpRIsH0FUgOAP5cTJjW4NiA4e2CRsW6H6HR78HRXx/h0vUpCEzvSKvlHYOn4lUPeuWF48h5fQkaufx8aE36Xw/yrQf4qjni70aK00KBkjYVWiEgQzK7c2n5ELtYBVt1qYxSO67ZECfhO1kA8wyeSyqgR8mpCcZiSmjMzl8KEFGVNBplRzomOjCjNUmo7Q9MAbOV39vNk7x0XpKHWdTyoC5aPcdY50IB7FrnOoA/PFYrJYjEfOexMxmrvOSx2YjCZVufFo7DrHEAicd96hA15HTwW5FPuXgoKFqnX6lqczPloXGdj348p7SVTdJtOW00l5l+vx48eeefYbNnfiHImWSFrgnavSn/BQROqKs7k8ZEwO/CWQ9VrQS0GO4MVnODZ7l6VXPCsEB0+px0Lu4Q7hdfqil8+vrjKe54faIkykyUdtxAUm371JlfCSJZOYZ7Q85Hwq9p8K3Z7eJb/JR9Z3rfXGoZfxz1q9iC+3kom6T82tK2YA/50g7wV9KsgrQW+1oVLghO/TCbtpsSJqvX7vEG2yBPGzNJOLsZ30kfNL9T5CKzTx2gIqcMJPPC94ZoMzxlKBE75meRG16kWNlVLgvHaW5Imgt6/fHwfOVJ8YP3HI6/cqCN+Bc3TUPzzsq2PG1xA+OmodkpaJaUS1yqKQJCsnrXUZluS5oM4rNZ9bcFxA3grqPJo45DdB+yeTW58Mln3yWtDbc+AogSOS1qPcIZLJBM6jvMUuUofkgcNa5/y6pSe9Q/I8cB5NqvBM5tDCFZnNIFHL3A6ZBg5LWiCYkekU0kDsdshEFpPCHJlMIF7Zqx0BNJAmydGRAqb24+RGJoGQSm5uIAUEbWdJfpfjlXyE30+C9tFJGP77JIw6JxFeoJMTPELhy2k0m6E8x6PFUbo4OhrJ/xeH6eLwEH5G8v/FZDIZTUaLSTpaXIfp4joaLT6G6eJjNFr8mo4W6kh4Uf0uPn1aXFygi4uLER4tXrxAL168kF988WzBFk8W0+lo8fLlaHF5OVrMZqNFno8Wx7c+2Vsuvi7+tfj2bbT4/Hm0gBevv63H/PX748Xr94vXr0fy/0V865PNpcz/Btr7M/w+g07tk5fwV379obp5uOyTD+pzc9knLwTth243Gp1MbreXffKnzj/qk8/6Uwf/ZQV11C9m5Eig/zQBCfZXC6xPJOCfINntk4KVSW6fcEb7nxcyLGEGI8D1QpCkFo9GgUrCI5koLBDopKdRwKM+YTLF6+5FnfAfrPvtZO55T7zuydzbev78ZO7teDJwuCMDz/cg8PzwqQwcPofA82fPI3cRnsy9bUj1tp8/P+lHLjrJO6N6dORiNWcEyeAWYQq/OaMeiRn1yZzRAZkwOiRjRjfJlNEtcs7oNrlgdIdcMbq7/251fdCXT0d3xK/yScuFtVrBza1TeGwUpG0e1XZx5mU1vQLMGH0n9j9LYZMoCW4QEecode5yKVzqrJeYyGKySO2qYnGfC2LruUOlqsAlmB+HY4H4iPSmU1l3vymDu5j8BI37U6gv+CQvTejIqb0tb7k6bj5wqB5w/8ngem8pq4AokBSUiaw5apTi4HLpEhW465dZj1Tmley2QiopjQDs92FBtWvOwMw1JzZStbDgCiWlVAfnGbRYKsHuC6P9wzA9jEaa45xEkucsTnLsSkxGfXLDqPMLS+Ysuzl9zs8y+Dhi2Xh6+uQqE/HpEbs5/WWe8NNf5vHN6ZP5xTwvTo/5VcFnZzw7fTsuUvn3TfpFRRzyMXw4xmHyqYPJmapF1iCBS9AGsIQrwUqYEpqEJIHUyn9llDFyKn/lmP7k3HOLVp1pVVdntbmP4xaB4zpuAQPTI6EjF99BdLeTbQXoke95VhFZaFMW0gcsVsInh2zdkfLJIdtkw7NTP+ojGiK3f3Jgq5APQ/GTQwqmvj7Z41qB+qcgH6rwJ4f8KsgLK6KKgZGno0xaRHIGKRpa2XrY+IKHVDqwHAyMtM3V++v0UFyIAk7TOA7AK4MBtB7M+pKmkLMya2SZFe8Ra4DQNcxEzjYXyd/H27sjf8/zggEfYsUNLxl9hhxzFqjs5T8j51ryxutrxRuvU0fp1aHrnI8y7eNHlfZRphkbC+g7yEicaxUyScT5qDpTJW/Bd5m4BV10XfKs62u7bz+W8R8/lvFMduC1rOgagBOZuI5/8FBUTpl8rLiPZgPXjN7C2QuZpDfB9lI2fSKnkDOR7VKGJTJu8gOs2n44ocbyJ38JzJq1Y/LXINWBcAXBHJiohj7TkdWhu+pGEFTB04zuYRXBa52s4p6pfoaA75d9boft/L6a15Oyj3n59az8ks29Y/lpvqxQLlqTBxRas9ZNHlLOKgLjUBZRFaryD1nHGo9L3rGS9SaNdcz4aCv2/6esHXpCUv6O8V8b80eMOsfzZMJuTo9S+PN+znP59yOfJOrr/XSewcfzTMg/x6yYy41gbek5VoAkFAlCFpcFZRlZoJb3EPKeHqWn7+enH/np++np8+z0mNUyPYW17B38vjcr2kvJbV6+1AuS0nHDUJ3KhOlUJ3woIPJSRl5e6sgXKnI6mznr34xwHPdDobVwoHJw/0SN5yEGerpMZ7M8/0/AmLjKwMmAfnkffvbTFXdj9/J+7L4H5A7c/iyQw2BNgM8nDrh9+IhULxBnqqa6CvlDmD/MIZ8L+HpSfr0sJ/K0/Losv16+tJn9dGqHLi9raZJQn8tv2eJ/aRhWyssqBVb5lw6RdURkwnSMXI4uL1dmS/niLyrwPtf+KymlYuQFwkirzCHOkzVFwRvHu5k1wcHDG9yILP3MSflTgZFy+3S6Bo6q91J5z00qj3gbnpYOgAjrETe2Xt3BfgmmWvsExoSH43q0jLyzHknG79S0Ce8s/3i1ZADR03o0uxObl//bra7g/r9opebFrxh5Irfw7Co66Y1mo5PeqC/Ic5DJXio9kZx8bxm9NTf1gleCxGlyccgKrkzjgyeCWKrr4LkgynIoDt4K9XA3bH7eqkhYb4LfBLHPfYLXwphx3jBibQSDM0bgpexr9RdeDz6qvo9EEhxWQVXmmJHaqznBE7Ykv4G+4DX8/s5o/98neQehUaCUHrfbywXoaHAXjYKTycmkK38WH/Wn+lgoXQz8wRiNAvR+0cLI6Ewaf8MeiU4mLh7B/yg8cU9W9CuLk7zzWab/1Ccf78FKI1Xh9DCU6n9+FKFPd2qFyDdGw7B2G8Yh/RLpbknAfqR3WmUmaE8jw4sXL150w4/Rx4/dZ2UWQ/pGjnp6n2z4ZRWHtQpuh0u79lrVdrFPn46ObPR9ryqnU04mt7vLEg9Ao8TzY1VTmWinDZZ2Zf8fe2/e3MaNLYr//z6FxN9cpiGCLVKSnaQpmOUlTjxjO47lJONwWC6IDZKIm2hONyiTEfk++69wsDR6ISV7fF/dqveyiI19B85+XBe/3Z2Ox/g1TKOv1XIFyf+KI/fnX+G/4g7Ub/Phxny4mq2Wo0j1k0ysuoj8Drh/veZVHtyQB5ezVFJdihdv4kyMmoonsPtPh+oO+VcQ/Ks7VFv1lOMfVII6E69SsX23YtvfWbx9N19tn2d8e0Xl9molEB7+K0fD4F+x2qF5MIz+TsX2ObvevqLZ9vEy276im+3fV2L791Wyfbyaba/YcvvzRG5fpzfbZ2yCoJDe3dDDXJ8hHRX8yyQFkO3Xd9sfX73bjn54+urNeHT1bPxuO3rcffGP7h+0yz92/xqbM6MOLvrb6WCv+URyFbRAxv5omaU3PGbxEc+PRCqPuDiiRxmbpDPB/2Lx0dvnT8++Ozs7SrOjF1c/H2l2o/WFdDRJRS6zlWHr0yTJj1T1RzI9+jPX8vwIH32a88ncNpCxhNPrhB3RSZbm+RFNkqPrLP2Usyw/oiI+umFZzlORh0evU2HbP1WNx1Qy04P8iGbsKOb5JF1ldMZiKPqJq8rYUcYW6Y0akzii4mi1nKQLLmZHC/pnmqkOMJozZ+MgY1OWqR7fz4jBn3lX9aNmuaCEksqKrc0PvONZIW8dGWlYpKkZ1jo08UDYHS7MQZfi4f38iaoVdJYZFlyUDThgz3JDuKBrsGDHaBzec5ALLroLuj5t1Slq+0RpB3tUsqQvygGka63NIqObQA3/z8pQVGf3D0UN9H/GUB7Vh/IrJXXCIWhlivTT0H4EKOrYrbHDP1IysmQ/q1yEHYXb0JQ0gcEgH5blZuWWysrh48FjFrT+aOFW1EIYvv/QXpf/BtFCExH/MJ8Ak6sMf/zRDNsbw6bHPQXOy78Wxlacw6r/TcmpedA1KHA644Oy7Yr69v0DbtZ/ddFQwQ3/ik/QKDwawzXaQf+K4EfdiuZLvQQnBjT4JxTtouEbVbQ7HPW63+NwfILe68q8mFe1mN9rMc8g5l0l9qd71HWlOzT4kYVTQd54mrf4R2aJFuQpgwH/nZK/s6CPWzSOWwj/A4LdPm5ZdawWGlQUP4kHvbyzLyt4G6mqjDbmHP0x1qq2v5TPV0LFLKiZe3mhzxQuG3zxiWnqgpwxeSTn7EjVsaIzZjzerTIQBAiPfq0XDnynerZcXrkt92g/+s37ypeapKNp6bPZYYq9OkDv61T7H388XMwQ6koln4ugNZupBlu21lYR2xCtoLKCSFxJqKcYAqMmR6uB+SRGE/vjjwUx+b3lDryu5NKExR8dw2DmvlSrBVVB9bkI6T4VjAQ9piJsOl0wFsyoTQQQ5Mw82Pkww9Ql7yRRnzkSta1MD3hcYiRoGT4xOsRL+CNo/QLE7F9S71aF6Syu2F/0TLqIb2FQv7TwD5p+oj6beHjnJ4Fm45mWnrXwqKWA67Mxbj3T1HPJLPUYLupnljKsQt9DO88cFejZM38VnqV7aLASjGM34rTbLQs/pD6SuzfrSyY4E44Y80x17llrjFeG/1NvnoFLGIXEG/uuHLw+ACMG7pe/AdKuLRwZJgrgGmpS1O+5mpZnZmKMdpajrZsg5HDkcxt5YebnWQv/3c6VCvxpCVwQ0pGNb1fhp8Psqz+C1kL1TGEEmqxqHlPNVrYP68LwlU24rzuycEu2WLglg34sVJlFa4wn1IjPZWpOXllhmOM+TEuumlZIim7aGXf5PSie8lw3bcN9zSDKXdN5Xm46V2Xy1hjPTdMMmr6yQjqm6as9JNL//b+Dqq50gE77vR5yV6XCuZqvyn2F/bJXsAF6uGLPxiVfGd5p/R7u907qtfs1q7IP9pW9u/BVCz/cU5qd3136qoW/3Vf84h7Fr1r4u33lH9yn/FULf7+vgofNFcAeL5ZBK4Drne7F9h/CTrtSpw4uQxXQoZ9sSAf/5MY6RaYtyWdEL+lAZIZmeEm+H4isQ1pXLfS3QGT4bxwVefdk5FLlfCKMlps5S4X8qdvVfxmm3V+pYI+vrw1/5C8/+jVdMKt+lpHrAkgb0CykcUz+TjHNnDkw8jcGwSQVjEihvmM+nZIEPsEiEvkA3xoNJVMdyNIFmbnP1+knsoSQTMnCfKjIG/ieMUl+VR8GSHwsyQtIMNaSCDMhbf+ICBeUnxgThJrwFV0wknmBnzNdPi3FmVpyE6ntrr+BkALIyC8wA8aGxEYUAQV5kWuIWNA1+RPyLbggP8GXeny5mD1P6Cwn7yCbAvZ/hA+tc0rWOtoAueQfVM8FCD2RKzMzxpHCMxNUTwl5ZQJOg51MdP9FvlSZ5yb971c/vyZPTcDkjCG4EnxNPsGnsSZAPkJIu2FOBQzvMURt4I2geoJeMrqER+NaqggLapGfzQQ6SIu8hRgDQZDiMyd/6VkD0Zs5VBPTTf5CaGGcqauYmN+cvJNe7aT4zMkLlzt/IaDd3/ye2MiXereqyfsbNS0S0zB57+pQsX/5bamI17aL5rl8DXUpfJOY35w8t8u/koy4r5zITK+8OprEfeWEZTq/O7eEVszMcsjhzPuQl8yEye/M7ULyntnNBnq65C8Iz2n+OOEzweKf0lVmKnjN9LieXb0jT8w3mBQiP5nQr66xP4sY8qv9fvfUfNsbhfwgbFDdJOQnN8m5wqrg44hOJizP06yKVCl0CEhWlmLRwn+jyG2MXONl6utgFZDF1tHCc4nsloUa4ONgBSpHUf6j7oIaUgkxVBH7KS+BZ0P6cykwqubTFv6NIbc81mjzlUJdinC195/hGz2X3VxXUqHMqWuap6KFf2D6Hcgy8sJ7BzLv9n+MM7XtfCYTea7iPDYT+VlFGHCbvFUB69OH/Cgg6NQyTITPcCIvIYtT6CC/qbDakW/Uh9UmlEUAWEsk8WIAlCexFwNyGWRZLaWjZxAN180z+JzyDETdzWl/U4mEG+ipKwQXyM+l4CsuyMtSjO7k21Kc7ubvpTjdoyf1sjrhh2ozOvoniOb5m1fkn3qMlq/8d4mlttyGb/dw/KzQeyDn21xuRbzNYnTqWIU1QVsi/6vfw4L0CXhnUdi/AmqHLTlvReBnZ9jKZSvSHixbIm5F5/ozi1uRyuXohx2xAwIvvLPusEGw+aTrZHh9vVtDMlsJvFnlilTUnZXpTK5Cbo5CmhHr1wHnGZnwQIOEif5WnysT3UI41p/zFsIT/alA+bn+/NRCeKo/X7UQnunPTQvhZUamvARjqnoXOraIuLHZNNKE8EZHaLUBhK91UGvR47XJba3/fdBhrRiA8MfMs5WBP2XkNs+jiwucRxcP8EL9mUdnZziOzh7iV1G/v8OvvKm4ykr0PGcipQCfnjF8lam8hHH4imPC4cvBOlQn5GRlPnwQluSZjjSIGkkym0m/qisTBmY4iU3omTqKExPQ0MHcFtQXx9QE38PzMIOQBX9i6IjRQScphBQsOoev0tO8hII2tMh0Bt21GwhpoGAD33BDXMOnBmZmukbdpTUk6Pfqgy5rrKqRa8jnQ3lrE1MJApinAwWgelUFVHVduS18FbS8YHDIxnXJVJI9KEeBSCWfMKB5TuiSS5rkqIXXHEHbBnj+I2j902AcCubUSMjaxJi51+T3tSPE/bOFuSG5/LPVSLoouEcKHfXtuON+z0lxrO8q/DGQhslk2GqkdRb2vwv7LcyDD3CvTAUBt6YKsv/EMPCLyCv1IdJP5FeKwdwimapfBVP/KQp7C38XYBod3sUVtrcNkaq0JYHfYBnGhkZMfoSUXHtQI2ssiyfmF4E9W47kV4H924twXfKZrekdc93Qj88/hFebeqEk18RyLthLYyGPOQcvJkYw10pOKPNq0JX+TcA8ZAua8L/Yr4LLnLzHsvSkv1WXjNpwN7yS8m6esXyeJjHZqKSylWvyi2rO3TKwCDu0w7e7Mf42Gu2RzAnOvkMBGsjg7CEKOMIyuOiZj7Mz+/HAfJy7pL79OLcfF/bje5vZFj+3ec5dqW9tjC117pqw3Th3eb6zHXM9tKUubKkLm/nCZbbd6Ns8fVu8bzP3bea+zXxmB2hTXC02oW/H0LfD69vh9aFbxjTNaDygWuFQBhe2zIUtcwFlEObhMlnNuMjDjM14LlkWUIRZyNbLNJM54eoO0FsuLjz5fOIiTj+124H+CJ/OaSYJV2vd70X9Hu73o34f98+i/hnun0f9c9y/iPoXuP8g6j/A/YdR/yHufxv1v8X976L+d7j/fdT/Hp/1orMePutHZ318dqbes7Pz6Owcn11EZxf47EF09gCfPVRP3Nm30dm3+Oy76Ow7fPZ9dPY9Pu9F5z183o/O+/j8LDo/w+fn0fk5Pr+Izi/w+YPo/AE+fxidP8Tn30bn3+Lz76Lz7/BFL7ro4Yt+dNHHF2fRxRm+OI8uzvHFhXpcLx6oh/XiYXTxEF98G118iy++iy6+wxffRxff4++i7/D30fe7Mf6uvrlb6hLWMsCtQTGdZZb6E1/Mv+RnPoQD1LqmWQurq09C8m6nD9P3X9qefigPNwl5mlrt976w2WfpajYXK3m44djkamy6/4VNv+TijvEmXDSP9uwLm3yTJjR7nDF6uN2lzdbY+PkXNv6WxnftqUxlaWz04osa1SjG7Ty9YVl0u0hjFrVyLmYJa+1wDu9QdLt+vGZ5NLpVXYhgzlUflmnOVTVR6zqVMl20MI+j1rpL1zzv9lu7Md7cUS5hU6lLbYpSOyzTNJF8mUe3EyMflEe3ksvEU1YvhKp3OKHXrIw4mbSg1ZHh+qVK7rTwkQptTAi1drvdrmBy52E+oRKoneplTIXM0iRhWRFfjlWDwTK8MokH18zU0LhqD/6DVZPhnCVLluX+MK5pVllOmJ69q6mAz1mabVrYfr1h2YQJSWcsCr/D1zTzI77Hs4zH6mDm0a02vPqjizju7fat+m6821UmVvVUhgqYypl86hJCtpZMxMFtTCX9IWEKMnunqpIh06E8fMsmkqpNirngkgMwFFXkaZgxETRoasNXcLUVWOEbT/IGMyIUTvKKgVkMFuaSTj7qOFOptmg4+YgZDOi4t8MaomsyLWAMIWFKeFFtCDaqwGxe+CFbJSzTqW/Vp2qVKPTf+XVll0Jr2RrI0UxRQEdsjBmWyHbAJHj98BVgoB8ZoeD6W+KUUG+guQ65IeJEbf9VLtPFdnu7wyuShelSglicW5TMLspAhh/WVwDPQjXw+TzNXsRBGq4fr3n+4hn4Zdvsy7TxMsW6Ey9EzNbEeMlV8fBBhLZuFrOE3JqccMKjPIR9by4HIJJSHZWPxBhfp1nMsquPfLlkcZSEpfCwEo5W5TBWd9IMkPmnaZJmqnw5ZliLiZga5W8K/3qsB/Nz9kwf2iCvZsYCr6pxyPTZtViEhqXQHS0VGXUrRdi28DuP5dy1AKFhKXSfFiCj1wKE0Q7T8rb9kaULJrON2ZwKA+E3qQyqu9jmi/bgIfpU2b2AzRGCLl5pwR+cEqAvPKE5e8PXLIF9noU8/ynN+F+pkDSBnW5P4XZbOoYrwhXGNFklVLInNIO6oaI84GZbMoTjSjaYnmo2nKABDeeuXZJjGl7TnBExTNVuoznDNFyTfKgj5ozGURyqa5hlmIYbkg9tMCqyYBrOGZ/NJSTn6lbUUkyYhp/UApB8qCMinbzDpVl6EUd7JJCK28qcTSgJg7t3ybVXsmjzQDnvTrBRfl8DhCq9+Ly6/N7buq7UXf40XZXuzcb7W9+bZqMVVZmNZm9HeBtYjHPiiZNRfRuZ+8raTohkp48TZ30GTCvkcNWL0nUMs8kQFurBabepJniolN94zq/BG367HaRE+5eH3167nTgH40L3yroZdx1LfXNUJtN2u6ccQqpKwFBtvCVMJmZAxYTC9ET7lGRL065NEmB78KJGFXr1tNemnRNhpx2ugnK14MmtctiHQp+KSJhjo5buVISSTz7aVcE5SU/Uka4CRzgh+SnFK5Kc8LAEJdl5WGkS7oKLoLgwi5sSSr2b88lHwfIcrxR80ZRrQddP/Iz90x5C+DYvdirFqsdX6rinDoyDYF4El3TCxSxKuzmerpLkCc0gR6IgPPhawZfJlnRXGmCMxG6H99x7UVk2y/MMilNsbXCqGTKHJSaJB2RMdKh0R8/Jqnw28JS8Bg9/wXwkxxA9YmOEZ2RSO2NLEhtYbEHAiu/M29yzdtvZXlsikPkgvYG4lINORyBOVtUTJhDm+oRxA/YRslSBAoatX0iEkEnI43Z7VT+VQvvsc8MR3nCC6WWv3aaXve12CvZJ6aOeyr7oEOocYx5lZKKahMl/nuqVCBbqwmmK70zVAxek3QydnuHbXO8H9bREGYYHJcXmBUk7+elZZZ29h+vwoytC2ChwFcI6hzx/mi6uU5zqy7G4AgLpYMtyZ0HinWGJM/UCw82m9qQzltwl2VCfSxV9ehb1cN4hIvR28kmqoyob/vRMRxd7201Goicj15ORdxI7HXkngemIM/qpdgEZtAKuG72tqY8kwJrCXVMCoFMHwOOc9PQNH06ouKF5OEn48nHGaMDDiVxjM4sqBg3yy3TQ6eRIEg3CjvKx9Youvd0trYdNibZblSlUfQ/QwDWyEuVm0A7nTP6kEMUruUnq6IoxsKpXtHQmRxWofKyeRQOQw83rYQqZg8kGWRWiJQoEumHZkwrs3Bx9COgUjSUw1zcqDBJiglofEMKZDwO7PnnQdT3qPn3x4OzGfnhwd9EHgJLLfdDwdz3q3n3QkDgvt4F2WGsl/R/cAdZgs67wAP7YtFOua5vk+nP2x3Vta6R1BKu6E65Lm+D6vut/XVr6tIxiVVf6urTI1/dd3+vS0qblpQUGm6MIFWjGk8+kDe2l9HlUnio177+JqrTDdp9Et26jRLdlNF13YXc/CqLnarRViEhYO5e9djuQo97YEAyHgnihiBlKgpcbkuEAXFZSjdFUQ3so8o0RwqJOvSy6xfzzVjpuurLttui46LSio4LcudvVyG3lbVAjxTmy22cjhOsvRggtKrlDlhz68KuTQzXT5OsTuHtfRODufRUC9+dtEcwPZdOGrM2OLO2mA9RzFeJhZsnoVcKunvMvpO2+SbmQ+2ioHuxVIBIKGwcaKgsZncwD6mvocHQrKpRShjkoDO4llJY8/ECDhnbkyKPZfrpm0pDo6JkrBTQWb2Os8xZg4oTEekX4GM9Jtu+pXMIcTUmm1w2OBUyswLeG9Brl2NBXowSXHutoivVLHXGsH+bodh3RYe6D5c/YhC9oEoQPUJTX4fVWCkLShfHryXASvaavMcdTnJXRAIQ3ER0mFdpblNRrnajiCGc05qs8osNetAp1YGg/Ipiwt/AdTBCecxOIVqH7Hnrfh57RuMiGOZ4XIfOEHuIbVKEnbfw4wwLPNQ9/Br7dAfKdhflHviQr+Bnqn0jD67NwjSzsPgs3CIuCAOoG2kg5CTPjmPjQHjGzthfWL+Fyd3BKSlXo4XIsnC+dRjhRVOFE8Hiv4cS0fBbyYr5y022SajjWrGopdGhdqZ8RZxaj2jdHXmbU4d7+0gMZZeOD0DLzr6bBl+waoV66g300uicW5azMMqvMsqErmIkeY0pYGSJnBU5mZprag2Y/IuFNBEfeG/3t13ijgYJnX+mBCK38ALmlgmsR6ch8srepVG/BcQ+bCH2tHfd3mIL6xVuVP+rj5kc+YTMm4qfmpW0QsB2NB0xTMr+5XCVHk4TmOWl905EhjzvfdHX51qNvrO9bPf3wCDkyFSXCQHgDPg24Af2QNaiekd4gu+QK9tOMKGstN0Ou6YQ/usyXVBzlameQVoGedCeAdHzTgQoqaMsoG3e+aT26PFVlH32DsNqw7bapFnYvNoHW5WnCH3lur130KnnUUtn+TLkIWi1kZy261YOKbmdMsIxK9lKHGx7m0Pe1I6owsKiSu4cuy4IuA1ef//JWSXI9BJeG2/e9MTzFsNvpGCckb7fzMp9y/y2BY9Jw2mg2AcLk3fy8VVDDIDHFcR2rnJNDzDqoxkMYoQofYZySQ5y4orjGBYviGhe0rkIlW8uI4ylPEn19TXAus/Qj06E5VsCrrnKK5zyOmTAvVGrnF223bq5DnQVrOILudigajXc7nIqnCS8dsgphmBiT0iVawMCQYzEnpjm3xtvtaIzscRGXHKi1lKQN1Fp3W7fbxc1tOkqOqzFokBqwMFA322Ql05X0cNIHPZype0ddREDGf/PipBs+wBOeTVaLKcuYmLDo7MSk/ecwvXuKhUMVQ3d/A3InPhOKZyHPQbUt4GgYcMLDPOETYJWMeuMOoSjiHUIxBxheAR5MPRTgp7G4kbU8oYYuVRZ8W5urHkBLPgrgrvNytGrhC9GCx9lE7dGPVxplY6FI0yWASFzM6vylwpNFD/uEfg9iaiTQdzqO6Mx298BCLAXYI9oCIdwyohTiULpc4Knls7nsqldgKrvl85sASUhhm10aynRZSfZYSjlOEI7J7Trq4U3U2+FJCSkC7KGyUIAxlDaweqyml24X6+HNSBbavf9fQZE4mHWIC50Es0fEfA+7/Wh22bWhftTTT+WSzDpTvFB91J7e0jyYKXQAQjkXwQzt8E0pfVlKX6Id3pAZOGjrXZLldju7LPrQbrvPS7LE1ypj+KBILb5dUT/5rJSO16p416V2K0Vdglfkg2mx6zdZLdj32+yX2/xI5qf9Xg9/UnOwVvPo1ncRrk/Un8vesB99RPhGhW9cWE3Th0qBjSqwKQpsVAEbRjv8SrWyGdoydO0aeVRp5FHRyHU5v27jUaWNR66NK3Krearhg5PgVbjufgrXCGv2qonbdD+FG7Qb+Jv59EqzYnFyemWYsWZ3q0o6qhK4fjcqvOmoClR4t+Mleqo+AXTtUb6DCdyOCPMwXUkL6BM3pGDVLdWBTs9wT+XmQtRzz4elatTincyjni6hIeeX8FiRoJSxW6oPnWrxFH3xuPtQ86l5qCmg/yRxuD4p1eLS3pM43FTSJqFMJU2ABWbYeO9SLdQiSkOvdszv9okG++2dGgj9pqgqGudDHKhLTYohyeg1+E/pMocF2DLvCs4LEQwFFoYOqcArBWKoi7eT6ntYrXesImW67KTm8lWRE5K7axDP/cC0Kh43I7zdTsISvjLsRdLAGsNeRItFeepfwMFUv91ijE6CvHw5n3p3L8LLUhvwDEK93rLgxZ48/ja5OQATF4QkiW/LRCMreWdIR8IjHa06md2yeBPFLvQeg979YyDVTzATsf6cV4CoGfb6Fy2wN6JoaQClm2BqBPoErgrzKeQU3ptNwWyEd76KbQcS4fpCbbcBCLwMN2HRX2+5o1I8rbB6R6LbH5tWQztEvHGfxC/d2ZRXuCTvdpDC8CVkBe2JCx8iEtFsgjy2P9wX+zje5S3PSZmYjynpFYAnHHredOilQeDAQZhjWTNzUrbbgHac5qNRFqNeD0uHp0lZtsxhgBvRdetRr90+to0OPVBGnjKkQKja49EMU4IgWA/rO8ici8wZJsbaEXQGjqAZkaPU7o+h913C4hSMqtNgnYbed6iNmlUoPgoDq/KDFfrEHvEhi7gCTR/xoYi4HTv3CDhfpvBSuHAuu/5tkFmSYT5PP73kgmHmPnO0s4SCBj6NQj/JrcsbHfdwvqTiR7rMo+MKZee+Au3349ZUuTNVbgb07C6kJWdyD94Cs/Cl7A4jS6Xfulxjvea4qVfNtIxX5lshyzgmafm0A1PBtQeKC3NdlcejyAsexbSEXcPrxoIpjrWlnFm7HWQk8aksTq5qGkomcp4KK2tFVJxq8Z2ND0rhogTCSfgBFpTM1WdJ6js1ZyxRZ4InccYEWamQEfx2O2Ua2s9h8RnF7hOb1qLMtjt0X1HTTi71VkFYtrdValBNZGFYi4mm1ZjtdlIjIfnXQklWYFgKqcqKEFTkoYk+gakk1DAshVwlRW88SpT5pkv9GrmSJmJYjShqMxFehSbG1PmM5sXgVGDoB1w9KlDUoUJeeW37o1SLjhrWo0o16qhyvTrO1P73lIvykF3MsBbjqnYxRc0uCshuURaqn6H+ibxDA9H6J5rAD84lWy4ZCEBEWeiFhqXQnj3r5cATG0J4srrmkxdCsmyZJgDavFL3aRY2Jwz3JexptTkznuxJQDucWOAHmfd0ZSl9/JIOOh2O0gqKsNJ+RaW7i9QM2ouguH1ssSfsL662M9zZcNPmwd62VN0l5hsUqEqh1SmbnNzNhqsRihsgqqwkN+VkPmsXCyf1q4Xqdp7UsopDXLKmMlhBaVFNuqrdDnhdCgth7s2Uf7F82Sz5dPDPmSHvgoPZ8XpiR1nKco9Z8bpiZ6SIsrPh0+lrM1EFID9/JgxJ/94zoUHbspzfUEeWhlVJ8bNvt97IdFR0//nSHYb5KtbBCKkdwOqZw+r3QzsV/bMKiBI7GGFiJMhHbHwvMKeU6IQ4ZlUgqip7sXQZPFwU4K8FWOpZmttlu1Whlc/1227TsoDEwL0FVsrBg59WusG3Jj4ohV0BhL06nBhDvZqfeK0mF+WXVOjc9DPEPhiO8UJtSz6cVwU90oL0AafjfTBR+UG5z6gJTj1twHlN6S8u1P2Yp+63jijeRBkGaYoEhCqsJAV1MhWZEydJzFRttwd2c2l2McMzN78Qr5/8JCwC96lNwwCqsiJYhyBhNzY9OXBzlEA6L2sR62fTV081myaHQjYL8zocwmKo1QgHHfdKMMnxcTVju10r6oMhhTxOUuyy+0ye254wgb6EDq5sq2YthSoGpamFSel2WdWugqS4J2LSwxOtT7KqqpxomkDFHzo12FiBrfMxzkheZVlyhLX9AkJIBqri7XZm24Ujy2M1qzU+FUd6bHOrS7LSNNvZXE+l5X6yMUKD+WVvOOmQ+Xbbi2L9C3j4dF9hWeibTC97w1WDmFZniqKG+LgzRTur+1RPLjSTG8Ayn+jkKA3SrqSp1FGdHd9AYoEw05QFVqge6eVOSF5aZPvmFmTimCQOcVYLnNS2sEEc2+0gJrGCzSXLgro41nFR4iNf7hACnwmpTPXy1urdAxILcMMn2NNVdsNemQqCGA1YkoOrkYABlzwulME5aAhSEqsHLyO0kLUvVRaIcJmxG56u8heSLYIYM+SUZbEIBVvLWkKy5zJAOLMkEli9N6bmf5LUtRKu92R672faVDK9ZmupalHdqdWgEt/bxI1ar71QlBp3OKFLvdP0FkMH5i+D+XMTsmd0MtiTglfAUsArw1LYO/R9NbzHq1CmS9CWBv5D47xUS0PsHW3rSWsqWWuzWeHpsLoTbDlHe0pLfPCcWHPKODE6T9KoI/2kKYBV1acUYbVTy4Rmu7qo3XZ3qlFvwtX6alpOgwR0aBOUjRKjFJXeqfrEvr7qkxOz84QNDz5/zDx/niAjx3cjDr4sI76vxtW9O1JXsBJfQcHq/s2XFGvEf6hT9ZnNfrEaFbMHiN1vR9FiR5XRPAXG2B3lwH1eRxl4BWUohV0BhFO7K7P7gMalWjDF7D6Cx6iuZqXZj3sAXYrK+lJ+5hKo62XU68v2ALvUF139/murlzg7UORWa0sb5oIaPU1eGhYDKCsZ7aaY58uEbkB61VN6ArZgQrPouLfTuIYRtCwVAMX06Paazbh4LP9gWQraUfj+MrO9nc+U7RbSKBVR2v8nNPt/j9Ds/5OY/b9dYnavlGxZSPZ+Mq7/67DimjtGFSlXq8C42afAWNy1X1WE9EtlPH0gOPUkPnOSlg9HUoi5lUU/cVnUEw32Sagl3ZJVpdOzwzJqaU3ws0ForZ4p6n9NKbbPEjnTG7wsYnZQskxhMysh1eKoX9MFs+554OTOsq8pd2bfpX3G0wqc5ZD4WWbMZcSHbtoJKQk5YQW/7hEgS50AGZ6SVbh+qm1Ezcgq3JjvJenhRcnA2w3pDW7AkNwNKl1aN+rSWthvJ5XT6SyNhFXuiTPha+KJuGkLKjyXVEzAxatu3dF/vI6uyaYzP1niD2TdCbwq5gh/JDX5tftX/FnyaxpgW3mCbFO8iWYl8bNeSTSNDz9G1z781CD1t4nWhaRbY/oHcy3GwQQLPDECbJg2iqv9z5APazhjzYaZysdAVGXEeE1GTNQOqEC3RkTLLasvH8Y7nR0w275UJAwGc5dI2CmLegXecNb72ngDmHElt2WYez8G4Vs/UGBHdOvI8vV3Utf93y2x9CUPaV1lO2fahBpsAnWNVhRCK0YoxSF6n1auhnSYyQJHzhvkkvKKXFIpXJTwtF+KHleuFvt+FTeKlVKKMvwhSdOlwsPsNWNXLnUiSO4rajIAVuoYTvaLINWA62EtJsrrIkjJQRGkEqw8LIWivCyClOwVQSrB68NSKMrLIkglUF/LzaRaQCatyM3kOlr/RImWm6lIJKVVmaVqRNG8k1lKDsgspb7Mkh9w9WiZpeSgzFJal1mqR5VqtDJLyd0yS2lNZqkW46r2ZJaSapR6ktyOt4+QA6vuB1FhoeDAA6I4nyXpXyGF1UAu6h9+UKQ6yMFfae6QJsMbix2FPTLsARVuy6V1WltaobWVwq6Ax55PG9nzaZ09X43yS3q30r0BndwDdPgwt4BitArXeAMxGxezOSgo2XRLpaVbih7kyxRcJGvSweie249Duv2lCcbqQrRT/CUimXc39KRmmTc5bJn3kLzlPZormedN9pvnPSQbet9mrI3e0s1dEnXIPJGFoR+4Rxv69lS1+2IPhSBA5hnsyO5nsKN6JqD2qtUOjxGrkK2PfDnMfJMbLkdhecNFbe7Lo/ZA3ipTuQr1DvaAuh7RjhdcW1bi2rIy19ZUQfFxz+PesoJ725jBHbjBPsZmnbFeYuRKy1qUQLfYz948WM8GS8fdlEDwaGRyNtVh2MF39EOzOveWb2ifO+zqq5tD9N6qCkOnyhPkJSMn/L5GTkSdQUj/cwYhbzbJyL/AJON+tiH9AruMvG6XkX+mXcZmRiL9TOOMvG6ckX+mccZm1iL977fQeNemvMNCI/Tb37iZ3bPZfber26kFaNS0CWsyz59n7bHx5aZ3m3zkZVHi+5t8rL3d9LDdx5LG8ZDf3+5j7fWmdeOPlmrR/9pUi1mSXtOkoGaSW+tILeqzc8xozsUsajGas59X8pcVzWQLp+JNls4yljtCQSqepotlwiQzMTssw8euUhkadMDRK2A7RmAjeLLKMqaACbaMelisFuorjx72XOstnDERs0znT4Wr2HWjmuB6oxIAhHAjvGLZDZ8wcjvN6II9c6P9tmC55tFojOMsXT5XWfKohzP27xXLTYdpHLuGKhKKdannouHcmggG31wS8+02cP0SM3LcA41LnJHU8nroZTbodCji0yAd0bEpSohEhtilsA1IIgwNUs3GZAj3Af+wLEejOAsjcB2HoQWgKSomLGkaUGGRcMpFbKw9l4eEfYKlIwO6bu7QQBwT0u2329WSABNNFFrW95ZHTUMfrsmG3jYDawNrO9kOUbVlvwnzBw61aEKp9pPmu7YvysAiS00Uf8ZnLAdoAhk2u47ZY8SekWdUqhPwCWilPXXU3D561Ae7oQDETJMUZKeKVDDw6kLED/yXnqL4Ri1V0O9Yv/VeY6WGOiTgXYZOZVja4/4pKNk53b85TJuNOrPAzKzucaw5/I6zjwSho2zsMd1E6B14EpSC220PdapZHNBXisYitFeFQp0t9zIQob4r8IhjMca8kthweeCRzleq/hEp6h8G+6qw14ytgpf2MaZ2k2e4j1DU6WS7Hda+6I3XyDdZumSZ3ATebVkQ+XHLTawu1cK3Myb3WGFVqNJnVQ7r8UJoJszBqvXSATxd2gpFGpHeK3X2H71SJfE/crsbMBDyA3yg5JVLM9N1QXBOgFc4xpMBn9a1GgS4zxDO72uAcGukMx399O7VyxcLOrM0q3FLXZ/bbSnDU+hVKQfyb+Ej3UuoJxCYdo0jitMznHWtM4rTM2yisY1CqrvHgUZauUJfL0kPodv8E5cKw0S35q2OWAhCQm+onAPZjmaTQL0zHPdwYVUCg7mnnLlsU54kARpcZ4x+HExozloy4yC+1KpUmZPzE36qDfX8O5PBOcIxyU/8iNMzzEIFwb5LA9rN1eA68em5ageIRWlAO42xOOuenZi4u/qXqZWLJqSv+3L1y9t3ZyccV8evCr5lExnQ7gRn3Qk+O4H/tVOvLP3IGlOrLYFvVRa3Ii1/z71G8ZTQ7hzPSNadY3MRmd4MHDQFy24qcU7MAoaneIaXeIn56dn+Yb5N7zNSN+ETnJUntTMpTX0tvTu5z4RPsjTPW9G+ZnHW4ZVqealbvNxsx4S9ZmutwcDLDRqhikmaB5Zld4FOuDWQlXNRjvc6kOCsuyp1IcFZZ4UqeTq1PLrUno6q5/5rz8r/uEGCusreQX7m0sY0nx+asTuq2tmDC6JJzImSk7K4kQxzesNAVNxvSIK7gYBpQRwGgvDMSOe4SCOdwzSxSEITCtBhnpx5xYVnxnKZZkzn0r0ndajfyfv5mt1Bi04ly1rG+VKRIO00sHCNRbhBkYsR4RqzcGP4C6VolRFFwtHvTY5rTddcZTDNfMiaSYIRa9A02Jf7fUPu95gPRb2OSOzRoGjK/X5P7vfYDjBqHrdCKO2NqyEEwiy8cf6fWj9h6JYRBnq3vhF28+Pr4xZSl54AJihKWDFOJwALscySXAg1Zl9esWzGghoK7rl3GDFQHfMKqx4gzHauyyWAyWQaMM0nGxp+mWvZxEfmN2+37VcIdlZUxyZUnRwTC1ZVUDhNsx/oZB6UhVJ0nhELeTwumthpAiwIE4YfipatfGHRde55c5XpsqVd3Vh7/IA1Gh6hMyycEWm9YoPA/DKhcppmi4GEiVJAIniD15CsCXryEyqCOu6aBwLfTlKRy2w1KVuK9+3EDzhh1od3TtKQTv694hl7mgrJ1hJyOyu1sC3xiiTtdmLdj8U6BEBfod7OY0LDFY8DIHjLNcnVr97WRllpymeE48w4+YtxZv0ArnAWelImZDWMT1eaMuFE1DzhxSz8cL2aTlmmwBOFGSnMxBhWI1lBb09YRjJ/Gkeqm2OS7UEqMtxS+38v3pD5Zo7qqEMpGbAHnLfbyTDIPP+tgbkFMyeyaq4HtW5pwkKWZWkWtJ5TnrD4SKZHk4xRyY40eeloQsU38sgsmSqk1uxomqWLIzlnRzN+w8QRl2zRQrsDfmcNocENTKSSTzeBxK1rNk0z9kJw2dLiXpILqj2fAU/jmov4hxsjwFiQXzOWL1OR8xumse9cjfW4p7Iwka8yLSeX/0Rv2Itnuuj1iiexjoaw6u27NE3eqfcL+32CJ8d0Se7wJGE0a1gfGkIK0GUQcBl2OJfpsgkFrFHPwgrVqFSLHs8+pRl1udq9CQo0+tQo5GxBuZCUi8fF7lZ3lbfZt1vY57lPRKHGQCRfrBZaRYQjB2jpLOkwP00jP+NPcJZUToV/BUwfs2NCcpBMg9RjQhIEyjafjD6KcbiJuT2LNitJMA9BX8Hm6bSW65aLtLl0bHmjMISPpdH/XVlTm7m1r5nsBm5xGW7puW3h0QrUGeyGSsVbSFDTVY0LmHZEqBYX4DFzlljDdnQLamlGAIjVt+QevqldV/Uq6XcCGBPCBgdU802ZfnhwFa7jMVF/tltr5KvVYSBHaUpt7ii18UsplF+oRKEToQfqoHtHqeZhur5HM/t+wjOSktF4INxDGqQktc9nIEqvqhbaL+l0uIfv1lQeSRxXTZzFdddMO4SLyjefX7m1ixZX3NjsdgghbGputw392JW3XgDjJgUonhsORnTc8yt2TPNWsXAprnqnSD3HnsZ7YE36BF5oEULr2lwIdMfeQFa7/6l9wtMsyOE0J+iWg1yB7hM6JoQHInSdREYUR4eInzLQh1CwT0dJcMvjKA15jO2EpHgi1wowlmusXxi2Q4NstIKHcoVF6Gal3bbgFFkZIYZS72lsduC79CXdpCupb1CzOX/OfoUzWgh67t+pozHmROu6u3NS8bdpSxbUMi2o7uuOZDB1eta3W/NBqAkz+1rrxRB6p6QmmHowBPID5qrRrINM67zfakiqBKCNdE0wiEKcE8l5ln46UovxA7zz37S+6eicnW9aRzw/Eqk8ovqxPwKp6G/QwG8fFjIPmMI0ue2z11d1uanRGFp439NT6w+yS5swyLQZCDHKxseEiFHW7Y/RLbPWXshxT2PBO2eaUT2CThx3z3Vpb0O5b70Ak5F1LR9/hqEdhZtKZBr1GzOsH68rgX6lHazuA1sN1tHLFo7U+oiAe/AGtzCQ3rAtddiO++iWF8gAAHjGnZPefDzct8sDZCeF75sU6BKvO0/2J6VUfTHynabR60GaY9d0SVm0Hya2KGHay4Py+AHessPHvApuD0sxmsN0m9C/NhHDjtcqdxE3jAsY4M4uhe5l8+EvAQZ6FXR2swrACEsgxl475umHqwO2gSZGw6c10e3XCmODi8oNsJZs23Tbx87Tnl3Pp0EdhLZlKtuobPNfNjrZHjDQemFIltcpYGhQg4ur7ewqnT4EtVa2nESYk9sFkzSyOoBy17AmpoxpEY/42C2PqN+VQcMMN9QAhx144uXbonxW68dU70k3v/Y8Wkiw0Goq00pq+93Wg2nBGksVCOEzxeQYc7SDd6XdDloroVHIuHVs2TJMm/tj262XSiqpaWjPiYGSE7jXPa5WcOvEBgK23XoFTvsPw4cPrSRBGuqP2tx5/veoyfPDdMomQIzR4bHGVjwOZEb4KXNcwoGxYCHAQJUaeKOkgpohx3lskljId2jQgHD5QgcBxwmQuXZgRIZb0xl5sH9eegZi4bvCGBCvOUQubfoBMyiiJ+ss222FgR0D0SaQpK8QCplRoQGooHJ/mBOQ0U8ttR3cxneQynVahujVzQujYZ7LZHilmQVTzYcdtP5wdzM4N7Fvj8lSP1CuQ+rhdL3f427EXACgmcvuoZnLmv2QsMMPeWkSB8UYSvF6veoXbHnd/JNfvYbyymJ4/PyDo+v2B+IR6Q263QMD9DoXCCzRYN9V5vViVxpT45H0tY0qNzA1NzC3N7A56oBNRMy7j0VlIkwPaLEpS37g9Sb09o4oj6Aori2LGijjsQRyT9QgFyNDsA5FIT5cpDHLQ+3RzKjtlSrKP7MmoDlb/b9brtJzNlHY2a5a7z8fr3l+z3pHBhNvje9XN/T5eZqBUdkys97pfjS0IqyHo5YtU7wCdEht2xw07HGxDT5zkszGPjQUb38dggUqkpnjgQg/qI243Qbmi9zuChd/EKNp5u7yBbucpSSiFQWBxKXqBjE0cy60qJzbnzpsbBTAtzF0qQMbL7CzhkwbtLpLNtrMPSBJDxt4S4GFh0EusJZfvQwYardlp+M8MO9wNcsBnc7yAbfb4jpNE0a9XWHVR4fH9is6rvd3JK3Cs5oBY7lDW/TYI+liIaGytRSLnccsl1m6qcHjIIzi2ajTRE0Q+Mst4U2/IHsm1L0ddaOCoLBYrDxQFvyLSncpKOcCITY0SEANciVKdGhL+dX2gljCaO74GTmY2MKOx6bJrcCigBqL+zDHLdN0C+GYKdjlyOce5GpH77BMn9CcPbwAwZi94kXQWChTNe5f374M6XKZbAIvDdNstoI7xlDqDe17D2bknk8DKb7ToeD2g6Hb4A8l6ScVASdOn2xsOVhRQSR1nCwNjDTjz8U87xUQlOGHhOdSbUagKApSEkJkqvRPVMRqbc00+IMviAZOlhva85RQ0G2qwEXox0vTVCAxB8OKIz4mYrePGRFUemNYEwqWbarSkaNhj+oAEQoRFJ85EwPVuNtFRbyj/VaoIqlRgK/2SKhntEBFGwXt66ZExbBVUhVpRa2qoH4LDrOWz5U1+VwiR3SMuZG1rVoFLcvt+1DfKB0HClPwV/3Qs2N2XTOEB3NRAu1YnffXw6xOj1BH27xVLJxDN6AydQHzLRHttqhE12Fqr3XjP7jekH0R6JBZagd1uBqmYUL/2qCIt9vHrJCk1KRUy7wwxYpzCXoaBb7khF2Pewhh1sT83DMBmO122BvlPRhYwN1Qjzh0AqvaB54xnFw+nkh+w4gfALGA1iJd5SxdSWB4A0lzyEKqc4/GkfsGpKEZvFJHGuAaTdVKBWzWdtt9aklnhmUoqKoM21qRaX+1bB3b5tvt1iThk49FzHYrQmPKSK2/+TxQqT9KJ3xuaU6eDQw/nx3CMSCStFxWp+2pg5bL95CafhrSLKObH/69okleZPJbLPeTuDyZptIXxEgirXzJxdf0riONF6A/cyu/Y8Ohu/WsIH8U7BHoxS1bqAVCDFM+W2X0GszBYSZWC2ZCfXwDmNCtqzsasbEaKjROG4U8NNbVSoW6xVodjYo/lkEPhTL9dblk2VOaswB1mHE/2leImByx8WBff9n9ull7zcHZqe+xPNHc5yQJikcR7PYUIANmBYXDPpb1OS4RHRrQDjkS43Zb/XXPsHpaYJ+oF8aXxfFQVdcS0Mo9B7Cu3Yxwrc/98xRIlEZBgvvC4pg7+fztNmheJftaqvX0IDDbPtrtDDHQydFQMmqpndXCrWW6bOFWPudTqX6h5RZurYSOGjc5Pa+K4WlPWQ78gfnBvJCzOWC+BjcZLHF4Ty2ySTSjQh8YCKdYY4x3EIbVtFubJmDASsEwHjvAY0/V5dp1JXLn20XZD8oUhlBEyWBfgKyCCrO+COBBM99E2K/tVlaU9Hxu8qg3DnmMsKtr49W1cXVt7qhr49Xlo7sH5f6rKJWbHI2Kl1HlpvJVrM5VoPtQGGmP6txsvx4jeibH+zhdhoR+3GtG1SAPwGLtthZZ0SFssDstPKT62DAtlfWu71/38rfbCulgDtkwa4HLRh2Mkbxdrd27yA4NTQrdpCjwm8YmWdmOhNyBhk+8l0upnrpmUz9lMz6FtWhr+EdbhgZCglMFkpds0OlIlI3kmKg/CsAoDx3gTTu1hT2hekbD8yw6/1jEb9l+/k1DOxUbUrDHzQ0scQ88KhRbqrCCIjHsrkYO457twisXgjOVtN0aNicZjdHAqPceE0KBc1dsVLtLEWYB/JgYQrXUc74Rk/q1FnGtm3kv+nbp/mLFgsLTpQ30UdIbUCBTUCRGdFyjWJvVAkKwMTLTQLzeb2XMtG9Pl5aqJRYcBEtj2uC4a0hWjIeDz4ZOhyOF5pq4gzrX5TfkPzfmzPfb/8sLOwE1k03kPoZN0kA02FpldQXokv0lcsh4CVRZUZwvqTiX7EGRQwZKiqqsEjOrqL5/PQPt4oCB9gMrYJanMAtweE2aDasPm6MjhZI2JWCB0yBvsIWQ32FCfViP8hop2ZSBBkr2DfI7DKUP61HVyq0lmbxmwcC/br4IIrJ2o5lvBFqbjrZ0FT5kpSuZYt6lKKKPuDr1XOQsK0RaOKZdDnRBP7pyzAs2V28g4CkSmnxdf0QC2RHAvlU9frPK5zUgotKBmp1Ef2DdfkG/s/awXeVledumN2mZgoKKzn+loPO7SgAI75WBGYwagPd9r599+6qjrI2je+Za+VVjDnfNVK9hLgDv3mfaEJClOcu4zC06/uAOdNygPcEZuhdmjoWz2oM9NVI8x1M8Iwah/cg2eSC0j8KM9HBKZk447DIFA+Z8GuRkNsrGOCZilI8xC+c0//mTcGhwjrbbgI3yMYkRToj6wskxIXG73frQOiZEgebolk8D2VhUqqIJwiuivvDEoqoxnhCHt65UR1o5aLm2CCETqHBOeLBCeB7e0ITH7XYwJTyIEZ7qCIRuoV/TcMHXwRxTFGaza6sqO5ikQnKxYjsjcDANWgJcDUED7TbPnysUjQUr5AVcratOEHdX6IQWFelZ2DX4D7amSbQWh9k4peUTDsUEMSa3WS2O6JMCfLZBUc6Zo/AQ0mbxfy0ZZUxp93cYjAsd1g+Q4Ycbzj6BdQf4IiKcJKlgziYVAggOrAnAKPfKIFTY38aii7oodWmwWQ1tOIJHu90/JkQOg2y7DVwyud0hrODNoixEMaNBLBEWKApsbo5dLsC/1W1oSM/Wrl4d47tdRxqlMqa48KYU3gB1Nf+tSudxilU8N+6rSrWgdntP0sbcHGXTIkSU7ouz6ExdGQ+/9pVhCDuWPemOmyUeDQNOljTL2QshA4n7Pa2zoak+rf8CASwwi8EJB+PdLFzSjAn5Oo3ZSIwRijiRmFdUvnyF84o4TrvdEqlgQL8tSlFg/DirZHE6geNgdc1+4+yTVqlyjYOl65m6iRfLlWSxtVs84mOcNCWlkLQiIsiRuvqCBOGJcUUWvvn56sW7F7/98OHF6+cvXr94995u1NV2Gw+daYfVkAU5lpiiaILjIQsSnEIARXpUO+sKYV7YBciAuEf2WWBJNZeE50BDDCQCMyqFT3eONEcn7fYH9JFCbrpdZGiLAgNDh3puujT6kw5op7MnV5PdAXOI89I7YnzdpiQv41a1yvMRHY+x+ot2O5zpa6TBaILvOVUTPH15WYFu/WkYqv1FpCHd9lBU73Sxq3TmzF5gKNKFQRsRuze6Ga+syDGrgmyHBeljTqpQADDARaeDzABc+kiMPXqu3DnlONCjJHWyte1rUchUeR9SMu6jskmbBu6jE2QR1UeaI5wSOhQjPo5udwOtcZKrN5JDpDUUr1VAU8xQZLRS/Cy+mmiK92g9GJwmD5iRwWcoOqbbrV1LJ2GZbrfF4qdIK1AZ+xbVvMzPy5DuEIsa+8XQDu0QFmpBikGRJmnUvSsiKkzdFpA6QVt6u21tXGDIqzMt0LBpaaiVaM0aNEr0TGGvkWGhduOUZBAghYfnPEXqwuAjMXb+X49VCIgimyUbQhLwcMrTlmOGUMQMw03/HhNSKzui1Qk3sVjVEB3OolYmghAZjXHDNNn9+x9M0mDfCO+aOqp2Ktqp562+pu127SriwIUxt5EO1PLoOWuYDTtXoJ7M1U7dRxsgVeig9NBqFWVrZ2ooovJ1emmflKEcsXEkIuk31dxGQwtDpgsaKKHG+jLxw8ZqpMdS2u3HfIEWWRE5UuiGmiRCmDVoI8xB7fZVhz7NWcYqva88re12tbfaryZy3TN+Npl1HjUaH3iy0C2DSo2ekXQ3jTNERurtmZThnqWUnhUzkJ2uSYQI4hZDDGUkBnbitHd5WfUuz6eB91YbL/Z2xLw0harp12wtf69NJVyTFpwTbquL7TYQpNtHXidEpz/gl0U3Oh0nUjnioDvFAuo6QHe2YWtj4TMbtw2VutDtD7iClXi3e3fjFhAnezkaQMOt3AOtSaHR10JDUBSz4QbEYS+6x0kVj/MqIqJwsV7sIsI8jNCzlQVSZNwhkKQYGpbttvPC4FvXUhhV+OFDvlqy7MOHcsU7kJ5Jl34HVdyKx6SGWjr3Gg0stk5ntwP5dosgkQY/ttq0FCAjz5OUSgUJe1g66I2FNFmkudRyE/suQ4DW6XUeyC5Dl6Io9vs8TaoXhD7lUATInoUs55HosksFYnbYI7jwFnRNmhh/Ycbi1YQFjReeHhVDQxk588CaA20wj9c//Pi4hHnAOBdcfIW2uCi3VcNyoK2cz4Q16zMTw4ZmXaJahUbmJ+lIbN4f59JERvJRb9iP9NWSpLN+TzcDn3vbgdR9DdkcgUTaFtXL1/2eql6mb2nMqcgbp+3EWSvqf9dDOv8zNssY25e//13v1NoqM88kONZ5nqWLiok3bx+xcN2V4RpoxpuuDDeYksIumTgRHX7CEc50JJVUnAUciwLgvPT8IrbbQdYhnsm0W7DZFWU4Nh6JIn1/2eATJj8xJrTJ8OZnvOgMfC3TT4Ht8xnqeHG692fInDpOc3Cp3Thb/3VGCOkNe1H4APZTYUV8H9KrQNePfKlACZwRpl1G6whhrSaUe5mF6y6t9DILN12qe2ntKJQLpeG6m1UKpeGmm5lCK5KfBnknQTgmif4arIjewCs07EUrHJtgrIIxAAUTwk9WeE74SWw99llL49HtOlI9nZxA2zRcI7yJVD8hBrqLdliwtTRZO/NqVh1jssL6/vDm6sXLn19bWoUJbrd91u1flCfceu6uP2XGDmFKAnlQK197anBmmWOWSPqPqIcX/wC/ROBkzrzsxqv1gIGjY6YADU7SERvjYy3upk3hAxQmCHvUG6Yj1u2PjXlawi7zbl/FdVxcu31MS0W1Hp2NW3dtxesBD3XfiAIKkmFg82xcng06TaLe7lhst8KrdMjDxT+ILQ7oKK0lC5sMLzLcfTYKHRNSxNp6kC7Xi+DHZe649NMzIA4Zor0/ed2+nj49d5ToGcH+HJa7uN0G5afQ9QL3NJXZ7BHTKap7FqyICp66zDGkFOE5ccdkVTo0MT5DeH5Jvt9ugwk594wvzhGGJlYnkxNXD7QXezEIaXh6Wt8zetDlsd5zt4h2+9hfWGAXBG6HdG3aGp2euxaa3RAUhaYHc753OTfd6YkaOaptWt2Nhi27rxva+4DL1pnuzeU13zHNwwVtvTDsA4nEkD1ygHK3P5Sj3jiSeiqrKUXAZlEt+M4gDrRySXr1KtSPSenZCOg1n7DXqwVpdEDq28XJHFAAApynbl/2e5gX8BphQ3rZDx8M+xG9PB+eRfTy2+GDqN+L6CXpQyw5g2jyAOKxOClXtRtYukxJuZbcaoJCI+izw4zm7IX4ZUWbBcNOTBZtmLwpT7d/Ik8CCWxKXdn+vIE8JeEDdNkfhg9O5IkET8pBt2sq6PZdHU9X13yyp0den/Zm658EgSTytN/tI1Wi0/e7t69YtX8nMlIdDGSXnOlqilGCnfa9PSzPW2PGbrWPJ7Lbr0xiY8F6L+1MFh098RfklxUXcs8syVNiWy/1eX+JSp+rc7uvaFOva/NbmeMrLljzzDkLn/K0XwDIZwh1+m4Qe0ofmdK5wjAqpb1x7GtbdbhqX/REnvb93fvDepk2taxpUP2oXxzdM9zvqVVQE4pc1/dV0HcVBF2vim6/B10or8ThXvQiW1lpZaodU92CRaq21+1K5C3VU541n9dHpD+UUdfOMzy8/a7dd8WsqWO5p47+Sbmo23+lM91cuBhcsXKmIqmbj6rxdjv60/lDQnNZuTM0laUfftvrP/jOqB463RR/kvVg1Uxrqk94jjC/dKh+Hw0DTvqYEXF6gSL1ExSYk/5QN3rQP+UIdwNeXyTSR6jY1UH/RHYZOvFqORXI317/Y0ZTGgts4zvHUdnkXzqUMzOU8IE3lv5JEJ6f9MMH6D8Yk7Sb7UsXKqrNyj1LnoQPvNl5QksO0ivzMqi/AUHAOuqjy4qtcu9K/GfBVtRhldW6o7b/1fBUBBIqDNgJ6YcPzh4g20VUfjtqWVTjxf30JF1VnSzYvnfBZAkMFjKpq8GbgH0F9bRd9k/Pwm8fDPsnwbfhg4dn8LahSF6eVeNhDcMHEK26p35UPhNVyXkWnnlZvz9Xmas5HpayfHehMvmTvXfI8jJ8oOZWD9xOTnCmeu6ii/k4A8ikEz7Y7QZZzdkHqRFNm+zMaGcgJcKZDNAu0vHN3jG2W5P6iV1/5PLtwTyL9K/DGdI7yud70hvmzxTJmXzHFwwsy+E+Oz992EOGWDxj8i1LQHvPSvM0owrA9EgzPuOCJqB6uN1KEBcxZnDe0WzGVGSYZ1YkFAgZMwbrE3Mxe5pwJiSY4kfgwlOmq8mc5QOwi2u1nIaBIMmoNw4nkP2fmPvB9ygKBKFeInUpxmSfR9+GERrZFNxa0lh1owsWDxHC8d1ZZbpUOSd35wTr4irv/O68xpojQnhKcmOXPAe75N1Vd4JnJLfWyfNQpstu3J0XGJhHSA+EK4ZOpyep8TPhbBM9Yzd8woCqCDZTkcX5THFu60ens5PUeqY4UPx2HQm8iTjQzKwWeA1XlTUF8WE9CjhvkQyplHQyh6RhKRS0UtHq6Fwj/T0mwGrw9L0b2m7QBh82xoItkuO+6kTM/E54ocZOZMavlZGAErnMKBdSS1g3nEMaSNxa0HUXlqeFW3rDQv4WqtWjDdIerEivlKtJl3BV+RZwG/2qeCJeopBN8/Yqqx4WEFvjd+U1ZwAyU8JCb5xd0eU4JU1T5vGDNCE4RUNasFcoTisDa5igLxkZnO37Dcwe2MrIdEeah2bMCt97bNAm2cPRd7bGNmDU3Q+O2DhqkuVrENwDlUEEfpENh1X7Q2ZInypniLjZGU/zpUDMKxNX4rfb/oBPg/4xIZ5KrDHhoVVRwSq6eli0SXTrB4fwE2ad4xB6wrAMJ3KtpUkCps10lmwpc21LWZTMLlOINLJyjGYVVw6qRoiH56iHe1haC4jW/CEwzVM1zRkXs30UOdZpHbU6UrV21OoIzYcTM5bLd2wtG7gznHDnXsCqfzktMKs6RLNrOmNP0yRhE1mL2G5H4wGHzqnpbbcDV1FzBaMx1tkJQ1iaL2PIvjdokMHyhAGYEwZg7bYvFHZMyHFvmJMsXDCarzKmBhxITEF2nqHIzwy88QbxIytmwZxUTUX0bLsN9jcBkmcDzcCwPJPTM7D8+8iqkBRSLyvSG6wuk8Gq00FGSZqO0tFqPB6kVuWhhxNkbbfmwCYuGq4tZiFNy0a0kGIBcWsVQ2Sp3xQ5j08gzEIRwtkjDqK/mRFL0sL0P09f0msGFpabRCb6BwVmytI4llD8SG0TVohyWLnNJp9FDQPFmTo1ZScrxpsL62RYgNte48ikw7sm5t8rGmdU8ol1ScI6HAsMfztZuQwWHdrN9pfqUGxq7tBSSRdTL6dLFTXbMrb1phLYjcf3RwPCpuBFdNjk6PDIWjtKp0faP9iPGY3VG2Fmvepm04SNch2vyGq5iiveDSB7+Kc2dzxVq3asTfu7NTfSUCbUJAFQyrBHCMD5O4NM4OPMSC0XYlbWf5qWezKyI4XJjX14hBbGPpbb7TFT6ILejup2sTvTCKv0gZG1X1KNEjkSY5wSpn6ovwLQ63Y7rcUBn/S41E94g12bTquFHhOSFl0xHyAHYa2c1mHPdrvJegQUUAdRSyeBXSL95hf6h41M//qmekOlZJkYyshsx0CiMKdylVHJgvABCmOafWQiCPsltR3bbWvEjBSj8FQjvv26xk20VZjh7TqSWgUk3OyitAnn1CI0jWY0sDHMBLK4JWVQ2B4crDDlhTxeZuXxZN0YHUdIb6maHQKu/a2mxpNPoXyYgo6zNTULieqmT7R2jOGlsiBBu5Jbm8JWdiHcKCrXtAy5eEvFjIHnJxZuULvNPSlHXlbdYP5zs0/YCOdeg1rJJ22WXUG42h91Mrg6kdVuFdCbAimZkCyDOgKQcQc8bpBeZsMgJyM5xhlJUZQSQrJ2O3fj2SHsef7R9rkKE/RgIhQfEqgBYbNw3WXhGu1A+8RZbByqCU9RpGYpxcd9nCO88ubBkhaGVfPqDbZU1J5v3jzW4jAnDWbYnR7/CEgVWiN5PNBs62KrtNsr+/KrCVmBDUs9Bb7em2etkoCwSB7davOgvqCqE/RhAWhtZQf2mh6SWVcarjFV6+qACLNIONshnFltENxXE63AkCgzJlUzZ4PS1ylpWMcDq+PwId/vbJDXZxWMk1TMpamQ2kjY2iKNGncMWE7X1hnApXZUVoGx06bt0hrsTEGVIDdEs5JTX4k9N1BmgGrbS5xhXgzTVZQ6FwLtdhrmaSaDRiMDIO6WMRogEGUrQpSILvfZABTg+4rqe5dV5gbTHcJpafHWpe3iAat6w6QKLcg9Q2VNN9Q/9Z7JQP0udTul2E1ZuMYZXF5BTtSUI0yLaWm3j7VvlNEY4XSHN1+hS+9tw5uv0SX1j1Ym/u7znz8rmduMtpcEjQtl6l2hHmqhQnKrwcLotjDBGB338AF3PFEPNzhJAmta2tbiSFt2U0B6y7Myh41xN9wC6iuodtoA5B1jUP2Pbo3tOC0IpK6hqGVORwv75nJxzeJedNHr7bAxE2eMOXnwbtTKZtcUkO4e7oV91LLJz1Nhs/x/Dx8+LMU/pwuebKLWNz+x5IZJPqFHr9mKfYOPihgVeJxxmnyDj3Iq8m7OMj4tVXPF/2JR/6wUBWYoWiLNFjRp4XyefgLMCybTmhC43eGyIdgGBtFoPGB6P35zuUqOJgnNc9L6piNDHne+6eryrUffFHL02gTBHm8GAlQATX0Jf3SZL6k4AiIHaRVmJLoACUaqmbK1DDGuGpvofNN6dHmqqnn0DXgZr+aH+77dNq3uy4CwydC6PE34oxYqTB3a6FXyqKWy/ZlyEbRaIGYpw6fGLpezp/f91wQ5udZPqaLD9rA5Nz+EsF0d3JR1cKBirE8ulnDXfiAMS+3Ip3K9O0U3NhSRxJQw0B4p+qcJVoRQ8znkXrVd6gUim7tr8+406abax8L8m1d6h6om3wYVByDEepOGrU3j+Em6rlqG0Db5QX0dvuDKZOF0lSS/Gz9o7nu7BXuabpKLz+0WvDtiZodvP7bbHjY1WzOH1kRPvTP22YTsQ1usUHWKuv2BMKrUNtXQdAS8htboYN3+q1NqAbN4bkRgG08PoYVbusutMVgYNLq6KekpyHfQ6aSIEzpKx7hBE7TdDrSt3REfNzrWAeKK246pJ09MFCD6U5rxv1IhaRKggRgCOcF6bynmf/gheoqfIfym61zRocjPe4VfIfy0a33XIfzOOPmau/ojgRdcwA3J8HWq7YK5juXFRcfLakzBO9xEFFGrAF48waEUs1iZPx67rrcJm3rqG2/xC4SBm1DEvcSPEZbpMuphTY6PervB3pn4eHrmvGLIws+dGZ6hw73xRpd8+eiw7X/P9LkH/fzN9vP33QC8O+7rheqo68fKgDmlaVKoi2qB+MOcR2+x1NzDUjztTqO3nafghXVJfoIjpvpBfuo4qvtPxMaiyNb9g6vth05BDFd1/FbU8VvnDf6BmIxop5ZUT1tcuJ81Fw2ekHgYh4aHYszUkx6ekh6ekR5ekt5AM0UmaBjMyQS6oS6FKZnoBlRgRiaqE+pzSSamH9ttD0WqjMqrsqg0TQleEGbvK9wy3KsbP85yqTZ+pGYGXftRjumz9mMLr3ZoIIIFmJsVwQ2YrRXBxoSvAdNR3flAaHfeneKPJOvOukv8iXw4PcOviNqgVySg3U/oNFiYG6VzY4lg+BkJsu4rdBpsbNq1S3tKPuA35CN+B97eNA14YZ0P3uANvkY41c2/ID38mPTwc9LDP6sJ17k3Nvc1qiOKMybf6EUruD9FXIAGL4g7lS+wdqiN8OMi8rH1tg1obq2DX9Lk86L259j47P65iPvZOfRWTcLI35I5fkmm+Dcyw7+T5aChG3nRuVKX3nYsU6ro/k0px8uGHP6cFjVvSuV+6zjOV1HwupTl96Ysfq8ThCtj+6qj+I/6CzP/3tse3be4hwZvO+Q9ftnxdkj3pYpXuf/yVrb7m4r9rUP+wr97uX/u/m5zvyZZ97fu7/gJod233ZeDJ4SQp+32a0LIGzCi2DARtnvk9b6JaMqxqeTwIJ3AzBp5gvbNyoH8b8hr/JQ80eP5gcw77/FPZNb5q7ZDNwivEP6hQ57inzrkTdH1ldfoCjgV9lIyD9Fb8wDp50g9BuYp+q3zZmfLrkvPGrMPjKvL855fiva86ZfiIcadw1KSjioczj5VTy8qcO/z3hfhAfamKEDcKpvF+ArXFqRc4IP5ikZj/GFCJ3P2IlaPN5vxXO41ux/aYoPR2C6RRI0QuVPRDyQiGiZlHmlX12Ya7nR2eCW+XttWTbLogYOLWQkibuhHzUN0qW0yGjcUqTqP8akftqR5ucDG7+MkuSv7DmuPAoc8NeAcJ8YtDcsnGV/KNAOfXyuS+NpzXD16lyvLFqAkGXENxOvGcE6yERvjBs6NIRNJN+MC1PqwoTlRC+8gnBveToZTtd7HfdTEOvI6GlXd4IJRLzN87Tmo2LoAPvOQx8Roxdn5dxYG/DkwggVggnc0xqn22aCxHxAwyMnec4ITktpRgQs/A9C5jhXmhcrrayYoLXKOxoe2J/W3J+guqh1aUNh5jFNYqUFqfXKlamJ78MGMGZkc/B9iWhCwDUqjexHJwl+v6voOGXjEmy0CVNy4PK842xX3hcWX3f2BZfgGvp7QXEWXrY4Ft+pi07da/ytQNzxRE+5b5OG+mIlxdxKV5Q2YkTfwSR0Ok+Z1syQgi1PZGTgjImBaDob/xTANK7Q0pHaYzSE3STWLikI4d3k0Ga+cScdZKtIt+IzPMBC6ohRPNeEvx6p4xD2ZnADugbp5e/8h0J6Xb2OeLxO6iY57uOL6Gs8yHmt6n59rUqJUHpn/gFiZcMG0odQ+2DHWVlLB+3VGP/0sntp3z0a945OPQE2UfPLxFc0+voT7Ker38F8sS1969dlwE6H07AFquQy60Wc0n6tXrB7783SaM4WKpvDxoxvkcR9fl8pe18rsMMwayKBEt0Dy0xMetVrYzVF/B+PJo1sQC3ks/2BZqqpfcPE2lQWVeu1CD3p4wbMszVQ2ixiqh/eGZTk4f/BWgK5kevWRL/3vN64M9MoN0nKzIxnCZKurZwHM8jwEfxI5nGgt3VY/sgWqcdCgowaupEVpXxp0VcFaLvKdRlU15OVi31ps1sBhLuGJRWd3O+y7T/b7wQvHqiXHZH5+DMb4m+lLrGB6+dYpaakC8Aa2oGtN2JM6YCQtGYQyeI6cV4cmkodHmsFCVejdUU/V3UrqUfAm2b5cMfmML5jI1QChS3ktRnsirkUXLh1f8gU37s1iJlm24KIa7/w3enG6gicrnsSwh3RkOQjlqlmgnAq/S+HIPE2F2s+weJiqt/GGZRIKmBxeZfvL6Xqf0mSySqiEBuxBCoy33T0pUPH+grri51y7vJ6aXyj03A/4G0NTrHbYS7jPFvWyFzu0Yanvv91LxYo68321eYe4iaqm8TLpdj42xLCeR1rT9NIocFiiLM6GoZD1CgqZkx/FpYsCshR3hB+Ey8GP0JcC6Znp/uyZqpeqTn6x9e8/80WZoraG82WdDVSO2L373dRM9Wjev9NFGa+2oh6/r59XfaVIta+Nx/r+3W4sXrTRdKUcsL/uHBeq/AOpf4n5BSslLFzlcGVAj7Zb5nqHzCb84gHtL12ds8Yb6/5z1ljcm7O7aq9M2kRqnwKlyQNwmKKBhmWJFhA3Fg3glrQVb7e9AVA2bXED2IDN+jILxLKfwN44KcP1zMigY7NY6nqqvJ8Ix2SFJ4ZgytcseZ7CfAd91K1H9lD34SB+NGm300t4422PB3oG5oQXlp6CFLAzYy5okubBvLADxAWETlaPvPsQ3abd7uA6Y/TjLu10cEzyk9VOar6xbYqkZlN98YrvL13dVM+5vP8Wes5lUX7K93n9UdvCvIjkFl6HqIf1ra8gn6zYNaAXolcutzZf4RRoA8oWKMcrktkdguMa1w9PiAhShOekHz44EUGOQoUj4alfR1hGLtS6xUPqHjieP7cEyQANiwevKy1opzXJiqAFYW3OyFa2areT0OE1w2nUw6ol8zjWUyPa9HB6R2LVbgdFDR3imuqQOcKpl1Evw6xySvRhndx9UpaEN0jYB6YIwgsSPjiZwOTC/KFbVwtk18DxDA78TemgVHY4wpvizNwgfF2cmRuE1+T6ZNbR7ZwsO4uT5cBNUWFWzpsrNzVrZLRh4PqZFNfPB3udj3pj/BEYVmpOPphZQfiTy2DvfWdABr9y+T/Z/AOwKV4elIKXfIjGcsgIIZnj9g83Jx8759HmZNE5r4I4+wosoMCrzjkAWX4TH0/P6tW8UpGGt5uGGqEczkgvmnWq97UtiO3eLU+vcVBjNtsMlUE0vUCnZzXAzCbspPGF+kofGdCKMOpHFW0iYpfQOhY1RfaCquYUgv8nf0YKE4d+dOUU415lKPVS79KlV0imy1KZt95e9EtBfPWOKJU0U1QvqhO8soYp0EMWxLjnVW3zFje1f1vWacvA27WkU0fRLIRTik3ZmENV767Ou3yFO6YPkLphtipuDgqJJGtFeLtt0kSXw9f0dfT/s/euzY0byaLg9/srJO4dBEooQiDb7bEBlRhte/oc7/pxjt3rGS8vV1EiimRNgwAPAKpJi/zvG5X1BkB1e8ZzN27EjbBbRL0fWVmZWfnQwTWMY27w/mj8dc76DttnntnAN7QFKwve/EILns/aVId7sWNTUfh81qiND2kbH1Hapj/QH2A2gAHfVrWMIKcoZ4e8kBO16fD9tqohf6C8uB8uB3Hkq7AcVBbhRNkGjsPSPQJR6QEp6GTfGjAsfaQXlmbL7AXakZTNknSC8ARiit44zYvOIEh1HRF6O0WK/Cvh5EWOfXVtlYIjUnZu4NI7sGlyltZ42gjSmdy7amc7l6fIOuQSJzdqb8LmtjtFcNTjLPc3bMm37vnwHphAQ+eCcg7T68289Wad9eakvHFSRRmwle0ui3UnRiPCOsvCusti1G3kRJlBo2vWfkUbaXF66Vh2odOEZxI1ZYpaJZPwAkew5SWoZG3pwarwOrLPWZKyuyQIyrtkVqbsXvy8T2ZM0IR+vDvmyuPgMUpBowxUoegdpVYPL10Uruf9gIA+NxTm0iMpNz69uQInjtS/0fGa5LGWreIdoV11s1xMFgg5YMlBo7qTprVuBh4UwhyICRmcbB93lXwRfiJlmAtaaelQjMsOJZsm+HGw9c1HWj8Q4FAePEKNdgm195ZQexBkEu1TfDfvsyJe8aKQRtpbmPH3oHyzCqXzzYpcT3D4IbLLqfVZbqh3Lu+pPk3UO03UP03wzjWJHA99n9L4bfhprSOEcBMEnZE1wIpm19Xp1G22Op0m6L7JUHU6iYEhXEVkkq3h63oitU1/Jkq3yqfvpCJBqhQExkf8zUvloqMuib++QDCCCkKqtQrGR/wfLxWEBmW2fEeVUUXUmfGVd/kqHAqooyO3+KtCCCmjCS5IdT8JgvJP1X1yOpV/qggh4uRH1X2ngug+vC5OpwYFweV+NniNt/gpKwkhoUMaGBqAxvq5R7q573yniVKn896WQI/Oe13CWyfFPhzhp8Fk+dQCSneXAyuGy9g8juES4fXLZZcyaqHgu4bO9zK2T1N473wIxPGxCnK8XjU1BYmwHvEBv8cf8Dv8LX6D3+If8U/4OzLa8jwv2Aj/Yn6qMz4MYeEvZDXTJVOp5/ydSJIAno6WYC83wj8RBYwo7dRR7cpq8CLp19JQLof9V0J7Ip0SRdxxIB1uUPbjUDG87JI4KMpj5yENP5L35B15Q/6KD+Rr/IH8B/6WwM2L3xKt2AN8FwDqr/g3IkfsLQr+gRitT3GBSP4s/I78ZuYnVwf/Sn5AqczQ6yWfZH8lx+gHhH8kv8002vg1VfjhV1iHrwbXIfsqIp2lgDX8lKXAj+Rn/J58g98pmgW/IUrNCR/IB/IteUu+On+vNAzawyR9xO1xkh5we5im73F7nKYf8GGSvsPHSfotPkzTN/g4Td/Kp8q/pT/KH7+mP+G14iY2eF3Il941XhfOk+7W+1TPnE+41iKy8eTmQRnntbhlB6BfxNFLf4HPNwVfl+l3oGNllMW+7+pdLq1kJSzihj6xEOHCHmHSxmqkuIibtq7eM3kDinSJQ4q4Ye138ADQbGQz9jsU5b5yjq1s206J+AXUAcWF52jAoQ2gA+V2oI3bwwS3cXuc6IZV6hRSp0hXdV7k/Qagfqc61IbKesqwJjVr2qoW5CLOLX3mLBrE7SukKrrccKx+/Ar1xc6JvNoQHoUU4jxJsVURu9tIWu9T5cKuqiz4nWkNOOhIqQkZHRHkhnuhMtipvoqiqESSpgEJ2mgUgRl9ginCNAI545OUg4GYxSnKcIITlDlLIkBs4xOtgFVJInHnO6KObqgPs/xGt1P87cWrW+G9g5K1pBJ/6s/MYKG3Qzgoe0feaoLCaUH2rhPwtwoph6Y3+BbjekPezpxAAan9eR7c9Hf4W2eb3yBvxww+72yyuW1c6vJRA8ZBA4ZZ+k3s6GLANriQeZYn2iikCPC0R/kTL+0EdY76J1zgSjX3R7XNgHEl3vxOLjD+xdxj+K8+gnaGiLKduCV+IVpgMwAOEgrwdxH5K/4lIn9Fafgj+WkIAvTFoa4N/KOo8pOo0kEvBh/8iL9zMMFP+BcXBZwFItV6XdM/SFvVDZBFnp2YNGCT5dpnafVQUGB5d9yxnoFyN6xNM28XpMRKQ1K2JJK07hyXfC80+PVQWJ32giFrVTddG6cWzYa6TyWNa/vRsb887Ue3E8Pb9oO8MTdgXle/qzPNcoHS57NWhbnQtWOt59XOeH96QRByuXhK+UV84RIkl3kOHcA77LHat97kmHKtJIfZdDSuXUO6ngTRN6brSiVdazqd90FrJHiGfrG07ZN+R5BVuX71BwExXMzkec1KVlMJuz1X7o7uruKa27hp2e5n/htYRunf9wnixH5aJF8Tpn3Zh2W8pRB5gIP7vow7efWtFOzDoMYTBE4ApHzOYaWh7i1HN1xHQlkyXsiGITkDYY8Y2ZYe3AEGAXMjHYWytzEUR7e2HOa3E/ZKcvFScNRIwZH2VhU24wrd8qwgzA8zUWBHVFYg1dDMS0ztmAuj/moZStHfDP5NK2tqvCcT8H4VRXukqlTR/saRxvXboQf5Upg2CNMzLqo1rXm72XqejD3FbKlyzAaUSxsiyCuxFG5AAGdXmApBALsDIoqCDGfTA0SW6e6c226BUJYQQppZyMmlTn6oQG4HIQ+64KHyOoEQjIpxg3BDaCe2AUqHO2u6PTTdZlGWV1e26SiieGJ9P0xwFPGhDrMPG16wkN8VpxMnhBRBQO/2EsZyud70gBsn2ib0kCPQcLY6kumzVJIcwv7MjXDYpqNR1J7xwAm3Dkm4Fii9mvH5dDHm88kiFf+M+TxZZMafC0X3kyBor4m7NIBsKWnHXpqcksACckWdNhCuyAi49MSV1Wjv+yq8Bcoa+yZlHgEbPE2QoHnEKW2rt/zA8rDRT4tklIyMBP8y9Dshl24/Atqt2GnPw/coGYG7cno6TeWf1/JPImOfloSY9RxPZmKUfznsqpKVLadFiNLRyDGj+ewPMaeXLENhPEzZxwZa7DY0ZDf6FwIvU9S4mera1GsQArbLUV7vhmwUUKmD90k9dmUPjnDrOVayF9DDAa5dEMg/HOH36dTGD5IeELeI9MKDe65TtDLvQeo3sxlgK+91K+SYiqXFR1mmnJWXy0jfOBy7faQUH0yEJ+X9ykY/dxw7OZbtXcE+J0UfjZrBa31lFuuf+GjT9E98kJIAFssf+Ki/5Q/8WOVH63WDxd43ftCfyvkGD20JpchfDijy23rStYZbTdoIlAM2AlBJD8/8NiMEs3SvJXHPln17BJH/844u5Uo4X7jlbcHc2foJ+MF8O/PtpF2YsCllZ+wnDU7ZFtGT81KGZid70stkP2Rbdt7up8yTGgfyAVEXcNPwqqpaVrvr00nBDzbBWaFu4oUlssXsGnXSBhfJKaNXyU8aXCZZRK+T86XasyvlfatcuS7vqp3JNyl4SWsmh8Ji8xsvq7pk9U805/tGZDifuOOFRUCln4Ir0Xt7TBPtZEakfmUKpSze7ouW/1/saBO1rQSUFX1638qsw55r86VypARS50ilBd9Dm4ObHsCWE94gOZneOAiGggc+ec5A2+2l8JltxJSmnTZqZ8BvN/YTtDuMvWCCsioiutJXVX7slHSSlD9bBdnaR8ee6B3UKTnpHrMl6WCVDenCWMYj0tzkWPyZhYK1uPHPWJrIvIGDJbOqmyX8mYWVrO3iKSixn/WATWXcbGR+uJdVfRBOZOA3cKvg3uvUUjwUl74LXWUaFq3Q2V7vIHYqXAOrHLO4g9u8FH24C+XcV04erxEeam0p6voXgZPQa8vZdGVf6Gw6Ep2sSAfqZ8toKg6RbKL2BN4qsVXtivomCYDQS4GO8BqdETisGJqOYNG7WM1P6k1JZomOaATnyFzeSo+Uaj1S7hzGqnMYZdxIbs6lpHHUEdWiblxbuWNlfmZlfLzTShSzSsra0jI+3nOteaJzgXk1XjAyo6IsoyuSUIlXlZwN3U4xxORrq12kxXXodprpngkRsD8cDPaObM54OPLp/eaM0ovVlGrK7fRidcLVi7gtKnjHobKRKnKvqpxxPlhON3WXnPHywsBWM7myev3OuAnL+IBmYa2klXgPCUEQehu1DMv4iBBKCydbvZflL9bIpGpsGz9oZRJNK8pbcK1owJn+kdaaHFwrcnCmf6TV2XP845hfkzaWRPURFJ3tDVjpLw3SjUhw78GClJoO3ZNSk6A5qaMKL0kTVWrEVj2gmHHjsSh1IKkIgtDm3E4R1kLj/YxGJE/p2H1YEJhVadCKu1QFuphip8X9zAiRixkXTTijkL3lKHXLjMmyWyYiSwiSwfExpdIstXjRP0FbVUXLdw15ZiV9LFgOpqf7RtwaQ373rO0qfWI1XbOOK74upeEbkH6BRrhDqI4eqyIf+cTjdIBe/LxLPI/+j9VqpSpKkFFg7V5r0w5/oSpZQl/V6ZKGalT+NTft0Waf90hW1YFL/KkuDHP0OT7Yny68plOHtHvtE3Sf4z79pTvzyTCxCQ7l5W0AGnm0lzVbBRtaaffTFiwtpK4krO2ghqTgOgmTr0ENpoTPtIwgVWYn2reqdrDZzpNFVseS552VRP9M6X0Cbv0F13pHgyAsCZ+r74VxhV8aKyBnfPZ+9lNkwypJPlIPR/I2jgXb2GWelZfB02lkxC+lGFhERunVCOEyEtgHelEeauVaD3fScSvrdwVai9Jzb6vmDL58wWevxp/uflKfku4cN9ol7M9q3bwlMTSMv2xvAWh12sr7kjrPbhLYM7+T6GPAopmXvOW0EKDcc+cB5AOhUhlSXxbyOeidD3I9FUhdGsDPQC8smQPARpRD6zUEJmkQrglXhPlAZkV4bOFrqITr2LohZdhgKr0KNLjWPyqlymnBcnAiZhJ2Ai6pqToHOx/Tv/Ul7QlDrax8Nm8X6XwhB+B17TgDV8ZDfGAEnvvmYiisg3zFUHginS9ABgsubGDlROdZGVaautVmvgB50kc/x2IsCItSkuBVypD9bEn9KjvggSaUILkS5HEt3boYoP7kRbcM3D+/5upsfBxynSXnhrGUtYfgjlr2cRCoFUdyuYHK2deKlGGFuXSZUQkIlj/qAbN94wB/j6V3ErwkuT1/G/Eh+YCV+UlouESg0/tAly1/Yngnfgv0hrdE04GbjixwowixM34izweRjY8i8YyP2rpto6xZFG+yMUrYj7IG3Jt/g2rw89ezuIXWNgJLrAQcZAJU6kEsyiMxCMw8a7K6mS/N1yJc4zx+AIfGOlaA9qo3X5j4EHuytvEh9qDa8iDhk4freblAKFvGK160rA6C8IE8qI9wKOCGzmvxDp1BhYi3bPtzVbeq7oBLb1NXFwWCWdZXZ/nBO8sHObylcxjsPSZPWo5bMXdg5xBwoRJ9kjzWeDp8wDuRbpGXzLQI0JSweU6qOX8yy5xgnS/hXmZKANc5BzeM3GN8EGlHP+0o0lzyiix97mDlTLkhB7yCa1hGJyAP+EhqiRBWCG9JJX8fEX4iTbjCR7xVrzQWtBJ9zFYKwMlWQ/pKgTjZaqZDzOEpPsC4n+IjXim7raOC9JW22zpql5lqNn8jj1BNwjl5jI/YHL8VZkGwjCX9bn/pDV0hnEtLgK9F5Yu2L760TaYIegRLewEIwwAr6YTPwBxlpdZdofFhgilotrlpU5E29dNeibRX8uL0Gv2Y7y3g4Czzl4svl9dbWl5vY3m9FXCPa+Aed5p9w1vjslagDYcp26CGrKOt4NQ047WchZysxC063guiAlekifa4IM14D++vq2gnciOTO4bcaI8yHdzFbYySVZRHe8wJhQZptEcOW6dK7Mb5uFNGpt9OvVTDhm5QRQQHXEHvldRkqMRkRGIkE6UZaZ2JgXLydNb8+iTl+DBNKT68Ejz6JK3wcZo2+PgqLc5n5VKowxt44ahKiSukNYlGwtzRSCtj5x0B845ampiDsWryRaWNTlFcWcYd1TUWlt0nFYp05DFPdFbhsidXLPtyRRnRE+eA6vckAWMXher3dznoMnCrH1fP9wssAKwV6KeNjxGpogbvowmohsmKYFF5jPQ8XC533CC1vF0SrrO6nrC4Ugn6BaFR31lnwe2T1sX13sMS+m9wFHlrvB9czxqXXclq2ZOsKrWAxBVaMQEXjm5nGx+i3Fm/OqrOWaG1mOxNg5W3XXG2PQY4y8lmVrtSWEfrqcKNkcMaSnVpLklNltry6FnsVxfGBt9IFKjxooCQfgoMcC34A+6pNEIT9u6ZNwuPqaO2/FBLnaF02um86/TGFIHKcTTB9Xgq/vdb3CO8lES1WRFJiS9Fmtg6sxXm8hbr18bHMakk6HYp4R7wyms965+H7kNXB37dN7QhCO6tTff18AIuKPvPeQ/9d8HLUvUavwTNDix3O4pK/yHljJA6/VbG011GXOsu7Dy7mz4IcC6EQQGrmlu6b3AG76rLs7GXZwGX5x4uz9xYmC+NaTlctO4lnHFP05VrTdci2mDnrmqCQGpAasoEYuGoIULxfLyRTmd7AfOKKMd7DP9GG4T/m3N5N0Fg79Hq433gfbQcby73Ei2xGkm0RNiR7n58/KrOUMuyXd13Z/iaTvjo6OXsh9vHarm5G1BQHZZQUGPO7t8nQcCt0nHHbNRnqC2RyBwiUStCMU0XGxf7H9SDr8fKWXtaLlg5BswfE8yfej2kj01o2rqLk2SCZklqUoAFdh9cT6eB11pI9D+7r7cizXunzXyxgZKPB4Hg4cxm2JMacsywOKSwL4eIOG9rXJx+583aVJdslKxJkU0HLqifrJggnSGIBenMAWJFX7CsxiVhrtzseiJtrR8K2rRvgE0n3tfpNF84AXsIIW3cHndsxjRbT+aL1H4wDQpr1ioxYPOmhSG9rervqxxC/8ZbCIqMxAgKLwCiaQl7w0D4mlsvtkNDVrVKvTenU6k4HvTMOmw78cIAKh+1TDFOmXEMfZ1AbBn+VLXijJwIjQ8AzFqT6nSi8dFNOWoRNT9DgJQBiQJ5Vo8laTfeXduLOakCb2AuTWZwTRIgPhlJQNVMwSa7K7MoYloq184ZaDVXQVDFG9po03Kli1jpdx7DsSHQJ4gPmIo/RxxF9dmQ/6lrLH9bI3x0U+htLQBvIFCZHzNRHABQxT3i5mLAQBNEkdq58TsK3pKfJUXazjnMbR8E+97ccrLvRwVckmI46GDIcI6y5V0DduRLXJI9Op/5SjtBFvdXf6VqsoGZbMxePx8EW5Q6wbtevf4jtNnxgBpeVsY6BlRM6yV57sr8Sy+Wq//4o5+77JvP9Ixb2+CbejkouweJKwQzu+gP3HmguL5mQWA0T9ux2PgpujMpLK6BHIhYDKG9FIc+RWesQqZdtoowdwq3cXKoildXrgv2tq62ct85FkccH9PyDC4zYggljCtCDTDghojLjdayLi4Ij1mZw0dW3DUZKiIy1YZWAJtZfV9kqB73kkXp2i0tobW+Fxd3fUcKnJPqnvCYl4YeCoLqjvC42reGQlLIYx8E+dkEgcU+SF+6gEEmwwjYMug5Ra2ZEbqd4pJA4EUzgqh1e0e3U/NGL7BjZDwRMHRTSlwZGcdRIu0sZe01oy8NKnMjZ/7HtzdhaEc1dkeLbkOzgAjdhBaI3GHiKRo7Oc508FSQzJ0j+5Hl8lbr8sDU2jnDGHtdo9tp5CX8/pX8/cQV3OUOAHPCLAB3w2PTeqljqGLmrWeJeb+Au6wclxBIxotBjVufqfBYitbhJzydPty63EqPV2k1GSob+D8rXpLRI3tixQh77UgrGG0MZ0zhPv+X4d2+soRBm8BzPrfSt2kaf4Y/CSdLBPzKw9DDyJvutBrCvm1HH3WXrZLE2ql6Ww66OUu6+4r9xhUaAd0AsdrpdeLdAt9Jg9/uNTAInjoesiQtBWkhAbMQvyzQ7kkTNzta/hvdNQIRijxe5DUrVYRQ8CnoryjekLEMul3FD0VV7YIgNzKCXFmOzJMFmEW6tuJf0x1pYn/tTqdlJ6VrKu5bijeOnwZb94LVeNNz6uBXUd4MCgvSuobZI1vBJHmW740L+7bwkDF84x5EQQN7IOUbm4olxi1JsvYut6bYLeIkn7cLeG3c1eyJV/vm25Ztw1xG9lUKCVjajNQq7rQ1ZdcRT/GGtCCZ3kA8hRlN8/lmgU2FzTUh7XgSBNf700mV6TUCas3lE20sd6uCGGM1DtkPyoYt5B3s8M9HFP9DiB7eWpLHNVH5HY0fP6nxThyCCwEVqAchGbXHEKIVk2fZePpKRi9WOGXJ62XBRj1c56O3iYfeamyGl06wQ/yln8mvr9yqHlIClDVInPYoxs7jlbeOpbeOJWxSZNdxXHZWtrQLGpVqlRWl6hDFDOvIxPBTRgROy99Bt3nUguJKB2mrDillJ2Oackf5O0ihgRGYkAithq/WV88fdiPmLry6GbRuQNMjZwpSxhas4BVPjT8nYnvEtXAU51sDsTLyX5FJnEzwmiRZqdFJ4+HB0seDNW4G3Q9Y/wGe5At3j4GbiXDjUDE9iavozKiFsCAIq/hwx1Q4QeXD5WZ1V8WH06kC/WcIKKg92kAWhIu29WZrEubjKj6g21CmwEfqtSYKVfFhnKNb+Kuj3qWmE1FiORati2baage/U7/nIAihoeN4CQ0dxyaYHcJr91l9kiQ3a3Q7SRLsr/3G3wuk7PPWYJ+nzfO8RRQ1zNdweaA/4Sb4dwUKAvwkt2c0wZGL77/4A/C9OnCOGbI8Zb1QNq0n7gD1Y8Otipas9EUpCWclEbvUYC7+Ro0WcW55KVYdV/EjbZi4b43dhJNs/R4JWkurEGel28bBtMHdNmwyJWJ7xSVQxceoOLtxRUoIoUFVQA2uQ2nUNrxNl0Tm9sjUbNkCw90XUPDLxLCvqzpY8Of3fLdjuaPI7lwSP5leP06+mp1r3V2eh49Ri/702eJsJG/d+F4eWetgupzsvYcTvgr3sQ1mOwuZKEAbhkuyjw+Yk318HO+t9jcVCZGTUJPyns0m6XiCKzLBjelALcLppAKKptD2YbzXoCW7iOw3dAY9wAhqIpqk91y2PtSyCTeaS8BdWsCygvhxibD54mOKUJaT/H45W6a5eo/NwRCDRUrL4ZqQZra5qdNE4JIyCtWbDKSPVcaO8CiE5yBVvBKpW0KjUI9Llxc52eqakHUQhJzsMCVbhHfXhGyDIGRkhUuyRueujxXnjbPHg/rk9N7ja12aPFcKE/M5w3SB5wzzBZ6X6l+6WOAjmRt/akrvWz5Nqkkv8CM5mtBjDU5Q9qji84WPRPmxOZA2BAdHii4+zJMFPswnC+tL4YFMsoe7z7KHKEKi+IPjOMYpXmjmOgd2x7zqqLeXT5DgtAA+Y0Gd4MuUl35UsPW1RXoJaSjjpL1X7nmCoL3TDnqCgN1LFz1BwO6Mkx4rUscXZJGOzbtyHnuteARflF6T6wkoOVLrg4CwkKJZe08qO55KOevh9yAs3wUBF4lqOJba6wsR9AytwQ+zzaoL+WxpxE9rAEYA9fUIPkJZwotBf/ukw1k0C1vC4wMuScjjY8TlZXA7RWnYiqSDTcIl4YKRM7LU3yXwU97HbwyKaOPjuFX31z9JnLoOkL78Q/0MrJkkRiEVcxmwcEvb5Sa8/X/D/5FHKPwfsfgz2x3++63ZKT6TrxohF4dNOxvyXBA4NkuNjE0nw2S8aduaP+5bFo7kWRwhMGbys2AtR0gaVMhb6O8N0ar16bM6xhTLl1Udsc5EkeMmpIO26dMP9bKCNjM7n7EpSswvcSUU1fL9CCtH4fXpNAIfV/rVSSyYGaShlypQ6JDajBU4BVP1qapPEV+FI/muCCPWAYuc+EbyGnO8ZNNmx5btT7Tl1ek0RdYzTyOHoZexOw7VYmMsSXx/Dphp7RQFcO1xx9IWw2KnDJe05U8spacTWEMdrPZ3OSulhdTRpvEZhzTPw4IDavu5fEldnE7yB6YS/H5iBXTkalRaOAtLzDCND5jGR9wiz0haY5G8WoLGd7ysGW2ZIobCEV/VdMtGpjEWLwvaND/QLSMjBVLjDc9zVo5VWczUtiyb5h07tGSkAQrgIRN8+6qoPqSyWiavyzTJpOfsNMkgFFsmHWhnOiJbpgK1ZQoYJ0nyp0wCIvw0pmX0samKfcsyYMNYPYaH3CYtq5Jlv42lu4vxJBMDbekj2NGQ8QQXMc1zeHYOGR4VFc1H2EExbna8rMqWle1feZlXH04nhkc1a/hvbIRbhNsQnRFmHftXJ4idcxhbvnwvOObryRlXxOlOut7l5fp0Cs1vcp2ApOq/9qxp35R8+1asuNTP/QBjcUfMVyGN5bhqZJwkmaYmmIU8NAMvETojdM5MFVKHlWCheNmwWill2wZB8l437dcbXuTIM7aUMdGDwM7TcXuiUnQz4IiexTtas7L9ocpZXDNBt0CzIRP9d6sQcUQQzlnBWnZl83v2inhPnttqv9zAi0cqVRLy6kM5wpAs+lGp4qdKZWWuEve7EVYwBFenSlaGqypHtOc1rdJ7jav0/a7fesGoKVztW5Ne7VsnVRu6P9Plf+15zb4WMHjovqCPGuB+vYgK9nRbFYuvjt/mYYvS1lGjAys7hGHrJOMsU9WHJC85gXxBUMgB+F/haJpbdMGDgKvaIPoNS3VJIonocM0KRpuBuViQkdWVBocHUCWxKbG61rK5xuX6bllcjOPLSTlvFwrlE0K4RNPi10wDor1PW5SyuHFvWEFGWl3HUqI9iAfpv0crhDgvF6QVmEHdblqVSUEycyAZa1zzHW9aVvr6kJ4ts10dfZIJIQy5Uogq5M4BwyVutTuq0nQpYzYbtHRGEO9pV1cHzkSm+Q15e9LM25jn0ehhFLGFp45bhjRkuBWoxEGZHDO8R2K/xaL+YVNrOlNDSjPHndjzWYbL1nN5Fpi2dsefVYK/cYYGw62ss8HPPhbfXQ05fPUlukA8tvGuoO2qqrfk4gFGz5cPhMi7DBSy5gtLi57B4FLLRJWQww4K87AFhbDnV1+mr74Uc/6joj9rx4na8YPZEcFcZsZlvaAMwTXA9XXPDFVQt4RcT8z5rD3NLshMVNKoqvmal+BRjZMdrRv2tqhoG9Z4kiBsos9wpET70sUZR+LY6zbGo2tC6nmyCIJRpH+fTiEnLOIIX4dcgOLpxO+S04nfkxIFAc+aD1wQ/TV6XtKGaU4+VW3CLTTKIAt8K6h0VuYq9TdWVyZZTwNy1EcKH7Ih+VtUTjVnmin5V2o0QYaei+C1mhXubnCV0kg3ZALUiHxZx/K6hcXUK0d1dPgrnaWGJIjzWU3Mfiq3odI4Yca1D1wvOYUpXKj5rtrNOERLsAkOCS3TIHRJ7X6lXMdI0QTxrCa9tBA55fiBFQB9flKIkJXM1ybSQe2HWqjjgxMQoY6PyOyIWBwVA8ncyHUQmLWszVoywrth854PKZvVmk1gM/EjNYp2sPo9bsQ5b3O2MOcMV0Tp+F2XdnRSFUlFqK+MYKlGIFTKpJLhwFhrebjaeb3oalny+Ik3/LFgtmwl395r+RIsRmNVlVxGx175MOKSjJSpuzGjZ4AF5DpYeGRI3F5k9CjwBa2PI4TzebkAhObzOpqHC4LrFh6BejS6Y2oAswkCKhXHWi1OY/NkER8w/DmKKU2y+o5nUVSjfefhucVsXo8nC/FHSd9anVfO6XgiGlI/oCk6nmT1fZKNx0ONlWK5S9nidYL6Hk5A9qxoI+PoBq/wGmzVlPuoLeFWteKJzEHquMCPJMEHpSzqawIVYKK6i66vm6wA+9QC5aT40w4vCZvnCyXO3pAyXOIcc4RXpJaGvHW4QXgVBOtZ+EielNEowgdylL83CKWPQXAIgnA7C1dBYMusg8ApFIr5P+EjfsQHhB/JgSR27AhlXnZX68jKbqnRFzr31XN2xR4CN4kCrCbPu7ra0TVtWXqdnDPNW+haYMegGY2cPCtQHaRgFTgr50bgsqHj1QGeVngQlDFvVPov8hiF8KBDBR2turC6MKeTtc3WauezQdPees7UPskr9KzIb31kBoetM3FJ2IzFB4mIOHwc4cNYrPYMkZ9laYHKIRZbiY+poa3b+JhyQVxpjobn6Ugu+8jGXW5Y23Qjdde4Us9i+v2OhDUsjHHGIRYFaWDfkCo2+4hX2uq6IElW3C0BlAWd29mNAihc3SLO4QrEoEAsL80g2LtR6jq6UEEQ5uRZocG07m9pgaQeFQv3uMBLpMRFNWZFuhekd/zfFRDmeKVd3vYGnpPVvFjgXHQHIEZ4uMIF3iCcm72TAaryeEt3O1YTGubIC6LdsPYb76HL8z2yZS3VY8msnjMH1SvraERq+LhQCUaFsk+8J7T/4F3oU+epklQQS9VadjUqrGeNK0zxHnOp2IWs4vRn0z9ElGvkz3tBH2jtghmTkuif//Ond9NUHAgZcdOlqhyOpWQfrtr4OzDuC5+X7SFlMHolg0xLLRY8owzC6q/4el+zkGGOS4haLz2dM4FDmbISJHxQK8dzkQ7rX1bVzsrRu8hNNWZku9eJ44sJnpiM43aRV7MnVjcsvZ7gD0rcxl7hqvy6uBT0kHl+afRrgnbvxrtHrBRLIAWARMt31ffsmvsnel4uVJbCQNraAp1xVf579cQUjST9CaXPep/SzxKjkjJJsHLxzrrxxh2UJ7o0sgvr8dcMBc3sbwg8bjlfXFohsODclHsjbG6f1G2wy+DMeknzZDHgaFOtw3Xbxyolwkqb0frLNLqLSgHR5EBMKl8t0cvTKohKAdHkOdqG+l3T98GJnbfQjt9ORxuMuTo8nuPhUvCpG96gdL44A88qD9Qn+ghq0TM33O2GNyCPFZAoD8C/8/ar6sAaQTpIs6hqv96U+/b7KmfkeqJRo7p36p5bE19EseGNhRa3prRWVAF6SSs//l0FPIAvCBJJSqzr/czab/hWqgQ3UL3ppcDV2C+ozNX2vMglaMvUzrcN+O8W0mHDlUVfa4u+dT/cWcnA4coxlFmogVmkNfbmcDFWcCd2q3n+cWIct/AoShLcyidR/cYDEZcHonO3gmuE4pLpJLqUE+r3O92iE2g46cYQTnqhgUXK5ejpal26C9HbI5HmfF12/KNfsJSzNOkSzEVkUvwvpiydrWTMOospSdlzFlM6rvFVXqkrA3IDvy+2Y3UXCOJU/ZTq9nCgvm3ZtiHavVpnfiaAfT0UjL7U/qTMKx1wq2qeyu4Gnh8bUirdv7466n5INQ/nZB/W0kib/8Zw0XewvLQllPJ033PzxpRRjqGLIcfQK8J9l7NLQYKtSTmAc3ak1LCDt6QbIFiQWNtZuFPgX1rw32kYr2aTJE1QagrJBFugtIcA4QrxVdhIm/UV3sp1fyKlVUppyDwRXFTp7qdmJfKo1rCfJlnjRoSSyinNsBm9Eoc7LeIh1QsW1jhHYqej/HYaNb6HX2haufnNnuZPNt57VNlx3etoykEQHiPijBjbOgsV3Bjh9ZwviFRXS0BdLVFv2ZU+wfkZ+50Rp7czMgsdkaPVqHskttsDGLEX+22pF3iBH5z89yTBH0iCvyd59Jh9bLHMXaMWixL+0cX6EH1/v7NOcB8i8t5ZFuW76T3SIzEhZLf0EL7HFOEPEfker+fl8FJRu1RnhEXrTpsKLCPycFYbQ1QS1qGhyc7YbJuw6fW/Ogb6kDHKxxBQH9k0pOrYnBQa/kAT2R6rDB5fvZhyOegmCzw2qE6MV2SjsI3yqT4QEnhtykjXLX28trMlAK9VA3hta8oovFYN4bUnH6+t8Q5vUbbsY4HlEBZwgrKR+DVeejpyK7x0pDLwBaEEQcpyBFhfI/zYQ6H4QFyCW6ASvgqvQ978QH8Ij+h0Ot6RBKHnpbbwWXoqw47nCztntbRLYwskyqnfuGsXpMs51jy6uEM29w18dD2w5tE1xAfumfYgb+10WUVc+1rly44Stee2oztD9eaXEPJioxmE4fNMnbzP0J2vN1OEtENGiM0sf/kctZJNkrXDWN9O8Z4UtzYB54RFe7wiZbTPLqtwLzF3uYgC53ilnKrVp9PSdYEjYOUoQGppndnotGzpmv7gB+LT+gLGltYnSwmwjo/R+nYawVN2qXjTIAiXnbifdheneKnlx6aq+KtL2fSI2xytcYnO+H2fWMjJ+9nzIVVx+8NivJ8nC3Q7xcdUhtW3OB8iqybn1Ba3eRdLA7x8IGub89HbyuhzLi9fT5gSOVWOK5LHB9yQPD5m72dVRO/BDTUkROQDzmFtokgWJO5MZdYClBCb6MN9qeh8cPouCleRfw3rGs48oR/Snb3uFOFDWGFgIB/nbCH5j0r+FrxFgx9kPuYIv5/l8SEi1CGY5BzOv8PJhvWxYTRErPOM0bLgy/ej1Ch+yecWR8ME3i8BH5axEouoNxhJqIBrOmjk2r50Zro8SHZ0eeVzGdwWtPER3litYmptFFODoDLqppVVNzWBWxvCuhhcSjAbazxYaEcNzbxYqJ72tif5U2umQ4d70yH8MhrwyJmiXAwzMckjMdzGUiPPiNcAhOfFQlBW10n2WDP6/jy0rmZNf19T5rGOCmIJBN2y9EixR9+WfPjRwPB9UDwT/BecsI6IomtMVTsBFWVVcKKhWqln8MQpZY+9WJSerBDXCFez0BdUVpCqOyA1SsVFXCOUVkEAymFiyaQQs0JWs0U2iRTF1z0JbnAINVDQoXXOTcgcbYxX/6zM11HGk0Jb6UjnIzJb7i0FBWc0VmZLEVaehL4qquV7Qs96Slpuy3tyW0F6viy3lT5Trdh28qLYVslqp+wVXnWd0TuCUJBPjsAUBib+6bI1i7GyUpdlAkWyPrPbAVT6v2VpnyZLo4OyNPq/ZWl6IfqyNOrJ0mhfGEUdYRQdEkapa1g9zXY4M+pczvUAW+jLqnhILYdW9zm0gjA954z19qswyoFmvwor/Gmi6Q21MhmUmvK9PFuNuSIhrXyo6mGtPU+KPldOL3Lljr1Fl9fOOhy7z50Pc+Jm9SXypYO74NxsQ8y5dBbrcdzqkZjQj/DMS1viIs+8MWVe4plXpByUBSaggdFWO/AVDAG9nwy5hI+anvI8pdKPSgO4luz1wagh2yg8jreCHyjILgqfxhAXfk9EYho2gzHHt1F+O02P47xX62m806a8//HtTThUeRy/TuPXCNCtZL65E2C+wYVIUAHm18jzzmmiAfELAeZdJ7mSA0twIp0SOsybprDg0nyRwCodAku6Gi6DAPzMXaSvjAdS6teE02/vfUlilR8hsWRNSWG5ZAUdoLBEqqawurGxLb1FHXrLHY9DNn32B/m6ebYkiDGqFbQNBDE1FISON3rhqnLeGxTGkOZ/HUBuVZyXJtUhUxp0OrVKuHDGOWtZvQVpREu/41veXnpLKaV9lL6wZYhsafwBtxA9yI/SyH6lVm/mWHLbjV++b2SI7VAgLa04V+KBMgg7XfFrcFrCU5vmaBj2atPDx3ugoPJkxu/2oNLUCEg5t70uZB2ZpsoJkk1cmhB9/IUnKX8ZYRggVrKtq9DjckTEKJ+NJzOWMuXCx5Z2hh9NpC93L1TtRWcdcF9bQIKLoyMlMSSlgp4guKYyGi7QJNIbILe6BmyhwuksUFrKuc1bGa1dr9GataAc+raqoXralxgBbyFoBTHIyorXa2ei49o0Og5rs6vrmuffQUzJCiSH/6a/Z0k6QXiCMlf1tDU6qS3Ena4HDlAKLoRNFXo6udWl7JQZdwO1v72ieKu03xoDhi3KGCkkpBUpO8Pd6/es2fpaGeNVOCf7m5A509a78ymzDwJ+OtUuUNUOtHEUBGEekf3tFOFaigwcvxO5dG2zJLU2lq/whiyHR3P1aaMJgnATkaXssK12bn8b5EHJu77SjGb4VDwED54kIlSgt8BtBN/msICqC5M9QPm3VQ31+5waNsfEQKCCaX0gHRPJjwLewPHCNQnFcZKku37hQbfccLRjIvKlgwgggMpPWd2wHZNaLC0j7R1JZonrQbS9rVWkJK2mPeDwBtZMWT+fBZoC7XB7b655I1gLUCo/7lg4WtKWrav6OAJtLH1nvv7j70z5aAK7kD7rACqpYMXFvtig9rEMUn+Wl+t38AHjFdO2bipevgAHvVY0Aiu8OfDm229A157nAkV4CecOktWEN9UIV/qBEki3IrVBn/BU3wERqT88yUp5BwnoLeXVsy8K5dMf3Gou37PcswfJg4ApP/LYV7Tiq/A616IDOqhcNqAfFQQtmH1Yg1vZLShsgka19HwOIzmdjCOL53PWH4dVRe0PoBKznjdSVGvNy3TTegSEkEamzap0NMLqYxH/veJlOIqNOTIhZDkvFkEQij8amJ4YHH+IoVWyNfVSzir4BFSM/Qo4l6l+paE1q2DNGmQ2Qm71gMyfkqh7q7Yok5cLRaeTVKcV16t8Ljmdwv2cLYj453RKcC4+cvUhqHpt0czLtplB0UmSpPQumYlSEaHpXv5FZzC3leNb4r6RX9uZvo7G23YWQEbL2vIy5DI0lsCXEJdGAy4cji0vZzQ1zk0gAVPkALUsRg+zOnXw7pYecC0GC09UfYDi2FjG9wCqHoToGnansrvDL+0Of2F3ODqdqv7uePOVS8BTflcqslelIOxNWK4BT/l9qYhXlYJgl9RSGssN+ESy9VSjBTeXHpBsM91LfT0pEhaoEvxh/CjRUqij/y3fAwrsvfRjJ3idRz5Lk5UO5eL4RKKic8DM0PCs851OJtKfzZLxIlQOEW5fJ8jxMFBLd8Md52GOjKjPHnYlFNk/PiJF74TTmxohNx6mXMlveM1gpWjx9UasaNOLt+gvzulkqROrD/ZRml32G2l6x4VDnxkcpMRfpLl7tE4pXdziQZSEBXPOynzMbdzwrvCE6adGRdjc1jchHXPlukgSIQyBFyX97jjWtM+Fsp9CrdmXwM4Nykk500GEtbwOUxKWs3YspUnGI9vYJb6Ur98oBC+/Y/WJbuhL1Okn06ZvGum/pIEd+iQaS1I0PoX1R3ngZfIlpUcp9QQTwyjkBamBfZctXaQBb/1v2v+H1ZXRaIsbvi4lZyuvEP1NDyijd0kQ1HfJTHLeiYpdK/6RVUiCzg5tIhCvTIffqUu27Ndr1rQs/14iY4mDW6+KW0aKGywSgU/sl3FNHeGNRY0Tfg93LjG86dyp4paRkgjLgsAn9ssgLaNQDckloYcowt5Kn05QbDzuYvzyMkIrX5BqDJK4XG4zoAq3E3Dmb6YxxUqnpiLPGgWnNRY7RWF5xbIBdsZNy3YQlvnCTcAPLP9ZlcE01sXRWcUrW75viGYQlPJvZRiEsMKluFkvzT/UpIkkaBr45KUidQTVo/H4LHRQOlZoVN7sECUjl9QASkMnj5c2jx7QGS+r8onVsGrNu2pYBqjeEFkHkxCVYJw8s/g3VlfiREuuX+drOQT4GJfHe1dXbSUI7nhoAOrV3nH2+Nmf//9k7ao1rXm72fLleUh4+o9wdft/hqtzQV7yd5qtA6VHw98VQ8QM6D525G6D3J6EvB8qOMq/h/9rXuL/6gH+r/7X8H/OOKjl/+p/Hf9Xv8SThYPc4XxhuCF6mVsTRXH9AltQDzNt4ovQS3waNXwa8GY1uBR6kTmTWKhVvJe4HQYZLt5luPggw0W7DBftMlyNy3BRzXD1dpAOLj39X4jhwgDg9n52j97ppNpV30i3r4+mz68VIVV4Huga2UMhORH5pe8UNawgCKWDBhi6ElDLial5GD/UkwQ7XjiYwIyTRDGGaDzRvX28vKAhogmCy0n0MFE1JwlYvP6jFMDzwH1+xtWL17JF7mEt7+YuD1V596y9nCv/cq68y7n6n3A5G/JeikSIw+zpO/n3XLhgyfc/kzn8B5kZOdtPZDV1gCr1uFMrdrMhUd1nNwtinhPwnhSKgTAvDV3WU2BBCdK12Dc01p8VgugJzYwT+b6RhqV+W8E6LSpv6Y05FI1bG8HJMA8ggB2q02lvwAsc8Ig+g8AmeuOR3OPYJjgIBHPS6AZm8B6SNvqNRpWRyVGcTG/K1PkdxV9+cXNp4F4nCKV6DewQZWe1di7z4go68xdLpln3FxbthfaG5i8mqQYyMH3Vn14B93P8OxYBYf4J4gSAUgmjtZkC9adA1Z5mxjtgFx65idNZElX6xsXFYTuWbnnRTX3LEUSwNvE8fTQfUiujgMK3untcfqL4wCJWX4bwxR8Xp0MfVitDAQfh8p3KuDZV9ssK1ankmWtEqc0f5DtbmgwH7nC0p0xzuCSrATaRWYHhuq/CxF+qA0pN6wGlJvpCLaXmtB5Sc6rJyouAC15ztDOPRjC6pXImy/EK6qQUNFLT+jzgscjEvdf28SWaPX9IV3FRCQa2lUYEuIU+cYnwJtVaGjcsmsSvb0KrtYFu2Dl9/pC2nglCqa0PNinrulf1XbhetdITYAs+qZ6l70g2Lm+nmJV5yqLydnpO2ztR5N4tMH4tC5xTnaYrRK/PA05XtZN4oH73rmzGOHTXjmynCOfkuU7VNy7SBLepLogf0+SMgU0BdxlujGLcxg8WtgSsNGS+UK7WgSZdVXVYkySr7zZZHUXouZEaHkDRG19ZNd4jXBGuHHIUcSMl1w7czuvF6TQaoYEu5/VChYtfy8aBCoCwY2GN8I6s4rb6hq1rxppwjf706vMEbwkNd7iB4AMfcIInXyQIP6nEI67iDf4ywdM/JyjbSjxyl8cFOEMpiErBy7gga4S3Au3d53EN2TWBb7yMa5H5ZGq3kN2SJ1O7lQVk7UfIfiRPqvYjWaNzGzes/Ynl+6V8iZBBJTy/Uvq0v7zDWQu2Tbxcv6kZJZ74GEMvbpTKBBTgkq7jVQXCiVS8nHwhAy5p9bq0vZt8kcykmCJVPuVtC4XVX+Gr0J5GhpANnVjGR1wTceY4rkiSVXfM2nJUqHXCV8+rBS5jwYNhGpEaXkSu3AKQW8ZHZxZ7jRO+VHOY/lnOoYyPY8Lize00Ddv76Z+T06m9+zKRnIvMcprJ3dc/qVK6GlQpbR2V0tpB9RCp3wHuzA14XTnGc36k7EpGm8mk3ABA/RsVPPJtXW3lDhryszZEDAg1pZwUgSctwSwORdnXG7GDwzueZLt7kmS78Rj2rPL1X7dDJ3mHl+hSYO02Psgh4jY+yl9OlOhtfMDb+Gijg4edoNBnvgpzfwhPF4YQvUb4SGiYOyqu676Ka6ZUXDcSmbnqscdMWl538ckO4YOHTx71Ukpd0yY8ILwPD0NoarfATwgXIe9gth1gNvyEN4D30NnB58tuhHoAOKmN7Nm8Gvh7I4frBhKSMYqo4HdrB9BerGNhEOq1A3o1S14v9wWtUe3tdw2hGwc2G3OcYBtRE/+32nOlVpt9t6+blbxF+CoU57TSZmVeh5kUkw1AQoI5ymoNfgLZN/HRxqkoyCQr7qqsiCI0WL/AXK+YW//SsJ1t2zjoUqA65Yu/RbM2TUBOunKkvOsBrf+d502JlnwrfcU5FjpADgp0PsIWtaRutaUMtFuvH2mY4Cv1XzxBI8fBzuSMzZ6mz3pTwWe4kjU3m+oDwOpXdPk+r6udaPtR/f7a6WP6+jXW/yfxn1+jkSmmoov/mk67SX9Lp/gTFJWwc2S8SWrCNZ0ktp0hJv18PuPtC8pOn2AC4/pMgVdByXAPmsEM2rqoQ+FewA4ZZo6Kn6/vc+0iewX3/NxQaKqFxSWSu3yJuO9QBszwG/x2Og7p7TSSEcXcTUQpv53+HvVoZtwTlhejbWOus374y7+96QTilgJR5stpXHG5dJrKhtRYTESIAb2X1bAom1mEG7UdsQtzZdhK0kpdSaslxWoZUt4q6GIp+jwbnWlCLq7HLElLpUbNbbHe2sySVFwo/5jqSsfKRool2SAQtS8CkRsIUD0Be3ok7Ut6JNJXlgXC21BQgAyh3/fe1z/Zn/Zqhz1+m7ABPntLdyEbYqdjjXG0zuy/UhLZsSzrGEj1JQa1lJCmlZaUeszEC6aS8FyinCY08ChSeIHfWFyPlToSTpBbrhZ83JjF7a2JMV3GLcINGTu1H8cm2IuuLks+ooySTUhFb+DTtyKbsBLVN2Ej6MEeE2MigClkOQ5pVCOBTP3UKmpEqjLXdJkdFVBNLs9gGCNf8V5LvZToaszG1B0Wrkgb+SkNKTsphRFjjblfPaNDd0RYwaQiJQ3DdOiiCJuokIXaaieh0VKuA0ZKhhS7VZGYPL+KGrKCoJ/mRPmevZibJpgSrvu5md6++jwxyts3ZSR1hS4zMZfMg23cnlZ7gk4y4yrSxSTw8jGGBw8nzEzH+9hMFWvRTZlCRFtR/KaU4nqXJnzZWMTnFVoVGP4/vnUj2TsbZgCfoxuGotKQzMdeqQb0RmUptfXn/ugGbUb6rxQekdtinf4CL6l1zFwP5y9c9fAyJCkfa3x+abAh2P5aJZxZkrK7JAjKu2RWpuxe/LxPZixN0EtR6XukmSFqwfJTXm2D9JEM/uExlorPwhWhIX9RNNrYEhcFoYUp85LYc++LPUF8ZwgUZQhlKRSRKSie4j6BKK4SkJUvqI/KBtqukl2xgLDVGrbGoCpR6kUJguSakCIIJDuaS2tLb8VWZo4vsNsrZXRK9gINd7kK2dCO1J5stdGOx2qHPeexx37g2nreMTT2eHc7HfMu7fo3vBlXQxm/4l00vRkoXw0l/4rO9ZCJaX3BxLT2PFLVVkzVWKYAb6SVafg7XgVQEORhC/U+4YmjpjmnxXdKT3KLd+aN48tPDEHyOco4GemiNuoAn/FUxoiKt9WWlS3+PVHuXKvDLVMxH/xAQ2UMwTZMqCkOAj6VBjX6g+rW0d9Awg/WABbh+6psN6dTL7ICc/pGmeI2fqEFB00i9TO0IRdMtBjJzGYmUgfM7Fo3S4OAAhk5C5dV2VQFiz/QugxH7pqoNq54c5WzXc2WtGX5FS3zq5rtCrpk+dXj8cqrIecajxCmoE/MgavxQwAqusYIYHFNfnz8O1u28Xt2bATJVRHtMBsemx0TPS06eaaknheLTPvZoQsIPtu0bNcEgfoxV3+t68PTaS/uh59btvPVsk4nyxmE5Zih2zC/2UvRGLojHEnfN8bzTf+5RRNqzbyUMSrgOUEZSsp22bhFt7VAzRO8J2zcSpd6MEYjj84J9UaNlyTJlnd5EFT3PFtGESp0ifly4XWwvw3rm0Ip6l9BJIzqngcBv08yFEXFcGE1p8J7z3GDu80XMt6iZqbsbaW1OjMqvSU6Vr9GbTeVOhxGAFYRrnRhsiqmeR7WuI33JW9R/AQ35CpEd6DbkSHVbGVzrOBtSw+nkyxH59Ru8TUh4KsNatogh9J3TeUIwBryvOVFwRu2rMo8lY98E1BsbdL5BE/xazxJ8DTBrxM8SRI8fS1+JgtBs7tV2KtepVei1JaX+5apUp+zz4ZLbap9rcq8+py9dgu9wp/jyXRxxjk9qiJffP6ZX+b14ow/MPZed5N89gV7jRWMp5+d8VYgFpU7/Xz6Bfvc5L464//a07plegB//uKLz5z8z874yKgZ3eT1Z+zPJvN6cj7jYsByHgtEkD5LTJBeT7DEIuKX2GbxF2hM8UNdKG9NCd5Uf2XsfS4dA215+X+LKiNno0Z+pSb1dnG0SbfbtGnin3/++YqOcCdZJKltcZNgD0bff//91Tf4avNmBAs+KoqRXFr4JdcRCv3666+/jszSjeb/ufjPq7FKhQUbwe+zkWXSfVv9/J7LRcsG9KvEkpEBKh8DZxfbVdH4XIxLulITaxoEFUS8mYUl4aFkJCRD9OMqHKnaI+S0E1bOcRPskmRGPl4LS6YF9PzBvX84wXI0ohnqnNR0aDC9oz7Utyo01JXO8rvCEmOBYptSajujs7ocuorIQ36gVFgg1G7q6sNVyT5c/aWuqzocfQ085t+bq/HV90BiiN/Lal/kV2XVXj0KNLsv8+urX6v91XbftFe8XBb7nF1xkbuqanZl2mirK0HetBt2JTb8Ciin+Oqb6kNZVDS/ou3Vpm13TXp7K+mZvzfxstqOBJXVVVCzs/DU0j5FOor7+oEAgJhqed/3b/728PObt395+PaHd3/5t7/8hGuT8+0Pfk7juysoyLMKWTBfaC/4YLuZVUpZVTujNaOqdHyfPWFhiSuU7S1dEwQhlyypvNUlgKgkhFuy90DJigIx9YRHLa4hILh8/GoWpEVnhM2Y+sLdBu81XzNfZKMKCBRLlik14mRhdEHdFLUiPbHwHs28LgcWoYBF6CraVghlxfCqFEOrUvyOVcn1cqQ50StkNCMLKxXcL0h+RriElO95Saj+TQ+kxmX8ACg/F3MmxUv6qRzXWOph4oI0PgzqKDXf8xLn+jeFx8cCUIlSYAf3O5KMKPRSLJXwotALwcnSLsS5UHq8ta4scE6HstiQxjiL+Jru6JK3x5CfTnuEV6QA5HM6lXIo4mrCIg/Xp1OONygzHJK8m0jhfzfz1UKpjBSGdjqdaGgbWclmLiriijUKrW3OBmxzOKC82trkrOVNu3Kv08LB42eJKvdQL4fwPFt6IJW0ppG9QyIvRSIvTeLL4uauPJf3pdpB0N7xi0pls4GsebtIRyMICDMkrC6NsDpzgsjJ2Ha9Y1uDRxHeOV01SGg9PkYFZpf7BpUYKNcrlih8qThCmMqrX36/VQt0MSqoalO+SLgAg5S6Y+etpCbVoNGVeRag8b5h9dfq03oBmdUQBrREKf+0Rw7jg09Jbt7xLWtaut0J4JSDaQ2wauc9u3DgIZSHAsVArizprw1ufTVlFb/lBYNSI2EFW1IjP70t00SzyX3fLVRHqL1xLEq7rlUoOmsjZykudwt33KLUL2tXD0jwdYBJG/PRtd4B9ERdVGohnS/m5cIYSwhQhkDF6lY4ncKWsJBi2tPYRioAshEctN5VIjg5iwZlB9c2IOkVjXsb0533p+qoq9quxa0FKaOr/g8aFQ8aFLfjcKCcMjBuqx1Ct6UNKw8wFIHKspXgO0hPKi90HdQO0lNS8gve8T3RItNqm+oeXlZNWMVt9RPNOS2bEGxTf6paKhpCCDfEyOJfKlYM4gT+u0zy9X7f1FFx09hZ64vw0sMI61x7zEeO/h0YO+yaeT3pI4RQIAyc4PlC2lF5OxAqU+SP7X9F6lsT/Kr6NHGlGPII73EBYsrP08/PizN+PuP5nxco/DM6o+z/CwAA///q858kZBMDAA==",
+		Contents: "H4sIAAAAAAAC/+z9e3fbtvIwCv//+xQ2n1YlLYgW5UsSyohWmjSXveMkjdNmp4p+XrAES2go0iWh2I6l89nfNYMLQYpynOzu9zxrnb3aWMRtMBjcBoOZwe7O9v9s7Ww9nrFchn8W8D2T8iLe3R1D1J9FmOXTXYj+neeFyNJ4qxceht3/2drBctnFdS6mM7nV60b3tl6J8aetJ9llKjikvuUJZwWfbC3SCc+35IxvHb94t5WIMU8Lbuoq4t3dqZCzxVk4zuam3l2D0u5Zkp3tzlkheb778sXjX16d/BLOJ/+ztbP7P9vni3QsRZb6MrgR576Xnf3Jx9KjVF5f8Ox8i19dZLksWi0PUDgXKZ942yZxnk0WCQ/UT6izUukHfZ4UfAvgGfglRAWl1VK/IZtPAvXpD0dEqqI3n1m+xfucNtV6KdJJdjlQP3FTjmmSnbFkoH4acxQ8OR/An1jOREF4iMQC1Fcr39IkQDxkP+dykadbJn5L+pykRAQ3Nob5GSmQgtvpMBupL45fACKhDYTI+V8LkfNWS3/0oUzRaiWBri/xM7LdDSA+N3G5jgOoC5ryy61f8jzLfe8xS9NMbp2LdKL7Zesnr521vZ+8oC9neXa5tQjH2YRT7/j1k99e/nL66vW706evf3v1xCOLFcCbUMCd3uiOjG9Wqz60YdgdhWOWJP7E9DFxhw0UTSlmjEZDOTLkYn46SGMZrMiElCUlUbRb6VxQpUlcnWe5D+Dyu9CLZLTbz45EmPB0Kmf9rN0OmC+A6BaFlX8TxcMSW6jc6Tahh71uBae7//t//CHrnD/qPO12Hoxu9lbBD7skXYs/xHhBd/83n56xwUf/Y7HjD9ud0eDjpB18LHbIVyP8QVzGDT9OPoYjjA8GHwE2a4Btc/1YAXiX6K/UltNd/+NlO9glGR12SZd0R6SgEUmoDOdMjmc+x3GYBDcJTYbRqG+6akG7/cVRZjph0W4H2XAxohcsL/iLVPrJcDFqwx8SHQYrszCUgNPgO2CGBayBfm9nQXo7i3Yv2ABcBMHNt2DajkZBv1BRT5OMQeT+qAkyuwPkYyZnYZ4t0onfCw8Odipgsa47VpYHanWWOUuLC5bzFJZooJleF1Sfke4IOimjV0NIG5HtTKevvobqtQ8/pEt6Bwd2+hS0WC67lBaDa78gXRIFcUSy4d6IFiRbrZzVb20azYpkbVr4g3jCp8GgcZT+uDH2tqELc7M6QlOFg3DJmgJZCaPXvu3rFGhHumTvsBuQ3KSY7D1Mi7rdgGT1tL0yraDXvihesVe+CAZRLJBEmnhDRnKSkWK0cgiVNxDq8uy/ZKqRKSsJBCu0GY681eJ64nfJXlDmL8r87C75kw3wB3y4N4p9BSWwobwMfc7EZKtbQloAJFXc+z9e+8yXw+4owN9I//ZGTs0T2IJMiS1+FC2Xcrg3arXg71E0GGN67OXTM99rA7C2R7bgK7JfvVHbC7wS5rgCU2FIKeWtls+pCm5TChUM4E8cBQTgs1srgC9erWem6hHn/hrehjs5xyx9xRE4qx/Us9s7ONjB8SCqSZGTxKpJvTJJd5KmTNr2fgQUhf5lbe9HF1eFyM1/GBG2ARP49bki0XIZBVU6Tr8yBi70GJgVSUMX/Vj2UaXFF98xCmCR/koVDeNg/j01XZ59pSKv7duyvNWK4GeAeXnseTUSfi6n3dbpUDqTfFRmujbsns6IHToXqa8+2JXPiQxI6kzPs3JhkKHMTmQu0qkfHQahzH67uOD5Y1Zwv1ww9DZ61Bt4XUCUIx99RaV/EPTLE9HNlMu30zMWCzLl8nmRsJgRFRdnOiou8OPyLM7h41FyMWNxQmb8SmERL0g+PdPfE/hmOjAmFzwf81Tq8MyETYZzMisS/T2Fb5NwQWaXBuScfOLXl1k+iT+vcAKf0puV5cg+bYl06yo4HV4NP41G9NOK3BzEB6sR6a1z12pfkf4+zCLpR7Bx1I6ZckukhWTpGPl6s3pIPAH5cCKrppsMcNyBxbgPWcLPLBETuh0RE1rwgt7k07PYcLCzInG+P5ffl2We8fz6U2zZJ8KQ7pEiAe97BVKnPITIAWwPoe5QXwaED7D+gsvfEQUfVgYPZrDJCR2OW0g946xIqhkvYQAGrdZaxkuEGMRr53OJs06Scu6E+XLphvhkI4JOtqRSKAGJRMqLYiPKTubPlaLYDw3FPteLXVaKXc6E5M01XrrIwkITjlstN3TN0uWyXgz6FWm26ufhRZ7JDChGb0TxO4yb2Dnkm+FnB9UKptemHAWXJxdszDUZWT5dzHkqi2CF4+2rpZCC1VKf71Lqc63U5R0wRNq5pXC0f7WYop1bLp+ePcpzdn0L2Ra8CPPpGVLhLnlnRYJtv1vez9jitbwon6FOVrM0w/4hQ5zNAxnOLkE0lo6Z9Ic6dhTEGK9ochckIB9Sgt0dDQkkWa8aifRNYGZF0gQGP2J3da1vy3LgtkHl9+uTBaM92A0hBfqbT5qgmnKPZyxNeaKnQJeAkGeac57evVCEhc6SBb97mR6WmW0o0mr58ke6d9glksqj7mDvsNuWsW5TBRhOQYV1weQiZwDqTmhgSYW6XSPvXrBXq/LzHYt+NnXaVfJuBS8dOrPxp28rqJDFcXN3NFUhWJHvVEYtNKon5mzKU8m+oZxq2jVPkuzyG4r1Sop8Q6k9NfYsO7a+VrDQpvq1RVGtoLeUtKn1kmRt9ipY7HZg7BugVbnHJoCVHHeCWTKcjXQqkgZYsyLZBIvdDox9C7TL2/rBplZhXTa30nDMTZB02l3789VifsbzWzcDKG43hGF3dHQUHS7hIHV0dH8Jp6gVSRZzkWaFkJVtxTDxa9AIp8MRSWm3nx5JIxJM223Lww9TPPf2+TAdUXFEw+7eg979gdiNeuGDXowHqYvs0vdFO+weHAS7EfyQXrhv5PthL+od7nA4+IX3ooPeDoeTX9i914NPwHmcpTJnhYzrdwpcoVu2yQ9QjOVG2JPYw3Tgc0Ai2PVT/I31766OX5GEf+bJpmoMGq486CG9F0YD79GjR17MH9L98AACXux5KzJh+aevdhfh1O89eLCDB9+D+/d28NwbRfs7KBfajfhev5QD9O7rXWV9QG0jYKjTD1Yk5VMmeXMXD0eE026fH+31ebsdyCEf0d7BQaeG2ZDbe5rG04HeNjVCtY3dIVs522xD4DxP4e+OJBuODxo2tOdbQXfuBlpvsvxbgEeId3QHvPn3ge/cDbza5b8G+vLsbpir45MGjbvet8G+rTcrsKc5vy7GLOF3mRbhnpoU4cEDNSnCKMJJceuoHHLCCR+ZGscJZznPv9IaXGU3gdWcL+/wHTvkswv21+LvAdt2wOaZvNOIISn1ccGUwY97h92yJ7ojmmq+No3TrwyiubiKXcmMEYZCKhFUEkbLM/QgPIg5yWlvh3UiktFUtc4POsJ8kYL6fr6TUUo70SCP/bydBbt+1M53siBoR8FujyQ06hQVkoC0tthJQQrhB+1kR6gvAnF4YNCx+hvj4Uygo9VnoHGwWO2wtsVrx486LAhWRGb/OHn9atMZDhABzjTJ0vXxSThJ8S49B8qUvUEYTfWnlYRlIAkTgQhnrHh9mb7Jswuey2s/C+D8QeH6mXB6s7LiQ3V1LgPiDZXwZgvPfSMPCc/gxt0KMIPYZlIMQSVXPM7SIkt4yNWV/yLlVxd8LPlka5wlWb6lmHVYvO0elq5WxJF/hAWc8I2YzMv5xCMeEt8jHlDb01Izb7bgHvHKs4pHPHvi8bQ4rSkT4uBpGZvOYI8tWIk+iXhG9ubBccEjnj4AeMRTLL3J7I2qTZizK9uCHnAb6p9GHM5/URf/aSyrMZdntRiFRNS1URhdrXJqZllFkGmGRGUGk5TerIig3b4oeSrRbgfpUIagmvNI+iIYUT6Uo6EYufIKrsZ0qwUj3YQCktZ6sAEXO70Jq+CSq5DqdJKpENIPbvdB3OpKUrtEr1sgNggKyq0ajxF0B2woR7S8VDMNhNWBD01oZMuVAr6y8d1gpEjXwHcCeGA1nexpMOoXQIxVA9Ac8ndHVssGwv2vwk6G6UgDheauxLnP1Ce1VwNdYq8LImIXymKgM8ZFEFSopdm0SCnmbMJh0VhBpjAjGsMgMF/uHdQisEvQBJagPJiAiKvV8tlwMqICSkxGPpS0s3+7uzZ0UMLXNHIo7C/9uvQoVdIjO/59GcS+l+LaVAqhUxiyFJc1tzYYJGr140FQ364kSUtpUxVHffKmGy4WyvE9lE0YiyEfxSmlFD4Q/9iHT5qu4yAsDps1zVotX32Ej2GVpXlAyqudfEVuojgi+/H+akT2vqZrhMsFSYmAiarvImGW6rtHmKL6rpEU1A4QvCMPSFKOHh2zoEm54SYwPjntxozSZMCpn3eyYHcR5yrYa/tZh0FERmmC1wb7bZ918mB3ERBe1nbY3eHqwp8fdSFfm2JIUL9oJ7DPpxTr6sZwJDwYLHb9pF0E8WLX73WSThEAnxZ1uzsp/hWjmqZInQiaALrx397wlHahid14sZvsRHxvN+qS/zwxEnUjrKobYntGjSoM2MRUNZGpJuaodwDxGY0Qjq2Ql+tCShisBTTquHnw3tJ+Yh6jS5EjvTP8y0ZN+hGK6iQvB19WDr7CDj5DWVYSIurkJOpkJIL+5RTCQEDkvpbLLjCtUSerRAmIKipRsM/uVAbHGrKJI4v75xB4ulBdwonza18Go5ryRUljbENaNkeUzeGUPwy7+939g0Eps+BrMouYK8EGSWm6nj9dz5/q/IKK9fzrMpFYC05wk2A03I96+zu8He4d3DvcSdthdL97sCNITrXURItMUi0vESSjYTd6sAcpUfQAUx4cQBkzApCYSFI9FkYV3ZPq3AP6kZwyNQ4ZjM+CMucMlu/SBwdhd/8eyXYpcEYF/NwP79/fIznNH4bd7v37B4dlm3MS7e4F8b3w3v17O3k7OtyNokOS0Ww9a+ZmzUzWghbrWQs3a2GychpFhztZJzokKT3odndwihNBe/Cd6WWoOivHztj64ANBgop+S22W4OTojnaBXyzUwAIqJGpgRd0ucFCgH2e1c0HUspOQYU5yko/6KU2OwoNBsuNHsEAm7aKT7BSE095O0gFmTd9C1zQf91AvT9CsHe3u7XT8RScKiID1R7TbRDyMWi3R6ZCcHu6Io2jA24c7ftrhwY6IexiTxns74qg34G0V7/d29zoi2DmMOWGg7gdo5qWWrKs349BALV2sbHletlyXhS03H+hmxH6+Q3uE7dD8iMIhsdfJYWHI2wy2DWg228UQGQriLAWuitSF00eZP6v20dxJLOqJrlZIUk+8ri0Wh12Slg0TtmFG8+c8ybLc58GPhySnvFOJIxkSUMDZMwXetwzt5LBdOWFYKAMclhDXLy6F0h69GbOCb3VjPXEFSUg26mNkZCILImxkz0RmRJBER+6VkQUROnLfRCYks5EHZUVK284S5upbuzylfq/Dgp2ccMrg7y5NobvTuNdJCacct4Nd2qt3clnnaaWPr6s99anSx7XEy0of1xKP6zP4a/OXLGjRTkynLGBi+cUuXZBkl5Z8gOr2w50sICmNOgkRFBYeTrrb1I9aHE7+gkYdAZt10RYwGWGTDG4m/JwtEhljFxzGpstpSqaUkTNa9M9yzj7ZbqeMTGlaT+jFOS10AnMT9nQCgErdhH0NqqgnHOjKIYFpEf0QFwM4Qu9M8e+Z01MnDr2Ff1yl9xMnkdUTH1e6sZb4ptKNtcR3jZyyGYy6E7OyEwtUNnOHKKdRxzm/MZiFRdAuVAc6KXmZIqopWZmCBOJImhT/ulvKiwqB3lVb8qhCoFri08okqCW+rhColvj22whUUmUv7O13D3dYO9/pROHB3r1eO9vphPsP7sMmynY64YPD+w/aUXj/3sH9nbwddvejg52MCAo8zD1VMOx199vA1NzbyYjirLp70V73/gAiD3bsvs1JtAtsTweiY75DXeaquUhaKZLuUJe/ai4iKkWEKeLw8M6ZmwckgkHQlJZimmhME5h260B4+fWjjd1wLV+VK74qK/kqRtk6B8RcDogZDug/w4Ipvip3+Co4Fhm+CnmsNb7q9wpf9bI6Wt83nz70yYMUFdLkR/T+wE8psrC7D7p7IZBEoeinMJoDjWgQ62wlxw3NDbARe1aDFxKwHDYW1nS+q4h/RA1FuO6OHT/bPeh226yjq9jFimOdWhJO5YJaBBW7uusMvAcDYXoTaFfs9rrdGkCTXHawykb2Goj74e4DSw96Jlna8zOSw1DfgyPsbm8XU968ICmeY1N7jsX44q9c+vlO3s5glxsyIoi7X39x+vet/77av6/ujp+gGWzGO70dgw2nufoeZ4UvAF8dLkQK4SEjvILKzw4q7/1XVVR+cRK/1BOfO4n/GEoH5p+VNfx5tdhvlTW8lvissobXEv+q7IC1xD+cxEk98V9O4kInOurE+fSsB8JuQdTX55ipr0vQJ4YvFGoX+GmUFBIMXV1/UZrEvYSdKT3iXjKexWOQnvdApj7DLwB6rr4uz0BRuEgU0Dl+GqCfQcSOxa7xC7C6Ul+XZ/EpfmGxT/hpil2CHB6LHeMXFDvRX5/jJ/iFxR7jpyn2BoX1WO6d+oSCL8zn5/iR/rw8i5+qT1P0tVHWwNLPbQgA/OmEPse/laHLs/iZDSE+f9kgkO8PGwKy/otcXX9B8G/xC3K8VF/jWfw7SZgi/3v8gnxf8AtSP5BkPMMSr/AL8v2MX5DvF6WO/A96w0Cmikpzw95+l/T276urD5ZK8deC40UL3Ip0SW/vgPQiSPprwUDB2V6TQMSc5SLl8TDq3VMJUW9E2JdFbgCbzGdcTDHugOC/XndEzkTxF2IAmXr3SfTgcKT1qYxW9VnC0vGMT1gyz9KJzgoYdQEm4t9VxmYq+FlkCZfxMNq7T/b3SK8HEPPsMo2H0eEB2e+R/d6InC3y5PoyywBgr0ei+/sk2jsYkTGbcKmgPjgg0cF9Eh3CzQ7Y9OZ8UTgNxehsnCUMCRXBbc8B2YPoLGeJwhQy31dR6XmSXfJcAcddZP8B6e3dU4mFSD7pxu3fV8QZ52JeZClg2CW9LkFEQB/O7QJQfCipEO09UFEmX7T3oIycZsmEpzk0WjV4n0SRTgL90WF0+IDofyaacwWni52h45qyfpqxTwIAPyDR/T0Sde+peKOMNwRUHAyzRHzmGv79A8hP9nWRLGfpVI+KaN9WnOXjmQDcD/YIDMvuvooHDVMNXecscLDEw97eHokOuiTq9XQCZ7rKaH+PRPfvk2h/TydBPypK3uuRw6jEFFMUgfbvkXsPyL1qAl9LkIv8r0UmCuyVXveQ9Lo6xY7O/fswaJH8nF9ciNT0PoyLeyq2+HRtujZ6EOn+FnPdV90Dov/pWL4Wm02mZsDtAdx9BeNc5PwsFzDJonv3yd4+2dsfkfMEhq2d9zC+YFnojsh5lvNCasLBoNl7oEosxrNCMJVbT8EpE2lxluWZHrbq34hMZ1khDXAY4Wa9gUGp64twUjmjtBfB/Dsge70R0a2GRUL9G5FydPbuk64OayXOYXRvD2cJEFPTxi07y1J+PeGXziIFTZ1lsuwLJCTMXpFOBEtxnPW6B+RBjzzoqdhpFg/vHeC4hnyfs/zauUoGiHpeYC3YCd0RSdhnnk54DiNURfcOnOizZFHMzGIAfXAAaZepGbo96McetDjh8ywdz8T5eZbqAjgzoABcsevFBkgRHUJFOt6sUPvdkiIqAdeNHtagVxiMt51iyIv1wL/IwFQd1Isiov/ZeD3h9klvDybcfpnQWMDpgPs9Ej3Y0/F2VkPKoZ7VKsVO670egSEd3TNY2TkU4Z5xqCmNSeW8jiJYJg9JdLBXTeTNiZLzxND2EHYt0rPIlBTSo6CHDZ5zs253VVBjrPqLKKxSnmrSquEysrrM7hybszzLUjWgcc2b84lYzCu7cbeHYKN7NtnZK7sHJtKuqfcPyf0D1Qkq5WKRXyQAaf8eiaIe6UUPTFJJbuiFew9IFO3ZtHIZjXqwDWCv29QLuH6x8xZaGh3sm1Rn2bwH6D9Qa7xKVCunWusfPCA9WKChVjFJy5HeA5IDupCQynHO2VyzHGpuQHwhr/OscLiOXg/okY3HrBBpGR3dj0YkZZ/Zn5m7KgK7cG8PU671rgvTJ0smCRtjpj3F4ED34S5XLj0mZpKzM+ikeyTa7xFgPCp73iEOEhWlVp2DA3L4QEWqDsOlEbtlf0QuWMLdZXPvPunhTOjqND0FD3qkdxCR6KCn4h2CR/cOiCp3X6U59O7BBIh6amO6YBfsml3OxIXhxaAz9kbkgrPx7GJxfq7jAcH7BxCfL9S6Ge3tkUPIWU7wB9DPEJUsoKOgVw9hx4hG5CK7nFh26R7MsH1FUzsye/cN8XN+xsdjZlO6PYLt3BsRSz+cKXlWXBtuUO3+igPIs2umZ/ShWvhxUBRsMkm4KbD3ADoB5kG5FOkFFHqhYOnEAO/t75PocJ8AP1tOl/1D3Dvv38PIYsaTxCzzB4ryheBpCszSYZfc7xFY+QuRfIatAmil/43I+rIGg8idfN1D8kBP9cpC11MI7+/beL4Wn5brl96a1iYusrYj4qyE93ATVBumZKlmie/rLU9ylpitGrsMlCVkgpzzoWJvosMRkdmcyUxV/uABuReNiDNID/fVKOjeHxHDSeGegrvo/RG5nHEm9YTHefpgRCocjd7TMK6YZ5/cAwkQu754d02UnUL7ZrlekX+6dq2/girNP4K1e+Z/DH8dBSP664rcrEZkf7ORKwqa1nUOQZlwUVaTK6XBGzbM295bdumNaIPliY2y1tVrmjbKyPoWTZvSaC8goBPkc/BolCub/Ex7eenh392QX/Gxn8MFVgaymoRmIKthw2JE4c9yebMi8DFMICL/KtL/Frraa4BGGm5W14xv0+Wy1POxRsGWyox2++woNQpXDJXuWEWLCsKubqSfByt17+r0obEH+FxQcIG0cFDPs4Xk/1fpUAUrsriDdmBFTxnxl6TSTieEOn5ajXjxVS3Iqg0FlkY77orGsaqTCDdfOupzyoYp+IzytSKWg4BxEMVXZKi0v5WGszI001ZZnhbAeKPwPMt/YeOZ7+LmYA8w1yeYIkjZq750zV5XrqYXW5GbvXhvNSIH68tBme2/Ipr/imj+K6L5r4jmvyKa/4po/iui+a+I5r8imv+KaP4rovmviOa/Ipo7i2iU0OXQOWVpd8vbTgQLbtacYYlmN9XpIC1PcX4QN/gWlq2WBFfUA+mzIObhPIMjIOReoYkcccQD3qLgWyCaGEuv7/iELj3SiZBdXCTXfrpIEvc8WbUFOhVU1ixjNICKezQUCSyXDdajrzHCOaDXTU5rjkc1dMBqGyzHLEgF6FthliYuKIHhIONyDOGsCVrVDGXNP1Gj5ceaeEQul02msd+A7mITgZ8wyR3oEPxW2BPXBFPQYaMRYrudBiK8WBQzn/sSDP7S0k5XbPLnuYZF1e5Y40J4sOao04jFUugYHoxhFqGRsrKCTEtBGCR5pnUe5rGNpWW7A4IZ0fbu9bnOp0PG89vr84DImh9ONXl1XVfcRIEf83Ahx37g+sY0GW/4/EJex9sRWaSLgk/eZZ94WsTDkQ6/SC8WEoLZZ56DBCDu9FCcULzk5/I1rOxdAkNdZdyOiEjRzvU4S+UshhQT8zTL5wyzLAqev1CRTPIJlioy+EHfthMYG29YLhGPOc/FRPC5gpWfj3v3ez3Ie8n5pwm7PhYFetyNt6NVs3kDFKRUhqcX50jL04tzOvWDgOBnzepBnPs2v3ZiZ4RvAJOk9FJoSWFYw5Y0yG31KrAKiKDbyjuvDE8nIOR7J+bcBzeEPDTEBZ3BbR5in+CXS0w34r1qO0ZZ6rsZFLExpkbtVsvf5qGh6nJZfrdaKQqEZXiq1l1UuBetlnYA6Pa6iXRHjZ6BFa+BZ2L6PFvkAVGE0NNMFE/z7AtPW61ahC+tF0rRL3uACiOmLONqBimqi879V+xVKXlWxB/M/AufB0QGMfzWCQIW2bzmz9QuMoQBRQqfQ72PjnG/UjgHy6Uv12JpQ0aiipsCmEVHnutImNin5zoy0ZEJxYCKVD2iU1SAltEqj/wy1xnklznVEbr24rd3jy3Kv717TG2kypCdnxfcwFcBWkarPBcG3YtzeoGScoVvNmYJN0hjgJbR5Ng8HfCwG5Tr9bFwLbYFPRZom035UIxI4TMENxQjymx3SteDLA9urhXPoH1xhKcTdC4B09FXPc/D08mAV6ZbDOND5dfjyMea6gAgW0BOBKV0G8xVTgQMExkuLmDMvEaqYCnMtB0FNUufph3wbLm0TIH+WBsszcY74GdPqe9yAVvictmNHZsZWTfrUZOhLdHplIahPf6K4qlIheQ+2tOk9BT7Ma2Y/jh3b8SxTTVbLLF+CkiuUtlZYVM7ZSq8HAE9rtw0MPTP4KfggVmMAJuhGC2X22mr9Qm7Otim9JMPsbAqZu22QT1r5671EQ9uZFgsLi5yXhRP+EXOx+gW4z3LU5FOC91nTTyqdu3RaumP8JLlaTXkew7IrUsFMwYHyYFrr1OSiG5bGs/cR030TgK9PCkhPmfpJIH1sykWC8CAFiVfwUgO3qbUKxyWy3Vf44CaGPU8ssap2+z4RAprU+9jOsTnSkZbnr3QK4BzKbN2RwFr06LtQaPd6GGh3Er3GWXWN0Wnp19SYNStrZ8rFowFq2Oft72P6SOTCmBvuYDLg/DPTKQ+eqP+mHpt3z6/EoSFZONPuJdGZkdI9SkA1wLXmWpaMaHC/vrm/oBS5Am4NVgu/WPfBOh2d4MJVmXCP9U5HLbXRH0r6/vG0f5XXK86B3D0Hkce+1z5PUamE1/bGXqnXhtCyo3z6ThLz8XU3EeeTtj163PkK17nE5Gy5A0wMy95KngqcR18y6e/XF34/m35wyJb5GOu/QKfZutJQdtbeu3dj5ObiPRWuya2YobmMvUzHy5Jg7KNDkudI1MdtFq5j4z1wIdNA25VZ/hFMNkEMEsQ661gmI4GwrDk8YQnXPItgey5Q84xXva2WtumTlulqgqxw1JNRwo0UrNLvLlFrvTjowYvG6ewT6YTBm5VlstqVFiwOf8lKbip7jG87mA4UJIGsaiZujkXxadJlk5hO1Pc4FCig4TGlKrn9dLSBdbnAY/95lIjmoY5vwDBob97fHx8vDw+Xj55spxMJpPdaRM/bHwwRcEqIBuAVuzzqlfIp5q9hfw1Q71KNj0QLXLejxOPuP3wsjwxKZ0GVS7nCZPiMwdmAW7OLdFZMGC2SMzKVv842RUVyL/X3YDVoMqH3YF3vpCLnHuxd8EK6Tn1pMEg9XkQO3T9sdgVlYPn+0oNocxeZpem5/q/4ziFv22v8PCLj6is2Tnp4/+62/XfBQ5C+KkCXn+U40vVovlm1TSRfE4/wLLJcQYBJpsmzyvVqvdCqSVULZHUoB6WFvy6Gq52mZtFKmSckotcZDm45XwPVFjZamAxyqXfrKsRmlIdbj9BJcG1d3IfOLBQRO2UJwb+n5olRY8368yidcYTP9cZg1XFcqqCl2VQB3BkHHpTLr22ZeAH3m/vHnv4aAMfKca2YmqllhkHSqulwBS3gEk3WGRJCsOGPEasYYoO9Icf4GZTMdXSz5esO9EPbiSFUVP2IoXe1W68UteNF4JPh2IUQteOfOkEyqeb1tAKnFVAazg5MRWbMXcZ9rx2yccGcArpmOMJyal8aLloPx+kA68N9Iq9jhe0rWlh1CWOISsLgvJhiyAsFmeFzP0oaIuKaVp1CRLr7yAIcDxFm53tDcXIx1W01fJ/xlnD1Dz7WQxBQ2rUUO4vnzWzSwT8WhKOz+gEJFVQ0tGGqkN1tnvCJPODUC+2G0HLYLXB6M48lrT7cTj8WHw8Ge3CWLcr3/9+HC4/jn7YnRLPC2In4eNHFVdC/Ud1MTKAPwg12DjtkpTaIyc/StFt689iCIvSCJ1ZUROKMfQvXK+CfoMCnmHLPcVDa4U41ITL2/SxL0D9bQB/zT4tgxiCBljuzPp/bpz1Pqe/QtkKtQPyCtF7pZjSf/hcx4DoJK7mDZ3d0hX//aqqtGF3g+ZhdT/G06ZcqXlygO39IsKEFfJFOuFXMHMf0m6r9UWEkiu/voGkZV99EbDoVYsQ0aFRw6n+BzMrc3SAh0yt68nTkW/CEXqQxtwhpJQVSo79nBEZDBBWKUEhpWQidrhcZNMcEmHYgEqlXzbI+/jRg7Hnjkb/4zBYws8oWH4c+sP//Tj6OBztBB9HEBsGFV5Ii2VZSfLlMl0uxXLJVi4KqazMFFvdsPNx9+PH//1hpz0I/WA5/Di6WY1gPnz8+EPLnRNCVllrdYGwvumjR01YPUniay8bDrZwbIIBB4IFWBrW3e1lbAi7+og6ixvTdSMOLjTVchGeXlL4g8qvHLo2PL3ENHetyKUZEZqLb7XGfgbd2mplql9JGp4yklZYsKw6EqxQB/6Ev717DKntiHSDAARDv717XJ8gRRWABN4PObk5HICKYOCGhjLED+CRKvGVMiDixAm1XH5mgZorPBh45xjpxR6cGicsyVLujVyQt1VdqS8sIZQtSW5vycksy2UwWIva1CaV+Jl9N/6bKlyvpbE5C1mTTinvUciqwpx2OWHQjnWh4qkUJZBrsSBgKQ8jx00JBeBUS1F8S9Trt9siyOm5P+zxPSJGwYYyIM7EFKeZfo6LSUMDmjGqwdhc3ArBB97x8bFycOszOmdauNCIIckCwrbR9zDDk3MQr5WpIdRQ5Hsr/PaqvhG972i/e/24Pvisb1d3NP1yxcbmhnZrIcv61Ku8iiNZK7Vcrsd937gMyoEprHjQGZxpq7W9aWzBXrAhyRUKef/rtd1RyCo7ohfiDtn2fvCIJ7zbpsNmmGp23A44IOZJKpdqKFb2c9qIZNtbbqyHNIFyUczrmBgsWi3v2Az6VmsTBQ2HVF5xgYxYla0UbaBUY9nt1JSY357ZYZPGLleAQByes/JG3BqnwANxDlz5pP3DrtkBAg4y+/Ipck5rPKiDGfCq/7ONNha2Hv2RutcME9yOCWzj4TVnuR8Qrq5rbzu+ekgwb6SEtg5bOZOOJYJmJAb+WJbXRw0n9e1u7aiuwTvrwbmjjJKpYgbdcnz57goylWuSqvq6MfDHuj6d9JZP+ZUXLJdzZyUJiFzfQ0+QwcX869spRgdxHXqZ5gW1FahMolcsIGtJTnWt1veh49zX/8coswGLDfRoJoWiwmmdCrcQ4NZay2bPYQjZUJVXM/doHcNsr8rz7RCuSocjvBoyB9w+vG2Cx9rUrPYc9gIlIltb8VJYvwLC1pJNSv4NKS5IxEcoiZuECsxXbr8q2Cqn0VIdtwnTIfgNnKb19jFzrpNzTF7vo8p+4nvtXF8mLdXbpu52tDbK16Bt6u21WtidarkNgmiA4Gg4uLPjGgKDvcPDeO/woMxz7eaRP+5TCsdy+WPUhZvf7nIpf9zvglKGI8I9c1aw68oK5t5ny/LMim6hcesoyrvyaqo91x9FXUAApQP21hkN9J4ukuSDqqbVwjsSGyMD4ojpTmUp/F07xTVr/32lfq5PfBUU0J7PjZQV8e+nKt9H77U5epX177UBQdIlojxIXvtBhwc/3tNodFhbdCLndl26AgCAh+9yA6y0I4If75GEYn2QThY0at/b8XknCtrgQdPwlEe0O/BzKvEVkc/Sz4P2IogXD3GcYEobUhYdjAhiiIFwQG6ge+Oc4HUetDV2X5g/XudwERu7C8POmrnuOnG31qD8oJN3omD3XtC20p3sKIJDgIHQiQj42z0BhguABXH28KRWwcAXNOvUY0kJpB0h22+TBc0CcgPqYLEg2D7mtOmk1nu2NwnD73aEIY2wjxTrCHCle8+5P3YnF1JJzW6oNJxkl9XgdRDCl3NDLOv3WKagc8O7Kc+1c1cq6692uZwW5Fd7YL+q+TZQN8Ihm0z8ezu+7PCAeBN3gXnhAD7WHFBE9hXMb4b2qLKRGTZyu7xlkrFWhQsGvoRnaZU6m+YSZUDWFVMHUh8u7aP5kkTuLfzTxkodAOvV/HhvubxXogLwY4dvfL1JdmLgBINqeIiToZSZlPGVoBUFfV2KosF9reJafY2ik7eV/aEGwAh9GtHHROfq9DZAxyLdBOZYOFpGv3+XIKfSgY4opxJfOxu/b06bi3QtRR2b71XFOdEowPaIYENJK5FxGvpVqc46amtg7iYeurwVxp3EQ3Bbv0FcU4HeJOnZXHS9hXcRLtWJu6HOb8f3W/H7DsyCf4ce/05T7oTat5X6D1JWI+ToM9wmV6vUW5Gs/X6rZK1Sbrlsir19Mfj6EnK+SJJ6UmAXkTXRm7OQlBK4NRiuDK4psVFiZqd7g7gsHGyQxH0T5I3SuCbwTSvkbcCPRfpV0JvWu6qkr0oIR9bX0I6GVIvIpsX1jrJAu0K1Wpt7cqM0sF64sbduKV0p3NAXXxElXn4tu8Ncf5DaZmK7qlId1DTwIRU0VPqudpoS4KnviXuAip04jKgr9PuSPrJMePV23LKmssORLQ1ihwn68n34+vpd2Gs/aN/rrNVasvPlwa+JWzYoOUpP34pPqVRs2PWnzYSovA8JmJdN+PHegMe8cy9Y1fMEy6Vz6Pl5XSa3vho7UjmTaGVofzbK5exUXJORVQC4srk1yH5DAfqYBWRjFaWE7q71O/pf/2FCNEtLK8kbSdIowF1PpG/WibNRiPvtiDlKbP9ZWh2LdDNCxyLdSCeT1kAlk0TfrdGoWt06he6GjqOX933yXn08Go5IAX8S+LOoin7vVSW/htnggfEG5e5xKKglrJrgyHBJXk3SsZmS+4qAFOqLBSRRX3lAFupL2C9mv3LFmWVWAFzYr8R+LWriYdWiQgt8C5QOJzqUYGihQwtHGFxdEupi1sVmQW3DnGmASDYMna9nvU36m9wRrdtgFF+HUR2taxCyW2XQv9XlU7NskRd+8GPUWy6jnqMBuiHjctnbdzQw9eD/AwWoXXIXRUNjqOi7YPVdm0gXkhd4U+hqEP1Rn2OnBgiuRo5aorNueRfAQvmybc7O9tRcvq7qqB7a44tB/GEUgZroxdyLvTfHXpwOPAbfj449R++vJkapVWSZy1OPgKapK5j6VVbexbXvfXT7eamZpTIwCvUM81EQFheJkD7AIpwynY2kOkM7GgUkpWDEX2bEs1qfP+z2kUkR9Afpl6Y/3FjqdLxgjQ812rwPgRWFp1VSuFJ9SEGWrV5K4p3OKm+3Vw6r46gCWoGkoIgE8EhvQeVrg/OBVgv+Nw4IAplf3wj6goWn7OwsJ8Ck76qxtOu1ZUAk90WwGqOSqAxuDBJYQ4mFutwwd+Wl6qGf0gLkdoL7Moi5yoVasy8YTYOAmIpLUDpTaRVGDVz6Gm1MeQgFqNSWsohJ8MT3VFOV/AYsb3Mx4R5B7wjKfYK+vFY5fNXIV2zOyZYy+Qm2ZLY1nrF0yrdYusWvRCFFOt1SOUMDxa2nEUoxyxbJZCtLk+utM74Fdr9b51m+Nc45Q4AMNe1U0a0TzrdmUl7Eu7uqgj/B6+B8d7oQE17s/p9dbVlX7KqKO7p3EOQ8y/mWSM+z0INBibQwFkxW08DYeF6wnKdSIe7Yb79lw2rayAzRl2spy6W/Homn+fVobXGQsjmPJVE4xXylLJz7aUO9BvPKIMPV94X/zk9Rt+GlGtr40+hjEcZPCJUSGeoeWeEwlgFRo1ZD16ZNGEeqkyqtj0FHOfQ16zsDD5VAK2SHReOdL3Cca9zhs9JSVYLo9inklK5+FbQTqpQfqJLrCXGlvNtCe86pzVycmdptBsiS0PhBDTHnU01S1H+txQVk2wqaXuD83GZwBr5BFZcf3PMw70vq0P/XqsEX4+WO9k74b5mTlnNHax0wYKY1aasF3gasYwBKaaeH1jTpMGGjo+5yiR+w0yQsTocLNjqKIHLBRg8z6afDgo0I5gkGC8gxMcUmbPSwtw+7MaUUg62WD3fE6XDMRsul+pyVn+cAYwIwxgbGmI0eHjwYjCFyZiJnKnIGkecm8pyNHj548GBwzuJORLBRp6ZVT8zNIbTsqGDLJX+4YMpqaMGCWm4QYxStFgdaRJhn2pgH/SI4uS5MLktMXlEPynjDi6SkgP4QJKG/M+XAtwiWy/f2G0ZEorZYhC2KDP0IoJ3BF1Y3NBDn/hfQaBhGIwUhGUajILhhVEWD8z392QPT6O1IPSe4sssZCxyXLr7jGmE7UsgM9/SD7wqHV004vKrhsAc45NRPhr3RculteUH7lcanXn9+S/2Qa1sYo/b8q5juj5TM4wMziOyPgttKZdT7w1uhnwTW9vPlEsR3foa/5LxcZirFyh4umnuYJGRBb7ytZ8fvvNjbane73a5HvK1fnmC4093X4RMdPlDhx09qYZN+qMLHT2phk35Phd88qYVN+v1u11uRCfU+/Ov977+9O3n765vXr/549PPjJ788ffb8xT9fHnt9rWJ3Khz7AzA4CEY7H8EYIP0o0Rhgy7VQ8D8WH4t2sBb/vx+L5cdCG9iQlP6iO4QHmt9Lh9EIL4i8tn+Ay0U00uNqAKbgMcCLPY8w6j3ZOj4+3gJ77WHPZHoYdQfehw8fPkBW+AtHS+/583g+x4z7o4EXFwUq8BEArrpp7HhyAJMkMqND72SReqiF5xHv3QJ4oPd8At+zhUe8p7nwiHfCpDcajq3gEH0kI9LaBAveftumdGZGG07emicZmMhNo1y/X5oOD0zz9POyvRj0apJBQc0oiv2ETkIB5i6vz7EE4FCxsQ06UY8U1E+OugNvq4MjEEa157UTt4s6g13idb3AmEqF4Q+78KZ52+t2vaD6HGlBF0OoTM/evnkYFeLVOB+tIJ0WoEwpqOL10RzXGt2TjHpbf/zhEZxuos3aeTtTk0wto9oFD93u3jrpEu5bDvdnM67A80lVmEvTAdKaV9xvtHEsBLGfARRicph6tFMOzQiUCUTpmN4lpwyRb+VP82yO3nOesiQ5Y2NQZg0q11MLXjvfWaGssS0f8Ni52p44DbcNkmGaXTpiWR6eLgoO8u9huqaSREzUsdIgtWEEFYxiVaZeoJJbZy3RGq8vgiBBUgrApxO1dwg6wY6W4emlWs/RERIDtqISTiA8w7zWlcnE2c4ZBbJBTuBDBPwNiO9mAmUlfK2e0kph2P43sAngdoGk9FT6jHRJpRAxWNE67Qz6tEpEI2XaqzSLj/rtNg/0N0VtQ9BLRPGVlUlVkiulBz28/I3ibmzgIZuFAeS0uiY0roRmldA5GylPVSm/goVM+Z5RIGhXaUJTX9oxdCrjKxm4CnG50y/yy1zZ/mo1t2MtK5HltYuJCjoqf0DKyhUmqu7evmuwPNu0r9pd6pKYU9qzZ8b7DQ/fl5+/BDkFVbV9ksKIgXzEDptj6Z9yP1CaSNfYzULlek8ikF/i9y/w7TNgf9nDezB8EhgoeE68yV3G3mprZQ2x1331QoGpFHUZNVLTaYnUoorJJVng/hHYlk7g7p+HE8CpCzgdWpysN4qQq0y8nROfhxwy8pBXsjKarwS0SoCKXIoIDRpYYrrd1WCTQSM3DBn8gl5K33ZSYNtDlcZnZTbRolTqc/XdcWFDUcXpOQ7VFyevT+8fdiOXd9vCJbvvZnv79PEpbBmVbLhU9+G4BdJsdMyFfs/AnY51vqVZcc9rc8WNG8c3ZKF9CzGwkQ1Pzx27ztL2d7kcjrTFInMtFnN8EIEI6hc6s5R+DgdxKBEgO95q+RktSr6hsNu5CEAUbnxatVrKqVjpLk9JCLKAFFAe91e3cNuYIQdk0aZl4GcxzEcDXwxcWkSxA127WTMCd9QOghN5EPPSZRsw4hvL9DGl4smNJp0FKb7anAK3VVwFjmjU09m0ezfYZrutlskAUPxKOvZ4N1DdXHObB266rFBRZzFCWuoIbG39dMpLt2M2ljhZAzJGRiCHv475Ba8omlRvasHNiVkyDRzAfVANKqcrJqMo3hyDvqr68tMAxg1otsPJs02jXkAESMdB3LdcwtEsILxyI32xvobC3NFbwbkZHS67WnHx5zKrdf9ljnW6A0vZqVM4rF4rZzvlVqH2E0DefJexOADOEashGxFcDchcWQznbbo+skjeplF3Z30w6imMCcU4yznNiT5miuUyPxLKCDknKeVB0J/5Euy83J6cc3tvjryLugr/ghx7AI4DGZ34Q66WNq6MFAiHVQ3WWRCXEo4XCJCIex/hYcHHWTrBmCQRKjRqdGLTatW0UHHASXdv/Myrqutnfs79a4isMIHlHstRRSBCBQFSptip47oodGBDi5Xw6Lxf+khUc6Pc5ZZLgexYovqa4nAsn85ptTyltfbZv3GceXZX8ITOmhWYYggEdeCHFzm/0CZeAbkC2bhtNKxP/gKicIjymIGXGxz3cTrAg0V8Bn/J3DcOCCfIVgWkYpN/5jDWsB30CwBUPTgYPjterKelxncqpK81Kx3giUVh53M1hNJyXWoYB02DAFqbA4g5Qksa0Aji2w4fjuFFaVpRqHYn4GVHV55uq0UXf6PlEk7rJLXDxc99tJJHkjL8VmuKXlBQ6GkyJ+t+LLe7JDEznybGaWQBkQlNsQSV8HNOOfxol5SCfOZ+4jTilN/qm9Z1nPiJV/wVwDIYwai0fkWZD35NlHwQv8g2ry2QwLppr8BcSdWivjjipXNgEaBOk+MVZxsiwJdACoMvRbeEzuNVpekG9xVukg5Hja9rka4t94n7nih+5udZzquep46/Hcyjc8nzKpQTXrkCVN6QxLn/jFlOgwcoA7VOJ4d81GppZXe4MC99SJfueLYjrb/4jLkkAwn68BkbipGS3KVlWZwDT5OMyTIL+nG0IbwuA4d5666qn/A1/151566PnSzP1L5WJr5xFsIvyjuvWvOXyy4RlId/LVguOQYZ1fsABHJtdADfGdUbQ5cUVO0K8J1QszVAaEHN/gChCa1sEstlt19Bn57g5mg08GzGgrYn7Yjv7Szah3x/J8HvYuewu3PYLf3yFbSdte/t5BULMtpm7b0d0Y56O6nNKpl9Usws+aJUDT9bnJ0lFb8W7/gmV4VvnO3lBW9yPapedutEOzLY6USxE1fxb8c3XuurMY+oLeRY29pCl3ltrzTa6uLC1JEQD1fVafsv///5f3y5e9gNSC9ocwzLH+GZhcpN/1N39aA+R+Gw4e6DvhVkO0/awYWdvmIemvvqTjTCUwOjvmiXEP4CUcXQ63j6HQba9g+7OwykVO1PPgMhpWkDLLP5oBt7bdhT2bA7GuRxx7kKfs19V9vZlEuNRiSs5OE4yVLoSkZ92E6XS9xBebmFxacwxspw0BFlgAh93EevtxiwaW22ZvGMCzERBiYOJXfcvHVHRHSw03F73/Gv+yVLDchgNzpw/e1x3/V1ZRSMtH9hmEBfU4LkDUqQ2tHYup26uqZ7yv2U4Y2l5nlc92BKeGndfvHgKDpUpyef79BDuwZvO8qqqGTgM/qWK/W0gLit0K/56bywgyosmdasBf6OEW8OQnDljNfftv4L1AX9C/A4P815UQz+qeogz7jPO7kqFkE/xc0FrFpbPWGD42Jr4d5QQqnoY+qqtjzDAM1jQwDHoIdXfe4YTd116y89wQNSWwqk8eQcxJ1akmsswOv6hWW2bmWL/LCW03Si31Cu2nURsQ48F2cyZ2PpmyZjT2jalHrFvOLwF0GBGK3eRJNAtiNiZFRbTUNYY2NWzafc58ygGPRdtWAXfBDXYrqk3HhrLvBeOeTZrs087CI5AN4tcLsh7pI67YKODH48RLtih/Y/1zd2p8BDjNDrm3aS0K1Uo+fEV8ocBM0j5BfdF9uFb/r0ycm7k5k4l3xS8RBYTeorUt+sYH251krVAZH02ojEmXvowpmAzvIZrpv40V8HS+ukvcSshIcyQzfIfhA8NFcp66W3o/5GjB2tW+5v7kt3BXNUUW8r0ljit9tK+JUZhkcNZ2l0uucZrznYJ8o4GXdjoygBFB/k9GZexLLKQZFJrESVBTnGNOSOVnGiLZ/BndgABfMyzl0mraAS7pPoH/ouSgbBwEcmg1J8mHfQieKI5PTmOu6SSfzJz+DaINhJyQwDExWYY2CsAgUGZjoFQi+4D0xdhloTwU66wkr/dYdK/+I+PAxM0oAcq8AeBi5VYB8DExU4wMBMBQ4xMFeBexgoVOA+BFZKXAVMCZAnXvPLCfc13nmezT2RbsHluszwK8C97l8c5OF5CBkCgp8yC+Ae92ZF8nBeUFYhM8nDY4jCfgExORx833AQWGK3ggs3STzNr+LzJmJdYBEQx5ncXxXWzkhf9KGj1JQkYDPkWfGKr846aTDoxmmw4zC4f1Tg3bjYx12iMI+75UlbN8YcIPCWRH8BP+5zY91uTNJhtTCLFez6BgLxjr0g1Ac6lJx1OjYtrY7WNu+0b4Hiuuz/V6Oaojs7edWtJHKguKaZMypKbKgmTOzjJ2apYdWphomljW1HAPLRjTR11GarDIOJ910msSqhBeGg6tM2PqYBTt09pVzoB6HX5m3Pv+C5yCZkS9mPB1ui2DJu3fkk3HqTcLgpL5UnbUFVgGwpAEH4DQqMbDLpiPQzzyWfdC5YzuYNWow5SmcEETTHM8+6+Kmdxilh9BlHEhDD/zHDnLg+Q7lf9SFB09oqmdEX3E/VQgkXESqke6jPKys303eYbLlkJEcxfnl24NWzQ74jApK1Wn8C7UGc5ZHn9jNoZ5BctFpj6XOVoL0mtQtIAU64yolyki2XhSvl+7U62cOJOD8HQNASD5kmMyiOOocDz/hH9+L0qBMNvIQVaCsH4a4KPmHXEIpUZh3qDTwtYYXQPRVSBUuYjj6ye2YD4empOpy95r5QjEJYSJbL1+c+YIodLkPjxd24UsX+DJbLsgbY+Fot/7Gfgo72AP46Vq0iiDG+wgIoXwGg/bSuIW8q9JXwAJZrUVFmkLXX88/qfDyvvLQOb5kMJLI39jxUP6aBi/gyZHx+o36054xJL+YBqUSgxBlh2fH10JHRxs73UYX/M5SuHH/LJqT/rzbhyG1CBW2eTmpIH6WNDWDpmsxUwOLn+YFHfM+HagXIFXQr1HYiYeE1DdOrOsRBc7xAlYlGg2oG7hZScCDKQSZPq15k70hPsU5PEMm4xPs6LQHnMgTbkhMkXx0TRzC30iP6lV5wW9v0kL8oTthcrUrLZYVU1QfhijuWtX3jFk7SBocY/Soou4MLWHfMAUW4KYyCYNEX1RNa7TQVEOyOgHjArnjqNsjDzcEEtOBUdYqf0UVqViRSTct26V5cwoFb812491ROh2ai6AjQKHP7ON+N+F7sKeGqiTrk+7EHslcTsXfID2JcSxUOeYcFu/cP9yEWRLdu9GF3/z4/iGFnHWTxqZ8F6qGnqlP8RVo7edBm5q3tNzB6ekF32DEQ5UBa4ZUXep38qAsMfi1nJyrzEkFBoBPs+nknDYJ4PXe7MXfayYOAdHyGzI8jRJ/UbaYMMLUz+B5PvcDsGqDLiXqaT55soV4m6mLGRbE1fHb8bvTHH67J11g/Y3SLXK7vSHVNvfjYYMmGKqqChov5BoXwBwNwfI66oR8+dI6PO0+eDN+NDDbhycnJ8I+RF8SPlb8v942cFyev9UuJarmQmVLpcpP8ILbwN0Mvmzq7tamayzQ37/7uzpb2HHAq2t7Wzm7gaULonB7h4B1eA3up5KkgoaP2ZOzMx4GBv5BjLzYBPOT8kaUcgf3hBX21oXlDry3bP/ne6Ce4rNHLm6Jsq+UEjijSGWmA6rf4Aaq6jRTxSE55+6ehF4x+amI40jYqg7oKQegNXyk26Kb+Bn0/kKHWPFWMz29yHNeiTGP+aZxw9jda/V1khTQoOJVPG1fbkqu9wrOmdDclJc8qO3fwjPs3MsNdmsD5NpYrO20cRPwgCGeLOUvFF+5v8yB20m73tH+R1mWQUI1SMHOFIvO/qTGqEdAcmf3tjfm81hiZrTflOi0tb9ZeoR24d1XK0ib28dLKaqFwxwXxGJ8S1FLhsoqz+oqnszpX54iEVthWr4QoXW21U8WOf9ZugNrTdleLMYRpOh+6o410Ntx6VB5RZO/LEOxU5ktyXVTZqJoqIGTq1gqXJkmFdaI+xNlEw33qkuUxz7fCXbslGrm11mkHzQWLqJOsoyBHVN3STQ5Fnb0d953BMj7Y3VsTgp+m6y/GlF1fY/7saIh9RTBVtS+pPj8pSmiuTgZGO8Zty0D3hgxKMT3snYXnsnSf1kaLc6DtILtUu4tyCl86heuUsECAn3Fv+GtnLJxH1RLuTX71blTPmaG0roQNxYl1jCxxXKk0HDf4qQirYy2hKzrgTxrrQp+QIGjVNZq3BWzFUG1sa8cxHUtrf62HciwdI2wj+ZF2LAfEQUplLoO+qzn1uN5fJXurFx13l69aOL5xys7rR9t3TiI+5Hahcrh+EJ0sOtWajTmX3GWuG4GqUpoXIGqf0sFzopal2F2jCErIY0daTpTqjI5SAYccT1Nzo94lQ0mMAs+IdCvnh9fu5HufVlxj2QXBD8pvmPoBucWXzS1p1+7d8Ncq1jPWD0h95VGa3c4lsUP9k6pD7WrG32v6BOtI9pvBSNWwWhPep2tOWetefIxTTMiBwOB4c2IdtfKHaMMIsr0Pad0tGWZxLybXq6MVv7AkAxuHHOshXZI72tgVNgkbla17tHXdj2dVcwidhlM5qxlE1K400/UntJ1HaCubQTuC/SCu7Bu+BK+1bq4f3UXyVep4HC21CfzGQ73aEjrNabijB/ps6LievZMDpp/17OJwX0M/4d2N73VDcBLg3immftNVsvU+79zEbcr5OFPvZEk+2fotFZ95XrBkC2SsVQB/unQ/VbdJLn/1m1PBKW92glyeHVz+7ZkL2TGA/Stde4kMDSDoOZAZ1UNK/hwU5/28wvH9YUUXYN2JnCocCEipXyhx+/cMh2cOkLZizW6pQ4FA1+bOw8H6HQvQJqNYRLhFDF7OdYjTHu8syxLOUtcRrJ+Yx4854RZHTpXKUIy4Q5oEPYBb8/Yr5JIDVq6ecbdUU0mdBnPip+0cHD0LooY1AslIYRqtXvq9h0/7wlO6VJXKaqU0SGfG/qN6iNDdYkUJzvXBbRnR0Yyb+9e0Zv9m6esZxzJu9h++nn2tCim+WuZYpG4JLqo7ACrDVW/KK/dW5YsrTmzg6tzZHBO8Mqno3tXea6mxWCo9rEEOK4lOvOaSTCEMGJ5Ox+KnZf1MVls38msqEj/rS3cq6hP6mZL0OhriLrZtKnZYNUrbA5kk+CT2tt3kxwCRTZqGQlSGWCrK/dBV+WWbs3XcfLloUkk0r5/H7tvoZaHMjpHSQT1dHwUko2XHk6LyDABJGoZXji7lM/xbPATX+vkRxuDf4ghiwCICN6SdXPiJ8IugneGD6KSgqHBdGT/5jxHfI5Ke+igbhXSThJqOnJ5qDcjEDh8OCfh+u05Q4yf9sbdPsjY99dPd3j5cUZ36hQCJKCnalJGsQxVGLADh4qlf7IKVSvEjjXokCdVUgGrUyCtIooebqA2ywu2S/fvd7o7cjfYPuw8cD4KJm0cl7shdyFzmWYjbXSBaL4zqYce17uuX74CWImzYboxM2vrx4E4/t4XiF+yDyHpkFwLl4iWgQRqnu1Gvr2UJFRgO35KI6goREKkFDuqIalDYvdcWWlzdL8UGJtVg5YoLdFpvfweS9w5NqpEYWOrudzHHId+vSg10hvuH+12VI+J7BoRztbV+yFXjlwdtYQ3J5SzPLrfsg+y+91v6Kc0uQbYuJMhE3YPcRNx+kKuuQao2h7ouPX+Meju9gwc9ftjeiw72DvnhzqcKwWEQV6X9Y3fsbfAuxoqq0c5MrEswGjAfSnxbuf7o7vkdaqyTANaVoRwhoBLS1KHcqfXcWfjwtkKZ60I0PCbJQve1abhzi8j2dkokEU7Ruag+ivAMhYmgB4vPIuS+ANp4Bb72UoZR8zErwzMIF2UY3K2SpAwfQ3hRhq8hPKHsiF7mYVG0WkOv8Ah4mmFHEIMROiY/ohGE5x4GLvNwjsG5R/LRcpnp5JmHgcs8nGFw5pFstFwWOnniYeAyDycYnHikGC2XiU4+9jBwmYfHGDz2SDJaLhc6+RqSh971tUcW9vHWybA3opxMhnsj2pYPu2Qy3B/RlFwIlxmfuIJTd2A4wtBPeeyZTNX3OD/lVKLqZilWre6UCso2pZe58qlkwfIBRsU+/lBOvMLI1pDo6IENYLsC1a+swV8REfc3vEhBUjrXO/q2dGX8iqEO4fHzp/gSuo+znaRBQPgmgf+V8P9dHPV0oceN3CAsjISViYoRzJ3cWn0GNmqBu265MYsfKdhw/Ej1rpzjtprDtopVZzQlBc1JQhlZwPChkoypIDOqV6ITIwozVJoN/BmosHT082ZvvLafDbK290FF+MWgaHvHOpAMkrb3RAcWy+VkuRwPvHcmYrBoe891YDKYlOXGg3HbO8FA7L3pPvHQ6+ipIJ9E/5OgqKHq3L4V2ZwPmiJj1z7O2iVRZU2mNadTa8v18OHDrnn2Gw934G0GjJjQO1cpPwFbLGXibIyHjMpBtEKyXgr6SZBjfPEZr83e5NkFz6Xg6Cn1RMAZ7gm+Ti/DYnFxkfOieKI1wkSWvtdKXJANrqhswnOWThKeU3vJ+Vj0HwvdnvATvy4Gznel9cahl/HPWr6ID0fJVNlTc8fEDOG/EeSdoI8FeSHojVZUir3hu2zCrreYHG29fOcRrbKE8fMsh83YTQLBmnofYWto4rUGVOwNP/BC8twFZ5SlYm/4khVytFUtarSUYu+ltyKPBL15+e4k9mb6xviRR16+U0H8hnc/d5882VXXjC8xDDfNZMvE1KK2bFFMgsrJVlOGFXkqqPdCzectvC4grwX1fpx45K2gux8nNxHprXbJS0FvznFFiUGF9cfCI7DIxN6PxRabZh4pYo9tnfPLLT3pPVIUsffjpAzPIYdmrsh8jokqWHhkFnss3ULGjMxmmAaBwiMTKAbMHJlMMF7pqx0jNOQmyfGxAqbO4+QakpBJJdfXmAKBwluR32G8kvf494Ogu/7H4fB/4UFpeF/a//gxGPjD57PRfO4XRTBYHmfL4+MB/Ld8ki2fPME/A/hvOZlMBpPBcpINlpfDbHk5GizfD7Pl+9Fg+Ws2WKor4WX5d/nhw3I69afT6SAYLJ898589ewZffPnLki0fLWezwfL588Hy06fBcj4fLItisDy5iciD1fJq+a/lly+D5R9/DJb44vWXZsxfvjtZvny3fPlyAP8tk5uI7K8g/yts78/49xfs1F3yHH/h60/VzXurXfKb+txf7ZJngu4O253R4OPk5nC1S/7S+Qe75A/9qYP/coI66h9m5ADQf5oAgP3VARsRAPwDJrd3iWQ2qb1LOKO7fywhDDDjAeI6FSStxPuDWCUFA0gUDgj/Y6hRCAa7hEFKt/NgtDP8iXW+fFx0u4+6nY+L7sHTpx8X4C+s83Hx5B4Enj7AwNMnjyHw5CkGnv7ydNReDj8uwPUYlDt8+vTj7qjtfyx2BtXoUTtQc0aQHK0IM/xbMNolCaMRWTDaIxNG98iY0X0yY/SAnDN6SKaM3iMXjN7vv1nfH7Tx6WBD/Po66biwVju4sTrFx0YhCnT53VOceVlN7wBzRt+I/h/AbBLFwfVGoHbqbXIpbGXWq4BAMShSMVWUt7kgdp47lCiqCCyYb4fjgHjv60On0u5+ZYP3A/IDNu4vob7wkzw3oWOnoVVXx/UHDtUD7j8YXG8t5RQQ0gdGmUDNo1opji6X4Gn4TmSzHqvMa9ldgVRqlQDc92FRtGvuwIyZExuoWlh84aeWq8P7DCpXirH7zOjuk2H2ZDTQK87HEaw5y49F0AZMBrvkmlHvHyxdsPz69Ck/y/HjmOXj2emji1wkp8fs+vQfi5Sf/mORXJ8+WkwXhTw94ReSg2b46euxzOD3VfZZRTzhY/zwjMPkUy8gZ6oWqAGAA2gDGOACWIAJ0AASAKmUv2KUMXIKf2FMf/BusaJVd1ql6axW9/HaEmxP2xIHZpcMPdh8e6PNTrYVIHgp0ikChfahkL5gcRI+eORgQ8oHjxyS7a6b+l5f0RDvWg1sFYpwKH7wiGTq64M7rhWofwryWxn+4JFfBXnmRJQxOPJ0lEkbkYJhioZmW48HX/SQSnuOg4GB1rl6d5k9EVMh8TaNBzF6ZTCAmsE0lzSFvLVZA2XWvEc0AKENiwnMtrYPfx8e3h9ED7rduAf6BzgRPjH6i++Zu0ClL/+H713C2nh5qdbGy8xTcnXsOu89pL1/r9LeQ5rRscC+w4zEu1Qhk0S896ozVfIBftvEA+yiS7tmXV66ffvexr9/b+MZdOAlVHSJwAkkNq0ffChKp0xRoFYfvQxcMnqDdy9kkl3Hhyto+gSmkDeBdinFEoibfMNS7T6cUFnyJ98FpmHvmHwfpCoQriCYCxPV0F90ZHnprroRPpSnGd3DKoJXOlnF/aL6GQNRZPvcDbv5IzWvJ7aPuf36xX5BczdsP/WXFeymNblDoYa9bnKXck4RHIdQxJuYv5M77WO1xyU37GThpLaPacJR2f8fWzv2BFB+w/ivjPljRsE16oRdnx5n+PNuwQv4fc8nqfp6N1vk+PE0F/BzwuQCDoKVredEAQIoAAKKQ0EoAwUqeZ9g3tPj7PTd4vQ9P303O32an56wSqbHuJe9wb/vzI72HFab58/1hqRk3DhUZx5R4jtM+E1i5CeI/PRJRz5TkbP5XA3rte3M89q/SS2FQ5FD+y+/9jxET0+X2XxeFP8OGBNXKjgZ0M9vw899umIzds9vx+5rQDbg9pf0PYZ7An4+8tDtw3tf9QIBcS55ZULRHs4f5pE/JH49sl/P7USe2a9P9uv5c3exn83c0KdPlTQg1B/2G1r8Lw3DSXlepuAu/9wjUMeITJiOge3o06e12WJf/AVLFa79V4JJyaAbC8OtMo94jxqKojeON3NngqOHN7SItH7mqDRggG+fzRrgqHo/Ke+5aekRb7uriioiNCNudL06vb4FU+59IggIH46r0SK4pZ6i+GpN+4SVgW+olvQwelaNZhuxef53t7qE+/9GK/Va/IKRR3CEZxejj+FgPvgYDnYFeYo82XMlJ4LJ95rRG2OpF78QJMnSKUiplWp8/EgQR3QdPxVEaQ4l8WuhHu7Gw89rFYn7TfxWEPfeJ34pjBrnNSPOQTA+YwRfyr5kxOxY8XH5fSzS+EkZVGVOGKm8mhM/YivyFuUFL/Hv74yCL/Ed3x/ESuhxc7haoowm6PiDGGQfHfizfK8/1cfy48T+BIE/iP13y63ANzKT2u8wJKOPk3YwwP/84cf2xzX5yvJjsfMHpP+wS97fgpVGqsTpbihVf74VoQ8bpULkC6PDYcUaxiO7FumOJeDuSJ+0bCZsTy3Ds2fPnnWG70fv33d+sVkM6Ws5qum7ZDuyVTypVACyN6f2StVusQ8fjo9d9KNuWU6nfJzc3F9ZPBANi+f7siab6Kb1Vm5lFsV7q93RiLxCMrpWLSeY/HES2z8gcWsjfJOPNOYj9WxrOcpUN0nHzudVBOx/TvXzebVy8x+pZqml2hQnXsfpGCDFzzj6dwewhnz0/Y8dEDcGu4L8AgkwJ46zdPluwZfv+WT5brZYPs3F8oTJ5ckiDcjgYxEM/I8TGKGFP4j/wdLlU362PGb58tFFvjxm18t/LNLlPxbJ8tFiujzhF8vXY7l8lX1ePuHjAAup0Y0YFmoOqSj/o07yMdtv75bPjt8th788Pn4zGp48Gb1bDh91Xvyz8wfriE+dLyM9Z2DiBj/s9je6T6Qnvoc69lsXefZZTPgEHACkmYQrGLaV83E2BbuXydbbp4/BI/FWlm+9OHm9pa4bzVtI8ORRIfOFvtZnSVJsAXh4SOnPQunzB2TrcibGM1NBzhPBzhK+xcZ5VhRbLEm2zvLssuB5scXSyRYofIosLcKtV1lq6t+FyidMco1BscVyvjURxThb5GzKJ1j0UgAwvpXzefYZ2pTCQ06Li3E2h3eX5uzPLAcEOCu49XGQ83OeA8Z3c2LwZ9EBPNY8F1SOpLLma/NUtB0v5N6W1oYNlDTDeIemDgu7IqU76Eo87p/PGfSg9cwwF2nVgQNxPDeEc3aFHuw4m4R3bORcpJ05u9r11iVqm1Rp+xtMsqSryoGia2XNIuPPPjT/z1pT2NVtTRHp/y1NebjelN8YXRccolVmml0OzIcfxG0zNFbkGaNDI/YzxkXESri1TEkJGPThw1y5Gb2lqnH4qP+I+94fHvFiLyD4/Yd6dfkHjE6VEPEP/Yk8OWT4449m3l4NWfTRhg65tK84e6r+i9FdvaErVmB3KvpV3xXrw/cPXFk/doIB8A0fJzvBMNwa4TLaDj7G+AOrov6CnWBHswb/wqKdYPAGinYGcAtEwtFO8CEY1GKO12Ler8U8wZh3tdjnd4B1ohDqP+PheUrfOJa35Bk3Qgv6mGOD/8HoPziYXLEJyJL+iUEwdjbmWB44Y64YflKHe3lndlZ8baRuMtqYE8x2sepfq/MrYWANVHf38kLNKVJ1+OIK02CBnHK5JWd8C2As2JTrF+8WOSoChFu/rRf23Uf1TLmitlpusH50q3eNL5VIR8nSp9PbJfYwgT6sS+2fPbu9mBbUVUo+TX1vOp1O9bT8oIT2JrYhGriyUkhcS1hP0QLGD2otmE4rIkYd++xZKUz+YG4HXtVyKcHiM3thMLVfz565UoXp1A0pnMqLBNWmMqyRLi8WdKt1BArkNB0MPXQzVcmviqh7VkRtgKkGjyoXCUqHLx3edpfwh+/9isLsXzNnVUVylkvsr4qSNuIeNupXj/yi5Cfw2XSHt7fjq2s8XdMTkLw9eaJuBZ4o6bnkRnqMC/UTIxmG0AOs54mVAj154vbCk2yDDFaic+zGMy14aj/NnIh4Y9aXPBU8tcKYJ4DcE29EFvr+Z716PlzoQ7z27yrw1Qe8iMH15Qc8tCsPR/oS5ckTTRT43QOyPNGE0dZZVraug5jDis9N5L6mzxOP/MPQCgJ/GgEXhlRk495lYZlx9QdoZhKlG6nEqnozVdfKZmOd63tlHY4UInPbZfO57TLEYw5l5t6IjJmiicyBJsdGGQZeZvsDlESJ0tpUVVvnLu/9cisvVNUmHKkLosJWXRTVqgsoU3gjMtNVc6z6xCjp6KpPNohIwWFwzVYarHS73cAulScnGuE7F3bLnuAA6JKaPxubfKLvTtfX4ai7sw7dhXxyom9Xm8p+vfCJRw43lOZ7Xy994pF7m4rv36H4iUfubyp/cJfyJx55sAnAYTMAHONlNygDcPKqFhsd4kg7gVmHiyEEVOi5Cangn0KNuTRXnuRzqrq0n+ZaZnhEH/TTvE29Ey/4wU9z8oMIyrwbMgoJOX9OFXCh55LFsBzVX/SlHXhTfnR2pu9HvrjR8DKvMT/L6VnJpPVZHrLJhP6DEZZbd2D0B47BJEs5lSl8g1s1muAnekSip/itjqH0XAXybE6n9vNVdkkvMCQzOtcfEPkZv6dc0t/gQzOJjyR9gQnaWxLlOqT8H9HUBuUl5yllOgwOk2juBF7nqnxWidNQCh2p/K6/wRAwZPRXpID2IXGdlgHgvOgZRszZFf0T881FSp/jF2y+Ip0+Tdi0oO8wGzD7z/BD2ZzSKxWtmVz6T6ZogUpP9ERTRj+k8EQHYSuhxzpgLdjpWOGfFheQeabT/3Hy+hV9rAM65wSDi1Rc0Uv81N4E6CcMqWeYsxSb9wijrnGPYIpALzm7wE3jTEKEYbXoa01Ay2nRtxijOQhafhb0i6Iaqt7MEAwIiV+kShnn3AKm+reg76QDnZafBX1hcxcvUqz3dxcTE/kyVdVITn9gukaqK6YfLAyI/eLWBRGvDIp6u3yFsOC8SfVvQZ+a7l9ITu1XQWWueh6mJrVfBeW5ym/nLa0GCyowh3XvQ19yHabvuR2F9AM3gw3tdOkXDM9Y8SgR05RP4LpAA3jFVbuenLyjP+tvdClEn+vQb7ayP8sY+pv5fvdYf5sVhf6SmiCsJPS5JXIBpyr82GLjMS+KLK8fquA4BDmsxMIjP7DADoxCncvg61YQmMXA8MhMBmbIIgT8uBUA5CjLf2KBaVLlYAgRmyUvvuND+lslMAB51yO/88B2j3HafOJ7briO/Te8jV7ITqGA1CRzsEyD2Qj5hat9IM/pC2cfyJ3V/xHJ87B6yUSfktyu1BBNX0OEZrfpWwiYN33osxSD1ixDR7gXTvQlZrEGHfR3CMOIfAMfxppQlgG8WqKJE4OsPJ04MaiXQS/qpVT0FKNxuXmCn+ciR1V3Pdvf1CJxBXpsC+EC8roSPBYpfVmJUUi+rcQpNN9X4hRGP6+XVQm/1KtR0c8xGu6V6b9UG8298j8kkcpzG7nZcONnlN59OVsWcplOlvkk2LVXhWuKtlT+GIFpCjyp88mHQBeY2oEHfo4iNBzyCunF6gVLD0wC99RnPvFiyGXlh+10hQJe3GftZMNg80xXybj7OquG5AYI7llVQBD1VWAqkwUo9FTIcmredSBFTsfCVyxhor7hc6GjvYBM1CcoP4zVJ7DyM/UJam/n6hPUcqfqE7SoLnJ6Lio8JsCdq9gy4rPJpg5NAblWEcpsICBnKqis6MmVzm28/52qsDIMCMin3PGVQS5zelMU8f4+KeL9AzKHP7O41yOTuHdIjuMoWpFjhxQneUWeZ12klOzTE05OcshLucCvyYQK/LK8DlMJBV3oD5eFpUWuIvVBjSa5yaR21YUO42U4nejQE5iKYx1Q3MHMFFQLx7kOfsDtYYohw/5MEBFtg04zDAEvOsOvytZ8gQVNaJ6rDAq1zxhSTME1fuMKcYafipmZKogKpStMUPvVqSqrvarRM8zncnlXOqYWRDZPBUpG9aTOqCpYhSl84ntO0L/Nx3XFVZKZKFt+mkkx5ijzHLMLIVlSBB65EgHWrZnnP3zvX/rEATynOoRc6RhNeyV+v7KCuH95RGiRy7+8RtFFeXsEx1HXjzvIXowWx9XXCoPgQ10y6Ws16vXC6H4YeUT4p7iunKcUnzUFzv6SE7wvosfwkWaX9DdG0N0iPSdS8dR/pqW/hX+k6Bod98UFMasNlVDaiMA/ExlOtIyYPsOUQr2gRq+ILLeYX1Pi+HKkv6XEXb2oUCWfGEjvuEVDbT7/TIms7FBSKGG5SPlLhRfn9oEXHZNyW0tBGXcgKKA/pEiHfM4S8YX/lgpZ0A9EVrb0t7DIwID7LGop72Y5L2ZZMqHXkFT1ck1/hersKoOdsApW5GY1Ivfi4QbNHL93P/CDvvR7h4EvAiL9/a7+6PXMx4H+2LNJkfnYMx/75uOByWyK75k8e7bUPRNjSu3ZKgwaezbPfYOYxdCU2jel9k3mfZvZoBGZPJEpHpnMkckcmcw900CTYqGYhMi0ITLNi0zzIkRLu6YZjvpMGRxKf9+U2Tdl9rFMQER4kSymIi3CnE9FIXnus4DwkF9dZLksqIA1QA25SfmSz6VIJ9kl2BXjR/h4xnJJBfR11I2jLomiOIpI1IujHon24miPRPtxtE+igzg6INFhHB2S6F4c3SPR/Ti6T6IHcfSA9Lpxr0t6UdyLSK8H+1lvL+7tkd5+3NsnvYO4d0B6h7DF9e7FvXukdz/u3Se9B3HvAdnrxntdshfFexHZ68V7PbK3F+/tkb39eG+f7B3Eewdk7zDeOyR79+K9e2Tvfrx3n+x34/0u2Y/i/Yjs9+L9Htnfi/f3yP4+bK77B7Cx7h/G+4dk/168f4/s34/375P9B/H+A3I/vk8exA9WI3J/fXB7sAgrHWCvX5KzeqX+s6vmX3lnPsQJ5J2x3COw9ElMXq3UZHrwvfWpjfL2KjFPU61R9zurfZItprN0IW+veKJzNVYdfWfVL0X6lfYmIm1ube87q3yTJSx/lHN2e70XJltj5XvfWflbNvnamMohS2Ol+99VqTpi3MyyzzyPb+bZhMceiNES7q1IgftQfHP16IoX8fAGUIiR5oDDRVYIABN7Z5mU2dwjYhJ7Vx12JYpO5K1G5Por5RJ+LlWp67LUisgsS6S4KOKbsdYPKuIbKWTiGKuXStUrkrAzXj046TTfa8vw6iUktz0Cbk7Cax0KvNVqtSovuYuwGDOJ0k7YGbNU5lmS8LyMr8ZCY4gMT3TirX2mITT22sG/0WsynPHkgueF24wzlte6E8mzsTeB+Zxm+bVHzNcbno95KtmUx+F9csZyN+IBmeZiAhOziG+U49VnNmK7u9rU66vRalUjLGAqQ2CmCi4f24SQX0meTvybCZPsl4QDZ/YOQMmQq1ARvuVjyWCQEpEKKZAZimv6NFy7COo31eEauBoARvnG0bwhnKZwJjnm6BaDh4UEbTWM00CVR8PxJ8KxQdvdFVEcXZNrAe0IiTAqSrAh+qhCt3nhab5IeK5S38In1Erh+G/fdeVHqbKy1ZyjJpHPhnxEOGjCkEqCg4drAIN45JTh09+SZJQ5DS0oqzSRJDD8F4XM5svlzYosaB5mFxLV4myn5KZT4B3vqxPkZxEMfj7N8hcTPwuvHl2J4sUTfJftelOmayfTRCHxAgyRqX4lF+Lxg6bKu9mEJ/RG58QZHhchjnu9OKCQlKmoYpiOyFmWT3h+8klcXPBJnISV8KAWjhfVMIE1aYqH+cdZkuVQvhozWIuJObTydzh/PVKNeZ0/UZPWL+qZSUoW9bhA42xrLEODSugrNZUZVS1l2NTwXkzkzNaAoUEldJcaMKNTA4aDFWHVYfuMZ3Mu82s9OOEEIj5n0q+PYpMv3nAOUbPKjAWipxCieKIUf0hGUb7wMyv4G3HFExznIEN5nuXiS5ZKluBIN7NwuaxMwwUVcGIaLxIm+c8sR9gIqPCFHpY8IJNaNiRPPRtJgj4LZ7ZeWhAWnrGCU3gHY4GfhIVXtBioiBlnk3gSwjLMc8LCa1oMTDAusxAWzriYziQmF7AqKi0mwsJL6ABaDFRErJJXpEKlF5N4gwZSuVrpuYklsXF3LnnllCzrvKWcsyaYKBdXcMddxeLbYLnYG1gnsJY/zhaVdbNx/Vbrph5oJSg90MzqiHsDn5CCOupkTK1Ger0yvhNi2Y5IYr3PoGuFApf6tLIcIzV5QFLYcFotpgQekPK7KMQZvoYPz7lQ9b48/nZbrcQ+MJ4qrMwz4xaxzHVHpTMtlxvKwetAiTqhmngjmEx0g0qCInniTUayFbIrlwTETLy40YQetvY1sguaGrLjUlAFiy+51Sb7IFWzIk71tIGu201DKcafTK+QgmY7IlxnjkhCi11GFjTZEWGFSzJ0WCgR7lykfrlglisllno3E+NPKS8KsghIc645u/rZzRjtdoOA3BTlSGUEMD6B6Z5ZNg6DRRm8YGORTuOsU5DzRZL8zHLMkZAz/bXAL50t6SwUwxinqxXZsO7FVd0s52VQkhHjgxMopCfLhCYOkzFWocoaPaOL6twg5/QVvvDnz4ZyhNHwBj6Z0vHaHLugE/VN5hS9+E6dwT1ttazvtYsAZlhKu/30SPbb7TQQdFGfYSmIV3CGCQWVUnoBgZKHXV+QKKXjUExarcX6rEzVm322OanTHP8cHlBn8NjOOfonZQ+7kH3epsw+jLmV0zFUicR/mqme8Oew4DTFt89hg/MzeIWoR24KNR5ga4lzghtKRvQOkrWL3V6tn52N6/ZNNw1xoOBSiP0ciuJxNj/LSEaFnYUIzpeWt6wiixrvnEiSww6chnpMmoYXHZoP1LyE6N1e3AWvqGnojOSdTEXVBvxuT0WXY9sSI1HEKBQxinZiyFG0EyTHJGeXawuQPlbgcqOGNXMPCdinuNZUGOjMMvDgSFat8OGYpZ8ZeEYXFyBL8EU4lldEUxFign5xlPXb7SKQVLGww2JkXkWXzuiW5oVNGSyXkCkE3P2gbytZpNVqghUpuHwOB8UTeZ2sH1dS/TgU9mhlTg5rXPkItkXNkBNWPSnklifr53WOlgIL9JnnP9d45+bo25jOtLEEEWpFxUZijL+GQxCQ3OWBLU5l1GA96i64lLmb8SjTHRyQS67ioPjv9ag744C5iajWEayIskr6/+MIIJkL8JbzY9NIOVsbJGffMj7O1oZGtn7Aqo+Es8ogOLtr/59Vuj6rHrHqPX1W6eSzu/bvWaVrs2rX4gWblQiVx4yfv1E2tFHS50h56tK8/5BUaUXMOIlv7ECJb6rHdIXC6m4SROepUa9UkTB+LrvwpNGwO9ICw0FKnVDMtSTByY3JOAGOaqnaaaqKG5b5RkFA0nXpZYkWd+dbZbopYMtliXja9uKtUty5Wq2J26rDYE0UZ8Vu33wgvPruA6E5Sq4CIw49/NvFoerS5O8XcHe/S8Dd/VsE3N82RIi4LdsEHVnrEVkZTbdIzyEkwtyI0euCXUXz75TtvslEKjfJUB3eqzxIwGkcZag85Gw885lroSNABaEqKeVEoMHgRkFp5YUfrFDLjqx4NN8s10waEq08c0FTd2+cqLwlmzimE9UjYkRmNN+0VV4gjc5prvqtzw1hU3KjRa9xQbR8NU5IZbOOz4naqWNB1MYc31zFbFC4bPkTPhZzlvjhQRAX6/y6l6GSdOn8ejwYgy90Isg5yavHgIBcx2yQ1GRvcbIOdQzFA5KziVgUMRt040WoAgPzESPB3uK3Pw7ITOgASMDM98D5vm0bnZTZiCCzMqS30NvuDerck3J+nJOUzNQd/hTfdkfOdxoWn8QFXeDPQP3Eil+fhleB4d2n4XVA0lIAahvaKDkJc/0w8W1jRFNtI69fOct95aakAkI1V5DUvqXTyCemdT4RX7xXfGJWnQtFSa9Co00zxcfqXq2EbutX5mYkORG308jJHLSFM75UQ+Ah99u4Ze4uTf3vGTUp4SS9FUdFZXvkrFGZ16is0Oaa0CPCKK9y5Lw8k2lKMzPRzEecOoQQgbNH3/s79miU4Jldup+GRn+A3rBUKBXpWH/yt5mEvWC7S3SEWta2oxVhaH7xFvLHEWne5BM+5enksd5pGxRsh6M+V5LMn44WydY4YUVBvZ/aMhST9k8dVd57+JN5+1aRHzch0wUoA1AcHgiehGb9AuNQPafdfn4Eb7/riyjjLTcPbNWJeHhUXLB0q4CRQb3yeNIZ46HjpzYCqB1bhvmo/ZP38GgXyj78KSAwYFstDRYCAdEB72g3EQ+dZ69t9CJ56EG2PzOR+p4XGKrFN6pR8c2UpzwHRTUVbtiYQ/etnbTOA6d1cffAZpmzC9/Cc3feukiuG+CiYcd9d4RbMY52NgKBbKtVVO8pN68SZEIbZhvLxyiY/Pp93sJfO0ESRibrp8oZve2yDsGUYQXCPTCe09tu4sriGHaKY9h09Y3kVzIW5FwkiVq+xqSQefaJq9CMAPOqQJ6TmZhMeKp3qMzQN1guLa1DlYUoPoKtVkE8hBv+LH2ciPGnJl5Vv+OkXUpXZAF9LY4lgurqbB8vl8NRYKZLeiRQWsto1iCttas1iFnNt0aUbtdjgn6m2UJ4xJSMFzJbSOdMetAleSbVQoRi/DcvdjrhARmLfLyYn/Ocp2Me93Z02r/P09utOLVHxdCu33i4S7+Ri+dgwZfn7NoXwcAXVIRFIsZ4VTLsjkCyHAt4WkogDw+MB6dcGRv65YockJK7hCzkZo1W3VVQOwKYwrVzJtTwnceCR/kYxuinE3Vk42GaZRfIIol0un6/VL5k0SWuoN/hmBoF9O22FTrz1R1OIUYC7AhtURBuLqLg4FBZXHCrhbumDuwC57JTnb8JioTgtNlhocwuasnOlVJBErhtvrmKu+Q67q7IuHIowtNDraPwxFAZwLBZnR/ZUayaN6V5aMb+j36Z2J+2qQ3t+NOHVH8POlE8PeqYUBR31VZ5QaftczIHHDFtnBX+FI4DGCpE6k+DFflcSb+opF8EK3JNp/hAW/eIXiyX06MSh1bLfh7RC3IGGcODMrX8tkXd5F4lnVxB8Y5N7dSK2gSnyKmuseNWWS8YuXVG1To/0RmYt5NLoMEV0NH27zy82oE/R91BFH8KyGcIf7ZhINNprcA1FLguC1xDARMOVuQYarkemDLsylbysFbJw7KSs2p+VcfDWh0PbR0n9EbdqYYHO/5xeNW5DK8Coq5Xddx15zK8DlZ9dzDvnqirWJLsnujLWD26AUgbgODyew3h6zYAgPBqJSryVDUD2JUj+fbHuDrCbV62kIbRp7ZJ/qJTgQGXZfAuUCjSdD33bFABA523M4u7qoTinF/iZkX9SsZOBV6wq9RT1MJj10N1Ty1CJQH9F52EVzsVKDbtA52E17W0cSgzyRK8AtPXeO8ypdSSVppeR8xFeycN3TXVT9WeAiAa6ZHeAguIokUyqg/+XbnM7QpsubMEF6UKBrCFoT1UkAWwGLDwtjO1DkN/TyBSZhftTC++EDmmhV0GycwNnNfV46ZUgDZE5bwy6MZS8xqDbszKTnnsLsD+udq701Gw4xfVxXnXWXsDclGpA7dBhOt0C5lvyOMOk8+38MSlIEmSm6rQyGjeadFR6oiOFu3cDFlyHU9s6ANBu/tHKKofE55O1OesxkRNiYNfPCdOi+ILzSh99s+1Ql9K6sp8cDjF/ea6vGzEfb5+2vZlQNY7arn0UeFlcB2W+DrdHVfiWe2qd5h2opGuNTRNJNf2k7ql29fVHq7ou90qYfgesQLOEE5uExKxfBysSHW92HTjXR3yglaF+YTRbsl44qQXTZNe0tRKOMsra65nynLps7a1fNTGYmxFmidPk7FsBSm1Ilq04J5m21Q6cFgZucsDYKHWNo9mnhIVwbpErUF6XuTWMTFRD0Hn+BA0p3KYmfExcL4rpzjgUVUa9tPA+Q6VU7OaxAdOYPX7YDg+8YdiwGMBrOlDMUhjYdouHAHO9xm8mO8tXn36t0FnSYbFLLuE2zvC7WcRrIygoOGeBo6f9MbmBWEPyDOesYsi3q5Jdu6q0H6325r67Uz9NgMx+9qhpeByw7kFqfC91x1al0rtdQXNypGNu5qumSz0NxyWyYRm1dmOlwq2PmgOmSlQzh1FUd5RnNOsvrtx/5xMlKecaavl5zRxpSxWr+o8lDwtRJYaXSsKcVDjOxPvV8JliYAk4Sl2KJ3BZ0XrO9NzLIE5IZJJzlO6gJBW/LYj5Tw0n4PyM57YT6Jri3NT78B+xU0juYItcFgG27o0aE1lYbAWE5/XY5bLcT2qooJd0RUYVELxuRtCQGWwoileUWoYVELxuRsqgbiYPGYXajfKw2rEoB4Rn9ciHIA6RsN8woqycRAYuIH43AmUMCDklFe+PypQVNRgPSo+X4uqwlVxGvo/MpFWm2xjBmsx8Xk9poRso1DsFuch/AzUT+xMGoxWP/EYf0ghOWg0wOIR56ETGlRCG8ask4OMTSgg48WZGL9IJc/BfA3WhmNYT/OwOWGwKWFDrc2ZyXhDAsiYDfMT6P10YSR94oj1220RZLUjwkK9KyrtWgQUNAtBufqYYj/zL4Lnes3GlbbwN9YFsCuXb1igroW2LtkU9OvXcGuC4gaOKq/oTVmdz7WFRdC1uJiFFw3IDgQtu6rhlqypDAEuLV7Trmq1fLGuhRUQ4VDKXVi+j0quHPxbKOQscIJWwpYylSx3oIqDiqFIGWWoUcY0UKLOQH47JbRI/86UUKxtVc9voCIrzaqluNmXS6dlKiq+O70Uwkivsh9UpLjlVM/tqX4zt1OzP6uxKBPLI4y1BjlYw92FzakkWiWOKc1u7SByYTM4Z1Hkv+boqedCry7LJYQW7q3fcplVFST6di8wWg4O/7RQFb7V8X4lbAsExIFh1RjWwTwXa5BslFsSjnPn36D2wcmEzGFYisGsruiRlaIPnB0f/DHkR+M+bSZ47lgDztaM/ialuR93zP2uYkau45ygNkWCShVGk4JZnYrcqpMkmlTL5S2juUJdwsnU0hfj1ZafhGXgLtAwIwIrg+scJI7Gpi0HV44KS+dkLWPdbGrpqWfDWJXN8Lz2DGFOqPUIyx13KzzJ9nY9Y6tVj6mwIaU+TlKOsrsQzw5PJKCroUNqw6rZSqF+glLSwqSyuizWloKkXCcmtEvGyp5kUTc5UTKB2nvoTJ/GytO6AL2Xon5lKQKi/BdQSnM0FW+1clMvTlkwJCnW76lEoNo2M7YkCyWznc4UKc3tJx8FQR+eoh+36Wy57MYT9Yvn8PNNhWVpb3J+1B0sGtS02udB3BA/aZ8HK120Ibm0TG5gy1yhk/nckqYnNVArdbb3BpKkAeFKssBL0yPV3QktKp1s9txSTAwGSebgDB2crA1hfXBstfwJnQBvLnnur6tjbZclPomLVRDgmwmZzFT3rsHdwBKn+Axfyh8v8s/8WAPwJ0GfJwXf0maBRNBJaQwu0EKQ0QlseDllpa59BRjc3eb8s8gWxQvJ5/6E8MBkzUkapvxKriUkGxaDgOTh2Om9Nxryv2hmawmvNmT64Ga6rmV6xa8kQAF0wquGxA8m8Rr6ayMXBe0Ox+xCjTQ1xIJb6Jcj/SxBNrRO+htSyAKvFMhCXylsbPomCB/IAu4fyMLcPzTSRfoNsV+pWxGtqeRanc0GT7ebO+GQs7KnjIraJYyiM0m0zZPU5kjPlQSwbvqUBQRGalXQbHo3aLVUVTAglXkTqcNbs3LqJ2hDmwT5MNFGUdlXTZ/432/6ZNXsHGXDW7c/rre/MjsR5OsHBwc82prcyeLqzoisG1ilf4OB1d2rrxjWpP+mTdU3VvvdZlTcTCB+txHFyhFVPeaRrBxRlt0X60cGUTsyVMK2QEAy/UXzu7DGFSiEEX4XxeNg3cxKXT9uYHRZULWXcjNXWF0no+pfvoHZZa7q6oO/27zE+oGiN8paWl8uQOtZ8lJfMaCxkrZumojiImHXqL3qGD3htWDCcrB2UmcNrWhZKYCG6fHNGZ+K9JH8g+cZ5F+Ru+vMdlfupWyn1EapqdL+V2n2/ztKs//VmP3/usbsRi3ZqpLs3XRc/+d2wzU7jWparsaA8XqTAWO51v6tKqTfq+PpMsGZo/EJQobK5EhKNbeq6iepqnoG/U0aakmn4lVpt3e7jlq2pvjZoLS2nimO/k4ttm9SOVMDvKpidqtmGZxmFqmkqfrVKOh+L3yrd5b/nXpnZl/a5DytPLPcpn6Wa3cZk9tW2jGtKDkR4F83KJBlVoGMnNNFePVY+Yia0kV4rb8vaJfMKw7ePtNu/zM6kvscVBatz7Bozc231cppty+0hlXhqDORM+qouCkPKqKQLB3jE6+qdiv/cRC9otft2Q7oz161fQfELCCf6Jr+2t0Bf5P+mmLYFo4i2zm5jqcV9bNuRTVNDD7FZy7/1KD1dx1flZpujemnelmc+GOSkrFWYCOsUV3t/w79sIY51uyYqToN0rqOmFjTEUvXJmga3KgRKW23uvphot1e4WXb96qEYWO+phK2C0YN9tzQ6/7d5wZ040pvqjz35hOE6/0gESlkMmL59X1Swf5Payx9z0a6brJdcOVCDb5xGa0ZhNacUKa3yfvIwqYjJcszctGgl1TU9JIq4bKEY/1SYlxbWsz+Va4oRkspzslpkmUXcA4zy4zpucyqINmvuMkBWAUxkmxWQVpjrgdrMXGxroKU3KqCVOGVB5VQXFRVkJKNKkgVfn1QCcVFVQWpwuorvZlMKchkNb2ZQkWrnzjBn7rOUlbXWapHxEUtwsGgQWcpc3WW3EBcOIESRrPOUraus7QeFRdrUVW4G3SWsjWdpbWYuKjHlJBtFGxJdsSbTciyVXfjqOByJr1NFeebNP1rorA1lou5k58kNrzhBn+hboeUGF577Cj9kRGHqbBDLluXtWU1WVslTLP16/ms8Xo+W7+er0e5JZ1V6c6MTuEwOmJQGEYxXoRX5Bpjrm3M9a2Kkk2rVFZZpdit9zLlLZJx6aA+BubjNtv+CoEJLIiGxN+jkvn1in5e88yb3O6Z9zZ9yztUV3HPm2x2z3ubbuhdqzE+eisrd0XVIXdUFgZu4A51YD6EXgZdhx2547Ajv5vDjvqcQOh1rx3ORSzNlcuN3HW5YXOUnjds1PVd76gdlrd+qVznevsbWF1HaCfKW1teubXl1VtbDYLBy8bl7S0vb28bM9gJ1990sbl+sV65yJXmalGi3GLz9eatcK6JtLebEgUejZecTTD0dfBX8FBXnRvLN9Qv7Onqb3eH6OxVtQud+p2gqDg5EXd1cpKuXxCyf/+CUDS7ZBTf4ZJx87Uh+w6/jGLdL6P4Rr+MzReJ7BudM4p154ziG50zNl8tsv+8h8avDcqveGhEvN2Bm5sxm991uNqRWrJGTYNwTef527w9Nu7c7OsuH0VVlfjuLh/X9m52u9/HisXxQNzd7+Pa7s3WnT8aqUX0d0stpkl2xpJSmklvzENqccT3CGcFuI72OCv464X8dcFy6ZEsfZNn05wXVlCQpY+z+UXCJdcxIMF4ZIHKUB8HrLwCh2OMPoLHizznwEzwC7CaW8zhq4gPu7Z2j+Q8nfBc5c9SC9iiUU+w2EACshC2hSc8/yzGnN6c52zOn9jW3iuvXIsYjK7y7OIpZAGRYc7/WvBCI8wmE1tRTUNxXeu5rLgwLoKh6VQSsVz6Fq90Sre7aHFJcpqZux52lPfbbQYqiBlcOKmilMpAC7vgtIFJlAf9TF1j8oBElFogrZY2nMUWWMSxaT5aiqZjnjQ1qPRIeC7Sifb2XG0ScQWWVgxo0VwF/XQbXMu3WvWSyBON4VgWBaRChgiXyQZsm5m1vvGdbJoIdZlvyt2GIxQlKFXvpLlP25dlsJOlEoo/EVNeIDcRrIgT04wL4fQJkzADLlFW2oWpZsfRwwj9hiITc55kqDtVpgbEDVE38KMi0eQzdJUftc279U5llYra1BcdHuzKsDLG3VlQ8XO6eXDoOhttZvEysz7Gibrhtzf7QUrh7tG5dEtDZ8JTvxJcLrtBu57FMn2VaJKGZqkIiOpU2An8NFRrBRkKko6IqCU2LB5kqPJVwD+kJfyBvwmEWWYMCFEZx4SZQZ6TKAjidjtfrYh6i16/Gvkmzy54Lq99Z7UshfzEs4RVpTwCvlw3eGGFo9I3Acf+eJGqS5hbQauuQ366MhTKNCqdXar3b+1SFfU/erPqc1Tyw/NA5VUudZmuCuLjBGRBJmQMiidrVg0pPp+R2ndf/YB4Q5Vp6/m745cv5mxqZFYj0O/NlstKhseIVSVH4K7CWwpLhOOnhHX0QxS7PZJ3zGMUuz2io4mJCgDdbV8dWgUcX49oNwhuiksh4YQZ3Oi9OuYhKgm9YXKGYjuWj33YZwTpktKrBEF3TwW32UCI6gf9s5yzT/0xK7gnc4HqS14NZEH3dsQuAir+yqW/B5ryxY4bsdsjPAQO9l3ms04BjWtPdvegHhQWZT5rN8aSvNPb0XFfwy+HnovHNFK4nPz69l1vR5B6+6EgvBrms86Y5J0x6e3gvwAf9QK1jsbUek34tiqfeLHSvxdOpeScss6MTGnemZELWibsiL7lprDbNRD7iJnPyTmZkgtyQcRub3Mz32Z3aakl+JjkVaK2xxXSr6V3xnch+DjPisKLN1VL8raogRUVtES12rYOO9Wu1YYNr1aYUOsxylzZ7Qc7wjjIKkRajXcQSEjeWVRQSEjeXgS1PO21PJ3FLYjCdv93U+X/ukZCxs2N/MaunbBidhvFvgJqZSYuqiZxq0pOq+pGMizYZ46q4m5FEp8b8LlSxOGoCM+1do6N1No5XAmLJFYBjA539MxrT3jmvJBZzlUuhT1d5/qtvp9r2e177Fzy3NOPL5UJ0pCBh6Akcx3ENiYNrwgHt8G4q1SiIWMQp1Z+r3OcKbkmSBffZb4Y8GaRYMwbLA025f7QkPsDEYN0HUacNtfXmPvDhtwfiGlg3NzuFSlXXMUhUG74jb1/1/sJD2445Wh36zph1z+uPW6pdUnTin4eMWqcVgEWY7kRuVCm3b4c83zK/bUjuPO8w5Cj6ZhTGDAICF9ZlCsMk87U5+qebKDvy2zNOj7Wv0WrZb5C9LMCiI2Z9G0selUJwvMs/wWk3FWlFJVnyEMxGZVVrJQAFpUJw9OyZh1VlKgL5zVXmV146qkb448fQit9R2j6G6VZ+lVsVJi/SJg8z/J5XyKhgEnE1+AVJ6uDjv4ERDB7u+awwDfjLC1kvhhXPcW7fuL7gnLzhndBs5CN/1qInMMtAr+SmNt6qcVhSRY0AR0h/fzYRIWQ6SvN28WEsnAhJj4KvOUVLUiuy1NtrHQuplSQXJWkE5JriHRB8tDRMqGLwWR3oSQTVkXNUV7Mw9Ozxfk5z4E9gZMRnEy0YzWal/L2hOc0d8k4BDRHNN9wqMiJB+N/47khd90crR8dKsl4eiBFq5UM/Nx5v9XXq2BuVVb18gD9liU85Hme5b73lImET7ZktjXOOZN8S4mXtsYs/Ulu6S7bGqs+2zrPs/mWnPGtqfjM0y0h+Rw0vTe/O6sFDbZhaSbFObxv6Z3x8yznL1IhPaXuJUXK1MtneKdxJtLJL5+1AmMpfs15cZGlhfjM1ekb3q7yQf4jQ54Wi1zpyRXP2Wf+4okqerYQyURFYxiwfZdlyTvYv4iLE245GiW5IuOEs7yhf1iIKSiXCfCWAQQc2UVD1nXpWViTGlWgqPZsMpqBxdWMTTSgUbMmo2k4ZyKVTKSPytENa5Uz2JdLHOeFK0Rh2kGkmC/mykREBJbRUlmyQbGbxW7G5ziXICecv3yuphnooKBmGqaCH5IAjW0utT2K+i2IMHPRZKUJESHaK5g8be/iyrORJpeKrQ4UHpBtqe1/F8bVZmH8ayarvu1cTjxFW48MF2jOYAZUlr7FhFZrPc7n6iFC6Fzkx/Rc4g3D0XaokRkhI7Y+JDfcm5p+5dTsE3gxkZpgn6l7U642HlLn68SEwp/l0jj58toc9Sh1qeuvlLp2S8GRP4XEVCUiBjDRnalUb0fDGM3N/onbSAZWMqndSP2MZmb79NPKrqqU9is2HXbju9HAY0kmdRdnk/WnmVYBKYFffztw4xdtUnvGZrUKgoBoyK2Wlh/b8qlRa24ygBKFvsEARTgHsL0098qOy0j9dYrMedhTvx64pn2CO3QaYu3KXQiiY1YgY93/2GzhWe4XOJuT4EagXoHCKQCTOrhGtxFaFUeFqJvSV5MQXnVP/BsxibNQTIghSEbG8goYY3lF1A7DV0E/Hy5wo1yQNLRUabUMO0UXWomhgj2b6BH4LnvJrrOFVCuoHpyv899wjpaKnptH6hCEq8rW3c6T2nubpmQpLaPcUbVCnYYcSaeovlzqD8p0mJvdWnVGqkZKpoOZw0MEbkAvNerqIFc27zeKk6owaEMFCRtRqnMGcpZnl1vQGb/gPv+T91Nb5Wz/5G2JYivN5BZTm/0WRIc/wS1ICRg7svA5nDSFwdnBFRY3aI2WhUeOnVrUz49MQj9XbiDSYT7apjQd5p1oFNxw4+2FbnfVKXhlXTPCJmjVcTcsl2Y1lJv6C08yct3Kx6Uw1gNnUxnoSt3K9NWPg4qvdmnLq7vMVoN39KqHI+if1BcOvyEMD6QGrAeTbTsKbkR5GEAGTz/npAafCDeNcj8wRBGbiIIoifXHk12iVMCXLV8pGb1qpJ52TYuUOfYjYcsSur7Cr7Yf+S3TfCLq7PagEqNumG4S9uU65sTetcpVLPTFBTZwtSIuls2Tv8IYqF5Q2XUv4EVYgjFm2dFbP5RWw0AJo/HTuOh2oWLbcKGyDVxLNnXa4WPotGHUi3N/nYU2ZWrDqOrzXzY+st3naPXCA1ntJ58H/TW+uF7Pqob0bVxrbcjJgAh6M+eSxcYGUK4a+kSX0TWSoRjZ7knX10q/gcINEHCy4514dbWoztX1aarGpKWvmY+GEyytmqqykrXxbuAQVl6NZcBCuJdiEi7FVrivtFq+t0jVEXLibZtrGa7c/fHl0kmltdQsNPNEc8kJruvOrZZ/Y9UGfL5cOgV2o8Pw8NBoEmSh+lijnfP+HtN5fjk/52MUxqjwSJ1WnBvInIpdbm8J+9qDRYoOqqDhjZoKWVgGGjUWihVc5q4duFylA1+QBMVcK3QiI4zrjMLfTJeu5ljEqnQGJNYeRK4M+j7XR0RH11m2WnAC20ahjS9pBAcKmbNUMVB+bf3QMyBnlx4MBzvwLadyllU5elh5sTXceTIZd2lu2FT9YRqtPuzajI+bmL1HZ1mfUBYh2Dgt9hueG9ELAFrm8jtY5vLmd0j47Rt5hYj9sg2VeNVf6wtstd/cmV9fhopaZ5QNTW9tXSfqpw9pt9/p3NJABzk/BZeem5YyB4tVpU2NU9K1NqqtwEyvwMKswHqq42ki5s56nNYIoTFg5aCsvAOvBqEzdtJqC8riyrOo5jIeSRT3xA16MTJE71AM40NQzitC9aKZNturACq+ERLKnDUgciMgveBjOJ2t6nD/Bf7I7gh3qE/i3uhusBHnp1mOTmWrl/XW9qOhltS8cOSZMuUuwAbM1C3Qwp6Uw+AbiaQH9m1NccbXbbxATTMTZAKnMBCXS19/0ZtV+cQfxiiZuV180S9nJYkqQ0EUcQFsVEPT8wIjy/GpwtpHAX5rR5cqcO0EVsaRaYNVd8VHm14HJO0SzW8BW3g7ywU51hYDHrRast02LZUrUs9yi01ndYKbYXGWZQlnzqgw5qODbfMVb6/jO5TG4HlFrOcO5dFjg6aL4YSq3lLM6XzCC5ln12v8OCqjOD7qlFATFf4KI3hTO8gGgtq9Y92pIBoslj2PkgV3oVIo+dVcqMQW9BM0g1ykFTm0kfwqf0E84ayw9xkFutgipgVUiVshUkEs18OCeLpqLyATnnDJt9zbgwJG9IrIDByHHu6jYsxG9SKsLJQZtPu3ty9DdnGRXPtOGmH5dIFrjJbUa9n3hpOR3T41p/hOhfybUy23IacV7SeIwBmnZjYxN1hxKSS1N1mKGWk+P5d03qggKMPTRBQSBiNKFFPq5JQhh9LPWTqBvtVkcBtfCg0MYljC4aFEcJMBu4h4vNRV+ZIIdKw4FCOarjZdRvg1bPTVBPCyTSCtOBrHqArQFA6C6TdSog+V21FUxlvZb00qkmkD+DpGKWyj5VG0UdF+3ZVoOvAqpiJe7NUV9T2czEo/V67p51IJjneE1rWtTWBR1dt3ub5hNoKXWInb67dtO3rUNXN4SIsKa8fX7/66hK/LI2Bq672KhzNEA4HBAiyWNAW3RdXodZ7aqV2/H7xekdkR2IAbaQezZzXCQhCLBOBiYZuXmpRKlGouL3Sxcl6inUbI6hcXYC4VEN50+bmBAAT0CZxW3uECC283YBNHJAhA7zvOcAr5aCzFZ07dAKoFePNsUXAQm6DmMuxpAx4ylXs4iu03DzeyVzClka9RUq0sxcHaatlPpenMiQxTBsCIgRro+hcX3rapvtXyxuC1qIwB13HalVGrZT9vAeq20iqfG5lTOZN8N59pwnYUWEi2rErbAINVy3cDID8LWZ6z61/+WrCkKDO5NVbxpDZPrqT0pTCSSqNfsv93vq4j9StAfxZGf8eEQ7vqGUX+2N+g0Es8U8hDJYZzMV3k7AzdwRGeLuZchyLyGU9CNxZ2POQjaCpWzhqVPNSpy8tSWMW8tjqKP5J+Nwhl9tvFBVjSF9wP2lw/PxrBQUwO+ai/CV9+NzTXdnN87NR9sTxRt89J4pebIvrtKVkGwksJh9ks12lcETo0HDvkMB21WvDXbsOwteA4gR3G1cVxjqq2JpSVOw/A2npzKpQ99+tzFFFqAwnhKosTYfXz4f2sxl4yuyX0p8OBmfqD1UrVXerRMDr0YGR5xLvILjziFTNxLuEXa/aIt0hV1Kjp0fO6Gp56KcuyP0gfIko9m1vc15AmhyX23LMW2aSaUZMP9FNrWKOdd1BO0rD0aYIOrICHca4DnOupdb12BUSuXL8om1mZ0hFKWnHY5wfGQIWbtwhwQ9PfNDVfy6WsGem5t8ng8FBMAmJhXTuwri2s66/AunZgucfdW/X+60cqSxx1FK8elZvK1091FoDCoXTSHq/fZrtwtOqZHG266dIi9O1u81EN8yAv1mqlfhki+nSnlIcAxway1Pp7ffzanb/VgkMHt4cN3Rek6tRBO8lbrdX7NbFDQ5WpqjK1VfLGKnnVj4RcoYXPZOMtJWx1za5+qm58Sm/RxvGP8gyNggRrCiSPeL/dlkE+lCMKf4DBqDYd+U1D2tKf0HpGfedZIv8onbzlm+9vGuqp+ZDCMa5XYEm6+KJCOaRKLyiS4OhqvGHcMFxEbUGwrpJAAoQfdDgCoT/21jalDG/uyoFqRmlAuI8/OoayALWei+t0vL6sxULZZt5Jvl1Zv3jZobh1Yf/ha5AMxRQsSMEasS6x1r3VatnPJuH1Zi9jun4zu7B+QEbXL6zDcVuRrDkPxzcb4OEnOObquFttrqt7yL/vzFls9v9XlH4C1lw20bs4Nsn8tMHXKl83gK74X6K3OS9BkDXD+YqJc8UfFL3NQUkJyhgx85rp+9/noD29xUH7LT2gu6d0C3B7nzQ7Vh80R8e5zxsTSEoyv2jwhVB8xYX6YD3KqaTiUwYrqPg3KL7iKH2wHlUHbjzJFGseDNzl5rs4IuM3mrtOoJXraCNXEQOdqJdkRkSHBTF7CDKWUKQFz0uVFkFYR6Bc0I2uTfPymqvbT3ErSpX4en0T8WU7xetbwPjNopitMRE1BNb8JLoN60Sl/E5HlcCr+rZNe9JFduHb/CfAnX+tBLLwThmkYNzAvG/a/czeV2/lWjs6PVvLb+rk8DVKdRtogefuTa4N8bA047mQhTmOH3zlOK6PPX4vuNPJnKTWaw9xzEjJDEz4qD7QfuLXhZ+qNwpz2iUZnZrBmh9l6MBcnPsFnYKx84SmwwLMN2aseH2Z2mNwAW+x8WExohNQaIQvAlfpk1bLOwXBC7DmwY0492VjUQlFk4AsKHyRsTmqTsiY2nPrAhDxCrRyBenSGAHOqPAXAZmFn1kCbxD551T4k4Ccq4gguEG8zsO5uPJnhAVhPj0zprL9cZZKkS74SiscnPteik8NYQWtliieilRI7i8CJ2ChLtr+pLMIdlgJSFFh1fB+sHFNoqw49MCpdF9qj5ioxmQHqzkjuqIA99qgLGfAugfSZvV/BG1caUcrgs6FbrcPkOHpZ8Evob/UF03DcZKl3PqkCpCDQ28C2MqNOgi1629VGhdKVRp9VmMdpnLRakUgwRv4+XLp22S4jCTAb5ZlMYprC2IZkDSIfZNbEJsLz9+wGmrR85usjqfVO76K1ZFKu+Ii15XwNUpXi9/rch5rWCUK/XxVBUrQam1IutYrR9W1CE0r60Uv7sGScfh3Lxmqb8xb3OV0M8KjgS/oBcsL/iKVviRRV9lsKKmP96MXaKmPL6hA5908vGA5T+WrbMLBRWEQCyqJqJl8uQbnNXWcVstLs5Sj/LYsxfDix3olm2RjnA7G1ux3wS+VSZWtHD1dT2Elnl8sJJ8Yv8Xw5FnSlJRh0oKmcKE4oamfBGSsnyIL37w+efHuxe+/nL549fTFqxfvPpiBulguJwPr2mEx4H5BJGFBPCaTAfcTkmEgiFWrVuYphFnpFyBH4R7d5IElU7ckokAZoi8DWBiz8k13EagbnawT9RmotrBOJ9CyxZTghQ5znulSx5+sz9rtDbma/A7oSVxU9hH91m1Gi+rZag04vAo/IvAX1GRytYw0OE1wX05VAk9XXzYNblwyDGB8UalFt90gXke6HFUqc24WsCBWhdEakdg9uvlcWdNjhoIc7jsjImidC8AL8LTdDnQDbDp41ijluXJFcteOkq6LrQ2uZSEN8i6iZBC9uiDzhttHq8iS1jdpEZCMskE6FKP4ZtVXFicF7JECI42jeETdzwgPYpWnksVpnp+RDVYP+kxT+Fzr4PMg3mbLpelLq2GZLZdl52eBMqDS/i3qebmblwcKIR434gWycDCygQ4pG0WbtFE39khau9T1UNQJxEjBmMcGBqJO6TQYNHUNMxqteYNFiaIUcSoZlGY31kgmwEPh7TTPAlgwBFwNmPdftyGEQhG4ysMkvMOpkg2uJoKY6ws39QvWKfWy4NCpWlLHEoAQ354FeibGENiGNJDJjN9/g0j9TS38GukYjFS4P4kb+rTVWluKBN7C6NVIBdbyKJo1UMPQCs2TwSZjo2yA1rmDykarTJT1xJGDNK4up0dmSxnAXUwM2vykgba08dGUsoYBVwU1l7B29aXjB41gpHOltNp88kVZZE3lCI4bQCRKuXFok+qJ2okAocsZz3kN+9rW2mrVsVXvagYWPRWGNUChMhzdsmUFNxyBajsjaVca64iMrtenUwYbulKWWXC1Wa1phKTUdkY6kHHaN4RTr8vL+uvy4tx39mr9ir1psaiQEKoG9wfv10iJy6Rh51I71FNQJKSdKHCQSNtRXxyVaLTbZiZD7cDtcJ9ZBNjKVGx8LHxj5aaiCgqdqC+AVxKdztcrN4w43XijgTLc2jrgjUuLPi8YyNAJNxwcNh73BK2f4xxANC2fWC9HEeXlN3F8ZaEWmbAHSFo2jUh44VdF+04JPFGFp6fF4oLnp6dVwCvUnskuXAQhbiEmdO1oaZ/XWG+6bLdXK9RvNwck2vCOrXIthYeRp0nGJHDCzikd7cZClsyzQiq9iU2LIXLr7KzwZYcHR2lZ7P0sS+oLhJrlWATFnqUu51ba4UfAYrb5Q1zw5uyKNl38hTmfLMbcb1zwVKt4MJCxdQ+sbqD1yePVL88eVU4e2M65SP+GukRarWvtlIN1FWKaGrc+03TQUK1NhF5ovPykbUn0/mOfNJGxfNgdRLFaWpJsGnVVNfi5sR5M3VSRyeHLQPmievkq6gJ4mYF/V5YWjWTbsd6KovvdQOV/wqc555vyR/e7u7pMoLdJfFgHHgGquXhzxhEPrzoyvEKZ8XVHhteE0dIvWbqTtsWOCEiuIplkac8XJC0ZziPnXcRWy8/b1HGZdoM+u+KcTPSLRLFav0zwZy4vOU8RvaJ5Gy+Rwa+L7NI3OPeCthOnsO8FetYJVuCT2o3U+rFHKe0OunF4gOOp9CK+6dBLpfKOzmNJcsrVk9EqIjVeE6pY5uFVh9WwzMPrDlNYkqSpUBZedfJaoSy87uS60IIWu37RTkAekKiv/oKqAbwI4P0nMtHBCQQnyBSMqdhZkBkVOxPzYp/xNA4vHACm4x2sm4VXAbmOAU+MQXRBwgcP/Kms7Vk9a3vmZMX+/eXNyYuXr18ZWYUOLpcR70T7JG96uXt9K9N+CDPqy1ut8tVLDdYt84Qnkv0z7pL5P/FdInxkTu/s+lXrPseHjjkwGoJm8Br4tghLV/jIhaWUP+wOsiHvRCPtnpbyo6ITQVzbxrVa26xSVNnRmbirjgF8BRfwiBsFpiAZ+CbPtc1zHewmcXe1ncLFfAl0IML5P6kpjsdRtpacmmTckXHtM1EgEytjDZxAlevG+GMzt236bg+FQ1po7xKvEynyKdoxqihCXBpWUQSRaWUrtFiQrpIy6zGikWIKM39BIbhrM08oq4Rn1E6TRWXSTEgvILMj+mC59Md0z3G+OAsIVrHYGe9YOFjfxIkJAsVPn6+PGdXoalvvOFrSVmvb7Vi8LvDtCOmYtKtgd8/W0PwMQVno/NacH2zO6875DrQ8WBu0Co2GIbsJDfX6gM3WPt+Yy6m+ravHBdq8wrCJJUoH/KFllDvRQA67o1gqUtZTyoDJAjW4j0HcUssR7a6DgB+d0jURiLUY81eLOW18gNT1i5NbpgAVOHftuIy6RJT8GuUDdhSFB4MoZkd7g17Mju4NDuKoG7MjGmEs7WE0PcB4ku5UQa36Ri5TMa6lN0qg0Mj6rAhnID//dcGaFcN2dBblmLwpTyfakTu+xGtKBWxzXl/u0vAgOIoG4cGO3JH4krLf6WgAncjCeLw4E+MNGDk4bcwW7fi+pHI36kQBlGhHLnqbitXx25ExIOjLDu0pMGUr0U/7RgyrdGvM2KnjuCM7UY2IubwTloaSJaI7bof8uhCp3EAluUtN7RWcN5eo4Vyn7aaiTViv0bdG4xOR8mbKWQ+fcjcqGeReELQj24gNpbeiHesHtF7aacemugHhun/RHbkbuaP3l6uLrKlmJYOK4qicuj0SdXd8RdDAor4JQGQB+B0HRCfqIgrVnrgdi25sgFV6po4YoIWdVK+v05GB01XwnGTjbHhIo4GMO4bOuPFGHTPuSqrBtNwAI9qpFrXjrzKnmwuXjSt7TgOSqvq4Hm+Go0vOXxJWyNqaoaQsUXivGx3c16aHUb+ByKqxQGkl9Qn3AiKO7FE/CuAmMyKcprv7QQw/fnlyUh+wovvRrghIxxfrnUSjIChHtR/tgPhgx4Gymwbu8Pq/pjVipzas5FfbURvk39uUnm5KeOC0Jdrxw72dKDwI/o02STPYvrej4jWq3LHkTnjgUAe05DbTpb++B/g+b8NHh5dD5c5A3G3BAGrzWm99Bdr/NGwVvkSAPt+hUXjQOwgMikF171jL0ubu+vRztqg/smBw72ShaSxmgqXBIcCmgopsR9FuL7x3MIh2/HvhwWEP97Yglke9ejz2YXiA0YAe/EC+8KApZy/sOVkf7EHmeo7DSpb7+5DJJfbGJsuj8ABom4UucfweYG6jS3r0kDNphwcrUAioP/ZB14SmTX5m1GMgFcEZ6M/FKr75dYzlUqde8rNPQr69Nc88+3J7huwr5YsN6Q3000UKLt+JOUfPciTie7uH3UALi6dcvuUJWu8ZbZ7mowJeemS5mIqUJWh6uFxKVBfRbnDesXwK6vkyLHKjEoqCjCnH/pmIdPo4ETyV6Io/wCc8ZbYYz3jRR7+4xspp4Kc0AcOUMWb/FxFu8APoJlHmJDKbol32OfJtbKHWTSHeBZsAGh30eBiAROqrWcEtcQBKLF/Nid7FIe/s63m1N8cAHnUvtF/yAv2SdxadMZnSwngnL0KZXXQmnVl5AnME6X5qiwW75zuZfmfC+iZ6wuHqFaWK6DM1IKJSXBj4we50JzMvU9xS/AaeHL2OBcrMjBX42llVrhmID9aj8OYtliGTko1nmDSohHwvS722yjVU32CvTnLX3ruh7gZr8EFjLGAA1qaxDCfcRWLCb0ci1+9aaQ2otJA5E6lUGtYN85CBleacXXWwezziqQGL+b1gDY5ySHsrINVTFpIqYUG5HnAb31VxVLzSUjfNGau8PllQbU18La+eA5iZUR467eykHUEy2kQy5z5ICYKzYMDK6xVGslrDGgj0PS3DuX23hpkJW2uZQqS5aSrt7m3DOumGG33ra+wanbq7QdAzaNLla1DcQ5PBABLMDat6D5kHalZZR8SNpN2wKFC9y0xq8ctlBFfBoILqmMRqFx7KFBUIhBuLcolu3sGhYoebx3Eo2+FEgtMRpU3ic+Wms+JLWShfymnF7TLDSK0rx1lee8oBIGI8bkddEOqqgsRghkQ5z4DMuUinmyRyvO1teW0JtW157VTdw6VTXsh3/Eo23M4IKuzzAsb8y1qBGdMhlp+xKX+cJQkfy7UIuF3oC0QOyAte1AygZgBgvoXZKQ+I1F/akX2336CD5SgDcKsMwFutUtODo2eJ7qCgeTjnrFjkHBrsS8JQd54HsZu51dK18IZaKPr9MwatVdWz5dLfXAVqnvXVBYa5M9ntwbhLHhoTklLrZUG7/cVR0l+024E2kmbDbLgYjfqZMXnokiQwvluLFalUvNaZpTYtH7JSiwXVrSGGygreLLAvPqEyCwsCkoMZiy9ortWSlDL96/OX7Iyjh+UmlYnoVoWZqjaOERQ/hGHCS1UOo7fZ9GZRQ0PBX3D9kRX9mgtv5ySFsHnIpC06OuavBZvkTIqxeZKEtwVJCf5t59UyJG2zTr65VJsRDbnNKiVtzHo5VaqEbMqY2ptKENse9z0aVDbFV0QHTQ8dbhlvR9n5lnof7FnOJoKnUlO9/symDmvjOlHT1bKAa68bYPbwT+Xu+Bx6bVu59rd9rrWhdKhJA6CSYYMSgH3vDDPhG2daa7lUszLvpym9J607Urrc2HSOUMrY23K53ObLpRmOsLroT604tB3hRdZmTTVQN0pHJKOgh0yY2wOIdauVrcXhPel2BU/cg22d1qoFjG+zEhX9gXoQxsvpOu/ZajV5j8ACMBGVdhL6JVJ7fml/2Hjpvz6o3jApeQ4qaXo4+jIICyYXOZPcDw+CcMLyTzz1w6hitmPQNk7MaNkKxzTi3t/r3ER5hRmAMYgyAQmvV3HWdObEgs1uNIh2zIS6uBVjUBweAr0wFaU+Xm708eS6MzoRBGpIrfkhEOq91Uy/5FMaH2Zo42xczWIirPSJso7Rd6ncT4JV5Vmb0ld2qdyY1pZpGYr0LUun3Of6nSfw/FFqOYqq6QZ3t5tNykakcCpURj5Zs+5KQOr4wMwQy+U6WiX3BiwlTyXPEYaPOu54jutnR/nALyj4+c1pFsQZpTSHl3BMe0CtoWyQ8s9VuqBHF6HkNoUaVDYLrzo8BFcRCerdaY+NAyB4FsRApYxsR6QAtZOSDka0MKi7V2/wpQJjvnnwGI/Dgja4Ybd2/EMUVSiL5FFfXVuXQ6XVWpidHwiyQB+WigSu3ZvjrZKiskgR3yj3oK6iqlX04T5abeW3jDXVJN2voOMDmi+BZSJ0J5EcnNoYaxACIlH0JRrn2qVqbn1QOng09eMtvWPPQ+67s36xTlV0TlJzlwYhGEjE+CKNG0cMDC+uvDPgk9px1QTGkE35pVVFAEuGekMs50WtgfYZKN1AGPaS5ESUzbSAMvuEAPjALrJc+o1OBlDdLefMD1CVrQwxmnaEew3AkL+vmb53eI02hK0CklU676oyXBxmVQ0YeMCEFI6jsqYV6l9qzORofpfZkVKOJlD+ynHx8gt4uBrf+rBkabW21dsow1FAshW5/htQ+mAqvv47UFqtjDHx/W/f/oxmbvOxvaJoXBpTr0rzUMMV0hvFFsY3pQtGcIx1y3M8oC+2/kgSFFJeIOOh8uwGTLrneJkj2rkb8VD6iqadJoB5RwRN/+Mb7TtOKQLBMhR7enZ4xHWXS9Y87sX73e6KaDdxqrzL78ZePj1jeOjukm4YBZ5JfpqlJsv/OTw8rMQ/ZXORXMfeT8958plLMWZbr/iC/0S2yhgIPMoFS34iWwVLi07Bc3FeAXMCZr1RrxKFbii8NMvnLPFIMcsu8eSFxDQuBG5WpOoItuGCaDjqczUefzpaJFvjhBUF9X5qy1BM2j91VHnv4U9Bv+qCYMNrBimaAGp4iXh4VFywdAuFHNQr3Uh0kBOMoZoKGLDLqTmbaP/kPTzaBTAPfwpIQ35c71stXeumDAHRGbyj3UQ89ILS1aGJXiQPPcj2ZyZS3/NQzVKGj7VfLutP78HfyXIKZZ9SPw6byWaf+aFgBbTGbsp1dqDmrE/OL3CtPaWcSPWQT215t4ZufJDGkjDK0XqkxE8JrChl+nMgHLAd5gRik7tj8q4C0oRj6f7NKb0K6i7f+rUHQKh5TRqHNptMfs6u4vp7WuiTH83X8QuXTB6eL5LkvX4HzX4vl+hP0xK5/Fwu8XVHwk3zzcdy2SUasnFzaFz0rCNjtk3MPjDFSlOnGNzSa1Nqk6plOinuhsbp4Lr/VzMZBbrFsy1C33iqCR7xFMreCD0MalvdjHaB8+2321kgKBtm8PbnmiUoSADQ1y681tH0sA4KV+xwzBx9YipDUTzPcvElSyWDt5rTAYoTzOstJf0Hp/Fj8iQgbzr2KbogdvOekOOAPO6Yt+sC8k4/8jWz8OOUzEWKKyQnZ5nyC2YRK8qFTlTNmPx3pEkoAr2Ar3jig1LcnMrc9ph+vYFrhtJ84y15ERC8TSjjXpJHAZHZRdwlShwfd1f9jZT4tNuzr2LYTDzUzdNyuDdO65Lvbx0x+Hc1zl3E83eD5/tVH1933IQFIGrxWGg2p0ImOLpADdRt5ix+S6S6PazEs855/Lb9GF9hvaDPcYoBHvR520rdn1MTG8QG9i8W2i/tUhgOMH4vYfzefkN+oTpjsBLnhmyT8vlZvdCQMZ0MJqG+Q9Fu6mmXnNMumdIuuQDjMbwUGQcDf0bHiAYsCud0rCqAwJSOAQn4vKBjjcdy2Q1iKAN5IQukKUnwnHKzXhFP3159duPMLdW1G6kug87cKHvpc+XGlq/aBf3Un6O72dT/jG5rU/9ah88gjOicwsPxnXPyieadaeeCXNLT3R45pjBAT6jPOpfBrj/XK0r7s/4IyBPq553jYNe/NmlnNu0xPSVv6CfyDl97UzLguXl88DO5JmcByVT1L2iXPKJd8pR2yWvaNbmvTe6zYP2gCBdEqtPK258yzg/6L6idlS+IelA7II/KyEfmtW085q4h+D1VPi2hPyX6ze7XZdxr+6A3VIktf0tn5CU9J7/TKXlPL/oNaBQlchWU3rbNpVSJ/udKjpcNOVyalpCvK+V+b9ubr7LgWSXL+6YsLtZJQGpt+1tb8W/hi5T/4AyPzlvSDfpv2/QDedl2RkjnJemq3F+cnu38DrG/t+kX8t7J/brz3uR+RfPO75335GfKOm87L/s/U0oft1qvKKVv0IliAyEMevTVJkI05biu5XA4HV9Tjf4cbKLKLfnf0FfkMf1ZtecXOmt/IM/ptP1lbYReg4iI/NKmj8nzNn1Tor5wKl3gTYVZlPRG9FZvQGo7gs1Ab0W/t9+sTNmryrbGzQZjYTmv51eindf0K/EYY+dhJUlFlQ/OPoatNyjP3nvd7zoHmJWiZHHr1yz6rXDlQcoGTvUXvKpyOmbjGX8xgc2bT0UhN7rdD02x/nBkukgGjRy5NdH3ZUAVT8od0a6Cpitut+GRhL+vbmMmWWJg+WJe4Ygb8Fh7IbpSNwhn1ovUH49xpR+mpN650MfvoyT5WvYVUS8K3PZSAylIokgz4cU4Fxcyy/HNrwVNXOs58BUqjhbmWoDRZCgUE68qA2klmEc13NxoMZG0FE/RrI9omRMz/E5ACn23k5MM+ns7CpqujhxE4/ozuOjUSzdfvRxUDl1kn0UoJpTSCv2thwGXBlqxAF3wDuF+DN9sUKcfVDAo6MZ5QhKamVa1WvYztIiV7oWq/asJlJU5h6PbhidzhyfaLsIILSXsYkIy7Kl+Zt7kgiuF7S5+cO1GpsD3DwkrBdjq60ZhEcvyvV5AHXy143rtUIuiFHdSpSt4drfrhTkvS0skGb7BL3jOhta9jvk3q8CsatHfIN1wVE2E65FHuGom+rmTuKpvwLW+gSvqsCdpse6WhLD1kUFymvpc6cGIL5ywsCZLA6GtzQGiqVoWiIIrAZNHifGqmVSckSLd4JvxOUFBV5yRc0yNCwLFY+Ho5Pi4Dqy7t3c3AvXy8s1EFBcJuwaRXu3pazLNxUTJ+9xc44qkckv/j8LKRKRcOUqN0I+x8pIKhSD0On1s9j0T9U6MP6E0UYrxp2OWf3qJ6xOYx33hefbSgWfCTYLS3kHg2Qyq0iesmMEuth77+vwcLmq6JMOPZ7aR2xE5q5Q9WyuzIkg11EGJb1Dkpwgeex6xNIpW2J4ivkG1kEfyD55nAH4u0reZLKXUVzZ00CVzkedZDtnMwRA23s88L/DxB6cH2EJmJ5/Ehfv9xpZBrGwjzW12LEMkNiw9c7wsL0J8T6LAGa2029anbHnUuNWho2KupDnSvtTHVeC1bOQ7dVRVnJeNfWtOs5oPswk/m+PsakXc55NdPET5sGrlYTI3P0Fn/M3yJV5eejmN2WIVAPga2JxdKcGeVAGtackxlON2ZF91aBJ5OKIZkgJAZ416DGsrXY/CPcngcsLlEzHnaQENRJSKtRj1EvFadPmk40sxF/p5swmXPJ+LtB5v32904hSAn8GdO44hFVkNYrl6FiwHYXjB/Ywnj7MUxjN2HmGwN37mucQCOocDbHM5BfcxS8aLhEmswEwkX7+2uyEFAW8uqAA/FerJ63Mhy0JP3YA7MJTEakWchLsMUSd7OUIbuvruw71SrIRZbILmTOImqZo6l0k78okWhnUd0RrmQTmZPiXKcm5oCVm3lJBJ+3x2ZaGg3TL8Lrtwg7g4uBFqUaBdTe5vptR6qTrxy6F/d8qXZUpoDfPLPDZQm2J3xrupmvrUvDvSZRkHWgnHxfXbwNeK1HFtnNZ3R7uxeFlH05Jyi/91Axu3675Uv1T/opcSHi4KXDIQo+WSW+wCPQi/u0GbS9dp1rhi3Z1mjcUdmn0Neo1oY6neFKgQD9lhFvQVL0uVgrj2aICrpAG8XHb7KNk0xTVj02rVV6HAXD+hv3Fa5eu51kEnurOIdJNx/wQ7pgUZa4Ep6N8/zZDefhR01iO7QeewP3k4brWyI9zjDcZ9RYEZFaWnJ/Bvic7MrSn5rPQDJFIM7SweOuthcJN1Ov2znLNPq6zdJhNa7CxWUt0bm6popgfVd/f45tL1QfVUyLsPoadCluXPxaZXf2BY6B2R3uDuEHeJWvWB88nLUYN2IarnCuPzFWeBcqBsmHKyoLkZIWSydutHxjT1MzAti8KDndQvghDOSOTchRFWDxfQb5MBsxucKJ4agaQfDMoNryMNa6csycqgYWFNztgAW4C5nj3XDM7jLoGa9Oa4nhqzpo3TmRILePHTQmhTW1WbzgKSORlVN0xrs0RN1vHXZ8oFFQ0a9r4uEpA5DQ92xkhcpF9wY6FgdsUcT3HCf65MlNoIh9slO2c+w8WSnTOf4ULpbGfaVvXsXLTnOxd9S6LSrZxDK0uaq0Bbw+DyMy6Xn1OznA+7I/KJck2TU02VgFzaDPq3dCBDjm3+S5O/jz7Fq40a+JZDQY7G3JCBrqe97R9c73xq78XXO/P2Xp3F2VRgjgWO23vIZLlVfNrtrYM5hkh9t5uF6kA5mNJuPG3X12tTkJixWyWvfqBGD7ZpUGXRVAft9tYYM5Owkvot1GM1ZdAqQtXDatZE1HThilSKbGRV9SzE959cipQuDt3o2iwm3VpT1ku9yy6cQiDT7wZrhF4rhfH1NaJSUpNovahKcMrqS4FuYFiMOy7VJm+5Urur5bpsGe92jejUAHGUU8pB2ZhjRZyl82tvhdtLHxR1I7VqzxyUGknGi/By2WSJLgev2KvYPK5hHXOj90frr3Ow7rB9UDEbeMIkWlkIeG1BTMBhpH7upcRNUbd2NJIhqO1fB7GMX7FX2BpcAeFxPtQF1pyzw16ohpbxGH6a5ZjekB/2h82POIJQuFFZRFBtG9jxU3cKtNPKIEWd7F07DNPqouentsvKDbQmKQNHIgG4gc8p25HtSmX4SHXepmy3F2j2L8WZ13bsq/NSKbhN09oOnFYmbNxdKWs8YwTpNO5ddtFOq7PIAk5h5rbljl/s1puIjnoccj/hYzF350flgqmPLoyblXO4oTev0JvX6C1ouiPblTxoK1snS+lOjLUpr5GF18mis3LdUG6XUXAswAplcbppWtZHp32eCUqqGE0lG3HLiWAuUlTJmrOrUoXXkX0OujE/6rZa6VF3kMYcFNvTh90BB56w+t4dd+VxeBmlR6N6qELzOyqTuuliuD0vGgT0E8thjiss5azKb56jE0dW3dHJlE5CI1slF5TV1c0m0Fhk5PBIjhrVtTijddNwoeBPkJlQj5MtwrqSL+jlpPD00DUdOxzjuMbJglSxEfrsK9CvKJ5QTiuMGqszap9KRu0U2CS2zvHtfOon4blIEmWkPccWH6PyzbmvnG9m8Jy4f9kuyWn0WXZYZV4+ZGY2scpsYtXZhPdcUdvx0HcX4Lv+3aAHQUCKVquGWYFH0f52tlxWU3Z9MOcOHhb9IFsuAbGAZG0a9acY2o6UtukJ1bpVVf5OKRLEWkGgc02e3JavfW1ykscbGEZUQYiNVkHnmry5LSMCVMnqHlW9KqLnTFV5V5z7TQ/qmJdbqlShFPyww5Up2HqkP2bwQnP6I9xTwsxvZw9rBaB6fztZLoug1dpcz4xMyZx87sOdqO+wBpYHYKG57lFu7mvhuKvV6Sp3S2TqxKg5M3diyosj8rkxWl21oNLd5ocV/XFoL8fgAmB6e168Y4N8zavHOCyvpsjCCcDC8bUCCl+yWIvSflLPyBX5RC7JO/KCPCJPyWvylryk3lxMJgn3yO/2U8/x5hHm/07PByZnrPScX0KUGuCxN0Z7OY+8pXowBnGtjIariuGNZLWUGeUK7feUrYl00qAtHAfS/izov27KRsZ1FidoT0LnIo2c0U/0HX1E35Mr+phc0jfkBcWdlzylRrEHz104UD+QL1RhXCEKeUWt1md/Ys5n/kv6xbZPUYd8oK+CWCUYemEG8oFet1+Bgt+XgVk2PsR6ffiAdPi5kQ79n9u0RgrytilnAynIGT0hn+gT8k7zLOQR1WpO5Ipe0hf0Kf15daw1DORVFJ8ReR3FV0Re9eJPRF734ktyFcXvyHUUvyBXvfgRue7FT9VV5b/i1+rjQ/yWTPVpYkamibrpnZJp4lzpzitBfc35meRGRNaJdk4VuFgSya+Qf4GpF/+OwUeJmKbxS9Sxsspix3W9y3EpWfGTsGCf4bInKacwyBQVpiQJC5lnn7jaAWWo8YZ4Ll/iBUAxU2DKsC9DtxkGdtkkWs2gIiGb62jA4Q2wAu12QIbyKiIylNeRAaxjexjbC0xR50a+CgDL14pjaSxsmow0yXkhsxzYRTIp+TOHaPhuX6JU0VWHE/3xActnUqXllvFIlBDnM/4ABKcbqawEdSr2KpXld99owGFFWk3I6ogE7nMvTD12arYiMKJXPA1K0DyvjWb0XcICwtooZ/ys5GAoZnGyctBFCPoOSWCIzapMK66qtKvWzndUT13fTGYVDnZ75MXGrVuve1f/P/betclx40gA/L6/ooe3ZqAaRTTZ85AEdA1Dliyv7iR5V9LZVnB5E9VEsVkWCHABsIfcJv/7RWa9AbBnxpb34iI2Qpom6v3IysrMyoeWtaQKf5rPzGKhb4ZwUPYz+8YQFF4LqneTQL/VSDmyveE3jOtL9s3cCxSQup/nwU3/mX7rbfOXJNgxi887m2xvG5+6vDeAcTCAYZd+k3i6GLgNPmSe1Ym2CikAnu4of+SlPSWdo/4RF7hWzf2T3mbEuApvfqcWmP7Z3mP0LyGC9oZIsh3cEn9mRmAzAA4KCkCV+C/0zzH7C0mjP7EfhyDAXBz62qB/gio/QpUOerH44E/0Ow8T/Ej/7KMAUFW1el23v5G2qh8giz15MWnQJsu3zzLqoajAAvH+ewbK3bA2DcTWL6nWkFQtQZLRnZOK78UGvxoKq9NeMGSt6qZr4wSRSAa6TxWN6/r52szI1370OzEDHQjyJvyAeV39rs40yyVJn85GFeZC1561XlA7k/3pgYsetXha+QW+aImSyzzHDvAdFsxegskJdfOWaphNR+PaN6TrSRBDY7quVNK3pjN5741GQmDolyjbPuV3hDiV65e/ERDjxcyeHkQpaq5gt+fK3dPd1VxzmzSt2MEL2njsfr+dEsncp0PyNRPGl30EkjOMPCDRfV8mvbz6Rgn2cVCTGUEnAEo+57HSWPdGkmtpIqGshCxUw5icobAHRrblB3+AoEPtRTqKVG8TLE5uXDkqb2bipeLiMRO1brf8YLxVRc2kIjcyK5gIw0wU1BOVFUQ3NA8SUzfmwqq/OoYS+pvjv2nlTI33bIber+J4T3SVKt5fe9K4fjv8oF4K0wb8C9CieuC1bDdbuRreXKNyLAaUSxtWRWop/IAA3q4IHYIAdwdFFAUbzuYHjCzT3Tm/3YKQDJwnNOAS+VInP1Qot8OQB13w0HmdQAhWxRiUvhnvxDYg6XBnTbeHptssyfLqyjUdx5zOnO+HGQXt9YEOs/cbWYhI3hWnk2SMFeMxv9srGMvVevMDbbxom9hDTlDD2elIpk9KSXII+ws/wmGbjkZxe6YDJ9w5JJFGoPRyLhe3y4lczJYp/DORi+kys/5cOAHBCbiw85YGkS1n7SRII5nBAmpFvTYIrdgIufSpL6sx3vdVCzXJGvcmZR8BG3o7JUDzwCltq2/kQeRRY54W2Wg6MitXXYZ+N/EwbMYAIIDkLfTwPZqO0F05P51u1Z/X6s9UxT4FIZD0YockbQUO80tRthIktOlo5JnRvPpNzOkVy1BYD1PusYEXuw2PxLX5RdDLFLdupro29bqiQLbLU17vhmwEqDTB+5Qeu7YHB+dmvmMldwG9O+C1iwL5d0f8DQ9d7xQ9ALeI8sJDe65TjDLvQek3izliq+B1K5KUw9LSoypTzsvLZaR6D6N+HymnBxvhSXu/ctHPPcdOnmV7V7AvWdFHo3bwRl9ZJOYnPbo085MelCRAJOoHPZpv9YPeV/nRed0QSfBN35lP7XxDRiIJk2g5oMjv6inXGn41TAlraRsBrGSGZ3/bEaJZetCS/O9uQ2iPAPk/7fhKrYT3RVvZFsKfbZhA39lvb76dtAsTtqXcjMOkwSm7ImZyQcrQ7FRPZpnch2rLzdv/VHlK40A9IJoCfhpdVxW8rHvr00mh71yCt0LdxAtL5Iq5NeqkDS6SV8asUpg0uEyqiFkn70u351Yq+Na5al1+rnY236bQFa+FGopI7G+6qupS1PDKtG8gw/ukHS8sAJVhCq2g9/YIdg3KyQyk/t4WSkWy3Ret/L/E0SUaWwksC30G39qsw51r+6VzlARSJN7XOfTQ5uGmd2jLiW+Qkt1eewiGowc+dc5Q2+258JnwPKw07YxRu0B+u3GfqN1h7QWnJKtiZir9vsqPnZJekvZnqyFbJ9I9MztoUnLWPWYr1sEqG9aFsUzGrLnOKfyZR8BaXIdnLJ2qvIGDpbKq6xX+mUeVqu3jKSyxn/eATWdcb1R+tFdVQxCe4syVWwX/XueO4uG0DF3oatOweE3O7npHsVPhG1jlVCQd3BakmMNdaOe+avL0gdCh1lZQN7wIvIReW96ma/tCb9MJdLJmHaifr+LbdGqaqAOBt05sdbtQ3yYhEAYp2BF9ADNZWNmh6QCL3sVqYVJvSioLOuIxniN7eWs9Um70SKV3GKvOYVRxI6U9l5Tbn87omtZO7ljZn1mZHO+MEsW8UrK2tEyOb6VOnJhcZF6tF4zMqiir6Ios0uJVLWcDoSnG5AOBqhHXkZvbzPTMGMD+cDDYO7YBp45DWW83EMP+UjWtmnJze7E6k/pF3BUF3nGobKyLvNVVzjQfLGeaupue6erCwNZztbJm/c60icrkQOZRraWVdI8JoEfkb9QqKsE3I0kLL1u/l+XP1siUamybvDPKJIZWVLfgg6YB5+ZHWhty8EGTg3PzI63OgeMfz/yatYkiqo+o6OxuwMp8GZBuWBvegwUrddd0z0rdFSiwxBVdsSau9IidekAxl9ZjUepBUgESuYnbfkKN0Hg/5zHLUz7xHxb2cwPUcZ6anze31GtxP7dC5GIuoQlvFKq3nKR+mQlbdcvEbIVBMiQ9QuBgJep5zj9BW1VFK3cNexIlvy9Ejqan+wZujSG/e852lT+Kmj+Ijiu+LqURGpB+Tka0Q6iO7qsiH4XE4+0AvfimSzyP/o/1eq0rKpDRYO1fa7cd/kJXcoS+rtMlDfWowmvutkebvemRrLoDn/jTXVjm6A09uJ8+vKa3Hmn3OiTo3tA+/WU6C8kw2ASP8go2gIwC2suZraINrbL7aQuRFkpXEtd2UEMSuE4m1GtQQzmTcyMjSLXZifGtahxsQlzQrE4UzzsvmfmZclBTq5U5/h2Im0omF/p7aV3hl9YKyBufu5/DFNWwTlKP1MORvK1jwTbxmWftZfB0GlnxSwkDi9kovRoRWoKat+LMtYdatdbDnXTcyoZdodYipixaPWdYTuWz1+BPfz95SEl3jhvvEvZnvW7BklgaJly2bxBoTdo6+MIqQQG0Z/5ZoY8Bi2ZZylbyAkC5584DyQfGlTKkuSzUc9DPIcj1VCBNaQQ/C724ZB4AW1EOrx8wMEkDSrQyaS9lVkwmDr6GSviOrRsGkjtOqPpRmx+VVuV0YDk4ETsJNwGf1NSdw4xd/86XdCAMdbLyObx3LZZqAEHXnjNw1SiTAyMI3DcXQ2Ed1CuGxhNgt480K/zAlYPOszKqDHVrzHwR8rCfSFIYC6FQCitTrQzZz8YmjR3wQBNakFwBeVwrty4WqD960R0D94+vuT4bH4Zcb8mlZSxV7SG44459HARqzZFcbqDy9rViZVRRSaj6wc2PesBs3zrAhwD5OIsVy93527BcH2S6tj8Zj1YEdXrf8VUrHwXdwW9Ab3TLDB246cgCN5oQO9NH9nSAbHqExDM9Guu2jbZm0bzJxiph36saeG/+lR7Nz1/OcAs9uAgsiRZwsBlSqQdYlHtmEZh91hR1s1jZr2X0QPPkHTo0NrECjFe9xdLGh9izBxcfYo+qLe8UfMroAR6DSbZK1rJoRT0eR+/YO/0RDQXcMHkt3ZEzqhDJVmx/qupW1x1w6W3rmqKQrOvrs/wuOMsHNbyVdxjcPYaJUU5bmDuycwS5UIU+WZ4YPB29oztId8hLZToEaEu4PC/Vnj+VZU+wyVdwrzIVgJucgx9G7j45QNoxTDtCmk9esVXwSdfelBt2oGu8hlV0AvaOHlmtEMIa1EMr9fsImp9NtKZHutWvNA60puaYrTWAs63+AaMzKUeTcmCPyQHH/Zgc6VrbbR3VX7o2dltH/cPM5q/sPjmYj1/YfXKk9vitqRiPV4mi390vs6FrQnNlCfAVVL5o+xJK21QK0CNU2QtgGAZcSS98BjwGlkZ3hYNmG0fNNj/tFtJuw7SXkPZSXZxBox/yvYUcnGP+cvjyeb2V4/U2jtdbI/f4gNzjzrBvdGtd1gLa8JiyDWnYQ7wFTs0wXit40F3DLTrZA1FBK9bEe1qwZrLH99d1vIPc2OZOMDfek8wEd/Eb42wd5/GeSsaxQR7vicfW6RIQg7FTRqXf3Aaplg3dkIoBB1xh7xV2/VTBZCAxVonKjLTOYKCSPZ4Nvz5LJaiPcnp4CTz6LK1AkbShx5dpcT5rl0Id3iAIR1UqXKGsSQwSlp5GWpl47whUdtTSYA7WqikUlTYmRXNlmfRU10RUdp9UOKFyQHRW0bInVyz7ckUcxJ7miOr3bIrGLhrV7+9y1GWQTj+uXuyXFAAMLNfgn5hVcUP38YwxWxEtKo+xmYfP5U4aope3S8J1VjcQFlc6wbwgNPo76yy4e9K6uN57XMLwDY6TYI33g+tZ07IrWS17klWtFjD1hVaCPKnWtW5nmxzi3Fu/Oq7OWWG0mNxNQ7W3XTjbAQOc5Wwzr30prKf1VNHGymEtpbqyl6QhS1158gT71YWxwTcSDWqyKDCknwYDWgN/IAOVRmzC3T2LZhkwddyVH2qpM5ROO513nd6YYlQ5jme0ntzC/2GLe0JXiqi2K6Io8RWkwdbZrbCXN6xfmxwnrFKg26WEe8CrrvWsfx66D10d+PXf0IYguLc23dfDC7ig7D/nveu/C16Wqtf0OWj2YLnbUVyGDyngH1Cdfifj6S4jrU0Xbp7dTR8EOB/CsIBTzS39NziLd/Xl2bjLs8DLc4+XZ24tzFfWtJxuOpdwJgNNV2k0XYt4Q727qhmPlQakoUwwFo4eIhbPJ1hB9gPmFXFO9xT/jTeE/ot3eTfjsbtHqw/3QffxarK53Eu8onok8YpQT7r74fHrOkMtq3ZN353hGzrhg6NXsx9un+rlln5AQX1YIkKD3QdhnHRKxx2z0ZChdkSi8IhEowglDF1sXey/1w++ASvn7GklsHICmT8BzB9nVsnKtnWXTKczAnauJgVZYP/BFXwH9V5rMTH87L7eQlrwTpuFYgMtHx+PgYezm+FOaiSpoHBIcV8O8Ghs39YknH7vzdpWV2yUqsmJS0cuqJ+smSCTQc7GmQPGir5gWU1LJny52YuZsrV+V/Cm/RLZdBZ8ge9UL2APY6xNQEtqLgxbzxbL1H0IAwoPotViwObLFof0TVV/X+UY+jfZYlBkEHi+KIIAiLYlGgyD0BfSebEdGrKuVZq9OZ1KzfGAcnPItrMgDKD2USs045RZx9DgPV8kO/lYtXBGTownhxfMFkwOYJp69FOORkQtzxggZUCiwJ70Y0najXfX9mJO6sAbVCqTGVqzKRKfAt6AXRxKcVdmcSyMVK5dCNRqrsBp7oY3xrRc6yJW5p3HcmwE9QngIQ3+HGkc12dL/qe+sfxNTejRT+E3NQDeQKCyMGYiHABUxT3S5mLAQBtEkbu5yTuO3pKfFEXaghdeuY72EL2uO7ec7ftRAVesGA46GAmak2x116Ad+YqWbE/OYLZSGt9T5cBK1WyDM9nYvX6CUHTH1Ave9fL1b6HNTgfU8LIyMTGgEl6v2FNX5l8GsVzDxx/z3OXefG5BbG8b/LJeDcruUeKKwcwu+gP3HihevBDjsdU8hefpA70ldzZFJDWSA7FIMLSX5tBvwXNK2e2lYxVh7xTp4uRwHa+ufCjEN3W1Vfsu4eGzpce0PKPLjARDCdOKcQsMtGFwuYGCAuYVTCaizPEjK+6ajBSgFaENrRA2s/ptkZF60kuG0rVfWkFr/RYu7vqOgWOECl7/ZWnpIfB5xmRS7VuTYkRG+/E4P9sgsDQE6UsXMMpkBItab05xa2cEGhEli1p/BHHr9w7qERaigTmwnggEuS4Vroyt4yhIOytZey34c4PK/MiZ//7tdRS5UU380ZKbyC4gIdeRAyJ/mPSWTLwcbzr0FkjmzpH9wHIFq3V5YHrtvGFMgq7JzW0cJHz6Sn46cYV3uQfAkgkHwN3w2LxemRiqVATrWVLZL+Avq6QlBpIJYlDTNmQqApai9fiJQKePtj630uNVWkOGqgb+z0qWbHQvHkUxokE747HpHg0+Dd5980/Du70UhzaR53xqlW/TNHlFPwonKwT8MsDQw8ib74wawr5tRx90l62TYO10va1E3ZwV3/1e/LfUaAR1A2C10xfT4Bb4Thn8dq+BQfA08ZAVaQmkhQLMglU+0O5ZkzQ7Xv6R7xqaqzxZ5LUodYRQggLaYEXphk1U0O0qeVdU1W48zq2MINeWI4vpklDPAhYqfsV3rEnCtTudVp2Urql48Bk1np8GV/eC1XjTc+oQVlFpuh6CtKlh98hVsEmB5Xvjw74rPGQM3/gH8XTqgFRobApLTFs2zdq73Jlit0SyHCwK4Wbd1eJRVvvm21Zs4RVIPZ2rXVY2I7WOO+1M2U3EU7phLUqmNxhPYc7TfLFZUlsB4qy3EAnkxf500mV6jaBac/nIG8fd6iDGVI9D9UMysw6hhbyHHf7xiOK/CdEjW0fy+CYqn9D48aMa78QhuBBQgQcQknF3DDFaMXtSjacvVfRijVNWsl6BrXgX14XobRagt5ra4aUz6hF/6Sv19Xu/aoCUEGUNEqc9irHzeBWsYxmsY4mbFNsUMSk7K1u6BY3LpLYrSwOiWFATmRh/qojAafkJdFtALWiudJC26pBSbjK2KX+Un0AKDYzAhkRoDXy1oXr+sBsxf+H1zWB0A5oeOVOwMnFgha94evw5CB3xWjjSjQNibeS/ZrNkOoPAfVlp0EkT4MEyxIM1bQbdDzj/AYHki3aPgZ9JaONRMT2JK3Rm1ULA2KxKDndChxPUPlyu13cViA8q1H/GgILGow1mHUlQb/7AonxSJQdyE6kU/EiD1qBQlRwmObnBvzqTpLYTKLGaQOvQTFvt8Hca9jweR9jQcbLCho4Tk09Am8Pj/WfT6fUDuZlNpzRc+024F0Tb5z2gfZ4xzwsWcRN5X8Plkf7Em+DfNCgA+Cluz2qCEx/ff/4b4Ht94DwzZHXKeqFs2kDcQStTEmVVUetJX7SScFYy2CUQwlXJIW6MiHMrS1h1WiX3vBFw31q7CS/Z+T0CWsuoEGel38bBtiH9NlwyZ7C9cAlUyTEuzn5ckRJDaHAdUEOaUBq1C2/TJZGlOzK1WLXIcPcFFPIyMRzqqg4WBG9zO5F7iuzeJfGj7fXD5Kvdudbf5UV0H7fkd6+WZyt568b3CshaD9OB7Ml/OAEZVeKC2UKg3D0uO8iZElCl2CfHyd5uHeWQEHsJNSvfivksncxoxWa0sR3oRTiddEDRFNs+TPYGtFQXsfvGzig3I6gZNMnfStX6UMs23GiuAHflAMsJ4iclofZLTjgYjrP87Wq+SnP9HpujIYaItZbDC7CB31zX6RRwSRlH+k0G0yc6Y8dkHOFzkC5eQeqW8Tgy4zLlISdbv2DsAR5mGehabAndvWBsC4GO2ZqW7IGcuz5WvDfOHg8aktP7gK/1afJcK0wsFoLyJV0IKpd0Uep/+XJJj2xhxqs1RtQjlwn6uqT37GhDjzVgXnav4/NF90z7sTmwNkIHR5ouPoBL7cNitnS+FN6xWfbu7lX2Lo4JFH/nOY7xiheGuc7HYzNJeNXRby8fIcFpEXwmQJ3Qy5SXeVRw9VU6ZyWmgY+M9q12zzMet3fGQc94LN4qFz3jsbizTnqcSJ1ekEV6Nu/aeewLzSOEovQa3GJWKmaCbrRmIuJk3r5llRtPlWis9xaF5bvxWEKiHo6j9vpCBDNDZ/AjXLP6Qj47GvHjGsARYH0zgg9Qlvhi0N8+5XAWAs0wmRxAogVPUVJdBje3JI1aSDq4JFoyCYyclaV+ksBPex+/tigCFANafX/9g8Sp7wDpi9/UzwC4B4Kjj6lUqoCFW96uNtHN/xP9Zx6T6D8T+DPfHf71xu6UnKtXjQhcSxDjbChwQeDZLDUqNp0Kk/Fl29byft+KaKTO4ojQupeFaznCCBjGvO5vDTOq9emTPsacYkEbsc5GkZM2pIMuaUzsdAVjZnY+U1uU2V9wJRTV6tcR1Y7CazCIgL/m1QkWzA7S0ksVKnRgMqvQKZiuz3V9TkAFboTvijhiPSrixTdS15jnJZs3O7Fqf+StrE6nW+I88zRqGGYZu+PQLTbWkiT050CF0U7RAAcPnWlLcbFTQUveykeR8tMJJkEPTvu7nJfKQuro0uRcYlrgYcEDtf1CvaQuTyf1g3IFfj+KAjvyNSodnEUlFZTDY11ypC0JjKQNFsmrFWp8J6ta8FZoYigayXXNt2JkGxPJquBN8wPfCjbSIDXZyDwX5USXpUJvy6ppQI+FjQxAITxkwLevi+p9qqpl6rpMp5nynJ1OMwzFlikH2pmJyJbpQG2ZBsbZdPq7TAEi/rSmZfy+qYp9KzJkw0Q9wYfcJi2rUmT/PVHuLiazDAba8nu0owGxVpHwPMdn50jQUVFxsOByKMbPTlZV2Yqy/Yss8+r96SToqBbglG8E8q42Am0n0bF/9YLYeYcR/OsCxwyvNhXzulOud2UJLortb/ZiipKq/9qLpv2ylNtvYMWVfu57HIs/YrmOeKLGVRPrJMk2NaMikpEdOHgDI+Sc2SqsjipgoWTZiForZbsGUfJeN+1XIJclgbEl9gwXmJ2n5/ZEp5hm0BG9SHa8FmX7Q5WLpBZAt2CzkSC0X4XBESE0F4VoxZXL79kr0j17aqv9aoMvHqlSScir9+WIYjL0o1Php04VZa4T97sR1TCEV6dO1oarOgfaC5rW6b3Gdfp+12+9ENwWBpUJkw7e2FyqMXR/4qv/2ssavN614tB9QR81yP0GERXc6XYqFr8/fptHLUlbT40OrewIxa1TjLNK1R+KvJQM84GgUAMIv6LRbe7QhQR1IFUbRb9RqS9JohAdrUUheDMwFwcyqrrW4AgAqmQuJdHXWrYwuNzcLcuLcXwlKxftUqN8xphUaBp+zQ0guvu0BblH49+wQEY6XcdSoT2MBxm+R2uEuCiXDMLQaxUmqz6uIVl4kEwNrvlONq0oQ33IwJbZrY45yYwxQXwpRBVJ74DRkrbGHVVpu1Qxmy1aOhOM97Srq4MUkGl/Y96egc2jzOPRu1EsloE6bhnxSFCw+cg8lCmpoHtypmpRf7OpNZ2pEa2Z40/s6azCZZu5PAGmrf3xZxXwN97QcLiVczb46kPx3fWQo5dfkAvEY5vsCt6CyzJ28QCTp8sHAvIuA4Wq+czSkic0uDQyUS3kcIOiEiROMN2XX6Qvv4A5/1bRn43jROP4we4IMJeZdVkPlCG6BnjxomeGCtQthNG257MONLswc6qTRlUtH2SJHtUk2/G6Ed8UFW+jms6mhNroM5KMx56LM0ng2Js2JiAvqBfT5Xg8is3v0ymSTIAnuxeRBFA8neTd9HSSb1lJxmOZNe8lEP01eVrxRhhOPtVt4i00yjALfSvodFHmOhW81o/SzjQwR3+k+KEaUr+hcmo400zLv1KrCTL0XISv1aLwd0PqlEa5IQNQY+plnarrFhfTrBwnxAsBhOdTDQmI83nN7H5qt6HKOGEujQ/cIDnFKVyo+XO1m0uMluASPBJapWHoktr/SqWJkWII4nnNemkR8crJgygQ+sIkECLb/mob6aAOQy3UycELiABvkHZHYHF0DCR7I9fjsV3L2q6lYLIbNg8UWue1YRPEHH6kVtEOV7/HjXjnbSGW9pyBHafS8XtRutEpVSQdob6ygqWaoFApU0qGA2Ot1eFqF/Wyq2Upk0fZyPtCuLKVenuv1UswjMapKvmMjrvyccQlG2lTd2tGLxALqHVw8CgI3F7gL2Nf5rw+ggxyUS4RoYW8jm6mHY9ftPgI1KPRPVMDnM14zJXiWGvEaWIxXYLSC/w5wpRmWX0nsziuyb7z8NxSsaghQJ1Y1Fr61pq8csEnM2hI/8Cm+GSW1W+n2WQy1FgJy12qFsEvbM/DCQyeatrIOrqha/qAtmrafdSWSada8Qj+To/wzz2b0oNWFg01gQo0Ud3FL140WYH2qQXJWfG7HV0xsciXWpy9YWW0ojmV4HCoVoa8NQQwWI/HD/Ponj1qo1EIbHNUvzeEpPfj8WE8jrbzaD0euzIP47FXKIL5P9IjvacHiHRwYFM3dkKyILurdeRkt1x/ReTcV8/ZFXsM3AQFRM2ednW14w+8FaDvkhnewtRCOwbDaOTsSYPqIAWrwVk7N0KXDR2vDvi0IkFuJBud/md1jCJ80OFAR+sunC4MaFo716hqf+eDpr31Quh9UlfoWZPf5sgMDttk0pKB8vYB61CJH0f8MJ33DZGfVGlA5RiLDXTYLG3dJkfwD3W2HI3M05Fa9pGLu9yItulG6q5ppcZn3+9YVOPCmNVpYFGIAfYNqxK7j3RtrK4LNs2KuxWCMtC5nd0okMI1LcLDMkwcFYjVpTke7/0odR1dKIgGyp40Gkzr/pYWROlRiWhPC7B/UuKimooi3QPpnfyrBsKcro3L297Ac7ZeFEuQzUc5ghiT0ZoWdENobvdOBajKky3f7UTNeJSTIIg2xB0PHroC3yNb0XIzlszpOUtUvXKORpSGjw+VaFSo+qR7xvsP3oU5dYEqSYWxVJ1lV6PDesJ7Gqd7KpViF3GK069ufxNRrpU/74E+MNoFc6Ek0T/9x48/36ZwIFTETZ+q8jiWUry/apPv0Lgvelq1YI4Co9cyyLTU+yzOJMOw+mv5sK9FJKikJUatV57OBeBQoa0EmRzUyglcpOP6l1W1c3L0LnLTjVnZ7oup54sJn5is43bIq8WjqBuRvpjR91rcJl7SqvyquBT0UAR+acxrgnHvJrtHrIQlUAJAZuS7+nv+QoYnelEudZbGQMbagpxpVf4byBNVhvInlD6ZfUpfTa1KymxKtYt30Y037qE86NIsoheKxA6FzN1vgG8nUkA4MEJg4Ny0eyNqb5/Ub7DL4Mx7SYvpcsDRpl6HF20fq5SEam3GVPR0F7UCos2BDxqqJQZ5RgVRKyDaPE/b0Lxrhj44qfcW2vHb6WmDCV+HJ3A8DC9G+OgEnlHgtz5QH+kjCISPpgA0g/JYgER1AP5Ntr+vDgK88ajkvNo/bMp9C2ZG7MXMoEZ979Q9tyahiALeDi20+DWVtaIO0MtaKl0wZCbwC4NEspKaej+J9mu5VSrBDVZveil4NfYLanO1vSxyBdoqtfPtAv77hUzYcPxay9YV/cb/8GelAoefqZcBSnz9WcCLUvB9KVZwJ3arff7xYhy3+CjKprRNav+NByMuD0TnboFrxOKK6WSmlBfq9zvTohdoeNqNITzthQaGlMvR0/W6dBeit0eQ5n1ddvxjXrC0szTlEsxHZEr8D1NWzlYyoX3AoDO0sucspvRc4+u80lRG5IZ+X1zH+i4A4lT/VOr2eKBAy7dhxr1aZ342gH09FIy+NP6k7Csdcqt6ntruBp8fG1Zq3b++Oup+SDUPdGuiOlkbi+yi72B55Upo5em+5+aNLaMdQxdDjqHXTIYuZ1dAgj2wcgDn7FhpYIduWTdAMJBY23m00+BfOvDfGRiv5rNpOiWpLaQSXIHSHQJCK3gqbXB0bE23at0fWemUUhoG6h5HVvr7aViJPK4N7KfTrPEjQinllGbYjF6Lw70W6ZDqhYhqmhPY6Ti/uY2b0MMvNq3d/GaPi0cX7z2u3LjemmjK43F0jJk3YurqLHVwY0IfFnLJlLraFNXVpvotuzInOD/TsDPm9XYmdqFjdnQadffMdXtAI/Zivy3NAi/pOy//Vzal79mUfs/y+D770GLZu0YvFniG+dBivY+/f7tzTnDfxexXb1m076ZfiRmJDSELyn6/Uk7o+5h9T8Hz1OBScbdUZ0Khda9NDZYxe3fWG8N0EjWhodnO2mzbsOn1PzsG+pAxyocQUB/ZNKzq2JwUBv5QE9kdqwwfX4OYcjldaTw2qE5M12yjsY32qT4QEvjBllGuW/p4bedKIF6rBvDa1pbReK0awmuPIV57oDu6BU9kPSywGsICXlA2lrymq0BHbk1XnlQGvzCUIEpZjgjrDyDm6aJQemA+wU2lklBGsvmB/xAdyel0vGNTQp5WxsJnFagMe54v3Jz10q6sLRCU079p1y7IlPOseUxxl0T7Bj6mHlrzmBrwQXumPSRYO1NWE9ehVvmqo0QduO3ozlC/+YF4/blGMwzDF5g6BZ+RP99gpoQYh4wYm1n9CjlqLZtkDx5jfXNL96y4cQk0ZyLe0zUr4312WYV7RaXPRRQUPJQpp2o1WDx5LnAAVo70wYBDkJatfNMf+o6FtD7A2Mr5ZCkR1ukxfgD7TXjKLjVvOh5Hq0Bu6u/iLV0Z+bGtCn9NKZceS5djNC7Jmf7aJxZy9uscxGw6NmUx2cPr/c0tSNrQi7nD+dBFOj2nrrjLu1ga4eU9e3A5H7ytrD7n6vL1RDlTU5W0YnlyoA3Lk2P267yK+Vt0Q40JMXtPc1ybOFYFmT9TlbVEJcQmfv+21HQ+On2HwlUcXsOmhjdP7Id1Z286JfQQgRZ3S+g9SE+hb1ap38BbNPSdygdpza/zHHx2cNdOquZw/gQnG87HhtUQcc4zRisQvYxSq/ilnls8DRN8v0R8WCZaLKLfYBShAqVVIy/cS2dmyqNkx5RXmKJFtwVtcoRCtVNMra1i6nhcWXXTyqmb2sCtDRNdDK4kmI0zHiyMo4ZmUSx1T3vXk/ppNNOxw73tEH9ZDXjiTVEthp2Y4pEEbROlkUeFD8KLYgmU1Ytpdl8L/ut5aF3tmn5aU/axjgOxhIJuVXqk2aNvSzn8aGD5PiwOelLqhHVEFF1jqpqJTlV0oqFbqef4xKlkj71YlIGskNaEVvMoFFRWmGo6YDVJ4SKuCUlBXZJrHQolxKyI02xRTRJN8XVPgh8cQg8UdWi9cxMJTxvj5T8q8/WU8ZTQVjnS+YDMVgZLwdEZjZPZckK1J6Hfg5oj42czJSO3lT25LWflB+S22KAntp09K7bVstpb8ZKuu87oPUEoyidHaAqDE/942ZrDWFlpygpAkaLP7HYAlf+vLO3jZGl8UJbG/1eWZhaiL0vjgSyN94VR3BNG8SFhlL6G9dNshzPj3uVcD7CFoaxKRtxxaHWfQyuYMHPORG+/CqscaPercMKfJr695k4mQ1JbvpfnqglfJGSUD3U9arTnWdHnyvlFrtyBXI/Xzjoce8idD3PidvUV8uWDu+DdbEPMuXIWG3Dc+pGY8Q/wzCtX4iLPvLFlnuOZ16wclAVOUQOjrXboKxiOH3205BI9Gnoq8JTKPygNkEay1wejhm3j6DiBCEa0YLs4epxgXPg9g0QISDQUc3wLsqX0OMl7tR4nO2PKCw55hipPktdp8pogulXMt/QCzDe0gAQdYP6BBN45bTQgeSHAvO8kF4tgQBJ0Sugxb4bCwkvzWQKr9AgsLA0EB/qZu0hfWQ+kPKyJp9/d+4rEKj9AYqmaisLyyQo+QGFBqqGwurGxHb3FPXrLH49HNr36jXzdPDkSxBrVStYmGMTUUhAm3uiFq8p7b9AYQ5n/dQC51XFemtSETGkIWL8o4QIEmW9FvUVpRMu/k1vZXugP9W/smCKiQmQr4w+8hfhBfZRW9qu0ejPPktttPLi8xxDbESAtozhX0oEyhHpdyRfotESmLs3TMOzV5ocP98APxB+/34NO0yMAbXfb61LVUWm6HJBscGli9PFnnqTCZcRhoFjJta5Dj6sRMat8NpnNRSq0Cx9X2ht+PFO+3INQtReddeB97QAJL46OlMSSlBp6xuMXXEXDRZpEeQOUTtdALHU4nSVJSzW3RauitZs1ehAtKod+U9VYPe1LjGCInNZqkJUTr9feRCe1bXQS1XZXH2qZf4cxJSuUHP7RfM+n6YzQGcl81dPW6qS2GHe6HjhAKboQtlX46eRXV7JTYd0N1OH2QvFWa781FgzhlZEVCtKKVJzx7g17Nmx9rY3xKniUu46EN22zOx8z+/FYnk61D1S1B22gzB3lMduD4KxWIgPP70SuXNusWG2M5Su6Yavh0Vx93GjG42gTs5XqEMQQXn8bEkDJz32lGd2ViYcQwJNChBr0lrSN8dseFthvlAMYEumbqsb6fU6N2mNiIVDDtDmQUfnhyRrAGzhetGYRHCdFupsXHnIjLUc7YZCvHEQgAVR+zOpG7YTVsLSCtXdsOp/6HkTbm1rN3qppDzi8wTXT1s9nQFOoHe7uzQfZAGsBiT8fdyIarXgrHqr6OEJtLHNnvv7t70z1aIK7kD6ZACopsOKwLy6ofaKC1J/V5fodfuB4YdrOTcXzF+Cg14oGsMKXB9l8+zXq2sscUESQcO4gWUN4c4NwlR8oQLoVqy36pE0fRJT+8Cwr1R0E0Fuqqwc0SJUXVnSrufpV5IE9SD4eC+1HnoaKViC1zI3ogA8qlw3oR43HLZp9OINb1S0qbKJGNaF2JKeTdWTxdM7643CqqP0BVDDrRaNEtXY6tmkzAsZYo9LmFURi1x/L5G+VLKNRYs2RIZDHoljCE8OiWBpgehR4/DGGVikeeJBy1sEnsGISVqC5Sg0rDa1ZhWvWELsRaqsHZP6cxd1btSWZulw4OZ2UOi1cr+q55HSK9guxZPDP6TSlOXzk+kMmtbVolmXbzLHobDpN+d10DqVixtO9+kvOaG6rxreifSO/tjN9E4237SyAipa1lWUkVWgswJeSeICLh2MryzlPrXMTTADpnwNqVYwf5nXq4d0tP0CkLP1E1QcoSa1lfA+g6kGIrnF3Krc78tLuyGd2RxLwKtXbnWC++C+TqbwrNdmrUwgNJoz/Qrm3pSZedQrBXdJLaS038JOo1lODFvxcfiCqzXSv9PWUSBhQJfrD+JNCS5GJ/rf6FVFg76WfesHrAvJZmax0KBfPJxKHzhEzY8Pzznc6myl/Nishi0g7RLh5PSWeh4FauRvuOA/zZER99rArocj+/hFpeie6va4J8eNhqpX8WtYCV4oXX21gRZtevMVwcU4nR504fbAP0uyq39jQOz4chszgICX+LM3do3VK5eKWDqIkWrMyEWU+kS5ueFd4IsxToyZsburriE8koR4RIgh6UTLvjhND+1wo+zHUmnsJ7NygkpVzE0TYyOsoZ1E5bydKmmQ9sk184kv7+o0j9PI70Z/kmj9HnX40bfplo/yXNLhDH0VjKYompLB+Kw+8Qr2k9CilnmBiGIU8IzVw77KljzTwrf/LFgwOrUZb0siHUnG26gox3/xAMn4HoWrvpnP8ZlMduxb+UVXYlJw92gQQr0rH356fkKTZPzyIphX59woZKxzcBlX8Mkrc4JAIftKwjG/qiG8sepz4e7hzfgg696r4ZZQkwrEg+EnDMsTIKHRDakn4IY5psNIg+9nKcjLpYvzyMkIrn5FqDJK4Um0zogq/E3Tmb6dxS7VOTcWeDApOawo7xXF5YdkQO9OmFTsMy3zhJpAHkf+ky1CemOLkrOOVgYDFMAha+beyDEJUgd17mVyaf2RIE0XQNPgpS03qANVj8Pg88lA61WhU3ewYJSNnWJOkkZcnS5fHD+RMV1X5KGpctebnalgGqN8QRQeTMJ1gnTyLBGyi4UQrrt/kGzkE+hhXx3tXV20FBHcyNAD9au85e3z12f+XrF31wGvZbrZydR4Snv49XN3+H+HqfJBX/J1h61Dp0fJ3xRAxg7qPHbnbILenIO+HCo/yp/B/zXP8Xz3A/9X/HP7PGwd3/F/9z+P/6ud4smiQO1wsLTfEL3NrUJTWz7AF9TDTBl+MX+LTuOXTkDer0aXQs8yZwkKt5r3gdhhkuGSX4ZKDDBfvMly8y3A1PsPFDcPV20E+uPT8/0cMF0UAd/ezf/TAcYX/TUz7+rvDrxUR13ge6RrVQ6E4EfVl7hQ9rPEYY3jpoWsBtZqYnof1Qz2bUs8LhwDMOJuqQoRMZqa3D5cHGiKeEZLqHma65myKFq9/LwXwNHCfn2n17LXskHtUq7u5y0NVwT3rLucqvJyr4HKu/gcuZ0veK5EI85g9cyd/yoUL1f9HmcO/k5lRs/1IVtMEqNKPO7VmNxsW1312s2D2OQHUnDUDYV8auqwnZwaka9g3MjGfFcHoCc1cMvW+kUaleVuhJi0ub/i1PRSNX5vgybAPIIgdqtNpb8Frik8qoszHY5cYjEdxjxOX4CEQKlljGpjje0jamDcaXUYlx8n09rpMvd9x8sXn15cGHnRCSGrWwA1RdVYb5zLPrqA3f8lMlclzi/ZMe0Pzr+am1aHp6/7MCvifk09YBELlR4gTEEoVjNZ2CjycAtd7mlnvgF14lDZOZ8l06WsfF0ftRLnlJdf1jSQYwdrG8wzRfMSdjAIL35juafmR4gOHWEMZwue/XZwOc1idDAUdhKt3KuvaVNsva1Snk+e+EaUxf1DvbOl0OHCHpz1lm6MlWw+wicIJDB/6KkzyuTqo1PQwoNTEn6ml1ZwehtScarYOIuACqBkgegJPbWmpnclKusY6oHVXlS14L+p7LLJx7419fEnmT+/TdVJUwMC2yoiAttgnLQndpEZL41rEs+T1deS0Nsi1OKdP79M2MEEojfXBJhVd96qhC9erVnkCbNEn1ZPyHSkm5c0tFWWeiri8uT2n7R0UeesXmLxWBc6pSTMV4tfnAaerxkk8Ur97XzZjHbobR7a3BFzM1Kn+pgWY5KWmIL1Pp2eKbAq6y/BjFIP/TAdbACugmqtdrSNNCrr6ECWyvttkdRyTp0ZpeCBFb31l1aBsVTGpHXIUSaMk1x7cLuoleOglA10u6qUOF/+gGkcqAMOORTXYrK2TtvpaPNRCNNED+d3LN1O6ZTwC+ysIPvCeTuns8ynYpKlEUL/b0C+m9PazKcm2Co9ASCJ0hlIwnQLWNeyB0C2gvbd5UmN2zfCbrpIaMh9t7RazW/Zoa7eqgKp9j9ngyEjVvgdH8W3SgP/dfL9SLxEqqETgV8qc9ud3OGvRtkmWD+AtmwXiY4q9+FEqp6gAN+06XtUgPFWKl7PP8cfcqNel7d3s8+lciSlS7VPetVA4/RW5jtxpFIS40IllAjFz4cxJWrFpVt0JZ8tRkdYLX72olrRMgAeDMKE1vohc+QUwt0yO3iz2Bid8oedw+5maQwkhwkWyublNo/bt7WfT06m9+2KqOBeV5TWT+69/SqV0PahS2noqpbWH6jFSvwfcmR/wunK/O5GyKxVtJlNyAwT1r3XwSIg0qXbQkp+1JWJQqKnkpAQ9aQGzOBRl32zEDg/vZJbt3rJptptMcM+qUP91O3SSdxD6/kJg7TY5qCHSNjmqX16U6G1yoFtQJXIRnsOg0KAGlIdDeLwwhPg1oUfGo9xTcX3oq7hmWsV1g3+CCO3HTFled/HJDvyL+fjknmS+rmkTHQjdR4chNLVb0kdCi0h2MNsOMRt9pBvEe6BTaUFt1Y1QjwCntJEDm1cLf1+q4fqBhBBqKAd+t/YA7dk6DgaxXjugV7OS9Wpf8JrUwX7XGLpxYLOppFPqImrSf6n97aW13Xf3ulmpWwSidgP9a8zKgg4zJSYbgIQplSBo0eAHyL5Jji5ORcFmWXFXZUUck8H6BZVmxfz6l4btbdvGQ5eA6rQv/pbMwfIAOl97Ut6HAa3/XeBNiZdyq3zFeRY6SA4COh9Rh1pSv9pKBdqtH+55NKVX+r9kRkaegx2I6Gr2NH0ym4o+w7WsudlU7xFWIaB4Xlc7aPte//7K6+P29Wtq/p8mn70mI1tMRxf/Jb3tJv01vaUfoahEvSMTTNIQrmB7ZNsZYtJBVXn7jLLTR5jA+D5T8FVQMdyDZjCDti76UPgXsEeG2aMS5pv73LjIXuM9vzAZ5ppfXiK5y+eI+w5lICy/IW9uJxG/uY3LpLNjv5BU3tx+inq0sO4Jy4vRtqk0WT/84Y9fdgJxK4GoCOU0vrhcOU0VQ2osNiLEgN7LeliULRzCjduO2EX4MmwtaeW+pNWRYrUKKe8UdKkSfZ6tzjRjF9djPk1LrUYtGbu4NvNpChfK36e60rGyUWJJMQhE7bNA5AcC1E/AgR5J+5weCY7BA8KbCChAQchHiBS9977+yf64Vzsa8NtMDPDZ4IxNDLHTicE4Rmf2nymJ7FiWdQyk+hKDOlK+ziojKQ2YiWdMJfG5pLixIaHLpAgCv4mknmh1JDolfrka+LiJSNobG2O6TFpCGzbxat9PbLCXKfFL3pOMs03EoTf06VuxDYjWGojIB/Rgj4mxEcA0spxEPK7BcJ+GqVUMQbGJNtf0mR0dUE0tz2AYo1Dx3ki9tOhqIibcHxatWBuHKQ0rOymFFWNNZFg940N3RFThpGItDaN86KKImrhQhdpqp6DRUa4DRkqWFLtRAEgCv4oGssbjfpoX5Xv+bG46pZxJ08/17c3LN1OrvH1dxkpX6DITc8k82MXtaY0n6GlmXUX6mARfPib44OGFmel4H5vrYi25LtOo1cWvSyWu92nC541FQl6h1YHh//1bP5K9t2EW8CW5FiQuLcl87JVqZGlL6a0/90c3aDPSf6Xwq0QtNenP8JJGx8z3cP7MVY8vQ4rysbudXBpshLa/TglnPk0F6DKVd9N5mQpQYCrfTucinZLnotL3SDNL1KLlp7raBukjFfwjYCw1n0UrxiP5rGi0cSUuCkILW+Y5sec+FHui+M4SKNoQylEokAkUT/F2ilFcFSCr0ecflA20XSU7cCwB9KuGrQmqSpRmUcZjeNApxmPFjubK2jJYsbWd4zPs9lobnbI9oOEuV6Ea2sG7hidbbYzjsdpjz2USsB+0dp53LI092d3cTmSXdv0r3UyqoYxf6C6+vR4oXw0l/0LO9ZCJaX3BxLQOPFLVTkzVOKaAbpSVafQJrwJkPAZp1PnjnjhqnktefKf1JLd0Z984vvjIECRvIFriyBR1UQcguJiKEZVsq60oW/opUe58q8Ot0DEfwkBDZYLBNmyoKYkCPp2GNfqD6tYx35G4VANZhO+rEqLa9yIrCK9vkmlu48+8kKhJpH9GLuSCjRajmNnMRurAmb0wzXJwZwVk5DxaVWVTFSJ5z+syGvlrotu4ks1VLna1WPFW5Fe8zK9qsSv4SuRX98eroIaaazIilKM+sUSuJgwBqOkaK4ClNfvT/d/Eqk1+FccGSK6KGYfZ+NjsmegZ0ckTZzU41zF+dvgSg882rdg147H+sdB/netDeB3e8gMoJoZqWaeT4wyiciLITZRf75VojNwxSZTvG+v5pv/cYgg18O2MhFqDpvnMa1eAKnMNqHlG90xMWuVSD8do5dE548Go6YpNs9VdDn6CZLaKY1KYEovVMuhgfxPV14VW1L+CBrPqLcSrejvNSBwXw4X1nIrgPccP7rZYqniLhplyt5XR6sy48pboWf1atd1U6XBYARi8uOikKuF5HtW0TfalbEnyiDfkOiJ3qNuREd1s5XKc4G3LD6eTKscX3G0xRMUdj3VNF+RQ+a6pPAFYA4owRSEbsarKPFWPfDNUbG3SxYze0td0NqW3U/p6SiG+9+1r+DldnmlYRbzsVXoJpbay3LdCl3ojXg2X2lT7Wpd5+Ua89gu9pG/o7HZ5pjk/6iKfv3kVlnm9PNP3Qvxqupm++ly8phrG01dnugXEonNv39x+Lt7Y3Jdn+l97XrfCDOCzzz9/5eW/OtOj4HZ0s9evxGc2E0Lx0GLAcp4CIkifFCYA9z4Ki8Av2Gb4izQm/NAXyje2hGyqvwjxa64cA21l+X9DlZG3UaOwUpMGuzjapNtt2jTJTz/9dMVHtJMMSXpb/CTcg9H3339/9TW92nw5wgUfFcVILS3+UuuIhX755ZdfRnbpRov/WP7H1USn4oKN8PfZyjL5vq0grDQuWjagXwVLxgaofIqcXeJWxeBzGJdypQZrOh5XGPFmHpVMRoqRUAzRn9bRSNceEa+dqPKOG+WqFj98uBZVTAvq+aN7/2hG1WigGe6d1HRoML2jPtS3LjTUlckKu6IKY6Fim1ZqO5Ozvhy6ishDfqB0WCDSburq/RU40PpDXVd1NPoKecy/NVeTq++RxIDfq2pf5Fdl1V7dA5rdl/mLq1+q/dV237RXslwV+1xcyfZKed24sm201RWQN+1GXMGGXyHllFx9Xb0vi4rnV7y92rTtrklvbhQ987cmWUEA8qyvoOZmEailfYx0lPb1AxEAKTfyvu+//Ou7n778BuR9P//hj3/4kdY259sfwpwmdFdQsCcdsmCxNF7w4fc5q7SyqnFGa0dVmfg+eyaiklYk2zu6BnxFKJZU3eoKQHQSoS3bB6DkRIGUB8KjltYYEFw9fjVL1oISrx1TX7jb0L3haxbLbFQhgeLIMlUFo6lpXVA/Ra9ITyy8J/Ogy4FFKHARuoq2FYHQ5YOrUgytSvEJq5Kb5UhzZlbIakYWTiq4X7L8TGiJKd/LknHzmx9YTcvkHaL8HObMiuf0UyWtqdLDpAVrQhg0UWq+lyXNzW+Oj48FohKtwI7udzAhK8xSrNSPqDALIdnKLcS50Hq8takMOKdDWWxYY51FfMV3fCXbYyRPpz04QioQ+ZxOpRoKXE0U8mh9OuV0Q7ImCe4mVoTfzWK91CojhaWdTiceuUbWqpmLiriwRpGzzdmgbY5ElFc7m5wHddOu/eu08PD4WaHKPdbLMTwPSPwr+DfSvWOiLFmlbGp04vPi5q48V/al2hDEXV5UKpsPZC3aJVgS1GFrfiQVLazOvCByKrZd79jW6FFEdk5XjRLagI/RgdnVvmElgcr1miWKnitOCOXq6lff3+gFuhgVVLepXiR8gCFa3bHzVlKzatDoyj4L8GTfgINn9em8gMxrDANaklR+3COH9cGnJTc/y61oWr7dNUyn0dYCq3Hes4sGHkIlCAwI5qqS4drQVoGWUVPW8VueMSi1Ela0JbXy0xuItq3Z5L7vFm4i1F57FqVd1yqcnI2RsxKX+4U7blHq57WrByT4JsCki/noW+8geuI+KnWQLpeLcmmNJQCUMVCxvhVOp6hlIuKU9zS2CaF+Se8nXiXAyTk0SKg5Py7Adm9juvP+WB11Xdu3uHUgZXXV/06j4kGD4nYSDZTTBsbwZEFuShdWHmEoRpVlJ8H3kJ5SXug6qB2kp5TkF73jB6JFYdQ2a2ZF8VXSVj/yXPKyidA29ceq5dAQAVmvlcU/V6wYxAnyk0zyzX5f13Fx3Zxp9yK89DAiOteeCJFjkNkkHrtmX0/6CCEChEGndLFUdlTBDkTaFPlD+1+x+sYGv6o+TlwJQx7RPS1QTPkmfXNegriSLj5bkugzsCD6fwcA6vOfJGQTAwA=",
 		Length:   201572,
 	},
 
 	"data/js/bootstrap.min.js": {
 		Filename: "data/js/bootstrap.min.js",
-		Contents: "H4sIAAAAAAAC/8y9a3fbuNUo/L2/gsLMwwAjiJaTXp5SYbQyyfR9c047M2cm065zXLUFSfDi0KSGpO2klvrbz8KVAAlKdnpZ54stkgC4sbHvewO8+GrxC+8r7+um6bu+JXvv7kXwIviNB4u+34cXFzntY/UsSJobxFq/afaf2jIveu/5+vJy9Xx9+Wvv/X3Z97TF3rs6CVij35cJrTuaerd1SluvL6j3h3fvvUrc/oX31cUvygwC9jQra5qCKOo/7WmTedf/65a2n1BftM29V9N775u2bVoINJDPOu9/kDvyY9KW+95r6c+3ZUs72Q+gzTK7rZO+bGpI0AO47ajX9W2Z9GBzR1ovjkiQ1cH1z6x10O2rsofAA+hqvVNXAUCbMoPx1Xr38rnvx1eXu5e/PRwuo4jd8v3fsh+XO/bk+e7l5eHAbr968bkge3e07cqm9i6D3waXXtN6RZkXDJnxbe9VzT3HH6l1u18CdIQSTXh+tuqBF0P0wKZOorRJbm9o3QdJS0lPv6kou4JALzJAOI4e/kTjD2X/viV1V7IRQnA/uvNNnQL8h+bvZpte/6bs6Xfms8bq6jWjtv3sMMdN1rSQQZ94Ze3FqMzgXVOm3noRRSTo+k8VvUp2qKX9bVs/0DoN46tkd9yIG4vLI19uenNbkZ5aUEQac7HATxItLnEa9UXZbQhk/1DQ1BSCuBvNXPdED0m0WB8RJy0amfcPBwJTFPRtmee0hSTobvf7pu2DYYIBrVOkQPU62r8vb2hz20OKY4QZAEdMoDGoa5Aohgi7Hvg+JAG9Y8vd7WlSkioYTSR6iMs6ff9pT8M58HBKK5oz1J1sVZA6rWhoYrTMIIFx0JM2pz0Kyk5gVK6UFweiz3fxtfzVBmS/rz7xZpi0OafUDh2P6PhUeo/Rg3wNGyygJClMNIrFlkuMaZQEKekJW+eAVLTtAdrQw2FyF9OIMXcqJ4IBe3OdD6Ir9n16Fe+ChFQVTBjY4k3PrthIq7Tsbsqui4AYbvcM2zRIYMwIDoKkKpMPACecBIKkajqKjps0+OM3P/z47rtvI8ClNMBp8P6H19/++O79u+++/evbn354zX5El79a4zTYt03fMLhEf+tFGlMJRA95kNKeIWigVcC7pMGAj6ClN80dhWJGVOMui2hA+r6FgM9QLDZAm+xwgMOzoqUZYI0z38+Clu4rklB4EXwFt9EXV3/5c7f76kt0gQFAgpHyiEDwBYiiKNte7cIMbWLfj4N9y6n5Lc3IbdVDhPOgonXeF4cDzCM50Y4JcAk1a6HmxOT+N3dc2vF2xuQQjoOyk8N+L15CU4jYsHLibyrSdRCUNZjjtTwoSCebZSSlAG3zGemRIKc8gs7lRGECETpKAcOFGQd7M/yMYjxcBG+auuvb26Rv2ig1H9TNm6bOqjLpTTkl+cQYjWrJo9SFQZUabZw5VmRfMjqdkNu/gWFTg2HTgTXj275vak5doImvadJb/BgzTp42l6ycCGGTMWbumzyvKIiieEsDcQFRyFg66Gj/Y096CuOBpwd+wil64FB/SYU+jRgjC95t9qxNF5GAfuxpncKHI06Ct9/87vVPv3//I05ls7L7fUPSss6jxeVxk0wYfegSPVSi5Xv6sQ+BvAiCALCBh1VQIDuUHEjLjsQVTYFUdhpwjtmS0/n+tgdoC+5IBUJQ9DcVwJlCO9rEywgwAACub6sqihhTM/VFP/a+r7CtbwGcXtEdRAgbKo4wYD9+MleZt5IDXsW7rYnBq3gXspsIAwMBfLXgGIVM/JE0FcyYICGEEsZ2+7bZwwQv1giFdi/fnwxziVOL+xMlBV/z8YbRLhE6YkGaa2Qvg6CjaETKhMEY27jX0ksqC9kTCILtwO6ZMEqlyFOLaY+RlXWqV28DWpKWDYiiRIAKGEgAbaG6TgqafKApQMxUiBaXTBCKIQKS9OUd1YJfCjZ1F9uv1cjWDVAoBo+bj5P3+/4UgEU0mskgTNWQGka7ocDT+PV4/AZ8bniEie8nhgosSJ1TgI606qg3mi/XaqQtyWrf0q5j4y/Ov+ARgAs5nwo5L5Z+Y/xWkl5cWaI+sZ6cl/VywPScsJcDDtLeos+/KAJl1ox+UzJI7EQbgIN2jvsaoE0szKRUS16EzeZlB59xQr5iVBNJWt5hz7ypaWz3jGnrZGoiGPIMK1WxTYdlzprktgMoTE3eCe/KrowrKnuoS4CCrGy73rSUZH90FGjjlw60eXF1234uOoVpOIdIm5AEPPjiL/wHLGu0/fIi6Fn7OGBIQ/8Wa3pGOSekbW47WnH1PKcDpVLBTvWNcB5N7extHGZBV5UpHal3/UKHgq9vb2LamqMw+QxjFOZbepXvIAqzoKx72t6Riqn9PbntKERB8ilhtoBL9Sfzqv/Lsk7LhPRN2zmltIZ1NTQEI7NBegAckDRiqlHcYFNnOmq4o+A2bn0phIp5p+zpTWfckO8JPtBPcUPa1PdtSBlJf6Cf0ua+DizsKu3Nm8sWWLlFRXMn8Gy+gs/B9xcQNHXf3CZF15O2B2Xt6aCE+iHDEsgFzQ0DgNnn7QmA+LskOEOvipI7eqIXX2bZy22EubytX6/XlnmmFiL8FX2BOSChRAi+b8k+XKyxwna4WNvWgkRkZDJlmcHFBZdKh55+7ElLyUUpGJpIkRD0JP+W3FCEHrr7sk8KSIL7okwK9JCQjnovfiOMHSYcmfHWUvJhI578Vjyp6cdeP0mF8AwFzx/JRKgebag53iw7U7n4hwM06Vcr74HJkoqS9p28hNZDmxWMPgtjTG22KfLvaK+Hs1aXTVEaac5xkQq4mFPLaf+upzfv6pR+tFbFlIeSq0iwJy3zL1GQFGWVtrSGIGDPtL3EGwYlGw2Sw8HkUuR88e+a9m3ZUv5a4/04tsw/E0omftIIsCUDUUR8fx1FUXI4gFqYy8zIUbaWAEfYk6tLZl2mCrsKO4xkdcRG+qB68O3qMrzEWQSTJUX/NR1zM8VSQH+G2cRGtlArgrRcbLvmZ4k2p1xlL9L2KzeaF5C8ck35cCAv1zoiZUrW7VjyUAjYI1t8GEowZqqEoCMKE4aZgUQH/RHqF1BIXiVbsSShQCceIYmgEZb4YI/isvXY0pR44RzgcW4GSOLA92cihyNb9VwQEw8SFDLvaqSUzrK5PVcGqWm3siU0V2eyYhQKZI5x1tK7p44j0DNyptmjkccveOE8ATK3WbK6g6lhjCkzcSyU4CJS7BpvQUUzRiU84wJwKcLTZQYzl4/hIOVocckZ9zrKrtY7/GGIg/Fp2RT90NKK9DR9zxVLeI1TBWlYHDkvuQnjA8KLD84gGse6Dc8a51K1Sw6ZGEzayZ08eJR7yudbSZPU6q4lM7qaipYM7dCm8v3K4dBy0+/Gxt2TUKd9Lxe7zfmOfIWYx54NIMUIs4UMmizraP+nMu0LTIfHBcKZdUXP5zAyC41XMS52wXVT1jwxhhzYwNTuoR7YHXFp0KAZ+zFZckpLN+iI18yhcgZJnVYYQiGkM6GKzAX/iEGwm6pvENKkKoW4kFaWm65IYGNdKVddXbucdf3svLuuh5UO+zjhpFxubMbmCXRG5w8HmI5i88j3U2dgfqkD8yORM7h24s255d9lyqmj8gfChZ0q4KS96huANoXvw3xQFiIOxYMDGc4RLnw/c7mUTOMWItIztk83s+EMjfLBAwdXA0A7gOnTeqz6hnfCBN6Xddrci+5VQ2weIyqw1zJdMqBv9wydzk+pQEmCE4XLz8uI8VFxGsUzNJHoJ5omkpM0oWUaTNHRyCl9fvYtaaqK7Ds6iRekQ7wgOR0v2CwooxcRFPH9i65o7g9FmVIVBUG+D9VzTmt2qk+DYGX7RARhJt+nQwPpI0MDrqyAOUOlEKUcisg4KKyB3F2xtYrAF8+WcVCmy2dgh2ebGgtu9Xgm3zdoJDu2MLjwzL+RprG40Ab699L3EUYuSdPXbUle1+kb+Xopge10gzXLkV+mFlBAJtMxj0+CvuBJ0MEtFyNwj9tMVqXlDa07y7tSIfo5nXzPNK5B/Ft5JwQF5Taa/QZGf04b1MK28rsc7ytrJWJjrAxOgXxlN4gr0+vck5pLSNMRrbGniJsRsXSMqO9T7QvAOKJOZuTpVwtgJGHKDJOoaO4Dq9+8vZghvMhm7EUbpERIP4pBwW0hHB8OLiB5Jkrnj/lb9eJCtLHBsCyFAV7DUjAxdZXv4BqZUX/6cU/qlIf91yNmdY+dgnP9beYTJnthEs75GXBgp3OgHiMhNgkAnC9bz5g/fEVre0mPnGxcVqzyPQqxYEJ3sVmUEQkSckOrN6Sj8Ap0SdtUFcC5shVXzLxwONwTk1UFdAoV23tCGp3N33rH1Xp3Ve7Q8Xi0GbawfL15hn0Evw68UShP6xG8ESO8iGd4w4j6zFL3VTKeabKDCA0+w//P5dRsDs+iJic1c4KaoefLMT9M6TE929nFDHTCDKep+CkcAoyMTlGmKZ1Q/Qn3bcvr0SYYX6MzVEw/g4pDanDXmHKnyWbW7OoUoW6FVA05qwNmyVgjasXuckugwzhAwkF/dtoGkbYDeLZ0DCEsEmE1TooEkiH0wqzOzWlrI4YUYaqS8yhgptZohnN9ndFWMsLehrjpOGEOjJWWG0gfLxI018uq8lFdNtaVdh3ltavcRz97hFuphj1b9KMHnUldmqts5C6N5RIaYcYjTSde3EaYF3wN8yhz2iVFlG9V+jhUruYmUc5j8fl+kttL2iQOPwknUeL7F19cvV79H7L6+046Gsms/2QXu6WsN4GJtihT5osL+2ebhrFOK5guVoIeEt9/EUVRIlI9hwMkkA51epjADJ1N1cYwZZ7WKHQlAgzUIPZmT2tRuOH7sjqRvZlxkO/PZKeScXbK90mQNHVPyrqD9Gq9wzrbfzhAqiVwMlWbadvs0+a+BtwPS+aq9VI3Y4GMVN00aiVnZQS3h7dKDWC9FzHH21iF9GmObmo6unpgtEkt93N4Y8rdz/xUsWk6LTalEdBjrGKSfGAXAGfRiFn1e3bPGHfNVqHaWDCcMXTc5BNfLD+hjSaSgBlWlJdnBKoiDXuhLk7TDoaWAGN6ZCMkEOFFjh4enVX2/UVmlFDU5C4m7aomVk4EzhXKp+WdHRGd4hoFZd3Rtn+d9YyooJGGVnW9SFr3Tr7jcTZFk+nUvRpWo0CivsVhKAqy3NBJocoMh/TtrYiVWlprjkG0X2DBctQl90eLECZZ7YQb1RfwxX8ffrk+PP/N4cVzpISmTFz7/uKxYmUk1ThRTOuAkqDrm/33bbMnORH8iRfpWeqTIjKPqJP6FrnvP//NYhDC/FoLZeUSGbeYb8tNpGxaRIRTswKbkYoiFOBVZVg3PdSwIlWU5BGAy4iqxIimxxta34JlwcEsraLB66iUOWgtfzcv/tuA8PrV2vevVyv8y7V592WpE6e+f71c4n9cHw7wOlojXAb0Z3g9nRFzruQUuLmhoNtYV8puUdeWTZOPnp23afSwxTmbRg9q1DKfbzIg2cua9saI8hK+Q2NMZ8fzQ2YOyYnG1Tdn+8m2j+k4JhTnOE81nZhtfk4f2lsHtNq7aVJSAcZoM1Vi9EzUN7OiQmI4nJk1YLlTiW6zq3gHUxTmPFLn+xn/D9OT5V6j+qwv4yb9FBlag12PsvBmZVhakqrJ3cljDvpKtNCFI0q5mAVf3Y9MCpth2rbMy5pUXzfpp++JVS7GIy4xaXm2UHnKZV43Lf1aDv2GkadOhSmXjNlLPR0HOyxQmUlH656pz4ak0NEdO2q9ZyJObAix8URSxPHJBVkveEHW16/f/M+3P3z3/V/ndsiYNVsKvaPiLMwoYVKkNbZrRtVAcl1ElJzZr7rwg5EVGVcWWNHhWOkyTrB0qvslXY/shvg4Dp8pfFJkEQvjkRnT2aKphaQQXl77oyIelafvaD+5xznAMIsEaQhFKVrQLiF7netvaVf+fcj8mxV+Qk7KvVLGpMebqMTt3TO7iI9hXBUimsw2lAEKSTgZ3tyHMIpD8m63+1OdRiW6ZQeHQZjflLq5bc1TeQJQRYQOiemsGUhdMR2x82hjPNNFaWqrlPGM7Pe0Tt83DFghsoyHnF6RlB3vmz1cI76v4vq2699ynEKEqQnHuCohdUUX+RaqNKB11rQJ/R2vUla+/tTAnCd4uk2NtT1T4JA6RI0yiIeUxNNKDsLHDjpieTu+HKMH9942R/BYKcnZsLHJxL4/E0QedrpIZr88yaCW8ZRlcoplPQ/QZMec6DfD1/LpLI+N2Vg3dvPx3Pa8uSCo3Kr3lOyDljN/YG88GcSdoRx7BDimEJM3IquE4OxSDJsQzNtTBUzQgxoriiJVSKxKUgd+nn/IcKgrkAfpMqPXlT+gtLk9X055k/C+JmRnqfp2vlJ9Kqdd82dOGVHOjaGtFYyhrTlHr2Pon33faH6Cl+bmt7ULR0RjB+wCRL5T+qd9Snql5kKjP4NqPIBD/HCyc2e8R4aEQMm8giJS81s8byl/IrYGvuZ6g+/ohvqmaUYQB9UMgTg1lQlm2WuVUp1mSxXIiv/V9RAmddjX9iviyeBjK+2MaBH/QwB0xs9N2OpFQ17dJcfokDfUkD0hXCWWRvX0wJKiwQoYLLmn2GUu3rIM4qnhwzNm8IQTgkLeQokXLoWS25ZZMsIE8H0Iup70ZTLeZ6Lmth2LskDsiDKkL0R64ybC2ZhGxtbMiIJGFs0iVjG3bDtq6dYm8azKOOW6oDCGckuizkvb5PTlQEdOmjfTV6JWwrKTbI6CovQDouPmn1CrZzCRfyYmcoUJCaIt5gxBOZELthFrdzSfnSkK4kfGcBEmMuqv5mLOQVKVtJZ595GETboOPuxJmpZ1/nua9aFYVMaF77rv7mibVc09LziQuwpsZz4EAMveP7DRw7nei7nuU5lqi+v5GpQJ6GNYpoPbHuUUv0KTBWVdUwGgKDdR9RSzCM5p/3VzW7NXv+G4/oEmPUQbEsUBr5xf/YH0RUDiju+gznp0dCMqsmI4cuE4JC+JM5jC791Q0t221NBn093485Pek7aj7+reEMAct0AicyVq/9HhsMaXa+nrj6M9Ntz8XJzAXIzDAcj8jYM4Tr8Yk6Vj5i6ycU7yzOCu2YzGHqN3isKTCnBDgoSJpW/JDZVxg5Wey0oODqxABleJkCB9ZJShCVbEJAt7kxPrzcSClLVFWbFRcGwVjHMINsNPVSrOL1x14uLB+ci3GO3slm4x3GweXwdWXPu5h02+o6NdiM6+jjL7vJzvZGm5nd6Xpt6Q1x8iwiKcGC4uvpDpIop8nxrV5movMdqI1A4BPAk/ca4zuaPLDqvZcXx3oEz2HFumdrCBvXrYNO770/3mPOwzFLkLI+Q/uDG7b5qqL/cnjk1ZUN+/SGnXt80nu376cID2pms1mGPP9WzFtCO8zgttRIb5055O64+NO7TmSTDjTi+2lhh3+KZbcQaKsQNaRePNndPjRmVd9hDoWTG4Hh9+HseWSV3e8ERQuFhjzgTs/SHomz3AHa0oY/RwcYl7erNnRlD47GVa3nlcYkUaCq9tGG+qy1eONivSts09ePXyIi3vnA24WtUN+N9nWJKm3KXsyQME+rKvKFPoKa3Ip3CNi/6mYmDKCg7KYb4r6T2zCcMHPRPARKC2A8L1aLMww62VUcHpsENMLauKP3M6iCd5lGRURq9q0L8T11BtR/xSgWd7COqu7xPI2Px3ChpnK7R13h7K8GzwpHsxaa7wIwMJk7fYtPjAZTXDMF8U9oOvS7i4POKxDVrWXU/qhLHXoAYHJTLe0qsgmR5X+Df16G+eaOzd3Ha9F1OPnx+XlTT17gtae2wRS1KVfy/r3ANLvVZL4DU1P+tR2nEKHk+ImAVA+jg/ai+KqhYdDmTkCUK5kThbrTZqmxG9ynbMKtR1SDmac1YN0LATB3ZwRSgc6RBulJMFbkh9SyqwiHIBQhHpEw7yrXEgAQhV5A3gctqGHz+g2jS3PZiUPMNiCZ4KM3+znfAwBiyfPiAHU4Xpjs4OgiP+6trFYrbHD1q0SBQO8o55BoJTsvLjeyZr4HQHvNS/ncPcGSTspJeUAs6t0lNYjddMslHSqIiZpuZi0Penh4ioJ1D+ih541lBeYaY51QVT+Y5JivMVJ2Arn+fhaOyDH2C17E61Fr5PhBVg3TUMGyZtkyuyW0QpA/mK7KIUTeHiVOU4Oiz2DGEjUoSDoNnGIYGxHadBg5Vg0KEGXpRTMhE0Hmw8kLFaFr4gQviRb8UJQtiagcyw8F0mUvReaR6OInFgzlbabOoYjx3fYZ8EfbmHaFQQfDgA3jMxrA8R7DLvRLxtCPmueLUnNlEmDBt71BYnmgcltY1u8GSdepEyhdwbbqcAstFEsu+IXeMiFKoGIx4tu3cCa+/bWyvKooQ88UoVBRQNkY6BSnQTdXTrYj0c2Wq9hAukfyctlhn8f5YOmZ44QYiXCC8Sexl08Z/3OPJibzhPX0yMPZK+BMhjAlO5FdfAnMCGBvN750abapT7Nl5NmSnp3ojKFKgOEpLm5VzxSYy0k67LlCfxvua+pu3bmTDUxDSTR/a5XclFqkLGgz2Es0i6M3uoz4XIaf/Tu7fQmKSqw9AzxJl0vkseUMWz59WltEvaMqZp/GloqJZE+yq+b+6al0UF0vZR6zHoQHsTh3Jxtu7bhuHMz6OYoCx098NldPHnbktu+2b75257UeLrSJaEFmhz7fuwiAodZygx4NKYeVkMOfqIWx6z7Jt9uMYVzfpwjdOy2zMXB8RVk3wAR2SenuBmYMcxQtot2majhMqkhThmbCgRtv2Hub14ooeojbIIni3Lh2EfbiMC6BDhKpqcE3Fj3pLh6DKD14K16qjAzXQk25dCmyICcdP3zQ2IosL3PwTiannzqpE/txzvwseVbfpmv7p52bD/W9VdHWoim/CLZfWqCfiGWn3yCf8n27Dfq+plw39sZf+wwPYJFrW1hjzOsNfzekOqhGcb0u84GmCBP+AK36jNRPt99el7RXVwjwuB4p+nQUdqyLgNPbV/EiypWC1MJ3EJKTCJ2PVK7iikZ9ItfbmfplqymezKz08rTfh5cryXjZBRNeSQCJUii0bpmOgy85bce5gPQe9URIVvSJuX9Uow7OUa4WKuCacH3mZTdt+Sb2GOfB/mvA6ZXxeIS4O12IW1X0Y5FkH/ZVRgFcpl4lMSAAMPD54Bs/7y0CqVGMQGTyO0zW3Ns5PNHgkxYt0W+QWGxxhhWTWVjvNu5RRR11NEbSQPJb5/vYgy7mb0zT4SE8tW12P+/6NkU5HAoelbWvUEJjjGJb5GG8FBW4UOcRlKNHEulWfnXPTN/iD4VO9nwjdRtX3+leRCuixDdsE4O1te4zqqtsCYDgiBOROwSeXMdKWSFNav27a5hzeYTf6q3uFqklkYmlnRQl2OywNfUrgnWm7wCNuv1l/ByxW5iNES/BfQTYR84g2Zpphka6RinUkAClKPNdKlA7shqjLWDrjJs4aUIij6m2orzlQOQU8/9mDHUELsBC1ja249N3tPrIPHwPVkLuhMTZmxSUmY/AtLWvETVaRKxIPkYiJoVMb12m06OPfLDlJOZmQtu0adxtSXe1HnPapuc1pxE5maI7zIT+158bJHH9mePV6Kpk8sDVSxBFcQ2l46Ff84k2veQLXXlEdmuXEjg+sLZYcRMwGjMmor2Z5vv5t/jqfD681CssWETwYT2xGhGfHGJO6hzAu7FDKKR5VWG+nsMRGdRuDr797+b7H1UOz+4cfHzCV/xVHiVJgTvg+pFf6h+IE/CKnMEFMu17A4xSOk0pZZUSbhjkjVqMrI5o9//P/0hrLE9OUmz3EepVvL5DyG2ZbBFsZKIjJt9yBSkmG6nc1y61rcw2GU7lUPwtgo2EVHXLJ3i2kOZWL8mr1TzHR4IG5AdAwZeMOedxNpBS5xPl3OsVU1EtTD1pDBdiQCK0L5xPLlWCokrp9iAerF81Vy8fyo7Emz3yo92UGajs43XTxfpRfPze6r5Bg+qqF6z3GCBqf6deBCSEabKja6omcwtuUSUEl8M3kMd8pBpmAOh7XhSM5a9WUGLzgfHBg4UucTpELeAtvZKpf0hUttg6hby3RTvMy5hc95JuK/V0VYvuK/lrlEKGPFoYG+vSpFPY/cpCbXJMMf5M9ltkw21y9zYcIIbo3E1eo6/PAqF3zMRzeeLXOxWKsPaoeiRyfr5hbB4zP6VSBWoloziL1ZfCx5DwfocJqTgD/eyv/CLY65AyxvTZnsp3dvI7uE1yPL6B//gJf0118JE5TUaXMDEdrcF2VFh8LAnCop8vWndylbWA39aHtLuXeefsL0tj4XlDUanf+g0pcIXy6iwU+RGw8nySYzZ/Q31dfOOyVN3ZVd7zWZRz+SpK8+eZfMHFpV9I5WnlyTBbBD4eytIwfGNhxHh/eKp8ZvqYBEeHdkz4kM66Rcm8TTYzeMZKbdXO7gnG9/6dpv9I18OtvLvDq9Yck6PHgT+z5MoscGS/F/PFKKcLwdwqJBIipERzfwJAi6TUQSAyaMn4RfnSDBW9O4/XZoEg4dR+smaiFmyqatYKtZkaAMwTKlo8Jpq5Y8AEsiUCzN17cDPuQDTCQTiv+DAS+uhYFJFEWrK50DVzfMCoij/cEJSeYb80IVJ8lLV3mSenS+QEmNKUuU/mPlLvtmz0Pm/5JyFzXYv6zcRdSb6GFFvYk4ZmvA2TRd/73oMHxOUK3DdQfQ6Y8ImSbd3OoOe28fjLIVVbSnMrvqSAPhB4QAzNSxqNmdqmOx61eKF6O+Up++enlRvHjlGFvvRLULXI7IZOPHTV03R3b1qkX7/3y2+nMDDUZCWGcAhuCDja7HBh9GnYddvcORyUMUnR+k5Bp4zACJfpcIig8vTdwRD2e4w5NOvHuC/NUQHQ7zDRy5pac4r8P53RrdUwfo5EJqCzJ2W5Cm/ahR7zYc40A22Opfwngk3HjUN9G/3ApS1o+pMiSqN+aFUhny0qUy1KPzKkON+XkqIxk+znZqj75wYL7RFWYEJmITr9V4K/zkMDlxjGs8/bibKnqJRgaz3FUHAFoCL6jJnVeV3iuPqJJo7kd30ZXMkYn2w7U4y1qYT5O9/iLmqs/Es+bHC6TEnSBWHbr9p/FHVNomoV1n1Ra1NGtpV6jolmwyOZrqM7X0AIlTTye+n4w+92PALpRxfEIZJ0wZJ+K03niiHWOjZlOgPrxcs/W0uPxHE7tzzGRhe7xJ5HDgjtoN+QjtknGzET4TAhKtkA2eXJxpwlx+S0OG4wFOo/XmUSRm0ZISgSYKuPFZdn/ifAHdsxdHS4O9DDwMHx20idIIW1lnCnDZY/ERCm7IZJd8bJ5qJglkqEGPx9XqF3/5IjBqyAkcapL4N0rVSVDZpJb76irjRMRDFymmu93hIEzpoGtaoxSC23dKkl2tdyvm3B+nZ7KpIGAX7G87UbnFsIbVaQLm/csd3wIZW9+44Dw4H7uYRbN9+KIAwrAsRuucRo7my2TleomKI6rNkXKCurhBzEtFpUxBpgP/JuktokTGuAb5E7+KVPmEly8iSKLsKtPnE+1UyQcfm/QUEh7hyn0/fkkZek2GnZGk3KWDomKVDLWoZLXaoHwRZVdk5/vxq4jyH/Kr1FHErpeXu8OBvYn9msDCeo5WUT2zvPRfTKGLbdCM42AVdyyf2ed8P1vG/FRwedam0UycHC6fP8P8a31InhfRQVCVwPXph02qjpQYnyE0nJ8G0ygdzlirSuO4O9e3Is1zrxQibM00whafvr0zfiQh5Cx+qvuycqldfO4TIpaRowHZ2JfKmtE3LFMnHj88b+wMIw+beMZfFbAwY54F5fjWABtJnbc896EBLTnVuZkxjv+5Dw187p4UEosPb4/uWX72Y47hTywuEmAYhzA9dS/HuXo08wU4ieKB8m8reWibyScIIP4RBltH8a9v8Q9zjHRVGqVz3+bQ54jihT4lVLCt48tAMuWQ2F/QCSvS9R6R31sYZWQ58sdHr3AlZuZvVRWeqzXlrdncqHn0e/yIdC4/p3H+EOzCPP9XCdbYFDoA6Q8p6AYFLvSBOCbDDNA9sIUOjaQ2n5YTB/G4ky73cfQRmDjyM5uT04Ifp5gaGfyMf6PdKaTkWr7ybArzXnlnpJtw4J1nNTPYmaiYPaA7dojwzxhpjXCx5QkPqwYmHtXKMId2EiUADIqzWih+vAr6bHys+RFI6rv4eZQOS6K/NVREdPYza7mGdvr9+MNhsTDGEzdVQmUz9CxmPzSfPa1qIeOf1J9UT9ghYhJv1A8dGiaxMyxM4seEhEl84ptGjr2bctekUiHi5HJ04pM/DI7Zza5ieScf/TnZZ19ydYr/g1+5J1lWfnz0R+5F66fEp0991v6R37D/UlqcxGVvoVHQgUNoYNj+EGtBkw8qUY3H5+c+rfufyr7giur3TbN37pMyDmbkw1rbSm/rfWmesbgv61qXOBiugvlKOGNh/PDNj9+8jwB/icf/rvpmL3/Jqghr66gMQ6yxwGEoLMFJ5HH0nf9RjYG5Nqb3p5wxMw0lSlFyu49y5pgWZzPm3pgsxTCxpmsVXqrnfJeeLvdwtZXjbReQLgd8v4wyXlrp+7pSmDXIX0ZklRp3ZUW6KPMxh8dlVGxpyEfB11GxzcN4Y7/S9+nLSBUCinup75fL61fsJcM7pnVLJgXYifIJhVhepvngxOdoJJ1YWkow/8aMJzsWNJ5Z0M0cFLKogziO6HCyjzldY/PHOd7Fl+jEG1w41FOwgy+WwT0cOnMyQsHFKCODLEplRRfOIx2CM5WGHmgcJNZP0EZJX11zl/o+zCIapQg7YvWUV51xAEZl/s7mGR9MwTnuIcldx2cY28Mcx5jibKiYlCywiAr0IOh6YKzxeT28qpqXyQJdlSypbQmLLViBZRECgPC1NvfLJQgGfTRfp3mN8PXJo8ktgVuY0tbYXbDVZUsG2UIUTjf0n+GhcihYLbUvJaeKgQQDIGtyR2ubg5O3eAFXvopXGbPsTTuJj7EZfipbSWguh7UkHpy3l8Ro8+GBE4EAMa+zHxwcvlSAdM12pH4cDg9HLOWlvPU1xxI/5FSW1gu8jRqgUbf3zd7s0zd785G285gqs8IQm/8bAAD//y+jxWq1kAAA",
+		Contents: "H4sIAAAAAAAC/8y9a3fbuNUo/L2/guLMwxAjiJaTXp5SYbQyk+n75px2Zs4k065zXLUFSfDi0KSGpO2klvrbz9q4ESBByU4v63yxRRDXjX3fG+DFV4tfOF85XzdN3/Ut2Tt3L4IXwW8cv+j7fXhxkdM+lu+CpLlBUPubZv+pLfOid56vLy9Xz9eXv3be35d9T1vsvK2TACr9vkxo3dHUua1T2jp9QZ0/vH3vVLz4F85XF78oM9+Ft1lZ09SNov7TnjaZc/2/bmn7CfVF29w7Nb13vm3bpvVdNclnnfM/yB15l7Tlvnda+vNt2dJOtHPRZpnd1klfNrVP0IN721Gn69sy6d3NHWmdOCJBVgfXP0PtoNtXZe+7jouu1jv5FLhoU2Z+fLXevXzuefHV5e7lbw+HyyiCIs/7Lfy43MGb57uXl4cDFL968blTdu5o25VN7VwGvw0unaZ1ijIvAJjxbe9UzT2DH6lVvV+66OgLMOH51coXTuyjB1g6idImub2hdR8kLSU9/bai8OS7apNdhOPo4U80/lD271tSdyX0ELr3o5Jv69TFf2j+rtfp1W8Kb7/X3zVGU6cZ1e1nuzlusqb1YfaJU9ZOjMrMv2vK1FkvoogEXf+polfJDrW0v23rB1qnYXyV7I4bXrC4PLLtpje3FempMYtIQS7m8EmixSVOo74ouw3x4R8Kmpr6btyNVq5aoockWqyPiKEWjfTyw4H4KQr6tsxz2vok6G73+6btg2GBAa1TJKfqdLR/X97Q5rb3KY4RhgkcMfG1Tm2dRLGPsO2F5/kkoHew3d2eJiWpgtFCooe4rNP3n/Y0nJseTmlFcwDdyVoFqdOKhjpEy8wnfhz0pM1pj4Ky4xAVO+XEAW/zfXwtfrUB2e+rT6waJm3OMLVDxyM6PhXfY/QghoHOAkqSQgcj32yxxZhGSZCSnsA+B6Sibe+iDT0cJqWYRkDcqVgIdmHkOh9YV+x59CreBQmpKj+BafORnl1BT6u07G7Krotc3t3uGTZxkPgxIJzvJlWZfHBxwlAgSKqmo+i4SYM/fvvju7fffxe5jEu7OA3e//j6u3dv37/9/ru/vvnpx9fwI7r81Rqnwb5t+gbmxdsbAylIJT56yIOU9gCgAVdd1iQNBngELb1p7qjPV0QV7LKIBqTvW99lK+Sb7aJNdjj4w7uipZkLlTPPy4KW7iuSUP8i+MrfRl9c/eXP3e6rL9EFdl3ECSmPiO9+4UZRlG2vdmGGNrHnxcG+Zdj8hmbktup9hPOgonXeF4eDn0dioR0wcDFrqCHXBHz/2zvG7Vg9bXEIx0HZiW5/4IPQ1EfQrVj4NxXpOt8ta3eO1vKgIJ2olpGUumibz3CPBFn5kW/dThQmPkJHwWAYM2PT3gw/oxgPD8E3Td317W3SN22U6i/q5pumzqoy6XU+JehE640qziPFhYaVCmyMOFZkXwKeTtDt30CwqUaw6UCa8W3fNzXDLreJr2nSG/QYAyVPqwtSTjizyYCY+ybPK+pGUbylAX/wUQgkHXS0f9eTnvrxQNMDPeEUPbBZf0m5PI2AkDntNnuo00UkoB97Wqf+wxEnwZtvf/f6p9+/f4dTUa3sft+QtKzzaHF53CQTQh+aRA8Vr/mefuxDVzwEQeBCx8MuyClbhJyblh2JK5q6QtipiTPIlgzP97e9i7buHanc0C36m8rFmQQ72sTLyIUJuLi+raooAqIG8UU/9p4noa2KXJxe0Z2PENZEHIHJfvyk7zKrJTq8indbHYJX8S6EQoRdDQBst/wxCIH9kTTlxJggzoQSILt92+z9BC/WCIVmK8+bdHOJU4P6E8kFX7P+ht4uETpijpprZG4Dx6NohMoE5hibsFfcSwgL0dLlCNu5u2dcKRUsT26m2UdW1qnavY3bkrRs3ChK+FRdmJKLtr58TgqafKCpi0BViBaXwAh5FwFJ+vKOusiAgCtLsTmsAraqgELeedx8nIzvedMJLKLRSgZmKrtUczQrcjiNh8fjEfC57hEmnpdoIrAgdU5ddKRVR53ReplUI21JVvuWdh30vzg/wCMmzvl8yvk83/qN9ltyev5ksPrEeHOe14sO03PMXnQ4cHsDP/8iERS0GTVSMnDsRCmAg3SO+9pFm5irSanivAjr1cvOf8YQ+QqwJhK4vMOOXqhwbPcMpHUyVRE0foalqNimwzZnTXLbuShMddoJ78qujCsqWshHFwVZ2Xa9jybt0ZGDjT1awObE1W37ueDkquEcIE1E4vPBF39hP/yyRtsvL4Ie6scBAA39W7TpGeGckLa57WjFxPOcDOTVEbaKb4TzaKpnb+MwC7qqTOlIvKsBLQK+vr2Jaav3QoO+8WMU5lt6le98FGZBWfe0vSMViP09ue2oj4LkUwK6gE30J/Oi/8uyTsuE9E3bWbm0mutqqOiO1AZhAbCJpBGIRl4ASwcZNZTIeWtFX3KmopeUPb3ptAIxTvCBfoob0qaeZ84UUPoD/ZQ293VgQFdKb1Zd1MDSLCqaOw5nfQi2Bs9b+G5T981tUnQ9aXu3rB3llJA/hFsC2WZzAxOgsNgTE2JjiekMrSpK7uiJVmybRSu7Emaztn69XhvqmdyI8Ff0BWYTCQVA8H1L9uFijSW0w8Xa1BYEICOdKMvMX1wwrnTo6ceetJRclJygiWAJQU/y78gNReihuy/7pPBJcF+USYEeEtJR58VvuLIDzBGUt5aSDxv+5rf8TU0/9upNyplnyGn+SCZM9WjOmsHN0DOliX84+Dr+KuE9EFlSUdK+FY++8dIkBa3NQutTqW0S/Tvaq+6M3YUlCiXN2i+SDhd9aTnt3/b05m2d0o/Gruj8UFAVCfakBfsSBUlRVmlLa98N4J3Sl1jFoITefHI46FSKrAP/rmnflC1lw2rj49hQ//RZAvtJIxe2zI0i4nnrKIqSw8GtuboMSk4U6dPh+uTqErTLVEJXQgdQVnlshA2qOt+uLsNLnEV+sqTov6Z9bqZQCujPfjbRkQ3QcicttMG29RmszcpXYSClvzKleeGTV7YlHw7k5Vp5pHTOuh1zHuq78MpkH5oQjEGUEHREYQKQGVB0kB+hGoD65FWy5VsScnDiEZAIGkGJdfYoKlsjbIULowCHUbOLBAw8b8ZzaPZw1omJBw7qg3U1EkpnydxcK8xU11thC/XdmewY9TkwxzBr6d1T++HgMfthr0YWP6eF8wgIZvPhoOOxTtR+jCmoOAZIcBFJco23bkUzwBIWcXFxyaoCUmc2G8OCytHikhHudZRdrXf4w+AHY8syMfqhpRXpafqeCZbwGqdypmFxZLRkR4wPCC8+WJ1o6EE2UvNZ49zzBpT1pwqTMnInLx5lnrL1VkIlNZorzoyupqwlQzu0qTyvshi0TPW7MWH3JNAp28tGbnO2I9shsNizYUoxwrCRQZNlHe3/VKZ9genwukA4M57o+RhGZoDxKsbFLrhuypoFxpAFGpiaLeQLsyEuNRzUfT86SU5x6QYdwY8y4yS1amEIhT6dcVVktvmPCATbsfoGPMiep/E2wa0MM12iwMZ4kqa6fLYZ6+rdeXNddSsM9nHASZrcWPfNE9/qnT8c/HTkm0fgtrM55pfKMT9iOYNpx0fODfsuk0YdFT8QLsxQAUPtVd+4aFN4np8PwoL7oZhzIMM5wgUEDSwmJUjcgnt6xvrpZtadoUA+WODu1TChnYvp01qs+oY1wsS/L+u0uefNq4aYNEakY68FWTKAb/cMnY5PSUdJghMJy8+LiLFecRrFMziRRPEYJ5KTOKF4mp+ioxZT+vzoW9JUFdl3dOIvSAd/QXLaX7BZUMAX7hTxvIuuaO4PRZlS6QUBR6J8z3DNDPWpKRjRPu5BmIn3KddA+kjXgC0qoK9QCkTBhyIydgqrSe6uYK8i94tnyzgo0+Uzd4dnq2obbrR4JsYbJJLpWxhMeLBvhGrMH5SC/oOwfbiSS9L0dVuS13X6jRhecGAz3GCscmSXyQ3kMxPhmMcHQV/8aq2BNHrgPTCLWw9WpeUNrTvDupIu+jmZfA8SV0P+rSgJ3YIyHc0cAfDPqoMa0JZ2l2U8iDkKqwjTSAe+5+lPutW5JzXjkFpRWWNHIjcgsTCMKDi6pC3gxxG1EiMLvxoTRmJOmaYSFc19YLSb1xczhBfZjL5oTinh3I9it2C6EI4PB9skWSRKxY/ZqGpzfbQxp2FoCsN8NU1BhxT4BtdI9/rTj3tSp8ztvx4Rq73v1D3X3iQ+rrIXOuKcXwGb7HQN1ClrvgjXtQ62nlF/2I7W5pYeGdrYtFhpexR8w7jsglWUEQkSckOrb0hH/Su3S9qmqlycS11xBeqFxeCeqKzSoVNI394TwuiwfmOMq/Xuqtyh4/FoEmxh2HrzBPsIeh1oo5CW1iNoI0Z4Ec/Qhub1mcXuq2S80gSCr4PN8P8zPjUbwzOwyYrNDKFm8PlyTA9TfEzPNrYRA50Qw2ksfgqFuFpEpyjTlE6w/oT5tmX5aBOIr9EZLKafgcUh1ahrjLnTYDNUuzqFqFvOVUNG6i5oMkaPSrDbzBLfohwgbqA/O62D8LqR+2xp6YJrJFxrnCQJJIPrBbTOzWltI/YpwlQG51EAqtZohXNtrd5WMoLehtjxOAEDxgjLDaiPFwmaa2Vk+cgmG+NJmY7i2Zbuo949wqyU3Z5N+lGdzoQu9V3WYpfadnGJMGORphMrbsPVC7aHeZRZ9ZIiyrcyfBxKU3OTSOOx+Hw7yW4lbRKLnYSTKPG8iy+uXq/+D1n9fScMjWTWfjKT3VJoTfxEaZQp2OJc/9mmYazCCrqJlaCHxPNegG+fh3oOB5/4dMjTg7xRdDZUG/spWFoj1xV3MFAN2Zs9rXnihueJ7EQYGSjI82aiU8k4OgW+5qSpe1LWnU/BFymrgPVJFQdOpmIzbZs9RMZcZoclc9l6qZ2w3IxU3dRrJValObeHUYUEMMZFYHhru5A+zdBNdUNXdYw26eFge4FTZn7mp5JN02myKY1c1ccqJskHeIDcrRGxqnF2z4C6ZrNQTShoxhg6bvKJLZafkEYTTgCKFWXpGYHMSMNOKH+6ysBQHGCMj9BD4iO8yNHDo6PKnrfItBSKmtzFpF3VxIiJ+HOJ8ml5Z3pEp7BGQVl3tO1fZz0gla+FoWVeLxLavZXumJ9N4mQ6Na+G3SgQz2+xKIocLTd0kqgyQyF9e8t9pYbUmiMQZRcYczmqlPsjzk9FtROmVF/4L/778Mv14flvDi+eI8k0ReDa8xaPZSsjrsaQYpoHlARd3+x/aJs9yQmnT7xIz2KfYJGQXWzDvkXuec9/sxiYMHtWTFmaRFoR2LZMRcqmSURYy0viqCIRxXWqMqyb3ldzRTIpySEQFKIyMKLw8YbWt+6yYNMsjaTB66gUMWjFfzcv/lub4fWrteddr1b4l2u99GWpAqeed71c4n9cHw7+dbRGuISQ5fV0RWBciSUwdUPObmM8Sb1FPhs6TT56d16nUd0W53Qa1amWy3y+ygBkJ2vaG83LS9gJjTGeHc93mVk4Jxpn35xtJ+o+puEYUaz9PFV1wqnaiFl5aB4dUGLvpkkJZInlc1li9IzXNzscJt3hTM8By61CdAtJxX6Kwpx56sB1DP/9VApUa7rXKD/ry7hJP0Wa1IBnhOfcv1+mJama3B48ZlNf8RoqcUQKFz3hq3sHXFgradoyL2tSfd2kn34gRroY87jEpGXRQmkpl3ndtPRr0fU3gJ4qFCYWyPSlnnreiamCSkfrHsRnQ1Lf0hxbcr1nPE7QBT94IjDi+OSErBcsIevr19/8zzc/fv/DX+dOyOg5WxK8o+QsDJgwSdIa6zWjbCCxL9xLDvqrSvwAtCLjzALDOxxLWcYQlk5lv8Drkd4QH9HGDk+KDGQBGplRnQ2cWggMYem17yTyyDg9HC0YlzEK0NQijhpcUPIatEvIXsX6W9qVf1dPRoYf55PirJS26PEhKl68e2Ym8QHEZSKiTmxDGiDnhJPu9XMIIz8ka3a7P9VolKJbdv7QCdhNqZ3a1iyUxycqkdDCMa05A6nNp8NPHm20dyopTR6V0t6R/Z7W6fvGT/kGIqy9ZPiKBO943+z9NWLnKq5vu/4NgymEd/V5jLMSUpt3kR2hSgNaZ02b0N+xLGVp608VzHmEp9tU29szCQ6phdVIhXgISTwt5SB8bKcjkjf9yzF6sJ9tsziPpZCcdRvrROx5M07k4aSLIPbLkwRqKE9ZJpZY1vMTmpyY4+1m6Fq8naWxMRln2Uk6njueN+cEFUf1nhJ9UHzmDzDiSSfuDOaYPfhjDNFpIzJSCM5uxXAIQS+eCmCCHmRfEZyj5ozrcDDgcLXezb8EGKoM5IG7zMh1aQ9IaW6ul2HexL2vENmaqr6dz1Sf8mnb+sEoI9K40aS1nGNoSs7RcFl2YrzR+jgtza1vayaO8MqWufMpspPSP+1T0ksxF2rts2zagYX9MLSzR7xHigQHybyAIkLyGzRvCH/Cjwa+ZnID+ux8VairEcSCNYMjTi5lAlkYVgrVabRUTtnzzOfBTWrRr80h4knnYy3tDGvh/0PXVRE/O2LLgYa4uo2P0SFuqGb2BHcV3xrZ0nGXFA1awKDJPUUvs9GWoRBPFR8WMfNPGCEoZDUke2FcKLltQZPhKoDn+W7Xk75MxudM5Nq2Y1YW8BNRGvf1kTq4iXA2xpGxNmO+HWs0i1j63LLtqKZdmsSzIuOU6YLC2BdHElVc2kSnLwc8suK8Hr7iuRKGnmRSlM9TPyDx5p8Qq2cgkX8mJHIJCTFFk81pjHLCF0wl1myovzuTFAQYwtVjHlF/NedzDpKqpLWIu484bNJ1/sOepJCJ+nua9SHfVKDCt933d7TNquaeJRyIUwWmMR+6Lhatf4Tew7nWi7nmU55qsuv5HJTJ1MdzmXZuWpRT+HJJFpR1TfkEebqJzKeYBXBO+6+b2xqG/obB+keaQAyRRHHAMudXfyB9EZC4Yyeosx4d7YCKDB+O2Dg2k5fE6kxhZTeUdLct1eTZ9DT+/KL3pO3o27rXGDCDrSuAuWIrgCjpGl+u0cbq7THnze7FCfTNOBxcEb+xIMfpgTFZWlZuQxvrIs90blvNqO8xeKcgPCkANyRIgC1BuED4DVZqLSvRuWs4MphI9AlSV0ZpkmBFdLQwDzlBa2ALgtcWZQW94NhIGGcz2Aw/Zao4e7DlifMX5z3fvLezR7p5d7NxfOVYsZ3nHg75jq52ISr6Oorss3S+k6nlZnifD4+GuP7gEebuxHBx8YUIF0FQmWrZ5vIsMdrw0A5xWRB+Ylxn4kSX6VYz/fh2R5loOdZMTWcDDD0cGgcXycQQArfPkOTOlZD/4MHsvmmqvtyfuDYF0qgvUtr1bfPJzJ+GYP3hYOnMcuZ6NmPa4l5niTZsIVB5mn+sldCaBcG0kp4fLdFK2KFbfgfKUKi88UNRWY8rlXXZ+65aFczr8e7nsW+Z1OUNCwSBQ5kRAYwfuj2E5TtaUSD0EJww9GZfkZ6Gz16m5Z3DOFakZuG0TUWHx1eWOivSts29++rlRVreWSswsaoqsL/PsEBNcUrZ4QiK+7KvKAj0lFbkU7jGcAcMTFNkcFA257uS3oNOGD6olbjAApUeEK5Hh4UBtkZEBYJG0qSR2yr9zwwP4kkcJRml0csc9O/5sy+PI34pp2daCLIU4vxA5r+Ts7HWQltr8ZCGZ04PhfbqEj6Hg/U9MnHxgfFqgDDbFPjB9iVcXB7xWAct664ndQLkNYjBQYiMj/TKmUyvK/ybfPU3h1d2bm673ompw+6Py0qaOvcFrR3YxJJU5d/LOnfcpdqrpes0NbvrUehxcj4OZzELF6nr/Ki5KQILtQsZWYBQHCTOVquNPGZEr7IdaIUqDylHc8aqNjVshYHpXOECRxiEG2lkuTekviWVu4hyPoUiUjcc5FvtQgI3lJ43iMhP6rDrB2Sd5rZ3JynPfrF0nzpnNrIZ8NA6LJ/eIZumdNMdrQ04RfzVdopFr48fFGsRIBz4HVgGnFKy8uN74DX+9AS8kL+dRd0ZOOykleAC1qPS07lqw0yiUUKpiEFSMzboedNLROQbX/yKHljUUDxhkJzyAUS+ZZH8fsXJtKXNA3HwaDpXQ++UewEpdkwLMEo1xQa4bXJFdosIrk6Ir8guStF0XgyrLFeHxY7GbNgYGqPZxiHEwAw/DRq0BA0P1eR5OiWwoHFn44603TLgBScLHzkqTuCmP30FIsLCTpkI1nulaDiK+IU5W17iyms8duyEfRL05d5Ho4Tgw8FlLRNN++DOLr0kYnVDn52Kl2diE6nCIDypixNFgwLbRgUsWCcHkqqQ/cDtdILQGw/2HbGtX4RCWWFEo2X3lkPtfXtreFkkkydwZ6su2ZDygQpwE3l162I9XNlqDMIY0r8TFyFh7P9VPAQ5cQIRLxFeJOY2qOQ/53HoBSOcxy9gY4/ELz7lMYLJ2IqtY4ZgQ4X5s3OjQzXSfBvvJnsCwuSZKb68SEiol3PJJzFSRrpKU574+5r7mrZvZtxQE9VMXNlnNyUXqXQZD/oQziK+FmAu8l6InPY/vX3ja4uUeRhqhTgTxnfJHKp49r66lHZJW8Y0jT8NFeWWKFsF0qAGB7dIKhC6j9yPQQYafSgTZ2sv1hRndh/FBGShvR0uo4s/d1ty2zfbP3fbixJDHiMzSQu0ufY8v4gK5WcowbFwODArC4CjrrhlPsu+2YdrDG7AcI3TstuDiePGVZN8cI9Ivz3BTsCWa4SUWbTNRgGVSQ1+zdiQImzaD3Nn8XgLnhtlIDxsy4fhHG7DHeg+wlU0uSfiRi8S7ugy8685aUHGZDPtybSl0KaI3Ljp++bGjaLC8z4E/Gl586oRP7cM7tzGFXX6Zr+6ednA/61sLi81EVXYw7J61QTsQK26+YT9E3Xg96p62bAfW9E+LLB5g0Vt7CHzM+zVur4hVcKiDen3DAx+gT/gCt8IqmJ3UP8gsc7f44KD+Oep05FqPG5DT52fdJeU7xamE7+EYJiEn3old9SnZ8ItfbmfhlqymejKz09LTfh5cr2XCZBRNuQQCBUsi0bpGOmyKB0jHc4Hp3fKvcI3pM3LesUJ9nINZ3tmqjB8YHU2Zfcd+c7PIaclZ3nI7BnSx/0iWvNTWPtllGPu9F9GBZau3KCjvUAAmB4eLAPQ/vLQSJUY2AYLI7QQavAJdI44GzGKeXwB4BgjLLKm0nHcrZwC6noKqI2gocTzrhdwfbbPlhTxhWWr6zH9/1GQKQ/g0PQNrXriJzjGJb5GG05BWwkO/hgKMDEqFXfnXPTN/sDpVJ1nwjdRtX3+laBCuizD519xys6W17iOqq2rLccNXX0l7iYVK1OZSoJZvwa3lX+DYfFX9Q5Xk8jCUM3wFqp0XOb4Esw9UXyDedh+tf7Kv1yRixgt3f9yVRXOn1hFkBSTaI0QrDMBQI7qsQK6MGA3RGbGmg43dGUIAvCkbfmdyqELxxvcnR/znAgtQAtkzbTnZu/wfXBguo6IBZ3JKdMOKXGVf2FwK88bRCIeOJfnDb/1u46nqoP1vOzA5URE1tBr5G1Mfbnned6j7DarFjfhqTkc9zl15sXJHn1le/Z4Lpo+MTVQ+hJsTmhz66T/40yseePLs6bMM8uUG+FcX0g9jOgBGBlRW4n67Pjd/Hs87d5FRtGUTgYV2+KhGdHGxO8h1QszFTKKR5lWG2HsAYtOI/fr79/8b370kJ/+YdfHzAV/+VXilKsTnudTw/1D8QN7EVIRIaaMr2F+i0dIhS6zosDhjkjmqArP5rs//n/qQFmi23KT9ziP0q2hch7DbAtzC2PJEUHaPfCQZJhuZ6PcKhf3cBiFe+WLMB5+A9BLGJsvc0gTY88+kisdXvACHx1DmN5w5l0HWoFLnE+3c6xVjRj1cDRk0B0JhwoXPrEYHAuBxORTzKd68XyVXDw/Sn1Sb7dKTzYQqqN1pIvnq/Tiud58lRzDR1WU4xwnYLCKXwssOGc0sWKjMnoGZVtsARXINxPHsBbL6D9kDeTntXo4kcfo4ADTETKfIOny5tDOVrnAL1wqHUQWLdNN8TJnGj6jmYj9XhVh+Yr9WuYCoECKQwVVvCp5Po84pCb2JMMfxM9ltkw21y9zrsJwao340+o6/PAqD1rVu/ZumfPNWn2QJxQdOtk3Owse39EvHbEC1IpAovgEZ4V4qcVoTgL2eiv+c7M4ZgawKJoS2U9v30RmCq9DltE//uFf0l9/xVVQUqfNDQTA74uyokNiYE4lF/n609sUNlbN3hymL/fW209Abqt7QaHS6P4HGb5E+HIRqVry4OEk2KTHjP4m25pxp6Spu7LrnSZz6EeS9NUn5xLUoVVF72jliD1ZuKYrHEY1V0RMxXF0eS9/q/0+HAYdb6zPsQruJF0b3EqTjJchmGlWFyc45+tf2s4bfSvezrbSn04fWDIuD4Yv68CJ+0c6S/F/3FOKcLwd3KJBwjNERwV44gTdJjyI4SdAT9yuThCnranffjtUCYeGo33juRAzadOGs1XPSJCKYJnSUeK0kUseuEvCQSzU1zcDPMQLTAQR8v+DAs+fuYJJJEbLJxUDlwV6BsTR/OCEQPON/iCTk8SjLT1JvjqfoCT7FClK/7F0l32zZy7zf0m6i+zsX5buwvNNVLc834RfszXAbBqu/4E3GD4nKPfhunORNV9FZaXoKt3c7qra+EFLW5FJezKyK6804HYAJIzY81jk6k7lsZj5K8WLUVshT1+9vChevLL0rU6imgkuR6ST8eOWrqojM3vVwP1/Plr9uY4GLSCsIgCD88EE12OdD6PGw6ne4crkwYsO8LN2PCaARI3FneLDoInd42F1dzjCiLcvkA0NAZX5CpbY0lOM1+H+bgXuqQF0ciOVBhnbNUhdf1SgtyuOcSAqbNUvrjwSpjyqQvQv14Kk9qOLDAHqjf4gRYZ4tIkM+eq8yJB9fp7ISIaPs506o88NmG9VhhnxE36I16i85XZymJy4xjWeftxNJr1EI4VZnKpzXbR0naAmd05VOq8cIlOimR3dRVciRsbrD8/8LmuuPk3O+nOfq7oTz1gfS5DiJUEsG3T7T+OPqLRNQrvOyC1qadbSrpDeLVFlcjXVZ0rpYSZWOZ14XjL63I82dy6M4xPCOAFhnPDbeuOJdIy1nE0O+vByDftpUPk7HbpzxGRAe3xI5HBghtoN+eibKeN6JXzGBcRrIXN6YnOmAXPxLQ3hjoebpdabR6GYgUuSBeogYMpn2f2J0YVvXz2/WtrdC8fD8NFBo6LutjLuFGC8x6AjFNyQySn5WL/VTCDIkIMej7PVL/7yRaDlkBN/yEli3yiVN0Flk1zuq6uMIRFzXaSY7naHA1elg65ptVQIpt+JPoE1r8C4P07vZJNOwC7Y33Y8cwughuVtAnr55Q4dR7suaHDedzELZvPyRT4JnMztcxpZqi+TlW0Q6UeUhyPFAlVyA1+X9ErpjEw5/nXUW0D4a8R/4leRTJ9w8kXkkyi7ytT9RDuZ8sH6Jj2Foxxl5ueeF7+Eq+eMD2XMcFJm0vk8Y5UMuagEclHzRZRdEfgE+KuIsh/iq9RRBM/Ly93hEL/kvyZzgZajXZTvDCv9F9PZxebUBjteUcfymXnP97NlzG4Ff7YcV+M3h4v38C1iJvX4fRGd71ala/v0wyYVVSaXTQ33p/lplA53rFVlMFx3Z+nRuPdKAsKUTCNoseWbJ+NHHEKs4qe6Lyub2MXnPiFiKDlqIhvzUWozqsBQdeLxy/PKztDzcIhn/FUBAzL6XVCWbw1AT/K+5bkPDSjOKe/NjHH8z31o4HPPpJCYf3h7VGbY2Y+5hj8xqIhPQ7uE6alnOc7lo+kDYLgcVGH+bSUubdPpBLmIfYTBlFHs61vswxwjWZVG6dy3OdQ9onBftCRKRraWLwOJkENifkEnrEjXO0R8b2EUkWXAH1+9woSYHr+VWXi22pTVhrVR/er3+BHhXHZP4/wl2IV+/69krLHOdFykPqSgKhS4kIBCOsEMs3uAjQ61oDZblhUG8biRSvextOGQOLI7m5PTjB+nmGoR/Ix9o93KpMRevnJMDHNeOWe4GzfgrXc1w9yBVcxe0B1bWPhn9AQZPlsW8DByYOJRrgwYtBMvAXwF4LwUih8vgj4bHmt2BZL8Ln4epcOWyL5xEdHZz6zlarbT78cfDouF1h8vFPXRZmhZzH5oPnta1kLGPqk/yZ4wXcQk3sgfyjVMYqtbmMSPcQmT+MQ3jSxnN8WpSSlCGNXBJGePu8I8Zg+78u3F9Clt9iUTp/g/+JV7kmXlx0d/5J7Xfop/+tRn7R/5DfsvhcZJbPoWGjkd2Aw1CJsfYoVLAmSgGo/vz31a8z+VfcEE1e+bZm89J6VdzMi6NY6V3tb7Ur9jcV/WtUpxGIqNIf0ZDePHb999+z5y2SAO+wvZjuKXyIowjo4KN8QacxiGXBOceB5H3/kf5Rjoe6Nbf9IY08NQPBUlN9tIYw6kOKyYWWMiFUOHmspVeCnfQ4sh3cNWV/S3Xfh0OcD7ZZSx1ErPk61DqJC/jMgq1UpFRjpP89G7x2VUbGnIesHXUbHNw3hjDul59GUkEwF5Wep55fL6FQwyjDHNW9IxwAyUTzDEsDL1Fyc+RyPwxJBSnPg3uj/ZsqHxzIZu5mYhkjqI5YoOK/noy9UOf5yjXXyJToxgg6Fagul8MRRuVac46aFgbBTQIItSkdGF80i54HShoToaO4nVG7SR3Ffl3MExuiyiUYqwxVdPWdYZm4C5MmStnrHO5DzHLQS6K/8MkL2f4xhTnA0Zk4IEFlGBHjheD4Q1vq+HZVWzNFlXZSULbFv6xdZduUu4IQbha6Xuw4nSQR7N52leI3x98mpyg+EWOrfVThdsVdqShrY+CqcH+s/QUDkkrJbKlhJLxa6YhouMxR2NYw5W2mIJXPkqXmWg2et6EutjM/yUuhJ7sGlL/MV5fYn3Nu8eOOEI4Os6+8HB4UsFSOVsR/LH4fBwxIJfiqKvGZTYJae8QODvqAIaNXvf7PU2kA+mvVJ6Hogyww2x+b8DAC+jxWq1kAAA",
 		Length:   37045,
 	},
 
 	"data/js/jquery-1.12.4.min.js": {
 		Filename: "data/js/jquery-1.12.4.min.js",
-		Contents: "H4sIAAAAAAAC/9z9+5PbNrIojv9+/4oR14cBLEgjOcneE8oYlmM7m2Tz2jh7sjkUvQWSIEWJIjUUNY8MuX/7t9AASJCi7Ow991t161Muj0jiDTQa3Y1+XD+fXG3/duLl49Xdcr58Mf/sqr5CIdYfvypOecSqtMiv6qvtrfg2L8rkOktDnh/51fPr/zWJT3kociBGAvxkFcGWh5VFafV44EV8tS+iU8Zt+0LCnD8cirI6uv1XyuZREZ72PK/cADEyWWCnawg/pTGadFlwtSmL+6uc31+9LcuiRJbqf8lvT2nJj1fs6j7No+L+6j6tNlfsSpe08Krk1anMrwLEcOPAX2Sd8ojHac4ja6K7K8u78sepNumR9Ed+x8qrkHo+iYzOE07D+VFMF4lpOA+LPGQVSWg4P5yOG7Kh4TzNI/7wY0xS+tSQLU3nVfGuKtM8ITuazjfs+ON9/lNZHHhZPZJMZNpTSy6WRXLa74QajJiJfB7n8zRPK0hpSEGv33vr4/r01duvvlo/vFr403rw/uw6IQd6/X62P86uyS29niFvHbHZ7z6+TlJSjjcWzKvi74cDL1+zI0e4WYmWaT4/lEVViNmjTxJ0nD0Ji/xYlaewKkonJ0eecXi0LJLxPKk2zoJUxauyZI/dcrcN8XnIsgyJuccNSXjVAwk99FOWTShzFzfMFTk9NhU/c1m/78hvvtOvTKzGu4qFu16VYkkDms/3vEw4ZJ0bA0CYsA585oeS3/0IME4BOgKRt+IP8lW/kKAhnIWb0a7PRQq0QxhuyJ4dxrJBdW2HUT7fswPqA2RAwjY7kwMNSCjAAOOGADyOzO+gYj5nh0P2qPpTJgDQR1FBnJbH6lIF/BYtcEMy9sEssyVuCL8dmW5jtUhIp2yKxFIGzqKd60E/wxu6sO3gJnQ9WNzQ9x3PF9Xn0cVRtotV1+frKuHBScixKCsnnIsfcjzAtIVz+dCQfM4fKp5HFHaaejbaE8NhRMx7RDiJSULbSfQWfl0/NWRDlyTtPuthb+lkuYoFHguKIuMs77BmYttoS5NeZRtV2XSKyRmaTeo6n6fHr3S/ElzXKKFPDSYbSmlq2yiRALuZzfAqvdmsREVpjOROQrzXEsaiX9FVml9xzGjiRT4JKRc/yYTSUHTPtsWPaPWnjKW5nGcUiobFbkqPsMFRiDF2UeCigE6WJKbMtrtEhl0mVtFpv5t1QepTQ0TzVM892pKYhBg7d0UaXS1UbyBLiFvgSbqFQ0/84cDyqHDUeWFN0X76Pas281J83iOM5yU/ZCzk6Hr95johloVJevyZs+jRmSwIF6dND4aHJ5HYx3lRHExAbEi3HiMb3NKfLEppPhfrCNWoqXHgr56ouh6pgImUs9K/yqPrMs60bUYpm8sjTpT44bTnZRqObFKRtz2okJ7cibl+th3MDqw88q+yglUowNPlDV2Iat/uD9WjXMnzqgHuAwFfDKtal7p6KG3AwUhpIAzqWm+DiTEHdc3meRHxXx4PXG4KOSOI4a6lqnwUxAUzEYJtT3YSiTJiGd8tbKSYBbqjj1jp8Sf98mNsdS01IavCDWrx9GTZiL5n8+I+/0rgV3w2EVdtLwJsTpMGbQn3lNKgro2sDRGNX1p3SpnLppblnGEOMY0GKOqvbuptVeXY72ba0ekNSbIiYNnbO5Z1jQb4KRD7uCrTPQqwbSM25w88fBeW6aEyoDjAT2zO71hmDAAjMYqQ7XkmaIyxobB2ox6ItT/OrG7n3pISNmHEf2B7s7BBw0jIEOkCsPXzvCq+K+41YSMmtv/l7Chv6UAS0YWAxaMAriexViFlCr2vwptoFUkcG6hRCvwZEfEjmpkscVBytmt4duRXLcJlHy+hQYE1RMz0hxbdshwkFr6bqEKgN0F27PiABDMI3KCuPX81RBzoiFrUjF1NMYXEOgKGMMFHEF8MO4kcR0gYxiRsSJqftymoGNFqJKYyAKp/o/fCRpM1jIRyN0Q06OiG0F3chC7g8j17QAsSTUPshM5iFd2Eq1DOfihmNbDtwAt9Smm7z8JGPsyWDYGRjM7EtG0uogvCu/W936QZR+FNhJnHp1OfBl40nfpiEKE4lbDM0B5TgVjEftYWLGWVlBOxr0p+OJsfMXKYI8IF8xHThaANdM82dBKukpt4FU+nOKKTADEv9kmMSTShdGPbHAgp+NriET4kPY2VIJwkdEE21PNb+JaT3w4/uklWyXSKORWtJT5JSIiJBBVu2xvZIserFroTCd0fLaD6FyvC1PPJRjACpzRyluRQFg+P43uRxKroGTgGto1iyrzAJwFlhNEYkx65xLCLQqqYhZYQIi8w6dF67XrJngWSqiSa40PDCrAgo6O56Dpl5o84mMTvdEoiTckI5HV/TslOBZXxhlW8IcfTQbDNTtZgMoKz3z3ugyIDmizOPfk2TytesqoofRqefRKTALyI9aUkQK9+OO0DXl7JA/9KT88V7FnoxNXPPHn7cLiSaEBSP6phC0jmCllXFh4wzalnefIAubKmwdTyLf8MyeKVLnN17CiQyYTZtiVBzhLgIxB3u/3bQ381QlOFg9PfnSydjmYK61qdoVYOg+5BS3AjeI3ZEiC2EV2p6BkV0pH8ZENSsiU7kpE9yUlBDuSWlORIKnKi1jH9/feMW9Plc72U5M4UHtzTBXmgC/JIE4Yw+V3+vJI/X45z40z03bZRRicLTBYNeU2XL19+uiRv6FMzFCe8FSjjK/p2figO5C/i93TckK/1wzf0rRJefDtoTGOdkC5Ib+u3uJVJnBq0OHXV4dS/Uivc8HDHo1qKAHhUs+NjHtbsVBVxEZ6O8HTI2GMtGOeyyI51xGNe1lF6ZEHGo3qTRhHP6/S4Z4c6K4pDvT9lVXrIeF0ceF6XnEVFnj3WSggU1cewOPDIIt9Ry1uvH14s1utqvS7X63y9jn2LfE8t5Drr9Xo9r731+n7m19779fphsZit1w9s4eOpRX6g1nrtWdPvptZzZE2/n1oYuY56956/f1ZP/uW7FKsvrvMJ6ip9L34/8fFz/Em9toYJa0ukrK1a1YtrVct67VvkR2o5XYPrNULo368a18MUhL312vdra/rD1MLPcT1/jtdr0TT5iQqwlPsafTe1phaxEguTv5nfrffQxylU/F5V6mPdCn4uxzB9pgr/PFL4OZE/FibvxpKRdzP9l+jid1MLt1l/6WWlOuv79dr/ZG35z11z9qDtv5slfsTkv4aNfT+1nlmY/Eqfvnnj9NL+pKbewuT1d6/eveunrtfzLv2XV3/pp8qk2nvui+RXv/zyszNo9wdMfnr39u9vfhwm/IjJ66+/+W7QGQcBYIMYps7YsarzaiP+z8QLnqFwk2ZRXcQzgbgUuKj54Xc8r4soqhHypjO/xmi9jp7jvO4gViWo9/U6muIat5MJoGGlFiZBUWSDkYqd8NephZ+pLDnn0fG1FH05IysrF9bpesVv66SqMzmiboD9MSDXma3XEXah60bHkEu99zO/fqa62JB/0GvRqzQ/nCqFbGrRGVZyVgenqipy/Ow6Jb/R6/ebdSQe/5tev/feP/nT9dP6+Hzt5axK7/jV+v6aPJO1/Ql5AjtMcY3W91Ncr+f6A352Tf5Jr73pv/xrwhi9/qRer68TErAeqMHG89briM1i/2lJ/tzAINxajhDXcxiAgNmQ0VFCjFqLB2sazP78+eef/llTRoKoi+o6dANncRO58rSex2Wxf71h5esi4iiaQgnsjCbe3CwX9eefv/jiz2S5ePGpHdWf//nTFwtBrDCT3tkj3ACv/LWieN7SbySJczcH4PuhiPgRk/7bW8981yLZlilXLDFn+Olr+gT1Om9VLrd//vxFNcuIajbAuBml0plBpCvKnHlhR2rjVUtkh7Nl0zQtsREzmPCIcFlXTDbqKC/gCL8X52wg+HVevtFH9gMN3KAdkfOFIJIjsSae3zJDE4PDnrC6Xk4ofbDtL+TPEl71sQmsz4TbNkKi4l5jdR04d3hCaW7bgqkmgaBacnLAwCotVb2ooM+A1xa0ujiaY1p4Sx/yfEFFW3CdgrY0mCe8eptxUfmXj99EKMa415HtPI0opXH7UZLmW0wiyaumMboHCeX9SFW2XaGAbLFtf6we0aHCe+HrdA1lETG7ePzy8ReWCEZdjIxAD2Fwn/rYtsN+ztcZOx4lgx9cSPloa21OMRoSNYKhm98emW1PXnlsal1Zvm2jyW1dT27nFT8CNyzXAtb0ngbkSNlKT5UhpQouCB3wE9rJdXlVVWUanCqOrDSyMHZ3dNey7owRa71+ZlvYCebHYWayoydMSpoghsmGlnpLZPS/ZDd32LX+ZE13juWlEf3Emu6m1ie+pfbLZjbDpbfxaSaGOL1lSLzh1ZGW822R5sgiFib39J96zLZdMBTMD6zkeSX2Oq7rQMzWEQuccTbN93O4H3qnroNeZRk6wgRLhPCIn5o4zVmWPT7tKKUnsYQl3xd3fDApTaM496sUdRKhvxHr2VKcV7CXuw0uyGgppRfcbPs5QKHY8i1LB6AZiqHjm2gesnDDv4P5s+2IZ7ziV4HH5sdNGlcI+yTwIK9Pue5L0DW5YabMyjv5dLIgrEtPmXnvFJacVVzBH7Ki9M7Cq24GJxOGAjwiSNSTZS6BmLPuTU3fa4GMJeYQ7LaB/Lasj0QVB1dbGK40e5IOPpvhaM6qqvya5VHGvdDjvk+NYe96tQW2zUhEQ9teUko7oax8D4x39K9gfixOZci/ySP+UNev8Qz9iw2/AZLtISotYwlpOM/5Q/UuDbI0T0DoY3Als2UraXGXzmzZ9TgzF8oUTqohXNiumt0EegO4STHvcAlKKTPmd/8/qh8ZDdS1JekYeMMX2svN9jYMmU1qMKXTgJhJIYlkfziJKUOeT/TCkwCThMZ9MEhmMxx6nMZe4gs8KKCATlAkfsQzxo3413ap6O0F2x67dWej6N62WRPSmM2V3IM+NSQW7+nxH99/R0evKxAbHp4Mt5y2aqG9zHWtr3/5/rs+XnYmy4bsoVVe6VpGeH9OEsrc89acu/YKSh7Z4uxNDGBPht1xUU4TUtB8mEAOdBKjHBPERSKP2Smr/ivl99i2BcAcJpSK3cPnLIre3vG8+i49VjznpXv+CVmnPCtYZJGIkckSO1xsZRZuIJeo0HhFVpF32TEmIex7QMFHmhrAY4rmQ31uUiu1yIQNjrM22cKNqHFsyS/WzQ4HnkcSkWmM+brYS4xpYayaOycZBA+pAPi81fakp/8tD7X8Es0gSwoS50IXi14XGRakz4lMBhWKuup67Cs6DbspGnNRNI/TPJp/82YgmhF0xchOGtJ2tn3okM2AVuuulELXC33H85uGiPayipf9Ftsd1p62ASNhV8Homp1TMwInNw12kDpR28H9X2hWDvIidml7Ik/J82+yh+2MCMryjmUnrvpMVF9/efUXOg677pig7mNrZBS/SPM6QH26wTn5xFrZ8YXbKc8nnC5ITC9WLo5R67kF9yJP+jSN4ZYCi3M6NFCXot/DdpYiTfvEjZ4fEJvQy1T5/+EkGTUc3Iv0ejcfpBRjvxV/JPHe7fDhLAqOBQ029fluznNeitOCWi/ZlaSeT1Prk5uX1+zmpZQ4dJ9n63K9/uRqf2RZVtyH7FCdSk4/+eTmZXGQEm4lD4Vv1/Ljzctr+fnGIux8rS2vX917+sknfovbbPtWLo7lPX//zKedXPKTem2tQWI1WqnuSVdVXeuqOgmo68BeqKXY51JdafQvKsc/Vtu/6IVyjpISj5TpkkZLsj9Bc9PnI0Xnf5pPvem/fDhtBst7gfKW1BZeDXkrAZIWsaQoGrpiQkeAB9lzccYR682laRLpNBpbOygpJWatiPnSpPEcBORjk6aTiOVoOfqFWp4T58HCRJck8+eOmC8sNs1eMBz8qPPrDXSkhU6q62J+z4NdWn3fzysS9sXvI1+LsZzHwUexIwcrFs6j9BgWeQ7ACvnpsdXWAJaJdO/ecSJ2B4ytVGObUIv8KGDhlt62E29I624Vk1sLaqJsuedentLME+gZKeZhsRdMlyYDfyqOqeg4JhUN6trIllcszY/YHRNhfdHjklw2JAcdwU0FfQZvZVwHRXU9QZNICpoiQwdmgsK2abd7RBF22KWu2/byz/bFVBThc0EcXN7LEySgPbGASDEu3ieLVcvDki9p4J7Vw8wrpUwwz4uVFIdOLvZpNgkuJbXHlRs5KKJjPAKlFJ3L3bB7eQoC7CzJ0hazLlUH33BBP/NIrNClQtBQ5Irx5XU96Ael9M62K3RHGHZnSyeQuYJLuQLsLp2d+y3aEYZn4ifAzsL5zI5E6eXYAl2a2LBVbuiWDagG4zWhHvPJhnqBL6WVdT1pRXsworbT7tLh4iUe66AozE2xoBIbrELKVh0/b8BPMj/lUvASilzBeK6NmUvxql7kU0o3XuTjaDrt4GDHII1AiqOy3Ykub/Tz0lk0JMdO3pCYaYw3fhkLIuT8lGXyT4DNIi3+PFuMMTjUYl4GYt6W7P2FWPSTZ0tx4JMzzGzbBxBNBq1osqzrSSmxTgDkjSmsDDAG+ZzcUEdD2SyNUVTXI6hWgKrGRkp03X1oMU0rmtESfvzUdDMUkFxOj8d8fWbdLGCmNEYand2PzJLWe48ZsKiDKj5cGECf055Qa6CW4JNY8Nhv5CyZOckgJ3Y5XCRMDpoI7SvsTSiN3dgxOem6nhzcAZ8UYAfFdIQ9gYWM58cDD9M45ZEbS/7EAZGeGD+oqdIPqala7x7zij1cQU5ydcpLHhZJnv7Ooyv+cCj58ZgWuXNlTZmc0lOe3p74u6IcE4EY7AVs6oxOwnnEKx5Wb06HLA1ZxY9kRxV+fFcJSkSwXqBsgBaCJBEJ6EtMMs18BJR5sWA+4MTwYgHPYongEI8xNoSRTOlog+yJLLEGth1IOQlrCKcxzOQv/GFUf4NaFiC+2Dh4xUhiKc0XSKquv5A/S3iVjPeZutu84g8VXIDmVYsSzY+gusYom8NlJxCOK7YSH0y5ZTiloMarbw4+lU1/ZmJL2dP/Eksv83XzBhddUEfQMbINiaQ0SyKLI30yZNvO5wsiieCfjvwUFc6GEcAuzq+kA3XnqSGCuRO/Jc/gotR5sm4s5ylKS8fqkLCljAYmi4ZYVyPpDbGm7eeS36XF6ahG3yv7r0uZmoYcSv4VCAucJ7hlHxM+eEufij8DwQFh3qc+ReJvXTPvM/j7uV/XpoKkyioYFoDBFwIGoaAldob3qQ+3BKQFZPIZbtQF/gf70sMXxMqrjWxg6bc1fYpd1Tu9oRHzFr7o+Gc+nSLx44oui8c/+3W9xM6L58jidzyXlX0K2rpRpN+wKPu5LPu//Snz/vMsgyN+bHvYYqO1FcZ2zkQ0b9tidjSo/TqHOVDXQ6IOV2xEBwbkipy0P+VOaNt/l9lDcUoFNEEhmSywfGmNppCFrVYoPQvwTD+DgrG3EPUuujkMxIhf+NokC76Yq/Upxo0AaAlCv7z6i/NxidO4/F/KTdwznb1JTyDzb6sfN43SPjnv16O6gGxl2NLawlA6QO9bDRo2taSmQf0MW2JSHxEjI/0K5BqMoLWwE7sYL3X9cTHbUMSm5L8Whr3W4IYM9i4xrZnaz/pqgqrzHUWGNZbUceau4O3EvDmBi/hUIHVLfnAFnRk6Ot3lE3h9r15D215QSnkLZyF2rOddoplwM1s61jMzTYJTB4uyqX+pLEjgCt5C0U8CHWLAG8NKa7Ovdc1bONU1T5dQ99SaWc5kiQWCPEc32gJJKTFQwC5Ap3VgTxJqZexYmd9nn2GyoZZSIoKe6OkVB16kpsg9B5vJxOQWDIAXPUllP3o6kTSeUJq4lnHiWSOnwG2f7SjpRnBU47uFHOkkte3JhlR0shRH9y2c0LEmJw74ad8yDHu69w6+YEc37v7y/isdMfL9kK6dLFcFPVCryDPQIGW2PSlsuzecpt3/aYwK6iXurXHiO7dzMf3w7JPEto+id7cko3vv5Nc1Ej9gs7WjmbdX1Nc3b0RS7x3ybOnOYz6onOR0K7Agpfe2vfWWPqkEibv1XvhkL55uDX0cL/fb6ZhOBSVs22Ja6hpVNKcLXNfF/FAcECiR9GfCtqfTyrb3wEU+ieapd09yUvkracnQUi5H20Z7Gvz/Z2iYVNIY4o+P499ccTVQGMb/2RD05FQ+JnK++qYb1YxyGEZU19V/RJTShW1X19ENXTTNyMnbSfWBGgZq7QiTFM2PvJIE0dFjA/bFoCOsU64uUnl0JSuQlH5rEOCdfBc4EK75s6WLQuoxwohlkcAnZlsDLWPEhvyQecnMOtOCmAKjdOFqOaLfikPKS4DmiXw6QaH4gS8NHjtWRXULEopULshDOTfOU15UzmZM8Ov5RJlwb871R7rLDTEd/TEIzNaqiSU00mw/J54v0OjANmQzm2EU08Tb+JJS2YjhBOInxv3BEE7i7jwGkoZEgmcW1cckxAQ+wusklHDdNJhs2HE4xhFtA1NOERjMd4OJ5r0v1MLOKCFyXjEKTFanrgN5XSK4rroGzrU985g480S7GcuTC20qbSUGlMIlAIbyAL6EfYRaI2eKF6uouAIdkYMbzKGmoe7Vwz5zRILowDBNfm813mk4aC4Uh4M0L+hIWCaOb829DgWkQ5UY3AlIG0wqVvas4E2lxyJkUlzbPYt9uendY8qTfilN29KoIWVRjFrVM0pp0RBQzb+Uns9ZKBhAJZW2bTSBJr8Cff66e0aC4pxMBF4AMTSbb0oe1/W/2LxiAWj1gPU23E+Mk8v69gKwfUP068czLxqibo5Gafs/qNUTiP6zuTZiqC15UWck6duzhuin8b6ZClrmW1sBTAfpKlSD4PtD9dir8g/JEcC0VQPTyz+PWdjKPoz0dtKeLnNoHcyKN5xFvBwb229qs7ZzihsCEziW+R8jmaVW0/9wmQzdKA1uxqegIaCZfm5HPKzqUpu2bYkauvptG0nuAwV0yOgAIY0Fo6PLDEWF2rdCbuA/PUnewgf0OEg2JKJeMFuKPPx2mKPjoLzFTeiG08AJIecdz89rM6x7VgGY9NAXmA3v+FmDSRFFHyq+/EjxrBrraGvSSNu+rmYzQQCtdDVRr5rkD1cznUYvg/FaQMVFA3hebagB7retufVTyaK0cCYLiUaC4kE8x2nGxe+BHY/3RRmJ53TPEvGxwR1VFvg0Y8iw3n46noJ9Won8JT/y6jz/XubXSnMHhvBTc2CGdxWtIXPsetwjx0AIcGCCqhKU2I7n6e+cjhKQpska/V1LFNIY7XCrGrdwdq2cdrWhjKSCYtqKxrX4TVM5+Cm07Qni9Gepf74RsI/EFtnQjaqEewtfM8p1vcEkVRJd6vlYHJmTJUGcvmtrsG0wyFT6tiSW2Z+kyDuUtu5QqUG+XcFdf9doy5rLlQD7Uz2NWHT4Vy/xjT5vvcSXIxFPiMM4PtqLhKj7GIdfaj2N0SRUxt7tDG9UurNxO8kbdn5HjKS4nfumA4pbpg8AiSAXpGf3YFmr8CZYBdMpjqZg7CovCDplnbamkvWNTIJ5lJaE09C2TWGt4E5ITB+6m7NAnjtuj9ePO9k92DgrtjEwrqDbuyGmSgzEBYnsiYZJ757EAJDJedUjdds2U7W0N8x90fjlbj2lMdrSQDJ4gWbwUrr1gh6D13uHPGhDU1GtbW9aBnXjLf3ezab3wqcb7wWMReSmOwLfzvtrKPEeexqzHSvWm7FWMMbOtbTBO4bHfZVx5Ph3mDhm2hYrNoJNCW8t7Vdc+TKQHAR4gzFuGNp6TswUROm6BKvk+WRDFyTtAHZLpel30DqjARNtySoJhBKiGOqpa5To6x+yBf0H8bLBpreXtgt3RhcMliqy7Ylg6GwbRfSOoQhjwm17wuU3Lr6J/LinGq3wZE+WRUEo4fmkoIkey4HGdV0xFNS19dwim06Lw9v4zga4w1s6YXU9iW07cA/OiaED2RMG1ZOShi6vaxS7zCnqOsKu5zuJcws67rYdoltSypwRftrSE0MlyTGJ0JaIiRUJO7rtA8JOMJ8Z3Xo7mNHSy72dL/jPW/WUYdy0d12CPpcPogHPJ7tWA6ZfXynr28pFuPV2oqIVB2pIKt1tSYqbjxRHW8rdb1FMMuzsxaeb2dK2UextRQ8T8SO6J3dxCQOmlCZuqa/8CqLrx06JCXdVDxJSkhQ72ugkIWVPGf2+j0IJHIYdTCY0musLLg/uFASGF6Cb1LWRJM5LktLEXToLMVMXWGegPDdkssAku5DpWxQQyROrjHvqmUKTbp9PEgG7dR1OKN2KTYECGuIO0nYqu5Oph85pmRQb8MZfxTfpKlVeMfpjTdVY8Z56JUNHhvaYhNhfaUOtsKVAjNxqmuV9q/iqjkJMQu/ky5nmdDpNV/ENX3HZcq9drtvtScfuGEpvlrYtuwGP4vRrZdnpbIm10wV1GltX8kIrnb2QVbrWc8uxrMbw7qRNhELCb1Lbvu+qTAnHmMQ3XH5txePtVzh8cbPX9K0+x6GHHYA99E1gWlGL4TjkZkFiOkQxZCfLZKQgt6SkC3Kk1sIiFY1t2/PJSeysO7olDwLVgC6uVkdGAuXsMHmk91MqmZI7d+n0HFzV9XxJfqcPek+KddlJH2NSySip6x1eHSeU/m7byiVYRh+8o49Xx+lUogbbzvBTQRckqevsTCspr2u0R5kggSYHLem4pcwrplM4fW9RJkrmZIPxk6L/Mqwkx6I39/QRN6FtI5TRya1ozbbL2YzEtl3p7ICzyik9ktC2RX9L6FLbXCCbu0UVOYmpxd19fnmzUBLj42yGK+/o1/UJ/iLxQ7+SSh4pxquTQDkn3Gg8kpITJjvbFuj71K6jbZetdxgBoj2FCZR2CglybGRL7zCpmk7rXpw12Il1vg2Npb5Zmo1T7krjAhzAvDLo9kmMn+RNYAI2kh3kaVWp2QzH9J6hwAt9TGLv5LutToXD9dMqpq8QIw/iLBQnZNyqDVBmqHqnnUJB32oZTlzRVcMbBh3zLmXbjBRUzGaCGM3bZsQpJPXDOEj/BcFWaEQvabVCUFuFcd+LiT75bl7YtvXNG4EH0A7uDbBi1luDCmmPYxqfHcReahHSFu7pASGBQiPtDDDQTmM3k+1QQtEAi/5ib+GTSatgx1c5XGT3ZH2kQy5bzVhIQl2Ps0npr3PTpF4bXLoLZ3DIp7MZ9FOMNvWJMZCM7nqIVVDOeyoH42VwBsd0/8ExKUPPrT4Hxw0+lenrVp/MKVmKMcadcjNDW0wmbGh3K4CFcH1xpMAL5XW9QYwUGKMYtKkIJ5Ogrj9sdYoJb4ipZkRP2pbRwlrJSCnvghnKiZyrKtHJJCN7hElfn/OCwc/yA+qx4zadI4roLZHfty/YlDymn/xJWhdYxPqTFDx1Mr+BxEnkF7xvXW+ZlD/VIGvd8DTZVPV9GlUbi1y4aw9dqaDmDDXRiNVeCfflV+7SeSFtuDpdtjON7dGhgaDtGgwcjMH01edhL1jgg9H6yLhl1nbgquSlcda1EvRNLgv6urnQ9nagaHVp4ZSXtUG3OqV71bO/knF/Z2cr4AU+SH7dwYw74HlrXCcwMnQCI1MnEJOYNYjhVQ67nlbgEvNQ0qrTBlOfPMuxpIvNQ9lKl3JT/U+/0Mr4SvK5dH2rlezAq9Y/vv/uTRHSSj6SvNPvrNpHUL08XfB5AYdc595TX5owEGpg6UKhk29rxt74oqiVHCx3jijUd6/qyGPtyRw15O6izyPPHxGtD22Z2QQE0KGu2dC7u9cTCii2h9HJA71+/1K7E1kfn6+v3RvkOi/X1+vlTY2fXZNHev1+7r13/rT21nPiP3923UmCftfzlcao58csaK+l8nlS8kNP30fwElp1Q/sXJBEBjdiwgXM3OFPmHamnz+F0hc/drkEHH1u147ZKyUdor34BNb+0a/OhlvO5ciQIa3YzW8peNLqiC4AVeAvfuBlDjFpOXlQIVKWwhYkUEOmTCxRgOg5LbqOh9jVoJLle5Due7/SzIEbUIIKxQfRBCXw2Gz6G0ROoXI7p3sHVNXie4zTSBIGxAJ1KFr7gTFpsDDXlxgUEiKvpYsWVGBGgqzMX8QKfgCPtTmqlJKtmITkFArJEfkNMRIeOp2+WrsYqKMROCAevpjchs35ze2/gnoI5jIStFt/H/Wf/rhxcA1k5WcJkj1+CfrDgAoOT3bF7s4nKea5GZ9v3LQknSjhtL7TJbyN10V+RL6XrofXxOXrpre/Xv/rTG+y9v/Gf18od0XPwPvSato7fR2Gdk1g6yTUhAMRJNKzrVyOdlEiTWi/lmRtuWPmqQgts29ZN75PmY2dL3Dnju6Gfup4UA4DOgu98qZ3RELAU4d7St21NGguKLphLf/EuCuo6xApssHPmqDto04A10E5tAhpcpfmxYnkoBpC7Ync7ATEduZN8Ds6JBfkBJUmglclgS4+42olgkcmDXDFo7dwzdSDdYXLwLIp7KBick3Mfu+oBBeJNjgo0GDmBL6abc5DD0WhooM29F4JZs+3YJHdBv22eRhNKueE851U7SYZrdbqEHStYppbLNP3U04j0thZl8N70FFZhplCvmK5UZu83hp2ha80xbdFwXnIWPbrqF3YFyrHT+UvtdNZtGw162T6SXreYfsICCrSDW8QU2mpWr40LNbGByCuao0huvjdy68mZPtaHkt8h1/l7XqVZDWbC1+QtfQJFupLncDcoFV6O4lnQCHA3WPI7Z7KQ4RFaVL5hx1FMnuu+kahzt2Ku02UkHY0iaemPVJBJBpJuSJgVR2566u+TOsr6y/TJH4tTJqEt4qrr89PFhXo61/ow886iuy0Ah8gAK5G/Cm07FMTSamC3Be6VWmWF5dK2UeImUnFG6cgODdEvnMTgZRg/xe0ddO/GbYDX4+5ixSR0QSADOgwRfxhV5nBHvB139IjaGUQUgZ2g6RSN7ZjAUmrVHZXbttWDMS+uWv/yWCEMkQxeZRnSJ4YzWzaERdG4n+uz2BHG+HpxLhJeIUxkYXBWy6Loy2F8DLNSFkVIu5YehFhwBu8adhnGjend9SvZ1ai4kgS9dtdm28s+Xd/d2is3tU8jSitaV+TcJjZQlmomulfGUmqPj43yhBgx70BxmxswwSWF9WExEgoCY6h0ojKLGeipDeNG4o1LeYc60qruV1l2cQgj1X8o+4UWPj5msx0YtKjpD0zVUOtbFD3Kl9F1uUPIXOS6fmqwIRiASCotbh4tb8oRRGaNvEfZCy0dEH1N4xK81rjqeMkrw5xQf5JuhlujSEdvM88nzPSO2DQD38jgrJn5dMQfEzWCvgQdKW3B9PaU+D/HcLEZYhLZ9hl+iuCGs2WyIrjrMJD9jcC5b0GZGPIZ2ELkfNOJ3hCnfF7yO16CHpOqxaDpsSZl/0Kv1++m10m377/ututTKwNXO1tZy6K/gBiV9NWGwW+7OMdI0OTz1yzLAhbujj2DPkZHsLJo0ekO4YYok8/2eliecXA3PVuSlA6OWU7ZvMhDTiIa0Mlipe97VyI7fgppogW4CodNp5uX+lzBsbfR92OhOBFCQUqCJiPYQFbF4cf8K5YdpaJM3Hm1nixxw+Z7vi/KR1BAmSwxgegpHKS2oev5Dvjw39Kn3hnQafqqO/SgV/dsSRJ9NnZasVcRAjCEhQgG0YV6pFwotoAEDtvezjfsCOFe2vPWAW1TzT139IL20x1i245QiBvcGD7SiepoihQ8NUS6rRsZlwaX1kP7yC2JFheFtDt9Axkz5ma2xLEWVIdkicnmhoa2vZnNGt32kFRrj31T5hADWRIbZswDTc3eSoCSk6peyQbH4l7RRN7sgFlqL/fIEk/ihmSFeVIbFQnatK63WiUW6cZFgdHKJhxUEvmvadULdNFhbQ6QGML2BJMApVbsavVi7IR+B16CLDQWVNQ90tPtXDc6DD9llBvtb9S0KGRrhvp5w2NelqNqv55nlfxYZHfcIlZU5NwiBjZBltjpV3LXWZjovJHlE1EQPHgSK2Zp9rFyW9DghXJ5UaXxoyXOuyIp+fE4KKuL+WJSrQPPIzgMI/p0rFg1NmVhQ1h2zx6Po5HTxLCMrTUX3UVns1ptzDNSO8dss3W4Wc+m4SxwHFOoa7+E9lk/Ka4FIo97sbf0fXTWbGLbyXgIspUKCdXVJ7i3fXrkAg+pR6CN5dwCGyHmG8t5EKwlrKGaB/Eu1gY7oRd7C39qCcizfGgX/HKEXa0y9l8i45i0XWpwgwmTXjRxl1lQPfD4oVB1LZAyEmEnahrCjYMwmh9S0PIUi0PO55h1cxx7L8SJFXuf+qtITitNBGVONjCXUWTOckg3DQm85Xvmey98jRJI4L2Ad4ESMOEwIf5IrAs+OlXcjZzxHdvPT5N2h4MLNT1jHBOI5ABCaE443N/db/iYHviCnEfmMPllEtOldIj9AXiJnAVJKJjwu8wxIBuLmfy4EWogSDTFXDP/LJbbzdI966ITgSVI6iYKLAHPiQac2Syu60SDZ/u9aaT+IzjiuFkC/5yCXq88dyJMtv3XXe+1lQmEXuAP5kJ86qbDfDN3kOjHlqRY7SDxuhMHp9xASbuBZrO49cE3HIkoIA6CdnMoivAbKRIBcQ8dJbohyeiWxGYasjok34ZmWxIo8itLK2dJNkUWyYQeZeiqikWu6dRRb2iywI0s3ssOLnImC3c2M4qBRAuqxnWN2hfw6jsR2W27l/9mUdfom96sRMTLIeBKDNGxkoQr5wqlbSMBYoOvyILaLMGdR3hexHH7BQ8Y6m8RforOfYCCnpWgowZ+QN/8+L2y1PquYBGPLPJXTNh4XukC9K8YOyiaR7znIBR6A8dUuGF5wlU9g1xtDZ2O1F8RfkLnHa5r2RzImrlI0ZYcYbE/ZLySCslylt+JdgVD8i0SkyqXFONmAETUNJpKYzT5Bqcx+ob2EMDF+lWPFBFrptj2JBr655pHxbuwLLIMg+/YX9I9L04VUj3CrTvw85HjaMRd64VlOs+oZ1hq6kVDP64XlmmQq61EXn2qEHkh1RfcwAK3Jl9nYzc8/gAPoKfCtiedo30kl6DbS0/QRpsZWRmPK0u7ujbDthnzGZPPF7jpLXvToPbW8JsWfwRwnzbAKTC+7+DS6rurNL/KUaYvh7uYfNRaCFD4jmTzNM/SnH8pTskfOI+O37HH4lQJXixHo0FA+SrsCfJNV7RBIbYv8IJgllc9ZhwUhKJRfRG43RtPkGXn4fEIrm2sg9I8cVhwLLJTxVdBUUa8dBYrUP5wFiupC+IsVlVxcBYrMdXO7Isvvvji8GCRsOfRkOOBg0My7qFTduL3otiLUQz7FKXHQ8YeHTmFqz0rkzR3Fl3PDiwSe8tZHh5UL8WTqM5ZWpfnntFP4Za2iOMjr34VBQUxgUKjP3QJTotN9+cc9G/OyM/xGV5lc+mi9q0MHSq4fwGsym8tA4lIB6dnuZeNIhIVxH1Px9xP5sUbVjHPsIeTDiIGHqhCOjVDWrbOzJcy9ukX4sedLB24VFPH0ajzjdYFL/lBXTM+yTvG5+umXnv62cfPrsmP9Bp5r2b/7WNThvOToXjQxqE88/Dexi6JWMVm1rTzY/YjsWbPlsMhynvJQZcjfH5JGSqUQa2qPAG6Dt3JwrFilh3169KxxMzLN/A/Mw2nlnydhs4P2t+Mq64Gv3334w8guzBwWD4XPVdjlUrooVJIWf2v5tzu4W+jcU3TGCGYAvAwDm6fe1FRJX8EtoWF6AVkGzHWaJv5uYtUksboewg6qQwsyEYqmwjwAysLvRZkS1M3l/EMHEZ2NHWZt/Ed8ce2QW1gZ9tbbwdmEHUtnmD0uK7bq7ho5Pan1XLc1TXS1VJlmt7Ft3M2mIg6wYZm59PUfWqcJzlgR2yB4tB08YwnRgy2Tm+0+1rXiLtQTxcO2Nv5JMBO2/F+EnwCB0AJ2EIQDvYk4mv7APY8CZUvmHTDhsDCYvJUSFIUYJ+OAWbggp1/4BN5YEqpz1lZjJ2YJiQ2jH3eGTuqW9FI2SW0q5jQ2FjFjeBivHbBfad9BKMpb6NuyKVQ2BUfHPFHDlBK9ZRgDLsBDbQqvZQ3B8ToNsYOgHPkBtQLfAdiO5uDIvIe/ipyRXJgBAHEpgWREUNC+f72Ao/7MlymO/kbirBztkFa26UGhXWtvYa3IyF/k2MFa0GYn4yzXOBU5DFQ1nAGmLmukwlNVOxj16gPpkdtcdw0TcdsyDl/aojE1s6TxQ6CWryynMmCWHwf8Eg96xCHjhVmxzRy3rz436/ffPnnt7NXb//8ZrZchvHsiz9/+Z+zzz777PPPP/38s8VisbBA4gg1X3C/YOgfQV88c+XFRu7eCIRLnPwNbLajfp091vZnjduUqPXNMG+b853yr/jPP1AdAZ7qn3+oSsjb4L7GU3ReRsf2FPtB360mFHQiOuXTlXkcSU0W43pD3X4ASo+ljldsXCZP8vk/ZRrc4B15JA6ho+CzQMR/pkifeKG0IxMP85ztOVkA59C6K5PnnryTMXdLpGSln2NMfpLmbV4k4Hd1oQ8wS228VnX9cxbBWd7EgsTIoG/UiKWYBjfYGRFafLwgBGZ2Yld0lxE9i4Rhw9X62Hr3r43Pm+gKdT2U8KCB4fbET9z5oOIsc1FAweoufrDw1IIiFhGT/k99gsP9AvhFNmPSu708PW2REGMn6q5JIKhXN9iIn/cLP0FILtEJxT+Bl/mT8nNjiKo4DTtjYtEDyPZ1UeyOrU+c3kLwrp5mZaV5K0emKvaHUWE0m4FRIxIdoUotVfvENctiojBfDNdQRIuuSAIWkJPItmGDwZ0GCPCQ2NddX8dDRqv5hxxWJ0TWsxwCVdC+kSd5ZXJZlj6UZOZzA7WIlvWCC6jpJ4UyCM0Qv4wtnez8i0Ho4ImhvIdkzGAxq0TsfzLcSC9DVy+4RlFtJAJw7Sa+OqMboQ8u7ZZb9UFD7RCiFpbZtrmcgv4HJZIOXrotNQKwH9uaI5WIg7X82wfrUYAqtwHx/FGhuGnKtCS8L4eRCJ4kPT2kjbkhZrOornlPrhYTL/bF3ri8cOpUJ4yq3pmRjFo0EHuJT1gPhrHk0wFOBS6fTol6A9DcdCaWGySYckPyMMJqrrL5cVOm+e7Xkh2Aqz2aY0tjpG4KWlv5FaOTZXtjzTtN2n9HwOD+f1W+MJOhB2ZB8TA7pr+neeIoXQzxaTXbF79fSrvwWUssArE4/7bAIugNanxmsRoElKbW52JuGP10MpBnnMsuOoTSaJnCL/Tam858V/Dx0fP1vMbraIpcx+NvfUhYR9MaX6soav2ouBDDFQLAYlpjZE1/mVoYeWz2+3/4z9uwrv9FPeuX4mAR62exthaxviyqqthbxPqOx5Xlk18vRacO6poRKy9yYMtzsWoyUAPMsIWBG261FgdeugXS6WQO/9AG/V2UsiVJ6IsF2dDo3PfqVTQPTyUyPUwYVwyyHwEB9YmUin27pWKzgN9cBBlkEHQv8F3LcqzDg4XJjg6S6lokTCjd2vY0xbb9d6nu3LYgvX7sbHvnfeqDyfbTlm7rWrwSfZG+o9O0rperqLiKaVzX1vxzi+yuaUxyCSjQ1910qxVM4gmlKBbdvhaNLifAas5mSUslCmJnR6e7uhZVLwTF4S19dzdF4ne6xM9D74XvTMVfIhjcaH7K04puSTQ/Vqys6I5Ec55HlAsGDmKf/0bP/YySuHXaMebXgdJwZYS+pIYOCH7idLIAQclGMI4h/g2q3ZDQ2wiuDapuPUn2uHFQbejda0XgHwKioG9BcxR1pi064CF20JYGJLh00beVJcSRBpQDJkq/u3VNEYDzPhKSxI2cSDUgvmyITjLcUXCXOVtX9wM7qRuAm2ISYiduIPIwch3tWagGX0MQnvgZvQajIGfmTzGEGH7/rF5fI9fZsjtW83DP8DEs00N1nULc4ffr4/SaBIxaLAjKmpVVGma8Zsc04jU7RWlRB1Fahyy/Y8cahB7iT5YeqzriFUuzYx2lLCuSOk6TkIF/M/F4KnkdF0XFy1r6/qo3SVmcDvWepXm9Z+Wu3nORmrO7ujhVh1NVH9KwEuU0YVIfOcx2fTzt96x8rCu+P2Ss4nWV7nl9l0a8sLp9HhpBMYNeKMqQMoVNNYb4qmQJoFUpO+zjWh2ERCtiDZJRoNyCdqZakz8oFibdUXreExKel9IRhXpWkFdXL7M0313fvART1ZuX1+pXG35es09uGASWgvJXoK7+iYaXT65vLJLNMw43U79u0oofDyzkIBbvGUu20qNsXgnSgF4MkldJyqGNGDvfVPvsHS9TlqW/czq5WFAMZFju89dZkXNqvXRydnfz8hp+5A3aYHpydmfheSiygz3jZIHnxamSE0VCGeHS0gMXZITyxSfQQP+8DTHJ2g8yT5ub9I1QdWDwm4eX1+2zmNK8gJ7r8uD7r9e31ntvzzp00BP2IUgAE6eR2FKAA0aSdfwrYnWRsMLxeFPVWWQqmBMoJ1dkOJxLg9PT1s4IXBPCfAyvH4kpfqJLsQZdoMhhCMg2J9Y0TMTok3Sq6HhLYulAavtTVqWHjNNP9NMnNxaxugBpPsl4wvNIlopTnkVHXsk83ZtPxNLKPHt2kMnw4JMDK9leJskzSqbqZ59UAu/JDHJ7Qrp69ElVOt6LNu0lbCCVBR6NrGGRjeRtM4ZFBqhVlm7fzLYix/v0rHxVqvbKm5FG/6kg1BnsZddbEMsiluXD2P7xMkrvZD3w4DeriM2LQwWdoPI5LXISMYVDxIM4GcSD7iw8y8MD0sXUQYkNvEYdkudsINaD6Bt/PCasewERSc9L2BmvaRj4zB2JhaZrUNc80quGIAJi6S/Y1OKua7ZSTlIiGfQWfNtMgro2VMcjEmA31j4IW43wmHAGabgfIIbCJVEg2HhD+zzAbqtKznwC7kIGsesHhjy6ZyFlXuRjsMTRTG5IrCQrApa9vWOZRWSHZRJ49TJTMQbiL2H0+mVt/8ld309X12QjXgESrtPVIKD1f2v3nspbBGqt6DVKbZ2p4mEM6hGnYdpFIdmToqMvDzRkgmWE2I4lXayKm3JVSkOohDKv9Ekine+CH9RzCjTBWM/oLUkMV12J7ySdmkYiL3lF9qeUpnV96DOt4/wd2VKkwvAnuK49udXwefSPPY2Yt/XrOmJzvVdJapxTe0Gu57B5fyq5Ut1P8HQvCPeY7r2FtpyJZzOc0rRD4AC8IxSCbbN2UG3QQT0QwVTDWcCY7r+38DEmE0U94KeEWoBfgO+p601bmdviyAmFjvcSF07qpKatRiyVPrv91Jexi/EYe2BHzaxe7BNNrtj2ZHdei6jaZJ53uOnWOzVtMUhq+q2mlhYMmZ3Faa+yXtIqpYdu1iXHcmlWE8EQ2vahX9sZ0QIXpyU/IM7QLWmphpRhgHUluKK3XqmcKkWg56cU0xMSgY47t23l08cA6A01OO5kwHEnmKSUM9QH8gQTS/IbFiYb246Z6HKIn2KjL6mM16QctCRaaUssT6g70cbrl67IDmcUNwi4LiljjLhRlbpM4JS1CE/HNAeXqiG1ityaBgRlXuDTUN7D1zXifZIplNQSZOIGxeKFviZRZICBFe+pceyYZN1gWWpJjNSahAQOLhM5dvzxmuxV3n1xOvL6UKS5YJeUleSe56c6KllSR2VxwHWYpeHumuSqjBoT/NTwtzhVQXYq8bNrUkAm7/3cf46R66znaD7FNb4eeI89Vx+4NT4ve85ApVpFKz/pOfhWahEMPw1cVJpeFuGgIJs+y995gTgTzoZwNRbVdUi0YgXuJAIBhvo3UH8guOvYMAZUglIQCOgIMIhTcfa1dTkqwbbPvVKEIndEojavejW6ggmX68/pLWs30KTzYmqGSIltGyWUEz6q1Iqk7ibD5IKWe0M4qErQBH5AKQHetQYFxC46l9JLDUkWRdo+jJNI3nuoNPokD3Pn6dxStBcrxjhpM3HWkgO4oGvvaoDHLvFTON+0GqspTFeqvxBO09YmG+KXy6GEg6EQlNBSdu8oBTf6TfpU3dFSVYlBq0S/jUytQem1S5srHXClPSoVasGZuqRJtJ8dnSNKjwfwzSJXZjfnGd/31kZ+ohCOEUhEC5umapblk81QyWEDztYKdYwK+JVWbQW9pbG39MmBoth7oaKOKTnHXPupwqQAx3y6j+Dch2Ve4df1U0MKiri7Ba2GRJwvjwfubOdBmkegeVXXBblYNutUY57AdXFBijJNoI5bqS8QEbWiTkjEmjlyBYleW4cT04+NA8bYF5zcKA8KmAgGFQgQ56B8cM1B/IoJ2tPEK8T8qCdB1O3b0b0uTnlFF2Qr0PfpYNvqoXNecyA7PBFbta7ROXfqjujLFmRHJkvs9FRgbftMI9aaFmSHBUUn6hBLIn51y5k4udVUaWDvf6By5gQucffa5mwwtOmULMAFqvZuSPTKya0rJmSywCulTHhuGPeHt7LSMQEjzsHGBoFxuynx00VA3w4BfSt9DW8MWN8asL5RsL75EKzjp+wDoB65WR/Usz6o7+kOcoMJ5wYi9Q1ioa3Xc2xNNdyt13PkOvPn6/W8xhaeWkg8PcOCxKIx3Z8TogndC5Jzwm37dkJpMtc7pq7hjk2sMXyXQLCx7clGU0It3OO6jmxb5jOcWljPn1tSu27SfYe9oOElJktxcHRlBgA0m5FMUZS2rZ/a23xJck72nR5MNq84K6PiPhfZ9bMucCAt9lV7KjfNAhAjRZdD6xCIBVDSenGAF+IA32G9pLK4KDkVwAHAKiB6qOm1A2UZWaNu4uxW35IwakH8PoXd+37ETWIE9oHaBdTjdR355Jbu5GC1czvsBnAyOAEpjbR25SBD+2YAsONJp950T8FZZUQ+nVDKDb2i/xy8T3LFEt1Oz04oMfrbTn9obimHyCW9Ndokt7RstT1KtYUw2VCjqGPhlwvbBgR2SwIaGPI5N3DE7sjncjlvyTmtZtsBJsE8Pf4iu0Zj94XzKTHmgJYdDifBvOwSjEzu6C4sP7oLHRlAeV7y4ymrFDlGgrkMgVPXSD9Sjom2TghB87CnwkO8wMfkHLPcSswS17XgauUQ67p9bD1ThnIDSNeWk9i2J9k8L748BRDBFvQUpcE/4ljKLLa0j6nq+pboBd9Ob8WuFjy64fdkla4GXw7yGEgx2dN0taeUIj50UCRAReXbt1LolN/X9V5VJTtW1ww3nWNclNKD9Ixr2xOxvj+VxYElEDDoXVUcDjxCGMu9QIubpbs1UK0YS0KRPjfSbiOCGwZPFvO7kyUlltzCFiaGfWcKlmF0Y9upIOq7FNv+HqUQ9FOvfK9I+1UazwfgZITn1Rs5fISV7zro/C2B9RJjVOk/ydxihLaNJlkrbxErr59Vewd5N0RCaamPRc84tu2NbXPv1j9f+z3lYix720biQd43knMS9BbsB0QdSBu4HEHTfJhRwXxb4b5lnvU0NGAXDoRr39KtBfc4fUA9XwdSQ9zzBZN/Zkq57ZZWOjDrr64knX11YT3cUW3qUyODHIBXKNbtBbldoeLJTqzcG9X3uu69ym4pnSO5+542bXOKsjqauchWUOUthMd04wUKwtklCH9i8/BUim2iOhZLmj/s6klo3DbnhUaF3+z3PEpZxUdrZgYqhALd6xg9gJhq5MdgSxMxYaxiSgWdRBSh4UR3lIcvnZ/I4nWd6N5iBcNqSOlAk53pXRRpLxRgINvfSUR6pjBGiDDuHFnPD8Wx0itm2/333goS1kGrns3Lqr3aC0fQJ29Ar0AifAFcAnEYByqyQGwiHaFIqVN6/IH9gNhcxn7Cda0fXy71jf6ESld4PdQrfVhpT6K9RtI2sBiY1tT1sFV1AETymmCx2kAspCmOaeCFPuE07nkuJK20P/I46BF73Kdxj3Nyc8SlhyjlBisFfxPKtaJMUsH00zaYPiaywtav9yoyJKIycI0ADCftFiRq2rXdvAzGs0NjbYlAqTBvcINJ0pA47XvJSmNkXgl2EpN+wIVKGjUwsqHKz9UD6Nx5sb/a1DUafqQbulenaSxd681BsCbVUbNB0o4/yoSnBhNON/NDWRyO2mFVcThqgweVgp0uiTBqEkmJwDG8zxcEoDjIvcAnzAt90ANfdVHfNa2iHwXRX4ZKnCZOcCJv6mWqCWttiTax5zh8vucV+yt/hJtY9Uw2yq2Pu2kdbpEEOwzULw9Hx2JZ9Vf+eBUA8XK8Clke8kwA9FVYlZlI6mHEK6kXcgV44acNO/Ir1dQVOBfnkcoHhKj4LLt6VaV7/q5i+8PVXcrvr+43abixDHMQopfTeWpIt0Kql+FGYIMNK18XEb/a8UfxXzwPqhi4+zSUzrSpKjQMkwlPOszMXFfudo9OMFetYMKahhggpfsl0ceV/DlehVnK8+of6ve3q7gs9mplr6QC3z/U729XB5bwf8Df366OYcl5/g/1+9tVVahSHx+eEbSLBgqdAao02l4N5gCa1oEcgrnqNShQtaA1JCxJTPnQrhdcLwRF9EhUnV1lUwQ64kcw3f2Ox5ViiM0PCzyTuWQZI5f5YYFV7b+1tf/Wq/2X4tCrHN4HdXd5jPcFxkScxCbcAi2HBh/pxtiR4HxbTYCzEZ0DQNL2cJTSBHa3BK+lnbhL54WduJ86n9mJ+8JZSGBSB7fzlBUscp40++BMFjrC5tMZD9uarkwoLZmgVyUiFNmxIaPvviJMJktTRk968hJLXSVYDQmyUznapFEn7TUrSijHn+IRmjItIc7aKU6V1RA4Hz/UknG5JwlOdc1l252uD6XyYJDxG6SHSVGvdkQqngcdalUePuLRTS0zsZiFm4YEPC5KfsrlMpkETd8ZSeeXVBI2ts2AJEtzlikhIhp8mcvWQUuoLYcbARzp/pT1PPz0HFW3clrjKCIhkVJbRtLjO1U+gnuv1YCNQJGkDgJMogt8UHhG+jXSXL4V99BR/xHusMOjniPE3Iw5lACbswtjlld3q54HCUHkjcj5mRf5EFA50gxX3+9ERELpTVsO5KKPzJ7/YJnZReCYpup83vZWVHu8BVZTkTHw4XyWaav90H4yUMh5opyzDl4Eje4emHOrfSLLNkkgRe4AHvLqR7nhaw9gKoegX6VSyj2S/IA0UTGUtiYLjHtyIWnwp94Mh7lPhlNmRwPl+bidWwGg5zyS/P4BJkpk6APluZOq8xVZXZr+AwO3AEMexz1nepz+xE+WuCEDLujf6cn5yFRfJipDu3mhe4OWtHPAHgtG2FzSbfIMoWBoKXKNTee/09cPLIfqtOzNWL4PpSmXc+cjASwjvbkCtSXWqnQseC7ueGlJIizj7I7rz6fKIuoCXWVXb7KAelFFdBIcRUNXmwPZhU+feqdYQLTIywkUy3PmkCrsfO8PiAhgbFqmvlUGB6oLpPymM30io4tKFELjlrknYSd+GL8wJqpMgEnYQCj1udSLAPuF3ghVAn2CW7M/chLHRbm3sDtZylP17JpZMr/zf8qKBY1+KPnxqD9YZMw3rzpvQ2o0F7Snfk9nLiA6bjM4aCiLg/gke6VV80LDZjcklmoZ2zYyu9umjPeN6c/tjgJjwWGt0uM/bshFumBQUXeNMUhQrlKNQOAgVFJy9u7WWlMGqO3CoCBh4mzTdzf/g0VVdzMyTztLWIKUVLAZASmZcBmkdooXh163cb5d1JF2kx5pp1SfzY9Gqx3gCVjgZfUo25//U3szMteiVZKGA7ZPiunyojvaofw/t6dj9RoqigCndpLbM4gfa++skuGijjWz7Brp1lrX3tpcGqTteZckyQpqOqy6MBf9zbfSyxJ0a2Jso4DoHgy3UZsy3spkAJx1zcwDTr7+oi9aPjzuPowb27HrXbsdLyBnY8AGmSfdr3yoYx0QBhosTXCVH10Du38YRbcsyQd36egu1MtAJuMbSe5OxdXBjMJZKhnKltuTzJ7JlI0ZPZuzd7Y0AWkRd/9aATer4UEa+CP4QFL1kvToixrU8amXNyIBXvG6HvGCxqRTCWJkJYgLxn66vIAE/61mZ8sVd83KOXi6G2NdjK4Yl9Kgx92cGZqbRFhrvdhFDNV23vC9IUXO/3B2IsZdxPE4E0X4Ko0lB9MncsWXFni1UDYy6RWSo+GtjVRdi4xr3ailU6bW3JoaSU6XBGHpVXiOqL2cIDoCzbn/CuVtW2prypWLY8RJQNhZpBR9FzaRVxgXnASFYO6nDb9Dfd8R0lvlTvKiQp25HcWSB4BuzmQZZ9EWLtWnOXKxSiDRN6pTrh5HHRIoRwJdiLixCgEiW7tgeeFXMXp9tf3biZeP62hKLeTCpXq9jqbYuk7IifUMgV8i17GmAZta2Fuvj9c3vjL+vWP0+iVyJ6zkrA7KOiyyGpzd1JuyTvdJLZVgszQHU0BWg0UNRq39IkQtwuvrm+skJfeiNqlKXL8E09ZaGsGl5IHRa3Vur4/Pket476lf0/XxuTYWmOPrlDwyev2+Kk98fY3mz/E1+V3aPz5/OUGus/Zev3n1y6u1V89muBYf/LUvnm/Wx+fPrhPyitGQoQiTLxl9xf6IqXanT/ua9QXNvegAUiMe98w2zuNOBKb+u1WVEE3gg7Z43sIXh1TPsqyP0YbdVgWxwzpF3Tf9WPLAOCg/A1RFUYGgREzrw+CpdW1NlWTDqOitYZ35qNS91P1XG3DD1ZyJt/QdLQAa2rphs9av1MyexeYX09nprPXdAHX6ayShHY0QgytfpccG14VY++vTV6Qk6bRNQd0YNMQ3WN7fLQinGy/0h0Hv++RQSEQeL/JxI29rbVu7ETNDDShnYthQmv7L0PZpdTZsLCNtBxeiU5JJ3yTPtk31HvzUHW6BVKeOxPi41u3ra3OBJzmtzbUKzpbLsNbTRgDS2V8AVhPy/pM/VC56I9qbqwhMEN7wrfiCHcP4JnR7QVtB16M19RTEun7GPStScW61NjFAsFZlukdB91GqjXR2M0Z+0QPJZMqO9+2TRIcG5klBa1/aGiqRQIbUhOHCE7R3p0S68AsjPagQxzR023qlfRlUfNSPbJjodFFJQ9u2tDkBvIPGk2lrSvuBSU3w+pp1tENAY0WZer6grqTjBMOiii76NlXFbEluIT4iqIsaBva3Uku0roub5UhskVvQjeqMTG37Qau5tQEfh+rrWluVsjm/RQKBiDn1Fj69NXQIOIkBECDOyNcMwgEDvQQdKkBtdSs2PgTq75uzTJYE7P853ZmIV2y2UYshtKMcE0GDqtv8VMVe4QztDBuYN0wgmVRjiOJmv9pPpzihO7KfUHoAU4BcGtaihEwWQDJuBDaTFkgp4QwlXY3gplS5E9j7JCF7LFGX1ClLvbSN3TEco+xgSt4yLNZztWk7k4qqxuxxWn4v6Vv6if61oqktSTDgtGMpqI5/8juW/b3MQLtLPctE7OTzrhYkmuIPVV0nplWVeG03pNQC1s5Af2fEEjOw2lFlZtPaeLRA/Y32wtkaBkaE08DtRQx1GIk7u8eIci/28SqeTnFY1+Ab3nCfanoJ5IIiAL/0JmIKe9MRDaY9wmAHBTac3TL26ujZeEW4H8hKR4Qz7fpGXf+183THiPXy2fLm5fWzFzeWjOaW80sMAFFK4PSD/lUEkJl4VnpHk5F7Idrb5KS2sfXSmpoOam8s7MZDU3IHfcl6OLiz4v+S9aYjpl/2AjFhMkHDQ21oiS8X0Yy6u+x/GPQeqwOdg7+/jfgFWqEFEXHOJz5eTacJjrzEt+2/QMh7+JjGKICgdFDLhm7qWlYABkuyzpG6kukUf9XVAgrRQN50tkuyRwY6idoAMgBTEZmktg0m0iZk0Y3aHySWnrjyEd+KxvZQ2n4LgAHtE3ZLlftIsqOmkT7ZD1X6un3EvI2Pwf9JGiMU1PX3AprBtWnkpcoP41ZsNqBbNHHVhcZsv+w97rsDFisSzHafJBGdT1qSRNTciVPFG9nV9dh9YDQkXlzRP8X/OWepYMSotaRGuPeo2H/P8vTgfM2IvFYc26DftIy5CiUwMM04zwgSi/GQcL/JLGOBy9obQ1dG20Zd6EDpjAxpd1oIncUTPNMePrP2hYtSGbh16NcON0RWPCK++poNhF5koAW21Df4hufqkW9fDD9pJuN1O2uroX+NppFx5v6f6FqaH3lZfQkSWbEVe1HmREelsPbf7ufZdcHgw7DhVrjA4mpU6+L/fnO9wOii6bMgWBorMVDUVYhF3kd6gY8hWMnTMKT64IRmEK5ZR4k0p7d/svSSVtpxxXHgT8GShLgl3ROoPDqAbedsVYYcOT9oDRdnOgzmZOkw5WiK0sBljhT0AHU26oIMiEPDu2pDxDn8B7GChEC1vcGYIaQLdSVphAI3vOEapqq9yMftcd0SGhUQZIYHjLFQ1pN7ZsQDHHgXMegGhmX6mReCup4wM88kYp72mcA+5DPBlyrufY8IDINiPej2RkrzVs1PKOdnKFhAA/gKAL5Ij4fEq4AuDIfwTaA2xADhsg8iTzWv/aBq+k7ea2V7H9iYPXGgGeO0H3CWMDD6GY5MRj2WviBVX5TzCh0QmTDtdBduEeSofikcSz5ZGsuKT+rRIiYmcNRdlP76CpCPBTjI0hPwKsscy5gM66MxMNl5cGIIjQcu2syAhqvNDZVSmpBGlNKN4WtVbjSQ2aPYi3zsBT4KO0NsTkIZALcnYR6NZvktI39l9EkAh2OBe0iLfPnjm9/0i+Gu8DtTykPzM0chDGvo+aVAAShXYvBYHB6PMlBk5yWxlf4q7SaESdTxRt8bIrmIhPSvzGOdwBik4KozyhOjlDCIlG8ZRd+yus6R9VKGN72Cv9LVJf1k8cmVdFEpnqRnT/F4fWP1ez9QFwUr6W+BH++FRa3r3kdNj+C2PAnm92VacST2IQSrBn9quvvfsnYCMIFh0hCTEPzT/MDo9Xvpq/Oa/MgGXi6VZ0t3cnjA0r3lVHu3/InR80sX5WGSJFpCGEuHBIkX+5RJp4yCEO0eaeDF/orTUEEVUx6jzdJGblFRqxrSkL8xehZMZzXqHKPl6y64ptte8p6Rxmgr28dP26ET1TgrWOVkPK5WxYGFafXozD+3SDZXb9RazD+3KKW6pPpOMlHHV6I0nUyMauET0R8CFu6Ssjjl0essPVDL8LZqke3AAdl4EdEZcD8MHOE7kadfj9G5ftnLc5UOp2HgzfY/Dw8f8mfbOoJdKCexM5FnKRKGfnLFKA3fcxZJe7TsFpNsHhQP78AZLbV6g9Gf67r3/fvi9y8vJP0KHnHb1M6ZekaeSp6lLMj412kU8fxH0FEfCyKp6Bjb3iEsGM62Gz+rCj5WhjfkkD7w7HuYGvAc+7EioSryk56+j+QPxNkqe/NvtJIMS30n1vQjhTa9UGs7tSPBvH5k5+57q5tisv0DXosl8A0dExtfW6gCdbP0jo97KmanqtDOipcGlIpnAND/UOD9+eI/LBJQTjd0siQhTSCQnTgLXxf7w6ni0TsVmiqj558RmN1Zy/8Q5wnKpDFaVRzIhlovDrAf9dd9O82EU+szI1F2BD41WLpDbudq3y0ptaCvYb+wkaGtwvhGdnT7Ry74yG6wONv/d1xML6y2d+Z07Pr+oxdWO2nqy/LwYJGETiCIBOBihEaWcIfNBYK6Baz23WZ10Kt6TSUZQWK6AKQjqgVji595WB1R6wY0Bm8Zg6IDTNg6MyxvXlbRzctr8aeKbir5pLwaKseLW9MB2MJvEaSYtNdFlrHDkVPryA+sZALn7mTnxi5XIwuTXVdHu9QfWIp20czRQx3S0udrOClg1EbNxsB3gpX5wEye1YUx2Q8chTWt3++fGXnHyC/gBaoqDnUpStQBeOauxTGFn12vztfcRT8zes5YBIM7ZcOkfNW5lA7nxUHGaEQhZVgSzn2QCnBD3jH6Qam0pqA6T/ZhXf+spLOhC7X+pPT04H4LBbiuQy/wFVNKLIF0EtturTGSnlrtuaAbnAwZ3rQlPzTJ5sMzCmHb/tFwj/eDVpiTYVz+f8x9fXfjtrnn//dTWLizLGHBkjyd3dOlCnPTvDS5N5OknUkyLc3mACRI0aZFjSS/xdR334PnAUCQoibd3t1z9g9bJAiCeMfz+ntWZpNSfDW7q9YYdq7QN+IJb7p0L9W+x0vdQlOGTcv9dxTz3ipo5yZaxmVUTgk50OgvznvZbtD+mPq6Az/bf31cvAHAw7EEL6oVhJkNS74CuAW/9ybvh91X4ykgZtv7NbomPNeKFRyw6uChXkB4yfsNgDTKsAxOima9f1f9CsjyMiaX6o5EuntXOKb6uJkSvQ+aF3K/5IKOdG3bEn14Ev+o/7Fn9PFSqjFSQYQUMaWMkBoYSJ01tFdc0hO4XwdgX34SfC7qzUpch8k/aHp+TecV+1nwuSG0r3fn/Hp3jg/1sw+w8PX20cL2GMaTiyxRIqWzKZ2zv53ggGYdrP/fBX9xJAXpKNWHalfJqtY8AFkBlUiY3fosn8teCf5Sq/1ebd/pCq7LSJ9Cekh+VuZAhqBWvwieEKRHCSPfE0beNr8SRu52JGXiVEgKMw3dIEhpfYrBOEwO3IlBTS+2n+3DhSZBftxsrKxoKoyf8qXmI38Rx1GcdKH8F5Fk6VSyYfEdk53JI4hVMEJJUlbyBSwdU/TqqgQlUM5FUqYAp49rtEjK1LM6JE2dOw4foKJ7RwOTMbzRth3T3rZhfnSsUEYwYG3vQRD8HAKczCe+yt6KMO9MSikFDDz9HguzIPC/O1EeYNSgFBVnEYotck9mQSmyviWopE90ifS+ctQAMpI60gMyNt1EIizLV7S68ctl38vsbxaqqtPKxW/FHvbecMHy5DK9CDNwHZ2GOSJXbZ4IjWRXppKjiLU80wRqHhtukkSWTSU0fhMR2PNxz7qEaOSL5ZurYllM+WtqyA9jpxKWUxcyI5v+BPhTC6YoZXkcukJt3osuvIYhWUjvHVv25Di/qShknxI4eoh5jUZ+LcZK7hInR7X+ZMm240sPyHgwSjon93tM+OFRIjGgvLg9qzxmOghIx0L1gpC4LIRNLlkBEpLFFVdta1AjYcdR/B3aHhSUhYsr76lxo0aRjn/wKWfxopa5PiNDrz6WfQ5p2yrQeHQFKO7R64q27cIFfZvayaYJmbGpxXJWUDjv/KCFu51xY7dSnf4BZo3uHBkIbSUmsxM8mrCp5JJE2eFwODAXAiV6EevqDny6vtmrLVwAZAeAszb1/V13W1R1/b2phr6t1dOft82jvX4HQZEQytWdIpMFq6u1+trdNV0BSKDDxWYl1jt9+VjlzSNc/frNOldPcNU0d+Btne3AJ28XvaC8i0Sd9Com9opEBEYEbw4MbkbsstHA+vcDi4Q/DO6FFbk5iSKEaO1Fzqwc3aWHAicoVDRZuWA09p5LGa5o267Q6NEOMISi8W5XaUfdZHY26rVQqj2p4NKRzqHiJQjAhV4HOaWxiqpEAq5Z4dBSvWCnAHWquAl1k1EaBCq5TMGwGqP0KMoKTtYwRQhlqPDT/IPZrOwjU1Y2BfLvOPbOqou9Q4/lf3rPx+NpAXa2Dvysk/8RANxKZMpJtV6pbaVXyiTUPbEb9ITetDMA5tuDkX+ujzBwtof3bVzgG/piVkD0CbHx0SA749j/W8PbH8yw6MZwwTKfuMUYtGYTyykoAbZ3ojadD0FbXwnI9AoiESM9kbUtIOR6O1JhTOgXbVvtvqrW1V6FisaqbRcRANJ3KqSEoNyUMLN7p0eqHq9tfLgr+Y4PWfzB7KvH4aNoEKATtXcqxD/opv5dsGMy3RwvOYSytNfOSWc3UgcYUJ4HAZwsjlSQ+Dw2m3LO/oUDR1GmaLSgxnfTbGx2QkDXOOn7yK5tqvKz6RtNSvW5pXjAPBkTM7PVmFsKpnjxbHF57g00cg2zV5d0SkgkYeMn5KiH3KlhymT5gGEDL5tKL2a1rTStFRPgcUKnVZheLhbnckqo/gArdE/npmptm7krQpaZCejOQnnFL43AW3OVxGD6b6u7sHA67J/QqBBVnj2rHwgRf2STjl+yxLQEoM5J3vVSaCvDf+pAhnqfUzQqpuSMTJXRpbpR9CV3P4qwno0Irdno6EqczC+O/cJY+BeWC3snWCIsZYfB2boYpSN10DzxWBVANHuiBqE3MZA86Moi4Lz8ScEL+jf8SW/G1brs5IQgJiz2F9jAf+vKjBaHkaX76VIOeiEh9eO33viXo0iPEGZFeYQwI94zJOnh9O4kpnp/QvO5bvL7hqigln456BPvGGM883B9oiRLl2+MN4FKxPSnJE918UWSp21bJPnFa/hdeKrBA/vOWUn0NgeomT6teC7p0HRteDydNiUZiIF0QzRLUhWhHx3cYpshge3F81aGtSsSmZRp6jY7fYfkhG1KcehZsk0gLr8X3g7siG3UvAP4uR1HKqYHtls1jyMimExiy8AIb1Xl6nQeemD7pizrMSs9IpumVmLth1U2gZX1h0NjcKc/YK+HLl8/40fiNf7a9+wtvgpRaTsBR9ljJa0CSj2elbJD3pjpI9fLd1jP3j8qtealZH6+PkJHKZl+LxqL3WfsvRDbnDnwM55Z57hdtS6BIzLXXYwS9M9DuykuLcqD2O6trdwjXkAQRlPcOuc5XkKswWJA7WUdtXdg2f322FgGW7nB+e+q24Gu6TOwVHvcc4yBpP9OB/Ppnh/Y9n597EDMst/6mN8Bs/weeR+DLdfsuCb0sM8Sry9TazI3fPFcsAW7HH9mYemgVGty1zzy0PbqRdf79FxOu7t+ebu92hj7JT+p87VAwEhbPhoHmRCyah/D/092qnsOrx4O7Gj2epPUf8b88viLQ3Aa0D1mbFzUBeC1dJ19C3AMngvJicAhswEiTGZkuM2zuJ81sgxYKEwUAEjXxASTQYDyYc1txDIC8JVBBdez4gl61ZV/lBIKGp2s+qCSHnNg3kbs2e4QGG0FsKCPkdtee02BZ1MBaxBAqHqD6aDV+Egy0A8vwzYPGqIXIST0vCnGKmhRYGCXedGUjRj3gTiw3aM+uI+fzf77BYjtsmYXinO4/OEbOn/twYAReJfoTxVP/GhKMjdA/OUA8uSVZJVkNxKDsOBR0epdvNV7N301Z7eSz7sA0a+8mCu19GmWndq/r+5Uc7/3D4iVdUk+ULaS3KAydVKwO9mPkP1ibFDEgSmOEVMlSg+Xb67UUk356wtJM/5TolKWJ1bQN81SniedyC5LuQtYIiHiqyXDc6OIEj27srUc87kJP8rZXp86aotsaZJSC6PpPyLnJKWs4AtWdriZJQg6wY8A3XNw78n0We+EZl4VGnnCq8V4r8GJ32iaZeOYkI89+cvPIYRM6gLOk+JJDyWhywxjlbcthETqh+AnxZORW3C+mt2v4WEeBGF3A44VKy84PvNv/JDe3TttW+mjn3Up0ym7m4n6UTzv/Ckylta9dHHBbKh4U1XTwW276kfrBwSRoWF1aJnzan0m29Zw6GChBsxR86C2Rd088mTjrll3+cG7/luKHiUDvpzdchf0+Sb2Ot/XebTtWxF2LkU0umGGv9Gv3VrVgM9Bo9gODYoxKwRQ/06pfPeteG7u9xASH8qYcN4vP94YFjLadOqTHkMFXni2aUEQdu3kThN2HL09pG07Nl7e212pySL1u9J/cuk/+Zv/5HV6oJ4jL7glKQ6R+m4kSuEU+t4Y/SM8wlDSuH3pXlQQzSj8GOu2KBIRXAfwHl5PuKb1JlsPn26b5CnV7F21vlfLj3yyODRJnvJtEGyBa+lI+NyEf7jhneX4ML5DQ6k3xqE3SYZzgUL/23G6QU+ql21sB6Jan22DIPzItzNModHYMmcvBwp6X5uNTz5S9jHWR4ilzO9mebNWPdQG/dQS6uz4OZIhR3Ep3NZiI5WdNdRncZpEpvTgjWRDS76W4cdY92W0YDm7owyebNs21Im8NJTcR/DF1CS0TTG/nn4kb1u7tPWdPiF6nucbOYK43TnAC9yXfcFlBsweziaRZCnrmMICFSAQyQgfcs25UpZZoHFd/cLGB9EZBkLNHKWYyFuDIJO+FLw0vmphQbt387SrZkGxtm0bmq9mKZP6SpkZqCvMlRfx7PgYgXPpI4QQQLmO5TFBcvuFKtR2q/KQjqxrU6kKCJoDRIzp4bba8GCTy6U9OCVfybbVtAHLuKdmvMEh1DTC9MbR+xeSspxn8y6lbRes4JcXOSibK36D52zn6WG4cJuelOlse78OO5/D1Wzd7Kvi+edqvwoFS25YwfSAXF4VQVDFWRSuZlu1a+oH5bKkAD51YDeInH1X7ZTBAhcGVNpHOpCUNZu9lzZZsBfjS/glkHjRy4EhsRcd8ZMHllFmkbqM7U+ldpF0id8jyxJlzPVZ5HrVdlSUuT5j2BVRkjLj66bve3FiOphVeBYKdqM5ox0wyHg567UACB7zABvgOtgNiYnzynJEZoyOba0WLOcyHoxhBPulCy0HKDngQMknC+fR4sY3w/G99EA3wuMhvmR6PR4PLJMppZFO15tyL9lwbhRs52CQoRYbGd6O9gjt03S9FeXouxsmmH3dkXn98P2AEImgYz4ldoOci/kyPKCQlQMq4NOzeRMUG7mDvwanq1u2luyGst6HbBuAVw4C/9aGP4JXiicATt2GbjpXzM59sa7uohsGtYn8qh3AVtLGvbffsvcUjxGTqq9tu7LmbqP3FDorRFXbHPra7T8mDe/oYT37zOpdO7iRj5K9WPo7eiHnJEpOQQrNvCUBz2zXfQgzwyyyH62FBAMni/TATOHRUFjq9a+gcSi5YIIjBxAJLlwAseXQmUcMMVYwWDHzGYks5f1biEDST5rdrzEekkSPUDMBo6SRaXc/qLeM+/u/LUPQqP8AVrT1kNptlMrHzei4CIJjVKvY3yQFjV7saEdZ206yIJDotu51YRCIbkcTds/ErNLEnvmq21aCQB6casrtfxwmcVMU8SLq9K3OU9pJrLrLqLvUByzyxbq5u9i7TrpcaeSld6JBFMHkuCaCwFyYsBmhueXIYuWzps557pYA6y55D0art1k0dU51wU2dd9IrXZj55HqWK+SPgEM06fSgN+WenLwQuXrfnEZdM/EnwMXwZwpsiLOOYAtLQWqyTJMKsCRV6Iwt5IEJg+1mnp3SGfMhoSwoIBxB14aYk5V8SILyj0bq3Z9jrKDLUHUxvQ38Z7WuditQiknYNMPJgrqJU87wOS+ZatvCDBKAT3dS7tKIIrFvTSZW0uFR11sVY+CMOvvSAaTCDi7DzNXmKFCsAOMCyXBrsYBuMgiEAYDzqiXaVs8tlqQnMN6wEmBbZKSFQSCmpDt2CPQ97P87D1sKJhme0iWEWNH/ofJBkIclxn+3AfAQ+OA44620RkLdS3jOF24zvLhY0kK/ondig4jqJJtBAI/smtJkMCToGYbDmlEm+eSSFTZ8oGKXlC4nEpz+BmtDgOcxjv2o7G1igwRx07Gf6lUA9/V6S9Oxie1bkjLl3WJXp/2+zuO8I4iA5LfzcrJwQg/jNK0HGMyGsWfx10MOmugp0nWrxG6V2K3GqV/3pkzdbAc7CJ3Q9aYuxfWkhJ5ECdxiWV5JcF3PwQBa/zeV7d14O5Sd8rZRB9qzmTC8OkNunCGfno5idoKrrEyX5tc/kHoqJBQpt+2YXis7gVoKw2s3szsZSuhJLNh3E97VVa6+aB7X0Z00EgTKIPHHDSRB/U3Se9S46WTTTMr07vvNujNOwzIOkP79/d57ACXhA1NQ98wUd/htb+Ljrd22UtqNGpqHs5EnqSUEs9vh1guu/nbaZkYq2wlzl5kLowSEu8AgUCKkbauvcOFKO62yiwt2SZfSifGMTBpi0HXiYo8uHYC2YkUspaK/Y/UOYrsPadTl0EWaggAg/UHU/PL3rMvd42Zl24YVbNhq/43JHrpO6RdDbblAn3ulCDTccu9XkrIKkRLsG0BB8Jdd3TxG/2OxYIXY7aPXi0UnxX+zWJizO1e1eOZ9Ps6zriqeetSK5thkpDemzJwL3lGRdVPGO4t7cvtME8f5qTbZXAoxPkYmyZGBu43ln53ySh2Jf2/BKiA2zydd2gz+HKXLk+Vn/SD4JKvFbveduNMbD1TM9886O0N8zivrqGWcsf4ozlZbVfDfzcXvrsQf5+Lqj9CwM9Dt/c6iJv9ufkWY4NkJNywB+JZMDmoEAJGsg17WdfJbLelvlymGnl3GN5ewWr/4zvsgJ4i6N3M9wWp8m8/3zWZu4QLLXiUhA9ol6p74Dozqql9VzslcIPJ4/wWdiyCmuspuv1/zycQACIL5V4cSWDiUQFbP1DqDLp1MjoYT8dxtDqZchDp9VkKRX7iEwj381JwcDgTUjoDys55BJvRNloOmYT5qQRJ1h54aVETY1uXB1U82P5QKb1sHt63k8+vtvGQ7yefJ9dPrxfX+enu9vi7S6bxc9uj4B1GP6fD1keUgdMFid4jO4SC5BizYGI1jKFq1PAIJAkloHguP+MiYNfjQWx6lkTDqJBUrDr4KA5ZMxWqqH3QSVmNibhAIx0ChLPYMIZEA1zAKx9KDqD2rBROYc5g8Di2agpbb2MZKz0pYOu0+U8wOuI3Qh6OuqAFldFKsXmXUSE3UJ6tRHlcDUFFLtQ+7StA4i8KMK7OWRo2wrJ3eVgK0jQ0YTEiUWeAvbyo5m3ngiseNEIawuaYyfgS2CVgJGNNEA9olaAd8uJOMnEHsKVzvx19y8mM9lbnDKWJ4RuEeAXbuYHwGCRdGo2JDYS6ulGYcY12hCIJrFrGaXkaWzGYVX1ypeBUVsYoWy9VVtaxQmpchuluYuU+1bcWN50Nve4knmdteTN/i9uwtf/sc+M9wkvmYUvZZ2048kCY/C9MHW7lt7jeEosZKcr0gcXGxwk24pYlZKR2EU3nSbhV2h65TC+7HjpbUV1yjv1iJ/mI5V0kJqhCDvdNNaFMYzNBc1+vq4hJsyPMO+TXjE4sptKL6AZhWoIsD6i+8zJNLH8llMOr84pIydTj0mAjcYL3TM2U9QUpvF0iH1hw9fOvO/i92QLjca3Yo7ABICExqEci7Mw4svPpfBG/Ik3vZ0alp1lVMmjWJDNDugVqQc8nuJXuQCHC4hcWIaOrs0ZhvmGq3tuPoq3nFniQ/ogLYs06EQ65/tuhCP2lMuYbvnrZY7IyNxxjsE7Dx3Usen97bqI7q1VdwdRp/vSX/fgLW/n/An9f6x6yZ0cBqvUGwEXiMlSbg5hVBMEAKDSWXA5hqfXbBkDgfhtAMFEiEZ5onte7B8b2M9pJ6wYqz2G4lsYl/4vWJHoZIdUeV8s+InCvnxiEpjfMIVo43rSTLppqqybCQ0hViMNGhCPSnMAXkgw1fWvOQPLb4kgCkZZsbYZC+keVVFeHEp35c7BsOaCN9mDpDmXWW9rAAlr6F0RGNJcFoLzR5eUaZBK+t0XnY3w0XrNB1kJ3IvirCIgj6JiTUuM/yIlHTaUpz1MZsvqqeQD6fsRPDnNH4WQbBk2zbyaN0aaApnlxGIvF1z8SwfhdkmtGU20xrOwIZ0KXH+PJPMs4iZOXv5fH+1mMXQb45mFgZjUYr2e/ryROMVb/ZGf2tNmSpJs6zLvzZcU/tmvttpswIzK8fp/OSjsqAHqTxC3LzcnlUcUljyNbXWHSuSk5v0LZhgSUyfEE58Whm3okH6xuOepO70AdRNPKpzHPkMQvlZBdJmo5+Qw+lbVnobSlmgn9qiEdXE1Qk7EOoc0mjvQyCvTRbB8SoPFAGH92fmEhG79PbLgHIKqNez/anDjwHam4kikM3wTJAk8YWSs0ZWBoTnbPk8VmZ0Vh2Z/CDnFU5f5AQm0X/Zk2zzXfjqqvlcIzC0VZJSp1fNlQmNr+RFeY4QgjDt415LtmdbKT0HtQI6JmLSuVxZj7ie2rhOB2YPx2MM6zKKxBSjA+ZG2DkZOPJZYRD3ZFQaFDDrC3Nse+af6gdfcVNPuM2e3z2oBUxnD52uA4gSMCIKP05joKII9agOwF8MYe13Bqjecff4FIzjoae+tUQTRjTBUmm1kYiaHFIW9RwAh31mckvWp1BJ/VJJ707/gbphCbJnyadfugX44Ovug1YpG0rxjUTmv4GIYCwWNDMw+lIRGqocUlfDkMi66gF/xqRdXaSZupaAJJKoJk6twOZ9giif57gEeCz+k8SNzozxom3pIuQ6D79z/G+eyHB95Z45ijgNfYNxBe5XNDoV2mlaM7Gr20/O06EAFVbVcSL6OJSEy2md6IXUjRbEpHV/q7+qtlqBqcWux2JPPklelL2pXEuNlqCAjhGdttsZEn7ff6J1Vb2V/Kbzn3Tye9g/XqW85aVO9GXwsdz9cyz5YDD74dN8e/6GZEuPfZMGPnaf+kzfc2VmTCEka0S+ffr+pkwcieevoW1rEdL1bWBijF3PxjDdEa2zeO7jVjr9KY2V/c79VZsCCMASPonxDtgFu/gS7PFD5lbu5ZQ8NWTKIHEDsbKCEzdOH1VPTkxK1HrrIFq4Y74J5A9WrnjvPSiQcleXHG7FnBWgl/toc9G5vnn+tkpOaUD9nSGs544kp5mFvXsNZJG+4nQF0RK9rlxe7PiuTE4Z0FfpGep07ZJakFyUIBaaUIf7I4/l2FGWc4vQcrjm5WfkamakjMvtsefUMRFX0q+MOUVXCYlcA2dw/4ZmYLvLMIX51OOd8uVdezNKVMTziGCCvR0Znuareihj9ZtANP+H/e195Xf6O7JKaEzaiB1a2xbCPn/aYDwdmyYri4uaQ6B4bEU94T968OGatzhsHlma6YrlqccNaUflohnsUTDlW5RGM12b+RoNDRfGxf822H3ahn2xf122DX7LenhhHMoTkKYgv2R5hl9QaM48ymgLkaGW/IiyfXwqNlK7LAmksaq1y5JI9W1XBq7aEcwCAApwP6z8aV414AO6gkth375xZ2xv/wC8gUznDYyLoyobFuBDDVoMU4XgKADJn7r7hMLlS+Wkuu5JfTc6k/33Ez30UkOK2B0orvJDBJLa6m9WFqTbe9UI7K+355B7NQzE1D1zEZSPasbkZ9t1a76VZ2hAPfsfg2JECf4LJc1XkDo8rx5XOPV/QZ/9VCduWjnZzbA+VkXDP2sC4B+htFgz/AAPuuCbkO5Nvi2vrjfnKntttmewTn5tL9T63viuaCPmUj0OfaOdT4iyBe4NkDnbtDuMzPNbTRKSY+iqKx0Az8RMbNrcSjorGt0qOeTQ17/Qm9+dZOhafeXztCCfSX5/Dqesz9LPg8ZbcPrpH2hbXhoU9oSCCNJrq/1+Z2219eJvp7LYr3d69v75DoXF8VnF1+lL28O9Jxc786juN1v71VbiHqnwOiqvYjDeLK4zul1Pg3j6Hp2nU9bqstWX6bJ9CKNIQGUkkD3/se777/rgWxWRShmOlXTt/oX83WR0bq0UDNk2Gi9BKCjld1Q8VkXUt4GwlNunv9Z9p37wcu7Y6vB8Y8vKFuAh4gAzZnmO/IpnxQXE6XPoAOlsdsSiXmZTBUFSxKYYCH5Zv0g6io/0zWPzshUgvkHNOHD22+PIUZZDoehbNsj6z4HuKcbC3EcxOyL79/+oMvaxmEOUIZeEthOICzFtrl7B6VptlrP+PnTXU0o1e3Ctz7L9tWD+mDsKsnbKts2u6bYzzQf9v1bNC7YPa8zTmDICctmeiF/ePttKCntoj9k1gzHF1IMkJ+DYHLKLgHqu4XO8/z1jrrzw9tvsTdZht6oX0s+//fZ+as5+0ZP8SQOUvoLT/4RpOdz9h/AgM/OYxolZ9f79DxM/gFz/Zxeb+NX8/KO/afl0WVzv2/FZqP/Lnb7ZitK1c6mF7BQd1WzbouqVu1W7drHKi/Vnkav5uxb8/qfv3zffv3lZ1/QV3P2Vqddz6/nc/YdPE6uH2fTi3Qa6UWhH8C6u57H/56e/6+Whngdpef6eRRe51Pa0pbO2feSvxzYD/D/L5KT8zmxzqTknFD2V8m/kMDRsXeSf2ec3f46EBLi8ego8/c+Zd63Ezqees6wVH9vab1cQQ7e/0j/LB6SjRm15JIVipMpwjI6ZEo9kXMHR9m25JwwdJIS4EmXpNTZoGd6Bg+egV4z8/25fpRDM2KAIdGn8A/SA5cIV/i8cjtHskrRoBJOOgFIVEmPKTav3PCVMT8+ZZp707ZF26rkJo2LeBJW/MZKtaJQQvRTfTB35vU3lJX63+SSHiirnJrWz5wsUtq2EwU+BEFQwmTo2v3T0I+Nr2fiRjy9U/t9tS53s6IWe+Mv1LYmgoNxnewMGpI8DYIwVEmexiLCQBkvB0p1r+uHvjTSd2rSp54fvPZneQpM1zCrO0B4c40zdAw51/Oj0k2tZtg1HmqXMuiCd9WdQQcAs4e/qt2mWe/U10rkahsSE13x4j0G7UXjC4DdxIi2VRGuILCd/u/QCV8qNxolXcqtEreHqgh1Xar1WUYLqBZ6XLrCMtRcVSYgcdasH9QW3HxKgD3SD1Jw4DMl5m0b5rykB3BEze1AF3FYTLDhQdBVpKAsS4oOvch17wc5iq/GKnajp/ut37VmdUE/3CaXadcVfoXpTVIOhQP9BqXLgt/aQbFsEUDFzrZmBL6qVJ3vMDRdloykp1xSCOSX6zNfV/Er8CMIglByPwFC7NsmUPAnZN7n9WdxrhR6YNDCHNN0NwZBBXJGiLzHb5IKBqNI2/YmIedwySZlF4vvBuYEVz5RuEouU4MU1xWx0uPpSoE7YBM5nyxinU2lkf4Hfh0AH6fzsFs3orpU6k2vEnLqz5dBIBKyX22bxx1JqeRlKI3pvD788d4cvLWDXt7txV5FvXOUwU9UxnVEvmvOcAh3gCS6be70pJySs32je+Fg2U1bzu4+y5TmW3TXR/LgwWYKIBmiBavFbv+2yUELAd6LewFejP5mE73cb+vor5KBvpf8+cv3hFW7b5tM1NF/GgHnO6n7gmGU1GiyYJttoz8OARz1lqLpD4TMhPX8HooSm01dIcU7f7p4fHy8KJrt3cX9tgYhlcqXmjHY7tSe//j+q4s/ECayTG32xvnrLxLDDCJZtKlFtSYYYgxT9CVhT/q+96W7mp05Sord7AAd2sugU0yOG/EgTITKg637LnrRZc6v5dNdfS3n+Mn5tdS/cyxvfi3177WcH1h/4eDLxCZ+ePstMa2wSe/V095Wy6ZpGhRrYJaw7gGoIomQPETi8AzarDsab3Upmld1RLtJ1y2POmL2wLwDBcfbjtXTPposDm5G3J9Qd8j4JxnCsdU/qSiTNPpJhv1UCBGpE7rIsO9l+L2kkPh+K9a7TbPd68QfTOLAr/XIDa2jc6zzDBhEG8STIbJG3R2o95vwBZxz73g9M01u25o13W0QhD6Mzd3s5uO92j7TeB3eAcPwoNZ7tun5VbOPfD37XNS1FNntLiTNOlNnd+qu2T4Tyra8nuller/7vMkVhG/b6c1+r//d8wV74CQT60zVKifskb9slcif38HCXrCjc3IEQqgqwtec83s81G7pyy1/OTgJy38YQrOk9DaRxxHouExepwfJbxMxeHLoB49Bszt50HX6rK771RoLuwOVisvIyfD/qj7eq93+qCG+JrVXBUu13LdtKPg+yVL417aCgQZMGh9fpvnybZWrt4bEGFN46EJqR4RwYd/tBme8bwFK4PXVPXVICIJuE5nyRP9nIpEp0hdnj9bDVSSPZszTXiC2AxNST/YRXUbbPjj36yC4mUHGUFL2HC6YbedB12fjHNgfaedg+HEm8pw9zsxRwB/RK/cReTL+CC64rJ7db2sOgW/hsm3/KunUj2D9NViVutu3ksGGPyXzOVg6g2Yhm92p/arJ2zYzppm1S8EsrO4oGcvwd0nAMNDTPAkhqbFIqmeaxd190dyJag38vmWcoP4D3on1svNJOMnbNkeKAJoRBHny2ty9hki8CNRLVvv9JgImJ7lMY/KHBYnImze/J5RyzvWZN8gGpfXywdd1A4OgnnlnYifJdWyGzWd6hMPuLGwH6U7eihxiGYiaUvaj3i9ZzTL2SBmudDNTHpeVDXWsv4pnMqsQSXY9w7N/OgWaH+IUW9kWgR1xL7b7blDxpx9zgNUgmMXTkE++NTQAZqWs4DAKvVywykz7zPMpD7+SDmmUBCQiMaFT01yjv8Y7GEKRrdBdE7pIz9hv5AhQ6TeSkVeXv3Ay/VJOpzQqpqOfIS4HWP4XlggCB32fKgIC+HE23KhC8k1xYfNcvKvWmSLs6E0Qte5F+alCvmvW6uKtnuaky00pC7uJ0/WjvvNIKHBd1OvNS6PjX+oxUqxXCmVjL3wGpBbx1yxwNPXMEGFJ/0kan3wyNbR8Pzkm7IxM/yKnZHn2kS9mCwDhpVFXDDjYLx1lX89WeKzQkfrqzcU8BpdTiPuOMTvfqXWOGPXuFhUbd+yR1ZRjJ+IacovI7LV0+cAJXBJXkReznUaXhjy/ZM7l/fJAHxOVhrWtxA3/URMxZqXCafw4605zfqlXZjNYgxB+NHlkdXq0tmuU5elx2KNv1pVmTlb8FBibbQkx2Qk9MPcuxaiy9/yS3cx2mjfYsWfLmTwh7QDHHAVm5uxp+RxeXLInekCFC9xpzsTRbMQD/XwOHeInBpPbsT17YE/smWfL1xPO74MgvOev2QqwnX1/sxVlFmaJAUAnIazXb/JqEb+JFuyWyyv+erEIgt8vFleybX+/eMM5l0wP+QP/WYY1ewT0jAf+Qd88sEd2S9ltHA7W/RN/HJNAfCt2e7fSCWVPY1sEf6LsxPt6RbvXzPLmT5Sy11jRtiVff/nZF/r8wB00hqhZNu5BZNqDqfs7W5EofOYPQE8otuMPuGvu+QMe7eyWT/aURuGeP7OJDILntg2fOTFc5eIKLCP4Qh9Qlirh0l2C3VSYte2zpgPYbbzpQazcsWTHntljSqONj7Fyp+fsM9unXaGaggq3muA1g9mb7bcxznfDqUZw9yXWUU9/dhvvIl3cR8B78z6SUl1SOFg4n5tl6BbPxYU98EASPXbcNeBxYcnaR6BkQeR/0uCytOaTjAB/ReGdd8AmnvI0wHdMH0hGDFPZNzIp1Z4wsml2IyZ5Qy1W3wu6L6kF0Zpq25zlPGOZ44iQ5+kQX4DRE8jXS2b35kihxCBjdp8DUIlq94PmsB18QxAIaqr/i3oQ9Y/betTrAb/pfwpFCO5zti8YHPGetODSyRMumRWmaGaUDjAuHrdi81nd94/7V60eTFl9iwdr6GAc7ZyRmMG6hah2vqkrnamP4YJ2gZmXNlvf9KgXat4WzuQwojpCAwNg0nFweGu63qV0Vuyil94FDXJhvREguMeL6A74Zr1Wo64c/4QpwaAvoajj3vyE7YAzD8i4dALmkC4zo1KKs26QaCS9COUUaz9qzter9/L0VMiOp4Lsuz3SCD91v+5/rK/0xYEO6Yjji7PXRtMB2eSAFdnZ3bj44eh72IWEpAeDxNKD1v6bPDaXdXFZurhSxyCWnQj67zb82OQ01L3eTtwqmlhbGnHkOgFoixb2EOrWthbT0LntdcWInsXewZkpGLcBC1FksA1H5mQH9d+L+BvSfiylP/KF7hQ/nhIkTcSp8J4R9MqBDWoC0eNqNVKVyWiddRkgg3ol+fy/vV7MS/aL5PPr5Dp9NWci4/PrbXy9npdMZqiJRPMHa41c3YkSlJZqD/pLsEvOsk/ZMd+q51Kt6bzqJkmeDZQMRwD45hTytOtSnzBZ2/5irVhpnIcQB0KXNiUJmYZHcjhnhatiqcn5KUkJU4g9QZ1sP2tb++YEgls8b8D4Pkesrj7IjKTuewqKk4lKsUTDJPNRdx+epEwNHg32AkljGVp3WRkTos/BxLqOphzl0D/+9RtNWTRrjGs/JZxMR55ICqIYp+JCGqsv+QRDMV+T53P1HvikXjXCyBkNIFb/+LXj1QsDg3sKOESwzm/ZdbwHhJlnYcYA8FKPcgeuddNU65AEnrDnlWRkSoZn7k5tK7A/HosrbSQXCNNuM2K7KECK+0mndtDRQ9A46uHOqYwNQmcwImLfw9XYwx2oBbgaPVNh7vVOBN19us/NhlztQhJ13r1BkGW4Hnpe5jQIJjKzCyUI7N6Nzpnt5O/2EYVwxF7bfNAb36N+2ZO0orOg5zyf0Rgd57MRx/kX3YYIXWMY+pYIN6IiY+R6e70G6jMayZqNZwUi1pB8vTn8tLLkPaC+9wxS4hPDazRIcZWFNOoCjL9tcnX1h3ilU+f/CEu1bzU93GrWvtV7HQqGWuPTrDdDbzD2KP/Qb7etLvkQrTLYfFXGF6zIIPpHxo/rH9KlmIn9XmSrL1Fy1rsNSbNG8zfirzfrvg5hNYuMFlkiUrMBQKAOkFw12x2fTMosCMhjtV99vlW5Wu8rUe8AEzbTNaqhRpCNlZndJRxXHQ5srSbSl2m2LX7FTKOl1QMqP6KLY8MLVnKJbWYrPp2qTO9aJUQ+DiUKaiVI8CTKGvTdTm1hgsjZRux2j80214Tq02qLWi0QgxewWXuJwOp6CUmRLqUTtgdBORuK6MfSwu4V/c1eu/OEfLgwgiCVX2iiiaRtG46mc/Lh7bdf7/cb88DgGEO1885uAkV+5bGIqWA5RHkkdFmisET2RHNo34E4Zpp89SGrbNdX7Eb3dhYEYd62mrMvPakG6MKN6LPIklXqmDdWzpo1ZASWH+0lwUYQsEHfTPoF6fpbARZYOdyg5Uxp2PJjEIly5usfIbC5pid4Px3FRZUrR6cZadEtfak4IYeibSfSLu+27Q1YfPn69e9dyLbXizc0Kjh+KH69WERvFm8ON0GgQugoBhHfjrVKcIiYuRkPuzDugxvRaLTjoHN5FuktYqh+oS9ZEGT+Kj706G29u4DLlRfFRsz6U8t3uHIvVqMvfsp87+v3738g1C+sp7Z02nDkoI3au9NZs7OeWvtEusruRtOfLronPe23+dr8WoZxpEttdUaKyaDy7umpQeFsihlnKpHN/1KfeoIyceiOGKcfDp2UoMfdWVJLoH4AnMJRUzC5pEwM9ETIe3CUPwjzWY52WsMNd/R7YCDjldmBAuYgfNZcHNEXANHUtvnQfHJ8awYT1hHUIiskspOdTxZMzDD1czSMAAcnayQxeAZwR9uMC7OZN3CAcTm6IHoG0po+n0hvVbXtXL+r8tZKug1olOztXlCbT30GjH0/4YFlTPwtCBZiqLGsbYvw9WLBnE2L3gGyvvxEsswTdowta/D7x9odrW4wBcw0x3Cb8XnI6XUcxjxoX9H2Or6O58vewrvZNetNRDKj3kdrjY3V9h+HdbrJEJcOhJAA/t5MyS+ogPJJz0SA1aIYXQD6G2DQsSE+j3YUl1POIJOFtbzN7Ejdb2sak/ttDRFg+9u/NMomiBIqfe0Q+DJ0LjK/abOjKeTum6C+CwKifzs6fdW2BFsCqFg9rVCobAtsfw5Ytv5DGg8SQhoNUtgqlhB6NFmljrK9zRh5dUmmymW3HSYH2knbcVZ1aLIjFwgUSWdYZ5Ys2t2k/Jj2LTuLaDUlZ49id7Zu9md6JoF2oUwWqT7c/C7hKGaGIAQqZfqfX3LJnSPDgeUjeP5dpFSMCtH5HIeKRlgc8NTQ+H7XZ4Ou1DP5frcKFaWsRHdfNzIFDYIiLEGIWfKiiwHkNjI/diHYHgEG31DQDi5lI1b0omdFP+ofZUyA9OYvIcglEv/8Cc0DAHR4kgVB0gUgjBM52HdVcpnSNAoVvxFhIlKMjV0EgUVaDQLdWNOPoW7MndqWKkxSpnxxnRH81IhmCkb3S3c11uwxWODahTeuT+CZ+hZOGDFoxYXv1Natu6uLS7CXMMYXwtiTrpgFKqeUCW6TF0zfDwUmfTOrSI5ggkvQQJAfvn/3Xs9p519j+ZqeOkDvMAONAFruGbtFOohOIuhL0U15Vs507jCP1yH5Y149XBEn3vYmGeCSFdU6D3MU4Np1kgVBnxMvj0S2vW4v2tYzhtX0L5NMpBCJyNgOOX1OZ0/BOmUTG2iqfJ1XTxvGOl1w+ptuTX3mGg4G4Tz9PZmkwYPNx1U15VZtQof66vOcDpBA79cYF8SM6qGjjO+yga7g52qdN4+hoLGI/mdPTBx34CSVemxbKwTGNyIQAKOsFuAnUKh7GqegM+3zQvk3uwqxQlnNAfLwjr8clkTTI1VmQjCFVk5uc3OyVbXQJDnR7HFtahEiXLkpGpSGrOoSaogVy254SITcNfX9HmM8tS0pqidAnuK3FPZLgy2GEBksKViV0quLS3YThzmvXT1CvYfms32zAbxU/QEahWU/XHXbLvox9SuddLRkQQ4pbfiHrAemvqLUxi+XM4CZDu/0L95drPT/adll0RWBPPrC3F+s4EefhuR+pzewan0mYzmDG/vdO4rh6MM7xCLxpInNcHjpp7AsO6ofFFDRCS2UGbmZmz4WVltadDX0wXzZN5towWoTmtfhabICgrb3tSAAF9WhPxZDAp95ChTJFI1DD37MbetqPNgvbM3qVCBgyjJ+l4UFZVBjmBtTABEs1d+whYC4bOMb0gtNyEEB75sNzAtoI04m++aH4Zvf6pnWvapv9bsHGuUHZmdn1I8I1FOMwtoc9mruUEqNl41bFriGcn+9xpoXOtELUWjEtji6Pxg9G5N+KpzJHS6SHkw8UyDQtQunDn0K3WhXMuTE0Mnvmw3GTgZw8Aw7bSSf7qAuoxkcXDxQ9oVrIIYtfK/PgIkdDLOAsPhhVohAbXgU5rf3/0hmftRbnepu0kePwi4C20S7RY7spqBW7pVnD4e2/Ys49BHU3ZSKiDfbCHOzFNPN/D2KU41i8fnfLLdJl0eg5/knQj93Mk8OZ9NyuIOouIjBereIi0SmUbeehws7ydNI6H8ArVXEhVtoYRYDOdi1NaSRYlmsIi/9veb/KBTBFYR/zo8QgWCTHSLma4oBjpf0dAxvmWLQ8031pOofzEixUafnTNNvEOZcUva9cOhvwBl0h08iU4w23kE4UW9cUYEaWQApBgsgMrhSxzj1+NK/ufDkgL9NpsIKlSLJCIlIc7+H5MMAtRzGPPfHvBvXYR9qHsCLBuC2XAgvkoHHFsZpUejoY4OJRmY5d8TycDr1tKfHRI7UnOjJ2UNwNyVTkQIlJDtKCBje4THiYqjJmWzyZ2Asm7rW7zPVu7MZcEXaDP277uPUDid4R+Pq1g0rqQumizZ7JT1ovifOTX5WuNk5dICXlS/M6ltLWXIUwaERc47dr49eGbxQFO4NegBj4HI8uMwx1Wvi0ehTd/Qt9w7YLQxmT+x9npyfE2OoohMk0zzp+TkxsHnQBbvqV8VPbMaGPMaMYp2/U3WBjKDI8z9pZprYN724RUAqBAH+zsRdbq9DgtpgwpIeJpCdiYamWevj7eYvOidr9PWrpYcU+HmzLuoq2/Mx2n72Sm/4QBO/4k2G4WdMWe6JuV1nlK0PTAJmr0nTr611Ml3+2/8OAAD//7084G6LewEA",
+		Contents: "H4sIAAAAAAAC/9z9e3fbRpY3jP4/n0JEexCUWaRIJ+l3GnQJx7GdTrrjJB07nWQoJqsAFECIIECBoCRHYH/2s/auCwogaKdn3uesZ53lZREo1P26a19++/Lp6OLmHwdRvb+4m0/nz6afXTQXXkR04JfloYh5nZXFRXNxcwth07JKL/MsEsVeXDy9/I9RcigiiOFxGpJHpwxvRFQ7jNXvd6JMLrZlfMiF6575MBUPu7Kq90H3lfFpXEaHrSjqIPQ4Hc2I3xZEHrPEG7VRSL2uyvuLQtxfvK6qsvIcVf9K3B6ySuwv+MV9VsTl/cV9Vq8v+IVO6ZBFJepDVVyEHidHH/96zqGIRZIVInZGuroyfSB//Hqd7Wm35Xe8uojYckVjq/JUsGi6h+6iCYumUVlEvKYpi6a7w35N1yyaZkUsHr5LaMYej/SGZdO6fFtXWZHSDcuma77/7r74vip3oqrf0xwibZkjB8uhBetWQjUGeqKYJsU0K7IavxxpyS5/XV7vrw9fvv7yy+uHF7PVuOm9P7lM6Y5d/jrZ7ieX9JZdTrzldcwnv6/IZZrRariwcFqXP+52onrJ98IjxwWUzIrprirrEnqPPcqp429pVBb7ujpEdVn5Bd2LXOCj49BcFGm99me0Ll9UFX/fDrcpSEwjnuce9D050lTUnSmhm37I8xHjweyKBxBzycfwM5X5r3wZtvK7mcFovK15tOlkCUMasmK6FVUqMOrUaoBHKG+nz3RXibvvcI4znB0hxK3Fg3zVLzQ8UsGj9WDVp/AFy6GcHOmW74aiYXamwl4x3fKd152QIY1MdC4bGtIIpgEhR4rzcaB/exmLKd/t8veqPlWKE3oPGSRZta/PZSBuvRk50px/MMpkTo5U3A50tzVaNGJjPvZgKEN/Zvq6V8/ois1cN7yKgiUObrRa+csVZF/EZ1tpBqtpTsdVzgc/pfuyqv1oCj90v8Nui6by4UiLqXioRREzXGnq2SoPmsMp9HtMBU1oykwnLmerpnk80jWb06wN1s2+YaP5IoF9LCzLXPCi3TVT1/VuWNrJbK0yG48JPdlm06Ypptn+S12vlDSNl7LHI6Frxljmul4qJ+x6MiGL7Gq9gIyyxJMryROdkgiBesUXWXEhCGfpMl7RiAn4SUeMRVA914UfKPX7nGeF7GcvgoJhNWV7XOBeRAgJvDDwQjaa04Rx120/chJwGEXfhNt54dfHI4Xime5774YmNCLEvyuz+GKmaoNRImImT9oOnPcoHna8iEtfnRfO2NuO3/B6Pa0geOsRMq3ELueR8C6vX12m1HEIzfY/CB6/90czKuC06czh/kkE67goy509EY+0HY+BBe7oIIcxVkxhHDEb1TU+/tUd1TQDGXD4cpL6J3l0nd8zXZczxqfyiIMU3x62osqigUUKcc1B5enOHdnj57rhZMervfgyL3nthWQ8v2IzyPb1dle/lyN5mjXO+xDmFycq17nOHlNb82AgNRIGTaOXwcjqg6bh06KMxbv3OyEXhewRj5O2pLp6D8QFtzcE1x1t5CbKqWOFO8T6Yidojz7qZPvv9ct3idOWdIx4Ha09s0+P5keoez4t74svYX8lJx1xYWoRErub9NSW854xFjaNFfVIofBz484YD/jYcfyTnQO60ZqKOjTIljcqc7Jqe9rX3480zcuQ56/veN4WGpLHENZxXWVbLySu6/GpeBDR26jKdrU1i0PyyKfijudWA4gHrYj4VuRAYww1hZuFuqPOdj9x2pV7SytchLH4lm+FP0jD8Kn+7rrt87QuvynvNWEDHdsNOTnKDR1IYzaDubiHyfUIYxUxrrb3RXQVL2K5x4aqlbB/xhR+oJjRnISV4JujyPfiwmy4/OMp9FTgRwo9/aFBdxzfg4FvO6qE7Q3Ijo3okWBtw2BuLVeL/sbh7T2zNZNAU0wRdfa4Q9jTB4gvTvxUtiOinBAaHWlWnJZJI1lqDF0ZItW/1mthrckaTiO5GmIWtnRDFMyuogD38i1/8GY0HkfEj/zZIr6KFpHs/Qh6NXTdcBmtGGNmnUVH+TCZHym2ZLAnxqa4mM2oaMf3fp3lwouuYsKXYjxesXAZj8craEQEpxKREcwxFcIgdqOaaSmzZILCuqrE7qR/oOXYR1TA5SNhM6ANdM3WbBQt0qtkkYzHJGaj0OPLZEUTQuMRY2vXFUhIYajZR0Sf9LRGggqashlds+XKzG/Z+ab58VW6SMdjIhiUlq5oSiNC5VQRrruWJQqyMLM7lbP7owlU/RJFmC5XdA0XgUMW+3O6q8qH98NrkSYq6cl0DF3XSxhfhisaMk45SwjtkEucBF7E1GXBEEL0GaEdWs+Ml6xZKKlKqm98Xj8DAmR0PIWqM27/wMEEv+MxjTUlA5vX/SklOwYq4xWvxZHuDzu4Nvv5kdCBPfvt+21Y5kiTJcVSvk2zWlS8LqsVi06CoBPwLuJ8IQnQi28P21BUF/LAv9Ddc4FrFitx8YNIXz/sLuQ2IKkfVbCDJHPtORcO6V2as6WzlAfIhTMOx87KWZ1ssmSh01zsWwpkNOKu68gp58D0gY3bLH9z6C8GaKqod/oHo7nf0kxR06gz1Cmw0Z3ZEl7BXWMyxxl7hKrU7IQKaUl+uqYZvaEbmtMtLWhJd/SWVnRPa3pgzj77/fdcOOP5Uz2U9M5mHtyzGX1gM/qepdwj9Hf580L+fDF8G+dQd9f1cjaaETo70pds/vz5p3P6ij0e++yE17BlfMleT3fljv6VvZZcia/0w9fstWJe/K1XmN51IjajnaVv9lYu99TQ7KmLdk/9O3OitYg2Im4kC0DEDd+/L6KGH+oyKaPDHp92OX/fwMW5KvN9E4tEVE2c7XmYi7hZZ3Esiibbb/muycty12wPeZ3tctGUO1E0leBxWeTvG8UEipt9VO5E7NBvmLO8vn54Nru+rq+vq+vr4vo6WTn0DXO8wL++vr6eNsvr6/vJqln+en39MJtNrq8f+GxFxg79ljnX10tn/M3Yeeo54zdjh3iBr96XT3990oz+tQoYUSGB/4nXZvor/H6yIk/JJ8210/9w7cCXa6dR+ZJG5XJ9vXLod8zx2wKvrz3P+/ezJk3/i0eW19erVeOMvx075Clppk/J9TUUTb9nMC3luva+GTtjhzqpQ+g/7HDnV6zjGDP+VWW6IroU8lS2YfxEJf5hIPFTKn8cQt8OffaWV+N/QRW/GTvERH3Xicp01F+vr1efXDurp4Hde1j2j3aK7wj9Z7+wN2PniUPoT+zx61d+59ufVNc7hL785sXbt92v19fT9vu7F3/tfpWfmuXTFXx+8e7dD36v3G8J/f7t6x9ffdf/8B2hL7/6+pteZXwPJzayYRpgtDRFvYb/E3ghEy9aZ3nclMkENi41XVT/iDtRNGUcN563HE9WDfGur+OnpGjaGas+qPfr63hMGmI6E6eGkzmEAnOi11JYCX8fO+SJilIIEe9fStaXPzCycmD9tlbitknrJpctahvYbYMX+JPr65gEWHWrYl7Alr9OVs0TVcUj/ZldQq2yYneo1WbTQGV4JXgTHuq6LMiTy4z+wi5/XV/H8PjfwCj99XE1vn683j+9Xha8zu7ExfX9JX0ic/uTt4TdYUwa7/p+TJrrqQ4gTy7pb+xyOf7X6pJyzi4/aa6vL1Ma8s5Uw4W3vAbearJ6nNM/H7ERQSNbSJopNgDmbMTZICHGnNmDMw4nf/7880//rCkjIOripomAXXYVB/K0niZVuX255tXLMhZePMYUxB/8eHU1nzWff/7sL3+m89mzT924+fzPnz4Dfl7MbXpnC3xeuCt/pSie1+xrSeLcTXHyfVvGYk9o9+310n7XLFlzKVdXYsHJ41fsEfP1X6tYQff8+asqllNVbEjIcZBK5xaRrihzvoxaUpssdAwWTebH49EQGwnHDo+pkHkldK2O8hKP8Hs4Z0O4r4vqlT6yH1gYhKZF/l+ASI5hTJYrcxkaWTdsYFbMR4w9uO5f5M8cX/WxiVefkXBdzwuDXmFNE/p3BFgcrguXahoC1VLQHcGr0lzl65XsCd61gVaHozlh5XK+wjh/YVAWPI28GxZOU1G/zgVk/sX7r2MvIaRTkZtpFjPGEhMoSfMbQmN5V80S7x45lPcDWblu7YX0hrjux/KBCpXLZyv9Xc+ymNpV3H/x/h1P4aIOLaNYQ2zcpyviulE35suc7/fygh+e+fLR0kxMaA2NgWETTW/33HVHL5Z87Fw4K9f1RrdNM7qd1mKPt2E5Fjim9yyke8YXuqssLlV4hulAHr2NHJcXdV1l4aEWnpPFDiHBhm3M1Z1z6lxfP3Ed4ofTfT8y3bADoRVLPQ5c30oviZz9U1ZzQwLnT8544zvLLGafOOPN2Plk5aj1AozharlesRyaOL7lHryRxZ5V05syKzyHOoTes990m1235F443fFKFDWsddI0IfTWnsCecdLN91OUD71V4qAXee7tsYPlhvCePB6TrOB5/v5xwxg7wBBWYlveiV6nHI/q5n6ReS1H6B/UeTJ3iFrL7QIHMlpy6eE2a4JDL4Ilb650ODUjaDq5iqcRj9biG+w/141FLmpxES75dL/OktojKxouMe6KCV2XsC1yzW2e1fKwYqMZ5e33jNtyp6gSvBZq/nlOnN05ZNH24GjEgTF2ykjUnWUPgevab6r7XsJmLHcOuG5bm98N726i6gbXOARFmh1Oh5hMSDzldV19xYs4F8toKVYrZjV708ktdF1OY+DOz4HrYnZL+R5a796/wum+PFSR+BqkpE3zkky8f/F+GG6ynY1K81giFk0L8VC/zcI8K1Jk+li3ksnccFqCuT+ZtzXO7YGymZOqCWeWq75uIr2Bt0nodxSCMsat/t3+r/L3rAKaxpF0DL6RM+UVdnlr7tlF6mnKxiG1P0U0lvUB4TUHdoseeBoSmrKkOw3SyYRES8GSZbqCfRBmARt5MfzAMyFH+GeqVHbWgusOSd354HbvuvwYsYRPFd8DhOIJvGf7n998wwbFFR7vH56cmJu2KsEIcwPnq3dvvunuy/4IuIFYqqh1LqdlIYOMB6el+Xc6+1Qe2XD2ptZkT/vVCbyCpbRkRf8D3bFR4hWEegI+ioQf8vqfmbgnwNCry92IAePME1Mex6/vRFF/k+1rUYgqOA0CbYe85LFDY05Hc+ILWMo8WmMs1+28ek5ZtNGBczvlegves8yaPDZrPtLnJnMyh4547zgznx1yhByHhvxs3ny3E0UsNzK9Y74st3LHdAhRxZ1k6MEdUk3g01LNSc/+Wx5qxZnvKiWQOGeqWHaqyAmQPgc66mUIeTXNUKh36FcTCgu8eJpkRTz9+lWPNQN0xcBK6tN2rrtrN5vup5ZtdhEFywik6UfgViZZXouqW6JZYea0DTmN2gwGx+yUmoE9+XgkvqdOVNO4/xeKlY08u7uYmshT8jRM1tD0CFCWdzw/CFVnqur67sVf2fDcDYYYdR8bIyv5WZrXR+ozCE/JJ254x2ekU8sVFWxGE3Y2czhGnacOykUe9WmaoJSCwDkdWVuXot8j00uxpn2So+4fZJuw81T5/7CTrBx2wblvVn/QCtp+C38k8d6u8H4vwo3F6y3q09VcFKKC04I5z/mFpJ4PY+eTq+eX/Oq55Di0wRNgN35ysd3zPC/vI76rD5Vgn3xy9bzcSQ634odi2KUMvHp+KYOvHMpPx9pZdrP7lX3yycrsba57KwfHAebkirV8SeATXiPHajBTXZM2q6bRWbUc0MDHtdBIts+5vLL4X0y2fyi3f7Ez6XzFJR5I034aTMn/hMWNnw4knf5pOgYGDZ42veE9Q3lLaoss+ncrmJIOdSQrGqtiz46Q9KIXcMZR59W5boLvLB4aO0wpOWaGxXyu00SBDPKhTtOfqONrPvqZXJ5S/8EhVKek06c+9BeBRbOFC4fY6/h6Ae1ZqT81TTm9F+Emq99048KHbfn7QGg5FHPfC4QV2RuxaBpn+6gsCpysGJ/tjbYGXplo+77cj2B1YNsq1bYRc+h3MBdu2a3peItbd6suuQ1QExWrhuJUdpxQ90g5jcotXLo0Gfh9uc+g4oTWwKqxohU1z4o9CYZYWH/p3JIC3icHfbhNhd0L3sISB8VNM/JGsWQ0xZYOzMiLTNFB++jFxOfnqu668z+7Z796MTllxKHwXp4gIbMrifoMluB9NFuYOyz9goXBST7cFimBiIvOFpIdOjpbp8koPPfJHFdB7HsxG7ojMMa8U74bCc53QUj8OZ270OtSdfCVAPpZxDBC5xJhQXEA7StAUalTIGPsDphnd5STYDL3QxkrPBcrJMHc3wR/8zaUkwn8hMSf+Z+5MaSeDw3QuY6NjHJDO2xINVivKVvyFagEhCvJrWyakWHtYYtMpYO5L+AlGaogJBY2W1CxDRYR44v2Pm/Nn3R6KCTjJYJY4XCstR1LxkCtQMbWoHkRj8ftPNhw/Ebxi6+i3UGV1/p57oNEnvjFkSZc73jDwlhkIQNnRf4JiZ3E7J8ngzE0DzWblyOb15C976jDPnkyhwOfnuzMrrtD1mRoWJNV04wqueuEhPSYlSEhyJ+TC2pvKZsBf6VpBrbapml3I8W6bgPMTmNYM5rDTx6PbQ+FtJDds+QrfWZdzbCn9I402Lsf6SWt955wvKL2svhwYpz6gnWYWj21BFC1Ea77SvaSHZP2YpJAQJl0tNNEaFdhb8RYEiS+fZNumtEu6N2TQuJ7CRu4nuBAJtP9TkRZkok4SOT9xEeWHrQf1VTZh9RUnbfvi5o/XGBMenEoKhGVaZH9LuIL8bCrxH4PyqoXzpjLLj0U2e1BvAXmyykLxLpe4KLO2SiaxqIWUf3qANrTvBZ7umFqf3xbAyUCVy9UNvBmQJLAB+8LQnN9+QgZXyZw+cATY5nAfIYhwkM8IcRiRnKlo428JzonerJtkMsJqlQC+DipqN+Jh6EGRMxxcONLrIMXWpJIbj5sUk3zF/kzx1f8cKruNgVhIwpAi9psiXYgqq5xxqco7ETCccEXEGDzLaMxQzVeLTn4VBb9mb1bypr+E4Zexmv7DQVdmEfYXmSPNJbcLLlZ7Nmjxdv2P59RSQR/vxeHuPTXnOLu4v9E26kOOthwuYPfSuQoKPUfnSvHf4yzynfaTdhRRgOgw+tcDHw/Umdsgitxl5WHvWp9J+2/zkU6HumuEl8is8B/RCn7EPNhOV8x+NNjHFC+/HTFPPjbNHz5Gf79HPRbHdKPChcWnIPPYA5iQpCLwANKCaiZyPQzclQC/A/WpbNfUKeo17KA+crk9CkJVO30gvb4craCin+2YmMPfgKoMjz+edU0c+I/e+o5IFmXmUFap4xj/UYg7ecy7f+zGvPlf51E8OHHdfslHrW2wtDKGUHxrgu9o6faT1PsAyUegjwCWIg+NiiAmKzb5X7kuj/K6MDR9kKWehEYgckXYzTlOcQxTOlJSCb6meDAzCDfWduHIbT42YpFVog9Wp8ScoQJLacQ6HF8nOM0zP+XfJPgRGdv1GHI/Nvqx8ej0j45rdd7JYA0PGxpbWEpHXi/Gg0aPnakpgFoSkCngiR9oF6hHIOBbS1q2S7WS9N8nM3muoP8X4fgWjuSI+2tXWpbM5lgLZpg6nz3YssaS+o4iwDudtBvfhh4YgybuiMDAqAzI19/D8QIX39Vr5Hrgg6gMPMsIr7ztP1of7iazH3nif1NTqd2Lsqi/qWigBrkWJhZ9D1shwT3jX6mjV3XphFmnuqcx3PMe+xMHB8496PZ8XS70RZIsr8ShrsL0mnttKcpc3K+r+3wyWcgK3aUEhHWRHcvHHix6qLgdNqMRvZtwZrwUJNM1qOjE8mSEWNp4FgnnjNwCtx2rx0V6C+fE5TRPRtlrjta0xqMqbLEu8UTOtHkxI48bs2FYcu2y90KrqPrYHt+/VU+tHzbp2tH80XJdswBpSxc9647Kl2305yjWf+g2sCWaXBrnfj+7TTn6nlFU9fdQ+1uac62y8OqaTz4QZutDcuXW0V9ff0KPnXeMc4N2yz5ClVOCnYDuyBj9657s5yvaA0k7s3y2Ypu4enW0sdZFivTHeMxUMKuC93SNF7NCjYjwJLZlTsPlUi6PeG643Htulu8RT5C8Wx5TwtarxbSksFQLnvX9bYs/D/TNOCroDHEH2/HvzniqqHYjP9ZE3Tn1CtCZX91TTfqCRPYjLhp6v8ENZmZ69aXMRhPHQdO3parj9QwUmt77KQY2I+SINovee/6YtERzqFQglQRX8gMJKWvqySWh1WANxCh72dzUItfcsqp49BwRe2yelrGHu/fh2whM29NCxKGF6UzouWY/Q0OqWWKNE8MouUIfjDkSIaOVchuRiP4KoA8lH3jPxZl7a+HGL8g4mZowr0+1R9phRvQHd02wM5m1MRSFutrv6DLFWyjPdsQ0KjxEpaCGg1SKmtoTgg/Cek2hgqatOcxkjQ09kKZPdg7UgzE11Ek5zVIpdZ832/jgLaBzacIrcs3iBrV3ftMLvyEEqKnGXuhfdUBjhWKS+DW1TR4czVnHoczD8rNeZGeKVNpK3GkFM5NYEyP05fyj1Br9ETxYhGXF6gjArIkzKmve/WwzX34ABXof5PhRuOdRb3iQPWES/OCloTlcHzr22ufQdpXiSEtg/RIaM2rjhW8rfRYRlyya9tnWJfrjhxTnvRzadqWxUdaleWgVT1w8cojRdX8c9+LKY/gAqi40sBcwiK/hERN0z57QHGORrAvIBuaT9eVSJrmX3xa8xC1etB6G+UTg6VNtfQCd/sj1a8fjzw7UiU5GqTt/6BWTwj151OVE9yadtq4RH3S0rMj1U/DdbMVtOw3kwF2B20zVI0QYEPbyfIP8RHQtFVPpud/HrKwlXUYqO3InC5TLB3NiteCx6IaatsvarGaPgUjZOjAocg/D0SWWk3/y2GydKP0dLOCwiNFzfSTMk6yOlem6zqQQ5s/WFnh7QMYL739Ab6DWUdj0vRZhRpbobD2P91Jy9kKt8feZ4sjugwnc4gjbvsx2hvUEuwio3HoRxjzThSnuVnWPYsQTXrYM8L7Mn5+JLSM4w8ln38keV4PVVSnj5mp62IyAQJoobOJO9mkfzib8Th+Hg7ngioueoIX9bolpqbi1phbP1Y8zkqw/cfFH5YP8JxkuYDfHd/v78sqhudsy1MIPJKWKgtXLAedzTa7/SHcZsDaopXYi/o0/lbGV2272IHO6nHHLXQVrSGzb2vcIceQCbDjQFUBJbYRwNZlgwSkbbLGftcchSzxNsSoxs38jeHTLtaM0wwophsoXLPfNJVDHiPXHXmC/SD1z9cw9z1YImu2VpkIYNCoi3LTrAnNFEeXLYFDFQE2hCfYW5MDaBYyofVtaSKjP0qWdyRt3TFTi3y7QFl/W6i5mpOFsT/V3Uigwj8t05VV55tlupItgSdPYDs+WouUKnmML86VDuKySBl7mx5eq+/+Omg5b8T/3eM0I6bvj+2kuOX6AJAb5Ix27B4cZxFdhYsQTIDHaOwqBQStso7JqeJdI5NwGmcVFaDGajNr4XZCE/bQSs5Cee4Enbt+0vLu0cZZXRtDSwRtZENcpeixC1JZEz0nl/c0wQmZnmY9kLfrcpWLkTB3WePnqwXMghsWygteqC94GbtZhp0LXucd43hrlkG2rrs2F9Q1sHptySawIdfAIs0SD2KzDcWw0/paSrz7jsZsexXr9JhhjPFTLW2YbnwpViriwPHvA8O0LbHmA7spFcbSfiEUloG8QSAajCVhMPkcuM2I0nnBVQmMuNmMZu2EvWHS9Ds0YDRooi2vSrChRF6C+QCQjRb/0BvUf4CXNbHRXkwV7qwqWFeq2HVHcKFzXS9mdxwUGKhw3ZGQYQLCID7pqEarfbLDy2LIlFiuaMlS3ZYdSGlqDgbhzlOHrlstjuV65a/xdnjLwAholLhuGOz8A/d2dEs5Zk8rFgWiabwk4H7ZNDEJlis/9W9Rx911IwC3kDFj8njDDtyraEEoYOEsV/LDht10J8IGLp85u1lusEerZbHcrOD+eaueckKORtYF9Ll8gAKWK7ph1WB+lczvRg7C7XIDGS0EUkNS6e6GZuT4keTeDRPB37yE5sTfQtDVZA42+ssbqGG6vJHVk6u4wgaDGnVQaZFfSXX+xK8IFYGqQUormhFfG52ktOooo993t1CKh2E7J1MWT7WAa4kyBdjhYeqmTWN9gvOSZiwFJQHoqTNXZ6Q81yDQoPmZSH/zQirvxCrili1tpkm7zkeACQXi+RFjN7AoQEJC2pm2UdH9XD20oGWSbSCOq0VylS0yhYrRbWum2kq2bFlxb8+9LaERWS20oVZkKBArtupmzB9D1VFIaLQ8rGRPCzYeZ4vkSiyELLlTrtDldrhjd9zLruauK6uBj3D6GV52NpkTDbqgTmPnQgq0sskzmWXgPHV8xzkS0mfxwLBfZa5732aZUUEITa6EDDXscROKhy85bjV9q89xrGE7wR66JjCG1WIBh1yBvLm/xdCNTANWh7e0YjO6Z87MoTVLXHe5ogdYWXfshj7AVoO6uFodGdR36YbQ9+x+zOSlBLRXOgBXTTOd09/Zg16TMC4biTEmlYzSptmQxX7E2O+uqyDBcvaw3IMB2HgstwbXzcljCdAjTZOfaCUVwCL1ciCBRjvN6bhlfFmCWBpY814OKQu6Bms52ZE5UZxjqM09e0+OgOYFqgS3UJrrVpMJTVy31tFxz6rGbE8j14X6VlglU1woi7v1anqAriWtPL+6mimO8X4yIfVyv2qaA/714Id9KZU8MkIWB9hyDuSo95GMHgjduC5s3wczjq5bGXQYmKIdhQkvaxUSZNvoDbsjtD62Wvdw1hA/0fHWLJH6Zlk+TLkrjQsEgHlh0e2jhDxKSWCKNpLtzFP9Ek0mJGH33AMMHEITYG0anQpf6KdFwl54nD7AWQgnZGLUBhi3VL2zVqGgU0154kJVLTQMNoQuBaZhJYPeTD3OClMMnEJSP0wg9x8ItlJv9JJWK4HaKi15L6H65Lt65rrO169gH/A2KDcg6rJuDCqkPY5tfLZzXWtDulnO9Yb0iGqLrQGGt9G7m33tUEzRkEB9yXK2oiOjYCcWhev2eX203Vxu9MVCEuq6nceM/TS1Teq1wWUw83uHfDaZYD2htdmKWg3J2aazsQLlvGWyMcscz+CEbT/YJmXoeaPPwWGDT2X6eqNP5ozOoY1Jq9zMweJ3xPt2tzBZqNCCIzW9vKJp1h6nJSFegtpUVNBR2DQftjolVByprWbEDtqW0SFayUgp76IZyoGeqiqx0SinW2DgdvQ5zxj8zD+gHjts0zmgiG6I/K59ATBK2Sd/ktYFDnX+JBlPLc+vx3GC+HD3bZobLvlPDQQ1a5Gl67q5z+J67dAzsvYokApqfl8TjTpGJNzlXwVz/5m04Wp12U40tgebhoy2SzRwsBrTVZ/HteAgBqPzkXbLqKbhKuW5djaNYvSNzjP62r7Q9naQ59mBk8dtnwHYKt2rmv2dDuOdnYwAsIKA8xv0etz34l4prU5gbOkExrZOIKEJP4KaY4GrntUIibmrWN1qg6mgpeM7EmJzVxnuUmGr/+kXVluhtJhK6FutZIeoWj+/+eZVGbFaPtKi1e+szSOqXh7OYF7gIdfCe2qhCUemBpEQCi1/W1/srRBFrRRoubP3Ii17VUceNydzfKR3ZzGPlqsB1nrflpmPkAEd6Zwtvbt73aG4xXZ2dPrALn99ruFEAH/kMrjyAv/59eX1/KoBfJH37PLX6fJX/0/Xy+spXT19ctlygn7X/ZUlXgfHLDRiqWIKOHIdfR+4S2jVDY0vSGOKGrHREc/d8ESZdyCf7g2nTXwKu4YVfG/Ujk2W8h6hUf1CZoeQ4x8ouZgqIEEcs6vJXNbiqDM6M7FAhmpJxjzOHL8oaw9VpcCsSTKI9Mk1Z7YhRSCXUV/7GjWSgmUM9pN+N4rHqWpEONSI7lRCzGYLY9h7hKwGde9QdI3Ic8AWVgSBNQCtShY5AyYNC0N1uSWAQHY1my2EYiPi7GrNRQA+DzKyuFaKs2onkl0AM2sZdthErFcJcTUP9K4CmlcRHrya3sTI+i3ovCE8Bfc54EnKRvwB/OzfFcA1kpWjOXb2sBD0gwlnBEF2/SF1KBXzZBlw1703JByk8E0ttMnvUeqiv6BfSOih6/1T7/ny+v76p9X4iix/vVo9bRQc0VNEH3rJDPD74FwXNJEgufYMWOA1PmqaFwOVlJsmc57LMzda8+pF7c1A9HXVCdL32MmctGB8V+zTYCnZAKizsPK/0GA0FC1FxHK+cl1NGgNFF04lXnwAbLOIqGlD/BOg7tB8gwYIDWoTMhCt7GteRNCAIoDV7YfUBnKnxRTBiYH8wJQ01MpkuKQHoHZiHGT6IEcMSztFpg4lHKZAZFHS2YKhWIBBCNSDF8KbbBVqMAqKITbMOfLhWNw30BaAjQO338Qmd6HtyTQDEChhgee8MJ1kQauzOa5YuDKZW6aNU89i2llajOP7saOwij3ldZLpTDk9LYz4fWjNIW3RaFoJHr8P1C+uCq8gfouX2uqsu67Xq6V5pJ1qcf1EYBZogFuPq23ruHhpCdRgAdEXrAD5ICyZV3LpyZ7eN6CX6AX+j0Wd5Q2aCV/S1+wRFekqUaBsUCq87OEZaAT4hWQg3lt0tnJQ0xnayQtdNxq3cCv2OJ3fpOPBTRonfQRkkrVJH2mUl3thI/V3SR1l/WWNJQXBHE2Z2bia5vR0CTCfFlofe96ftdICBETGuRKvFsDIBmJp0bPbQnglo6wwBz5wGqRScUbpyPYN0c+cxIgyTB4TI4PuSNx6+3rSClZsQhcZMqjDEIuHQWWOYADtuKVH1MqgkIT4bTjXux2wlX016r6K7brqweqXQI1/tQcGAUwrsNjVJwag2FAex8M41ye+I6z2dfxcpALEmjIxgtXyOP6i7x/DzpTHsaehpXsuFvzeu567wDa10V2/lFWNywtJ0Gu4Ntedd+n6VmqvYGofB5RWtK7IqU1sqCzV7O1eGUupNT7UygPYD7eZAbigio07wTmF9X4yUEeUe8JAGdADHbVhcpT7xrm4fR1plfeLPD/bhIHsPxT9TAkfb7NdDjYacvoDXdUrD5Pu5cvguNx5nj3I4AqDWIwB9KRi9ubB9DYfASLrzXvweqG5A1DXLKkQtSZQx0tRW+aEOkjCDBujSF8vs+WKchsdESxPOksWwZr5ig3gMTHL6UvYktIOdm9Hif9zgoLNiNDYdU/2pxglnOaSFaOsw9rsgXftvUZlYoxn7RYQ81XLevMEE9NK3IkK9ZgI7e00gmhS9q/s8vrt+DJt1/1X7XJ9NDxwtbKVtaz3V2Sj0q7aMOK2wzlGw2MxfcnzPOTRZt8x6ONsYFeGEv32ED5SZfJpxMPyjEPZ9ARctPSOWcH4tCwiAXb3bDRbaHnvAqKTx4ilmoGr9rDxeP1cnyskWa61fCyCEyECUhI1GdEGsi533xVf8nwvFWWSFtV6NCdHPt2KbVm9RwWU0RysgUdzkFh7CYtAOowY/jfssXMGtJq+SoYedvKezGmqz8ZWK/YCICwe1UCEPe9CHVIugiUgJwdIgNd8j+5ezHnro7apvj239ILG6QYjrxhkZ+RoYaRTVdHMU/PpSCVs3UC79HTRiYecc2l2UcTa0zeUPmOuJnOSaEZ1BIzq9RUovawnk6Muu0+qmWPf5jkkSJYklhlzT1OzMxKo5KSyV7zBgZiCpVKyg2apndgDQzxKjjQvo83pF5AVz2jUNDdaJdbThUOCwcxGAlUSxU9Z3XF00e7aAmdihMsTTQKUWnGgfj3iR6t2egFZaA0o5D1Q05upLrTvfspKN1jf+Gi2kBvb1c8rkYiqGlT7XS6dSuzL/A6Yy3FZCIdau4nnwEq/kKsOWM8qbuysKCREBE/qJDzLP5buBjV4MV1R1lny3oHzrkzBvLqXVidbQac6O1HEeBjG7HFf83qoy6Ij5fk9f78f+Cam0CxraU2hut5Jr9Zr+4zU4JgmWrs36960wAKHdwol9ktZ9+on2bXwsxDLZDlfrbyTYlOAxBt0QbZQLqHa/OD2ts32ggTmEWlj2bd4jYD+JrIfoqkaQ9UP0VSODfGjZbKcrcYOzDxnheUiLkfU5ip9/6XSj4mpEkAbUi5RNEkb+UjV44dc1ZlJCoxYPz6CeXp7EMbTXYZanjA49LSPedvHCdh1rVmy/HS1iGW3shQoc7rGvoxju5cjtj7ScDn/la+Wz1Z6S6Dh8hm+w5ZAqMAOWQ34uhCDXSWC2B9esd34LDUrHCHUdI8JQtGTAzKhBRUov7tfiyE9cFBzPHGsYd2XacLmEhD7A/Ml9sFhCprwB9y3ZjaoK/wBI9QQSDR1uearE19uV/PgpIp+jJYgWZCqaYn7HBTgTyZJ06R6eprw41HqPyIQx9Uc788Z6vXKcycGy7jO66bzangCwADo9QUEtd1hv9krCOoB+ltqBcHrBg5OuYBSs4AmE+1r5eKkJZCApm3umiL8WrJEkN3DBolu/GRVS+5mema1m7xxzTanmOQnntX+nK7LPJYf7Nxh4ZlY47Gv3rwRwJ1XJ9ERImc0CyYTKxlytDBrULwyL3DOgvxnNHPdTvyrWdN4X3d6JabLAh2uJOgdK02FAlcA7hZMsV6o52BuDtzOYzItk8SEkN6F+m8eeYxPMUBRzwroqB4O6Kvv3ihLrW9KHovYoX8nlA/HlRCgfycggJzGogMQirXBYypa8yIVKp9eLJNDqyP1d488eqcVbhpZHPKaBXzRlhwgZs9FLRWSZS+/hXLhQvI34F+oISXkqB71JGK20RTww78GdtPXrLMBnM1f1UgRsfYX0Cbt43NN4/JtVJV5ThA79l22FeWh9lSNiIEDP205OQ06O0ynEXUPYwGPcR/H9cww9WKZTKToU7nIi5gWcOMV2Jh8nbTdQvzBO4DuCtCY0sOeeHII2rX0iGWYyJ6Ti6R2NNS17bbN6s+Efj4jx86wH4+ekRp+bfaPkBx1FBMm2/cNCq2+AeZ9ASplUjjc+uQDzTvG2Dc0n2ZFnhXiCzglvwX57Tf8fXkAW3NaeINOQMUiYvEw2KYTlrB88S6IZnn1e8DD8UIWD+qLUHH2g0w7jfZ7hLZxdkrzxOfhvswPtViEZQUWW7MFKn/4s4XUBfFni7rc+bMFdLU/+ctf/vKX3QOAa9iIhoJ0XkNChxE6ZSV+L8sttKJfpzjbg2cfX3bhYsurNCv8WVuzHY9hbfnz3YOqJTxBdv7cOd/3nH2KUtoySfai/gkSAjHhRVZ92BxBi234c4H6Nyfk53APL/KphKh9LV2Hwu0fJqsMRGiffd3O05PY86MiEtWMe8OG4CeL8hWv+dKyh5MAET0EKnCPaxktGDDzufR9CtoQETi3QqGaOo4GwTcMBC/9VokZH6WM8en1sble6ucVCBi/Y5fe8sXkv8EndHvUfG8pHhg/lCcI78Z3ScxrPnHGLY7Zd9SZPJn3myjlkr0qx+RUSBmpLYM5dXXA7ToKRjPfSYCNol7nvgM9L9/gyR9HY0e+jiP/W403EyjR4N/efvct8i6sPayYQs1VW6USeqQUUhb/cTy1e/jHoF9TULnDLkCEcYR97nhFlfcjtC0soRYYbcBYwxTzQ+upJEu8N+h0UhlY0LVUNoHpRzNrLMDXeFBIfwY+B2fjARhZ+PDHdVFtYAPIChs0gwAbqM0KW0+axoji4gHpT2iMXJrG09kyZZre+rfz14RCnmhDs1mxLHg8+o+ywT4sgXJ3bP0ZjywfbK3eaBsKDMoA82ndAS83K8AVNBXvfsIgBABK0RaCCrQngVDzgPY8KZMvhLbNRsfCxdS4JPVCsmJDEzMM0M4/XFF5YEquz0laAo6OU5pYxj5vrRXVjmis7BLMKKYssUZxDbeYpRnwlW8e0WgKjGggv1AyhQMI8OGPbKDk6inGGAlAP1Wp0kt+c0itahPi43SOgxCQH3307Ww3iko5/EUcwOfQcgJIbAsiy4eEwv5eghhcussMRv8ANNKTBWJsl45e1DQaNdy0hP5DthWtBbF/csEL2FM9gKoczYjf25mbJh2xVPk+Dqz8sHvUEgf/Iu1lQ/b5I6iLQM7+owPMClFfOCDudcQ2FLF61i4OfSfK91nsv3r2/7x89cWfX09evP7zq8l8HiWTv/z5i/+afPbZZ59//unnn81ms5mDHEfM+Qz8gqV/hHVZ2iO/8u03iu4SR/9Am+24m2fnavuD3tsUq/VVP66J+VbhK/72B7KjeKf67Q9liXGPpKvxFJ+m0b49YT1o2WrKUCeiVT5d2MeR1GSxxBtK+oFbeiJ1vBJLmDwqpr/JbyjB24sYDqE93LOQxX+iSJ8uI2lHBg/Tgm8FneHNwcCVyXNPymTs1RIrXunnhNDvpXkbmBASsjhTB+wl469VPpx6cJaSWOQYWfSNarFk0wDayADT4uMJYfCJnwRQXU51L4KgvYVaHxrvrtj4tIg2UVtDOR/0ZLg9iIPwP6g4y8GrPFrdJQ+AroVJgH2quxOqD8Q24iLbPumDTpyOtgj4lo9bMQk69WobG4vTepHHkKlKqPsToswfFM6NxaoSLNIiI5pADTDaV2W52RtMnM5AiDaf4wLUlzUfmSnfH1aG8WSCRo0eVIQptVSNiWunJVTtfAmKoahmXdEULSBHseviAkOZBjLwgMVp1XXYZfTYaWM4LRNZ93KEVIF5o4+YvX+el97nZBZTa2uBkvWAE9r/FJGjmU/Jh6aUrvyznuvgkaW850mfwdCrFNY/7S+k51GgB1xvUcYTAUK7Qag/uBC608UsuUV3ashwQtXAghcdaziB/kclkna+tEtqYMJ+bGkOZAIHa/WPD+Yj03C5DMDidYgpbpsyzano8mHkBk/Tjh7S2l4Qk0kMAHk2Xy2h4NP6uPjAwKlTnXKmamd7MjLbAABMUd6Zw0Te03Gewl4+HlP1hlNz3ZpYrj24lFuch4Gr5iKf7tdVVmx+qvgOb7V7u21Z4ilJgbGVX3BgxBhL1VaT9t9hMAT//8pfmEjXA5OwfJjss9+Bk6B0MSBoMdmWv5/7diZYcyxCGJx/m2ERdho13LNENQJTM+dz6BvOPh31+BmnvIt2QzlqnsI7cEA6WQVwj4+fXk8bch2PvcBfitcr/HAdjxtyqbyodb3iOr9qB7CENcRzxu/A3/CST37/z9VT49b1n2zpvCt3DnV+gLF1qPNFWdfl1qHON8CpW9GfznmnDpuGU6cAKStiRkX7vXTUgD0MqFojS2uxh9INm07Lc/hZG/S3XsrmNGXPwD19fIq9ehFPo0Pl2QgTpBUxyHqEFNUnMgbr9gYYGBHi5noYQTpBX4arwAF8SnAisWG9T00DH8AU23XHGQEcW1R3NiVI1I+N626Wn64gHtjV3zQNvFItSN+wcQYMnbi8SMCu15l+7tDNJUtoIScK1nUzvtEKJoCg6SVQ7UsoFDhACcgeUkMlArGzYeNN00DWoNUJeifBZuzB73hOnkbLZyt/DH9BWciLp4ciq9kNgMnUvKrZhsZTUcRMwAUOfZ//wk5xRgFDRg7IIK4DmO1Yri+ZpQNCHkFBARkla7g4RuQXzHZNo+Uabm2YtUGS7NzGIWXXc3+M+BDoBf0GNUe91rRFOzwkPmB80PCcoO9GpoAjDSkHMOdE4ZuBpggRvA8gO4LYj1UBELKm+pMFRyEC7t8Euh7Ez4IQYYpB9Jcc0fOwF/gaWahBrCF0T/yEXaJRkA9WQehi+NcnzfWlF/g3/I43Itpyso+qbFdfZuh3+Nfr/fiShpw5PAyrhld1FuWi4fssFg0/xFnZhHHWRLy44/sGmR7wJ8/2dROLmmfgcD3jeZk2SZZGHPHN4PFQiSYpy1pUjcT+atZpVR52zZZnRbPl1abZCvha8LumPNTgd3mXRTWk04RJsxfY283+sN3y6n1Ti+0u57Vo6mwrmrssFqXTrvPIcooZdlxRAm9Q7qZ6h/iy4in8Kt5hd6/VTki0IlbvsxdKHpVlqjX6g2xh2h6lpzWh0Wkq7VGoYwV5cfE8z4rN5dVzNFW9en6pfrXh5yX/5IqjYylMf4Hq6p/o+fIJ2E/m01ygZOqndVaL/Y5HAtniHWNJwz3KpzWQBuysk7xaUg56DefTdb3N34oq4zmgSI3OJoSG9NN9/jIvC7Ak8Qt+d/X8En+kBK3XPQW/c8g0guhozziakWl5qGVH0Uh6uHR0w4GMwGcRwzbQPW8jQnMTIOOY2LRrhKodg189PL80z9ClRYk11+mh1d26GfTejnVoryb8QzMBTZwGfEvhHjDwWbXAoebpNI7yN1WfeKbCPsF0ckT6zTnXON1tpkdQTIj90Rc/Upv9xOYwBq2jyL4LSBOTaBom5uxRgir6yzl1tCO17SGvs10u2Cf66RMwg24dpK1oLlJRxDJVkok83otaxmnfVhSGVsbZ8p38jA8rUOrmW/lJnlHyq34GSzfBVf5yeeJ39biideUvn5lvz3EBqSj4aEWNynwgrokYlTlurTK1ebPLiv3lpyfp60qVV10NFPqbmqF+by0Hyxlg+TrOCtv28/M4u5P54MPquIj5tNzVWAkmn7OyoDFXewg8wMkAD7qy+CwPD/wOXYcp1vgat5u84D22Hnrf+OM+YYPhYIW8RPzhnPqOz4LTIJODEvNIVA0gAhKJF2xrcTcNXyiQlFg6vUVsGxDAWarjMXhoSjQGodEIT6jg+I10HcQwFBKFrmtlAX0V6ITA00a4kJ7v+p4hj65ZxAA/maAljr7kRtRJ8zLk+es7njtUVlh+QlQv+yshSPylnF0+b9w/Bdf348UlXcMrzoTLbNFzaP3fGt5ToUV4xopeb6kGTJX0fVAPgIZpiEK6pWVLX+5YxOHKiL4dKzZblFfVopKGUCnjy2oFIDPQmykZpEBTQnSP3tLUgupKV37aqmmkUsgL0R8zljXNrntpHb7f0RvmKTf8Keiyy6VGTr1/bFnMlzcA382neq3SzDqntkCuF7h4v6+EUt1PyXgLhHvCtmDKrC4FAPnGsnYDx8k7QCG4LjeNMk4HdUPgUo1nAee6/gCgQuhIUQ/kMWUO7i9472matcksMHvkiGHFOx9nfuZntq1GIpU+2/XU5bFDe6w1sGF21GWyoppccd3R5jQXyNq+PG/IsR3vzIpPaGbjVgOAoqyAXVmSdTLrfFpkbNf2uryxnOvVFC6Errvr5nZCtDSNshgX3LulhmrIOMG5rhhX7HZZKVCl2HVbxfSUxqjjLsA/BdbDmtBrZt24096NOyU0Y4J73UmegqgT7xsOAeXShEOVASbQqksm/TUpgJZUK205xKAitLfTTEKR7U4obmRwnVPGGIBRlbpM8IRg1VmBkKoRc8rCGYfUywFJI5JyeBAad0mmSFJLGElYFAvIcxSJIh0MLERHjWPD5dUNh6WRxEijSUi8weUQYyPeX9KtirstD3vR7MqsgOuSspLciuLQxBVPm7gqd6SJ8izaXNJCpVFtwp8G/5aHOswPFWhmlBhp+et09ZQA72fqTcekIZc99NhT9YFbK3jeAQOVahWGf9IB+FZqEZw89iAqbZRFPCjounvlb1EgTpizEYrGAM2OasUK0nIEQoL5rzF/gMuniWUMqBilyBCQDyIAOQjIWXRevvoAwpATJRKIHdPYxFWvVlWANYrjL9gtNwto1KKY2i5SQNafMkHFoFKrJ3U3OajEDmq5H6lAVQmW4g8qJeC71qBA30WnXHqpIQl8aGUfJmgs5R7qG3uUhznIrvuWoh1fMdZJC4hdJd0hBJ2R1eAduwIfrGujsZphd2U6hAqWGZts9F8umxL1mkK9lFWyenvJuNFvElMVYCNllqRprLeBrrUoPTO0hdIBl32jFGoRTF3SJBpnR8cAdiTMbjUym6nIxbYzNjKIoTtGJBEdYpuqOQ5o4oenbh8SVqpjFOavtGor2S0DbX26Yx7o8MvcFJ9jqnGqCC0RmE/XEcF9eL4sV2DzSEsGGjCo1ZDC+QIIxDfTMCtieASnJ/Rs2rxVjXmE7vBLWlZZinncSn2BmKoR9SMKY+bLEaR6bH1BbRwbH42xz4DcyANBEAoXVCRA/J3C4Joi+5VQb8vSZQn9o56AqNua1r0sD0XNZvQGtu/DznXVQwtes6MbgK8ZzZvGO72dBqdBXkk3gLzhd1RgXbfz6uExUtINmDjeQB4wJPCrS87h5FZdpSd7N4DJnoO9JNhqm7Ne08ZjOkMIVI1uSPXIyaULHTKakYVSJjw1jPvDS1npmKARZ29hI8PYLEryeHai3/Qn+o3EGl5bc/3GmutrNdfXH5rr5DH/wFSPg7w71fPuVN+yDcZGE841eurr+UK7vp4SZ6zn3fX11Av86dNrEJeAxoAHT+AdDaw/2faUEE3ZFkjOEUCAgvuqqV4xTYMyNhhjDJeTYA3OqDQlZOY9aZrYdWU8C9TCefrUkdp1ozYc14KeLwnYKNppehNoMqG5oihdVz8Zab4kOUfbVg8mn9aCV3F5X7hu+6wT7KjZfdWaKmyzAI/Tso2hdQhgABS3Hg7wEg7wDdFDKpNDyjFMDpysMKP7ml4bVJaROeoiTqT6jpyjDvrvU7t7F0fcJkZwHahVwJaiaeIVvWUb2VgNbkcAaQ0mVkgr65sZOYxg3qwJ7C8lqDfbMgSrjClI8YSlV/RfvfdRoa5Et+OTEwpaf9vqD00dBYhcsVurTHrLKqPtUaklBAZMVlLfIc9nrosb2C3o5ln8OcA/EPcXxVQO5y09pdUAooeG02z/TlaNJcEz/1Nq9QGr2j2chtOq/WBFCgZXYfXRVehLB8og4T/ktSLHaDiVLnAA4VQ9MgFcViVpQs3DjgoPBc1aerqz3MqdJWkauNXKJjaNeTTIlJFcABLaEmBKR8AL/eIQogdb1FOUBv+eIJJnccO6O1XT3FI94DfjW1jVcEe3cE8W2aIXspPHQAbcgWwBXsM80Qcogqmi4m0NFzoT902zVVnJigGS6rEFxvUytpPIuGBsDWhGVbnjKToMeluXu52IPULkWmDl1Ty4sbZaaEvKPH1uZO1ChN4kS5ls1Z4sGXXkEnYItew7M7QMA39+GRD17RfXfeNl6PRTj3wniQmVxvMhgowADINsvkcUdh1W/paOEtVG9f17GRtaCJpgueG3NE37rMrbSdkQjaSlPoGaAStr7bpiebs6HfstE9AW0FCABylvpKck6C3aD0AenjZw2aOmeT+imvMmw625POtuOB6pJly7lm5muifZg9fBOpAa4ksALT81pbxph1YCmHVHV5LOKyWw7q8o8/XxKJ0cICoUb9eCXK6Y8WgDI/dK1b1pOq+yWkrnSK6+x7UpTlFWezsWvQGq3MzwhK2XoZrh/NwMf+SgHQDLRFUskTR/1OYDbul0ccvIyvDr7VbEGfhKHsqZW1shJmhfh+gBj6tCvgtvWEq5VESXKug0Zp7X7+iW8sDOJio5WF+q2hI1h1WTsp4mO9erKNYoFGgg211JVCJTWC30CDEzcDPdlftaj5jrdt87I0h5O1t1b55X7dUoHGGXvEG9Arnhw+SCjcM6UMGqJYs2EghFcp2y/bf8W49Ppe8n0jT68flcS/RHTELhdbZeiWGlkUQ7hWTGsRia1jRNv1R1AMRSTDBbrNEX0pgkDIBLgC3QQS6khtsPbsuB6lmKFUs6N6eg8IREiFIwWBniTShoRflJOdPPjDN9QmWGBtd7EVscUQx7hInhZ+2AxEcztuvn4XB0LMykCJUK8xqsqVJAaOiiZGWJZ4sEW45J1+FCLY0awJRB4Vw9oM7dMlkt1uDCsxfI1myrTtNEQutNkbGG3/2892kj3ssPwFQQbA2aebu9Bqwqd3tt8KC+EL/9RDmziaQU9hjRvReEqDgowNyDAzYN6IEvWq/vmlbRj0D0V5Fip8EJTqWkXn6155pJYT52gMOnW1Hzv4v3KIlVz3StYH0C/YCUv89R/XK39x2e138X7y9CJF72FxEvIpHDhL6I6iqHT50d8ULqhVzgvvD9mu/FhSrqAsHFRaziISEKwbKqF6DU8bbm293FXSbuwfdOtHYscxCqhxMYUu0IqVpGa9gN1rx6WcbiYiPew3947mXRg/u0lM60qSoWjJ2JT9rNzFRnHrSPfjhVpYC78yO1ppSul9w+LuTP/iLKM1HUP6vfXy6Sqtyqkb2QCnw/q99fLnY8FT/j318u9lElRPGz+v3loi5Vqo83z3LaxUK1neFWaZW96PUBFq0dOYRTVWtUoDJTq09Y0oSJvl0vQi+AsIWqPNvMxh7qiO/RdBfUAdWF2A6YkYmMJdNYseyAGVG5/2Jy/6WT+7ty18kc33t5t3Gsd+Dkwklsz1uk5bxeIFtbKxLBt1UHgPmamkjaHg4ki7i6MZTNXXAD88xNg0/9z9w0eObP5GRSB7f/mJc89h/19QEEFcrD5uPJHdaYroBjCw70qtwIITqxePRtqEfoaG7z6GmHX+IoUYJzpCA/GCzSypN1ioUUgWcesSjbEuKknPJQO6AsnkWbD5VkCfckwanEXK7b6vowJg8G6b8BHzHfwGufexUyKg8fQXRTw0wd7gAjIRRJWYlDIYfJJmg6GVm4pJKwAZ4hkGRZwXPFRPR6IVNZOmoJmXRgXkb32faQ87rPytOWs4ZPax1FYDKBrApOs/1blT5Gudeid43wYkkdgPHJmXtQdEL6HaW5vGH3sEH8iKBf4UHkCNcdDJYoJ2faLEV3iw6CBBB5A3x+UGyA3gaPavLC1UnlgSAErf9lQ85iZHbwg2XkwENgmrrFvO2MqEa8he+ajMGA015mRvvBBFlbyOlH2WftfAEaPdhx/1ZjIssyaei6ZnpI0Y+C4TMHMJNN0K9SKeXek/cBTGMrbQHccYcvJA3+1JsFmPtogTL76jM9bbd/CxP09I4kwz9wiYII3Ul5ClJ1OiKLc92/45QP3HGC00uP3+14wN+jvVvQv1OT05apuoxUBLN4sXq9klz3JAivZZJuk2cIQ0NLiDXUnf9OXT8wHKrSsjZD8T70TUHOnbYEdxmJ5orUFoxV5Tv4XN6JypFEWC74ndDBh9qhSoCuoqs3mUC9qCT6Ex5FfajNHu9ixR47p1hINcvLD9WV5wSQKmqx93tEBF5szKXeKIMj1YVcfhtMn0rvonILYYm53NOoZT8MC4ypSgOWjUd0pT6VehFov9BpofrAHlFq9kdO4qSstg4BFAXcqU7EzPLyO/1NZgw0+g5UvXWAQ4ewedV5GzGruNCc+h2duZBqv80I0FCVYI6uaqVV8yLLZjeijioZeHt2dc2X4bpxHWxWFBoL9nOViP9gy3aOLuhl1Ioxeh8UVKrlCHzEWz57K7XWlIFnqtBLSAFQmWrZzf9iUJVsRsYxvUTklJIKNgNTSn44P6U26i6OtdYVIYHXknajDmmnVJ/twKbpjKSsIMwFUdXvZfnT3+Rvd1CNkjQesF1STKeH6mhA+d9uDqC8BRnFuKcSelqwmvFD5Z1k0h/UoWLmhJ6Otc7d2FxapO1plSTJimo6vD7TF93Ft9DDErZjYi2jkOoa9JeR+TJcyqg3OYHjZh1wTWN1R9PojIfb3Z3j1nJsa2eW45nN2WqwReZJ+JUPVaydhKGelvZ0lYGBtbt/eIs2V5IPrtLBVaiHgY6GF5JcnepW1zT6LMWA9rYnL3v2pWzI6NnuvZOhCanZuLtiBXJc9A/ScDWwH0iqXpIeXVaDOj718IIu9AJEqKfKGlyCSlArKgWUmhkZz89sgv9WsZP5QgR25gKR7oauLlZVLKE0ZEKOJ4bmZdG75LS+l0B3Ttt5Y/iRloX4w9EptLtMkuFLFBXAJ0eqrUvkQoiZvJopG9v0Ci28vtRGqq7Fllg3NnTK2Jk6Y+uT336isWF409gIJ6j2QHOKX6HQtqW2phy5JPEEDSk/8ZSinhHnan4WJChCcz9t+B1peUfEbhWc5FmFOns5wpCHuN2c8DJOvC2cy0+lxFGCiHZ2CupxEJBAAQm0LuKGMsQZaeyCpcCv5uzy4uYfYN5wHY+Z4wUoVG+u4zFxLlN64B1D4Ode4DvjEPxfLa+v95dXK2X8eweK/l4w4pXgTQiaq3mDYDfNumqybdpIJVgwAANTQN6gRQ3xjP0iei0i15dXl2lG7yE3qUrcPEfT1kYawWX0gbNLdW6D26PAX/7KVg273j/VxgJTcpnR96D2WlcHcX3pTZ+SS/q7tH98+nwEWrDLl69evHtxvWwmE9JAwOp6Bc9X1/unTy5T+oKziHsxoV9w9oL/EVPtVp/2Je+Md9c7gNSIJx2zjVO/E6Gt/+7UlUPOWbJo5fblbNU03Yr2zKT71VYJgfdvqv6q60seLw4KZ4ApLyrolIhrfRgydi6dsYxJrYxeW9aZ75W6l4zV+pkO9M1kOV/5mgHUt3Ujdq5fqp498c3vurbOWhcGqNVfoylraYQEoXyVHhuKC4nG69MiUpq22qaobowa4msi5XdgLL0GFfCe0/suORRRiAOWNUcprQX13x7w2OORyiA4G0xj/9q3fVqcNJtIT9vhGe+UdNQ1yXNdW70HDKtNd0h16hjaJ1SjSVebC5HkVMeQRXgyXJa1njYCkGB/IVpNSPknCCu9V1DeVHlggh/6GkKIbxnfREHHaSvqehhTT8bbZ9KxIoVzy9jEIMFaV9nWC9tAqTZiXpkVH2ogL5my4l37JKhQzzwpnEZ9QyUaSpea2Fx8wvLuFEsXf7GlO+XimEWByVfal2HGe/3I+x/91itpBFiAypwA31HjybY1ZV3HpPb0+oq3tEPIEkWZLgEcbyGBEyyLKjbr2lSVkzm9Rf+IqC5qGdjfSi3RpinBTciJNv0t6ka1Rqau+6DV3IzDx776utZWZXwqbj3YQKBPQXPk1tIhEDTBiYB+Rr7iXqIaJ51Nlqi2egMLHx31d81ZRnOK9v+CbeyNFxbboMWQt2GCADRgrKT5mfK9Iri3sWxgXnHYZDK9Q5RX28V2PCYp29DtiLEdmgIU0rDWSwHLAEjGtetqC6SMCu6lbY4IU6rgBLYrmtItkVuX1CnLlpnx3dFvo6xgRl9z0BcDvQNdmQyyGrLHMfe9tGvp57oWa+qGgnWYh0JroI3FHc9/rHLXbZ/lRzBpbHPxoCjxUDeN/FVWVfBqFiRWw4CB/s4BhoOQxYYpMxtj42Em9ddc07faMDCmgoVBx2Ooz2nS2j3GTAAk0CIBIPSmQWx4Cz7VRgkUQBEgLr29MUWd7oh73Q4KeIlMag1jJ4+OjVdMuo6stEc4265vEPrP9NMdp87zJ/Or55dPnl050ptbIc5dAKhSAmcfxFeBSWbvsxIdTXruRW9vo4Naxs5zZ2wD1F45JEj6puS+9wXv7MGtFf8XvNMdCfui44iJ0JHXP9T6lvhyEG2vu/NuQK/2RB3oAvH+1kwoWsFMETjn0xVZjMcpiZfpynX/ii7vMRBUCdEpHeayZuumkRmgwZLMcyCvdDwmX7a5oEI0kjet7ZKskbWdxMaBDM6pmI4y14XCOjOLrdX6oMmRmsl7xpefmQGIytJiwt4wBR9JN8w20qfbvkpfu44A2IQg/kmWeGAU8MaLJdYmi8HdPOIw3sBiQ7pFE1eta0wTsl2KVdC7YgEL3O+SJFD51JAkkHPLToU3ummaIXlg3CdeAqifuv/5J1/RiFFrSQ3c3uNy+4YX2c7/ilMpVhxaoF+bi7lyJdAzzTiNiByLYZdwv8goA19aiWEgvW17retACUbmaTgtzzvxJ3iiPXxi7YuCUum4tY9rB/4AMeMB9tVXvMf0oj0tsLmW4FvI1QNhf+kH6UvGS9Nriz6+xvEo/cz9X1G1rNiLqv4CObKwFDte5qCikln7b9fzRFzQC+gXbJgLPKlF9f+T4jqO0aHoEydYelfiqKirNhYpjwQNfXRW8th3qd47oTm6a9ZeIu3u7Z4snU8LDVyx7+EpOJIQdyQ8gYqj5jtrwValy5HTg7Y9nrVrBBCrcAU0BViP3JeMHqTOBiHIIFMbXfVI4Rz+g7uCnIFqeaMxQ8RmSiRpuQJvdw1imap2PB+b49oQGjUSZBYCxpAr69E9t/wB9tBFLLqBE/n9BIWgaUbcjjOK+VJjJvAPYSaspIp7FxGBE1SsR93eWGneqv6JZP/0GQteb36FOL9o5w4JTulnFiD8MVQLorfh8g9unqpfu07VtEx+uVp8fGF22IG2j9Ouw1nK0ein37IavR5LLEhVFwVeoR0iU65Bd1GKIFv1rvQd+eToXRaC1KND7Z3AV7IoHfoCNx8H9yBHdwA4HnWsznA+6gOTnzonRtd48IXaDg0X6ysmuTQRAzvftYW1Khca8uy9BBg2y3DlRa0htqCRdIDb4TAPerP8G6d/5+wRJofvIDykQ7/47tUv+sWCK/zG5vKw4gQohBM9e96VXojKlQQRiwE4EB1FtiiJul6R0m7yCI3bu9EbiyUX04j9nS95yzBGLriqjEJilBwG+PI3zry/8aYpPOe5dG96gX8l1CX7ZPbJhYSohCeJ7AmPl1dOt/Y9dVG0kv4b3sc7blGbphOo6RFi0tNwel9lNbC4QAW0RGZXW/2/cdMBhGIzWQTaD9D2b4ENLLE6L+l3vIdyqZAtg9HugUh4y7FGt/yes1Ohi0KYpKnmECYSkCAFHXQuQRmBEG0fWQg664JFalZxhRhtp7ZiQ0YLg+hN/8HZiTOdxSA4hrnXnYGmuznzAfbxG1k+ebzpg6gmeclrPxdJvSh3PMrq9z5gUOZT9cac2fRz4GrplCocNAT2+y8hNRuNrGwxiOoAgHUGhKgifplnO+ZYaKsOvekBkA0ngcog/DDeCN9CnG4+VuW6ac/3Vdbvhh6a7X/tHj6EZ2uAYGcKJHYCcQAL9gQnF1ppYc85NOvQsjfA7QzLh7cIRsucTmN0cNN0wt+Uv39x5tNPiIhrvrZg6jl9rESegajiqyyORfEd6qgPOZFUdIzrgnptcqSmGj+oDD6WRhzpLnsQ+RvsGkSO/ViSSCX5XnffR+KHcLbK2vwbpaT9VKB+/rFE646rtY1akWheP7Byt53RzQi9+QOoxXLy9YGJrVAzq1DdLLsTw0jF/FCXGqx4bs1SeMYJ+p9qen8++08HrFrYGhxZRSxFR3ZwFr4st7tDLeK3yjVVzk6DPTS7c+b/CeeJl0tjtLrc0TVznu1wPerQrelmKpjzmfVRVgSDjkTCIZu+2rZDyhysa9RNbEUwWVhhdMNu/oiAj256g3Pzfw/E9MwxtbO7Y9PFj5459KYbMgdE6ZSN0IkE7sWeNzCEG2IPEOYNc7ULm9XOXlVrJskIYLbipgPZorHFDyKq956BAU0QLaOXtLcTGjDD6up5HQNyYYwPtXxSqIYKePHGBgCbrcwGCZ32ssxzvtsL5uwFiKBhz93Iyg0JVwFAc9PmYYb6A0NhBs1uPeYhLX2+wpMCW23lbDV8s5yfhH4wL0LotgcUdjS43z9w+pbTd4gCVZe7poIUTYjI3A0cU+TJ5eJ0zAPvB85OLxZhT6ZsmZQvWkjpaFrupI9GL2Kc0Ogke3S395azD3KlNQVlcgYJ1w+KOxsFmOv3Sk8P5VteSJoGnJWqSyl1YNNJXddYY6RN80FGN4IMWWja8j40yqf9MwqMIr6z4PG+Vc+hdOOyVpsUWBNus0K6nUvghT/IlzbcCtXpWAotVHnosNhOI6iVKiGtmWgapH46dpwj8f9hrJf1Bm2PqS07sKP978fFGgB5OKZoRbVGN7NeCobXK0Lt3hu963dfLk8BPq0OhTRNeJ+DNB+x6vAjLCD5yLoNwDBCZR7MScqifpv9jsjyYeDMxdbxoXvXckzhuBkDOrtOENs5J2Sga5vGgcPTsY/6HztKH4+pGCIVuEckppRiUuMFEqJ6+omF5Azu1xGvL//k7JLnuzW/9pa/ktXTa9B1+YmzS0VoX++fgiqM/AjffsaFD9tHg9ujF4wm0VLwFZkCZvgvZ25A0xbW/785ezQkhdNSqnfZPguzHO4AzhqpRIfqrU/fc+kTzh5zUdeiegsVLFIfTiEYkp+EOpDRqdVvnC0dSY861PnOoc6b8neHOts9wPKec0mhpqEZhDDUNsWoHBb2zIlRTM+rF7U3AxLkx91O84rGXNkpz+Ee+Rs/9eIEmbLfwH53HNJ+9u0lOwpPIFZRCWUJ4o8ZLh2V9foqRSEQyE3SFcLpyzUKnkUsrUOnzGNzw0eo6M7RQMGX3DIFxCRzaQffZyfHCqGOdFjb+eC6P3kIJ/OBUukb7sWtSikhiIEH6SgIP+1yR8ICjOrlIoLIl2yL2OJZgEAXOitls/NdElqlnDTAGQgd6IEwUN3k+DIvW9Bqxi8Ou1Zmv2ioKhM5Dt7wGvdeb0bj5Xw1AWdzM0LGXiyRq8AFhB+2eYpwELGWgZ6hFwfqNglu4CTl55DgM9/BPV/uWXP0Rj5bfHaVLJIxe0YU+aH0VLx0bFxmRON/Iv7UjAqQBQaeyVTHnZi4jiJZnE4anffoNL6qKEYHl+1QQZWM+HYthnJuA0cntf5gzrrj07ZHk94oQUxm9xi33aP4vEd5MX1WWZdp13XaK1THCYmJ4oCeSIIcktkVgDjJw01qYQn2VuoeJIR6syvrqzKjliwd++ATRuNFLGI4I718enJ9BueYgrFOBoJZ9Dqgg8yM07exnmxAyAxNLRrThOB5Zzst3O+VGbvm6nQPMK10Z8hAbKujIhvGo3Kb6swdH0yNjtS4QPEfeZFt0abr61pU+ICQHQjOWuaHbfuaZHn+naoGvObi4a9Vea+f36JTJHxrT5HRjOZZIb4yb2WbgSTQ8WG35sUeHu+zuLzHp9+/BsQMfALHPGBtHe3RJm/vP0p+l+O33KvA0U+O7+CIyBcwr3ufD+llSwXrT3saCf/Ve+ea5WY4inTd8QUYEkQ3kQceDIWcoFjR5do4o9HvLAy9NWmatVR61AOMrmis1/WqpW4iPRthLaSidjJ8NKSzJwC2TtQoYaMxIYHws2WIuGaJQUu1nJ0i1KlgytVNBMIbsZyvULFaeukRBNDcC5wiDqFS4Af3B7VZ6U8qr2iM5N+p751163uHnPL/YM+Xx9MM9WwN+FnL/3MQcAsME5ysWIsKDJvoyIOe2Pd6AjbtCIH5alTyj+EIQ2N7TK/9At+AGAjnkv8BtvHJIBvl2P+3hrc7mF7SjuEMrJ7b4Zc+aNUmBoo2TlFWW56rzkenrU84RnqCnoglPRE1DSLkWjtSolToZwCw82VWZLXwBAnADsNHQPpWhLR0JN/UoWr3Xp2Ieqy2sf6uZBs+RMHPal89dR9FXFcaUVunQvA9NPW/OT0l09XxEqMrS/1sjHT2A3XAAQXYJDxZDKkQyu+B2pRj+j84cAQBxZcZUbabamPTEwK7RgeygV1bVeUn1TdASnVvS0H3VSnK+WqrUa8EVfGC6Wz+1BpoeWuYPpmTseP4IW78jnPSQ+bUUHnSuHdhQyubDBazqDKgtQIH7ziekSqM57PZ03DsECiAJtDTsapa00TmyXEWkXLoTr3wis0VwxtulY7sY9QHTowM+59SqVCKPDtaP+gi/kQnXZakiekQgTpHcdtLnq4M+2cLMtQpThA/AWCnsVCyVDOKNufuR+7l0wGmNR0c3VBO5kdz/ZK+8Cf6FvaW0yXXlB3m47Q+SgfqAHfioSp8I5KzNfCsiSHJgzYvB42XP8h4kfYNX8BmnBVpyydENmFST2QD/6PN058dB5buh3M5wkKS1I/demVfjlnD/NKsPMehir2nSNLj+d2Jj2F/kupz7eS3FVFRLA1okmwAYzyycH18wIf6TFkTiCUf/3MZryB7kEo3TbKMJ8/wd2aJBo/0W6Ml0dkcsGZwWrE4JH3Vtf7xdF6VpMcGgobAlSRLPNs7uMY2kwS25c9bqKtdsgyX6WplNjt4k+SEbkpy7GiyjdAvv+XeDvWIVWLQSKBhyyUxnooB7mBd3g+wYKJQtgyV8NZZLM7HAf27Mk3zIS09JyzLXPDCdqusHCtDwZ5SuIMC9HPf5OsnWUhQyF+dTr/KpOiVtmVwpJ2rpKouMHDSsEXemMKRa8U7FtN390IULA1pGp5D6EhDCun8Id99St9LYptTA37GIqpatgdxpJD2pfDc+ijBCEpviilVJ+k4EB+L8l4+oBNGlV0Rs1g+oq/BpEftRS21d6TRoTpVlpGt3Mn5b6prVgsaPAJyE/5VCpJ2GlP99vuRVofi1IAYxGEfLszugGl8kHcfhS1X7lnIdJ8trb5caZW5fsKnnM7ofPgb8a1cOTX96+lenbS9T56G4/atm9++FjvXPQlqbS1wIpj88UGpDe1FHeDfD3aq+Y5Jj0d6MnvbV2Z/o3Z+7FFn2L+tqrExXhfwrgV1tjXApfNcDF5yOWTaQYSKLC/c6lvQjerrC5gnw6kMB2ICEHckfxhuG0HoI/hKr4LFNHnAXjX5n4R4nPhnq96rpHU5UKkl9mx7CAy2Aq+g977ZXjtNwW9jjmsQQag6g2mg1dhAMNIPj/029xriuiqgY00xVEGNAoO7zCNQNnzYBuJI9/dwcJ9+m34+QbZdVO49/hQfv/+aXD6zYMAcTOtAUckDO5mS1AwQ6PqgJ9OQZiG9CaUTFnlUNLCLN7B3g++VTcguWwfRTyyfK3lo0yx7Ub/LtqI81PYBsdYmyUdC1yFTqEwtF2wbdj1kPyodFH4EP2nSDY7kHi4+uxILMWbPJiGJ2D+XYkXjpWb0jaMVi5ctyy5aMeOwJESPr5oMj5Uginf0yopwyObGuw2nNZw6opLX0uWKaBhN+5Pz1FkBFwA4nAY3M0VGJ9oRSPMcufdEcNYbpplVhTI8Y9WirNfwxC+BZtmZS8hth//yk4cuk1qH807yAEPpkEUkfZU3DbpE6rrgB/fgRAm51tNDgR8Bp6l9QcOKteUcn9ovtkvvNk3TZHD00zZkPKbbKc/v+fu9PUWGwtpEkwnVruJVVVUHN826660fEUT6itWevpyDUlrTqBs6vBC8HAGcUpKX92y5M8+0ffzZev5lRW/0fmndy+mGGafPN4HV+bbMo2lAc7GVP/g3VN1vINlGiwbsG7Rk20mFYhkVHah/K0S8/4a/Lw81usTHPEaMdfMPduoK6e9a8UnnQoVWeLppruu17WRGEnbqvR04uUPjZaVucwXFTqsr7S9z+8sv9pdnqyOxDHnRLAnMq+MVvQklF05I2xslf8RP0pW03L6gFwV6M/JuA2iLADYnrgNMJ5/BU0HTjCoLn64ChVm43mXFQSxuAR+pBLi9ynUrvLW0JHys3D/csFZzvO/foSTEGmPPmiT9uUCw//U43UhLqscq0AORFRdgLgquEGQI8YeWOQXoX2CB6WhsdEvobQBHiKbMt9O4LIQ9dPhVE+r09LskQ078UpitRXsquyiJfcUpgb12tEayJCkrQu82gL70ZzSmW0LxC6hYQCBLFSV3i7aYQELrEPVryUfiptFLG97ghOhYnu/CAcTt1gCey33ZZlxGeNmTswlkpLS9FCZSAIKejORHBjdXQiMNNA7VT7R/EIjQY2rGkosp79bIyASPcqmyVQN2o0kbr9pqJgR/OMCmyVKjFQWMbSbUDIQKM2F5PDs9RvBcukUXApKvo++YyLl9JRJRVSL2yMC6VpXKkKA5oseYDm6rdg82mi/0wRmyddg0QBvQiFlixhs5hEAjjG8MvT8JQRM8umxDwIN7wuaTGIXNGbuR52xr6aFu4Tp8ma6m1aHwWpvD9bQo6yx5D3r3HqfLG5pQGJD5VeK6WRD53npaiX2Z3wkTZYXgU0d6I5Gzt9leKCxwrkClbaSDkNByV1thoxl9VLaEr5HEA8BnSez5J/fJI40I1UhdSvcnE3s/NIHfySuLH1HTZ77pVd1RfmT6jMqu8AGOXdq6wXvHT0wLs4rfPE5v4Ga0xwuyfJx2WoAEj/ogG2A62AyJ8vNKY4nM6J/qWoGdThj0xtCfLay5g7dLnD7oJF9btJjxjeT4zi3QDe90iOcU1uPpwNJwRYgP4bApd4LVzY2g7hwOMtZiF3qbwR4hXZqus6IMfXdDOdXJDZnXdd+PCJESdMymxG7kzUWVjB8IRmWICvjwXqVEwUa8MFmD0dWGFiH4hugUpNuAd2XXtV+1+yNMkjwgcGrlmemcUT33QeTp31CsjW9X7Yi6ktrvvS5LvxN5jKhQeNbtisrtDvYUMk14lusY8Gz2HxUm34Dz80LLXVu4kduQPmr62390njr+8hyk0NRaEvhNd93PXqQui/RHrSFB0chidaQqc7/PLLX6lwOeBuOUM3kD8DnjxoHYom/Mw/sYK9JZMbUvEtGKdV/RA0k3aHoopD+kUFqEqgnoL8tw1b736h0G3f1f58GJ3/2AK1pbSO13QsTDanSAt3uKahXYmyQn/qMebR/0agBHpWl6Xei6vN3RuN4zZdRQ+Z75st1WXDc8GtGU2f8YTuIySYKZ38pbjaW0jha0j377CAcspsfm7gPrednGWvlWeMsalCyYWK4J11UPym2Gp16ZvGLF0zKPQQtMdQptH1kHRquzWZQ56Dbhb8u9IlRlDZpKscBH/KDDyRE25Q6fPOGxeFeeR11T/ifQxPAngtcQox1BZ5qCBLIMSAVcksIzyhbhkXKF7aa+nZMZsz6hzAkiHGHXejIm2Nn3SFB2q7je3TkGyiyeaH16K/jPrMj2axSKhbhpgrWNmTjpVH5nKeCfJGqQEHy65XKnihUp+1ZFoinpH3WdVTEEzgjRFwYgFd5o6EWmNieOYrnrKjA32Fo0oBvIPBUAnFUt3jQwt+hydQbjTVYCdYsUt9B1+dhpjx0H+x73/72FLYWTTJ7SKbpYgb9YedeNvVT6f9cO8CTwwWnETaiVhNpEGN9YMy7EZLIgCSSBnVghohrOpuviJ72mgAzGAJhhclgjMMwAlSbtPlBQsJwehWj011sbHC2P5dgP8t5G2kkQUx37oV6lUbe3gI5d6r51wAtOtOx29arb13EQtwQRkvx6Xo5mhukhK44DjGrDsmflb7sfIOZO2HZrKLs1lN2qjPqhN8OVme2oB7EM7d6EXExPhtiTkgM3W6RXIZqux6gADX9VZTsv1g6lp7xu1JF0dCbUXZ3K2ziV9/TVIGYnmsqGq4X6tQ+kjghJspSbZkiuFZ1BLcXh1ZvZNgTLaPBlIWVOlmB1n2exeAW4m9tQcRAIxcAfdxiE9VdB76TEDYJVMwmF3ffrolVOk3kcMfy7Q219wJzkB5VR+01ld/y4NfHp1q5bGeqNGpsnZyPo9ipCMNr0t1409dfTNlJc2ZaZu4iMGyUk3Ll0AsWBVwRPcuGGelpFkwmdk4VO0jTyXK3RB13LLrbo0h5oq6yIplSgHC134FUNwkcTA7JUGSFA+h3P2fxT2sbu3GYBRDPDDVvUX6vonumUbjZE54v0uZULl4pbJn0GOm8SKUGnQAqCPe7z8t7/82xGE76v/WezWcvF/2w2U2d3LIAj1L3HWdpVyUOHWoEbW+jDxhSpc8E6KqJ2ylhncYdvHwFxHJ9rk44lJMbHwCQ5UXDXvvyjc1apA/7vNVgF+ub5oEmbwp8jZHE2/6jrBN+Jcr7fA9fNke7wI9s+6+JC4nNeaUMtZYz1nF+sK5GwTy75J1f8+SW/eo4Nu0DZ3icaNRlMxSln0RkzLI74ljTs1QjycGgLvQx1slsdko/nyfuWXco2Fzi4qajfWgUyR6LuTU1P0FymZpd1ubvUcIFpp5IYQeolQk98i0p12e8iZs4ll8jj3QQQy5GY6iLafFew0UgBCKL6V4sSmBiUQJpPRRFhl45GJ8Mp8dx1DCqMhzo4KzHLVyYgMR8/NCf7A4G1c1D4CQz33aGWtsnhNB2IRzRIInTouUGVCNuQHz79U8fHXDG1NnCrQnZ5XV2mdB+yy+X1w7PZdX1dXRfXyWp8mS46dPwdz4dk+HBkGQhd1Njto3MYSK7eFWyIxlEUrVicgAQhJzQOeHvU04hqhQ/Y8gjxuRIniUAwxzm9kolAjOFDy2FVKuYKgXAIFEpjzziOz9E0jOCxdMdzS2tBOebsBw9Di65o2OrGhpaWcGik+1RQPeDaQx++MkEUKKPhYnUqIwZqIj5YjfS0GoiKCsobbSUIcC8jJtRaGlTC0np6VYjQNtphsOP4kQb+sqaS0ZnHW/GwEkIfNldVxvbANkItAaWaqEC7OGmBD/chBbUwcAOF6/20JMM/hqnMDE4RlWeU3CNQzx2VzzBgoiQq2hXm7AocLCYBVMhH55pJIMZzX5PZNGOzKxGs/SQQPpjqZItMcvMiie7mRaaopsmYsnzobC/BKDLbi+pbuT1by19/x/unN4psTCn9rWlGFkiTHQVsEmpQ6d6BZdGj1JP3IrW4aGIm3EL5rAwNhFN6Vm8Vd4e2UxNm+44OiS24lvZiqbQXA9F1uqIt9k47oVVmOENjqNfVZI465LHpRBaBEE0qkwM0sVKtkCYOUn5hRR7NbSSX3qizyRxwFo6dS4TcYK3Tc0U7jJTOLrBij6edYzFodV8EBgiXWc32uB6AEBpqEMjbMw41vLolTtHd7rm97OTUVOsqcMrC8RXQLoi18YioQ3oI6V0oAQ4rXIwSTZ3eK/UNVe1Gdxx5AljjITuhAuj7kKlDrnu2QKYfVKYssNzzGoutsvHQBfsMbHybyLqndzaqk3p1BVytxB+2ZLBTSaR5SuK6z+BHrZlBx2qdQdAeeGQRPuLmJa7bwdoEhGIW9mCq4ezCITE2DJ4aKOQIT+FOqs2Dg0Po1yGxnBVHgd5KpG1tp09gGHzRHlXCPiNiJowZR0hIEPu4cqxpFdJoDFRNJDNJTSYKEx2zkPYUKoO4t+GHWj0kDjS+JAJp6eb60knfwPICV/I29WN83zBEG+nC1CnKrNW0xwWwsDWMTmisEJX2PBWXATsIrbYG52F3NwQxIzj/b1n2YATkul0VEqLMZ1myFOAYO5bSmN2X2QPy5yN6ZpgjErwPXfchbJrRfWjCUFIM+HlLW/bsqKvfxBlHZMV0pEKPQIR06Sm+/EMYRL68yh/C0/2tc11E/mZvYkXEH6xkt69HDzhW3WZH5GNtiMDRHjjs0hv2aU/ty0MVCTUCl9f348uUDPKA7kJlF2Tm5eKk4iEJMFpXYtGaKhm5AbARZY5UJhCGPRqpNEFvfeNRr2IncBD5A0VFliGPWihnuygkq8EyYCh1yzxrS1ET/ENDPLiasCJeF0KdhcSvAd4vVFsH5AIFY6H1mYmk5D6d7RKBrCJi9Wx36uD3mPVMJdSVrJ1gEaJJyxaGcDPQNKY0zgpPz8qIBGF7Bt+F0yxmdyH6ZoHfqCyreD8sulr0x8gbbFVIiLHLxsoE6tfXzBxDCEn3bUOWS3onG8i9AzWCcuYkE3EQqUJsSy05TkdqTwdlDCviDJkUw0NmBljeZAG3Uw51S0JJhRqqdWlObdfsQ+2kFDP5lNns6dkjtYjx9NHDdURGgvSI0p3jGMZOrgbtCWCzOZrG7qNhxNJuChbCxVHRU78rokn6dJEkU6M9ETRySBsp4UQ66oWKzxuIAEFd0gl2x4+QTlIl+cOk0/fdbGzwVbMBc3CTMiyZAPobPgAzWHYPtXA6lnylqPEQTDt7RNZJC/5nRNbFWZqpbQFyKqlQIXp6dQiiP07wcLRZ/YPEDUQ+UlOq/1jzUJpP/7G7b81DtL11LHUUtBr7Gv2LzGfE/z3UXDSj49c0L04D0UFVJUBoPZkfj7JWX2YPYLNdgsE7wL5+WVZwwQFunePLX0jsSEvKLjfO+EZbSgYcdfZVNLCk7T7/wGpLuyv5s9Z80/DvcP1amvMqmJ3pS27juVrq2WHvht91m2K/dSNKuvTUMmGgtP9VMV3JlZowwOATPP6uyN8DyAt/+AbXMoyWyHMFFaPevleK6dSpyvu3O15AeJmrp8NevOE7hzoISPqFxDugGu/gtdri+5dbvZYk46vDUUKOHY6VYpiacfoye9BhzBFFVGK15I74BfIeNd/xMrW8QYUdv+J6LchZiXa1x+41Mo5fwrdzfEoD7GkUZy12JDl/WYTZqziNugjPZkSG9KUye9PsuSE4Zw7OYlpNHdCx0SA5koGaAaGPescvQVJPYzZHLo+tVg7Gr2AC27K4vpAsLvIINoUyv4SFyxQyi1uD/QtnjLazEr44HjP5tlhrw96YUBB2owcV7OlI9zRdk2MXrVsBpv0f7murlI909+gc0xkLwNbotjjO/00DJF+Hhgm4WjGLTS7mC/2fD5sU4/aHzVJbU12xOGeoGdpuicD9klRcaReFkmx3Ro74ffW1Yca/Hnarll6X3a+HHa7fYJDrf0BLAqdgd6RZRB6lUpwqiibDwx2CuTAMj5iu+V7WJCSB6LQLmCNty0OlF20IBu66pv+0fynWNqCFesK2Ob/9Zs7Y335D/oIaTvlZjSj4VpUXapRinM9Agg4o/637DyxUNluEDOYWh7nVne6xmu6DkxxXwOBEN5MZOZZaU3umJtVobp1qTpgfqgv0nSr/ZoX8LQ/1RV7y+KIS++x3cSEZuBeHAgPRT/BFHObyAV2Xg3NS+XTYyV8Yqgvj7Vw9HeqL1hn6ResA/UJ6g72QB/BF63Qb89XOt+HhsLsQVVVWF3hOPtRbURwcywR9SEWie2Nvr84nBPlMrg2UuSu0+0hNc+2NMiQnXlTW0MAPeMxsWwzs5LbR4GiGG+T1V7D55WUkVbtfG0UL+iVICYNL+teQXXqUNN71snkkjXdsVqRx0I2kc30N5/equb5ewvNlmBRVDa+H5XXMJ8mLyZerx8+O5KlzvX/qB01dHUST8HwvUOmqmQReMJpdx+Q6HoNPyel1PG4I5C1er5bjySrAABRKIt37t7fffdsB2QTR4xRCXVf+ynitZ7Q2zIMLmWw0LAHsaKE3VPmtdSmvHeEJM8//GnbGWFp5t9dqNPxjM0JnaCHCUXIG9454zEbJZCTgDDoSEpgt0VGJAdgCNUlwgnnO18Udz7P4AmruXwDzBm7Q2ISf33xzCjFKYzwMweCsr91nAPegsejHgU9ffffme8irCoD1Ku4vrCDUnZCwFFW5fYu5wbUaZvzlwzYH55depFK9APfY4melV+m8yaKq3JdJPYV72HdvpHLB/n0RMQeH3KHRFBbyz2++AX5H6/0h0mo4NpOih/zsuqNzeglY3wo7z7LXO+nOn998I3uTRtIa9auQXf5p+vTJJf0apvgycFfkN7b81V09vaR/wwv49GlA/OXFdb0CgEic60/JdRU8uUy39O/6jh6Wh7rhux38n+zrsuKpaKbjCS7UPViZJFkumkrsm/ssTkVN/CeX9BuV/K+v3zVfvX7xCsxf30DY9eX15SX9Fj8vr++n48lq7MOigA+47q4vgz+tnv5/GuLJZ3/1FL77HvigbUhDLul3IRhvfo9//xEy5+mlo41JnacOoT+E7FWINzr6NmTfKmO3H3pMQnk8Gsr8nU2Zd/WETqeeUSyF8hbayhX54N1Cumdxn2yMiCaXNFPcGUtYRoNMCRM5NnCUTeM8dag0kuLLWBnSah30CGZw7xvKNSPbnuvHsK9GjDAkcAp/H7b9kXpr+T0zOwdATY1m+qTjiES17FyKVZIbtlbqx+dUc2+aJmkasbxZBUkw8jJ2o7lavhei91M4mFv1+htCU+9GmhYRmhkxrR0Z7MgAdBJtCFw3xcnQtvuffTs2Vkz5DX94K+o6K9L9NMl5reyFAODaNp1sFRqWMUCWeWIZrwLuS0cZj0dCoNfho82NtI2a4NSzndf+FJ4D01WX1T0ivJnGKTrGeQrzI4OmZlPZNRZql1Dogttsq9ABUO3hB7HflcVefCV4LCrPUd4VJ++k016pfAGtTaVHW/AhiY7t4K9BJ3zMzGikZBFWgm+OWeJBXbLiIiIJVktaXJrMIim5ypRD4qgs7kSFZj4pwh7BhxUa8KkcgRESs5Qc0RA11gOdBF4ykg133bYiCaERuJDTHFHTvT+Hg/hqYAMO031jd61aXdgPgLbddoVdYXKzTPvMgW6DVosEMNbloOhrEcFDvFIj8GUm8ngvXdNFy4HwFQsJOvKLXVdW8Uu0IwCeC7MD0MW+bgJBe0JqFQ/FyrmSwMBIDXMZBt3ouhnyGdHzHrtZZjgYCdipLZ2n+EhHaeuL7wbnBBM2UbhezlcKKa7NYg3jaXLBN7wmghlHANHEyoc/aNeB8HEQh27MiEKuxJpeKcaE4lPX5UunXlfl/d5ZkZClwPvHhsHhL9/VwZsb6OV9zWvhd85Rij9+GuS+8215IYcQjrKLpCq3MCnHzkVdQi8cj8duPvtDFAm4t0DX++HRgs3kSDL4M5rzff2mjFEKgdaLNUcrRnuz8R8PVe7/EFKU9zp/ff3Oodn+mzLiuf93xeB8C66wCZVeUgGTcleVUDg6cIQtBegPHyEzcT2/w6xAOzuTFO/lw+T+/n4CGn+TQ5Ujk0rEC7gYVHtRsx/ffTn5L4fyKBK7Whl//SOUbgYlWbTLeVY40sWYDIFHhz5s815J25xeGEqK3uzLohsBQlSMG37HlYfKo6773n+EPC+vw4dtfh1eyiIvr0P4vZT5XV6H8HsdXh5pd+HIxI4O/PnNN45qhQ4CoYWulg4DGlTWQC1h6AGsouNL8lAShxfYZuho+Qq5wF3VEO0qHFrut8TskVoHihxvPVYPgEp6NDPicEbcEQb/DD08tronFbAK/H+GXjcUXURCQOsZ9l3ofRcSDHxX8WK/K6saAr9XgT271hMztJbO0cYzqBCtEE/6yBp5e6AedmBTFIFv4Xyqmtw0OS3bV9f1bBib7fTm9iCq94CAtcULw50oarrr2FXTW1ZMX/I8B6xOMKoqInGxFduyAviIioEsjNeH/csyFlBNCr7aaQ1/DmxG75gT8SISuYgdes8eK8Hj929xYc/oyTk5ACGUJd4zxthBHmob8rgB9BfNYfmbIjRTQjbL8NQDHQsBkyFkmyXvfTl2ncdItbvwCHV6kefdag253cFKBalvePg/gLHOvj5piC1J7VRBUy0H0BdjNWgc1KiewClKwEJl40vhXl5lsXijSIwhgQdkkhsihHGdth2c4b5FKIFnVwdikBA4qYDTsIS/FAROkr64uNcWrnx5r8Z81XHEdqQ8hMk+IMtomjsd8wZseTEiaO6992ZUt/MI9dkZA/Z70hoY3gKLjN5P1VHA7qVV7r28k7F7NMGl+fRQ5Qwd3+Jj0/wQAjBmy1v6KqT265uQ4oY/di4vUdMZJQvRdCvqdRkDrqUUP+QmREaheUvJ6At/G4QXBnL+TuI4K6WRlE/hirt/VW55VuB9X1+csP7dLAjtRGcjbxQ3TSwpAmwGGDM9U2/P0BOvBOp11nW98/GSs5yvAue/Zo7vfPbZpw4hjDE483rR3ob9eFg6NBCcPVtnouueXDN0PNUjDHdnrjsIOrniMfoy4Dkh9EfYL2lOI3pPqFzpaqbcLzLt6hhKlWcyzSSSbDGVZ/947LoqkuFtObgj1ryq20GVP12fAyCC5HtFmbPRN4oGkFGBrYuj0InVNKodSEfh9zHzvgwN0qjjOr4TOGSsmqvk1/INh5BHa2muiV0EM/brcACo9OuQOk/mvzFn/Bos1/xkPFiMY2JA5lmiiSA00LepIiSA76f9jcpzvk4mOs7kbVZEwqEnKZHVWvP0Q5l8WxYCIL6itdPGJoR67cRp+9F11aEkSSg0XYT1ZoWR4ZI6FynayYXQoQQvkNRy7DWLN5p8qoiwZffLKjj7Zaxo+W5w4NALZ/yPcOwsLm7ZbDpDEF7it9mggf3CUPb5dC2PFTJQX0HNZzQ5Rb/v0mfnW1HErtt5lYKNLb2nOWGyE+UaMotI7bVkccccfHRMRR7VdurPFXk+p8bkfX4k90ux8nJdiRv2IxAxaqXiaXw/bU9zNoeVWfbWILofXd7TfHWytnPJy4NxqKVtFjhV99bsHBibbomjogP2pElLpFfZA5sDVAvcDfb0vb6ZPEjaAY85gpeZi4fFe28ypyAcx8MN3+BmYmg2xwL9fO8ZxE/pTG5Pa3pHH+h7Fi1Ae+Tgut6BPaNr1+3Zm63B0aPSZUGATsehnX4Lr2bBZ/6Mblh4xZ7NZq776Wx2FTbNp7PPQO2JwpDfsZ9CL6f3iJ5xx36Glzt6TzeEbgKvt+4f2P0QB+Ibvq/NSncIfRjaItgDoWfSw4o2ydTyZg+E0Geyok3jANsRzg+5gwboNUutT8dX7ZGh9VZXxPfeszukJwTdszu5a9bsTh7tdMNGNbDYavaegl004G6/Z466Vc6uUDOCzeCA0lQJC80j6k2Bd5D3QAfQTbDrQKxs6XJP39P7FfF3NsbKFubse1qv2kyBgvIqIHjVYHZm+yaQ813dVH18ey3rCNOfboK9D9ndIt6bVciKQE5eb+G8VMvQLJ7JRB94TXPmuCvR4kKTtfdIySLL/6zCZarVJ6mD9yuCad7iNfGcpYFMo/ogpI66VHaVTECRiTq7cj+gkteXYnWtoLucWmStiaaJwTqdRuZGJO88LeILXvS4vNeHVO/NvsBHwCFS+xyCSmT77+GGbeAbQGKvqv+buOP5j1U+aPUgy7SLkiwEU5zuC4pHvMUtmBt+wpxqZgpcRkkP4+K+4jtwvdzzfv8/0npQeXU1HrSigzK0M0piCusWvdrZqq5kKm7BU5NxzLzQ0bqqRx1X8zpzGvY9qktoYPg+4Bxeq663Ia0WO++Et06DjFtvbHNXSwE64OuiEIOmHH9AlaDXl5jVaW9+QHfAqAeAIZxmuYDBuRIpBVE7SMQPLQ/lRNZ+UJ2vU+/F+akQnU6FsGv2SHxZ1KHoFtYV+sqB9siA4YvR18b8HHCMDRoDrd6N8R+OIZZLSHJUSCwdaO1fwlN1WeOXpfUrdQpi2bKg/1u7Hxudh7qH7cSsopHWpeEnphOQjYE9xLoBaJ/ENDRme202vKOxdzRqCspsQEMUyQyGNpgW6r/j8dcjXV9Kz9nMdXWItEXDoBE/597Tx1450l5N0HtcLgaqMhqsM+SBPKgnIbv8z2ezy5T+BiL95fXqySXlEdgAB9fFZUrDSEoipfqD1kbOtiDGrMRe1Ci/RL3kKPqQHvNGvE9FQS6zdpLEUU/IcAKAr04hS7oewgkTNc1vWouVBLGHfiAgt7GzdMbeCR/OaOGKIARyfuysHCqw4CMxvH2AKlApR+jc4v0Ole9jidXVBZkJiSlPYHYh4ABjjuqSzAbNfQDfQvQ+9faCkAShp81lQ9DrCWm81KajKyb50D/+8DVQFmUh/dqPHeaMB76EBFkxRsQlaawu59N1u++dW70FPgmrhis+owLE6h6/erw6bmDknoIGEbS1WyZHq0cVEGYceRFFwEsY5RZc66bMCs9xLWbPk5A6Y6d/5u5FlaH+8cAGqDkXWAMTUbaLIKS4HXRuBx08BJWhntw5hdJBaBVGeGBbuCp9uCNRK3L4TMW51zkRoPugz9WGnO09x2+te103iuR66FiZgy/QMNILxXX13i2NM5vRf+tP5Ei6bbNBb2yL+kWH0yqNBS3j+YgE0nA+GjCcf4Q2+NI0hkrbEm5GlEfUAR0KpD79gajRcFQkYhXJ15nDD+uKtap3XYWU4MzwKglSkEUe8VsH42/KWFz9V7CG0MtfvVTUDdDDDVztG9jrJGOoUTbNsBlag1FL/gekbhrI+eivI9x8BaBSJhF6/4jYaf09suBTXtc8Wr+WnLPOK/DvpfqbY683bb6ObjWTiCTRkq/UBoCOOpBzVVZ7Nhql4HHyPqvXLysRi6LOeL5HTNgIapRjjTAaTSO9S5hbtdfTtRqFNk+zaWQpahottBxQ2B5dzDU8oSkLZZvpmo3HIoJdK0XPx14oGbUhcvBCyWuAt72ocIKAvvx+f19WMRCqD+tKSrWQDZ7gZm0F4lXXClgmq0VomO2um077LPqhMK9NQmiv3fHS+XmiGEEingDR5ABPdjCcOT+/+earut6pDwrHGKsdt3oTkuWXnrKYEhqjl0eHLFLJLAk7rDmp3yFxzIB8tVZ4rLs+ozfQ22C+GjcN3OxTi6uBsnDF+kwi8IIWGSbItCwwIl75pb4k6ggiNuhno25GUH/NwEIthxupOZOqa/kpiEQ6teWP6Ngc6AnWDZfsoszkA2GKW7QhjxlznGPSNKNQL++m6QxYMH/27FPjsu3Z7DPiJ0wWFDybzfzPZp8db1xXeNhRNJ0OSpXwEFFzM+h3YdAFNyL+YMdh57LIhy2iL34hj6D4Zq/iY4feXkfK5MryYsOn3allG1yZhNlgwg+p73317t33DrEz64gtjTRc3qB9pyezphcdsfaZcBFtB8MfJu2XjvRblXZ5HXqBD7k2EJHIYBR5d+TUKHBW2QxfKuU1/zWcehw0j9ojxsiHPcMl6NzuNKnFpXwAjcLxiY3mhPKenEjePZjkP3BVLJN6Wv0Nd7A8VJCx8mxBAWNkPsMtzoEHhGhqmrivPjm8NaMK6wBqkaoC0ZMdNNr4VIa+lIoRaOCklSR63yDdvgL5qdzMSzzAWDi4IDoK0kCfj0JrVTXNJaQVcaM53Qo0KuzsXlibDxWDyr4fsMBSKv4aBEtiqIFP2MQDsDSj0wI7QNTln4Q0spgdQ8sa7f5l7U5WN6oCRnBj2ETs0mPkOvAC5jZPSHMdXAeXi87CA7bfznciJd6X2ho7Le0/det0E0lcOmRCIvh7OXZ+kwIom/QE8TWM8eACgDJQoWPn2He0E7+c4RQjaVjLTaRH6lDlJHAOVe74J9t/qIRNsJi80JYOoS1DayLzUZ0doJDbMiFXMKmG35ZOXzeNI1uCqFgdqZAndAt0f/aubN2PJOgFeMTvhdB1EKLr0eV6ZSjbTQRyQtD59nsdFvakk7rjtOhQRZe3QKRIWsU6tWRxmJwVO6V901YjGgyV7vn+oijrC5hJKF1Il7PVkXa7hEk2MzohEKBbIDo5p8wYMhxpPIDn33pKlV4hWptjTxBfZod3amx8t+ujXlfeRFJNVxACvg06IwMOExIvRSZmypLWB5DZyGzfhah7hBh8fUY7mpQNaNHzjhb9oH2UUgGCzT9EJ5eS+GcPUj0AQYcBknrZOiAMlmFv3wVfv2Tle4LdcG/JV9I3duK6GmnVdaGxqh89aMxWVKnwgN9gs+sU4yeXaKaodL8wT0PNHoIFzo174/wMnqmt4SQ9BoE00DJqa9fd1WSO+hJK+YIrfdI11UDlhFDOdPCMwnufYdJVs/LDAUzwECUQzvffvX0Hc9rY17jugDgAdpieREBq7im9RdLzTgKwYe2Up+kUYntxUHjO8zi7u3IMe9uaZIhLlmRF7MWSgavXSeS63Zt4esKy7XR70jSWMizQvzSkfIWeiJTukJHntPoUtBU20Z6kypZ5daRhtJUFrz5q1tS9XOPBwI2lv8WTVHiw8bCoJq3EzjOor/adU0XhuF9LvyBqVI8tZbyNerKCn7IiLu+Bccj9v3TYxEELTpKJe+B1SSpApvCRASx5tQg/IZm653EKWtU+y5V/uc8kVijNGbSTbkEFztnXvM4i5YLJ03xyHZs5lcg5kOSgN8xyVQtPwpWrrFFoSLM2IEdfsfSGeQ4P92V+qKWPJ0BkzR4QeYptiOu22GISIoMuE5qtwFKP3oD5RG7q4cEeGk/rcod4qVAA8b20664a3KZ0PFhnEHSyZJEPGWr3D1EHTH0NImAF5jdFmGlvC7/ybbKGv+O0jQIVwTjwoN4na/yB09A5AJQ/ghoGwDYAFyiq3C2R7ui9LTn2uIllf3jJh7AsW6ofBVD+GSmUGrmpmT4aVjvU6GrSBvMRgFNnNFeueQ2eJk3QaXtXCoJwUS36Y9In8KklQAHOauBZ8GNmWxfDzn5xaz7zDRTZIraNwI4Aa4xzY4wggqn4RbYQEZe1f0MyAUIOM3hX7nBeYBvlZNIpf+6nBBeIVlJ4hbRHAmJgPTv9rkegjmAU12a/V2ODUir7rl0Wcg3F9noN4C50phd8T7Ft5eh+r+RsNLRD8UxucZFgMOWZgo6ujTt17FPsRr2SMaZ0nfyu3EnfyQgOHslOG4gHHdRGVIMjFw/mPTENlG4L35U7mSV2jVpAMvt+VMjaUXcUarf33+KZn/RWK7obddGjZBe5rrVFDuymKFbu5KcPh6b5Bz92EdTNlPIda7Y51MxS37Hm74mfaskWv/xF3zbJ4gT0PP6A6+eW58nwbFr0dxARJAFq7yYBoOL77XruL2zwXwamakjCekmQmIXmRQGSg21bPeILGgXCt8Lfwf2PYBbgxgvR5vsbHG6yfcR8oBjweFmd9+EdrqTT8132IPLv1UjRQaPnCOg3dHMeEvodN+hveDNoD59luJLexlsIJ2KNqxSg+hpAiuIC8BWu1ClOvUz0H8Y9OeJvO2OumUo+6Bf7TnmoMfjYQy3HMY/tMW/Htd+HcAewvAGYLRfdi0RosSX9tKAy6SxQS83x1XJuieX+dOpIT0+JHBAlnp89jtxNnTFfISUUtpQQXnh78anxoRZOQUEAL5ZlnkN6KjpvOoJckTpC960tnOjhROtoubqhYSkxznSxnejzJKRJEKv4NDGzs28AH2Y2M6urLaXJUQkOjZ9AdeIkSS9BkpgU4NRG5CIddi5zSvUqfzSH4kwqkwb1FnqzJ7CKd54+dYhvAkIKd9KnTx0Fm4ddAPAI7MxmrMhjGZEX8VuRJ/IiyOP4C7hMOzql5bcISQXXlb9Tvo31s+dIaTD4faGnRRaKpingeLv5B8SkJTw/WVhIgS/LIsmzqGZDtP30CWz4SBM/YWUk3c+ovMwX9VpEhBZHgMRowyBZAcFk8R//3wEAvTzgbot7AQA=",
 		Length:   97163,
 	},
 
 	"data/js/jquery.tablesorter.min.js": {
 		Filename: "data/js/jquery.tablesorter.min.js",
-		Contents: "H4sIAAAAAAAC/9y9aZPjSJYg9ldAt2wGvOiBILOr12zA8KZFZVVZpymrqpWZtb0jBGoNQThJdIFwNuDMiOggzXTsanXMSGa6TLsr0y3TPTM6zTTSt6j+Ifonsvfc4XAcjIzsGR2mLyTg8PP58+fv8uej1b5YqkwWvqAPpH4hnKv7nZArLxWrrBDjsf4Pkm260I9+RH77u70o70nMBA2JvPmtWKqm4Fam+1yMxyc+BOJuJ0tVLdqvXPil+N0+K4VfV09pKPzf/rPwTI++291SqH1ZeDbpij6QfSW8SpXZUpH5h6T03vCrQCU3uahkqUTJHz6IsspkEZKXwc9nwYywXVJWoqzCKGa3WboWCh9TsUr2uarCB7URWxESk0Agk9p8nd2JNBzNWLWRt78u5VJUVVasIWUjklSU78V2lydKhORhKQslCnUkTBZvReF+LPZ5blN/hQV12jIpliJ/J3KBQwtHU6aSm9dFKu7gOU2U+FqW20SFZLtN0/v7+3vCYIjf7HOVvZOl+mfEfUiqTbaCJ/3traiETl+qMsfkffXtfnsjSlMZVC3y5P51kSkcnSg/iPJdlgqo0gywRFhCXj3UKnw4smxdyFK8SioBHyDD17JcmhHC65usUgBYeL7a7USRNt/e4Qxhg7JU0HiW5N+VAA2SVEvd+zdymeTildzuktLmxSE5Lyop8VVsd+r+vQzJjVRKbgkDnCjWkLJN7ghL97s8WyZKvNslGrriTn11p8pEw5vcJFW2JJh8pVSZ3exhLtNEJeeQpr+8Q5zS4ygQjm5KVmTqNwajRlODXK/ypKpCol/OH4pkK47ERTz9+N0OulGFD78XN2USRkR8EAVhRKYpiY9YdZbk2e+FgSIC0NRN2LKqrqqlefpS2MdvZSHMo0E29+WtvDXvDkLrhFebLE/xu7OWzpcmFbO8Llbyi1wuf2znyYqV/K7I7037AJ92hgLTdBWIQ71msjpZZ1rC9LS+L2VhPzlDhNcGDPDmgAJfswqqSSFpJ7NCifJVnsEIlvBnE7+Ut0VItnJfiVTeFjb9+51J3e8Iq3CtSrOKq5D80lOwPjy1YZ59TpuMBhQb1kp9K7bygwhJUOLD+VYQloqbvUNa6pUEOJ4JXH0au/HRIWfrXN4gkoRTlm3XgMfh9AijDx/0inPhqDEh0XPdhvAmsRhA2PJjuCDzdSn3u853k0rMIDoNYFr9rVe5Ta5zvC6GMpxnRQG1ZCdQZOegtfu1SdeUBpGmX/1VTYk0GvUzQLrOobGwn+P7AglESo4sT4r1PlmL8ME2eVUtRZFmxdqDJC/Z7fJMpMxzW4XfJzLphr+VwzVYfK80NfeyyktNImEF0DpoA2jgh0QJT0ms4t5LCi9ptatz6y4NZPdSMZBdd87NrpEcFofOdmSlWIu78EGZHfKV3jvDi+t6G70+XqxZ/RmJwcX1A8xv68O3yVbAB6Cv18eLjFW7ZCmq8OK6mlysWSGL38gyDS+uf3OxZitZbr/KxVYUqgov/KzY7dVBL8jDzV4pWRyA2ielSOhFxpabffFjeOH/4EeT6/N44S/C6/QzCn8BPEBCJL6K9dfrdEIXdPHi8MP0Lpqe/0lyvoonLw6QfLE2lUGrP1xfTw/X19MX9IJtxF140coPzcrtNgkv2MWapdk6A2h+/y68iK6rw3UQ21SxTlT2QbwXlQovfriuPrv2o+A6jSfX9KKd5a3Y5clS1LlMNmrzmSqi6/OJj+OIaLx4YT7awhELzsh1FV+sazIUPmyTu3DGtlkRnputGJ7rx+QOkn8vShlOYSaAPBHYxUg4ZUruYLPU23Y4mh3Zj+L+lUyBzgkguuHs50fkfzTbUVQKWKVvhNrIVNNCofa70DKFipX0IVv5ajxWgQLqPB5PR5yrQH0h00xUQS6KtdqMxyOdvEmq1832Sh+AixRMckJYxa98RVnCr4KtUAlwA/NuAT6aMRVkzh7Kgb0IlrJYZWtesqsAyvmKtYlvSdmbACm9XzKylKUgdDz2l7KoZC4i8x8gEV0QTUtDkss1iX1i24cF51TrfSCTN4FheanTNDJK8oMokzxX2RY6UIhb78tECUpZGVT7HfLjXyYq+Q4ZeO77gl8FqyLQfHlQ7fJM+SQglEbTmOO+87pQvoimMZtNKZtdwuPhMOOcw9N4/Pmlm22G2aA1w61x+xQo+UbeihL4SR/7Y9g33jz28uRJpfiDy2viNi5SzTafz47swoHN9flFoESl/CpIlCp9sgT2iVB6OPgw2yPOywCZ/wVxYXpOJiY9JATaBRqD5GXhPHMSQD77HpR6wfhvAiRxgSFBjBAatsu5aDH5JlGboEyKVG59Gij5Dkfvz/4ODao8Wwr/JXQBi3DFyuCFfqyCJE2RH/TfBMuq0jkmxMPOW2ZxIk1S009d64xSA5VS5oIwsi6zFEf7wjD9vApWWZH6ZdDhfKjtRYBMQSkKnzaPRJWkU3cpb3XV6gZXJK/c7DcyvQ8LqXwEqMtpTgitq3ogSZkl53n2QZCQ7GSeKagZE0uRiw9JoUhIkjwnx6EOkmWCHDehlhz4PmDb6YzRNKZBlgK2BFnKh2A4aXI3beqh6+4mNyLPRXpzTxhUgotBCwCwovnDEea2JZnwTj1WIjkXNg+hh0O33OFQizRvgpt9lqdmtnykO6vs7pXM99viNyDcAo17AwikpZevS7nVqKTTYYv/jSul6HSku7/W3CfUWgZKqiR/K28rPmWlpm3j8ZvgQ5JnQMDr0pjXip2Hg+ngq2S5Ebp7N1mRfvUBtmdfOSjIRtP6q6H/uq4+8RqPP8gs9YDAV5oG0gBWWJ5ValEGNc3ofwyT8biyxN6n7TebbTz2W7UMZOmAzlfY++llU85g3sLXpKypr8kCYJal+q7wS+akPhzZqAwceZPS0McZMbP8qjKgcfLAVHQ6NNO0v6XSgBHvC4Cxj2zjF2KdFS2SMSHIvH1VpK1kGvwRhZir31nmIinfZ1sh98ovA9ynmo4BQN1tFrDQaQw2nUDd7wTOTacsr4SqK7Yt0oduhTBFR/aL6ZTSI2X9nX7a2+lnT23iQS7XPvlO77qeleETaN2DHoawW9+IYrnZJuWP/lPbNaWD/EKLTfiqSPspPqWsClSZrdei/FVSpLko/Y7IbodIGAC1rxXUiGQyjcetV1/Ro8gr4Q1BogvDRd2926QsfPJOyd0OBZMWcALvvcPTbJLKS/JSJOm9dyNE4bkdpmFdoyhLWZ6scuR9K/WuzoxsLksP9xoYMz0yh6o0zGRp2EFLhZlyCT9wiVYr5e13QOXMH5BB83iV5+bJrE4vSVPn+ythM7yS210ulJaOZAHilSg0Zaxz4LOzjl+n7lvllWJVimpTv6aiUqW894zeQv/nIvkgPFKzdB6hwW9lVvgKuAM6FzUBkD0uRotVDLK56x0BQCbKWc5M0QcRVErufl3KXbJO9JozFA0L+GqTVYZRbhECEWTVFQwqK9ZmIItTK9glaYIRmM0jm02nNBz+Ro91x+3sdDsOEsRw122RVtehQKdWMgFYNqjwqU18rH6DSc8FeatQu+5uzYCN/d4y+XTVUKrbZyabug3Gf0rFpshTtbYXTaty+oDlsup7yKNp9dFFWVl8SmfMLvzUCJul+vzJdgq16i5Bdsuqr61QS8djvVx6g/+0Bp1Cp1GsRV2ei2KtQli3i1suifojatT1gaTdVNkmdM8HQbuc6ak7fK2k0t8/pdqm1EClhg4/vz5TYLB/QAvlG5mk71TSwXvx/P6NpsABotVM8atA3ClRpP5oylAMkWW2zookfycULJ+Kzv2ymwt6qc1msIf2inDFEMf6ChMjPeh+wND0/qt5/rALIOihZBn3Bb/yhRbEDN5WPHO245QX+zyfo2KnRO7DSsWVI6T9fRSd/r6RKghlvuRvAjTWyEpUyupqYJOrxUPy/uqLN18BmymjaRwUMkWl43gMryPOxXh85Uvs21ON1vUyyf0scAwOKJjblO937Xe0Uxge2vtR3O93sGk/sTv39vYK93amtBSfBa5ZArvsw6dcibL7kVp2wGCg7CxfmJ+SSVaxBCYoUEm5FoqygsMYNFcOrc+zlT/yZyPOfRHcbrLl5nAQgda40vF4VATbRC03/uDID3rsBzv6w4HUz5zzYjw2dY44fxPUSsQAdYiHw5NVA6dq5UXbs8Eiel7oeJxiVj3SWpVIUfE4WK6eYUqNETu1pRk07ZMZjMKveDKsbxuPq2gWX34Ow9yVAhbKl3rt+ZQCYDXus7KNyVd602AkAFFjWVWBxnpNEQBnXGW41o/VHbM4Tg+HBBaxRuossJY9ejhML5Ngl5Qoq0Mj7lezdg6HsilNjNCxSvJKkG4FGhesUsYAa5QFHfv4PGsUCCDXZtVX252618I/9BA2OApfHNVCRlmmJW1XT8hLV7OyRK2IVqhk8NlH6pDVOggsItJooKIY1v54PJKBa3vB3hUZmo79jEkm6PEIHemMaDxWph/7Qq8+JBSMIDgM24LpKBsSIN0Aah9dIMpz/Y2EpJCFIOwb+fvvK1Hq6k0iUllHFdTQWav1zoHgzley9NOgMT7yKGb1uzHQ6CQY6N9NygreaukvdaU/bpXMLA00bCv+JljK7W4PjBskIPD8tK95QzGNeWolpSJdjSJlOU8DY9hdnF1mHqpzOTmb+DadAykApM8gU/MY2hxIZZsPdHJGfnl5kf3yLCSEpY3y88q/CrbJrukmUtG0rws9SRlZwTLcvpAKthZpxrSS1C4S3b3aDF7TjAtfbQ4qpRdZvU6dBeoXvFenNjUDsvUxnBVDaE+pJSCY8mWiEj/VCmSLAsCOjKa0ixKRinkWbNQ29ykjQM3qDLX7y+GQabh1qdHroqEVftIr19vnOtZCVjdLT+aEuWa91nHKazUcIWEOg+o67YzHvuT9ZM2e+hmLFEtiSsdjoi0VjdZEjsd+wiWt+3d2mWYfGjxtQwAw72ySTMjlRZp9+CVgeNOoRq/xuJvidiJlNXLGlFWN4WVo9ikabIRZkrxiBnvQB8fHR5z6jJVax+b66AB11GvfTaUOPYiqmD8s5b5Q4fmMSXTsKRZpI/kvohmbspdxCP9x2PoyZTP8MmWzmOUS6Kt2DTJePyL94j4k5Mjsll3jftNlpxj0djQDwgyMRzEe+61+Bk5WPpq2BxFg113QFBS6PsPuTeNmDQCZhAUgYLG1LTE6AxIaXNe/shvwAAkYLPtW3naLA1kYMqrgWkWKOh7XE6+SG9zKCIM5PyJitbczoN8ln87LS0ul5+VkQrsbqwOcEva6dgJ/OFImeEM1a37yLHJwDzC/nJyR+KzfD6hE1OsRyBvwP22G4SNfTYskhO2Z0FD0Uq58Ov8YFTc2pmopdyIkS5kTBlDGx/22MN4rx65mxU/p4DbYUgd/sc9y5dWedG0FsKKUuZkbUFJ6ZEmavu6Yv12xz8pmwHC0sgHPwVxzTfjkLsVSlrMlW7Md27MV27At8qqwBdzwKbvn9RhFPUZ2yx+OsK+JxqYnBjZ017Qn+qY9NgUWeMP9Fddrm3OuFk2doWUpLRt931Eo/yYpi6xYh95niLda4zv6zPtWKg95n6xYe4mnnaY8ZBMJDQlBnud+PPb3lmVhzzPFfykUcHDF2lRaeStZeiJZbjy7qzLJH4y5TmpXrcZr6zi/udzMUXLwS76KbuKglLeVHSl0rOBTlvCaXFcs49N5dpnMs8lEFwRporOUCligaWPdzHv7+pYJu6MXlO10Bo0iX9y/Tp19ADCC2BHAiNYfy20M2oDRnOjliexYK5MGA4GtyHi3RUXMew3DVAIdF7W/wOHg2+cv0HVkUXt+hkTJHQjubXcBYfwIBhswGze2ULsbHA7oOdqtZzke+/2xk0Ke12OhbHc4+Ds+mlG2Phwwd4pIogt8LUs9Cb5gJTufsYJSBqh3GxGfTIoJoUjrlbhTPo35g644XAdZyuwchLvFLsjSmt83njjuKLX3TehC9kiZDAzqARzWTAYNXkLKjk0v/Yqn0TQGbHu3S4rzGaUaCye8YsmEV/PpZTWZzalT13nVrw3Sdqw6P58Xk8nxZsJt/pqIb8LZEUbe3WduaWdZe9630q4vDUyRjhrjj12juN4XRntTr9HbNlUltcI4NVU5i7dNj/cOPX7awibqoTWDBJy20OAuaJCUa2RoUXDUxCED3oYTk3w0RQql+HSuLsu5ws25hr6Kgyxt4ylaQ7uJyMmOZnQuEeZ18W5zMYf9ooXgrW5mK98uaFGL6LP5qd73Op6t/I/33Xq+dBZgTfjdGuZ1H45scKGFfS0/9Ak6XAtmPZCjmjLnhLDlwH63hglBGScfj9dz6hdcRZMJMkXl5S+miyJANh+EM61H+G6lWbpGqrucAgPFVVTGwVLkeRXJmBkqbRQy74EECJYyiU5owF8sG/zTuLwRyx/R3LnyPiT5Xni3SeXh0vdk4ZXy1iOTckKY2Y1Cj0zk5Cz0yNkkn5yRM0rDNR/NcJbm00t+fp7NYYr8qgFKlMHACDrCo0tFlo7HVZBV+tfPWc0gpCyxU1TN7Vx1qCVWpHHMGWlP7wuCsjK1EX28QzLgLHquLoQlXBnF2UKFV76ipvGeUFYtjGMM57xy9H6+5Inm+3T99hQApYurQJXZ1pc0NE8Ksxjx83BIDLGm4YDtvKqLV34STWMLqnIwu1VFN/t0XaCCIrYv/crMF0g/0b1mVacDAId1YfkuicRiABlhZrTRoOKETCQyJjWelDEruEv3orJenV4yHvvFeDwAoQKVkInCJusXX9rBYZOs4s4uyzlPgiwFoT2pC2Bv3DJMBM0xlQH5vBqP/YpXHQJDWWX0ZKg2PGGNOMkrsxt2z27ZHfvAXrEX7DX70nLPbxtIsfd9qvK3wEDnDeNcuoxzCbI+jIY/HAFEjqPW6G29Xt//cbx0h4l+Px77K0fvJ3r+RR2vmy+1MolP5+llXu8ZKXC2yJODbCq56X6UxvyhgOnWZ2Gi+Mg2PI/SeDyGX2SdrSppygo+nReXm/lkUgBN2/IHBCZw4GVyi8XX0MDIX/Ir39YAzLOjjhNB+7RG4zMJlS7djM7ZGar9jgvknV5wGTT9Nj08nzH/pvUhehFHls2PafCilLf8Bv/QRLOkbInqf5+eavVwsDk6ygTnuAdw+8uWqtWoCl/wG51c74PmNXoRA5zu+JS96gkir1AQ8fd8pzcKvykUZbHDLKeGhuxZRoHRvMUSDqM5Hvt3E37LXk34LaXsbjKZi7wSiAlbDY0l2xq1THGyO5NJpuWiPV8iJ4vbK/bt7tJmH4/9kf+B2x3gbXQHOd43O6xeB5b19FZyX6Qo35XyFnbSor2xZpOzuQeNeUtZqCTTy4ecTa78PTVEeHJG5l4qReVlytskH4SXGG3A4gyI3ADJ3bM7yrZBmdxGdzHw4IMgvWMVZWvIkbAP4zEp9ltR6n3Oh6GhGQ72StrjCu+hFHoeb5M7Hx+Sm8pPKKwh+Hg4TKmZsX1LLsCZec2n89eX/HZOYS9oHbU7HIAWvV5UYU19RzX17W7jQBiGhj55TXGHNwCYvIYBruuH15PJ3KLM8W4yOa6dFcS3rLW8BhZhzNdHCQW+Se74vUscJ0Nr9ohE+mMkjbL3GjQvDFizwv9FTYO1+bhTL32muuGLmvBiVYiLaGK1nSaAm5XnQxchH5m8qNOywsvlmnpJkWIhA6UJMSjckX5WuOk+HM2ysvlxtcPg7vh0fnf5Yn43mdAqImZNALb47ivqBd2EqKesyGIr7g4CKboDSjJ/lrRXPU9mOw442HypOSTNe53ikBqpob358yG/Sbbh6ITOOVdsyx/0rqPVP7gNvQBygRvRzYB/g7EVtaXjG7PsUtgZzA5fk+uKT+fVZTqvzBTl3C+ApCNIKxektYKJJXw6Ty7zeQKiGS+iJGZyPB5l0Y1dRnoDyipf0sPB3/PRlK35ZpGZjXDKbFYaZpGKWcbd4myJmWHs+As5dpiE9TanBsJeSiB+5yvKVqiZK/0H5ID0+Y6KlfJWPyY1QNe4rS8Z1GIqMxDeHSllaALYj8f+VvNhaVQ/NMTA0Bn8tamw6WA9kfm3X3a0ljS87bFm6W76Drp2K6nXLewk515yY07mtdDOHnEfEa25bdy6G4xMaqMtCN1J4xwuedlgg97565OCaEVIAnMymBU8ar5fVcvm81W1ZM2nL4X7Dd5ghqMkaA7dMvsCn5s3aCdmKY+IPU9IGGlOCxIQebvOYNpVybjQOGxMEamYuucp9FHDFJMHTXtuTVn00vXNATylR7bkScsYQNDc7KExxuGPUuapjbbP+Fd+4vq1d72J2q0W2vsGXT/YGhqrjSO6Km3lRtj+UXU6zkYDoNHawecAx61SAH1EZn59ysoyUJ0D2ZcxdRDCekKsrTHm0yrsVUWZ4NO5uJRzofVJL0ecl5GIo1msGb8191vANg27aqxaBaSAnJVuZvE7X1AmGxNqedKEWnE5aRnyysbu7uhQ5/KymsvJhCo+GoGcqw6H89klUPa/m+R78bq4Ksvk3pesWcgNYVFHSp80d/UNbACLaYxmtok/A3l+YSxgISGUtqwLWje3rkUvNZnQdaTilhvL4ZD7a0OMa0DPl9a6kPvLZ/WlKXyElqULdUOz3JlFN0FNFq/KLHmT3IjcT1h3qsyurWegY9syIMSDjEudYyHqJ1/RUMC+puhChML6ISnHkLjKykoZMtzpypDCqj7VY/QEDQ6pJ3BINGZUGaMqywp3LQJNF8Q8kXAwDxBfkwkPp+uT39+iiaDgLeWVT+mEhOj9Uh9Nj5J4rp5w1RrNUK9QTHhTpoUloZ9wvwrQ8j+Z0Z9VWlKrp7bkJgF4jFYtkdZYEHMknYSz5t0MpD5QTmLKVO9QHWEFZRoGIv3iftGCdp1KnBw0VIYAXg1kpMcjcy27Q2p64/BYK3eKxgQ3oPkucFlJN49eTFVP3Zc4ZjntaFMOGtCgeWtlOxxOZrE2Nmc1oTeaYJKVLmJjhIpBdVc9yiBL5wromjvrvGQqKhekptckJM7WAkJLh2o5k9dEIyBkghq7xn/BLxftObKODDB5Xd8GMkVD60AxbAgE8lLmlVO2nc4k0BF30gEcr9CJ5Rnmcq7QeFgzYWu+dJGg+cKj2HDca81xr/yUL2FB+GVDLFJzppo2Uhd9qG4ztdz4wvW50OvpcKjNu9pLRfS9cxbipPeNOOl9M9AUzzupuNj5lLKcD2RnkvuS+4RMUqD6xjX24gd/dkgP1UEeCnpB6UJG0xg2podlUgkyIyH+pySUfDa/KUXy4xxTKkgpDoepmyghEV0HMp5HPnymP7McOl3MkJpki2n40i1WQLE8mkwGxtMUNyWMnzs0dCywsWQhw4JVPCqZnTYJc3Y4TGvIoVeQ6B74jHnFJL8KMrPpV9EsZjkdhusllwsZQhbbJ0ubrvL86eU6l60DMNP+6QeJpwD0sVr3lLDoHMOVTDx1DBezL+V2K4vv9fkl3Z26p2H/zIOhJ53utdxpPlZrz4/VyA/DVT85vpqK90apg1/VFmNsoW7+lejBn0lkAq6Ag6h5jI5vZ1tbS1vy4Zl77NGo30eeezALFT4ol6Bpz8RIwSOSeDSypqfM21eiLkm8rKiUSNIzqtWpaIFtKxWM2sWxr3anAvWkvfmAIc+HQS5aglVXiV7LH74xJrWIaWO6YGsdg2PHl8Yhu8Vur5k2igDt31vd0S5mK97LV2oTi+JroK4N8zq95Dvtuc+XsCvvankEJ07z3cbcYufN9GVFWcr3rqKqiJmf85XVOtfsIB4XqEk5bAkVH81YwjNH+1IdDk3BKInR/anio2mYTXjri5Ur8OBtxte1s/q8GrbZZZSljl4UVRwZEKJqQJesWMYqKIA5Wopk4ZiEn1Ao741CFWro65Ur1Cs7ebR6GbUzfsXJvtAhB1OMybEoQ2GWIV28CZYbsfzxLb76glVM0nBglcrOmWTR18iY88LOGVxUxsDaQZTythlqcz1ZeoVU3m2mNlmBsYuyIkUNd+qZ4zxsaAXMjujo8VbeVp+iR9RGRN6zVqrxGCOrdHV+4/HFpSpNbBOF88Hu7Q6QrfwbqvQacorqg4e+ogaDVv4II+Z4teugXHlXRiPfPxUVTWPtpAGvDsn4GKzrc89b3DY8E/mx8vwZ9RJPx3z0ajLhlWIlSlEsdeQoKKc2SW0zcc+DJ2kKUyFxbswZb1l6/kuKRPJX779542loQqYb0RRIdHacW08W+b0nC2GOhQNCAvjuezvJCcr5jN1LU8vaopVye+TqVJSUWnQq3HnXa101x2ZMh3tCq+OXhOeGWi4uh0MnhoegRtOfasdC6OKOT4G3RSudQ87Y2tLaYsCYwVbA5u571latl1VAZDNq/MLXR5bz6Ty/XM7zyYRunMaiPB42iG3YjrI9ErHdKYPYhu2AiK0gR3KKiO2eImLOCA2l2p22kg1ltlazLd+0jZy7Cd9StptM5ivXWrVng1CN1jFfHbukD08fW15k0FXhk2ZfR6RyzkvVzJZzSPnjkpCVhzubeBSzXY0z8wH+Y2fJiDDESZSL5tHP2ZqGubMUF5a96Ma26JwKZ7n1TRigUH7q+Cpo9LeSW6blM7/SfEHWqM1wbQDWmVCC72EB+jnTXKPkIMsBGg+YW7RLgpwXqGZbW5mgjArX9q9tFw0AxmN/BHO4BmnPPBgOCkhqk5b8NrnDA3uGxA/VS+e9nidsNKNHt70O8NkT5B090d6KG3Rt13tEy5KYUspQPq5rBOxzj3fnlLlzC40/e3KPzCWyA2vgeVwo61PD9mpAct5agXp51Kf73BB0wmoDu2yBdY47FZCmPiuoKzmyX0zpfMDliI/KSIcMciMAx2zNTZtsx1VPubrvng4V1DkntmoUEPuT2soNtzFQOUZMu9+JBZ5BJKF+Y1uuGlF2ZTQBsOj3fA+stzo1uSY2cKkIIFzK/XY9Vq+4rccz8J3XcKmjH8eLl2HKbBpUT/XyBc57h5x37sJK/M5PKFsBM5o2EMlPQQRPeBwO+ZA+9ltZCKrj4NiOpnVHz2cojyy1yBiIZLlx8rWO9DvFRWx1mJwQDFPk6pZUJ5ZUFLNin+cY6dBGaaZa+e6kGMOxrElfNZlQGVWxPtu+siPQ55z6Gg3I686GKev21balxTC/4NtoYP5ievnyo81FK1bEndm3LW3Y7NJ6qVAjbM3mVZOIw/tYC5Oq28akqqeuUd4UbEt7mZquHGv22pkSe1CnP13ALfCeVWjlzLEez8mR62nsjQcHjPymHXMVGzYeJjeNZvGJ6WAvOefwvTUpeDp9KfyKzejgKjyf0Ua2ODXVp2eweDYS/H9nrufa/NNafu25bqa514vxWCfpUOnoCXsVZJVu2P1EF+5b6L44Y4umuPxYz3lE0hpKvfWerfzWku9bJ/VXdyCXU6ReGap4/QLpQDSLqaPpTVxNr9FhtzBxCiVYFk1jo/9NSVj09L4FTztKX62HTRezcNrR6xbcT919oqPELfj0+BGMqIdaPIeqaeiT45P7mo5SB/vaqa2/Hb4PAwhuYW9HbkP1wgVR9pH2VrIUXxUptvlkVpPpyGb0yGyTDT+TN54mS1ayNc8ti4Fi3pStIMkGyEd3vQ3PG3MICED2LEve1WCgl1Me9C4fGI976JvXwrbWYWqOND8RcqB3FcUKUX/Jc+vKtjw/n9MBL/z+Kfg1W7El7uW7aBnzUjtIuNZqrBelN1ELgcxJcaRknNGPiFJYvT6Uu8XDuDDiim+iEhEz0U8zhD/nPGEa3PpqhfFYRBXqD6Mq1nqjrZWvoryRBpA1O1e9pDlSbV/yi6ION4AAgZl5f78Tfm492SsKUMntybMqXviSkxspc5E4vnBvTI7IzRov/P1iFp7P6Gf+iQzns3AGol+TBqRpKCvI4XngXsywaL/6AtkU+NmzJfytafgm0kZyoyCY+PsFQRs0xl4nNHZKSV2qYjmloZ/x/UKEiqV8v1ChGAqcuFqs/AyKpLrRClocyLcDsMFPP3fdv0TtyyQ/1T9cc6p+wA7WE14cP2XmjyAc9tZVS9KrQ/PpiDxksnFOa3XPMx+PrK2c/ai15hOEv1oDORQsrf52ZI7QdsJc5uy0FGbSEq45ujz4rdPI5joUpU+LdmkWJNYjajyCF/6AjrrsBjmrVSejGaVhvTUvbPA5wSRrbRgDNR7ZCCMfNjEWxUfLnO7FkR6Zbvy0AttqmrWd++OSXUINl9o4FAP3IVqBF+wGO+QlVTFlnFxkQUJC5m3Nq7XbI4ax7saKZ1KeiB4khqMHCcrEM/b3pL1ti+62LZj8aEV2404+krXO1J7ApLMeSjoel35i5lLfoDOw+Qw4KujtR7RiQgxZqc9nHeO1I6HOB+D/NIQ+spydXWjY1wt3PxXrX6vfxYI6kEj/TqmLH1IbVYeaCPYaXIbsdlrKVr4AclCT2OlccN+qkWtayBQ3Bhkncd7b6bkJVoNRvIINRq1B37Q6KEATQ99w1ZiLstnfofTSb/mY9b6bHp6jKUNelnWPZ0fNXzTBddIA76Zg5Pp6+mJ2fT11AsyZbxUjTWQ5zMEkV3/DGrJGp249xaQ9FaHVpRmqSzUHlFXfJt+iWpMu4PdwmIYIga9zmSj9ATiBxOSUmFP2c8o6p85X0RE3RZozrPUn5FFQwDNHB+0xlmQ89qsJJ4Ql8EtZdZm0gJ5cVg3Qa3xpIddVtRz2I+jgmD50aXkfaU/2l/HhIOt4CPWBSzyeLPQhtGrR58eqRYW8VXheHQ7nsxDzq6fzAxTC6nCYmS3GDMC4RTgpeFPM/4tjwj4+e0QGAu0xKR02BUhN9ySIJTXqUixmobhU0OoUSQxkxt1qyDu0OT7KxaKOdBMCrpYTNddaual1Fk4mXATLTVK+kqm4AtndegR/lnRRSbOvQ6gElOYU6IsG9IkFPTCRyVOgLwaAWSwKg05FD51O5UfgFzBVvl5oAu1uWvaygDS2LmrWqdJiXiePMnnEucVEDZFBTPx/GCRFFxufBIgB4N8aSNS5aIPkxLZ5rtBfQXMRYScedzogjGN7Ojue6xd4pUXnzMsZOZvAh8kZ8fRVFxihIJdJKlJvK0vhqU1SeLJYitEZMAPmcrzIPjVxKY5sk1S9DjaDMCeomqitQjvhF6ts3Xpxbt2IVHw4jGa4eJvBDHv88m6fHBdfWQe3QOVBPQiFISEQfuWpSBcD4S1KNLt27t045YWMnkUYDjfhVbeDsAM5bv4J9rLWbziTV7ldxj5L3arRRXbDA+uPlNXlTB9bOWX3K7B2zecmfHA7l1M9dVDS3ktyYm5EExSY/EAmMnBuYDwZGPDbZCsY8a+v303ohNAJeUFYgjqkt2L91d3OrxhZE+BDQDCoY3popk6zMe3gu2jbKNrHK4rGAR74aYV8dAF8smbXEozMYuEve3iPeesRJIy8mBFKWxjSQthenIKhyMkZLzpQT21IgsIGOsl7eKLoPMe4hnmQpUzy0Yw1GvKE1ZVWOr6JfY2K3qgSylKMwNBo7EbleFy4azOJDwe/k6J9C9tBpTuXm9RXzYjB2yxytLLqaDhT1pwO/ZRLt8gkmdQEjaD1ushA4MyBp8c4modD0/SoaVoHq8A7pz6l8Vo70m/YRL8QKEzglS7peCy7YeeamEdk4pcLkrUGE+oA7fhM3QZa6pzqueGQ2rj5sQgaQ9iZ814gPbbkUYyqYhDP1OEw6iIBJvVujhiPRy0DPGz7gMGZg3eDtx8Jylo30qT6Vpm3IknvKUs7+Kx9Qgba11ElTWZ+FaxLsfNtAjtxXKleUaIpC9uFOlJWcYyRalIPhyimjXK94R7rqJjO2k0iibS9CLJ04RfBrsxkmal7XGP1C59NKVtGMuYFDfOB3Rxqwd08qTxRGHfjm71C9zuzxS9lKtAruZBKO+npDX90pvXmyyEduHUAqrtyqewjMFLNB4BE82kazhAsteMOy5+7qlAlBStCtVeEsyBgTzCjqlDebUEY4GQA2iFBwA8xSGeaYj7rTLqLYifuLBrEsFl91Z9gJE8qM+eQLVviFQDOdX82TOyTlwKheyVBt50BMlYeDujgCOuo4N2lwE5TnpHWoj5JfgqH/GC0+GJBKjy32KJGGdKZ2VSrmJvLDZ4mN6cO2WO/zH4dxRgPtzMo7S9SoL8IBseqd7YkxmvCAAN8FTmnPIAd1jZwxf22jtkct2WEhmrA+9CwFhfRdcXiyYVeMwVX7XVeIBZW/S0akJJNL4FONJQEUllmiQk1UevL8dgm1lb9hM0ow+BeGrAYLk6T42zYJgBbylt9tEQvH3Sv0svnzCNnE1UTDWzpDPhG43klWGa7ZRlAapM0ix7JOMbQddkp5NW5tVcX6kVB6GmfdjmNGQNYYQm0gwrINNkAcVH77Dp9gBp6/TJdsEYLenTk/EzTkYoXmo7UWHQ4OMeDgIYk9HKK3opR2sGvzs0esF9Y3EJvRD3LC797HRLsoDOWU6a6N8MJhh9omPsARLvkBhSnxPPJxPctcUEJNNsKn56L5hlI6Lai5MhyuQ4dYuaiUFKu93gLAT2aG7BPaXf1ahUaIw+Hnqe8qO8ePJ9dmmcbhA/v+mqJseHgoXAvKzwnuKF5mEJZ1xYx7BDL8fJZG4bKMeVK5P2zla+0HZc3IRS9cl6rDY9MM3WopxwEQz+aDlARqG0hQl9wff2txuQFHqyo34J99S2aRL/GJsLGtnU4KLroB1vHe4jx2tL+t+ZiYtTrnigakOa6id6lxbXWHbUcp1po32DMyDlKQMyoxrU6V9TakNIGyRM0LI3CHSbuS6hrAIm9WtOyaLXq9M4NRznYQ9szQmmId8kK9EvtXHPw3MDL3Ewfml3wsAxQGx1WTVOFHXWwqtYkInJBcsaFDStpNousziNr3/6Ulwzjw2RAfIJS3uqITTO25EXtPgWvFkX2GGjNhz8exbUdD15N5ZNZ7UnUKhNVMX1Y80r74aDlYQdME/RPX8LB+RqZ0Eo0gRcX7dfO/QVrGl75BR262mCNgYvSeXWZTnIbCsfpUaVHUelRrDCFJXw9Ty7XkyXu8CsQNMkdOdrDLWhG1nNZWxf3bGUNFfWTsTc7GZ/YdkZTVoBU0+wIyp0mpBMyRiEZHqzba/MMtJ3Sh4SPjMPUMdHBkZPlZsjFhNfHRsyJCnuHxLywNLJE2b0Idvtq45f02Eh95hzP6wLvdPVk6WXFUpalWCpPO1p4clVHk/LMaSkysQFLZAkMHpl74m6HMXzr4KQgPuiYOGTiBqQCat2+rPb0UfzTeo7+pb4y15IAqmsyqy7Muk68dUYK36yrOCok1Obr7E6k4zHg1JNttCTEjF/5Z5f1x+5tEHU63gZBmWoqxhZ9lP4kphoXqvqoYn3mx+lB+CGrstY1VoaJrRDFHcOi1E7PdSMX6rOZ+Dnw1hez6YT8jLCsPk5w5RPo/C+Jvn2GYAmCMUFtV3elwLwZsOYmFsETi4BgbApfWOtGYW/GlXw0au5eB5xsbtplFV4v5Rft67z1ATgb1K/QclFJD4deWkf1ukgmbvYTuULpBI2VURlDMfgP3VuHlfkA/yHxMGou3iVsr4YqJ+QcPdYSXic7WkdyfV3pPH50fX17Hk8ooTSaxXp4dahX3GycmGUDkIa1oWM2G284yzKow6HY57lrAVG4WZ5cSRVAxR6QTnnRieMGE9S70cHe+jJ8DQRGpTnDddiCXt0luUAYqigz595SqullgvQFaGSPAUzG47Q+DJfooE2p7kNCO3dLeSpKYmAOGmedE05CtW2hMSKwiuNhnME7behcLZoRlePx9FL2nX59fa96OzhRzZeM8Pa89m1SQ/7hDhNk7vsavNuHNe3TI54NBMBUvTihOwsJDPolIdG5hhn4QFPQjSD1rLJH5h47GrKQWrRjZXMgrH3n8pSp4AadZAYIqavDqJIPYnuPjBm5AHKKUYBSoZLlZqHMg99EgjHOGRK7YGa1rj84VTGdqyArKlGqqxXMogQ4252i2/fZ0egUobYv2jDQAqRjo7I03omHV3ca2IykTJZKlF/9bp99SHK8OvEhCcnjf/L4Hz/+p4//2eN//tM/fPwvCLsKyeO/8PjPP/6Lj//S4z/46R88/kPCliF5/C9/+kc//Tlhr0Ly+I9++pd/+jPCREge/+vH/+rxv3n8b3/6pz/9Y8K+Csnjv/r4rzz+a4//+k//5Kd/n7AsJI///eN/99NfPf7F41/+9D8Q9jokj3/++Gc//dXjv/H4bxImQ/L4Pz3+j4//8+P/8vi//uHPCfsuJI//9uO/9fjvPP67j//eH/6MsKoKyeN/RNi7dyH5P//6PyRsH5LHv3783x7/98f/4w9/Sdj3IXn8J4//+PGfPv4Hf/gLAhI8gvlquexcDdmEyycRQcvcEEBMAEX76S2w7HojRhGv+wkXFH84MkmHDmD7JdB6FbPBcpGKXcsRicgEMk9ITNCIBMJ6u5ibvYR89Ih+ze1ctYhEbbdP9K4tQA33kDmBxcSRdS7nfyLQETF037MHebyanHiNF7xVlToXUOLp5e7loGgna81E/2ymaxrCAM5OaACOIdutb3FtSUTr5XOCahh2Ta+0s4manBFPWx1RZ12KpVwX2e9FHTTDc7ZQzrnEyQD+18OwhJK3+jAeD/UsKmPHWKZQqzMetwcluxr3Z3Yf9p/yqUF87PxmjQmmgmrohvqqduhVshwIv+JaY9u0jFVctg5iyv7VecAI9azq1l5tAmbRj90gNx6X+rY12bueDhVLeKvu0M7jcjxtu5L2m20p2Zy7cyWrsKC5oQdHW3DLmEMnat4chZGaYe+MAKOK9DfU9g1gSfsGMJY27aykVLod75e98JXmhKd2Z1bIRTR4SPaZ2oitICyx2mFYfD1VZvteaBbZgjFlz8j9e3FTJiSmID91AqxkzjncP/JOfTeOR/t6/b/1O/VdXHjygv0J+VHc70pRaaKJLsKecdz1utc4D9zH34oJCoS0VprjiRSX1yH0eXf2s04A0rSNc5GLdMzBNmbDwtoYsKwVmrATLpa1z8DGbnjRXti9mkPtR23Tsf1ORYxT5V7g9Zaate9caWzgoU8rp/K2sFNkJ+XjEH8amOhO75BCoAJVoOR6nbcWMU6UWcD4jB8nxHOZSvwIy4npVdrMdh0F1XatuYCg5wjBRzOWCsR3uz2gP/uQI0Q5Hps4JXprSE5sDU43m1BTum8pocfjcY6ctZOtsYs4zpIgzbT1UuZg2VP3irPedXZ0LoauGh8N3DVuffjf61NR5hJvEDEhc637Wri59KXkAk9RNJeUCzwMcZsVqbwNDHDG4/Y7AOtw8N/AP96Ja9KBn3SycHd/5y3VGm9FNuc9OM0urZWk1pHYhNA+RdN4rjsR6T9rMnrAEAnAUAWFvPUp2mPE8Wg8IHTsA/9B3ytW37XCsirsy6bWSNH/RsiRAYaF+qKfodrxw3DF037FLfWlMdo65iBRRzYtnfteFtqzbpnkwlHfhIIywUstCONH2B6SUqDq3xU1fKEz94eiCUEhC9T884voh+tbFnjX5z6NL9b9sWK29mAb0wMI82iXQFPbEwN/Ezg2Ib8+bNAlT3WvGCGUKepAiWj9rLP4F2UorKWkMRc9B4ahBUsdV8eBzHJflqJY3hubCL+IJtfnLPA0cNp53otK8Ysfrv3FdTqJrvfTafLzF9f7l9NkiS+f4+8v8PflIogPz8hznU6u6eLFRX8m6lZPTUZziKNnyWqPCcE7NJZaVPv/yVTuy/zXpVRyKXMzU/5GqV21OKzU7rDKckHD64vri4uhEnb+h8v4t7e31wFdDMzTvsxPr5fBnj0B92Z2LYR6sO73WlvyTq7+NKk2/OLcweiskkBXAZnTh8+P0fXF9Xl8nT7M2Mv2y8B4TdmPjdlke3KsOqK5WNSC88Bgoe+MXOD47IECN/Dbl3jrdVZ9nRWZEtpfxdryn8SXnSiBePKLnzmQMYkagfzr9Lr6bPGzw8/g7zqlA+AwBT4GDqfexohcm0IvZ784sQYtNrSXYR9Opn6z/k6MuzuT22Tdncf68Et9yuBSGvEn266tcWiwsyCZ1h6BfklbxZAlbhb6dg1s8+FAklzhRabH+k6M0fQkDivRrNHrd9SPrn4df0NfXGTNcq7eyGINCAFgnvGLH6Kr838ufvg5m02P18HiupoYtGbw7CPuU79JDq/Th5dHX//RBXzRrSyARJ9q6KVeRlABFmiaxIo/P2LJLtWwVXyUeLRH1Rz8O9Gbv/mKU43vwouZ9+Ll/x2Lr9qA8GX6zC/8HxzqU8Xdl8+P9OA3tKqfAV7oRb/2Zlu/Pg+Yu6nbLH/v7/0prnRdh65x4PXzwfr/9JsvdeHPnyhqO7eUxQeB+shtoro3iZzczh0prv+tM1AklYxst2l6f39/T/Qx8AFXFXf4jLz4+cWL2QXMdEjSdLv91LIvL17MoCyUg7bN6SH/ydJ/+s2XgGBY+ufE3NNY8gY1LRtRPoFzJV2UDs4BO99bbLbJT+elPh34gxj+JKvVHDc0B9Wt4IBqxo6RUsas4IlVICba8ktg2WrEIkgb6rD6CesGB1GssjH7JWWdklXQvNvbMJ9qjRW0i9q+YAWS9UZ1f5IQqGwranZtuohm538SH2bR9PxlTEM/mp7/Ir5OgUYbcn/4wfcXYTSdxdfpIXoZR9Pzz2Ma1hldKg01f5Oo5YZfPKPmw5P1DpFwqP9jxLse3TOIco2B2rzeGwRlraXBJAdpsg6XtlAYlH46DadT7+obgpe9NFSevJxOpxfT2cV0hobO59F8Wc9/9cT6q+jC/4T1OaULxwJcNV+0TcBxwwyrj24lnQmp3S2erwRo2cyVq/7X6oRvTI2odxlK1HeWoIGbzF3up3H9iKr4VOeNhh47rxXOrD4nEP7J9MnedgIsOqY55cb+ZiTDG6CVE00Zr3JSAzc4mYDJp+5wVf07XLVfn45EunYjkZqYhSznvuRrHZDUrbds3H1Qp666oS5p47qMd63iJat4M/nv8GZpvaAqNNrUB93o4ZBMJkAdf/aS82lHJVkGCOKoWMzCqXu5VvNhGs5iWjvoD5PokRsN2wEry3hd0eEQEfFBFIQRmaYkpo0+ed5EMyta0e564VkFK2CoFbpFU9eo3roJCxCpU04HRz0e6VGHAXEUnUc6/78CAAD//7yGJhlTrQAA",
+		Contents: "H4sIAAAAAAAC/9x9aZMjOXbYX2EherMSQ1QW2TurCCULy6jpnontcM/MurtXu2sWR5HFBFm5k0xwEmBX1RYZ4UOyfEh2hK+wJIdvh29JPiMs+1vN/hD/E8d7ADKRB6uqV3LI4S9kJhLnw8PDw7twtNwWC53JIhT0jrgXwrm+3Qi5HKRimRUiCMx/lKzTqXkMZ+Rn32xFeUvmTNCYyMufiYWuC65lus1FEBz4EImbjSy1mjZfuQhL8c02K0Xoqqc0FuHP/jw8033od7cUelsWgyrpnN6RrRIDpctsocnkfVIOXvPzSCeXuVCy1KLkd+9FqTJZxOR59N1xNCZsk5RKlCqezdl1lq6ExsdULJNtrlV8p6/EWsTEJhDIpK8+y25EGh+NmbqS1z8s5UIolRUrSLkSSSrKd2K9yRMtYnK3kIUWhd4TJos3ovA/Fts8r1J/gAVN2iIpFiJ/K3KBQ4uPRkwnl6+KVNzAc5po8Zks14mOyXqdpre3t7eEwRA/3+Y6eytL/efEbUzUVbaEJ/PtjVDCpC90mWPyVn2xXV+K0lYGVYs8uX1VZBpHJ8r3onybpQKqtAMsEZaQ1wxVxXd7lq0KWYoXiRLwATJ8JsuFHSG8vs6UBsDC8/lmI4q0/vYWZwgblKWGxrMk/7IEaJBELUzvX8tFkosXcr1JyiovDsl70UmJr2K90bfvZEwupdZyTRjgRLGClHVyQ1i63eTZItHi7SYx0BU3+tMbXSYG3uQyUdmCYPK51mV2uYW5TBOdnECa+fIWccqMo0A4+ilZkekfW4w6GlnkepEnSsXEvJzcFcla7ImPeObxyw10Q8V3PxeXZRLPiHgvCsKITFMy32PVWZJnPxcWighAWzdhC6XO1cI+vRTV4xeyEPbRIpv/8kZe23cPoU3Ci6ssT/G7t5ZOFjYVs7wqlvKTXC6+bubJiqX8sshvbfsAn2aGAtNMFYhDnWYyl2wyLWTR+r6QRfXJGyK81mCAt5ei+ZopqCaFpI3MCi3KF3kGI1jAX5X4Ul4XMVnLrRKpvC6q9B9tbOp2Q5jCtSrtKlYx+f5Aw/oY6Cs2qJ7TOqMFxRVrpL4Ra/lexCQq8eFkLQhLxeXWIy1uJQGOZwJXn8FufPTI2SqXl4gk8Yhl6xXgcTzaw+jjO7PifDgaTEjMXDchfJVUGEDY4jFckPmqlNtN67tNJXYQrQYwzX3rVF4luxyvir4MJ1lRQC3ZARTZeGjtf63TDaVBpOlWf+4o0UvRn+GlcDkMFnZz/KhAApGSPcuTYrVNViK+q5o8VwtRpFmxGkDSINls8kykbOC3+lI8mMk0/IXsr6HCd2Wo+SBTg9QmElYArYM2gAa+T7QYaIlV3A6SYpA02jW5X4oD2Qep6MluOudnN0gOi8Nk27NSrMRNfKftDvnC7J3x6YXbRi/2pyvmPiMxOL24g/ltfPgiWQv4APT1Yn+aMbVJFkLFpxdqeLpihSx+LMs0Pr348emKLWW5/jQXa1FoFZ+GWbHZ6p1ZkLvLrday2AG1T0qR0NOMLa62xdfxafhVOBtenMyn4TS+SD+i8BfBAyTMxKdz8/UiHdIpnT7bfTW6mY1OfjU5Wc6Hz3aQfLqylUGrX11cjHYXF6Nn9JRdiZv4tJEfmpXrdRKfstMVS7NVBtD80dv4dHahdhfRvEoVq0Rn78U7oXR8+tWF+uginEUX6Xx4QU+bWd6ITZ4shMtls9Eqn61idnEyDHEcMzqfPrMfq8IzFh2TCzU/XTkyFN+tk5t4zNZZEZ/YrRie3WNyA8k/F6WMRzATQJ4I7GIkHjEtN7BZmm07Phrv2dfi9oVMgc4JILrx+Lt75H8M21EoDazS50JfyRSTlNDbTVwxhZqV9C5bhjoIdKSBOgfB6IhzHelPZJoJFeWiWOmrIDgyyVeJelVvr/QOuEjBJCeEKX4easoSfh6thU6AG5i0C/CjMdNR5u2hHNiLaCGLZbbiJTuPoFyoWZP4lpS9jpDShyUjC1kKQoMgXMhCyVzM7H+ERHRK8I/EJJcrMg9J1T4sOK/awXsyfB1Zlpd6TSOjJN+LMslzna2hA4W4HrxMtKCUlZHabpAff5no5Etk4HkYCn4eLYvI8OWR2uSZDklEKJ2N5hz3nVeFDsVsNGfjEWXjM3jc7cacc3gKgo/P/GxjzAatWW6NV0+Rlq/ltSiBnwyxP5Z94/VjJ0+eKM3vfF4Tt3GRGrb5ZLxnpx5sLk5OIy2UDlWUaF2GZAHsE6F0twthto84LyNk/qfEh+kJGdr0mBBoF2gMkpep98xJRIbee1SaBRO+jpDERZYEMUJo3Czno8Xw80RfRWVSpHId0kjLtzj6cPwrNFJ5thDhc+gCFuGaldEz86iiJE2RHwxfRwulTI4hGWDnK2ZxKG1S3U9T65hSC5VS5oIwsiqzFEf7zDL9XEXLrEjDMmpxPrTqRYRMQSmKkNaPRJekVXcpr03V+hJXJFd+9kuZ3saF1CEC1Oc0h4S6qu5IUmbJSZ69FyQmG5lnGmrGxFLk4n1SaBKTJM/Jvq+DZJEgx01oRQ7CUPKHMs5GcxplKWBLlKW8D4bDOnfdphm66W5yKfJcpJe3hEEluBjMAQBWNL/bw9w2Tia8VU91IjkRVR5Cd7t2ud3OHWleR5fbLE/tbIVId5bZzQuZb9fFj+FwCzTuNSCQOb18Vsq1QSWTDlv8j/1TiklHuvtDw31CrWWkpU7yN/Ja8RErDW0LgtfR+yTPgIC70pi3OnbudraDL5LFlTDdu8yK9NP3sD2H2kNBdjRyXy39D8t+4hUE72WWDoDAK0MDaQQrLM+UnpaRoxndj3ESBKoi9iFtvlXZgiBs1NKTpQW6UGPvR2d1OYt509CQsrq+OguAWZb6yyIsmZd6t2dHZeSdNymNQ5wRO8svlAWNlycIOh0aG9rfEGnAiLcFwDhEtvETscqKBskYEmTePi3SRjKNfolCzJfvLHKRlO+ytZBbHZYR7lN1xwCg/jYLWOg1BptOBCKnIOiW5UpoV3HVIr1rVwhTtGffG40o3VPW3elHnZ1+/NAmHuVyFZIvza47qM7wCbQ+gB7GsFtfimJxtU7Kr8OHtmtKe/mFBpvwaZF2U0JKmYp0ma1WovxBUqS5KMPWkb0aImEA1K5UsIy8TEHQeA013YtciUEfJNownLruXSdlEZK3Wm42eDBpACcavPN4mqtEDZK8FEl6O7gUohj4Haaxq1GUpSwPVnk0+EKaXZ3Zs7ksB7jXwJjpnnlUpWYmS8sOVlSYaZ/wA5dYSaUG2w1QOfsHZNA+nue5fbKrc5Ckqff9hagygLQrF9qcjmQBxytRGMrocuCzt45fpf6bGpRiWQp15V5ToXQpbwdWbmH+c5G8FwPiWLoBodHPZFaEGrgDOhGOAMgOF4PDVgyy+esdAUCG2lvOTNM7ESktNz8s5SZZ4SyE1FI0LBDqq0xZRrlBCESUqXMYVFas7ECmh1awT9IEIzCbezYejWjc/43uXcer2Wl3nJWHul4VaXQdCrRqJUOAZY0KH9rEY/VbTHoqyBuFmnW3awZs7PaWyYerhlLtPjNZ120x/kMqtkUeqrW5aBqV0zssl6kfQR5Dq/c+ysriQzpjd+GHRlgv1adPtleoUXcJZ7dMfeYq0TQIzHLpDP7DGvQKHUaxBnV5Koo1CmHdPm55X9UvUaOp72jsV9kkdE8HQbOc7ak/fCOkMt8/pNq6VE+llg4/vT5boLd/QAvla5mkb3XSwnvx9P4djYADRK2Z5ueRuNGiSMOjEcNjiCyzVVYk+VuhYfkoOgnLdq7XkdWSwVzTThGuGeJYV2BiTw+mHzC0Pat5/rgNIOihZBkHgUQozEHM4q3imbcdpxzEShMU7JTIfVSnYuUd0n4dj06/bk8VhLJQ8tcRKmukEkpXshrY5NzxkLw7/+T1p8BmytloHhUyRaFjEMDrEeciCM5DiX17qFFXL5M8zCJP4YAH8yrlR5vmO+opLA89+FrcbjewaT+wO3f2doV7O9PmFJ9FvloCuxzCp1yLsv2RVuyAxUDZWr4wPyWTTIGkLBSRTsqV0JQVHMZguHJofZItw6NwfMR5KKLrq2xxtduJyEhcaRAcFdE60YursHfkOzP2XTX63Y64Z855EQS2ziPOX0dOiBihDHG3e7BqQr3zYtWz3iJmXmgQpJjVjNSJEikKHnvLuRmm1Cqx06o0g6ZDMoZRhIon/fK2IFCz8fzsYxjmpgTtoH5p1l5IKQDW4D4rm5h8bjYNBtIpIxgyWE+ZwxlfGG7kY65jFY7T3S6BRWyQOosqzR7d7UZnSQQKWjirQyP+V7t2QDhRlSb20LFMciVIuwKDC5VQxgLrKIta+vFJVgsQ4FybqU9BkGgO/9BD2OBoEDRECxllmTlp+3JCXvqSlQVKRYxAJYPPIVKHzMkgsIhIZz0VzWHtB8GRjHzdC/auyFB1HGZMMkH3e8o6IwJptenHtjCrD4qDBrggdtkRk45nQwKkG0AdoglEeWK+kZgUshCEfS5//iMlSlO9TUQq64mCajpbSb1zILiTpSzDNKqVjxzsI+y7VdCYJBjoryWlgjd3+kv90x+vhMwsjQxsFX8dLeR6swXGDRIQeGHalbzhMY0N9FJKTdoSRcpynkZWsTs9PssGKM7l5HgYVumcc4P0oC+a1o9xlQOpbP2BDo/J989Os+8fg444rYWf5+F5tE42dTeRiqZdWehBysgKluH2hVSwsUgzhkOqF4npnlODO5pxGuqrnU7paebWqbdAw4J36jSqZkC2LoazoicRhAyOgGAKSNTC1AiQKxRQzMiyWigx03OeRVd6nYeUEaBmLoMzf9ntMgO3NjV6VdS0Ikw65Tr7XEtbyFyz9GBOmGvWaR2n3InhCIlzGFTbaCcIQsm7yYY9DTM20yyZUxoExGgqaqmJDIIw4ZK6/h2fpdn7Gk+bEADMOx4mQ3J2mmbvvw8YXjdq0CsI2il+J1LmkHNOmaoVL32zT1FhI+yS5IpZ7EEbnBAfceozVhoZm2+jA9Qxjdqp1KMHMzXndwu5LTRo/mSJZk7TtD75T2djNmLP5zH8z+PGlxEb45cRG88ZiP1Fig04qx+RfnIbE7Jn1ZbtcL/uslcMens0BsIMjEcRBGGjn5GXFQR8zY/YdR80BYWuj7F7ozllPpmEBSBYVrN//gQjocF1/YNqA+4hAb1l38jrdnEgC31KFVyrSFGDwE28Ti5xKyMM5nxPaU3Y7HYG9Lvko0l5VlHpSTkc0vbG6gGnhL2umcDv9pQJXlWuHD95PPNwDzC/HB6T+XG3H1CJcOsRyBvwP02G4ZGvtkUSw/ZMaCw6KechnTxGxa2OSS3kRsRkIXPCAMr4uF0Xps9kXx+oTcEwpb3bYEMc/Mk2y/XAWdI1BcCaUuZnrkEJEsokTV+11N/+sa86mwHD0cgGPAfz1TXxg7sUS1nOFmzFNmzLluyKrbmwW8AlH7Fb7sYo3BjZNb/bw74map2e6NnQfdWe6Kr22AhY4CseLrlZ25xzPa3rjCuWsmKjb1sC5R8nZQEmCYOPEG+NxPfoo8EXUg+Q9wHJcDIwRlMDZBMJjQlBnuc2CMJtxbKwp6niXwoNHFyxspWqwVKWA5EsrgbVrsokv7PqOmlMtWqrrf3k8uxqgieHsOTL2eU8KuW1qkYKHSv4iCXckWvFMj6aZGfJJBsOTUE4TbSWUgELNK21m3lnX18zUe3oBWUbk8GgyCe3r1JvHwCMINUIYESrx3JbhTZgNCdmeSI71shkwEBgK7LWbbNizjsNw1QCHRfOXmC3C6vnT9B0ZOosP2Oi5QYO7k1zAWHtCHobMJ9MC87cYLdDy9F2PYsgCLtjJ4U8cWOhbLPbhRt+NKZstdth7hSRxBT4TJZmEkLBSnYyZgWlDFDvekZCMiyGhCKtB31uSOf8zlQcr6IsZdUcxJvpJspSx++bXsf+KI31TexDdk+ZjCzqARxWTEY1XkLKho3OQsVTEHEsZA7WsidjSg0WDrliyZCryehMDccT6tV1orq1QdqGqZOTSTEc7i+HvMrviPhVPN7DyNv7zDVtLesBaG/c+jLAFOlRrfyp1iiu96mV3rg1et2kqsQJjNNB2l68TXq89ejxwxo24YZWD5IJDxrcBw2ScoMMDQqOkjhkwJtwYpIfjZBCaT6a6LNyonFzdtDX8yhLm3iK2tB2InKyIPKTCHNXvN3cnMN+0UDwRjezZVgtaOGO6OPJod53Op4tw8f7Xlm+tBagI/x+DRPXhz3rXWhxV8oPfYIOu4NZB+QopszBCGzRs9+BjdcEzzh5EKwmNCy4ng2HyBSVZ98bTYsI2Xw4nBk5wpfL8HXzVHc2AgaK61k5jxYiz9VMzpml0lYg8w5IgGApk2iEBvzFIgiauHwlFl+junM5eJ/kWzG4TtQAl/5AFoNSXoO9z5AwuxvFAzKUw+N4QI6H+fCYHFMag24EZ2kyOuMnJ9kEpihUNVBmGQyMoCE8mlRkKZgJZMr8hjlzDELKkmqK1KSaqxa1xIoMjnkj7ch94aCsbW3EuHdIBpxFx9SFsIRrKzib6his9mzjnUOZmlrDGM658uR+oeSJ4ftM/ZUXAKXT80iX2TqUNLZPGrPY4ydIxwyxpnGP7ly54ipMwFTOgarszV6Jout92hVQUKTqS7cy+wXSD3SvXtVpD8BhXVR8lwwC15UmMsLMGKWB4oQMJTImDk/KOSu4T/dmpVudgyQIwiIIeiBUoBAy0dikewllNThskinu7bKc8yTKUji0J64A9sYvw0RUu6n0nM9VEISKqxaBoUztWS02PKCNOMgrs0t2y67ZDXvPXrBn7BV7WXHPb2pIsXddqvKnwEDnNeNc+oxzCWd9GA2YmImGodbRG7de3/1yvHSLiX4XBOHSk/uJjn1Ry+rmpREm8dEkPcvdnpECZ4s8OZxNJbfdn6VzflfAdBtfmNl8z654PkvnQQC/yDpXoqQRK/hoUpxdTYbDAmjamt8hMIEDL5NrLL6CBo7CBT8PqxqAefbEcSJqemvUNpNQ6cLP6PnOUGN3XCDv9IzLqO637eHJmIWXjQ+zZ/NZxebPafSslNf8Ev9QRbOgbIHi/5AeanW3q3K0hAmeuwdw+4uGqNWKCp/xS5Ps9kH7Ons2Bzjd8BF70TmIvMCDSLjlG7NRhHWhWTb3mOXU0pAty8AULrzGEh6jGQThzZBfsxdDfk0puxkOJyJXAjFhbaCxYGsrlikOdmc4zMy5aMsXs5HbXrFvN2dV9iAIj8L3vNoB3sxuIMe7IGiug4r1HCzltkjxfFfKa9hJi+bGmg2PJwNobLCQhU4ys3zI8fA83FJLhIfHZDJIpVCDTA+uwA4nsdKA6TEQuR6Su2U3lK2jMrme3cyBB+8F6Q1TlK0gR8LeBwEptmtRmn0uhKGhGg72StrhCm+hFFoer5ObEB+SSxUmoJ5h8HG3G1E7Y9vGuQBn5hUfTV6d8esJhb2g4Wq32wEtejVVsaO+R476trfxIOgf+vAVxR3eAmD4Cga4cg+vhsNJhTL7m+Fwv/JWEF+zxvLqWYRzvtpLKPB5csNvfeI47FuzeyTSj5E0yt4Z0DyzYM2K8HuOBhv1cate+kRxwyeO8GJViItk6HeaAG6qQQhdhHxk+MylZcUglys6SIoUC1koDYlF4dbpZ4mb7t3eLqsqP652GNwNH01uzp5NboZDqmbErgnAltB/RbmgnzDrCCuyeXXc7QXS7AYoyeRJpz31tDPbvsfA5qXhkAzvdYhDqk8Nzc2f99lNsiuORuggyWJrfmd2HSP+wW3oGZAL3Igue+wbrK6oeTq+tMsu5ZfVDu/IteKjiTpLJ8pOUQ7C8UsLUuWD1AmYWMJHk+QsnyRwNOPFLJkzGQRH2eyyWkZmA8pUKEHTtAU5+YpfTTO7EY5YlZXG2UzPWcb94myBmWHs+As5NpiE9dZeA3EnJRLfhJqyJUrmyvAOOSDj36FYKa/NY+IAusJtfcGgFluZhfAG5N6oAtgGQbg2fFg6cw81MbB0Bn+rVNh0sJ6Z/a++bKg7aQzWe8fSXXYNdKutxK1b2ElOBsml9cxroF3l4n5EjOS2NuuuMTJxSls4dCe1cbjkZY0NZud3noKoRUgi6xnMCj6rv5+rRf35XC1Y/eml8L/BG8zwLIlqp1tWvbwU/hu0M2cpn5HKn5AwUnsLEjjyto3BjKmSNaHx2JhipufU96cwroYpJveq9vyastlz3zYH8JTu2YInDWUAQXXzAJUxHn8Eeugro58Jz8PEt2tvWxM1Wy2M9Q2afrAVNGYz2qqMlhth+0vVWQ9I9YDGSAefAhy/SlAiUGTmV4e0LD3VeZB9PqceQlSWEKtKGfNhFXaqAjXTaCLO5EQYedJzMPiaiflsPDeM34qHDWDbhn0xlhMBaSBnpZ9ZfBMKkNlXKtTyoApVcTlsKPLKWu/uyVAn8kxN5HBINT86gnOu3u1OxmdA2X8NhDavivOyTG5DyeqFXBMWvaf0QXVXV8EGsBjNUc02DMEhT06tBgyc2GhDu2BkcyubhCK6FcjlfDOW3S4PV5YYO0BPFpV2IQ8XT+pLXXgPLUsf6pZm+TOLZoKGLJ6XWfIa3KfChLWnyu7aZgZaui0LQnRkXJgcU+GeQg0awkyFmk5FLCo7JO0pEpdZqbQlw62u9AmsnFePlRPUOKQfwCFRq1HlHEVZ1eGuQaDplNgnEvfmeSmqTPBIjOf3F6giKHhDeBVSOiQxWr841/RZMp/oB0y1jsYoVyiGvC7TwJI4THioItT8D8f0O8qc1NzUltwmAI/RqGVmJBbEuqSTeFy/24E4h3Iyp0x3nOoIaLBU5OwDpg1ou1Ti5aCxtgTwvCcj3e+Zr9ntE9Nbg0cn3Cm4aOOyJ/kucFlJP49ZTKoj7kuYaBnalL0KNGi+0rLtdgezVDo2bzWhNZpgkpU+YkNqv7jLjTLK0okGuubPOi+ZnpVT4ug1iYm3tcChpUW1vMmroxEQMkSJXW2/EJbT5hxVhgwweW3bBjJCRWtPMWwIDuSlzJVXtpnOJKXNSQdwvEAjlieoy7lG5aFjwlZ84SNB/QWkKIbjXhmOGyw3F7AgwrImFqn1qab1qYveqesM7EqFb3Nh1tNuF+a+lYroWudMxUHrG3HQ+qanKZ63UnGx8xHIHnuyMwmCdRDNpkD1rWns6VfheJfu1E7uCnpK6RTspmFjulskSpAxifE/JbHk48llKZKvJ5iiIAWECn6ihEQ0Hch4PgvhM/1OxaHT6RipSTYdxc/9YgUUy2fDYc946uK2hLVzh4b2BTaWTGVcMMVnJaumTcKc7XajOasnfCbaDp8gwpH8PMrspg+GvSyn/XA943IqY8hS9amiTed5/vBynciGA8yo6/0g0QvAuNX6XsKi5YYrmXjIDRezQ8AKWWBzwnbH9TTu+jxYetLqXsOc5rFaO3as9vzQX/WD47OFuqM0wa+cxhhb2LPa56lHQJAtweqaVjxGy7azKa2ljfPhse/2aMXvRwPfMQsFPnguQdWejZGCLpLoGunoKRtslXAlySArlBZJekyNOBU1sE2hghW7ePrV9lSgnLQzHzDkST/IReNg1Raiu/NHaJVJDWJaqy7YysTgAHm1MchusNsrZpQiQPu3lexoM2dL3slXGhWL5iugrjXzOjrjG5y2gi9gV9648whOnOG7ybA5b7YvS1BcbH1BVTFnYc6XldTZtkLRXcCRctgSFCiYE5550he129UFZ8kczZ8UPxrF2ZA3vlTnCnS8zfjK9odOVL/OLqMs9eSiKOLIgBCpHlmyZhnIklOToyFIFp5K+AGB8tYKVKGGrlxZoVzZy2PEyyidCRUn28KEHEwxJse0jIVdhhRsuEHh/QZfQ8EUk+Do2VmlsuWTLLoSGesv7PngojAG1g6i1GCdoTQXHIULqQfXmb7KCoxdlBUpSrjTgXXnYX0rYLxHQw+Qyn6IHNEoEXlHW6mDYMy5tzQqBD4906WNbaJxPthttQNky/CSarOGvKLG8RDU5AaDwDFHB4EeONNBuRycB0FjDdVeURD/hbsmPJLxGKyd3/Mat42BjfyoBuGYDpKBifk4cGQCPJlFKYqFiRwF5fRV4nQmvj94kqYwFRLnxvp4y3IQPqdIJH/w7vPXAwNNyHQp6gKJyY5zO5BFDjYTYmApCjsaA/huOzvJAcr5hN3LUEun0Up55XJ1KEqKOzoV/rybta5rtxnb4c6h1bNLCoLqsdKPtmJ4CGol/akxLIQubvgIeNtZZmmP68+qorVFjzKDLYHN3Xa0rUYuq4HIZtTaha/2LOejSX62mOTDIb3yGpvl836F2BXbULZFIrY5pBC7YhsgYkvIkRwiYpuHiJg3QkupNoe1ZH2ZK63Zml81lZybIV9TthkOJ0tfW7VlvVCdreZ8uW+TPvQ+rniRXlOFD5p9E5HK85dyzJbnpPz4Sag6D7c2cVB6OJyZ9PAfm4qMCEucRDmtH8OcrWice0txWrEX7dgWLa9wlle2CT0UKkw9WwWD/tXJLTPns1AZviCrxWa4NgDrbCjBd7AAw5wZrlFyOMvNMn8OvZUMJgkSbCLparaqzgTlrPB1/0Z3UQMAlNUwhys47dkHy0EBSa3Tkp8lN+iwZ0qGffXSSafnCTiU7f32WsBnD5B3tER7Iy7RtN3sEQ1NYkopw/OxqxGwz3fvzinz5zYIPmBy98wnsj1r4GlcKOtSw+ZqoKy9As3ycN59dcuaiUoa2GYLHJYfDEhja7OVQDQaOukxOeJH5UxH7QjAc7bitk224bojXN22vUMF9fzElrUAYntQWnnFqxioHCOm3W7EFH0QSWze2Jrr+ii7tJIAWPRbvgXWWx+aXBsbuNQEEC7lYbOeSq64duPp+c4dXFz04/n0eZyyKg2qp2b5Aue9Qc4792ElvgkTypbAjKY1RPJDEEEPj90u75PHgsASM3gdTV1HT8Z4HlmYI2MELgNevoZLv1dczCsZJicEwxT5siXdiiU1mzPwC8ZIh1WUZmqE716KVRxLR/pAfyxnam5825fVCIyfU1eiAXn92bBl/b5WbZljWFjw9axn/ub07Pmjzc2WrJi3Zr9q6YqNzyorFWoPW+OJqhNxeI+1MFTtNobKTV0tvCnYmnYy1V3ZO/bam5LKUac7XcAt8I5WaOnNsRnPwZGbaeyMBweM/GY1ZjW3bDxMLgjnDkwHew6enLPxvDEp6J2+EKFiY9q7CsE6qDpbHJrqwzNYPBkJ/t+Z64lR/yTq4FzX09zpRRCYJBMqHS1hwUDFNOx/olP/LfZfvLHNRrj8WMd4RFIHpc56z5ZhY8l3tZPmqz+QsxFSrwxFvGGBdAAEvZ6kN/ElvVaG3cDEEZRgGZi93Dm5b9GR+xY8bQl9jRw2nY7jUUuuW/Aw9feJlhC34KP9Ixjhhlo8haoZ6JP9g/uaiVIH+9qhrb8Zvg8DCK5hb1fIDnTCBVH2SHtLWQqIt8dWj2S1mfZsTPesarLmZ/La0mTBSrbiecVi4DFvxJaQVAXIR3O9K57X6hA4AFW+LHlbgoFWTnnUuXwgCDrom7vDtpFhGo40PxByoHMVxRJRf8HzypRtcXIyoT1W+F0v+BVbsgXu5ZvZYs5LYyDha6sX3PYO9mhzCGReindKxhl95CiF1Run3DU648KIFb+alYiYiXkaI/xBHcHyqL5aIQjA2gvkhzM1N3KjdXW+muX1aQBZsxPdSZog1Q4lPy1cuAEECMzMu9uNCPPKkl1RgEpeeZ6p+TSUnFxKmYukBungtc0x87POp+F2Oo5PxvSj8ECGk3E8prGfBqSpLyucw/PIv5hh2nwNBbIp8LNlC/hb0fj1zCjJrYBgGG6nBHXQGHud0LlXSppSiuUQcTPj26mINYiApzoWfYETl9NlmEGR1DSqoMWefBsAG/x0c7v+JXpbJvmh/uGa0+4BO+gmvNh/yMzv6aRnXTVOei40n4nIQ4ZXnrdW2595v2dN4eyj2poPOPzZwr3B0ty3PfMObQfUZd5OS2EmK8I1QZOHsOGNbIYTa+Mt2qZZu51tuGERPA17ZNRlO8iZLYlxWWO3NU+r4HOCSdbYMHpq3IMAIvZjLIpHyxzuBUSMMY0fFmBXkmaj5378ZJdQy6XWBsXAfYhG4IVqg+2zklJMWyMXWYA906Qpea309ohhrL2xok/KA9GDRH/0IEGZeML+njS3bdHetgWTj1ZUbdzJI1ldpuYEJq31UIJTYpjYuTQ36PRsPj2GCmb7EY2YEH1aaoj630j2TqiTHvg/DKFHlrO3C/XbeuHup+fmt5LvYkETSKR7p9TpV6nb5iCYDupZ9swju62WwAkAyIEjsaOJ4GElRna0kGke6nbipLPTcxusBqN4RVcYtQZt01xQgDqGvuWqMRdl41+h9Cxs2Jh1vtsenqAqQ56VrsfjveEv6uA6aYR3UzACl1KMLy5GhLa/KUbqyHKYg0mu/4Q1ZLVMvbIUc8SPWnFphuJSwwFl6ovkCxRr0in87najGCHwWS4TbT4AJ5DYnBJzym5O6XKafAp0tOax9mF1n5BHwQOedR2s3FjArVENwVE4gV/K1FnSAHpypmqgO3xpIBdcwtJrR9DCMeN0WfE+svLsL+e7nXTxEJzDJbonC+OEpqZdfkxNFfJW8YkCY9QY8+uH8wMUYrXbje0WYwdgzSK8lJfiz3ZM2Mcnj8hCoDkmbcKmAKlpe4JUpEafiek4FmcaWh0hiYHMuFv1WYfW7qNcTF2kmxhwtRzqiZHKjSpj4WQIGpOrpIRQhudwdq8sgj9K2qhk2Nc+VAJKcwj0RQ36pAI9MJHJQ6AveoBZTAuLTkUHnQ7lR+AXMFWhWWgC9W7m7FUB0uq6qF2n2hzzWnm0zSNONG1A5KX4swdJ0cbGBwFiAfinBhJ9IpogObBtnmi0VzBcRNyKx532HMaxPZMd/foFXmnR8nk5JsdD+DA8JgNz1QVGKMhlAjr4tSwFqPWLgSwW4ugYmAGTS82qpzouxZ5dJarTwXoQ1oOqjtoqjBE++FI1XrxbN2Z6DqbNuHjrwfRb/PJ2nzwTX+mCW4RlnQ2cTDBevoma3x/poie8RblnHkvnbgc8YIXMhR0VS7hqdxB2IM/MP8FeOvmGN3nK7zL2WZpWrSyyHR7YfKTMlbN9bOSU7a+U+Z/r8MHNXF71dM+695IcmBtRBwUmX5GhjLwbGA8GBoQIHYyEFxdvh3RI6JA8IyxBGdIbsfr0ZhMqRlYE+BA4GLiYHrtdzcY0g++ibqNoulcUtQE88NMa+egC+GTDriWwfGUFf9nBe8zrRpAw8mxMKG1gyKu0ReMacQr6IidnvGhBPa1CEhRVoJO8gyeaTnKMa5hHWQqRacaslpAnzFWqTHyT6nVWdEaVUJZiBIZaYndUBkHhr80EnElbKca2sBlUunW5iR0Sqje7wpYctawmGs6I1d6hH3LpFoFQjZagEdReFxkcOHPg6TGO5m5XN31UN22CVex2H9i4k450GzYVhgIaNle6pEEg22Hn6phHZBiWU5I1BhObAO34TP0GGuIc9dRwSE3cfCyCRh925rwTSI8t+GyOomI4nund7qiNBJjUuTkiCI4aCnjY9gGDMw/vem8/EpQ1bqRJza0yb8DwjLK0hc/GJqSnfZxml5mfR6tSbMIqgR1wV3IrStRlYbvQ4ErNMUaqTd3tZnNaC9dr7tFFxfTWbjKTSNsLCJ0SFtGmzGSZ6dvdznvhoOVfzOScFzTOe3ZzqAV380QNRGHNjS+3Gs3v7Ba/kKlAq+RCamOkZzb8o2MjN1/0ycArAyDXlTNdPQIjVX8ASNSfRvEYweIMd1j+1FWFIilYEbq5IrwFAXuCHZUitA1hgJMFaIsEAT/EIJ0Zivkkn3QfxQ7cWdSLYWN31Z9gJE+UnXPIli3wCgDvuj+WHhIp+ZcCoXklQbOdHjJW7nZo4AjrqODtpcAOU54jI0V9kPwUHvnBaPHFFBSAhDSFyxnSmfHIiJjryw0eJjeHnOyxX3a/BpuJDqdnvXYKtBcJVf19lszxmjDAgBC0iZWXB7DDRgeuediUMVt3WwbuSD3Wh5a1gMs+2Xx4atZMwXVznReIhaq7RQNSgiVBmHjaa0hlWUVMqI1aXwZBlei0+glo9TG4lwEshosz5Djr1wnAlvLGuJaY5YPmVWb5HEMEFO2IBrYEkU5s3aFgWdWtigGkVZJh0WdyPsfQddkh5DW5sVkjPIVDT9Pb5TBm9GBFRaA9VECmqQoQN2v6rtM7qKHTL9uFSmlB9945PzN0RPHC0BGHRbtdPW+QxhLQtKOhRtrCr9bNHrBfVLiF1ohmlkED0bwOCXbQMfgd6fbNcCD7Rw1XHgIQqyXXIzglg5AMw7AiLngCzdYipCeifgYSulYUbkGWq9gjZj4KJeVqi7cQ0L29AfuQdNesVmEwcrfrWMoLd/fgyfjMPldB+PCur8YxNu51CodgKV5wQ/swgrK+LqLfIJbj5bNVGCpPlSuR9wc5otHj8jqE4qCcOLHhnhmmDuWUvWDoRtMBKgK1TUUcCm6uvzWYPEXHCvcWbdUXqBL9DJuIa93Wbgdu0J2TEt5DjNeWdr/VFxNDhkNFI0JZs4R3abGTuqOU41ALzRuMGTnBExCzonEjzhVOGlJWQfIEjUsrcIeJewl19SDxwElapo1Wvd754Sh7e1j1jEBUP/BbEXvWvebgqYGXuZ0+KKjQWQaojQmrZqjChnpY5SSJiFyQnHFRhZW0m0Xm8khn25/ykmF8mAyIT1TKaxOxacwWvHDmU/BaocgWA62F8Mdnc6fHg1db+XDsLIkaZcDY7G7FlbHDQc3DBpgm6B/ChXO+QiZUiTrw4rT52rq/YAWhvAvad7XBCgMXpRAIZ5hXoXC8HikzCmVGscQUlvAVeOUOF7jDL+GgSW7IvnJuQTWymUunXdyyZaWocE971s74wLZzBCHqZnNvR9D+NCGdkHM8JMOD/ca8Z6DtlN4lEMLTQDcxwZHBmrQH27hzG7EeFdUdEpOiopElnt2LaLNVVyHczsRafjyvCrzTFZxssmIhy1Is9MAYWgzk0kWTGlhvKTKsApbIEhg8MhmImw3G8HXBSeH4YGLikKEfkAqodfOy2sOu+IflHN1LfWVuTgIorskqcWHWNuJ1GSl8q0zFUSChrz7LbkDyADj1YBuNEyJcAnJ85j62b4Nw6XgbBCgQq4qxxRBPf5IXtQmVc1V0Pj9eD+L3mcoa11hZJlYhinuKRWmMnl0jp/qjsfgu8Nan49GQfIewzLkTnIcEOv99Ym6fIViCYEzQqqubUmDeDFhzG4vggUVAMDYF3IziDFaqm3ElPzqq714HnHTPCAmISRQWzeu8jQNcFdSvMOeiku52nbSW6HWaDP3sB3LF0gsaK2flHIrBf+zfOqztB/iPyQA2BOSn66uhyiE5QYu1hLtkT+pILi6UyRPOLi6uT+ZDCvdAjedmeC7UK242XsyyHkjD2jAxm601XMUy6N0OTMN9DYjGzfLgSlIAlcpBOuVFK45bELRTUI1n713pvwYCo9Ic4zpsQM91SU4RhnqWWb+3lBp6mSB9ARrZYQATiHxvneESE7QpNX1IaOtuqQFIFPfMN9Y5YCTkdAu1EoEpjs44vXfa0Ime1iMqwc1Ydo1+Q3OvejM4keNLjvD2vOZtUn324R4TZO/76r3bh9Xt0z36BgJgVCdO6KaCBAb9kpBYQ4fSuCroR5B6Utk9892O+jSkFdqxsnYIa965DLcwX6KRTA8h9WUYKnkv1rfImJFTIKcYBSgVOllcTbV9COtIMNY4Q2IX7Ky6+qNDFdOJjrJCiVKfL2EWJcC52inafR/vrUwRavukCQNzgPR0VBWN9+LhuU4Dm5GUyUKL8tNvttn7JMerE++SmNz/s/t/ev/P7//F/b/89jfv/xVh5zG5/0v3f/H+L9//lfvf+PY37n+TsEVM7v/1t7/17e8Q9iIm97/17V/99rcJEzG5/7f3/+b+393/+29//9vfJezTmNz/9fu/dv837v/mt7/37T8kLIvJ/X+8/w/f/tH9H9z/4bf/ibBXMbn/nfvf/vaP7v/W/d8mTMbk/r/c/+f7/3r/3+7/+y9+h7AvY3L/d+//zv3fu//79//gF79NmFIxuf8nhL19G5P//cf/mLBtTO7/+P5/3P/P+//1iz8k7Ecxuf+9+9+9//37f/SLPyBwgkcwny8Wrash63D5ZEZQM9cHEBtAsfr0Blh2sxHjEa/9CRcUxLyUtM8BOyyB1us56y0HFwD5NHxGhpB5SOYElUh00i7mZy8hH92jXXMzlzsi0arbB3rXPED195B5gcXEnrUu538g0BGxdH9QOfIMHDkZ1FbwlajUu4ASvZfbl4OinqwxE13fTF81hAGcvdAAHEO2V7bFTpOI2sunBNWw7JpZacdDDcIpo3VEmXUpFnJVgFbhuPZjqQ3KucTJAP53gGEJJW/0IQj6egbx0T2BHEp1gqA5KBkEv1T3Yf8pHxrEY/6bDhNsBarvhnrlDHq1LHvCr/ja2CYtY4rLhiOm7F6dB4xQR6te6attwCz62A1yoLnG29Zk53o6FCzhrbp9O4/P8TT1SsZutiFk8+7OlUxhQXtDD4624BVjDp1wvDkeRmx62RoBRhXpbqjNG8CS5g1gLOXKD1tp2hl8vxO+0np4GnNmjVxEjYdkm+krsRaEJZV0GBZfR5TZvBeazaqCIAp4PPfPxWWZkDmF81MrwErm+eH+knfq+3E8mtfr/6nfqe/jwoMX7A/J1+J2UwqlBpWJ8MAa7g7a1zj33MffiAkKhNQJzdEjxed1CH3anf2sFYA0beLczEc65mEbq8LCVjFgWSM0YStcLGv6wM798KKdsHuOQ+1GbTOx/Q5FjNPlFvTC9niRtK40tvAw3sqpvC6qKaom5XGIPwzM11GTFAIVUJGWq1XeWMQ4UXYB4zN+HJKBz1TiR1hOzKzSerZdFNSqa/UFBB1DCNAFpgLxvdoe0J69zxCiBMNwUbv2Jwe2Bq+bdagp07cUYiXuJ8hZe9lqvYhnLAmnmaZcyjqWPXSvOOtcZ0cnXW6Ca3bUc9d4ZcP/znhF2Uu84YgJmZ3sa+rnMpeSCxrbgADuHZwhrrMihfjUBjhB0HwHYO124Wv4xztxbTrwk14W7u/vvCFa443I5rwDp/FZpSVxMpIqIa6eZqP5xHRiZv4qldEdhkgAhioq5HVIUR8j9ntrAWFiH4R35l4xd9cKy1TcPZtWSoruNxBJAIbF5qKfvtrxQ3/Fo27FDfGlVdp66iDhIpuW3n0vU2NZt0hy4YlvYkGZ4KU5CONH2B6SUkxfu6VujxqhMJm7QzGEoJAFSv756eyri2sWDS5OQjo/XXXHitmag61VD68jq5dAVdsDA38deTqh0DkbtMmT6xUoIpimHpSIkc96ix8Ca1Waklpd9BQYxhVYXFwdDzKLbVmKYnFrdSL8dDa8OGHRwACnmQd0LPz0q4twepEOZxfb0Sj57rOL7fNRssCXj/H3e/j7fBrNd0/Ic5EOL+j02Wl3JlyrhyZD8ENwbY0Jwds3FndU+/9kKrdl/sNSarmQuZ2p8ErrjZrulnqzW2a5oPHF6cXpaV+Jav77y4TX19cXEZ32zNO2zA+vl96ePQD3enYrCHVg3e210eQdXP1poq746YmH0ZmSQFcBmdO7j/ezi9OLk/lFejdmz5svPeO1ZR8bs8324FhNRHMxdQfnnsFC3xk5xfE5rGoEfnuJt15n6rOsyLQw9iqVLv9BfNmIEognP/3O6aqdaBAovEgv1EfT7+y+A38XKe0Bhy3wGDi8emslslOFno2/d2ANVtjQXIZdONn67fo7MO72TK6TVXsenfOLbRjkwOb4k61XlXKot7OsrEqdhyVtFEOWuF7o6xWwzbsdSXKNF5nu3Z0YRyPb7y4Oa1Gv0Yu3NJyd/3D+OX12mtXLWb2WxQoQAsA85qdfzc5P/sL8DpRD+4toeqGGFq0ZPIeI+zSsk+OL9O75PjR/dApfTCtTOj3c0HOzjKACLFA3iRV/vD/NurCvq3iUeDRHVTv+HejNn3zF6dp24dl48Oz5/43Fp67g8GX7zE/Drzzqo+btl4/3dBfWtErN+3LT027t9bZ+cRIxf1OvsvzkJz/FlW7qMDX2vH7cW/9PP39pCn/8QNGqcwtZvBcoj1wnun2TyMHt3DvFdb+1BoqkkpH1Ok1vb29viXED5+JwwZ/85KeMPPvu6bPxKcx0TNJ0vf7Qss9PwcYlJlAO2rbeQ+GDpX/6+UtAMCz9XWLvaSx5jZoVG1E+gHMlnZYezgE731lsVZMfzkt9OPB7MfxBVqt2NzQLVFUHBxQztpSUEgw+k0qAmBjNLwgnhEEsgrTBhdVPWDs4iGaqitkvKWuVVFH97uD/YGusoG3UDgUrkKzXovuDhAAEt45dG01n45Nfne/Gs9HJ8zmNw9no5HvzixRotCX3u6/CcBrPRuP5RbqbPZ/PRicfz2nsMvpUGmr+HHTV/PQJNe8erLePhEP9jxFvN7onEGWHgUa93hkEZY2lwSQ484UuXNpUY1D60SgejQbnnxO87KWm8uT5aDQ6HY1PR2NUdD6N5ks3/+qB9afoNPyA9TmiU08DrOovRidQv9NYPbqVtCbEmVs8XQjQ0JlrX/yPnSw/tzWi3KUv0dxZggpuMvG5n9r0Y6bmhzpvJfTYeSNwZs5PIP7V0YO9bQVY9FRz2o/9zUiGN0BrL5oyXuWke25wsgGTD93hqrt3uBq7PhOJdOVHIrUxC8FkT/KVCUjq11vW5j4oU9ftUJe0Nl3Gu1bxklWYGPEN3ixtFpRCpY1zdINIosMhUMfvPOd81BJJlhGCeAaOqyP/cq36wyiGy36sgX4/iT7yo2F7YAVjfVvRbjcj4r0oCCMyTcmc1vLkSR3NrGhEu+uEZxWsgKEqNIumvlLdH1NGWaecCY6639O9CQPiCTr3dPJ/BgC8hiYZU60AAA==",
 		Length:   44371,
 	},
 
+	"data/maintenance.template": {
+		Filename: "data/maintenance.template",
+		Contents: "H4sIAAAAAAAC/8xZ/Y7cthH//55iSgfd3eYk+S5B2iZaAa7jukU+bPguLVrDCChxdkUfl9SR1O5dF3qgvkafrCApaaX9uPhQO6hh3PJzOPzN8DdDKv3Nt6+eX//j9Qso7UpkZ6n7AUHlck5QkuwMIC2RMlcASC23ArMfKJcWJZUFpkloCt0rtBSKkmqDdk5qu4j+QNouweUNlBoXc7Ldxj9pUWlc8LumSRZ0zQslY14oAhrFnJhSaVvUFlw7gWQoXdIVzsma46ZS2hIolFPFzsmGM1vOGa55gZGvnAOX3HIqIlNQgfOL+OkvaANNkxTGJLlS1lhNq3jFZVwY0ylm7wWaEtF2gkyheWXB6OJQ0nuTvL+tUd9HF/HFZfylF/bekCxNwrQPkzFW5vHzgw6xpblAo7RFfVRQmnR2TnPF7lvZkq6hENSYOZF0nVMN4SdiuKC16HAASBnvRzqbUC5RRwtRc9aPGY9qBblVUQ/GOAVqa5UEe1/hnIQK2Ztm1XIpEAolBK0MMgKMWto2z0nX3jVTvXQu+STMJkA1pxHeVVQyZHOyoMJg2+q010r0S41UA0hNRWWnjNGRkuKeZNdBHUnXfEktVzJN3LgHpjrfjrz4X2tomgQod21pwvh6zzqc9Rvf2TOA2dm+B3ckfWDaqhYiEriw+9jVYmDGTpyk671x/oR2I3ONlBW6XuURt7giWUqPEwnJ0jx7XVcV2uiqXq2ovk+TPEsTmqWJ4HuqJLUYgzOC4sh+NF+WBxtaKL3a80zXRIAWzgsOdDRIdVESWKEtFZuTly+uCWjlXLbtOkBioAeXVW2jpVZ1dTAOIPXd7amxeGd7AzqVOr8mUAlaYKkEQz0nV61CgVdvj4k9rkCUW3lk9O70duazEnIre8Jo9TN1vuKWZGlv56W4r0rnv9CXog6TNOHZofuetN2JxjRxQDxg9VF1UEkTSbviMZ4j2Vk3qbwYh8fyopdXZT8qhgY2JS9KKOkaYUG5QHYOSgPVCEpXJZWuwdVMqTYSqIG0UAwzWtxItRHIlsjSxDc5UQLBlng/0QiFWqNGBvk9UAmD8SuUNiwCGy6Z2oBawGqn5jlQydyacmKhULW0yNzCA/V2quX3bkHnwZoXJk6TarDD38rcVN/4trZxu+ULiJ+NlTHQNN0kH5g87VAhfva13nFDn/8b5Uozv71QNVbzqq8VSjKULg4MrGl3mUuo65HtbZk9d4iZNLHlfs+PyuKx9itLtT0644VkR9vHbWky1CJN9nTcbjWVS3wIML+RHlbL1whN0wekuijQGALbLaBk0DRjdVgWJr50Rxia5rpE8McZBqz62YiyfHey3YY5TUOyXdlx63aLwiDwBeAtxN9xyYDs+R6BpjkpXyqGTvyfb5lspYfiTnjThCMw6GrrKJmrWXa4zdiZ8GhnyAlcLhStqajRs7Q3bLu+L5sPn/tCsnamc4LjCh2JG7sYMUZkcDIdMH/9tmkShgIt7gLH61dX14ex4kHq9eU7s8/Ab3Cl1nicXY8xpmWnvLk1R8+f/mhmPQ8ESw7ZEKQnRKpxyFYs0JFUQ4oCbsAUJbJaIBuSTrdoT8CX2VU7DsZMfJmdDdEfxsZSaf4vx+biZOQe6HLSCsPg4OUehupU0BzFILOKzCq6hDY4R76XwELpOVncMklCzEgT33EqP2nFfOXC6YflAJy18tvAH8qjzGCDefS7GO/oqhIYF8plXg+Ey47pnb6eHcaU9VGRaSe/0oG7PhidvbwZBRb2ND5hlRag40lXqirnLNBSgUMotOwP7Hl9BM/e9O02bmnEUUgraHyoOuCD7h+ayuwfzY9tD+P4kvSx8RP4alihtUVbGXnrZenTlMunF3+Mnn4RfXFxffn066fu/z/PQVNbogZbUglSbX7JkT8uOCgZaZODTwCMk97C4osjUL46BOWLFhQCGm9rrpH9qmBIZZG0ydUnQMOLb+EI5REebzCvuWBcLn0Ku8EcDGqXAn5UOA63oRYLg9Zj0m/rUdekLqT1Yfrhm8suag86xun5iZZS9+9tC6Us6hAqfPHhx54TcdDteMWiL8cQjR4C9olZ8JN3fE0Zp1bphGRv2iL8jePmxCX/AUlGqA0am5DsKpTgDRpV6wLN44VRScW95YVJSPasKz9ezDDxI+N75KGo8QvGA276P1qgtLYyXyfJktuyzl0ikJibu6QKDy0mPLSQ7CW3f6lzeK3Veyzs/4PCxuIa4xtcVfGCJyT7z78dE/4+cnQIEVy5bvgOV9UjlR09DISTMX6J3VFV8p6uaWjtNP5suqilTzGns223QJLAMyHUxtNSe+lVkCP4B1vWDftsOnnSX5Ins+Gb7nT2zdlgWNwK0W/9fcUB824yi5EW5bH13RxbcjNzj93TSVFro/TkfFIp54J6MotLd1Oe7sYDHBUzFEUZe+4MNp1Qf02dzL4ZDmzOHyVN+2vKgwJnsZLTyUrVButqct7LhCnOYE+s2XBblDDF2D/HzMa9e4O9gb7HhYXnghc38X5vQQ3Cxdf7zUwVtbv+xkIV/lEY5jtwrNXTSW+cva24f+7d82Zn1IEmP3DGBEJxWpfLA13WVIPEzd/D889j9AgvRrGqUE57Cecw+TkXVN4cmYBxpXGN0n4bYtn05N5Gbc3Ylmd9aYdBkjiSDmn79bM/QU4NMlASSmpK4BJ+evN9fDbYca0FzA/tEFt1ZTWXy4FqfAHTWot4RW1RTidPJrORz7gzJek6sjQ3QN8Gpnkygc/dGrGpBLd+0tuLd/A5TMi7cDynE/eKNwCpGW7meekvBV77TYkSgnSNEDaJLD47uv4kuLqTLePcuJUe8PfWgv3u/XpzwDh8FvH1nYKzs7Me+PayMfhIFL4NpUn4XPjfAQDoz5p3PxwAAA==",
+		Length:   7231,
+	},
 	"data/node.template": {
 		Filename: "data/node.template",
-		Contents: "H4sIAAAAAAAC/8xZ4W7jNvL//N+nmD+b1jY2lpJFi2sdyUAuu9tbXO+6aHI9FItFQYsjiwlFqiRlxzD0QPca92QHUpIt2XKyWxSH2w9rkcP58ceZ4XDIRP//+sebu1/ev4HM5mL+InI/IKhcxgQlmb8AiDKkzH0ARJZbgfPtNnj7G5NVFYV1Ry3M0VJIMqoN2piUNp1+SxqR4PIBMo1pTLbb4B9aFBpT/ghVFaZ0xRMlA54oAhpFTEymtE1KC66fQNiFlzTHmKw4rgulLYFESYvSxmTNmc1ihiue4NQ3zoFLbjkVU5NQgfFlcPEJdBJjwoVS1lhNiyDnMkiMaYnZjUCTIdoWyCSaFxaMTo6R7k14/1uJejO9DC5fBV97sHtD5lFYq30aRp/M5+vfZFTbYFFKJvB3QtTLCCxdCDRKW9TPANlNgTGx+GjDe7qidS+Zv/CDYM0lU+tASaEogxjSUiaWKwnjCWzrIQArqqFQXFoDMXxoewG2W03lEiH4u2JooKpe/B9st8FPpbQ8x6qC8+5YlKyq2o6PV11wQRcoPPi+P1Uaxk7IIb64Ag5RwyEQKJc2uwL+8iV0WEIDExSlycbAX17CZIdWdadLlEz5EuKurjPTDEaCSxx1aDNq6aw7rp1l1vyed0VutEFrZvChp9IozYAYTJRkhpz3xfU09foORCkXYgYpFQZ7kurjvlV1JKpw/jMHpDWaQknDVzgDq8s+lE8cswPGjJtC0M3saDSAC6bZ6H1ZFGih8faox60Pr5SwvDikBJAr5mzOJcPH0cEcXFrUBhM7uPZeK1Mr1KfAJVKNxj4B7xZ4Gtznq2Pqj9ePOODmvdkGWDufHXRsnoUZMH9D6oc6pI5Un1NugvHWai6XMxj9TEXZd19vwxxHW09Y7XdYb4vZR4iBqaTMUdpgifaNQPf55807NiYJlStqyMQJbtyx8WjH5BUj+w3bJKZ88wOXCDFIXINPn+PEPp43W3hy1Uxas+gmwChsj8poodimyYmSriAR1JiYSLpaUA31z5RhSkvRniUAEeO7ke5co1yinqai5Gw3pj+qAXKzou6McQRKa5VsMnHdIAdqVi2XAiFRQtDCICM+JTTdjkLd33ZTvXTn+he1NgGqOZ3iY0ElQxYTH3tNr2OvldhN1aPmjomCypaM0VMlxYbM72o6kq74krp8EoVu3BOqrj6Yevj/1tAorE3ZcUfI+OrAO5ztFr73Z23M1vc74/bQO64tSiGmAlN7aLtSdNzYwkm6Ohjnq5x25EIjZYku88WUW8zJPKInyh8yjxbzOstOb8s8p3oThYt5FNJ5FAp+wCUsRd86PVsMLEjzZXa0olTp/CA0XRcB6suCPsmqCg1SnWQEcrSZYjF5/+PtHQGtXNA2siNbdIhwWZR2utSqLI7GAURe3Klgdi50nNrIJlAImmCmBEMdk9uGUV2dWtT5EPIwh+nCyoHR+y3c+tBKWFi5yxoNRVMucm7JPNo5eyk2ReaCGHZf09YsUcjnxzF80n8nOqPQ2eIJz/eanUYUStp+DiW7fSrMLru3jOxyJ6izuN9iTUIHX5bHJEMXXDN4dXFRPF6BvwPM4LuLL68gp3rJpd9Os2/2bR+Ns2++dHapwXbTFPOv5MIUV1FY7Pp8/dtyrhv+/+lCaYYaWdM0VvNi13K1F0qza/vKoZvPre5Z0mbzd6+j0GaHva7gHep/I1dcK+kOuiHxraW2NIMSRDnU/5ZygWxIcpO52ntQdKcsFX1BFHaX1hbuZ/wczhBmcVPC7+tzZ4penG23PAX8DQK3BgSSemIEqqr1AnOQmsB2CygZVNWT+klNvwvAZaoIDOt7dYkQ/EJz8ZYLBFLoUjYA/lBsk+hZP4tqdLfTcLsN3r2uqg69vtWYD2MH9Ot2y2Wi4YxXFZl3GlFo2YFS7/o9JOwExMkx3iKD0roy90n32tZsguulOk3FO+WkuAmZk3IfN4fSw8jpXuOi0O+jNqHsc8vRlh3oyfTuOSFVyqL2Hqg/T9RYx8npeISY5mz69ZMn9dDxfPoY1pRxapUOyfyn5hN+5rj+A87hP4BtZm1hZmG45DYrF0Gi8tA8PIZFXTaYumwg8++5/Uu5gPda3WNi/7eoG4srDB4wL4KUh2T+73/Bq4vLP01fXVx+B1O4dWL4K+bF76J9cP7V8bVrP/NI0mCcjdt3kfGkc986G4+C5uzRH3Y56ONoEiBNsmEdp2UzbiZBYsx4lJTaKD06H/m7P+rRJPCH0vjgEWAYqgtHGbtxhh+PXKm2wtH+LjV0r/0kTI25WuEzsJNAyfEoV6XBshidd56QcHJ8OzVrbpMMxhisM55kk0P5wHU2DOEHTC3cCJ48BMfyhBqEy9nAPbi9fgqV+FsMxHtzWavHo53Tjpbl/rlS/eHqxSCjv3HGfAXyBKdXA5zc5Vji+p/+evuZfNrHugLleIdxDqNfF4LKh0EVDAqNK5T2dV2qjp9Y5zMvAB1XVZOuVcKw+w3XjNVmmWZUMoHaP+UtNS2yafOCd0q3h3OXcQPcAAXBrRUIGU0eNid0n39pUNKT6rxxjnFlJ9uee7uQnsT3aMFmCN6GYDNqgcuVekAGpQlOqzo31xvmfftw2nvR6PA01/aNQ/d0el7gKYz7KF99Bdda003Ajf89EE/gYD9tj4K3T7NZ5VsumV+m9w+scbRCWCobPK/tFppybaynAHGP0IeLj0dh5RfV0ZgMkPSk3qWw9iyANrxshhLSlqt/xQqGd5iXHdnc7a6gfrb9sGcQ/OofID8ObAzPtcYapjlkkYb+j2VLgxswyl2QuVyC4A8I5FsCPv1BjlQaWOMJEInurqLgvswL9+vWXZ84Wq1hzW0G715DXbZ+S4JPJ2jzAmIgX3hNAi9rqleft8TbRCshHCmNnzH32XiU2Vycw0KxzWgSUMlzanG8HRoMYPwsd6qYuWSZF5NApalBO54EVhVDOtU5fHNxMZTpqueSXCfH7Y1R7aD6L4z1w2IU1n+u+08AAAD//+uikQS/GwAA",
-		Length:   7103,
+		Contents: "H4sIAAAAAAAC/8w77XIbN5K/z0/RByshWSFnJNtKYorkls52cq71XlyWdlN7LlcKHDQ5sIbAGMCQ4rHmge417smugJkh55OUfNm9+Ic4QKMbjf4EGvDkX1//8ur27+/fQGhW0ezJxP5ARMVySlCQ2ROASYiU2Q+AieEmwtlu5/30hYk0nfhZRwZcoaEQhFRpNFOSmMXoR5KDIi7uIFS4mJLdzvurimKFC34Paeov6JoHUng8kAQURlOiQ6lMkBiw/QT8MnlBVzgla46bWCpDIJDCoDBTsuHMhFOGax7gyDWGwAU3nEYjHdAIpxfe+QPYCbT251IabRSNvRUXXqB1wZjZRqhDRFMQ0oHisQGtgialz9r//CVBtR1deBfPvBeO2GdNZhM/Q3sYjSozj8d/FVJlvHkiWIRfSSJbhmfoPEItlUF1gpDZxjglBu+N/5muadZLZk/cINhwweTGkyKSlMEUFokIDJcC+gPYZUMA1lRBLLkwGqbwsegF2O0UFUsE7z8kQw1p+uRfYLfzPiTC8BWmKQzLY1GwNC06Pl2ViUd0jpEjfuhfSAV9C+QwPb8CDpOcBy9CsTThFfDvvoMSl5CT8eJEh33g313AYE8tLU8XSLHgS5iWca2YxtCLuMBeiW1GDR2XxxWzjPPfYRlkR2s0egwfKyg50hiIxkAKpsmwCs6mydZXAy14FI1hQSONFUj66dBKSxAZW/3pGtMKdSyF5mscg1FJlZQLHOMax4zrOKLbcWM0gDWmce99EsdoINd2r8JblbyUkeFxnSWAlWRW5lwwvO/V5uDCoNIYmNa1V1qhXKPqIi6QKtTmCHm7wG7iLl41Wb+/vscWNR/E1sK11VmtY3uSTIv4c6beZSbVQD2FnBvjjVFcLMfQ+xuNkqr6Kg7TtLYKMD14WMXFzD1MgckgWaEw3hLNmwjt579t37I+CahYU00GFvDKpo170yfPGDk4bB6YVtt3XCBMQeAGXPjsB+Z+mLvw4Go/qe8fvuA2RLBGCYbeoYD5FpAGIQTU4FKq7dAFF9elEuE1SWRBIpKJckEJyNPnz3+g8x/IEMjTy2D+42XgPhfnlL1A98leXj5/scgGzINzhqRF8Nk/8vTl/PLl/Hs3+PmLFy8vMxIXdB68zAjj9z/gs2fu8+UlvaTfk1rENHzFxbIjHp/xIZwhjKfg3ebj0pLKmtFsDDbVOINKU9IIaWP4eIj1zlx0mtpIn6bDIrBD1bTnNLhbKpkI9kpGUo0LcVpCZzxN4ZuiJw/nFfz0IYmjoXGRrOaoQC4AlZJKD4EKBhuqhBXBECK5XCLbW0O36jP0U7muBPLeyeUbh/SIpFcw9sh5fs3RHpNepZvln5/tMkG2J7tcRw9LdgBzqRiq3JgO7lYN3NDBRyHqdk72FvKVvBRR4A+Vnd9lxp5ZJXwLhdn8P+bpP2QqNTy4061ZdI5LLq7Nf6KSHbgAscKAay7FGM5/hwxa9tkTGdT69MPyZ+b8h/wZuQRq8Uvpky+gXySVIiLP4Ly6v7Z8zRXSOyY3ohpQ9iFlTlWvJYG0OWZ7VCnHlZyhbpNqd6cTDtXhUkedqnCrW7urmG/hVb6VqO2b0vpEXS513KlOuFVzng7XOuJcJ9zL/tOGBnfIOoy/4YBHXPD0lvT4XCd3vKcnqO96b7KDWK85Mm2u9En3gDZHzrdoJzw4N+5jTlxy49v9hu/gycZ58t4jD3gpYKSxLKgHcOEqKl4uR5gCEVIguarv77NVlmsNE7+oSk3mkm3z8oOgawgiqvWUCLqeUwXZz4jhgiZRUbYBmDC+H2lLSJQLVKNFlHC2H1MdlROys6IqjbEMJMZIkRc9sgapoRm5XEZot58RjTUy4gJO3j0lRX/RTdXSltCeZtgEqOJ0hPcxFQzZlDjnyXst90pG+6kqrAFMdExFwYxWIymiLZndZuwIuuZLaqPZxLfjjqDaUtzIkf9nDZ34mShL6vAZX9e0w9l+4Qd9ZsIsdL8XboV6SbVxEkWjCBemLrskKqmxICfoujbOFRSLkdYzWKCS1XzEDa7IbEI7Ko1kNpnPsoLG6CZZrajaTvz5bOLT2cSPeI0XP4mq0qnIomVBii/DxooWUq1qpmm7CFBXgasymaa+RqqCkMAKTSjZlPz85paAktZmc1BDFCU+uIgTM7JHsrgxDmDiwKVa4V6DlqXCsAnEEQ0wlBFDNSU3OUNZHfhLG9l2BkZzI1pGH9y30J8RMDdiHzFy/nQyX3FDZpO9opfRNg6tAcP+a1TIZOLzWdN+O3XX0TnxrSCOaL3SLDUmvqDFZ1ugO4TB8KJczA8vCsBut+EmBO9aCGlchDicCnc7vgCpwPtlI1DZQ6NBDd47Lu5KZ8eKPVKBEbi/jUjcMnJkI3pFV27KfL40ncTWc1zLOswYSi52VjHfP0k7aLrbZbhpSmaH78zR4lntGLufLluXnQ5cmpqSTcgNjnRMAxxDrHC0UTR2JN3YND1GriafPMBUNb4/iBeDJxGvBJA0za8ihJQxWnEIqXCBSlmtugH7+NFkpB5EWkbEhSasQ45WiUFGZhO9olE0+2vMqEFmawL5p50tg9mVn7TL+ozVllUr7cqLmQSe0r09ktkbxg2YEMEpeQjC6iCrv9j7HV3otzXlF3Rt/igTfVINlR1hUUiG/t5x/AMBfQiV73+5ue1MOS7CtQXGidsxwkKqKXHrIoWhO0Bt8AMjKGcFsTxu5o21Lau5xR38fO8p1bjrqptIV7AJeRBakWswIddgRUEenJketG6nSDJzPtW+brtWqpB2LzejkS83byi50VPynMyqC977bkH1912Ns0Uycx49BCkQYlTWQvFr15ZRzNeWN0pry0NIaYVFNHHx4UnudQ9d76PS4w1dY9vGrZzIagHBxsbr4E7ITYRs6U4JHWmERqgMuL+jvH5Wkvth4Z3EahsgJ1kurCrKG6BqCllReywWVATO49++TlOfYYQGj7l62TFDzhiKQl+LL0yUPO8sjyHNdIdfwPszFwwIrS6I2Lwwn5WWyWwa3O3s+cuB/nLgOYNYGf9s7RTSFOTCRU1nt92p04Htih1enjnzbxtZi+ksuUkgWeVVQN52dtaVD68Dw9cIaWrv8qKC3ELJlU0wN4YqY+vNBdTSsIA3guk0HUOedKvZ66ituu97XTfZD7iSp422kayOZbVsf9S3Grwx1CCQBeURMjKodEoVh1Q0ukvqtqDDlA+w3aNZ6kC303QfEN0emnQOobeWSn4Nt8BL2QPmSt6h+BMBzf8Lp+TynIDCLwlXyI4f/k4wqjHCwBzjDgWrR+6sulc46IuQzBZSwQsI7b3RxM/AR3EuGIFsamQOmQKj2wehPmfZdM8txgNn25B8kg3iXRvKxM+46Zblo0J8Ke7UnabqMjWHya5fXQLLPkmxrQ7RHlXH8Oz8PL6/Avd4Zwwvz7+5ghVVSy7c4Xx8eWi7s+348ht70sqIzVqmKcpM/+h5IvmPmiSefSvmOr4qb2PdK5z9Lt013N9RdkOELG9qo3i8b9nCIwq9b7v3C2XHN6piMyacvX098U1Y77VXhG39b8SaKylsgmoD29iW6FYIomjr/8mFzDbIq9Am+lbQrTQ0amXP3Um1QYpLqips4hvV3FuULrndVWl5Z2FU7SiX5/BqBoA0LXTHLElFYLcDFKx6MmziB9miywS4WEgC7fgOXSB4f6er6CceIZBYJSIn4A5ZLXnflqUU2pd1xV6nxF5VcMyZviX0227HRaDgjGe7hH1j4htWQ6o8HWwDlsyoc4yTSCs0u2TJTjQm37NcL2U3K04pneDc0Drhzto6oaX7+e4Rh5v16pi6/VX3Hs6HZ09qsbwZLlp6QrV/ULmQ0qByesw+OzYCzbpRc0Q0WrHRi6MF1LaqaXd1VFHGqZHKJ7MP+Sf8jeOmvTx6gpiO5Aa18cnsJvuCD6hlogLUX0WPChptDQ+0T2bXxfejKdVOF6S6b/8/V4F/B6WExsR67PtLbsJk7gVy5eu7ez/OitY6K1qT2c/c/Hsyh/dKfsbA/LFY1wbX6N3hKvYW3Cez//lveHZ+8cPo2fnFSxjBjQXDn3EVfxXbtUpX5kb79onXsDmNs37xALY/KN2enfV7Xp7e1cd9wP7UG3j2JVE7jsWy++qBfbTc7wWJ0lL1hj33yBNVb+C5vN+vPTppJ1UmRxl7ZQXf71F3bOuV7wuh5XL4ATSVO3idIDvwpOj3VjLRmMS9YemtMA6al7J6w00QQh89V6Ya1OENBADfh3e4MPAq4sGd14QHVCNcjJuA/e1mJIOsQj49iMsY1e/tldZYlv3nrlAPjyEqHP2FM+Y2eUd4etbCk70CFrj51V3gPpKf4lV2jKK/pzGE3m/ziIq7VhT0YoVrFOZ1dlToH1lnrbf+UKWkqnRQlorvl7/hmrFMLKOQChahcs8ql4rG4Sh/qt2FW/6GW3v65BooRNyYCCGkwd22A/f0k1IpHFOlx+x9XJvBrqJe36+24GfMCthOhmBCaoCLtbxDBon2ulGtmjOHeV+8kK88XS3xqa/NG0vdsVPRgnt9U6Hy7bdwrRTdely73xp4ADV/2jWM1/ebPfATF8wt0+kHNthbIyyl8U5j24UuuNLGsQDTCkMfzz81zMotqoQxaGHSMfV2ARvHBdCcLxOigEXBq6vMeu0e5mANmVvv8hxIfzxw4P3mHtt8anEMx2tGq53NNolkvfBLUrDBNWhpqzlcLCHidwjkR5JX6VdIhYYNdhARiAyMhM/JKra/dt1ZxlFyA+4C8O1ryPb4PxLv4QyaVQxT+2DSYhL4LmP16nFLvAmUjCLLlMJHzH3W79n/wjQEe4nYG3hU8BU12G99wAOg3Sy3Mh7bYLmKB55cLDSa/sAzMm7DSYdweX7eFunSU0Hu0EwPwkj3pKrvW7JnLRM/+39Z/zsA+6b4BKg1AAA=",
+		Length:   13736,
 	},
 
 	"data/radiator.template": {
 		Filename: "data/radiator.template",
-		Contents: "H4sIAAAAAAAC/6RY3Y7bthK+36fgURLYBmzJPydB1msbONgc4FyctkGTtiiKIqDJkcVdilRIyt6toafobZ+uT1Lon5Ilx7uLIFhqhvxm5pshOfTqXx9+uP3868f/osCEfHO1Sv8gjsVu7YBwNlcIrQLANB0gtDLMcNj8iCnDRir0M4PDysuF+YQQDEYkwEqDWTux8SfvHVslcAhrZ8/gEEllHESkMCDM2jkwaoI1hT0jMMk+xogJZhjmE00wh/XMnZZQnIl7FCjw187x6P6keKTAZw9J4vl4z4gULiPSQQr42tGBVIbEBqVyB3kFhCaKRQZpRZoYKEm8O+3dfY1BPU5m7mzu/tsNmXDvtLNZefkyO6LAmGgCX2O2XzsKfAU6sMJaTG9QrPi65aYqGPTKiLR5LDnMMjHOh1tJH4shZftipCMsimFU/OV4C+Uag7ccyrEFYHwpTTlOc1qOVSUsBxQd8xFCIVY7JpZoelNKIkwpEztbtJWKgrIlvhRmcgC2C8wSMRGAYqapzCLu07E/YIlm0+mbptzHIeOPp4v2oAwjmE8wZzuxRFusgTMBuT6puazjStWToHBwPo0eGnMzDuvJeXwTIjnHkYYl0hBhhQ20GJjoCJOcmyZcF7MGHkzpMAffdHMnpAoxP3WuAqySVyO36QgZpfwcGRbp72dva9IbJM26c+H8D/geUnvoe4jBGaP/KIb5GFXyMdJY6IkGxfyaMEzud0rGgqa0SrVEr6bT6amPrUxUxZemrC5JqZlhUiwR3mrJYysxlfd2MWXnSy7rs+gSGQvzhUgeh8LaDkxMitVzCNuAc/cthH2IRi051mZCAsZpoxKK4tlKY2SYprxVuT3r3WzXn8CktbRE0+ghYxRpyRnthHN9zDhQ5EagCAhjIZ0mh8xn7WJPD7FYL9EULaKH7P/0vJ2TU8pWZnzXLlh2LwDtIWGWkrBYLBDFOoAeFkiAxe5SGqbvrp9NQ2Wok4dK20PE9N31JbAvYSIWT+PievFsLixTnWxY+j4+rheXQb+EEamiAItLCcEYP5uQ2lInH7W6hw6M8UXAL2HjszS49OzEAd8/e7jP3qf/zuBeRnHDyhMpLsx08lvoesj1ff/bkC9mtoMBynTE8eOZO6FY2nGdGBnl1rMboD+Ixtrq2CXkXD/ScfMq4Niw/UlLVN5qlieLxeJprZvtKmV7qx3oN29f/L1gp4xbzcgl7UVGcj2zuHvPdx9yD8rn8rBEAaMURA2msCgtZR0Fmulvu54+B9r9etkEuLNWP9JAaJXti8nM8Z7vT2v32Q2ySu2ftFtWB1btFCayrnXLJbnvLuGt5PSm5V+Wx8nUnc2bDq686mW28sqH8Crr6gjHWq+d8in3JX3zKck5qPJRlwdXzMs+Ck2qUyhDXjtZmEXZHQJm4Kaalc6jaUmmnK6dubP5GEcRGPQpDkOsHtEqI5vRtaMNNrF2Nn//9efKS6WblWdoZc4zqhwfjyq9HpH7yWADGiWJ5VPh7PGYa5PEQRQbPCkf268bz9hX1ryWzzmO3UQ7m1XUkDubzP3N8ejept9JUnkeNbzPIOsPhFaU7e1vhGrorAidphblRLWFKRel/+353umC1K0eo8VedMqcFgV6PLofcw3eQZK8sSIuzFoR2/F5jQD7MwmCJmWhZgXW/HXDPEZFgXl3eI9zacnN66EfC5Ju+OGo2nOvhwO33JO/VZn/fTByAZOga0W6xgRMj1yi9XBAYqWlGowHkWTCgBqM3CA98IY2c50wNhSm9DZldjjAJD2IBqMbe2IyfhKaglDu4SzgyJViOAhlrCGOBuMKEw1hhFqw+sAMCdAQ3EPASDBqao/tIvM89H/wDbrljNy7bS3BGtBs2RZTSeIQhHG5JDhzZF2TY4waDqrktELJrl4F+P7mqsOT77KfAhDp92V+4sseKyTg8AsTVB6e5MchW+LKCMSwQhijwZctx+K+YwG4kYI9CPMBfBxzM+yNrSFLmrm8qkbFvHJQ/36XnefpMb65Wnn5T5//BAAA//8PtA+rCxUAAA==",
-		Length:   5387,
+		Contents: "H4sIAAAAAAAC/6RY727juBH/nqeY6nZhB7AlO+4eNo5toNgr2g/tddG7XlEUxYImRxY3NKkjKTupoKfo1z5dn6Sg/lmSJa+T7CIwNUP+ZuY3Q2rE1W9++Munn//x+fcQ2b3Y3KzcDwgid2sPpbe5AVhFSJgbAKwstwI3fyWME6s0/MLxuAoKYTFhj5YAjYg2aNdeYsPpR6+pkmSPa+/A8RgrbT2gSlqUdu0dObPRmuGBU5zmDxPgkltOxNRQInA992cVlODyESKN4dpLU/9vWsQaQ/6UZUFIDpwq6XOqPNAo1p6JlLY0seDkHgQlhKGaxxaMpm0MyLLgqwm+/pqgfp7O/fmd/1t/z6X/1XibVVAsa0YUWRtP8deEH9aexlCjiRphLWqfjX2uWMq5nhTDrWLP5ZDxQzkyMZHlMC5/BdlitcaSrcBq3ACwoVK2GrusVWNdC6sBg7QYAeyJ3nG5hNlDJYkJY1zumqKt0gx1UxIqaadH5LvILoHLCDW3bWUe8ZCO/xuXMJ/N3rflIdlz8Xy+6IDackrElAi+k0vYEoOCSyz02YnLU1xOPY1KB+9m8VNrbs4hpO34plQJQWKDSzAYE00sdhiYmpjQgps2XB+zFp9s5bDA0PZzJ5XeE3HuXA1YJw/SITr2nDFxiYwG6R/nH94/9JI078+F90cUB3T24EdM0JvA7zQnYgK1fAKGSDM1qHl4IozQx51WiWSOVqWX8N1sNjv3sZOJuvhcyk4lqQy3XMklkK1RImkkpva+WUz5CVLIhiz6VCXSfqFKJHvZ2A5cTsvVd7jvAt75H3A/hGj1UhBjpzTigrUqoSyerbJW7V3KO5U7sN7Pd/0ZjKulJczip5xRMEpw1gvnh4QLZODHqClKC+mF5NC7ebfYNWE8MUuYwSJ+yv9ml+2cnVJNZc73yYWG3StAB0iYOxIWiwUwYiIcYIFGRO6upWH2/f2raagN9fJQaweImH1/fw3sW5hI5Mu4uF+8motEtt2eDOuH+LhfXAf9FkaUjiMiryWEEPJqQk6Wevk4qQfoIIRcBfwWNgh9lOookF1dIvR+9mpG2tZ6WWlPGTpB7q838BZ2flaWVB6eORGGF19984/u/wXc6+huWXkh3aWZXp5L3QDBYRh+G/LNzPYwwLiJBXm+8MYsl/a8bK2KC+v5+/FCEI7iuIXQx3ajg/rQaDTKdqWwNjvrDiojvfCU0kstYU/zo1EQyw/4MNBYNMJdLBYv656brjJ+gPQK883eaxDsPK2NfvCaDq/gtn4s25/LDaA6oA6FOi4h4oyhPIFpIitLeVMHc/Nt190XWfeTqerD/PmFpHf3xpvJLPBe709nize/UbSzf9bxNprgejtymX84bIWij/0lvFWCPXT8y/M4nfnzu7aDq6D+OF4F1W3DKm+sqSDGrD1d3jh8cR/WWgmBuvquLoIr5+UPpcbpNOTIay8Psyy7Y8QtPtSz3DzmStJxuvbuvM3nJI7Rwk/Jfk/0c5ryEPwfFcM/5AdFli0hTU+CLEtTlCzLYOUQgLO1ZyyxifE2//vvf1aBk25WgWW1X4HV1ThNtWtlwM+xDGRZreAhvPMrm42QyljzY+tCFGlari4cdkH8QkSC4Bwuhm6EwmCWJdK9KWUZybCzubrr+k+WWDS9TqZpoc0yDxixZFrd2rxrXduUwTZJzsML0rQhrZn+rgHbIaAw2/y08zaruCX3NnmiNmnqf3LPLt4yR3ErTznk6QFgxfih+Qxwgs73pdfWQlESXSHAyf/u/OB8gXNrwGh5PHlVmZd7Nk39z4WG7DDL3jciLs02Im7GF7QCvLYMTk+rIN+B7Ts2+xyXOzD4Sg6kkFZMvRuHiaTuRBzf1ofSu/HIrw6tf9Zl86/RrY+ERn0r3BobcXPrU2PGI5poo/RoMooVlxb16NaP3BthfJoP0AvThCKMfXI8j0eEupN6dPvQnJhNXoSmca8OeBHw1ldyPNqrxGASjyY1JozxFjqw5sgtjWCM/jHiNLptazuTAYIA/oShhU+C00e/q6XEIMyXXTFTNNmjtL5QlOSOrE/kWKvHozo5nVDcv61G8vhw0+PJn/PrKqDDvtyd+XIgGiQe/84lU8cX+XHMl/gqRjmuESYw+rIVRD72LEA/1nhAaX/AkCTCjgdja8mydi5v6tHDTWtwukXOX3juPbe5WQXFBfz/BwAmZZHrkRcAAA==",
+		Length:   6033,
 	},
 
 	"data/report.template": {
 		Filename: "data/report.template",
-		Contents: "H4sIAAAAAAAC/+xZbW8jtxH+nl/BMtdKBm6X1l2KNja1QJHmWuCusXF2CvQjtRxpaXNJhpzVSVjoB/Vv9JcV3De9+5xcUDS5wIDFl+HDZ2cezlIj/ru/3nxz/6/bb0mBpc6+4PGDaGEWUwqGZl8QwgsQMjYI4ahQQ3ZbOQdI3oOzHkldk7Rtpm9+kIZsNpy1du2aElCQvBA+AE5phfPkz7Sb0so8ksLDfErrOv3ea+dhrlabDZuLpcqtSVVuKfGgpzQU1mNeIYnjlLBddCNKmNKlgg+RBiW5NQgGp/SDklhMJSxVDknTeUmUUaiETkIuNEwn6eVH2JDNhuUhsJm1GNALl5bKpHkIPTFcawgFAPZAIffKIQk+P0Z6COzhhwr8Opmkk1fpVw3YQ6AZZ+2y52Hskzlcz1kfMz6zct1BGrEkuRYhTKkRy5nwpP1IJMxFpXv6hHCpBsvoSqEM+GSuKyUHm32rDijuCn7HJhKoEK0huHYwpW2HHixDu1hoILnVWrgAkhIpUHTDkUI73g8Lv4hK+rJdTYnwSiSwcsJIkFM6FzraNqORvbd62GqPWvSyE6YnE3xijV7T7L6lY8RSLQQqaziLdk8sjZJMGvj/lSlnrSt3wsGkWh5ER8nhwbfxbJ3Zx35w7h76TmhdpXWiYY6Hvqv0Thh7OCOWB3bNweotZx6EzH1VzhKFUNKMi9Pnn2Z81uWZ5K4qS+HXnM0yzkTGmVYHVFil952z54oTz+PVojh6oLn15YEy4xAlIo8qOOIYQPi8oKQELKyc0tubu3tKvI2a7eaOXLFDRBlXYbLwtnJHdoTwZro7NggrHCIYOfXCpsRpkUNhtQQ/pXcdozYfIvjyFPJpDskMzQnr7QnuQ4iGzNAMSaOjGKpZqZBmfIj1Qq9dETVMhlbSu4UzlR1L+Gz8zgxyFn3xROT3ujsdzozom6dy3TYTFpPsZgk+vlo4KyY/PUN6++HsEcutTkKZTGIGTEqZTOiz1dwv3a6dHMraZd2L2s4JrCCvopiJWAhlwqm3N1Fmd/Rbs1TemhIMks3mJRF7a/4SB684cwebopjFjN6SbDvN/2RmvQQPsusG9MoNvdwaCSYM/cIuwR8fIvQZR5l9UwizAEk4Q9kM7PDq55rLiMw4Q38O5u5ROXcGpp97BswbofQZlG7qGSD3FoU+idHOnIXgrPHYUejvCxWIrwxBax9JXaOvTC4QBtz3lUFVQox7gOj/QNCS3JZOA0LaRPbHHbDh6LwmzdVoStuYJzOLaMsrMnErEqxWknwpX8e/a9Jcza4ml5e/p9k7uwicFa9/gWetrn2U3eDcd3bxDwhBLCBsNgeh8dC7xwkplVlckT+6Ffnara5pxl0/WQq/UOaKXF7TrK7TKACXceY8HG4NOsDRLtl3FgtlFsQ3jECmR2e1rsHIvYXPD3Jdq/lWShBs5XMIg94/VQot0C9UDPGC1D21VgGTpn1lrIGjjHaomwNPHkS1uVFFMdyvHWw2V6Su0+9ECUdmDYeTr3StMh5KoXXGcyshgr1ROoLVdfpOGYg6a2Y46+yObl3dvevUxLGkPnpJO/eq3oI9Kbltvv9UzXVIn7HoOg/8prqPqq67Hny66Dqgz1h0h678vEX3pOpu3v4Mae57k/+4RNdvI1VwWqyb8F7/GpV48/Y3EQ6Zb/dLs8v+YGbBXQ83yBMjhR8qs3NrEXxTg2qbZ8Ry/K37VNxLmXz1ZAXqVNnpbHnJC6kEWs9o9r5rkn823/E/ub70M5AtEF24YmyhsKhmaW5LFh5XzLXlsNCWw2j2N4V/r2bk1tsHyPH/i3pAWEL6CKVL54rR7D//Jq8uJ39KXl1OviYJuYvT5C2U7ifR3ivltOLaL5tvy2bsQSxFO9ozfzGeV6Yp6Y0v6h7zxXhUvB5dpDNl5HiUa5U/jl6S3pCMYQkGL0i9JdWMpAGtu/XWibZUPL643lq8GGOhwkVqYIXjkVTL0UXaFrV3zDbb5osxLV7Ti7Qpe+yQ3N21x8xDGI/yygfrRy9HziqD4Ec7sFvyzwEQFdrRCVJ9Y//XhfZHBc7a34z+GwAA//+kSIusRBoAAA==",
-		Length:   6724,
+		Contents: "H4sIAAAAAAAC/+xa/Y7bNhL/P08xx6RnL7CW1tls03VkAWku7R2aNkF2W+AQ5A9KGlvMUqRKUl77DD3QvcY92YHUhyVbTjYfd7hemwBrfgx/HM4Mh8Ohgj/95eWz67+/eg6pyXh4L7A/wKlYzgkKEt4DCFKkiS0ABIYZjuGrIs/RwGvMpTKw3YJXFb3vfk0ElGXgV3TVmAwNhTilSqOZk8IsJt+QuoszcQOpwsWcbLfez4rnChdsXZb+gq5YLIXHYklAIZ8TnUpl4sKAbSfgd9EFzXBOVgxvLRsEYikMCjMntywx6TzBFYtx4iqnwAQzjPKJjinH+dQ7+wA3UJZ+rLUfSWm0UTT3Mia8WOuGMbPhqFNE0wDpWLHcgFbxIdI77b/7tUC1mUy96UPvkQN7p0kY+NWwu2H0mfn48c9SqowXFSLhOAgR+I3ag0gmmxpV0BXEnGo9J4KuIqqg+pkkuKAFbyQAECSspbTaoEygmix4wZKWpk9VA9lZUXVoLAOFMVKA2eQ4J1WF7A0zcrnkCLHknOYaEwIJNbRunpOmvWmmammN8X41mgBVjE5wnVORYDInC8o11q2WeyV5O1WPNYBA51Q0zGg1kYJvSHhdsSPoii2pYVIEvqV7z1Br1RMH/98iDfxKlLu2wE/Yak87LGkXvtNnJcxG961we+gd1eYF5xOOC7Mvu4J31NjACbrao3N7s6GMFNIkVkUWTZjBjIQBHXYhJAyi2lVNrooso2oT+FEY+DQMfM72WPEL3hdOTxQD61FsmR4saCFVtmeZtokAja0VHPCokao4JZChSWUyJ98/vyagpDXZuutAEh0+mMgLM1kqWeQHdACB6653jcG1aRVoWWrsmkDOaYyp5AmqObmqGao86q9DsMMMTCIjBqh3u7dRnxEQGdE6jJo/XUQZMyQMWj0v+SZPrf1CW5o0Mgl8Fh6a71HdHWkMfCuI92i9V+1UAl/Qpjjk53ZeMJ2GL1eo7MkU+On0072jkrdHt1cs+URnk6n1fpMsmUzJnS25GbobO9036Tysz3m5AFxjXFhDBrqkTOihwx+Y6LY+FyumpMhQGCjLU6C9MU9t4yzw871JDY04NkxWFfd3EkmVoMKkrmqjWN7WYikSFLqtp3KF6nAHGRUGJgmfpVQsMYHAN4lr6PDV9LlYJgkD36hjMFc3LM+PwDR9d4D5jjJ+BKXuugPItTSUD2JUPUchAt9J7ED11ynToAoBRsob2G6NKkRMDba4rwthWIZW7xqt/DUYCbHMco4GvQPNbrds0Q7+EY1isfauWYZl2Z87pmJFtTt+DMuYWGoCLtKakxSt653B9OFZvn4CLqybwfTs7Ksn1jVUI/enRZGU5fFtMbTV73VZvuLyFrVpMYL0vOGnsslJJI2R2Qym+Rq05CyB+8m5/d+waDkkYQ0U+On5b9Ed/Cd35nar7LY7FHbXzg/dvbN073qTY1nOYLv1fqIZ1qY+SB3ojHIebrcGs5xbcyZcxi5OI+DZkRXBcYTuTni+orxwgys71kPDhnbtoVEObsQ7Hko7vM+10BdyqX+j5tlzL3Yd++JtQlqOK+SadKKSKoyBtjRZ671LRBVv7PxQRtWSiZ1UrTc6atMP3Iwwm4P3ws19aNycRsiPxEkugFzhh8K8OMX4JpJrArQwsnHDcyIXCwLWTtGGoBUvZUnA0WMSwq7xwHAdW3ez3YMQa4is3eXvUVOuWh/D5XKCwqhNrQ3HpgukXzSrqPWR0yRhYjmDi3wNl04ZQd5X1gzObGv3ilRJfbt1uLADdSkMN0+1rUm4m7K+TFlfcyULFWNZgvM8P6LWdOmcTx5Wxvgd47Z+hJG7OqI8rEUZ+LnCAyFzfXCA5uFP0qRMLEE5OWMydBp/7rHYhAGonRx0G6t8rhOqgH6rp2TBm1Vzps3ElWdCCjx+5g1LcsBLsMHT7tAvFHzwOsbZR5jd/jW5vigPdQx7hPfequ98lA3b2i5I/1xjq5F+x9ZWS+APcztubvVl7gvcACqg37G17Yvyd2pt7zW3lz98Acf2s4g/zrU10yRM55xunF6f/D+a4Msf/vB1vZ4gD/8sIp0/aaPEgZZUtQ9vCykNKneZqopHrOQwKzqk8CyZPHrv68DQk8DR1L+iCaNGKp+Er+si/OJysHRY8u/B0lUuwm8zN9Da0KfAUUH5xrBY+yR82pQ/BSijzD5yUhGjT8Ifd7Uv8MDxBTSSGpPrme8vmUmLyItl5uubtZ9X7zG6eo8h4ffM/LWI4JWS7zA2/1usa4Mr9G4wy70F80n4r3/Cw7Pp48nDs+klTODKdsMPmOWfxHbvPaHaQf2n293Djf+OrmjV2nD+wTzqLROJvPWk4JImMIdFIdwTFIxPYNucPOD7uxI8Bc3EkiNoQ21WACKqTkGn8tbeIk2K4FK9ht6ggGgDSOMUbA5sKdXGO4RcUWVduCyUhjm8AXL//PwxjR6TUyD3L+Lom4vYFRdnNHmErphcXpw/WlQEUXyWIDkdcLTuH7l/GV1cRl874vNHjy4vKogpjeLLChi/fowPH7ri5QW9oF8TePuky51NKGg0jr1dz/6ZMSTeWgMCwR0SQIxNsJNddwPt5YVOx7DdLcMlHGbgMhi2WJbdRdpxM3hjT59fbL6mLOFtpzui8Y1NT4nkmeRSzRoBv9lNyFEsTQpfNV1Nww6mhJPOYntny14kXOtQLNgS5t1FWOOcwSiViv3DOnj+LVWjg2V0BtTr1nZt5Kp6KiC9pe1kNmtLu96yQylza8l6D1+hzqXQbIUzMKrAHrTzlpSJpzrH2Ly2B/IM3DN/j8wlfWY9WIAmFjoABbC7czayhmE3xLN6L4y6RGVviPvoZJ9zgPXTtW19s232XrUEKN/uTbgZJjxtmawWBeXbHg/3DotlT8VmDXNIZFzYhzpvieY5R1v8dvO3ZNy+wJzYnmdS2GWPycOE7Oyo9jfZ5rqihTkIvAX3nck4NuvT2oyaEeW9Q/N7MG681PjkiI+6SuUt2G8snD9qcoMMNdwyk7pGjRxjgwlUOd4Bx/RgPLpfdYJLno5OvCpM7szf1ZCVUE3fcxSHSLM6oTo68ax3PIIHDWuVb3gwNinTJ96K8vFJZ2sClCf9ubw2GfqhCRrMKnc9biZkIsH1y8VuTmqMGo92idXRCZxAOIezY3zYYkeO6fnoxIuYSMajmLP4ZnTaOWlwhcL0GHMtnjYyf6VkTqtvY8b9VVaMCWtio4StRu0i+ky09CQ9Jyeee1E6Io4GM9Z6PIoLpaUanY5yaUMmNerAnsLHANgU+2iAqabQ/5yq+ooq8Kvv7LbbBBdMdK8HZbndOiOuossqcwzeCyZs/rgTBZZl/eWZkDJHgQqEVLhApWyQHcQywXC7fVBnnme2WIP4rs+GK03OuKHeEfdp6/1Z/9z79wCVVlIgLygAAA==",
+		Length:   10287,
 	},
 
 	"data/results.template": {
 		Filename: "data/results.template",
-		Contents: "H4sIAAAAAAAC/8xY647buBX+P09xlglqGzsSM8ECbTeygDS7bYFud4NMtkURBAtKPLY4Q5EKeWTPwPAD9TX6ZAV1s+TLbAcois6PMS+HHz+eGw+VfPXdT+8+/uP991BQqdOrJPyAFma9ZGhYegWQFChkaAAkpEhjeovC5QV8QF9r8glvR1uJEklAXgjnkZasplX0O9ZNaWXuoXC4WrLdLv7Z6crhSj3s93wlNiq3Jla5ZeBQL5kvrKO8JgjjDPgY3YgSl2yjcFtZRwxyawgNLdlWSSqWEjcqx6jpXIMyipTQkc+FxuVN/OpX2MB+z3PveWYteXKiiktl4tz7nhg9avQFIvVAPneqIvAuP0W68/zuS43uMbqJb17H3zRgd56lCW+X/WcYUzLPX99yiElkGr11hO4sUMJ7UyeZlY8dthEbyLXwfsmM2GTCQfsTSVyJWvd6AEikGiSDTYQy6KKVrpUcZKZSHVDYFd1IJhCoiawBeqxwydoOO1pGdr3WCLnVWlQeJQMpSHTDgUI73g8Ltw4u+aJdzUA4JSJ8qISRKJdsJXSQbUYDe2f1sNWEWlB3JUxPxrvIGv3I0o8tHSM2ai1IWZPwIPfE0uDbUQP/vxJNeKvKkTm4VJsj6yg5HPxgz1aZve0H5U7QR6ataq0jjSs61l2tR2bs4YzYHMk1EdpLZg6FzF1dZpEiLFmaiPOJhKVJlr6vqwopuq3LUrjHhGdpwkWacK2OqPBaT5UzUcWZ8zi1Lk4OtLKuPPLMMMRA5MELTjj6JnsyKJEKK5fs/U+3Hxk4G3y2mztRxYiIMlVN0drZujqRA0ia6S5sCB9osGDg1Ds2g0qLHAurJbolu+0YtYmVMJDfCF1jw/0junK/P7fXeVZRRuaM9CGme6OSgYzMkEY60r7OSkUsTQbrr/VjVQSvhqEV9YpKuEpPnfqiRS8MJjxo5wlfmHRHnYQb0TfPZT+WXvWLipuTe7O4GSCr9Dcm89WbhFfDkt1OrSD+0Ur0sN9fXYqfiETmxwn2EDfBAzfYhMskNZLIWBdAL4TWLB0oTeOkjZAz6Z1EFnXX7njnr6KoPx1E0ZmsEjYbY1TCIKyERFAGerZjMzRXVr/0l6Y3BtAIzf8os06iQ9l1PTlVDb3cGonGD/3CbvAkp9OhzDmMuRPnoSINFkk4FefmbknQ5UlEczqX8ONtgswJmd3OCbPGE4cYqJ54f+M/+AXihhSwlVA63JL7fa9BGRAdg90O0MhjzHMYeRGWTECUWVkGz8CwriqEmYJshTPKrJ/EaVy4T/svJznVWIl8t4v/+EWaM7kqIZkOswkneUGgIXhRooshfKAmL35f2bzY77vyIpRV0SFpDpNNbdwMvaXALDTW9twep36w26GRE0UkvPHgX09MzWLt8ZA3qvRHC6bxnS06hJWtjbyGUlBeKLMGKhAqQYTOQJJbiemQ+xPe9OMmOZ1SG+fDSR67MFK4oZ5fWUvomvhum08XkxfKyNzqqJTRN9PUMUmUx9Gu1cUawgmpBFnHWfqha8LfFG7PFRHTEuJcLfXfoVgQVf5bzteKijqLc1tyf//Aq7bS8W2lw9I/KfpzncF7Z+8wp/8Hwp5wg/E9llW8Upyl//onvH5189vo9aub30MEt2Ea/oJl9Uyykzu4dZ3pU+hQAfE7sRHtaM/45XxVm6Y6my92/Qacw1ut7baJhO5usZAhNC8m2Yu9nM9eDHfRbDF+VM0Xb65GYnEH4j4NmevzbBGjyItz+4c1VCi/CK/N+Syvnbdudj2rrDKEbraIm3trPlb6WZgxlJDyXTDYfNberrPFm7Hg/vpZaA5Lu8EnARexNfNZaWuPdTW7HjBhjgs4gvVbRXkBc4y3hcqLxXR2d3wBcA4/4IrgnVb5fXw8mwuPcPPt8bC0eV2ioVjbvHmVwfKgHCI3nw3GOTpK+AsPj/uDUUdM/qqkDI/Py1xen3DZhLczbv+ujLTbZ/HYNktiW6GZDwjXMPsl08Lcn1mAceVwg4a+a6vr+cWzTcb2U1teDa2DDjgPJR5qzAk+vv0DZCIUVtZAIXwRyrifP/wwKCScuHYalqd2iMneklNmPaKmVjCvnY6bS2k+ezFbTHwmxFRf8IL41BWwM/g67BH7SitqFn26+Qxfw4x9bsNzPvOF3Y6UtB8f5l1T0bTstwUaaNEdQntIlPHV2f1nrasHbBNnPuz0hL93FhxO3+y3BIzb7xJN/0Bw0THcd6SnX2najzMJbz/Z/TsAAP//WMD35MMTAAA=",
-		Length:   5059,
+		Contents: "H4sIAAAAAAAC/8xZe2/cuBH/359iTjlk1z1LspMD2tpaFWnueof2HkGc6wNBcKDE2RVjilTI0a4Xi/1A/Rr9ZAWpx0r7sGO0KJo/YnE4/M2PM8PhY5Mvvvn59bt/vPkWCiplepa4PyCZWswCVEF6BpAUyLj7AEhIkMT0FpnJC3iLtpZkk7iRNholEoO8YMYizYKa5uHvgrZLCnUHhcH5LNhsol+MrAzOxf12G8/ZUuRaRSLXARiUs8AW2lBeEzh5APEQXbESZ8FS4KrShgLItSJUNAtWglMx47gUOYa+cQFCCRJMhjZnEmdX0eUjbGC7jXNr40xrsmRYFZVCRbm1HTFaS7QFInVANjeiIrAmP0T6aOOPn2o06/AqunoRfe3BPtogTeJm2OdhjMk8fXzDISKWSbTaEJqjQEnchTrJNF+32IotIZfM2lmg2DJjBpo/Icc5q2XnB4CEi17TxYQJhSacy1rwXmes1QI5q2gGOo5ATaQV0LrCWdA0gr1hpBcLiZBrKVllkQfAGbFWPAs6eSdmZuFS8lkzOgBmBAvxvmKKI58FcyYttlLH3mjZmxpRA0hsxVRHxppQK7kO0ncNHcWWYsFIaJXETu+BoS63Qw//v1JN4saVO1kSc7Hci47g/cR38Wyc2cW+d+4IfRDaqpYylDinfd/VchDGDk6x5Z6eX6GdZmaQ8dzUZRYKwjJIE3a8kARpkqVv6qpCCm/rsmRmncRZmsQsTWIp9qjEtRw7Z+SKI/MxYlEcTGiuTbmXmU4UAMtdFhxwtL56BlAiFZrPgu++fReA0S5l264DTwx4CFXVFC6MrqsDPYDEd7erhvCe+gA6Sl1eB1BJlmOhJUczC25bQk1d/RTAkskaPe93aMrt9pih45TCjNQR7d167gJKCjJSfQlpGds6KwUFadJHfiHXVeEyGvqvsPNSEov0MKFPRvOEMImdax7Ig1Fz0EhixbrPY5UvSM+6QcXVwZ5ZXPWQVfqT5mihZJQXQi0gyTXHtHd/Evs2PC85s8UNnEr+xi9/+DQbBM7vcqSBCmGhUXCL4QK0Aa5XSmrGgdknYD5nZXXD8hwrmrGqkiL3xS7+aLUK0j/f/vyTM+Dw/3PM+1IG6d9//MEhRklcDVz2XGW2uvGyVrjZiDlEjSu327NT5SYkltnhfrQrM27BLtFXl9FOQiwL2qk8Y1IGaR/FcVlpCsqR3ZBYFranlKHlL8KwSwgIwyNF2BkbYlRMIcwZRxAKOrbDzPU7fDf0V98aAkgE/3+YacPRIG+bloyo+lauFUdl+3ahl3iwBdLuVLiTmYP1RoVP7iSm4ljfLTE63YmoDvuSeN9MEh8hs9kYphZ4kBA91T2Dbf7gJ4g8KQjmTEjkAWy3nQe5QzQBbDaAiu9jHsPICzdkBCLUXAfwBAxtqoKpMciKGSXU4kEcn8LdAvxytAKV5hhvNtGfPnF1pLwnxNO+N4mJn1DwBE9qtGsI78lXgG8rnRfbbXsac6fQcLfP9J3+KuFFr8gxcx8LfczGYR5sNqj4yBFJ7DP48VruB0uL2+2wKIPyubNCgzDXteIXuxpNBULFiNCoE/V6r2A1G4Av82toNwlgag167sAsAqEp7QUwKZ1sVYi8gLK21Bi9HsL5aR1b2P3aHdYZMqmLWENzhVlL0PvUdbwr0G/+LRU/7Y6i9ZKVNjxq9EduH0PPP3F1vcIs/M1nWfDTQjt05eM2rMu562ZtHrXikIVtY9Kuv1bxopXW6rh8hOq3yEbercBdYB8hiWp5XRnN67y5B5ymKZSfPqqlMFqVqOhxdFMrEiU+X9DNy8vLY+iSEVoCUysgre+g1AaBCqbg5eUlWHRZ4jPN6naKzyXd7HnD4c/2ZXIoY4q38tlnu8YKleP1i9OxK5gFg+5mjxxWggrnopUGztbWx8QjgN+iEWydF/784tFeXF79Prx8Gb68auFv2o4M59rgdSsF0SSdriptBeFnuBytrk2O18EtGve88F4thLr/EDzi/TbPLqBJrYb/naj8ZlsgdLi+QxCsmAVWarWw5Put1Cu0n5ETc5bTtbbRnJVCrmdvkX/P6AFyTt+OVmPBluhbvio/btGf969PVZM2vRmUWGZoOkt+0OPYeqXQXLuNImMWHzLgNDlka49OyMpD8L1NIKnSN34fBOYrrs+xNk3C1hSQBrzPZc2xd5AFQV3JGpb24a4zvB2MjqgnJIXpX7bmWhMaf3RrPh9+VjnxoJJrGZY8/Hp8KhydgfcPclKcvE0bxgUjbeIgfdt+wl8Frk5cpx9AavM4DtLb5gvetplvnw7GFJNrErmNg/RV9/10mJIJdyJnKsc4SH/ctY5Bjd8Kjj2a/HciUBBV9jqOF4KKOotyXcb27j6umicN2zxpBOl3gr6vM3hj9EfM6f+BsCVcYnSHZRXNRRyk//onvLi8+m3oSjKEcOu64S9YVk8kO7pwNytj/Oa5e+uIP7Ila6Qd4y+n81r5TXh6vukMxDG8klKvmprRHJ40ZAj+aZR3al9OJ8/6W9TkfPh6Oj2/ORuoRS2Ied+fuT9MziNkeXHMvhvj7uPn7ll5OslrY7WZXEwq7VLQTM4jf+Oa7vQBjsIMoRjnr13AppPmXjg5vxkqbi+ehGaw1Et8EPA80mo6KXVtsa4mFz0mTPEc9mDtSlBewBQjf6o9H/fuKfsA/YBzgtdS5HfRfm/OLMLV9b6Y67z25yepm9cDmO2cQ2Smkz44e1Nx/9wL490uqAMmPwrO/Vn7JJcXB1yWzIDC1d+E4nr1JB4rPyTSFappj3ABk18zydTdkQEYVQaXqOib5iltenJuI9l2HMuz/mvngzh2RRol5gTvXv0R3FbMQSt3QitAKPjl7Q/R2WDGtZEwO4xDRPqWjFCLATUxh2ltZOT30+nk2eR8lDNuTXVPNcDet08vE/jK2YhsJQX5Qe+vPsBXMAk+NMtzOrGFXg2ctB1O5rU/izXsVwUqaNANQjNJ5NHZUfuTJtUdtooy6yw9kO9tBPvZe3szwKj5AcK3dwTPz856xzc1bvhzTPMrTBI3v839ewA553UXrBsAAA==",
+		Length:   7084,
 	},
 
 	"data/robots.txt": {
 		Filename: "data/robots.txt",
-		Contents: "H4sIAAAAAAAC/wotTi3STUxPzSuxUtDicsksTszJyS+3UtDnAgQAAP//QoSkjxoAAAA=",
+		Contents: "H4sIAAAAAAAC/wotTi3STUxPzSuxUtDicsksTszJyS+3UtDnAgwAQoSkjxoAAAA=",
 		Length:   26,
 	},
 
+	"data/slowest.template": {
+		Filename: "data/slowest.template",
+		Contents: "H4sIAAAAAAAC/9xY727cuBH/nqeYMilgA9HSmwRo63AFBE2aAm3SIHYPuI+UOLuiTZEKOVp7sdAD3Wvckx2of/s/5+SCQ+5gwCvODEc/zsyPHEr85fX//nn944c3UFBp0kci/oCRdjFjaFn6CEAUKFV8ABCkyWB6ZdwdBoKPGFztcwyCd4rOqESSkBfSB6QZq2me/J31KqPtLRQe5zO2Xk/+703lca7voWn4XC517uxE546BRzNjoXCe8pogyhnwbfdWljhjS413lfPEIHeW0NKM3WlFxUzhUueYtIOnoK0mLU0ScmlwNp1cPABOHgLPnKNAXlaTUttJHsIAjFYGQ4FIg6OQe10RBJ8feroJ/OZTjX6VTCfTZ5MXrbObwFLBu2kP87EL5svndxgmJDODwXlCf9SR4EO6RebUqvdt5RJyI0OYMSuXmfTQ/SQK57I2QxwAhNKjZcyJ1BZ9Mje1VqPNrlXvKL4V/ZZNBFATOQu0qnDGugHbm0ZusTAIuTNGVgEVAyVJ9uIZG+SDWPpFrMnH3WwG0muZ4H0lrUI1Y3NpAvbSiN47M75qBxqACJW0A5jgE2fNiqXXHRwrl3ohSTsreLT7zNRY20nr/vcyFbwL5UYmuNLLvexoNS58k88umEPux+DueN9KbVUbkxic037sarOVxsGdlcs9u5ahg2XmUarc12WWaMKSpUKeoC5LRZZ+qKsKKbmqy1L6leBZKrhMBTd6DwuvzW50dmJxZEFeL4qDFc2dL/dKM4oYyDyWwS7IpuEBpc8LBiVS4dSMvX1zzcC7WLO96iAUWzi0rWpKFt7V1YEdgGjVPW0I72nMYIQ0FDaDysgcC2cU+hm76gF1G+unY26PA0gyskesN/Qd8kcWMrLjjtHjC3VWamKpGBO9MKuqiAUM41MyxERwnR7W78ncnRAKHgPxmazvDLcGgls5PB7b6DbbYDE9PCXXaz2HyRu71N7ZEi01DWgL6/WubL1Gq5pG8GKaPhr9PYf21JmxzHmFPskckSsvYVrdQ3BGK3isnse/l9CeepfTi4u/svR6VcXjuXg+IqvS6wKBdIkQKrQEzgLKvGjzAW4Ovsf7FKRVozaQXLRqCb62T0Eu0csFKpC5dyGANAaoQPAYD+QAdwixtCaCV8Oru/W3iJpmgNOeR0Mgu0H7P+nWiaofBvK6Gke5swptGMeFW6KHrbNt+7ChTQMzSPxeQVDRRkpwKg41H7slHVe+6uIAZ+H8uME7ea/LujxmIPg2EMH3gAranMBDBL20C9wP4ok1KWi7snbveS9LbBqWrteT/8oMTawvUgczor5f7mkL8rXNJSFM+sU/xLQPw6HpbhAA+vLfDst2GARvs7ypKTQBN+VUpe9dLG5tFwEKuUTIEC14zGM57ZVj96LfSrGtLviAZgOXAtwVOnJM3mJLE+PsAgMBOcClNLUkPM0p69Tood9rorw8JNeI5Tsk2IDtOE1e41xbVMeV72MA/jDkPEzCKYJGukUqN80lrNfjxFN8EqGUxqQidwrj1H9pE6dGTmvbzmo1gvd2pxjehvNPvQNsePewPWD/nC/8eN2cO0fo2364ezxxjzlsAg4tTFKq5MVnu+FjLfDpVtdLpSU5z1n6sX+EHzTeHe91f8VZ6BoWzo5d8L/Cn7TSrEjngbP01fD8xZ6ahpdSx9u9tDlylr7bjL5BS/8NklIQVeGS84Wmos4muSt5uL3nVXcDCd0NhKVvNf27zuCDdzeY0/cFPRAucXKLZTWZa87Sn3+CZxfTvyXPLqb/gASuohr+g2X1VbD32umORrtfLTaXFX4jl7KTDtifnM1r296jzs7Xg9cnZ2z7YwY73x6dnb/s7Jr+YfcTR7edCN598/plAOtOtvkEEwAA",
+		Length:   4868,
+	},
+
 	"data/valid.yaml": {
 		Filename: "data/valid.yaml",
-		Contents: "H4sIAAAAAAAC/9x9W2/rOLbme36FZ7+ch4Ec3i9+Oo093RhgTtcUalc/BQVDkelEJ7bkluTsSv36A/mim0lLlmSZUgGN3rHNdSG/j2uJXKQcx5n9r2j/+vUcvv638pLFr/vdTiWLxe+RG8Sul/hhsFj8pnZhlDxtVRL5Xrx4ms0iFYf7yFPxQt/8X4m/WSz+eWjwNJvNZoG7VYu82eGzjfuqNovZb6UPP93NXh10zGbOzJklYeJuDn+lf/9e+gtylv0u/vB3O7XKvvtR+Rtlv1y7/qbwxT/Kf4LK75ZJuIxUnLhRUmkyS8JZ9Zu89embguTfLj7Jf+29u8Fb4Zvvlb/zX4b7ZBmul/FX4GXf/v99MgvXs9JneYvYe1erfdHHHxefFCwJo0h5if+plkejcpuyb2aVb9LWiZ8O8C1gSFsUcfD7+e8qBHau9+EW1P1a+RvMCYCCCIyJPP6Xj6G/yX/3j+IfYE4QBFQgAY9tRN5hKvr0vfynPyp/gzmAlEopQd7Gi8Ig76niH2AOAMBCgIIG9afKR+rvxT9S2SC1KPNF8pwL/uorp0LxDzGXQiDlAFpyfbnxg7L/s9InB9s4kIBBmf13AZwL3JTbEyykgHmr+H3p7pP3MPL/Uqvlh8qt/BG/z/KvZsWvUr+lgJgxdLaj7Mrr3vtQScmXykd8ng5lqQ+8MFj7b8sohZ76LMwc3w9fzC6/QHOJEBcAMyCZwFA0mojwnEsMAOGAHlpx8jQ7keTGGfLUqMiL74WPbpkdU1aqTxUkN5pwbFO04O/5J7cakE+l+0iVJtDi34XJau95Ko5zyFT+Bk+b8C1ePDnXXPqv8O1pNtuoz9T4RRAmR/ZuVRy7b2pxoI4fvM3AAVmp8GMEWsy+Pf9I3Df1snX94I/n7+F2GwbP6c9fnlXi/fEtnejct4P/ziwTnDHTmXkb92Btir208emHq+PPVmrt7jdJNl3+BwKQO4A7SP6O8ALyBZBzxqUUXELxvwFYAPAfT7ODmalxKvGedwdXn71wpZ5V8OlHYbBNh+d5F4Wr/SFiP2/DlKbx89GG560b+GsVJ/GzH/jJfLdL/UgngsUMs25d+bfdbuOr1cxzE3cTvs38YIbmjMxi5YXBqti1R7n6DjT1BoRzwAijECNc6Y2zB0/nnGIZJ26yj4/gPIWIl9SxPwzwP+cdi8WPQ8sDxhI/Sbs6/f3h77Y9/67cKHlVbqLv/HP3k8O/zy4synaXvlsmX7v8B4evvDBIXP9gw3LnJu9nVhYgfPrk/56teSpF0IKadBLcu6kLy+NgHMMWJxIdM6fzqM0qAdnJ+ypHf/rv94rOEwnKNLhKBIEklVAUh352ysoWs7W7iU/dcMyVFrMk2h8/KeRIxR+eUsTLtksv3AfJ4jQNFVqXvzhPpi9/nLq/kinlgtPo9PK8j6PnTei5m+dXP8gR0QaP30zCvg2BUlZB6XX3dLDNUq/2mG2gVANiSDGhkjJ2AeIsNewdthggTqCgOthmGM1Qm4PREtimIErT2fmqK2Z1kgYBLMRaxGo9uytczRr1Ey4TkDI4HFalIIALCGHDKdYisJ5jWPwVf6YQaAPRc9tOmIyTSCXe+3VEUkOgz6zvL9j/ONpTCfVlRbqZUmIpOeF14b7UZ2U8xiXNbdDICAWUjxCNpzWClyNw2mBxd86P2yPx+NtlrJL97ra8s2K+DozFVZDmYPy1YNJTaTmlpEs3G5bWWIp4LK7POMV+K6Nxd6n6RkhCMIcCUgIYJeOD5PcoDMaCx+r0WLRdB8Zsha0TEi+0GJ6C8sW7IgyzJb97YxAxBLigUI4Pg3kWdMLN8f/mXhis2+eUl7K+DYTTK4mlxsGeUksNcuvV6qCM0gSTQd40v+wLvRwgyfAE0KsS70OpnYocL9xu/cTZRaozjHVCh8IzgnV41ro8ILDN+vWTNRSYDAxwgaRkXMhJAjyMkzsgPIyTwSCObod46vRDMZ4ZYAA54QzKgVGOMaWYiPGuFLy68XsbLKftOoH12jaMaY21ZHR/iwPfz7tSxbWBXIcBbkwIJmrWBbJeKoPPKypsAzsiAIGIjxd23j7atIFd2u5hsDsYfWfY5TpMsxwhdatRWSf1jDoKGaSYjBd1K/UaRrt3N2gDvazxw/CXm39nEFYUGZCIJK3dBy13Wt94JFxKMuJZcBO/OpHaKDdu9cRUaP4wTBZduDMqL1QZcIkJgrQGl9Wu6xuZnAvO2ZiRGa7bQTJcPxCL4fr+IAzX19EHBGa16Dv1Us+wY0AQCkf8NBKoxHMT1gZ5p6YPA9/Z9Dvjr6TGBEGo2yEqQ7DYXX2jEAvJ8Ygnv7jlM3H8yGfieIBn4rjumRhJLGv3yu/0TMyYRITiEcPOi5Rq9WhybPk46B0Nvzf4ClpMz8aAIFIHv7yv+gaglEjwEUffeL8KW8FvvwofB77U6HtDL9Nh2l8BVLP0XAHeuZd6hh3HDEEx4lWZRG2CduUYx5YPg97J8DuDr6jFAD/O6xcFC33VNwDTh1054mrJJFKt1l/Sdo8DX2r0vaGX6dADDwEMaF3AzXqpZ9gJKKAUYLyw2yaRk/hBq7M457YPg19m/J0hWNZjeuCVhNStuZR6rG8oUskRpOOF4qe/bYPCT3/7MACmJt8Ze5kKE+wEhrAGducu6htxEggM0XgR5+4SP9mvWsXdc9u7Yw8CA/gy6++MwLIebUE4EVRCVjf7lbqsZyxKRIDAE8Cic+yKLpB0Cr35UGSefRkIoCV1BpwKAnFTnDolVPYIVwwIEZBhOgG4rtzE7QTWVIAFUD34MRRQc2X68zUYcSZoU5hmPdgvSKHkkEEpRl1q47uBs3b/3bLW5tT6cfAseHD/cpuSJu0xBQo5FJLV19sUO65fVGJKgEB8xCs9q9Bzjl3UCpVZ6weiMvfg3qisaNKjkkEGaW0VWLnj+kUlgwBxOOaFoI3/GscbZxV6rUpustaPQ2XBg3vX31Q06YM44oTi2hqwcsf1i0rBAaRi/LU4ThK5Kz/tX3fTviynKOVxKNV4NEi1zqVG/RMS5wBK2Khw56JDe0UvAYhhItGI59R44+7f3hMVtdrQPjd+HFZz+++9t11WpJ9PAYQA1pWUlXutX0BCzikDHE0AkMjxNr4Kkk7APAuxAKCZP0MBtaxQP5UySTkkTQFb6s1+gYsxFATzES83JW78EatWwf/U9HEgPdt+733yoho9IAnBlOG6rfJCf/ULQyIQQhyR0cOw9aJnsf3DATnIkuelLgM0MeC19eIX3dcvPhkGCDJARr2F3rqa49z2cbjMrL//lnpNOQeUgkoESP2++p3KOQjnggIO4Jivrjjcr/ge7qPN13OnR6FvZnHf7g/XmisrLxzs6X6KEnQb6TTUxwmOIGp6JUVX3EoBBCScjxm3PWB1SHwSIz6HwmQjHEIBdOdy7oZDKhlFdMw4dHfJ8+kNLPONHyfzVd7Thw/a47NW9AC4pUbc1jt+Xzw31G+qR4aak9/3grkEgAoMxgrz+NUPnl+/EuW9K++jHZ7LMgYArtACt+LK/RCqU2SYciUDzW+j6o5FAjkbYw1e9Upw1998PUvpdATmFYEDoFTW3LR+6eQAiatJqwG/lHBMhsMvF1CO8ULhQiYWb549FSXxc5yoTzX/UNvd3HPnXtQhWbgi9P44vnJL4DVn75zw1mk2pcAMDZUBUwAgoHDc03Hez3JNFeMrNAd94DiXNgCAcQMAF9wbCrlVlabj7BJxNhxmCSMcTWbV69V/+3Q8N3Cjr37WvQoCB0Aua7TyVXRyuLWvC63GVQdG4HD4FRiwcV84HCduEs//O57HKvrstAZWFjQAXs0pb9WpO0+yOm2mY/MAQTIYPiHCCEkwpVWx/hbB7o9QjBqveQ28xHXt3QQMAwHwcBhlUAI4Qoz+/U/lHV+E9+oHaQ87byqZ7XcrN1Ht3y14KWsAnFZz1quu6ZCavTu5JVLrFZrmU4rp5fJr9l7nfrGKEJVUshHuduWvzm07fQ6Awmr+mRutg1z23u2WkKtIv8BX5VXeRXhl7//uG16SAIZG+wifbHft4JVsd/eHF9EH49To+8XeTLph8whBNNwSEcKIEAhGu+L56UbtMXZqPADO9AtAZ+Pvh7WSBtMOkWQID4c3Rhka9wr7YdNiHu+3cYcVnUzGAOgzb7MXXLnzuk1FkWm3RxAx2KMwIgARQPlEdiu3K9oRkxeyBsAma7JHeXZtsO3JkkITVjEfLkwTLOioL7fZhpvNl/O2d6NVq4vm8uadMHmQszzIafdS6KIf/RUp/zM3q3rxXFWfqeQIgdri+WonljG6vbChDVApAVTAkb5QcpmO/YsK3NeNmnVE7KWUYYDLNROq0S/ThHpo0BnD9apNKan2zQHrkmF3Aa8gHAM53ll2F+72Gzfyky8nHTjVbm38Ukq390Fm4pYncbe9AP2Kc/3NwL9eGFmZiE3aTdkB57V3gRr6ufKGSJNhbQDOGAccTGF23nkdJuWdNyigWd2UvPN6nYmNWDYqNr1bEgJy0zTcL1il4GCMLzXNu9n9DP3VTG1dv9XB5ULzQQELkRGxRY8GxuyFatPUC4DAj4Mtx1DgMb93aB+4SaKClVo5+91b5K5Uq0UFjZhOIM7lLc/yajaPDHmEzr/+Eol/XZpZySSM+o1vTa+72MzU1WWU742WtYG5QEACgMZeR+LukrkXBuv56pmCnpD/rbH0b8My4vp5q9qu6Gn5zcyQNvboSMMoQRw1XkjumRgMCwjlhIghZRK5Qbw59HJ/jCiLHZgKpxvzG3Ch4v3jSKAzRB8yCAC0+UHbXuEvCQaYiFFv8B1rjJxTjI0P/d8e9TppQ4PdvMui9XVAjJv1m55JEW1+uLZnaAvBqJzK6cZKz/eyb1iROTTMeZPNxKrfA4K9zgrTARwMmHwM5CmifJRvM71ylD9W3j591u/x7oSzyKEBL5pfoJB5PXACc8UIE9yJpA9KXiiVCI8X7k2emuar3cebs2p9bOI2HQMTAumz+hv7ZSCGtLHKRBnOMX8QZSQEeLwFfc0HIdys7s2YcLMamjCoI2HSXrGOL5lRpmcIjuGD6MIQIoSNeHdgE3rupt3jwqlpJ4SfZLQr3jrb3t9q/38VXHL0eky7VAyKuot4NyXpRVC2gp6UUoz7ZPKxQ+ZvKmifvOcyvt0fiebzyAVXepo/y1g0a9KumBMGMWONj8mXkdkGjRxzgcaYah/OJR79X7YEYt76/gi8uGSvan9PZzfL6NMqMSWvjMtrxzUrvdUvEBlDiGKGRnsgfu1H6qe72bSbEM+tu02FZym37cdX7O9pFvzHSV5pGiwrMR4+kc2vyluX1bQCnmSCg1HvlAQq+RlGH8/+2tnv5qtnAJxueKwROgRMzZvk17y9J3ob6dZGdSwJ4c0X03oANcaIci7GenXDVEF9sd3dzN2ecoMKqm/QrZ+qMUKci6ZXPHRGNUdcCCwIG+tUHYVh8jyP4/dnd5+8h5H/l1otP9RXjOatV7Wuy+yG6Th+P4hqda61xtmeJuofJxNLE3UT1aZLSwRsfsFOXFbeAtFYCATAeJdtzT3dN5oHQTK7EckPQnFN2fWwCJaQMzrW09vHivbTfZzv6f9WSy8M1v5bl8tNS4IGAa4wHhTQ+tbniQENdus0m87OMoDhTQcG+oAvBZhhMtJEOVJx4kbJMu3iNogtth8Ap5Tp0t+SEz2luhVUGvQYplBMqbia1l50W/+wFBJTPOL3RL75ieOFUavr9s5tuyHyK3ZXWz9Y7hN/0+6ka+ZDfxtVP4pWVbaryurM7+7hdS+FLvVfBZoa/S3wSQAlEIMR759u3Y9W2EzbDYVL4+UXqe3DYDJXZdrDB0zU4THrszthUUok2Qg3VH947yoFxUsYbL6Wh4LUNpDMWw8FzIsXR+o80QH0/LseEGpUeQFUOaeUU+11kXHRHqfak/eBK8QcgTFOnYckytuEgcr6Ypb2RasiFJ2cweArdWmo1rO+0lENdmqUVmHcdJm1N5hSKRjp/7k+ifb3R+mxrL8HmGoFDYVTrN0t0Pt2b6Be0fpwpAopJMPjRKq/3YVRMnvbvc0+1Nfs/3xHTIq/wTZYNYjqhFZ3l9z4Up2rXvWE0n+6/rkI5ZdwpV5OUDl//bddslj8P/X1UtbrNLBNm+VyRokg+Bqu3VTlh/rK/zz9K/9s5aWKXHgd6UWKXEU9AxhDMtaFq9M98sv2d/KXJdwX5FqMV1zoCdp/y5BjVGJa+JdUsBqMVnusPCHnqG0xDVMCsCRjPJb5I35flrdYXuKPP/8zfleb8NWJwrDVEZ2KiAFWVy/OXTZwTPuQdtGs2+LrD5Xsd+nfL7lKp7FxptIDysjlGm2sN92ZxWcbziu45yHpe+WWQQYA5WQyNFgp9y3cdKJBQcQANLg4S9PAMetocGGcYc+MIEKkjTRAEBIOJxINEvWp/jOO3+fHl4yG0dt8/9GeEmZxQ9CjSZS44rBdVKkz1EAbSjCjVtKGUiI5nloSFTubfdvbFzVi7EumMgeHpUdZrXOTkYbEilCCeHtqlIaod3pgRCmHcGrJVVd6VMTYl2TZTQ+tkQZ6QKyrDbGFHgIxgMSUk65OVLku0u7ky2IKNTHYQCesO+tlCZsIgQwKNL1crMtdY1pBNuZjVy8WuydfyoqdGw01PbBIDGEXopSGqneqUEQZBnx6eVlXqlwIsjE3s50qBkNNVKGCC4upwqFEAkw7R+tEmzqhtudpVtOpmdEGajHJiLSXWgwyRicUhbIU4eNz2zlX+/jc2pimpa4NzZNMp9PcPAMjOAea10/ewIjzsPRPBkEw5Gx6KVkHMhRl2JiI2UmGS/NM4YFhDK0kA8eQAiynnXS1JcYVebanWhYSptZUE3m0B7usIA+XGCA5vbTqNQw/OudVqRAbE6uDc0NzI1fq3GCgKbdCpBsjsqHpnRKCSMjEBNe7ulCiJMTG9MpWSmgMNAUJTICwlBKSYzSVyhVTEG9Nj2sCbU+yrKRNvbEmClEAuZ0UkgRzOaHS4bzsyA8+Us+7l3+dBFlZAHZ2cvAN+pJi50ZDTfX1vONSb2mo+qYKBxADidEEC8E6UuVCkJXFYJZTxWCoqSQMwG5783emCgeASDrxorAutKkTan1hmM10ama08YJZhi2mFkQMQjbBav3XTfjWfWVsE75ZuTKWOjf480ym1LnBQFOJi2AUd3qGOQ9N/5SQQnAwwQr9LpQoCbFyZcxSSmgMNC0WQ4aQnZRAFEEM2cRXxtrS45pA61fGbKRNvbEmCjEMmKUUkpxM6XE/C/V+2DnN8kMbkyw/HJwYZ5VOY+NMRABQ0C5EOA1J7zTAjFMOxPSSq/Y0KIiwMbGykQYXxhloICQi3EYaEEgQQXDaCVVLSpjF2Z5MWUeVOkNN0QMTwaykDSMYSja9JCr5qdwPteqcSZ3k2JhOnV0cmiElvc5tZpoumCCAddpZLA5T7yShGFJIyfRSrI4kqcqxMdmymyR6Mw0koQRSi0kiBZMCTTsB60KYGpm2p2IWE6mRyQZSAUQAsZZUjAqC2ATXuLZu1L3MPhViY2J2cG5onuRKnRsMND3mC95tMzEbmt4pwZEEk0zGulCiJMTGNMxWSmgMNJbZC0ItpQRnFJKJbya2psc1gbYnXVbSpt5Y4348xdJOCgmEJJxiorV693adE61UiI2J1sG5oemRK3VuMNDw+MExl50okQ1N/5SQCBMwwUSrCyVKQmxMtGylhMZA47MHktxOSkgigEQTvzKiNT2uCbQ90bKSNvXGmha1KEa2UkgKAOUEzzPGB0J0TrXi/GvLkq2Tg0OTpKjWuclI0zYKorjTg3xhiPqmhwCcU0gneIaxGz0qYmxMvGymh9ZIEz046lbxe096QCwEBRNPwDpQ5bpI25MwaynUxGDjsjEm2Fo6SU4goBMsoQ8SFak48YPuRxYLsqwsqi+4OnitZFW3c7u5Bt5IwrtdyVIdtt7JgxhCjIAJFt53J49OlpWl+KMgj9lc4w3EkEKryYMhR4xO/Mb7rkRqINf6An77CdbYdNPrVjnDwm6yMSyntKiQ31ql/OS9+wViqRQrbw87uDf4BS65VucWE43XtXDZqcAyH57eiSGlYBJPcIuzEzHKUqy8K8xaYuhMNG3SCILs5IUEnKDJ1/K358hVidZfDGYndxqYq+cRkVBwSwOMhIhhKcQEy8qCOFBJ98KygxgrS8uODg5eElBQ69xkpOlaMM5Ap6WAwhD1Tg+EoaBwgu8j6kaPihgry8wspofWSEMSJiGX1Fp6cIIYm/j1+R2ocl2k9SVntlKoicEGOjEqOLOVThhJysgEk7EPte1e458KsfJlkKlzg7/PK1Pq3GCgqaJGSt4pxmRD0z8lpJQIygm+ELIDJUpCrHwlpKWU0Bho2jNBQGI7KUEoIpyQib8Wsi09rgm0/sWQNtKm3ljjhZRAWBpVKCACwgmWlcW9ZFqxralW/BCGxLckW3EtLyjGotNycHxHYnAmIMQTrO7vJd+KbU247CWGzkQTMQBB0FJiMAwYQBMvB4t7z7riMaRdlpKngbnGe/g63oF8TyJxSAjm00u91n7nvGvt25h0rf3BuXFW6TQ2zhRSIO52I+VpSHqnAacQMUmnl2i1p0FBhI0plo00uDDOtOsBOaBW0kAiRqfySnpTwG5JCbM42xMq66hSZ6hpERgzImykjSBECCKnl0Tt9tFu0/2N20cxNiZTJweHpkdRrXOTkYaaRoG6re0WRqh3dkiIOeETzK26saMixsYcy2Z2aI00HSkRgNpLD4EwIBOvne9Alesibc+9rKVQE4NNT/FUQmwnnQQAFEjG2SQr6J0weuujhD6VY2kN/cHFB5Q45nqd28w0cYQh3rVOOBum3kkCEURgkle1diNJVY6llfQWk0RvpumBXkqK7CUJpwxhNPlq+taEqZE5gnp6W4nUyGRj9TCH0FpSIcgw53B66dnrJnzrYc/xKMbG5Ozk4CPeVN98/7H4a9MFewJ1u+u4MET900NyivkEDzh2o0dFjI1pmc300BppfMEE7XYi6570wARwDCZea9+BKtdF2p6QWUuhJgYbL/uiDFlLJ0kgIhO8Wz9KhXfOxQ5SbEzFju4NzZOCVucWE02BhgHSqRYsH57eiUEYg1OqiswSgE7EKEuxMQmzlxg6E00hgzEJLSUGhVIIAqedgLUnyVWJtqdflpKngbkmIgnGha1E4ghyNMII8w9/o14O8I2/Yi/ZzFfP63i+i8JEeYlaLd/daLXxg4947oXBug2LvjWW/q0bpQ4aalIzUCHUje7r2JSKuJE//3T94PTPX8KVejnBLKPNwZTF4odKXvTWnH/Zxnw9uxgGujvD1362sR+frIqz+1mOn+R0O7fRWnCdXEVCXiUaYwSNsrzm738q7+XYY45hUNuEqGsC704nVKVTAyd1FEqbPYpCTU02nqpk4PK4sTo75DQYIVuoxYmgcIx7OXWzYPy1vV8IKwm3MIKVnX9wADsb0zR+aYzX85BCgDDuP3ydDeiJYgJIIaWYUPTKRrSn4FXqcDtiVxm0jwtdVfI0NNgQuDAFDN4auCp0sINTAlKE0CTC1oeKArWZ7yI/SD76CleXQu0IUxpnhw9PJSOuhCWTsYYDPAQT2E84KinuiTKSIUjwyB+iyiPXPvxcdvDDwo4GjIOGGy0ZagzUEwBiKjQXK1+GFx28H8kNeOAuFZMIJ5/b+Xbr7pZbP1i6q1XUV0TRyrUjqOhdHj6uVO24ElqumGws6oEQ9RJdqrp7IhEERHA28ueciyFsH2O03fywMKPH5qCRxkSPejMN8QYiTprEGwPgH04YjgHCeCpRJ/7p7nZ+oOK4x5BTEWpNvKk6+5BgkxtxPdJojTXdayMRon2FmVxxT5RBGAPBxx9jCiPXKcBUOviR0aUKxqFDyyUZagw0BRVCgGwWVC7g/VhuSCnlGO/oMK+8RG6wCrf+X2r56S7jneupntfHDAqsWiszdcLD1s0uDapfQ7vqhGE9jUMpRZ/raZdG9EQ9TAUgYyzC1qzzaEa38zqboeMfveZmAvIj1t/MpLrBcGNI0126ZlyXM9LEGr4RRAnjYkqhLv6Ko3/3HN1ymVYFtIKrD4thBxvqw1bVVEMhggCCwz4j1UFvX2QRmEIGJxGcjsPWOR7l3fvoEFTA4SOiTokG180zxBaCKWweWoq4fiQnKOOQwmkEkEAl80Ala3+TqGgerJdeGARJ5Hofy8TbLTdhGPf22NRYlx0Bp3nXDB+Imth2JUDd6JopcEkI+glcTezpibyMYMEpH3dAazT87QNd4+F4WABsjv9BA+MttGznjulOEyJZg0B6A89s5C5HHAE6wmu2f3W9jxRW4U4FQbJbteHmuW0n4gXJriYwwgrrLkzXser0o36J9UuyWyy+b3wVJC8pLM6f6y0yZphEc9nPrmCvU+5ZZxaker2D3vyTDKiFj1M9PSFbQAAZYWNOKU9+P3fB+DetpG93hjwx5oIXPg2T6ZmQX2eYjgMIUQw549fytIcgnjAIRluEnebGfqtLE48t74toBHWIPtlsAYSLlhi2bxhASF7DbKEfHwJgAtFhGWCkDxKRihM3SpZtZ+pi+/uCGeseA0rm95Tn/5JBxqDCdC4GQ4avJd8XfVW+TCCHaot7BAiUiAs86jOYm9BzN4dJonXKkIm4c66AmTFXyL0YcIb9V+K97GO13CfeZZJQsciQIQtIa3ODfeJdgDX/7GRAT/Mq4pQJCcYM6NS/v8KgA57PEu4NZ26Ec+aDLWguG2SYiwGSjFkFZ4wAkOM+I58O/uaz4y5AQUg3UB8FqevAFkZcF50ZBtq/HQ1eLL6HwfplHYaX2L4wypQVE3T9kGB01pWKKn9WyJvP7cKwL5ALCsUo3/DlvasUVi9HBLaB97FlDukchdWruCq6tBdtnX5zHYE6QReI4XMkOFGO7kWjRS1O0YOro8wxhAKMeZTfw320+WozyseWt4zySVf3US4KuhhlOocC8yajXPDg+ihTCAQa8yivXL/dIB8a3jLGR03dh7ggR8NjihoNcG799fFlFBHCRzy+P5X6aDfAx5a3jPBJV/chLgq6GGMy5xTBJoNc8OD6KAtI8Sjv6Tx32DYMkvd2w3xqess4n7V1H+iSJM1IMwpRk5EuOnF9qKWgcJR34p+7LFCfqtVh2UPDW4b5qKn7IBfkaIZYYtloiHPzrw6wgIgRRMb5CPm69z5UMkR+fanN9Hx3/NXVkTYI00RnAaXUDva6rKl5ni2wIGR8c/d7GCeL2c+fP0sX9T4dXc09nR09BXBOJKaIEUlmR1efPvxgtZi5u93m6ylSuzBKlusw2rrJYsaejr23/FRR7IdBSjMxR0/HbaB9dByT7MutGycqKt8YrKRkLsB4jdmKQAZcQdCKeyvXJVyusMKvriKv6PUpidwgdg+rDsv93l8tZkwI9QpeXWf16kGHCEUdl7jQke4KylcGxGolnzw3cTfh26nJaoWYwGTtCEq4Q6CAjkBr6Kw84UHXBZ6E5Mlz0wlheW4ZH8C/mAVhstzHavVUWAtZzPK1kKfzD/fBCQZPQRjuzgOR/nu5U8HKD97OnxlH7X8CAAD//7STHGiaBgIA",
+		Contents: "H4sIAAAAAAAC/9x9W4+ruLbue/2KnPmyH45I+X7J016aZy0d6ezu0+rZ66nUiihwqtiVQBaQmqv612+RG5fYgQAhhim1upLgcbG/z2NgD4zjOLP/Fe9ev56j1/9WXrr4bbfdqnSx+CN2w8T10iAKF4vf1TaK06eNSuPASxZPs1mskmgXeypZ6Jv/Mw3Wi8Uv+wZPs9lsFrobtcib7b9bu69qvZj9Xvry013v1F7HbObMnFkape56/yn7/EfpE+TsfF3yEWy3yj//9qPyGZ2vXLnBuvDDP8ofQeW6ZRotY5WkbpxWmszSaFb9JW99/KUg+feLb/KrvXc3fCv88r3yOb8y2qXLaLVMvkLv/Ov/36WzaDUrfZe3SLx35e+KPv64+KZgSRTHykuDT7U8GJXbdP5lVvkla50GG3UbGLIWRRz8cfpchcDW9T7cgrrfKp/BnAAoiMCYyMO/fAyDdX7dP4ofwJwgCKhAAh7aiLzDVPwZePmlPyqfwRxASqWUIG/jxVGY91TxA5gDALAQoKBB/VvlI/X34odMNsgsOvsi+blZGvhfORWKH8RcCoGUA2jJ9eU6CMv+z0rf7G3jQAIG5fnfBXAucFNuT7CQAuatkvelu0vfozj4S/nLD5Vb+SN5n+U/zYo/ZX5LATFj6GRH2ZXXnfeh0pIvla/4PBvKUh94UbgK3pZxBj31WZg5vu9/mF3+gOYSIS4AZkAygaFoNBHhOZcYAMIB3bfi5Gl2JMmNM+SxUZEX3wtf3TI7gqfZTH2qML3RhEObogV/z7+51YB8Kt3FqjSBFj/nVyY7z1NJkkOm8hk8raO3ZPHkXHPpv6K3p9lsrT4z4xdhlB7Yu1FJ4r6pxZ46Qfg2A3tkZcIPEWgx+/b8I3Xf1MvGDcI/n79Hm00UPmeXvzyr1Pvz29Nslrpve/+d2VnwmZnOzFu7e2sz7GWNjxf6h8t8tXJ36/Q8Xf4HApA7gDtI/oHwAvIFkHPGpRRcQvG/AVgA8B9Ps72ZmXEq9Z63e1efvchXzyr8DOIo3GTD87yNI3+3j9jPmyijafJ8sOF544bBSiVp8hyEQTrfbjM/solgMcOsW1f+bbtdB8qfeW7qrqO3WRDO0JyRWaK8KPSLXXuQq+9AU29AOAeMMAoxwpXeOHnwdMoplknqprvkAM5jiHjJHPvTAP9T3rFY/Ni3fJrNZrM0SLOuzq7ff27b8+/KjdNX5ab6zj91P9n/fXJhUba79Nsy/drmF+x/8qIwdYO9Dcutm76fWFmA8PGb/3uy5qkUQQtqsklw52YuLA+DcQhbnEh0yJxOozarBGQn76sc/dnf7xWdRxKUaXCVCAJJKqEoDv3smJUtZit3nRy74ZArLWZpvDt8U8iRihceU8TLtksv2oXpYgaqrcs/nCbTlz+P3V/JlHLBWXR6ed4l8fM68tz182sQ5ohog8dvJmHfhkApq6D0uns62J5Tr/aYbaBUA2JIMaGSMnYB4nNq2DtsMUCcQEF1sD1j9IzaHIyWwDYDUZbOzv2umNVJGgSwEGsRq/XsrnA1a9RPuExAyuBwWJWCAC4ghA2nWIvAeophyVfymUGgDURPbTthMkljlXrv1xFJDYH+bH1/wf7HwZ5KqC8r0s2UEkvJCa8L96U+K+MxKWlug0ZGKKB8hGg8rhG8HIDTBovbU37cHomHa5eJSnfb2/LOivk6MBZXQZqD8beCSU+l5ZSSLt1sWFpjKeKxuD7jFPutjMbtpeobIQnBHApICWCUjA+S2SrUWPBYnR6LtuvAeF5h64TECy2Gu6B88a4Iw/OS370xiBgCXFAox4fBPAs64ubwv3m2MNc+p7yU9W0gnF5JLDUO9pRaapBbr1YHZZQlmAzypvllX+jlAEmGJ4BelXofSm1V7GQra0HqbGPVGcY6oUPhGcE6PGtdHhDYZv36yRoKTAYGuEBSMi7kJAEeJekdEB4l6WAQR7dDPHP6oRg/G2AAOeEMyoFRjjGlmIjxrhS8usl7Gyxn7TqB9do2jGmNtWR0f4sD30+7UsW1gVyHAW5MCCZq1gXOvVQGn1dU2AZ2RAACER8v7LxdvG4Du6zdw2C3N/rOsMt1mGY5QupWo86d1DPqKGTZ1sF4Ueer1yjevrthG+idGz8Mf7n5dwZhRZEBiUjS2n3Qcqf1jUfCpSQjngXXyasTq7Vyk1Z3TIXmD8Nk0YU7o/JClQGXmCBIa3BZ7bq+kcm54JyNGZnRqh0ko9UDsRit7g/CaHUdfUBgVou+aHUP2DEgCIUjvhsJVeq5KWuDvGPTh4HvZPqd8VdSY4Ig1O0QlSFY7K6+UYiF5HjEk1/S8p44eeQ9cTLAPXFSd0+MJJa1e+V3uidmTCJC8Yhh58VKtbo1ObR8HPQOht8bfAUtpntjQBCpg1/eV30DUEok+Iijb7Lzo1bw2/nR48CXGX1v6J11mPZXAIWyDninXuoZdhwzBMWIV2VStQ7blWMcWj4MekfD7wy+ohYD/DivXxQs9FXfAMxuduWIqyXTWLVaf8naPQ58mdH3ht5Zhx54CGBA6wLuuZd6hp2AAkoBxgu7TRo7aRC2ehbn1PZh8Dsbf2cIlvWYbnglIXVrLqUe6xuKVHIE6Xih+Bls2qDwM9g8DICZyXfG3lmFCXYCQ1gDu1MX9Y04CQSGaLyIc7dpkO78VnH31Pbu2IPAAL6z9XdGYFmPtiCcCCohq5v9Sl3WMxYlIkDgCWDROXRFF0g6hd58KDJPvgwE0JI6A04FgbgpTp0SKnuEKwaECMgwnQBcfTd1O4E1E2ABVPd+DAXUXJn++RqMOBO0KUzPPdgvSKHkkEEpRl1qE7ihs3L/1bLW5tj6cfAseHD/cpuSJu1jChRyKCSrr7cpdly/qMSUAIH4iFd6/MhzDl3UCpXn1g9EZe7BvVFZ0aRHJctqAmurwMod1y8qGQSIwzEvBK2D1yRZO37ktSq5Obd+HCoLHty7/qaiSR/EEScU19aAlTuuX1QKDiAV46/FcdLY9YOsf911+7KcopTHoVTj0SDVOpca9XdInAMoYaPCnYsO7RW9BCCGiUQjnlOTtbt7e09V3GpD+9T4cVjN7b/33nZZkX4+BRACWFdSVu61fgEJOacMcDQBQCLHWwcqTDsB8yTEAoCe/RkKqGWF+qmUScohaQrYUm/2C1yMoSCYj3i5KXWTj0S1Cv7Hpo8D6cn2e++TF9XoAUkIpgzXbZUX+qtfGBKBEOKIjB6GrRc9i+0fDshBljwvdRmgiQGvrRe/6L5+8ckwQJABMuot9NbVHKe2j8Pl2fr7b6nXlHNAKahEgNTvq9+pnINwLijgAI756Ir9+Yrv0S5efz13uhX6Zhb37f5wrTmy8sLBns6nKEG3kU5DfZzgCKKmR1J0xa0UQGSLCGPGbQ9YHRKfxIjPoTDZCIdQAN1zOXfDIZWMIjpmHLrb9PkwYsl8HSTp3M97ev9Fe3zWih4At9SI23rH74vnhvpN9chQ8+T3vWAuAaACg7HCPMkOC3/9SpX3rryPdnguyxgAuEIL3Ior90OoTpFhypUMND+NqjsWCeRsjDV41SPB3WD99Syl0xGYVwQOgFJZc9L6pZMDJK4mrQb8UsIxGQ6/XEA5xgOFC5lYsn72VJwmz0mqPtX8Q222c8+de3GHZOGK0Pvj+MopgdecvXPCW6fZlAIzNFQGTAGAIHs/zzTgLFdUMe6jOegDx7m0AQCMGwC44N5QyK2qND3OLhFnw2GWMMLRZFa9XoO3T8dzQzf+6mfdqyBwAOSyRitfRSeHW/u60GpcdWAEDodfgQEb94HDSeqmyfy/k3n2NodOa2BlQQPg1ZzyVp268ySr02Z6bB4gSAbDJ0QYIQmmtCrW3yLY/RGKUeM1r4GXuK69m4BhIAAeDqMMSgBHiNHsfcGHF+FlKzTuNnXeVDrbbX03Ve3fLXgpawCcVnPWq67pkHp+d3JLpNYrNM2nFNPL5dfze537xSpCVFLJRrjblb86t+30OQAKq/lnbrQOcuf3breEXEX6Bb4qr/Iuwuv8/u++4SUJYGi0t/DpZtsOXulme394EX0wzoy+X+w9SzdsHiGIhlsiQhgRAsFoVzw/3bg9xo6NB8CZfgHoZPz9sFbSYNohkgzh4fDGKEPjXmHfb1rMk90m6bCic5YxAPrM2+wFV+68blNRZNrtEUQMdiuMCEAEUD6R3cqNTzti8kLWANhkTfYoT64Ntj1ZUmjCKubDhWmCBR314TabaL3+ct52buy3Omgub94Jk3s5y72cdi+FLvrRX5HyL7lZ1YPnqvpMJUcI1BbPVzuxjNHNhQ1tgEoJoAKO9IWSy2zsX1Tovq7VrCNiL6UMA1yumVCNfpkm1H2DzhiuV21KSbVvDliVDLsLeAXhGMjxzrLbaLtbu3GQfjnZwKl2a+OXUjpBNxe3PIq77QXoV5zrbwb+7cLIykRs0m7KDjivPQvU0M9lZG9NhrUBOGMccDCF2XnrdZiUt96ggGZ1U/LW63UmNmLZqNj0bkkIyE3TcL9glYKDMb7UNO9m9zMK/JnauEGrB5cLzQcFLERGxBY9GhizF6pNU29Wg/E42HIMBR7ze4d2oZumKvSV7+y2b7Hrq1aLChoxnUCcy1ue5NVsHhnyCJ1//SUS/7w0s5JJGPUb35ped7CZqavLKN8ZLWsDc4GABACNvY7E3aZzLwpXc/+Zgp6Q/62x9G/DMuL681a1XdHT8puZIW3s0ZGGUZKdMtF0da5nYjAsIJQTIoaUaeyGyXrfy/0xoix2YCpAWELzorH3jyOBzhB9yCAA0OYP2vYKf0kwwESMeoPvUGPkHGNssu//9qjXSRsa7OZdFq2vA2LcrN90T4po84dre4a2EIzKqTzdWOn5XvYNKzKHhjlvsplY9XtAsNdZYXoABwMmHwN5iigf5dtMrzzKnyhvl93r93h2wknk0IAXzQ9QOHs9cAJzxQgT3ImkD0peKJUIjxfuTe6a5v72483xWz82cZuOgQmB9Fn9jf0yEEPaWGWiDOeYP4gyEgI83oK+5oMQrf17MyZa+0MTBnUkTNYr1vHlbJTpHoJj+CC6MIQIYSPeHVhHnrtud7twbNoJ4UcZ7Yq3Trb3t9r/XwWXHL0e0y4Vg6LuIN51SXoRlK2gJ6UU434y+dAh8zcVtk/ecxnf7o9E8/PIBVd6mj/LWDRr0q6YEwYxY40fky8jsw0aOeYCjTHV3j+XePB/2RKIeev7I/DikL2q/T09u1lGn1aJKXllXF57XLPSW/0CkTGEKGZotA/Er4JY/XTX63YT4ql1t6nwJOW2/fiK/T3Ngv84yitNg2UlxodPZPOj8lZlNa2AJ1lW4DTmeByq9GcUfzwHK2e3nfvPADjd8FgjdAiYmjfJr3l7T/Q20q2N6tkb6XnzxbQeQI0xopyLsR7dMFVQX2x3N3O3p9ygguobdOunaowQ56LpEQ+dUc0RFwILwsY6VcdRlD7Pk+T92d2l71Ec/KX85Yf6StC89arWdZndMJ0k73tRrZ5rrXG2p4n6x9HE0kTdRLXp0BIBmx+wk5SVt0A0FgIBMN5lW3NP943mQZDMbkTyg1BcU3Y9LIIl5IyO9entQ0X78TzO9+w/Pys9XwVvXQ43LQkaBLjC+KCA1rc+nxjQYLdOs+nZWQYwvOmBgT7gSwFmmIw0UY5Vkrpxusy6uA1ii+0HwClluvS35ERPqW4FlQY9hikUUyquprUX3dY/LIXEFI/4PZFvQep4UdzquL1T226I/EpcfxOEy10arNs96Xr2ob+Nqh9FqyrbVWV15nf38LqXQpf6rwJNjf4W+CSAEojBiPdPN+5HK2xm7YbCpfHwi8z2YTCZqzLt4QMm6vB47rM7YVFKJNkIN1R/eO8qA8VLFK6/lvuC1DaQzFsPBcyLF0fqPNEB9HRdDwg1qrwAqpxTyqn2uMikaI9T7cn7wBVijsAYp859EuWto1Cd+2KW9UWrIhSdnMHgK3VpqNazvtJRDXZqlFZh3HSZtTeYUikY6f++Po1390fpoay/B5hqBQ2FU6zdLdD7dm+gXtH6cKQKKSTD40RqsNlGcTp7277NPtTX7P98z87b+Btsg1WDqE5ozYo9b3upzlWvekLpL25wKkL5NfLVyxEqp5//tk0Xi/+nvl7Kep0GtmmzXM4oEQRfw7WbqfxQX/nH41/5d76XKXLhdaQXKXIV9QxgDMlYF66O58gv25/JX5ZwX5BrMV5xoSdo/+2MHKMS08K/pILVYLTaY+UJOUdti2mYEoAlGeNjmdlaYXmL5SX5+Pd/Ju9qHb062TZMG3xWRAywunrx3GUDx7Q3aRfNui2+/lDpbpt9fslVOo2NM5UeUEYu12gTvenOLDnZcPx8HpK+V24ZZABQTiZDA1+5b9G6Ew0KIgagwcWzNA0cs44GF8YZ9swIIkTaSAMEIeFwItEgVZ/qP5Pkfb7/ax7Fb/PdR3tKmMUNQY8mUeKKw3ZRpc5QA20owYxaSRtKieR4aklU4qx3bU9f1IixL5k6OzgsPcpqnZuMNCRWJDu0qz01SkPUOz0wopRDOLXkqis9KmLsS7LspofWSAM9INbVhthCD4EYQGLKSVcnqlwXaXfyZTGFmhhsoBPWPetlCZsIgQwKNL1crMtZY1pBNuZjVw8WuydfyoqdGw013bBIDGEXopSGqneqUEQZBnx6eVlXqlwIsjE3s50qBkNNVKGCC4upwqFEAkw7R+tEmzqhtudpVtOpmdEGajHJiLSXWgwyRicUhc4pwsfnpnOu9vG5sTFNy1wbmidnnU5z8wyM4BxoXj95AyNOw9I/GQTBkLPppWQdyFCUYWMiZicZLs0zhQeGMbSSDBxDCrCcdtLVlhhX5NmeallImFpTTeTRPthlBXm4xADJ6aVVr1H00TmvyoTYmFjtnRuaG7lS5wYDTbkVIt0YcR6a3ikhiIRMTHC9qwslSkJsTK9spYTGQFOQwAQISykhOUZTqVwxBfHW9Lgm0PYky0ra1BtrohAFkNtJIUkwlxMqHc7LjoLwI/O8e/nXUZCVBWAnJwffoC8pdm401FRfzzsu9ZaGqm+qcAAxkBhNsBCsI1UuBFlZDGY5VQyGmkrCAOy2N39nqnAAiKQTLwrrQps6odYXhtlMp2ZGGw+YZdhiakHEIGQTrNZ/XUdv3VfG1tGblStjmXOD38+clTo3GGgqcRGM4k73MKeh6Z8SUggOJlih34USJSFWroxZSgmNgabFYsgQspMSiCKIIZv4ylhbelwTaP3KmI20qTfWRCGGAbOUQpKTKd3un0N9EHVOs4LIxiQriAYnRhAVL2pinIkIAArahQhBdB8aYMYpB2J6yVV7GhRE2JhY2UiDC+MMNBASEW4jDQgkiCA47YSqJSXM4mxPpqyjSp2hpuiBiWBW0oYRDCWbXhKV/lTuh/I7Z1JHOTamUycXh2ZISa9zm5mmAyYIYJ12FovD1DtJKIYUUjK9FKsjSapybEy27CaJ3kwDSWh2/rK9JJGCSYGmnYB1IUyNTNtTMYuJ1MhkA6kAIoBYSypGBUFsgmtcGzfuXmafCbExMds7NzRPcqXODQaabvMF77aZeB6a3inBkQSTTMa6UKIkxMY0zFZKaAw0ltkLQi2lBGcUkolvJramxzWBtiddVtKm3ljjfjzF0k4KCYQknGKi5b97286JVibExkRr79zQ9MiVOjcYaLj94JjLTpQ4D03/lJAIEzDBRKsLJUpCbEy0bKWExkDjvQeS3E5KSCKARBM/MqI1Pa4JtD3RspI29caaFrUoRrZSSAoA5QSfZ0z2hOicaiX5z5YlW0cHhyZJUa1zk5GmbRREcacb+cIQ9U0PATinkE7wGcZu9KiIsTHxspkeWiNN9OCoW8XvPekBsRAUTDwB60CV6yJtT8KspVATg43Lxphga+kkOYGATrCEPkxVrJI0CLs/sliQZWVRfcHVwWslq7qd28018EYS3u1Iluqw9U4exBBiBEyw8L47eXSyrCzFHwV5zOYaTyCGFFpNHgx5durSxMv1OxKpgVzrC/jtJ1hj002vW83esWU32RiWU1pUyE+tUkH63v0AsUyKlaeH7d0b/ACXXKtzi4nG41q47FRgmQ9P78SQWc0ynuAWZydilKVYeVaYtcTQmWjapBEE2ckLCThBk6/lb8+RqxKtPxjMTu40MFfPIyKh4JYGGAkRw1KICZaVhUmo0u6FZXsxVpaWHRwcvCSgoNa5yUjTsWCcgU5LAYUh6p0eCENB4QTfR9SNHhUxVpaZWUwPrZGGJExCLqm19OAke3/SxMvN2lPlukjrS85spVATgw10YlRwZiudMJKUkQkmYx9q073GPxNi5csgM+eGJkmu1LnBQFNFjZS8U4w5D03/lJBSIign+ELIDpQoCbHylZCWUkJjoGnPBAGJ7aQEoYhwQib+Wsi29Lgm0PoXQ9pIm3pjjQdSAmFpVKGACAgnWFaW9JJpJbamWslDGJLckmwltbygGItOy8HJHYnBmYAQT7C6v5d8K7E14bKXGDoTTcQABEFLiZGdMg7QxMvBkt6zrmQMaZel5GlgrvEcvo5nIN+TSBwSgvn0Uq9V0DnvWgU2Jl2rYHBurILiRU2MM4UUiLudSLkK7kMDTiFikk4v0WpPg4IIG1MsG2lwYZxp1wNyQK2kgUTZjsy006qWlDCLsz2hso4qdYaaFoExI8JG2ghChCByeknUdhdv193fuH0QY2MydXRwaHoU1To3GWmoaRSo29puYYR6Z4eEmBM+wdyqGzsqYmzMsWxmh9ZI0yMlAlB76SEQBmTitfMdqHJdpO25l7UUamKw6S6eSojtpJMAgALJOJtkBb0TxW99lNBnciytod+7+IASx1yvc5uZJo4wxLvWCZ+HqXeSQAQRmORRrd1IUpVjaSW9xSTRm2m6oZeSIntJwilDGE2+mr41YWpkjqCe3lYiNTLZWD3MIbSWVAgyzDmcXnqWvbe8hz3Hgxgbk7Ojg0OzpajWuclI0wF7AnU767gwRP3TQ3KK+QQfcOxGj4oYG9Mym+mhNdL4ggna7Ymse9IDE8AxmHitfQeqXBdpe0JmLYWaGGw87IsyZC2dJIGITPBs/TgT3jkX20uxMRU7uDc0TwpanVtMNAUaBkinWrB8eHonBmEMTqkq8pwAdCJGWYqNSZi9xNCZaAoZjEloKTEolEIQOO0ErD1Jrkq0Pf2ylDwNzDURSTAubCUSR5CjEUaYfwRr9bKHb/KVeOl67j+vkvk2jlLlpcpfvruxvw7Cj2TuReGqDYu+NZb+rRul9hpqUjNQIdSN7uvYlIm4kT+/uEF4/PPXyFcvR5idabM3ZbH4odIXvTWnK9uYr2cXw0B3ZvgqOG/sJ0erEpWWvsnpdmqjteA6uYqEvEo0xggaZXnN3/+tvJdDjzmGQW0Toq4JvDudUJVODZzUUShr9igKNTXZ+FQlA5ePG6uTQ06DEbKFWpwICse4l1M3CyZfm/uFsJJwCyNY2fkHB7CTMU3jl8Z4PQ8pBAjj/sPXyYCeKCaAzA4dn1D0Oo9oT8Gr1OF2xK4yaB8XuqrkaWiwIXBhChi8NXBV6GAHpwSkCKFJhK0PFYdqPd/GQZh+9BWuLoXaEaY0zg4fnkpGXAlLJmMND/AQTGA/4aikuCfKSIYgwSO/iSqPXPvwc9nBDws7GjAOGm60ZKgxUE8AiKmQtEF40cH7kdyAe+5SMYlw8rmZbzbudrkJwqXr+3FfEUUr146gond5+LhSteNKaLlisrGoB0LUS3Sp6u6JRBAQwdnI73MuhrB9jNF288PCjB6bg0YaEz3qzTTEG4g4aRJvDIB/OGE4zhYwphJ1kp/udhuEKkl6DDkVodbEm6qzDwk2uRHXI43WWNO5NhIh2leYyRX3RBmEMRB8/DGmMHKdAkylgx8ZXapgHDq0XJKhxkBTUCEEyGZB5QLej+WGlNlLB6a0Jha7oR9tgr/U8tNdJlvXUz2vjxkUWLVWZuqEh62bXRpUv4Z21QnDehqHUoo+19MujeiJepgKQMZYhK1Z59GMbud1NkPHP3rNzQTkR6y/mUl1g+HGkKY7dM24LmekiTV8I4gSxsWUQl3ylcT/6jm65TKtCmgFVx8Ww/Y21IetqqmGQgQBBId9Rqq93r7IIjCFDE4iOB2GrXM8yrv30SGogMNHRJ0SDa6bZ4gtBFPYPLQUcf1ITlDGIYXTCCChSuehSlfBOlXxPFwtvSgM09j1Ppapt12uoyjp7bapsS47Ak7zrhk+EDWx7UqAutE1U+CSEPQTuJrY0xN5GcGCUz7ugNZo+NsHusbD8bAA2Bz/gwbGW2jZzh3TmSZEsgaB9Aae2chdjjgCdITHbP/meh8ZrKKtCsN067fh5qltJ+KF6bYmMMIK6y5M17HqeFG/xPo13S4W39eBCtOXDBan7/UWGTNMojnsZ1uw1yn3rDMLM73eXm/+zRmoha8zPT0hW0AAGWFjTimPfj93wfg3raRvd4Y8MeaCFz4Nk+mZkF9nmI4DCFEMOePX8rSHIJ4wCEZbhJ3lxkGrQxMPLe+LaAR1iD7abAGEi5YYtm8YQEhew2yhHx8CYALRfhlgpDcSsUpSN06XbWfqYvv7ghnrbgNK5veU5/96hoxBhem5GAwZvpZ8X/RV+TCBHKotzhEgUCIu8KifwVxHnrveTxKtU4aziDvnCpgZc4XciwFn2H+m3ssuUctd6l0mCRWLDBmygLQ2N9il3gVY8++OBvQ0ryJOmZBgzIDO/PsrCjvg+STh3nDmRjiffbAFzWWDDHMxQJIxq+CMEQBy3M/IZ4O//uy4C1AQ0g3UB0HqOrCFEddFZ4aB9u8HgxeL71G4ellF0SW2L4wyZcUEXX9IMD7pykSVvyvkzad2UdQXyAWFYpRv+PLeVQarlwMC28D70DKHdI7C6lFcFV3ag7aO11xHoE7QBWL4HAlOlKN70WhRi1P04OooZw8RCTDmUX6PdvH6q80oH1reMspHXd1HuSjoYpTpHArMm4xywYPro0whEGjMo+y7QbtB3je8ZYwPmroPcUGOhscUNRrg3Prr48soIoSPeHx/KvXRboAPLW8Z4aOu7kNcFHQxxmTOKYJNBrngwfVRFpDiUZ7TeeqwTRSm7+2G+dj0lnE+aes+0CVJmpFmFKImI1104vpQS0HhKM/EP3VZqD5Vq4dl9w1vGeaDpu6DXJCjGWKJZaMhzs2/OsACIkYQGect5OvO+1DpEPn1pTbT/d3hqqsjbRCmic4CSqkd7FVZU/M8W2BByPjm7vcoSReznz9/lg7qfTq4mns6O3gK4JxITLPKFTI7uPr0EYT+YuZut+uvp1htozhdrqJ446aLGXs69N7yU8VJEIUZzcQcPR22gXbxYUzOP27cJFVxyRBHSclcgPEKM59ABlxBkM8933UJlz5W+NVV5BW9PqWxGybuftVhudsF/mLGhFCv4NV1/FcPOkQo6rjEhY50fShfGRC+L588N3XX0duxie8jJjBZOYIS7hAooCPQCjq+JzzousCTkDx5bjYhLE8tkz34F7MwSpe7RPlPhbWQxSxfC3k6XbgLjzB4CqNoexqI7O/lVoV+EL6dvjOO2v8MALSTHGiaBgIA",
 		Length:   132762,
 	},
 }
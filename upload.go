@@ -0,0 +1,180 @@
+//
+// Reading report submissions.
+//
+// Small reports are read into memory and parsed as usual, but large
+// ones are spooled to disk and parsed via our streaming parser so that
+// they never need to be held in memory in their entirety.
+//
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+)
+
+//
+// UploadTimeout is the longest we allow to read a request, such as the
+// submission of a report.  A temporary file into which an upload was
+// spooled, which is older than this, was left by an interrupted upload.
+//
+var UploadTimeout = 300 * time.Second
+
+//
+// reportUpload holds the body of a single submission, either in memory
+// or within a temporary file beneath our report-prefix.
+//
+type reportUpload struct {
+	content []byte
+	spool   *os.File
+}
+
+//
+// readUpload reads the given body, spooling it to disk if it is larger
+// than `threshold` bytes.
+//
+func readUpload(body io.Reader, threshold int64) (*reportUpload, error) {
+
+	//
+	// Read up to the threshold, plus one byte so that we know if
+	// the threshold was exceeded.
+	//
+	var buf bytes.Buffer
+	_, err := io.CopyN(&buf, body, threshold+1)
+	if err == io.EOF {
+		return &reportUpload{content: buf.Bytes()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// The body is larger than our threshold, so spool it into
+	// a temporary file.  We create that beneath our prefix such
+	// that it may be renamed into place once we know its name.
+	//
+	err = os.MkdirAll(ReportPrefix, 0755)
+	if err != nil {
+		return nil, err
+	}
+	spool, err := ioutil.TempFile(ReportPrefix, ".upload-")
+	if err != nil {
+		return nil, err
+	}
+
+	u := &reportUpload{spool: spool}
+
+	_, err = io.Copy(spool, io.MultiReader(&buf, body))
+	if err != nil {
+		u.Close()
+		return nil, err
+	}
+	return u, nil
+}
+
+//
+// Parse parses the submission, using the streaming parser for spooled
+// uploads.
+//
+func (u *reportUpload) Parse() (PuppetReport, error) {
+	if u.spool == nil {
+		return ParsePuppetReport(u.content)
+	}
+
+	_, err := u.spool.Seek(0, io.SeekStart)
+	if err != nil {
+		return PuppetReport{}, err
+	}
+	return ParsePuppetReportStream(u.spool)
+}
+
+//
+// Redact masks any secrets within the submission, using the given
+// redactor.
+//
+// Spooled uploads are too large to be redacted in memory, so only the
+// redactor's patterns are applied to them.
+//
+func (u *reportUpload) Redact(r *reportRedactor) error {
+	if u.spool == nil {
+		content, _, err := r.Redact(u.content)
+		if err != nil {
+			return err
+		}
+		u.content = content
+		return nil
+	}
+
+	_, err := u.spool.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	redacted, err := ioutil.TempFile(ReportPrefix, ".upload-")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.RedactStream(u.spool, redacted)
+	if err != nil {
+		redacted.Close()
+		os.Remove(redacted.Name())
+		return err
+	}
+
+	//
+	// Replace the spooled file with the redacted copy.
+	//
+	u.Close()
+	u.spool = redacted
+	return nil
+}
+
+//
+// Save stores the submission at the given path.
+//
+func (u *reportUpload) Save(path string) error {
+	if u.spool == nil {
+		return ioutil.WriteFile(path, u.content, 0644)
+	}
+
+	err := u.spool.Chmod(0644)
+	if err != nil {
+		return err
+	}
+	err = u.spool.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Rename(u.spool.Name(), path)
+	if err != nil {
+		return err
+	}
+	u.spool = nil
+	return nil
+}
+
+//
+// Close removes any temporary file which has not been saved.
+//
+func (u *reportUpload) Close() {
+	if u.spool != nil {
+		u.spool.Close()
+		os.Remove(u.spool.Name())
+		u.spool = nil
+	}
+}
+
+//
+// isTooLarge returns true if the given error was caused by a request-body
+// exceeding the limit we set via `http.MaxBytesReader`.
+//
+func isTooLarge(err error) bool {
+	var tooLarge *http.MaxBytesError
+	return errors.As(err, &tooLarge)
+}
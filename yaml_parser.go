@@ -8,6 +8,10 @@
 //  * Runtime
 //  * etc.
 //
+// The YAML is decoded into a set of structures which mirror the schema
+// of a Puppet transaction report, as submitted with `report_format`
+// 4 through 12, and then validated and copied into a `PuppetReport`.
+//
 
 package main
 
@@ -15,11 +19,12 @@ import (
 	"crypto/sha1"
 	"errors"
 	"fmt"
-	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
-	"github.com/smallfish/simpleyaml"
+	yaml "gopkg.in/yaml.v2"
 )
 
 //
@@ -32,6 +37,79 @@ type Resource struct {
 	Type string
 	File string
 	Line string
+
+	//
+	// The time taken to evaluate the resource, in seconds.
+	//
+	EvaluationTime float64
+}
+
+//
+// LogEntry is a single message which was logged during the run.
+//
+type LogEntry struct {
+
+	//
+	// The level of the message: err, warning, notice, info, or debug.
+	//
+	Level   string
+	Message string
+
+	//
+	// The resource, or part of puppet, which logged the message.
+	//
+	Source string
+	Tags   []string
+
+	//
+	// The time the message was logged, as reported by the node.
+	//
+	Time string
+
+	//
+	// The location in the manifest(s) responsible for the message,
+	// if any.
+	//
+	File string
+	Line string
+}
+
+//
+// Metric is a single value from one of the metric-categories in a report,
+// for example the time taken to evaluate all `File` resources.
+//
+type Metric struct {
+	Name  string
+	Label string
+	Value float64
+}
+
+//
+// MetricValues is the list of values in a single metric-category.
+//
+type MetricValues []Metric
+
+//
+// Get returns the value of the named metric, and whether it was present.
+//
+func (m MetricValues) Get(name string) (float64, bool) {
+	for _, v := range m {
+		if v.Name == name {
+			return v.Value, true
+		}
+	}
+	return 0, false
+}
+
+//
+// ReportMetrics contains each of the metric-categories a report may
+// contain.
+//
+type ReportMetrics struct {
+	Resources MetricValues
+	Time      MetricValues
+	Changes   MetricValues
+	Events    MetricValues
 }
 
 //
@@ -61,25 +139,64 @@ type PuppetReport struct {
 	//
 	At string
 
+	//
+	// The instant the puppet-run was completed, which unlike `At`
+	// respects the offset of the node's clock.
+	//
+	// This is the zero time if the report's time couldn't be parsed.
+	//
+	Executed time.Time
+
 	//
 	// The time puppet took to run, in seconds.
 	//
-	Runtime string
+	Runtime float64
 
 	//
 	// A count of resources that failed, changed, were unchanged,
-	// etc.  Strings for simplicity even though they are clearly
-	// integers.
+	// etc.
+	//
+	Failed  int
+	Changed int
+	Total   int
+	Skipped int
+
+	//
+	// The time spent in the stages of the run which happen outside
+	// the application of the catalog, in seconds.
 	//
-	Failed  string
-	Changed string
-	Total   string
-	Skipped string
+	// Slow config-retrieval points at the puppetserver, whereas slow
+	// fact-generation or plugin-sync points at the agent.
+	//
+	ConfigRetrieval       float64
+	FactGeneration        float64
+	PluginSync            float64
+	TransactionEvaluation float64
+
+	//
+	// Every metric the report contained, by category.
+	//
+	Metrics ReportMetrics
+
+	//
+	// Details of the agent, and the catalog which was applied.
+	//
+	ReportFormat         int
+	PuppetVersion        string
+	ConfigurationVersion string
+	CodeID               string
 
 	//
 	// Log messages.
 	//
-	LogMessages []string
+	Logs []LogEntry
+
+	//
+	// A count of the messages which were logged at the error, and
+	// warning, levels.
+	//
+	LogErrors   int
+	LogWarnings int
 
 	//
 	// Resources which have failed/changed/been skipped.
@@ -102,6 +219,76 @@ type PuppetReport struct {
 	Hash string
 }
 
+//
+// The structures below mirror the YAML which puppet submits.
+//
+// Fields are only listed if we use them, or need to distinguish their
+// absence from an empty value.  The ruby-specific tags which puppet adds
+// (e.g. `!ruby/object:Puppet::Transaction::Report`) are ignored.
+//
+
+//
+// yamlReport is the top-level of a transaction report.
+//
+type yamlReport struct {
+	Host                 string                        `yaml:"host"`
+	Time                 string                        `yaml:"time"`
+	Environment          string                        `yaml:"environment"`
+	Status               string                        `yaml:"status"`
+	ReportFormat         int                           `yaml:"report_format"`
+	PuppetVersion        string                        `yaml:"puppet_version"`
+	ConfigurationVersion string                        `yaml:"configuration_version"`
+	CodeID               string                        `yaml:"code_id"`
+	Metrics              map[string]yamlMetric         `yaml:"metrics"`
+	Logs                 []yamlLog                     `yaml:"logs"`
+	ResourceStatuses     map[string]yamlResourceStatus `yaml:"resource_statuses"`
+}
+
+//
+// yamlMetric is a single metric-category, whose values are a list
+// of `[name, label, value]` triples.
+//
+type yamlMetric struct {
+	Name   string          `yaml:"name"`
+	Label  string          `yaml:"label"`
+	Values [][]interface{} `yaml:"values"`
+}
+
+//
+// yamlLog is a single logged message.
+//
+type yamlLog struct {
+	Level   string   `yaml:"level"`
+	Message string   `yaml:"message"`
+	Source  string   `yaml:"source"`
+	Tags    []string `yaml:"tags"`
+	Time    string   `yaml:"time"`
+	File    string   `yaml:"file"`
+	Line    string   `yaml:"line"`
+}
+
+//
+// yamlResourceStatus is the result of applying a single resource.
+//
+type yamlResourceStatus struct {
+	Title          string  `yaml:"title"`
+	File           string  `yaml:"file"`
+	Line           string  `yaml:"line"`
+	Resource       string  `yaml:"resource"`
+	ResourceType   string  `yaml:"resource_type"`
+	EvaluationTime float64 `yaml:"evaluation_time"`
+	Failed         bool    `yaml:"failed"`
+	Changed        bool    `yaml:"changed"`
+	Skipped        bool    `yaml:"skipped"`
+}
+
+//
+// missing returns the error we report when a key is absent.
+//
+func missing(key string) error {
+	return fmt.Errorf("failed to get '%s' from YAML", key)
+}
+
 //
 //  Here we have some simple methods that each parse a part of the
 // YAML file, updating the structure they are passed.
@@ -114,13 +301,13 @@ type PuppetReport struct {
 // parseHost reads the `host` parameter from the YAML and populates
 // the given report-structure with suitable values.
 //
-func parseHost(y *simpleyaml.Yaml, out *PuppetReport) error {
+func parseHost(y *yamlReport, out *PuppetReport) error {
 	//
 	// Get the hostname.
 	//
-	host, err := y.Get("host").String()
-	if err != nil {
-		return errors.New("failed to get 'host' from YAML")
+	host := y.Host
+	if host == "" {
+		return missing("host")
 	}
 
 	//
@@ -139,20 +326,19 @@ func parseHost(y *simpleyaml.Yaml, out *PuppetReport) error {
 // parseEnvironment reads the `environment` parameter from the YAML and populates
 // the given report-structure with suitable values.
 //
-func parseEnvironment(y *simpleyaml.Yaml, out *PuppetReport) error {
+func parseEnvironment(y *yamlReport, out *PuppetReport) error {
 	//
 	// Get the hostname.
 	//
-	env, err := y.Get("environment").String()
-	if err != nil {
-		return errors.New("failed to get 'environment' from YAML")
+	env := y.Environment
+	if env == "" {
+		return missing("environment")
 	}
 
 	//
 	// Ensure the hostname passes a simple regexp
 	//
-	reg, _ := regexp.Compile("^([A-Za-z0-9_]+)$")
-	if !reg.MatchString(env) {
+	if !environmentRegexp.MatchString(env) {
 		return errors.New("the submitted 'environment' field failed our security check")
 	}
 
@@ -164,19 +350,28 @@ func parseEnvironment(y *simpleyaml.Yaml, out *PuppetReport) error {
 // parseTime reads the `time` parameter from the YAML and populates
 // the given report-structure with suitable values.
 //
-func parseTime(y *simpleyaml.Yaml, out *PuppetReport) error {
+func parseTime(y *yamlReport, out *PuppetReport) error {
 
 	//
 	// Get the time puppet executed
 	//
-	at, err := y.Get("time").String()
-	if err != nil {
-		return errors.New("failed to get 'time' from YAML")
+	at := y.Time
+	if at == "" {
+		return missing("time")
 	}
 
 	// Strip any quotes that might surround the time.
 	at = strings.Replace(at, "'", "", -1)
 
+	// Record the instant, before we discard the offset.
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -07:00"} {
+		t, err := time.Parse(layout, at)
+		if err == nil {
+			out.Executed = t
+			break
+		}
+	}
+
 	// Convert "T" -> " "
 	at = strings.Replace(at, "T", " ", -1)
 
@@ -194,13 +389,13 @@ func parseTime(y *simpleyaml.Yaml, out *PuppetReport) error {
 // parseStatus reads the `status` parameter from the YAML and populates
 // the given report-structure with suitable values.
 //
-func parseStatus(y *simpleyaml.Yaml, out *PuppetReport) error {
+func parseStatus(y *yamlReport, out *PuppetReport) error {
 	//
 	// Get the status
 	//
-	state, err := y.Get("status").String()
-	if err != nil {
-		return errors.New("failed to get 'status' from YAML")
+	state := y.Status
+	if state == "" {
+		return missing("status")
 	}
 
 	switch state {
@@ -216,124 +411,174 @@ func parseStatus(y *simpleyaml.Yaml, out *PuppetReport) error {
 }
 
 //
-// parseRuntime reads the `metrics.time.values` parameters from the YAML
-// and populates given report-structure with suitable values.
+// parseVersion reads the details of the agent which submitted the report,
+// and ensures the report is in a format we understand.
 //
-func parseRuntime(y *simpleyaml.Yaml, out *PuppetReport) error {
+func parseVersion(y *yamlReport, out *PuppetReport) error {
 
 	//
-	// Get the run-time this execution took.
+	// Formats before 4 lack the environment, amongst other things.
 	//
-	times, err := y.Get("metrics").Get("time").Get("values").Array()
-	if err != nil {
-		return err
+	// A missing format is tolerated, for hand-written reports.
+	//
+	if y.ReportFormat != 0 && y.ReportFormat < 4 {
+		return fmt.Errorf("unsupported 'report_format' - %d, we require 4 or later", y.ReportFormat)
+	}
+
+	out.ReportFormat = y.ReportFormat
+	out.PuppetVersion = y.PuppetVersion
+	out.ConfigurationVersion = y.ConfigurationVersion
+	out.CodeID = y.CodeID
+	return nil
+}
+
+//
+// parseMetric converts the values of the named metric-category.
+//
+// If `required` is set then the category must be present.
+//
+func parseMetric(y *yamlReport, name string, required bool) (MetricValues, error) {
+
+	metric, ok := y.Metrics[name]
+	if !ok {
+		if required {
+			return nil, missing("metrics." + name)
+		}
+		return nil, nil
+	}
+	if metric.Values == nil {
+		return nil, missing("metrics." + name + ".values")
 	}
 
-	r, _ := regexp.Compile("Total ([0-9.]+)")
+	var res MetricValues
+	for i, v := range metric.Values {
 
-	runtime := ""
+		//
+		// Each value is a `[name, label, value]` triple.
+		//
+		if len(v) != 3 {
+			return nil, fmt.Errorf("malformed entry %d in 'metrics.%s.values'", i, name)
+		}
 
-	//
-	// HORRID: Help me, I'm in hell.
-	//
-	// TODO: Improve via reflection as per log-handling.
-	//
-	for _, value := range times {
-		match := r.FindStringSubmatch(fmt.Sprint(value))
-		if len(match) == 2 {
-			runtime = match[1]
+		var m Metric
+		m.Name = fmt.Sprint(v[0])
+		m.Label = fmt.Sprint(v[1])
+
+		switch n := v[2].(type) {
+		case int:
+			m.Value = float64(n)
+		case int64:
+			m.Value = float64(n)
+		case uint64:
+			m.Value = float64(n)
+		case float64:
+			m.Value = n
+		default:
+			return nil, fmt.Errorf("non-numeric value for '%s' in 'metrics.%s.values'", m.Name, name)
 		}
+		res = append(res, m)
 	}
-	out.Runtime = runtime
-	return nil
+	return res, nil
 }
 
 //
-// parseResources looks for the counts of resources which have been
-// failed, changed, skipped, etc, and updates the given report-structure
-// with those values.
+// parseMetrics reads each of the metric-categories from the YAML, and
+// populates the runtime and resource-counts from them.
 //
-func parseResources(y *simpleyaml.Yaml, out *PuppetReport) error {
+func parseMetrics(y *yamlReport, out *PuppetReport) error {
+
+	if y.Metrics == nil {
+		return missing("metrics")
+	}
+
+	var err error
 
-	resources, err := y.Get("metrics").Get("resources").Get("values").Array()
+	out.Metrics.Time, err = parseMetric(y, "time", true)
+	if err != nil {
+		return err
+	}
+	out.Metrics.Resources, err = parseMetric(y, "resources", true)
 	if err != nil {
 		return err
 	}
 
-	tr, _ := regexp.Compile("Total ([0-9.]+)")
-	fr, _ := regexp.Compile("Failed ([0-9.]+)")
-	sr, _ := regexp.Compile("Skipped ([0-9.]+)")
-	cr, _ := regexp.Compile("Changed ([0-9.]+)")
+	//
+	// Failed runs, which never applied a catalog, lack these.
+	//
+	out.Metrics.Changes, err = parseMetric(y, "changes", false)
+	if err != nil {
+		return err
+	}
+	out.Metrics.Events, err = parseMetric(y, "events", false)
+	if err != nil {
+		return err
+	}
 
-	total := ""
-	changed := ""
-	failed := ""
-	skipped := ""
+	//
+	// The run-time this execution took.
+	//
+	out.Runtime, _ = out.Metrics.Time.Get("total")
 
 	//
-	// HORRID: Help me, I'm in hell.
+	// The time taken by the various stages of the run.
 	//
-	// TODO: Improve via reflection as per log-handling.
+	out.ConfigRetrieval, _ = out.Metrics.Time.Get("config_retrieval")
+	out.FactGeneration, _ = out.Metrics.Time.Get("fact_generation")
+	out.PluginSync, _ = out.Metrics.Time.Get("plugin_sync")
+	out.TransactionEvaluation, _ = out.Metrics.Time.Get("transaction_evaluation")
+
 	//
-	for _, value := range resources {
-		mr := tr.FindStringSubmatch(fmt.Sprint(value))
-		if len(mr) == 2 {
-			total = mr[1]
-		}
-		mf := fr.FindStringSubmatch(fmt.Sprint(value))
-		if len(mf) == 2 {
-			failed = mf[1]
-		}
-		ms := sr.FindStringSubmatch(fmt.Sprint(value))
-		if len(ms) == 2 {
-			skipped = ms[1]
-		}
-		mc := cr.FindStringSubmatch(fmt.Sprint(value))
-		if len(mc) == 2 {
-			changed = mc[1]
-		}
-	}
+	// The resource counts.
+	//
+	total, _ := out.Metrics.Resources.Get("total")
+	failed, _ := out.Metrics.Resources.Get("failed")
+	changed, _ := out.Metrics.Resources.Get("changed")
+	skipped, _ := out.Metrics.Resources.Get("skipped")
 
-	out.Total = total
-	out.Changed = changed
-	out.Failed = failed
-	out.Skipped = skipped
+	out.Total = int(total)
+	out.Failed = int(failed)
+	out.Changed = int(changed)
+	out.Skipped = int(skipped)
 	return nil
 }
 
 //
 // parseLogs updates the given report with any logged messages.
 //
-func parseLogs(y *simpleyaml.Yaml, out *PuppetReport) error {
-	logs, err := y.Get("logs").Array()
-	if err != nil {
-		return errors.New("failed to get 'logs' from YAML")
+func parseLogs(y *yamlReport, out *PuppetReport) error {
+	if y.Logs == nil {
+		return missing("logs")
 	}
 
-	var logged []string
+	var logged []LogEntry
 
-	for _, v2 := range logs {
-
-		// create a map
-		m := make(map[string]string)
+	for _, l := range y.Logs {
+		if len(l.Message) < 1 {
+			continue
+		}
 
-		v := reflect.ValueOf(v2)
-		if v.Kind() == reflect.Map {
-			for _, key := range v.MapKeys() {
-				strct := v.MapIndex(key)
+		//
+		// Older agents submit the level as a ruby-symbol.
+		//
+		level := strings.TrimPrefix(l.Level, ":")
 
-				// Store the key/val in the map.
-				key, val := key.Interface(), strct.Interface()
-				m[key.(string)] = fmt.Sprint(val)
-			}
+		switch level {
+		case "emerg", "alert", "crit", "err":
+			out.LogErrors++
+		case "warning":
+			out.LogWarnings++
 		}
 
-		if len(m["message"]) > 0 {
-			logged = append(logged, m["source"]+" : "+m["message"])
-		}
+		logged = append(logged, LogEntry{Level: level,
+			Message: l.Message,
+			Source:  l.Source,
+			Tags:    l.Tags,
+			Time:    l.Time,
+			File:    l.File,
+			Line:    l.Line})
 	}
 
-	out.LogMessages = logged
+	out.Logs = logged
 	return nil
 }
 
@@ -341,10 +586,9 @@ func parseLogs(y *simpleyaml.Yaml, out *PuppetReport) error {
 // parseResults updates the given report with details of any resource
 // which was failed, changed, or skipped.
 //
-func parseResults(y *simpleyaml.Yaml, out *PuppetReport) error {
-	rs, err := y.Get("resource_statuses").Map()
-	if err != nil {
-		return errors.New("failed to get 'resource_statuses' from YAML")
+func parseResults(y *yamlReport, out *PuppetReport) error {
+	if y.ResourceStatuses == nil {
+		return missing("resource_statuses")
 	}
 
 	var failed []Resource
@@ -352,59 +596,36 @@ func parseResults(y *simpleyaml.Yaml, out *PuppetReport) error {
 	var skipped []Resource
 	var ok []Resource
 
-	for _, v2 := range rs {
-
-		// create a map here.
-		m := make(map[string]string)
+	//
+	// Process the resources in a stable order.
+	//
+	var names []string
+	for name := range y.ResourceStatuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-		v := reflect.ValueOf(v2)
-		if v.Kind() == reflect.Map {
-			for _, key := range v.MapKeys() {
-				strct := v.MapIndex(key)
+	for _, name := range names {
+		rs := y.ResourceStatuses[name]
 
-				// Store the key/val in the map.
-				key, val := key.Interface(), strct.Interface()
-				m[key.(string)] = fmt.Sprint(val)
-			}
-		}
+		r := Resource{Name: rs.Title,
+			Type:           rs.ResourceType,
+			File:           rs.File,
+			Line:           rs.Line,
+			EvaluationTime: rs.EvaluationTime}
 
-		// Now we should be able to look for skipped ones.
-		if m["skipped"] == "true" {
-			skipped = append(skipped,
-				Resource{Name: m["title"],
-					Type: m["resource_type"],
-					File: m["file"],
-					Line: m["line"]})
+		if rs.Skipped {
+			skipped = append(skipped, r)
 		}
-
-		// Now we should be able to look for skipped ones.
-		if m["changed"] == "true" {
-			changed = append(changed,
-				Resource{Name: m["title"],
-					Type: m["resource_type"],
-					File: m["file"],
-					Line: m["line"]})
+		if rs.Changed {
+			changed = append(changed, r)
 		}
-
-		// Now we should be able to look for skipped ones.
-		if m["failed"] == "true" {
-			failed = append(failed,
-				Resource{Name: m["title"],
-					Type: m["resource_type"],
-					File: m["file"],
-					Line: m["line"]})
+		if rs.Failed {
+			failed = append(failed, r)
 		}
-
-		if m["failed"] == "false" &&
-			m["skipped"] == "false" &&
-			m["changed"] == "false" {
-			ok = append(ok,
-				Resource{Name: m["title"],
-					Type: m["resource_type"],
-					File: m["file"],
-					Line: m["line"]})
+		if !rs.Failed && !rs.Skipped && !rs.Changed {
+			ok = append(ok, r)
 		}
-
 	}
 
 	out.ResourcesSkipped = skipped
@@ -416,6 +637,37 @@ func parseResults(y *simpleyaml.Yaml, out *PuppetReport) error {
 
 }
 
+//
+// SlowResources is the number of resources, from each report, whose
+// evaluation-time we record.
+//
+// These are the slowest resources in the report, and are used to find
+// the slowest resources across all our nodes.
+//
+var SlowResources = 20
+
+//
+// Slowest returns the resources which took the longest to evaluate, in
+// descending order, limited to `count` entries.
+//
+func (p PuppetReport) Slowest(count int) []Resource {
+
+	var all []Resource
+	all = append(all, p.ResourcesFailed...)
+	all = append(all, p.ResourcesChanged...)
+	all = append(all, p.ResourcesSkipped...)
+	all = append(all, p.ResourcesOK...)
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].EvaluationTime > all[j].EvaluationTime
+	})
+
+	if len(all) > count {
+		all = all[:count]
+	}
+	return all
+}
+
 //
 // ParsePuppetReport is our main function in this module.  Given an
 // array of bytes we read the input and produce a PuppetReport structure.
@@ -433,8 +685,17 @@ func ParsePuppetReport(content []byte) (PuppetReport, error) {
 	//
 	// Parse the YAML.
 	//
-	yaml, err := simpleyaml.NewYaml(content)
+	var y yamlReport
+	err := yaml.Unmarshal(content, &y)
 	if err != nil {
+
+		//
+		// If the document was valid YAML, but some fields had
+		// an unexpected type, then report them.
+		//
+		if te, ok := err.(*yaml.TypeError); ok {
+			return x, fmt.Errorf("failed to parse YAML: %s", strings.Join(te.Errors, ", "))
+		}
 		return x, errors.New("failed to parse YAML")
 	}
 
@@ -446,67 +707,24 @@ func ParsePuppetReport(content []byte) (PuppetReport, error) {
 	x.Hash = fmt.Sprintf("%x", helper.Sum(nil))
 
 	//
-	// Parse the hostname
-	//
-	hostError := parseHost(yaml, &x)
-	if hostError != nil {
-		return x, hostError
-	}
-
-	//
-	// Parse the environment
+	// Each of our parsers, in the order they're applied.
 	//
-	envError := parseEnvironment(yaml, &x)
-	if envError != nil {
-		return x, envError
+	parsers := []func(*yamlReport, *PuppetReport) error{
+		parseHost,
+		parseEnvironment,
+		parseTime,
+		parseStatus,
+		parseVersion,
+		parseMetrics,
+		parseLogs,
+		parseResults,
 	}
 
-	//
-	// Parse the time.
-	//
-	timeError := parseTime(yaml, &x)
-	if timeError != nil {
-		return x, timeError
-	}
-
-	//
-	// Parse the status
-	//
-	stateError := parseStatus(yaml, &x)
-	if stateError != nil {
-		return x, stateError
-	}
-
-	//
-	// Parse the runtime of this execution
-	//
-	runError := parseRuntime(yaml, &x)
-	if runError != nil {
-		return x, runError
-	}
-
-	//
-	// Get the resource-data from this run
-	//
-	resourcesError := parseResources(yaml, &x)
-	if resourcesError != nil {
-		return x, resourcesError
-	}
-
-	//
-	// Get the logs from this run
-	//
-	logsError := parseLogs(yaml, &x)
-	if logsError != nil {
-		return x, logsError
-	}
-
-	//
-	// Finally the resources
-	//
-	resError := parseResults(yaml, &x)
-	if resError != nil {
-		return x, resError
+	for _, parser := range parsers {
+		err = parser(&y, &x)
+		if err != nil {
+			return x, err
+		}
 	}
 
 	return x, nil
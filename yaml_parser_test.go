@@ -10,11 +10,22 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 )
 
+//
+// Run `go test -update` to regenerate the golden-files beneath
+// testdata/reports/.
+//
+var update = flag.Bool("update", false, "update the golden-files")
+
 //
 // Ensure that bogus YAML is caught.
 //
@@ -54,6 +65,17 @@ func TestYamlDates(t *testing.T) {
 		if node.At != "2017-03-10 10:22:33" {
 			t.Errorf("Invalid time result, got '%s'", node.At)
 		}
+		if node.Executed.Unix() != 1489141353 {
+			t.Errorf("Invalid instant, got '%s'", node.Executed)
+		}
+	}
+
+	//
+	// The offset of the node's clock is kept.
+	//
+	node, _ := ParsePuppetReport([]byte("---\ntime: '2017-03-10T05:22:33.659245699-05:00'\nhost: bart\nenvironment: production\n"))
+	if node.At != "2017-03-10 05:22:33" || node.Executed.Unix() != 1489141353 {
+		t.Errorf("Invalid time result, got '%s' '%s'", node.At, node.Executed)
 	}
 
 }
@@ -229,13 +251,13 @@ func TestValidYaml(t *testing.T) {
 	if report.At != "2017-07-29 23:17:01" {
 		t.Errorf("Incorrect at: %v", report.At)
 	}
-	if report.Failed != "0" {
+	if report.Failed != 0 {
 		t.Errorf("Incorrect failed: %v", report.Failed)
 	}
-	if report.Changed != "0" {
+	if report.Changed != 0 {
 		t.Errorf("Incorrect changed: %v", report.Changed)
 	}
-	if report.Skipped != "2" {
+	if report.Skipped != 2 {
 		t.Errorf("Incorrect skipped: %v", report.Skipped)
 	}
 }
@@ -297,3 +319,158 @@ func TestMissingResources(t *testing.T) {
 		}
 	}
 }
+
+//
+// Each report in our corpus, one per report_format, is decoded into
+// the structure recorded in the matching golden-file.
+//
+func TestReportFormats(t *testing.T) {
+
+	files, err := filepath.Glob("testdata/reports/format-*.yaml")
+	if err != nil {
+		t.Fatalf("Failed to find reports: %s", err.Error())
+	}
+	if len(files) != 9 {
+		t.Fatalf("Expected a report for formats 4-12, found %d", len(files))
+	}
+
+	for _, file := range files {
+
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %s", file, err.Error())
+		}
+
+		report, err := ParsePuppetReport(content)
+		if err != nil {
+			t.Errorf("Failed to parse %s: %s", file, err.Error())
+			continue
+		}
+
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			t.Fatalf("Failed to encode %s: %s", file, err.Error())
+		}
+		out = append(out, '\n')
+
+		golden := strings.TrimSuffix(file, ".yaml") + ".golden"
+		if *update {
+			err = ioutil.WriteFile(golden, out, 0644)
+			if err != nil {
+				t.Fatalf("Failed to write %s: %s", golden, err.Error())
+			}
+		}
+
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %s", golden, err.Error())
+		}
+		if !bytes.Equal(out, expected) {
+			t.Errorf("%s doesn't match %s:\n%s", file, golden, out)
+		}
+	}
+}
+
+//
+// Reports in formats which predate environments are rejected.
+//
+func TestUnsupportedFormat(t *testing.T) {
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal("Failed to load YAML asset data/valid.yaml")
+	}
+
+	str := strings.Replace(string(tmpl), "report_format: 6", "report_format: 3", 1)
+
+	_, err = ParsePuppetReport([]byte(str))
+	if err == nil || !strings.Contains(err.Error(), "report_format") {
+		t.Errorf("Expected an error relating to 'report_format', got %v", err)
+	}
+}
+
+//
+// Errors name the full path of the key which was missing, or invalid.
+//
+func TestMetricErrors(t *testing.T) {
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal("Failed to load YAML asset data/valid.yaml")
+	}
+
+	tests := []struct {
+		from string
+		to   string
+		err  string
+	}{
+		{"  time: !ruby", "  blah: !ruby", "failed to get 'metrics.time' from YAML"},
+		{"      - 176", "      - lots", "non-numeric value for 'total' in 'metrics.resources.values'"},
+		{"      - Total\n      - 176", "      - 176", "malformed entry 0 in 'metrics.resources.values'"},
+		{"report_format: 6", "report_format: six", "failed to parse YAML"},
+	}
+
+	for _, test := range tests {
+
+		str := strings.Replace(string(tmpl), test.from, test.to, 1)
+
+		_, err = ParsePuppetReport([]byte(str))
+		if err == nil {
+			t.Errorf("Expected an error replacing %q", test.from)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Expected error %q, got %q", test.err, err.Error())
+		}
+	}
+}
+
+//
+// Log entries retain their level, tags, time, and location.
+//
+func TestLogEntries(t *testing.T) {
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal("Failed to load YAML asset data/valid.yaml")
+	}
+
+	//
+	// Make the first message a warning.
+	//
+	str := strings.Replace(string(tmpl), "level: :notice", "level: :warning", 1)
+
+	report, err := ParsePuppetReport([]byte(str))
+	if err != nil {
+		t.Fatalf("Failed to parse YAML file: %s", err.Error())
+	}
+
+	if len(report.Logs) != 2 {
+		t.Fatalf("Expected two log entries, got %d", len(report.Logs))
+	}
+
+	l := report.Logs[0]
+	if l.Level != "warning" {
+		t.Errorf("Unexpected level: %s", l.Level)
+	}
+	if l.Source != "/Stage[main]/Common/Tidy[/etc]" || l.Message != "Tidying 0 files" {
+		t.Errorf("Unexpected message: %v", l)
+	}
+	if len(l.Tags) != 6 || l.Tags[1] != "tidy" {
+		t.Errorf("Unexpected tags: %v", l.Tags)
+	}
+	if l.Time != "2017-07-29T23:17:09.679987918+00:00" {
+		t.Errorf("Unexpected time: %s", l.Time)
+	}
+	if l.Line != "36" || !strings.HasSuffix(l.File, "common/manifests/init.pp") {
+		t.Errorf("Unexpected location: %s:%s", l.File, l.Line)
+	}
+
+	if report.Logs[1].Level != "notice" || report.Logs[1].File != "" {
+		t.Errorf("Unexpected entry: %v", report.Logs[1])
+	}
+
+	if report.LogWarnings != 1 || report.LogErrors != 0 {
+		t.Errorf("Unexpected counts: %d warnings, %d errors", report.LogWarnings, report.LogErrors)
+	}
+}
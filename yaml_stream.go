@@ -0,0 +1,297 @@
+//
+// This file contains a streaming parser for oversized reports.
+//
+// A report from a node with tens of thousands of resources can be many
+// megabytes of YAML, and decoding that materialises
+// every resource-status in memory.  For such reports we scan the document
+// one line at a time, keeping the summary-fields, and decoding the logs
+// and resources one entry at a time so that we only retain what we store
+// in the database.
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// The top-level sections of a report which we retain when streaming.
+//
+var streamSections = map[string]bool{
+	"host":                  true,
+	"environment":           true,
+	"time":                  true,
+	"status":                true,
+	"metrics":               true,
+	"report_format":         true,
+	"puppet_version":        true,
+	"configuration_version": true,
+	"code_id":               true,
+}
+
+//
+// The top-level sections of a report which are decoded one entry at a
+// time, keeping only what we store in the database.
+//
+// Everything else is skipped without being stored.
+//
+var streamEntries = map[string]bool{
+	"logs":              true,
+	"resource_statuses": true,
+}
+
+//
+// A top-level key in a Puppet report, which always begins at the first
+// column of a line.
+//
+var topLevelKey = regexp.MustCompile("^([A-Za-z_]+):( |$)")
+
+//
+// streamState holds the entries of the logs, and resources, which we've
+// seen so far.
+//
+type streamState struct {
+
+	//
+	// The section we're within, the indentation of its entries, and
+	// the entry we're reading.
+	//
+	section string
+	indent  int
+	entry   bytes.Buffer
+
+	//
+	// The count of messages logged as errors, and warnings.
+	//
+	errors   int
+	warnings int
+
+	//
+	// The resources which failed, changed, or were skipped, along with
+	// the slowest of the remainder.
+	//
+	resources map[string]yamlResourceStatus
+	ok        []string
+}
+
+//
+// begin starts a new section of the report.
+//
+func (s *streamState) begin(section string) error {
+	err := s.flush()
+	s.section = section
+	s.indent = -1
+	return err
+}
+
+//
+// add appends a fragment of a line to the current section, starting a
+// new entry if it is the beginning of a line at the indentation of the
+// first entry.
+//
+func (s *streamState) add(line []byte, start bool, prefix bool) error {
+	if start {
+		trimmed := bytes.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+
+		if len(trimmed) > 0 && !bytes.HasPrefix(trimmed, []byte("#")) {
+			if s.indent < 0 {
+				s.indent = indent
+			}
+			if indent == s.indent {
+				err := s.flush()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	s.entry.Write(line)
+	if !prefix {
+		s.entry.WriteString("\n")
+	}
+	return nil
+}
+
+//
+// flush decodes the entry we've read, if any.
+//
+func (s *streamState) flush() error {
+	if s.entry.Len() == 0 {
+		return nil
+	}
+	defer s.entry.Reset()
+
+	var y yamlReport
+	var out PuppetReport
+
+	switch s.section {
+	case "logs":
+		err := yaml.Unmarshal(s.entry.Bytes(), &y.Logs)
+		if err != nil {
+			return fmt.Errorf("failed to parse logs: %s", err.Error())
+		}
+		parseLogs(&y, &out)
+		s.errors += out.LogErrors
+		s.warnings += out.LogWarnings
+
+	case "resource_statuses":
+		err := yaml.Unmarshal(s.entry.Bytes(), &y.ResourceStatuses)
+		if err != nil {
+			return fmt.Errorf("failed to parse resource_statuses: %s", err.Error())
+		}
+		for name, rs := range y.ResourceStatuses {
+			s.resources[name] = rs
+			if !rs.Failed && !rs.Changed && !rs.Skipped {
+				s.ok = append(s.ok, name)
+			}
+		}
+
+		//
+		// Only the slowest of the unremarkable resources are
+		// stored, so we discard the rest once we've seen twice
+		// as many as we need.
+		//
+		if len(s.ok) > 2*SlowResources {
+			sort.SliceStable(s.ok, func(i, j int) bool {
+				return s.resources[s.ok[i]].EvaluationTime > s.resources[s.ok[j]].EvaluationTime
+			})
+			for _, name := range s.ok[SlowResources:] {
+				delete(s.resources, name)
+			}
+			s.ok = s.ok[:SlowResources]
+		}
+	}
+	return nil
+}
+
+//
+// ParsePuppetReportStream parses a report from the given reader, without
+// holding the whole document in memory.
+//
+// The result contains the same summary-fields, counts of logged errors
+// and warnings, and resources which failed, changed, or were skipped, as
+// `ParsePuppetReport`.  The log-messages are left empty, and only the
+// slowest `SlowResources` of the remaining resources are kept.
+//
+func ParsePuppetReportStream(r io.Reader) (PuppetReport, error) {
+	var x PuppetReport
+
+	//
+	// Hash the content as we read it.
+	//
+	helper := sha1.New()
+	reader := bufio.NewReaderSize(io.TeeReader(r, helper), 64*1024)
+
+	//
+	// The reduced document we'll build up, and whether the section
+	// we're currently inside is one we want to keep.
+	//
+	var doc bytes.Buffer
+	keep := false
+
+	//
+	// The entries of the logs, and resources, we're reading, if
+	// we're inside one of those sections.
+	//
+	state := streamState{resources: make(map[string]yamlResourceStatus)}
+	entries := false
+
+	//
+	// Whether the next fragment we read starts a new line.
+	//
+	// Lines longer than our buffer are returned in pieces, and
+	// only the first piece can contain a top-level key.
+	//
+	start := true
+
+	for {
+		line, prefix, err := reader.ReadLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return x, err
+		}
+
+		if start {
+			switch {
+			case bytes.HasPrefix(line, []byte("---")):
+				keep = true
+				entries = false
+			default:
+				m := topLevelKey.FindSubmatch(line)
+				if m != nil {
+					keep = streamSections[string(m[1])]
+					entries = streamEntries[string(m[1])]
+
+					err = state.begin(string(m[1]))
+					if err != nil {
+						return x, err
+					}
+					if entries {
+						start = !prefix
+						continue
+					}
+				}
+			}
+		}
+
+		if keep {
+			doc.Write(line)
+			if !prefix {
+				doc.WriteString("\n")
+			}
+		}
+		if entries {
+			err = state.add(line, start, prefix)
+			if err != nil {
+				return x, err
+			}
+		}
+		start = !prefix
+	}
+
+	err := state.flush()
+	if err != nil {
+		return x, err
+	}
+
+	//
+	// Add empty placeholders for the sections we skipped, so that
+	// the reduced document is a valid report in its own right.
+	//
+	doc.WriteString("logs: []\nresource_statuses: {}\n")
+
+	x, err = ParsePuppetReport(doc.Bytes())
+	if err != nil {
+		return x, err
+	}
+
+	//
+	// Add the details of the entries we decoded as we went.
+	//
+	x.LogErrors = state.errors
+	x.LogWarnings = state.warnings
+
+	err = parseResults(&yamlReport{ResourceStatuses: state.resources}, &x)
+	if err != nil {
+		return x, err
+	}
+
+	//
+	// The hash must be that of the complete submission.
+	//
+	x.Hash = fmt.Sprintf("%x", helper.Sum(nil))
+	return x, nil
+}
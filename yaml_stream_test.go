@@ -0,0 +1,227 @@
+//
+//  Testing of our streaming-parser, used for oversized reports.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+//
+// Generate a report containing the given number of resources, based
+// upon our valid sample.
+//
+func largeReport(t testing.TB, count int) []byte {
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal("Failed to load YAML asset data/valid.yaml")
+	}
+
+	//
+	// Split the sample around the resource-statuses, which are
+	// followed by the top-level scalar-fields.
+	//
+	str := string(tmpl)
+	start := strings.Index(str, "resource_statuses:\n")
+	end := strings.Index(str, "\nhost: ")
+	if start < 0 || end < 0 {
+		t.Fatal("Failed to find resource_statuses in sample")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(str[:start])
+	buf.WriteString("resource_statuses:\n")
+
+	for i := 0; i < count; i++ {
+		fmt.Fprintf(&buf, `  File[/tmp/file-%d]: !ruby/object:Puppet::Resource::Status
+    title: "/tmp/file-%d"
+    file: "/etc/puppet/code/environments/production/modules/test/manifests/init.pp"
+    line: %d
+    resource: File[/tmp/file-%d]
+    resource_type: File
+    containment_path:
+    - Stage[main]
+    - Test
+    - File[/tmp/file-%d]
+    evaluation_time: 0.000374922
+    tags:
+    - file
+    - class
+    - test
+    time: '2017-07-29T23:17:09.829591823+00:00'
+    failed: false
+    changed: false
+    out_of_sync: false
+    skipped: false
+    change_count: 0
+    out_of_sync_count: 0
+    events: []
+    corrective_change: false
+`, i, i, i, i, i)
+	}
+
+	buf.WriteString(str[end+1:])
+	return buf.Bytes()
+}
+
+//
+// The streaming parser finds the same summary as the full parser.
+//
+func TestStreamMatchesFull(t *testing.T) {
+
+	tmpl, err := getResource("data/valid.yaml")
+	if err != nil {
+		t.Fatal("Failed to load YAML asset data/valid.yaml")
+	}
+
+	full, err := ParsePuppetReport(tmpl)
+	if err != nil {
+		t.Fatalf("Failed to parse YAML file: %s", err.Error())
+	}
+
+	stream, err := ParsePuppetReportStream(bytes.NewReader(tmpl))
+	if err != nil {
+		t.Fatalf("Failed to stream YAML file: %s", err.Error())
+	}
+
+	if full.Fqdn != stream.Fqdn ||
+		full.Environment != stream.Environment ||
+		full.At != stream.At ||
+		full.State != stream.State ||
+		full.Runtime != stream.Runtime ||
+		full.Failed != stream.Failed ||
+		full.Changed != stream.Changed ||
+		full.Skipped != stream.Skipped ||
+		full.Total != stream.Total {
+		t.Errorf("Summaries differ: %v vs %v", full, stream)
+	}
+
+	if full.Hash != stream.Hash {
+		t.Errorf("Hashes differ: %s vs %s", full.Hash, stream.Hash)
+	}
+
+	if full.PuppetVersion != stream.PuppetVersion ||
+		full.ConfigurationVersion != stream.ConfigurationVersion ||
+		full.LogErrors != stream.LogErrors ||
+		full.LogWarnings != stream.LogWarnings ||
+		len(full.Metrics.Time) != len(stream.Metrics.Time) {
+		t.Errorf("Details differ: %v vs %v", full, stream)
+	}
+
+	if fmt.Sprintf("%v", full.ResourcesChanged) != fmt.Sprintf("%v", stream.ResourcesChanged) ||
+		fmt.Sprintf("%v", full.ResourcesSkipped) != fmt.Sprintf("%v", stream.ResourcesSkipped) ||
+		fmt.Sprintf("%v", full.Slowest(SlowResources)) != fmt.Sprintf("%v", stream.Slowest(SlowResources)) {
+		t.Errorf("Resources differ: %v vs %v", full.Slowest(SlowResources), stream.Slowest(SlowResources))
+	}
+
+	if len(stream.Logs) != 0 {
+		t.Errorf("The streaming parser shouldn't keep logs")
+	}
+}
+
+//
+// Large reports keep their failures, and their slowest resources, but not
+// every resource.
+//
+func TestStreamResources(t *testing.T) {
+
+	report := string(largeReport(t, 1000))
+	report = strings.Replace(report, "    evaluation_time: 0.000374922\n", "    evaluation_time: 0.5\n", 1)
+	report = strings.Replace(report, "    failed: false\n", "    failed: true\n", 1)
+	report = strings.Replace(report, "  level: :notice\n", "  level: :err\n", 1)
+
+	x, err := ParsePuppetReportStream(strings.NewReader(report))
+	if err != nil {
+		t.Fatalf("Failed to stream YAML file: %s", err.Error())
+	}
+
+	if len(x.ResourcesFailed) != 1 || x.ResourcesFailed[0].Name != "/tmp/file-0" {
+		t.Errorf("Unexpected failed resources %v", x.ResourcesFailed)
+	}
+	if len(x.ResourcesOK) > 2*SlowResources {
+		t.Errorf("Kept %d resources", len(x.ResourcesOK))
+	}
+	if x.LogErrors != 1 {
+		t.Errorf("Unexpected count of errors %d", x.LogErrors)
+	}
+
+	slowest := x.Slowest(SlowResources)
+	if len(slowest) != SlowResources || slowest[0].Name != "/tmp/file-0" {
+		t.Errorf("Unexpected slowest resources %v", slowest)
+	}
+}
+
+//
+// Lines longer than our read-buffer don't confuse the parser.
+//
+func TestStreamLongLines(t *testing.T) {
+
+	report := string(largeReport(t, 1))
+
+	//
+	// Add a huge log message, which contains something that
+	// looks like a top-level key after the buffer boundary.
+	//
+	huge := strings.Repeat("x", 64*1024-len("  message: ")) + "\nhost: evil.example.com"
+	huge = strings.Replace(huge, "\n", "", -1)
+	report = strings.Replace(report, "message: Tidying 0 files", "message: \""+huge+"\"", 1)
+
+	x, err := ParsePuppetReportStream(strings.NewReader(report))
+	if err != nil {
+		t.Fatalf("Failed to stream YAML file: %s", err.Error())
+	}
+	if x.Fqdn != "www.steve.org.uk" {
+		t.Errorf("Unexpected host: %s", x.Fqdn)
+	}
+}
+
+//
+// Missing fields are still reported.
+//
+func TestStreamMissingHost(t *testing.T) {
+	_, err := ParsePuppetReportStream(strings.NewReader("---\nenvironment: production\n"))
+	if err == nil || !strings.Contains(err.Error(), "host") {
+		t.Errorf("Expected an error relating to 'host', got %v", err)
+	}
+}
+
+//
+// Compare the memory used to parse a report with 50,000 resources.
+//
+// Run with `go test -bench Parse -benchmem`.
+//
+func BenchmarkParseLargeFull(b *testing.B) {
+	report := largeReport(b, 50000)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(report)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := ParsePuppetReport(report)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+//
+// The same report, parsed via the streaming parser.
+//
+func BenchmarkParseLargeStream(b *testing.B) {
+	report := largeReport(b, 50000)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(report)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := ParsePuppetReportStream(bytes.NewReader(report))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}